@@ -0,0 +1,193 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package binary
+
+import (
+	"io"
+
+	"base/cityhash"
+	"base/errors"
+	"base/lz4"
+
+	"encoding/binary"
+)
+
+// The method byte of the compressed block.
+const (
+	CompressionMethodNone byte = 0x02
+	CompressionMethodLZ4  byte = 0x82
+	CompressionMethodZSTD byte = 0x90
+)
+
+const (
+	checksumSize = 16
+	// Method, compressed size and uncompressed size.
+	compressedHeaderSize = 9
+	// Uncompressed bytes of one block.
+	maxCompressedBlockSize = 1 << 20
+	// Upper bound of the sizes read from the peer.
+	maxCompressedSize = 1 << 30
+)
+
+// CompressedWriter writes the ClickHouse compressed blocks:
+// checksum(16) + method(1) + compressed size(4) + uncompressed size(4) + data,
+// the checksum is CityHash128 of everything after it and the compressed size includes the header.
+type CompressedWriter struct {
+	output io.Writer
+	method byte
+	data   []byte
+	block  []byte
+}
+
+func NewCompressedWriter(w io.Writer) *CompressedWriter {
+	return &CompressedWriter{
+		output: w,
+		method: CompressionMethodLZ4,
+	}
+}
+
+func (writer *CompressedWriter) Write(b []byte) (int, error) {
+	n := len(b)
+	for len(b) > 0 {
+		free := maxCompressedBlockSize - len(writer.data)
+		if free > len(b) {
+			free = len(b)
+		}
+		writer.data = append(writer.data, b[:free]...)
+		b = b[free:]
+		if len(writer.data) >= maxCompressedBlockSize {
+			if err := writer.writeBlock(); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return n, nil
+}
+
+// Flush writes the pending data as a block.
+func (writer *CompressedWriter) Flush() error {
+	if len(writer.data) == 0 {
+		return nil
+	}
+	return writer.writeBlock()
+}
+
+func (writer *CompressedWriter) writeBlock() error {
+	var head [checksumSize + compressedHeaderSize]byte
+
+	block := append(writer.block[:0], head[:]...)
+	switch writer.method {
+	case CompressionMethodLZ4:
+		block = lz4.Compress(block, writer.data)
+	case CompressionMethodNone:
+		block = append(block, writer.data...)
+	default:
+		return errors.ErrorWithCode(errors.UNKNOWN_COMPRESSION_METHOD, "Unknown compression method:0x%02x", writer.method)
+	}
+
+	body := block[checksumSize:]
+	body[0] = writer.method
+	binary.LittleEndian.PutUint32(body[1:], uint32(len(body)))
+	binary.LittleEndian.PutUint32(body[5:], uint32(len(writer.data)))
+	sum := cityhash.CityHash128(body)
+	binary.LittleEndian.PutUint64(block[0:], sum.Low)
+	binary.LittleEndian.PutUint64(block[8:], sum.High)
+
+	writer.block = block
+	writer.data = writer.data[:0]
+	if _, err := writer.output.Write(block); err != nil {
+		return err
+	}
+	return nil
+}
+
+// CompressedReader reads the blocks written by CompressedWriter,
+// a block is only read when the caller needs more bytes.
+type CompressedReader struct {
+	input io.Reader
+	data  []byte
+	pos   int
+	block []byte
+}
+
+func NewCompressedReader(r io.Reader) *CompressedReader {
+	return &CompressedReader{
+		input: r,
+	}
+}
+
+// Read fills up p, reading as many blocks as needed.
+func (reader *CompressedReader) Read(p []byte) (int, error) {
+	n := 0
+	for n < len(p) {
+		if reader.pos == len(reader.data) {
+			if err := reader.readBlock(); err != nil {
+				return n, err
+			}
+		}
+		copied := copy(p[n:], reader.data[reader.pos:])
+		reader.pos += copied
+		n += copied
+	}
+	return n, nil
+}
+
+func (reader *CompressedReader) readBlock() error {
+	var head [checksumSize + compressedHeaderSize]byte
+
+	if _, err := io.ReadFull(reader.input, head[:]); err != nil {
+		return err
+	}
+	method := head[checksumSize]
+	compressedSize := int(binary.LittleEndian.Uint32(head[checksumSize+1:]))
+	uncompressedSize := int(binary.LittleEndian.Uint32(head[checksumSize+5:]))
+	if compressedSize < compressedHeaderSize || compressedSize > maxCompressedSize || uncompressedSize > maxCompressedSize {
+		return errors.ErrorWithCode(errors.CANNOT_DECOMPRESS, "Too large size of the compressed block:%d or uncompressed:%d", compressedSize, uncompressedSize)
+	}
+
+	if cap(reader.block) < compressedSize {
+		reader.block = make([]byte, compressedSize)
+	}
+	body := reader.block[:compressedSize]
+	copy(body, head[checksumSize:])
+	if _, err := io.ReadFull(reader.input, body[compressedHeaderSize:]); err != nil {
+		return err
+	}
+
+	sum := cityhash.CityHash128(body)
+	low := binary.LittleEndian.Uint64(head[0:])
+	high := binary.LittleEndian.Uint64(head[8:])
+	if sum.Low != low || sum.High != high {
+		return errors.ErrorWithCode(errors.CHECKSUM_DOESNT_MATCH, "Compressed block checksum mismatch: reference %016x%016x, calculated %016x%016x", high, low, sum.High, sum.Low)
+	}
+
+	if cap(reader.data) < uncompressedSize {
+		reader.data = make([]byte, uncompressedSize)
+	}
+	reader.data = reader.data[:uncompressedSize]
+	reader.pos = 0
+
+	payload := body[compressedHeaderSize:]
+	switch method {
+	case CompressionMethodLZ4:
+		n, err := lz4.Decompress(reader.data, payload)
+		if err != nil {
+			return errors.ErrorWithCode(errors.CANNOT_DECOMPRESS, "Cannot decompress LZ4 block: %v", err)
+		}
+		if n != uncompressedSize {
+			return errors.ErrorWithCode(errors.CANNOT_DECOMPRESS, "Cannot decompress LZ4 block: got %d bytes, expected %d", n, uncompressedSize)
+		}
+	case CompressionMethodNone:
+		if len(payload) != uncompressedSize {
+			return errors.ErrorWithCode(errors.CANNOT_DECOMPRESS, "Uncompressed block size %d, expected %d", len(payload), uncompressedSize)
+		}
+		copy(reader.data, payload)
+	case CompressionMethodZSTD:
+		return errors.ErrorWithCode(errors.UNKNOWN_COMPRESSION_METHOD, "ZSTD compression method is not supported yet")
+	default:
+		return errors.ErrorWithCode(errors.UNKNOWN_COMPRESSION_METHOD, "Unknown compression method:0x%02x", method)
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package binary
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strings"
+	"testing"
+
+	"base/cityhash"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompressedRoundtrip(t *testing.T) {
+	buf := new(bytes.Buffer)
+	compressed := NewCompressedWriter(buf)
+	writer := NewWriter(compressed)
+
+	long := strings.Repeat("vectorsql", 300000)
+	assert.Nil(t, writer.String("hello"))
+	assert.Nil(t, writer.UInt64(2020))
+	assert.Nil(t, writer.String(long))
+	assert.Nil(t, compressed.Flush())
+	assert.True(t, buf.Len() < len(long))
+
+	// Frames after the flushed data stay unread.
+	buf.WriteString("tail")
+
+	reader := NewReader(NewCompressedReader(buf))
+	{
+		v, err := reader.String()
+		assert.Nil(t, err)
+		assert.Equal(t, "hello", v)
+	}
+	{
+		v, err := reader.UInt64()
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(2020), v)
+	}
+	{
+		v, err := reader.String()
+		assert.Nil(t, err)
+		assert.Equal(t, long, v)
+	}
+	assert.Equal(t, "tail", buf.String())
+}
+
+func TestCompressedReaderError(t *testing.T) {
+	frame := func() []byte {
+		buf := new(bytes.Buffer)
+		compressed := NewCompressedWriter(buf)
+		compressed.Write([]byte(strings.Repeat("vectorsql", 100)))
+		compressed.Flush()
+		return buf.Bytes()
+	}
+
+	tests := []struct {
+		name   string
+		modify func(b []byte)
+		expect string
+	}{
+		{
+			name:   "checksum",
+			modify: func(b []byte) { b[0] ^= 0xff },
+			expect: "checksum mismatch",
+		},
+		{
+			name:   "data",
+			modify: func(b []byte) { b[len(b)-1] ^= 0xff },
+			expect: "checksum mismatch",
+		},
+		{
+			name:   "size",
+			modify: func(b []byte) { b[checksumSize+4] = 0xff },
+			expect: "Too large size of the compressed block",
+		},
+	}
+
+	for _, test := range tests {
+		b := frame()
+		test.modify(b)
+		_, err := NewReader(NewCompressedReader(bytes.NewReader(b))).Bytes(900)
+		assert.NotNil(t, err, test.name)
+		assert.Contains(t, err.Error(), test.expect, test.name)
+	}
+}
+
+// TestCompressedGoldenFrame reads the LZ4 frame of ClickHouse, from the compress golden data of
+// github.com/ClickHouse/ch-go: the checksum is the reference CityHash128 of the rest of the frame.
+func TestCompressedGoldenFrame(t *testing.T) {
+	frame := []byte{
+		0xc5, 0xbc, 0xbc, 0x07, 0xc1, 0x1e, 0xc4, 0x71, 0xfd, 0x74, 0xaf, 0x8d, 0x5b, 0x0f, 0x00, 0xc5,
+		0x82, 0x26, 0x00, 0x00, 0x00, 0xaf, 0x00, 0x00, 0x00, 0x7f, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x21,
+		0x0a, 0x07, 0x00, 0x82, 0x00, 0x93, 0x00, 0x00, 0x9a, 0x00, 0xb0, 0x6c, 0x6f, 0x21, 0x0a, 0x48,
+		0x65, 0x6c, 0x6c, 0x6f, 0x21, 0x0a,
+	}
+	data := strings.Repeat("Hello!\n", 25)
+
+	sum := cityhash.CityHash128(frame[checksumSize:])
+	assert.Equal(t, binary.LittleEndian.Uint64(frame[0:]), sum.Low)
+	assert.Equal(t, binary.LittleEndian.Uint64(frame[8:]), sum.High)
+
+	actual, err := NewReader(NewCompressedReader(bytes.NewReader(frame))).Bytes(len(data))
+	assert.Nil(t, err)
+	assert.Equal(t, data, string(actual))
+
+	// The LZ4 matches of the writer differ from the ClickHouse ones, the header and the data are the same.
+	buf := new(bytes.Buffer)
+	compressed := NewCompressedWriter(buf)
+	_, err = compressed.Write([]byte(data))
+	assert.Nil(t, err)
+	assert.Nil(t, compressed.Flush())
+	written := buf.Bytes()
+	assert.Equal(t, frame[checksumSize], written[checksumSize])
+	assert.Equal(t, frame[checksumSize+5:checksumSize+9], written[checksumSize+5:checksumSize+9])
+	actual, err = NewReader(NewCompressedReader(buf)).Bytes(len(data))
+	assert.Nil(t, err)
+	assert.Equal(t, data, string(actual))
+}
@@ -132,3 +132,7 @@ func (reader *Reader) ReadByte() (byte, error) {
 	}
 	return reader.datas[0], nil
 }
+
+func (reader *Reader) Read(p []byte) (int, error) {
+	return reader.input.Read(p)
+}
@@ -0,0 +1,202 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+// Package cityhash implements CityHash128 of version 1.0.2,
+// the checksum of the ClickHouse compressed blocks.
+package cityhash
+
+import (
+	"encoding/binary"
+)
+
+const (
+	k0   uint64 = 0xc3a5c85c97cb3127
+	k1   uint64 = 0xb492b66fbe98f273
+	k2   uint64 = 0x9ae16a3b2f90404f
+	k3   uint64 = 0xc949d7c7509e6557
+	kMul uint64 = 0x9ddfea08eb382d69
+)
+
+// Uint128 is the 128 bits hash, Low is the first 8 bytes on the wire.
+type Uint128 struct {
+	Low  uint64
+	High uint64
+}
+
+func fetch64(s []byte) uint64 {
+	return binary.LittleEndian.Uint64(s)
+}
+
+func fetch32(s []byte) uint64 {
+	return uint64(binary.LittleEndian.Uint32(s))
+}
+
+func rotate(val uint64, shift uint) uint64 {
+	if shift == 0 {
+		return val
+	}
+	return (val >> shift) | (val << (64 - shift))
+}
+
+func rotateByAtLeast1(val uint64, shift uint) uint64 {
+	return (val >> shift) | (val << (64 - shift))
+}
+
+func shiftMix(val uint64) uint64 {
+	return val ^ (val >> 47)
+}
+
+func hash128to64(lo, hi uint64) uint64 {
+	a := (lo ^ hi) * kMul
+	a ^= a >> 47
+	b := (hi ^ a) * kMul
+	b ^= b >> 47
+	b *= kMul
+	return b
+}
+
+func hashLen16(u, v uint64) uint64 {
+	return hash128to64(u, v)
+}
+
+func hashLen0to16(s []byte) uint64 {
+	n := uint64(len(s))
+	if n > 8 {
+		a := fetch64(s)
+		b := fetch64(s[n-8:])
+		return hashLen16(a, rotateByAtLeast1(b+n, uint(n))) ^ b
+	}
+	if n >= 4 {
+		a := fetch32(s)
+		return hashLen16(n+(a<<3), fetch32(s[n-4:]))
+	}
+	if n > 0 {
+		a := uint32(s[0])
+		b := uint32(s[n>>1])
+		c := uint32(s[n-1])
+		y := a + (b << 8)
+		z := uint32(n) + (c << 2)
+		return shiftMix(uint64(y)*k2^uint64(z)*k3) * k2
+	}
+	return k2
+}
+
+func weakHashLen32WithSeeds(w, x, y, z, a, b uint64) (uint64, uint64) {
+	a += w
+	b = rotate(b+a+z, 21)
+	c := a
+	a += x
+	a += y
+	b += rotate(a, 44)
+	return a + z, b + c
+}
+
+func weakHashLen32WithSeedsBytes(s []byte, a, b uint64) (uint64, uint64) {
+	return weakHashLen32WithSeeds(fetch64(s), fetch64(s[8:]), fetch64(s[16:]), fetch64(s[24:]), a, b)
+}
+
+// cityMurmur hashes the inputs shorter than 128 bytes.
+func cityMurmur(s []byte, seed Uint128) Uint128 {
+	n := len(s)
+	a := seed.Low
+	b := seed.High
+	var c, d uint64
+
+	l := n - 16
+	if l <= 0 {
+		a = shiftMix(a*k1) * k1
+		c = b*k1 + hashLen0to16(s)
+		if n >= 8 {
+			d = shiftMix(a + fetch64(s))
+		} else {
+			d = shiftMix(a + c)
+		}
+	} else {
+		c = hashLen16(fetch64(s[n-8:])+k1, a)
+		d = hashLen16(b+uint64(n), c+fetch64(s[n-16:]))
+		a += d
+		for {
+			a ^= shiftMix(fetch64(s)*k1) * k1
+			a *= k1
+			b ^= a
+			c ^= shiftMix(fetch64(s[8:])*k1) * k1
+			c *= k1
+			d ^= c
+			s = s[16:]
+			l -= 16
+			if l <= 0 {
+				break
+			}
+		}
+	}
+	a = hashLen16(a, c)
+	b = hashLen16(d, b)
+	return Uint128{a ^ b, hashLen16(b, a)}
+}
+
+// CityHash128WithSeed returns the 128 bits hash of s with the seed.
+func CityHash128WithSeed(s []byte, seed Uint128) Uint128 {
+	if len(s) < 128 {
+		return cityMurmur(s, seed)
+	}
+
+	// The tail reads back into the consumed bytes, so the position is kept apart from s.
+	pos := 0
+	n := len(s)
+	x := seed.Low
+	y := seed.High
+	z := uint64(n) * k1
+	var v, w [2]uint64
+	v[0] = rotate(y^k1, 49)*k1 + fetch64(s)
+	v[1] = rotate(v[0], 42)*k1 + fetch64(s[8:])
+	w[0] = rotate(y+z, 35)*k1 + x
+	w[1] = rotate(x+fetch64(s[88:]), 53) * k1
+
+	// This is the same inner loop as CityHash64, manually unrolled.
+	for {
+		for round := 0; round < 2; round++ {
+			x = rotate(x+y+v[0]+fetch64(s[pos+16:]), 37) * k1
+			y = rotate(y+v[1]+fetch64(s[pos+48:]), 42) * k1
+			x ^= w[1]
+			y ^= v[0]
+			z = rotate(z^w[0], 33)
+			v[0], v[1] = weakHashLen32WithSeedsBytes(s[pos:], v[1]*k1, x+w[0])
+			w[0], w[1] = weakHashLen32WithSeedsBytes(s[pos+32:], z+w[1], y)
+			z, x = x, z
+			pos += 64
+		}
+		n -= 128
+		if n < 128 {
+			break
+		}
+	}
+	y += rotate(w[0], 37)*k0 + z
+	x += rotate(v[0]+z, 49) * k0
+
+	// Hash up to 4 chunks of 32 bytes each from the end of s.
+	for tailDone := 0; tailDone < n; {
+		tailDone += 32
+		y = rotate(y-x, 42)*k0 + v[1]
+		w[0] += fetch64(s[pos+n-tailDone+16:])
+		x = rotate(x, 49)*k0 + w[0]
+		w[0] += v[0]
+		v[0], v[1] = weakHashLen32WithSeedsBytes(s[pos+n-tailDone:], v[0], v[1])
+	}
+
+	x = hashLen16(x, v[0])
+	y = hashLen16(y, w[0])
+	return Uint128{hashLen16(x+v[1], w[1]) + y, hashLen16(x+w[1], y+v[1])}
+}
+
+// CityHash128 returns the 128 bits hash of s.
+func CityHash128(s []byte) Uint128 {
+	n := uint64(len(s))
+	if n >= 16 {
+		return CityHash128WithSeed(s[16:], Uint128{fetch64(s) ^ k3, fetch64(s[8:])})
+	}
+	if n >= 8 {
+		return CityHash128WithSeed(nil, Uint128{fetch64(s) ^ (n * k0), fetch64(s[n-8:]) ^ k1})
+	}
+	return CityHash128WithSeed(s, Uint128{k0, k1})
+}
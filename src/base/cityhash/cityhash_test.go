@@ -0,0 +1,46 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package cityhash
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// The vectors are of the reference CityHash 1.0.2 of ClickHouse, from the ch128 test data of
+// github.com/go-faster/city and the empty one by its CH128. The lengths cover the branches of
+// CityHash128 and cityMurmur and the 128 bytes loop of CityHash128WithSeed.
+func TestCityHash128(t *testing.T) {
+	tests := []struct {
+		in   string
+		low  uint64
+		high uint64
+	}{
+		{in: "", low: 0x3df09dfc64c09a2b, high: 0x3cb540c392e51e29},
+		{in: "I", low: 0x3a4a285c94f1936e, high: 0x18115b183f326829},
+		{in: "uM4", low: 0xf567f556f5e83547, high: 0xa5c90efc9fbfd1f4},
+		{in: "nYUkHfL", low: 0x1a5dcb41c6c5af4b, high: 0x6e6fb2894e712ca0},
+		{in: "hgshOXeh", low: 0x3d827f87ef026cde, high: 0xdb3ea59195083762},
+		{in: "jq4yNr2sh", low: 0x2f6dc419deac4528, high: 0x2ac6994229cb757c},
+		{in: "ikMYpqGCRNf8lu", low: 0x5c28ef61e44ca61c, high: 0x3cf4e536bb86eb23},
+		{in: "vG5lApCgvf0qHGSi0", low: 0x9b4e57c5f1bc0e44, high: 0x94238ef9d1584336},
+		{in: "iQYX4e0w23d9Nu9lu4CcD5J12xC43El", low: 0xcf289326b4b8112e, high: 0x9b3c70221b26da25},
+		{in: "NflsJ2nh3KrqmSrYO5Rc2jcBIfxNickP", low: 0xcaa121c0f5ae65dc, high: 0xf4482d14c988e8f6},
+		{in: "Gv46ueHUXbDvkTiKLlYotjhYAgb3kS2WB", low: 0x7a3ba1d735c6cd5e, high: 0x6122b267103409e0},
+		{in: "8puRXyzrhWdBYjGzaPLHv8IbQ9WcFW85wsAwhYnzp1MaGR1fnkeMwdZXsaA4umZ", low: 0x77ba10ea0f4bd602, high: 0x399df10bb9154a42},
+		{in: "LpFcKNK9ybSpbCpocXvnwdqgqtbXUb32dQoSpdswmqpt73vs4w6etFJzyaEdD2V7vXSVLEPThCJYMa5eIjMD56JQKYTQwQyTLQT87Lysb6TfC1s0uWuuye96Wwml133", low: 0x4503305a9798430f, high: 0xb9bb426af29e421c},
+		{in: "yAWO2qGgsX9sDMCwgVoZc6JiEBHNvnO5flnGjwx0jj1b54VYEdUeox5KgrDKMOJe2kF08StGwtElfNxTUccCIFbiN3LmtVrVps4MScfkE5G7KTfHcJHRpYJY7rSlfyh5", low: 0x6b818e4caac9641c, high: 0x8d575710f793d15a},
+		{in: "9YwzHHoZLcvWHD9kxfUo5YTgaPNwXRP4SGxsYC53LuJQUj48ZDUhHftQamg6J0pTA3hY5AVtWy6W7JP8RnGXZbbXOVxvXbY9tI0xyLoTNbWm3im4U6EgOs3u5u7kJRTDs", low: 0x4fead0d0872da4c6, high: 0x52bb5177cde8b75},
+		{in: "yW6892pMW2Su5CUvdzCqqNvaBS59r2YhQpFOfbu4vNEVy3N5uwPnIiLQ0RBbJIxrAD2MU14cgVIkGtOhyX8sH6ufvlGd5QgGj8LfKof4I4dERLprPLfB1wrCeXrwwSrTFBS29m3BmtaAvQHPw", low: 0x27d37633662765f3, high: 0x8f5d8cb982811b75},
+		{in: "qfTh7Rv18N4JhMr7D0qvfmxNOL4oyiKKYcCtLenP0YeK6I9PXJyqDBGcFXf6cUg4OO0lWf7iP5noNcfMmV8qbZTPpOVokP9NawO9F2YmPU7rBe8e3aPrqqKHgRqwRdAccFUm9zCVEFcOqkGTwIFX6dwVp0HRzp2A0VQYz44ZMihx9BFSMneS5uVsbpxoDUfyWN94adq6vKvIe9nTTapYeR1q0qaqYVxYmZT1eDWDKHcCR9XjOUShYEwc9Hoxzwqv", low: 0x6e6ce91abf1af164, high: 0x7afaa37dd33af121},
+		{in: "Vu3tP6k8p9PCiilR7S5M1YSrsCIjEj1pvip6YCFa8WwHm4KP8jSh5WnqACxnAKdowsePvinQgcTd6aoZfbc1vp6uF84CVwK4PzlSvjDmvZYvf8XgQxZR6V9fNhcjoOj6PUft6baUrsHyciYTbSfDKBN2inCahc0ho3NwD8YovZHBcje8SDQEtDtm6FfqviLfVQif0jD3LrCuRuASSxFRoLsnavppLyim9hFUstJsNlYv2VKlsJVAG6Ip4QfgJBcMetJujGVYiepMzF8nPGeCCmvxvKV3ykPwr1qH6NT5okzF", low: 0x82d81021a215b893, high: 0x6b79606dab35371a},
+	}
+
+	for _, test := range tests {
+		actual := CityHash128([]byte(test.in))
+		assert.Equal(t, Uint128{Low: test.low, High: test.high}, actual, "len:%d", len(test.in))
+	}
+}
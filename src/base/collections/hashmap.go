@@ -6,11 +6,14 @@
 package collections
 
 import (
+	"unsafe"
+
 	"github.com/segmentio/fasthash/fnv1a"
 )
 
 type HashMap struct {
 	count     int
+	bytes     int
 	container map[uint64][]entry
 }
 
@@ -38,6 +41,7 @@ func (hm *HashMap) SetByHash(key string, hash uint64, value interface{}) error {
 		value: value,
 	})
 	hm.count++
+	hm.bytes += len(key) + int(unsafe.Sizeof(entry{}))
 	return nil
 }
 
@@ -56,6 +60,11 @@ func (hm *HashMap) Count() int {
 	return hm.count
 }
 
+// Bytes returns the bytes of the keys and the entries, the values are not counted.
+func (hm *HashMap) Bytes() int {
+	return hm.bytes
+}
+
 func (hm *HashMap) GetIterator() *HashMapIterator {
 	hashes := make([]uint64, 0, len(hm.container))
 	for k := range hm.container {
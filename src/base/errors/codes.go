@@ -8,6 +8,39 @@ import ()
 
 // Error type.
 const (
-	UNEXPECTED_PACKET_FROM_CLIENT int = 101
-	ER_INTERPRETER_CREATOR_UNKNOW int = 422
+	CANNOT_PARSE_TEXT                     int = 6
+	ARGUMENT_OUT_OF_BOUND                 int = 12
+	NO_SUCH_COLUMN_IN_TABLE               int = 16
+	CHECKSUM_DOESNT_MATCH                 int = 40
+	UNKNOWN_FUNCTION                      int = 46
+	NOT_IMPLEMENTED                       int = 48
+	UNKNOWN_TYPE                          int = 50
+	TYPE_MISMATCH                         int = 53
+	UNKNOWN_STORAGE                       int = 56
+	TABLE_ALREADY_EXISTS                  int = 57
+	UNKNOWN_TABLE                         int = 60
+	SYNTAX_ERROR                          int = 62
+	UNKNOWN_DATABASE                      int = 81
+	DATABASE_ALREADY_EXISTS               int = 82
+	UNKNOWN_COMPRESSION_METHOD            int = 89
+	UNEXPECTED_PACKET_FROM_CLIENT         int = 101
+	UNKNOWN_SETTING                       int = 115
+	TOO_MANY_ROWS                         int = 158
+	TIMEOUT_EXCEEDED                      int = 159
+	TOO_SLOW                              int = 160
+	READONLY                              int = 164
+	TOO_MANY_SIMULTANEOUS_QUERIES         int = 202
+	QUERY_WITH_SAME_ID_IS_ALREADY_RUNNING int = 216
+	ABORTED                               int = 236
+	MEMORY_LIMIT_EXCEEDED                 int = 241
+	CANNOT_DECOMPRESS                     int = 271
+	LIMIT_EXCEEDED                        int = 290
+	TOO_MANY_BYTES                        int = 307
+	SESSION_NOT_FOUND                     int = 372
+	SESSION_IS_LOCKED                     int = 373
+	ER_INTERPRETER_CREATOR_UNKNOW         int = 422
+	CANNOT_COMPILE_REGEXP                 int = 427
+	ACCESS_DENIED                         int = 497
+	AUTHENTICATION_FAILED                 int = 516
+	UNKNOWN_EXCEPTION                     int = 1002
 )
@@ -21,6 +21,14 @@ func (e *Error) Code() int {
 	return e.num
 }
 
+// Code returns the code of the error, the errors without one are UNKNOWN_EXCEPTION.
+func Code(err error) int {
+	if xerr, ok := err.(*Error); ok && xerr.num != 0 {
+		return xerr.num
+	}
+	return UNKNOWN_EXCEPTION
+}
+
 func New(msg string) error {
 	return &Error{
 		msg:   msg,
@@ -78,9 +78,10 @@ func (c *Cache) Add(key Key, value interface{}) {
 }
 
 // Get looks up a key's value from the cache.
+// It moves the entry to the front, so it takes the write lock.
 func (c *Cache) Get(key Key) (value interface{}, ok bool) {
-	c.mu.RLock()
-	defer c.mu.RUnlock()
+	c.mu.Lock()
+	defer c.mu.Unlock()
 
 	if c.cache == nil {
 		return
@@ -105,7 +106,14 @@ func (c *Cache) Remove(key Key) {
 	}
 }
 
-// removeOldest removes the oldest item from the cache.
+// RemoveOldest removes the oldest item from the cache.
+func (c *Cache) RemoveOldest() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.removeOldest()
+}
+
 func (c *Cache) removeOldest() {
 	if c.cache == nil {
 		return
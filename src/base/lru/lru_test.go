@@ -95,3 +95,23 @@ func TestEvict(t *testing.T) {
 		t.Fatalf("got %v in second evicted key; want %s", evictedKeys[1], "myKey1")
 	}
 }
+
+func TestRemoveOldest(t *testing.T) {
+	lru := New(0)
+	lru.Add("myKey0", 1234)
+	lru.Add("myKey1", 1234)
+	lru.Get("myKey0")
+
+	lru.RemoveOldest()
+	if _, ok := lru.Get("myKey1"); ok {
+		t.Fatal("TestRemoveOldest returned the oldest entry")
+	}
+	if _, ok := lru.Get("myKey0"); !ok {
+		t.Fatal("TestRemoveOldest removed the recently used entry")
+	}
+	lru.RemoveOldest()
+	lru.RemoveOldest()
+	if lru.Len() != 0 {
+		t.Fatalf("got %d entries; want 0", lru.Len())
+	}
+}
@@ -0,0 +1,197 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+// Package lz4 implements the LZ4 block format, without the frame.
+package lz4
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+const (
+	minMatch     = 4
+	lastLiterals = 5
+	// A match must start at least mfLimit bytes before the end of the input.
+	mfLimit   = 12
+	maxOffset = 65535
+	hashLog   = 16
+)
+
+var (
+	ErrCorrupted   = errors.New("lz4: corrupted block")
+	ErrShortBuffer = errors.New("lz4: destination buffer too short")
+)
+
+// CompressBound returns the maximum size of the compressed n bytes.
+func CompressBound(n int) int {
+	return n + n/255 + 16
+}
+
+// Compress appends the compressed src to dst and returns the extended slice.
+func Compress(dst, src []byte) []byte {
+	anchor := 0
+
+	if len(src) > mfLimit {
+		table := make([]int32, 1<<hashLog)
+		limit := len(src) - mfLimit
+		matchLimit := len(src) - lastLiterals
+
+		for i := 0; i < limit; {
+			seq := binary.LittleEndian.Uint32(src[i:])
+			h := (seq * 2654435761) >> (32 - hashLog)
+			ref := int(table[h]) - 1
+			table[h] = int32(i + 1)
+			if ref < 0 || i-ref > maxOffset || binary.LittleEndian.Uint32(src[ref:]) != seq {
+				i++
+				continue
+			}
+
+			// Extend backwards into the pending literals.
+			for i > anchor && ref > 0 && src[i-1] == src[ref-1] {
+				i--
+				ref--
+			}
+			end := i + minMatch
+			for end < matchLimit && src[end] == src[ref+end-i] {
+				end++
+			}
+			dst = appendSequence(dst, src[anchor:i], i-ref, end-i)
+			anchor = end
+			i = end
+		}
+	}
+
+	// The last literals.
+	lits := len(src) - anchor
+	if lits >= 15 {
+		dst = append(dst, 15<<4)
+		dst = appendLength(dst, lits-15)
+	} else {
+		dst = append(dst, byte(lits<<4))
+	}
+	return append(dst, src[anchor:]...)
+}
+
+func appendSequence(dst []byte, lits []byte, offset int, matchLen int) []byte {
+	l := len(lits)
+	m := matchLen - minMatch
+
+	token := byte(0)
+	if l >= 15 {
+		token = 15 << 4
+	} else {
+		token = byte(l << 4)
+	}
+	if m >= 15 {
+		token |= 15
+	} else {
+		token |= byte(m)
+	}
+
+	dst = append(dst, token)
+	if l >= 15 {
+		dst = appendLength(dst, l-15)
+	}
+	dst = append(dst, lits...)
+	dst = append(dst, byte(offset), byte(offset>>8))
+	if m >= 15 {
+		dst = appendLength(dst, m-15)
+	}
+	return dst
+}
+
+func appendLength(dst []byte, n int) []byte {
+	for n >= 255 {
+		dst = append(dst, 255)
+		n -= 255
+	}
+	return append(dst, byte(n))
+}
+
+// Decompress decompresses src into dst and returns the number of bytes written,
+// dst must be large enough to hold the whole block.
+func Decompress(dst, src []byte) (int, error) {
+	si, di := 0, 0
+
+	for si < len(src) {
+		token := src[si]
+		si++
+
+		// Literals.
+		lits := int(token >> 4)
+		if lits == 15 {
+			n, next, err := readLength(src, si)
+			if err != nil {
+				return 0, err
+			}
+			lits += n
+			si = next
+		}
+		if lits > len(src)-si {
+			return 0, ErrCorrupted
+		}
+		if lits > len(dst)-di {
+			return 0, ErrShortBuffer
+		}
+		copy(dst[di:], src[si:si+lits])
+		si += lits
+		di += lits
+
+		// The last sequence has no match.
+		if si == len(src) {
+			break
+		}
+
+		// Match.
+		if si+2 > len(src) {
+			return 0, ErrCorrupted
+		}
+		offset := int(src[si]) | int(src[si+1])<<8
+		si += 2
+		if offset == 0 || offset > di {
+			return 0, ErrCorrupted
+		}
+		matchLen := int(token & 15)
+		if matchLen == 15 {
+			n, next, err := readLength(src, si)
+			if err != nil {
+				return 0, err
+			}
+			matchLen += n
+			si = next
+		}
+		matchLen += minMatch
+		if matchLen > len(dst)-di {
+			return 0, ErrShortBuffer
+		}
+
+		ref := di - offset
+		if offset >= matchLen {
+			copy(dst[di:di+matchLen], dst[ref:ref+matchLen])
+		} else {
+			// Overlapped, the match repeats the last offset bytes.
+			for k := 0; k < matchLen; k++ {
+				dst[di+k] = dst[ref+k]
+			}
+		}
+		di += matchLen
+	}
+	return di, nil
+}
+
+func readLength(src []byte, si int) (int, int, error) {
+	n := 0
+	for {
+		if si >= len(src) {
+			return 0, 0, ErrCorrupted
+		}
+		b := src[si]
+		si++
+		n += int(b)
+		if b != 255 {
+			return n, si, nil
+		}
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package lz4
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLZ4Roundtrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	random := make([]byte, 100000)
+	r.Read(random)
+	mixed := make([]byte, 300000)
+	for i := range mixed {
+		if r.Intn(4) == 0 {
+			mixed[i] = byte(r.Intn(256))
+		} else {
+			mixed[i] = byte('a' + r.Intn(3))
+		}
+	}
+
+	tests := []struct {
+		name string
+		src  []byte
+	}{
+		{name: "empty", src: []byte{}},
+		{name: "one", src: []byte("x")},
+		{name: "short", src: []byte("abcdabcdabcd")},
+		{name: "repeat", src: bytes.Repeat([]byte("a"), 100000)},
+		{name: "text", src: []byte(strings.Repeat("select number from system.numbers; ", 1000))},
+		{name: "random", src: random},
+		{name: "mixed", src: mixed},
+	}
+
+	for _, test := range tests {
+		compressed := Compress(nil, test.src)
+		assert.True(t, len(compressed) <= CompressBound(len(test.src)), test.name)
+
+		dst := make([]byte, len(test.src))
+		n, err := Decompress(dst, compressed)
+		assert.Nil(t, err, test.name)
+		assert.Equal(t, len(test.src), n, test.name)
+		assert.Equal(t, test.src, dst, test.name)
+	}
+}
+
+func TestLZ4DecompressReference(t *testing.T) {
+	// Compressed by the reference implementation.
+	src := []byte(strings.Repeat("vectorsql vectorsql vectorsql, abcabcabcabcabcabcabcabc; ", 3))
+	compressed, _ := hex.DecodeString("af766563746f7273716c200a00005f2c206162630300021f3b2f00010614000f39003d506162633b20")
+
+	dst := make([]byte, len(src))
+	n, err := Decompress(dst, compressed)
+	assert.Nil(t, err)
+	assert.Equal(t, src, dst[:n])
+}
+
+func TestLZ4DecompressError(t *testing.T) {
+	src := []byte(strings.Repeat("vectorsql ", 100))
+	compressed := Compress(nil, src)
+
+	tests := []struct {
+		name   string
+		dst    []byte
+		src    []byte
+		expect error
+	}{
+		{name: "short-dst", dst: make([]byte, 10), src: compressed, expect: ErrShortBuffer},
+		{name: "truncated", dst: make([]byte, len(src)), src: compressed[:len(compressed)-1], expect: ErrCorrupted},
+		{name: "zero-offset", dst: make([]byte, 100), src: []byte{0x10, 'a', 0x00, 0x00}, expect: ErrCorrupted},
+		{name: "far-offset", dst: make([]byte, 100), src: []byte{0x10, 'a', 0x02, 0x00}, expect: ErrCorrupted},
+	}
+
+	for _, test := range tests {
+		_, err := Decompress(test.dst, test.src)
+		assert.Equal(t, test.expect, err, test.name)
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package xlog
+
+import (
+	"compress/gzip"
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	"base/zstd"
+)
+
+var errCompressClosed = errors.New("xlog: compressed writer is closed")
+
+// compressor is the stream of the gzip and the zstd writers.
+type compressor interface {
+	io.WriteCloser
+	Flush() error
+}
+
+// CompressWriter compresses the log written through it, such as by NewXLog into the file of the logs archived.
+// The stream is flushed every second and by the Flush of the logger, the lines flushed are read
+// by the decoder before the stream ends. Close ends the stream, the underlying writer is not closed.
+type CompressWriter struct {
+	mu     sync.Mutex
+	c      compressor
+	closed bool
+	done   chan struct{}
+}
+
+// NewGzipWriter creates the gzip writer of the level of compress/gzip, such as gzip.DefaultCompression.
+func NewGzipWriter(w io.Writer, level int) (*CompressWriter, error) {
+	c, err := gzip.NewWriterLevel(w, level)
+	if err != nil {
+		return nil, err
+	}
+	return newCompressWriter(c), nil
+}
+
+// NewZstdWriter creates the zstd writer of the level from zstd.MinLevel to zstd.MaxLevel, such as zstd.DefaultLevel.
+func NewZstdWriter(w io.Writer, level int) (*CompressWriter, error) {
+	c, err := zstd.NewWriter(w, level)
+	if err != nil {
+		return nil, err
+	}
+	return newCompressWriter(c), nil
+}
+
+func newCompressWriter(c compressor) *CompressWriter {
+	w := &CompressWriter{
+		c:    c,
+		done: make(chan struct{}),
+	}
+	go w.flushLoop()
+	return w
+}
+
+func (w *CompressWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return 0, errCompressClosed
+	}
+	return w.c.Write(p)
+}
+
+func (w *CompressWriter) Flush() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return nil
+	}
+	return w.c.Flush()
+}
+
+func (w *CompressWriter) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+	close(w.done)
+	return w.c.Close()
+}
+
+func (w *CompressWriter) flushLoop() {
+	t := time.NewTicker(fileFlushInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-t.C:
+			_ = w.Flush()
+		case <-w.done:
+			return
+		}
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package xlog
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"sync"
+	"testing"
+
+	"base/zstd"
+)
+
+// lockedBuffer is the file the flush goroutine writes to.
+type lockedBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *lockedBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *lockedBuffer) Bytes() []byte {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]byte(nil), b.buf.Bytes()...)
+}
+
+func TestGzipWriter(t *testing.T) {
+	out := &lockedBuffer{}
+	w, err := NewGzipWriter(out, gzip.BestCompression)
+	Assert(t, err == nil, "%v", err)
+	log := NewXLog(w, Level(INFO))
+
+	n := 1000
+	for i := 0; i < n; i++ {
+		log.Info("line-%d", i)
+	}
+
+	// The lines flushed are read before the stream ends.
+	err = log.Flush()
+	Assert(t, err == nil, "%v", err)
+	r, err := gzip.NewReader(bytes.NewReader(out.Bytes()))
+	Assert(t, err == nil, "%v", err)
+	data, _ := ioutil.ReadAll(r)
+	Assert(t, strings.Count(string(data), "\n") == n, "lines:%v", strings.Count(string(data), "\n"))
+
+	log.Warning("last-line")
+	err = w.Close()
+	Assert(t, err == nil, "%v", err)
+	r, err = gzip.NewReader(bytes.NewReader(out.Bytes()))
+	Assert(t, err == nil, "%v", err)
+	data, err = ioutil.ReadAll(r)
+	Assert(t, err == nil, "%v", err)
+	lines := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+	Assert(t, len(lines) == n+1, "lines:%v", len(lines))
+	for i := 0; i < n; i++ {
+		Assert(t, strings.Contains(lines[i], fmt.Sprintf("line-%d", i)), "line:%v", lines[i])
+	}
+	Assert(t, strings.Contains(lines[n], "last-line"), "line:%v", lines[n])
+	Assert(t, len(out.Bytes()) < len(data)/4, "%d -> %d", len(data), len(out.Bytes()))
+
+	// Close is idempotent, the writes after fail.
+	err = w.Close()
+	Assert(t, err == nil, "%v", err)
+	_, err = w.Write([]byte("x"))
+	Assert(t, err == errCompressClosed, "%v", err)
+
+	_, err = NewGzipWriter(out, 10)
+	Assert(t, err != nil, "level 10")
+}
+
+func TestZstdWriter(t *testing.T) {
+	out := &lockedBuffer{}
+	w, err := NewZstdWriter(out, zstd.DefaultLevel)
+	Assert(t, err == nil, "%v", err)
+	log := NewXLog(w, Level(INFO))
+
+	n := 1000
+	for i := 0; i < n; i++ {
+		log.Info("line-%d", i)
+	}
+
+	// The frame header and the blocks so far.
+	err = log.Flush()
+	Assert(t, err == nil, "%v", err)
+	flushed := len(out.Bytes())
+	Assert(t, flushed > 0 && bytes.HasPrefix(out.Bytes(), []byte{0x28, 0xB5, 0x2F, 0xFD}), "flushed:%x", out.Bytes())
+	Assert(t, flushed < n*len("2020/03/04 05:06:07.089101    	 [INFO] 	line-999")/4, "flushed:%v", flushed)
+
+	// The last block.
+	err = w.Close()
+	Assert(t, err == nil, "%v", err)
+	Assert(t, len(out.Bytes()) == flushed+3, "closed:%v", len(out.Bytes()))
+
+	_, err = NewZstdWriter(out, zstd.MaxLevel+1)
+	Assert(t, err != nil, "level %d", zstd.MaxLevel+1)
+}
@@ -0,0 +1,27 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package xlog
+
+import (
+	"context"
+)
+
+type contextKey struct{}
+
+// WithLoggerInContext returns the context carrying the logger, set it at the request entry
+// with the request fields, such as log.With("query_id", id).
+func WithLoggerInContext(ctx context.Context, log *Log) context.Context {
+	return context.WithValue(ctx, contextKey{}, log)
+}
+
+// LoggerFromContext returns the logger of the context, or the default logger of GetLog.
+func LoggerFromContext(ctx context.Context) *Log {
+	if ctx != nil {
+		if log, ok := ctx.Value(contextKey{}).(*Log); ok && log != nil {
+			return log
+		}
+	}
+	return GetLog()
+}
@@ -0,0 +1,29 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package xlog
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestLoggerInContext(t *testing.T) {
+	buf := new(bytes.Buffer)
+	root := NewXLog(buf, Level(INFO))
+	defer func() { defaultlog = nil }()
+
+	// The fallback is the default logger.
+	Assert(t, LoggerFromContext(context.Background()) == root, "expected the default logger")
+	Assert(t, LoggerFromContext(nil) == root, "expected the default logger")
+
+	// The fields of the request logger are attached to the entries of the callee.
+	ctx := WithLoggerInContext(context.Background(), root.With("query_id", "q1"))
+	child, cancel := context.WithCancel(ctx)
+	defer cancel()
+	LoggerFromContext(child).Info("hello")
+	Assert(t, strings.Contains(buf.String(), "hello query_id=q1"), "log:%v", buf.String())
+}
@@ -0,0 +1,98 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package xlog
+
+import (
+	"bufio"
+	"errors"
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	fileBufferSize    = 64 << 10
+	fileFlushInterval = time.Second
+)
+
+var errFileClosed = errors.New("xlog: file sink is closed")
+
+// fileWriter is the buffered file, flushed every second by the background goroutine.
+type fileWriter struct {
+	mu     sync.Mutex
+	file   *os.File
+	buf    *bufio.Writer
+	closed bool
+	done   chan struct{}
+}
+
+func (w *fileWriter) Write(level LogLevel, formatted []byte) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return errFileClosed
+	}
+	_, err := w.buf.Write(formatted)
+	return err
+}
+
+func (w *fileWriter) Flush() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return nil
+	}
+	return w.buf.Flush()
+}
+
+func (w *fileWriter) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+	close(w.done)
+
+	err := w.buf.Flush()
+	if serr := w.file.Sync(); err == nil {
+		err = serr
+	}
+	if cerr := w.file.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+func (w *fileWriter) flushLoop() {
+	t := time.NewTicker(fileFlushInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-t.C:
+			_ = w.Flush()
+		case <-w.done:
+			return
+		}
+	}
+}
+
+// NewFileLog creates a logger appending to the file at path, the writes are buffered.
+// Close must be called to flush the buffer, stop the goroutines and release the file,
+// the logger is not the default one unless SetDefault.
+func NewFileLog(path string, opts ...Option) (*Log, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &fileWriter{
+		file: f,
+		buf:  bufio.NewWriterSize(f, fileBufferSize),
+		done: make(chan struct{}),
+	}
+	go w.flushLoop()
+	return NewSinkLog(w, opts...), nil
+}
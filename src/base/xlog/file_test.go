@@ -0,0 +1,61 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package xlog
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestFileLog(t *testing.T) {
+	dir, err := ioutil.TempDir("", "xlog")
+	Assert(t, err == nil, "%v", err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "vectorsql.log")
+	log, err := NewFileLog(path, Level(INFO))
+	Assert(t, err == nil, "%v", err)
+	defer func() { defaultlog = nil }()
+
+	// The default logger is only set by the SetDefault.
+	Assert(t, GetLog() != log, "expected the std logger")
+	SetDefault(log)
+	Assert(t, GetLog() == log, "expected the file logger")
+
+	n := 100
+	for i := 0; i < n; i++ {
+		log.Info("line-%d", i)
+	}
+
+	err = log.Flush()
+	Assert(t, err == nil, "%v", err)
+	{
+		data, err := ioutil.ReadFile(path)
+		Assert(t, err == nil, "%v", err)
+		Assert(t, strings.Count(string(data), "\n") == n, "lines:%v", strings.Count(string(data), "\n"))
+	}
+
+	log.Named("storage").Warning("last-line")
+	err = log.Close()
+	Assert(t, err == nil, "%v", err)
+	{
+		data, err := ioutil.ReadFile(path)
+		Assert(t, err == nil, "%v", err)
+		lines := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+		Assert(t, len(lines) == n+1, "lines:%v", len(lines))
+		for i := 0; i < n; i++ {
+			Assert(t, strings.Contains(lines[i], fmt.Sprintf("line-%d ", i)), "line:%v", lines[i])
+		}
+		Assert(t, strings.Contains(lines[n], "[storage] last-line"), "line:%v", lines[n])
+	}
+
+	// Close is idempotent.
+	err = log.Close()
+	Assert(t, err == nil, "%v", err)
+}
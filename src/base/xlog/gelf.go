@@ -0,0 +1,235 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package xlog
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	gelfVersion          = "1.1"
+	gelfDefaultChunkSize = 1420
+	gelfChunkHeaderSize  = 12
+	gelfMaxChunks        = 128
+	gelfDialTimeout      = 5 * time.Second
+)
+
+var gelfChunkMagic = []byte{0x1e, 0x0f}
+
+// gelfLevels maps the levels to the syslog severities.
+var gelfLevels = [...]int{
+	DEBUG:   7,
+	INFO:    6,
+	WARNING: 4,
+	ERROR:   3,
+	FATAL:   2,
+	PANIC:   1,
+}
+
+// NewGELFLog creates a logger which ships every entry as a GELF payload to the Graylog input at addr.
+// The addr is 'udp://host:port' or 'tcp://host:port', udp if no scheme is given.
+// UDP entries larger than the chunk size are chunked, TCP entries are null-byte delimited.
+func NewGELFLog(addr string, opts ...Option) *Log {
+	options := newOptions(opts...)
+
+	network := "udp"
+	if i := strings.Index(addr, "://"); i >= 0 {
+		network, addr = strings.ToLower(addr[:i]), addr[i+3:]
+	}
+	host := options.GELFHost
+	if host == "" {
+		host, _ = os.Hostname()
+	}
+	chunkSize := options.GELFChunkSize
+	if chunkSize <= gelfChunkHeaderSize {
+		chunkSize = gelfDefaultChunkSize
+	}
+
+	l := &Log{
+		opts:  options,
+		comps: newComponents(options.Level),
+		gelf: &gelfWriter{
+			network:   network,
+			addr:      addr,
+			host:      host,
+			chunkSize: chunkSize,
+		},
+	}
+	l.limiter = newSiteLimiter(options)
+	l.Logger = log.New(&gelfLineWriter{log: l}, "", 0)
+	l.limiter.start(l)
+	return l
+}
+
+// gelfLineWriter ships the lines written by the embedded log.Logger as INFO entries.
+type gelfLineWriter struct {
+	log *Log
+}
+
+func (w *gelfLineWriter) Write(p []byte) (int, error) {
+	w.log.gelf.write(w.log, entry{level: INFO, format: "%s", args: []interface{}{strings.TrimRight(string(p), "\n")}})
+	return len(p), nil
+}
+
+type gelfWriter struct {
+	mu        sync.Mutex
+	network   string
+	addr      string
+	host      string
+	chunkSize int
+	conn      net.Conn
+}
+
+func (w *gelfWriter) write(t *Log, e entry) {
+	payload, err := json.Marshal(w.message(t, e))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "xlog.gelf marshal error:%v\n", err)
+		return
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if err := w.send(payload); err != nil {
+		// Reconnect once, the TCP peer may have gone.
+		w.reset()
+		if err = w.send(payload); err != nil {
+			w.reset()
+			fmt.Fprintf(os.Stderr, "xlog.gelf send to %s://%s error:%v\n", w.network, w.addr, err)
+		}
+	}
+}
+
+func (w *gelfWriter) message(t *Log, e entry) map[string]interface{} {
+	msg, c := e.message(), e.caller
+	short := msg
+	if i := strings.IndexByte(msg, '\n'); i >= 0 {
+		short = msg[:i]
+	}
+	m := map[string]interface{}{
+		"version":       gelfVersion,
+		"host":          w.host,
+		"short_message": short,
+		"timestamp":     float64(e.time.UnixNano()/int64(time.Millisecond)) / 1000,
+		"level":         gelfLevels[e.level],
+	}
+	if short != msg {
+		m["full_message"] = msg
+	}
+	if name := strings.TrimSpace(t.opts.Name); name != "" {
+		m["_logger"] = name
+	}
+	if t.name != "" {
+		m["_component"] = t.name
+	}
+	if c.file != "" {
+		m["_file"] = c.file
+		m["_line"] = c.line
+		m["_func"] = c.fn
+	}
+	if e.stack != "" {
+		m["_stacktrace"] = e.stack
+	}
+	for _, f := range t.fields {
+		m[gelfFieldName(f.Key)] = gelfFieldValue(f.Value)
+	}
+	return m
+}
+
+// gelfFieldName makes the additional field name, which must match ^_[\w\.\-]*$ and not be '_id'.
+func gelfFieldName(key string) string {
+	name := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '_', r == '.', r == '-':
+			return r
+		}
+		return '_'
+	}, key)
+	if name == "id" {
+		name = "id_"
+	}
+	return "_" + name
+}
+
+func gelfFieldValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case string, bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return v
+	case error:
+		return v.Error()
+	}
+	return fmt.Sprint(v)
+}
+
+func (w *gelfWriter) send(payload []byte) error {
+	if w.conn == nil {
+		conn, err := net.DialTimeout(w.network, w.addr, gelfDialTimeout)
+		if err != nil {
+			return err
+		}
+		w.conn = conn
+	}
+
+	if w.network != "udp" {
+		_, err := w.conn.Write(append(payload, 0))
+		return err
+	}
+
+	if len(payload) <= w.chunkSize {
+		_, err := w.conn.Write(payload)
+		return err
+	}
+	return w.sendChunks(payload)
+}
+
+// sendChunks splits the payload into the GELF chunks:
+// magic(2) + message id(8) + sequence number(1) + sequence count(1) + data.
+func (w *gelfWriter) sendChunks(payload []byte) error {
+	size := w.chunkSize - gelfChunkHeaderSize
+	count := (len(payload) + size - 1) / size
+	if count > gelfMaxChunks {
+		return fmt.Errorf("message too large:%d bytes, exceeds %d chunks", len(payload), gelfMaxChunks)
+	}
+
+	id := make([]byte, 8)
+	if _, err := rand.Read(id); err != nil {
+		return err
+	}
+	chunk := make([]byte, 0, w.chunkSize)
+	for i := 0; i < count; i++ {
+		end := (i + 1) * size
+		if end > len(payload) {
+			end = len(payload)
+		}
+		chunk = append(chunk[:0], gelfChunkMagic...)
+		chunk = append(chunk, id...)
+		chunk = append(chunk, byte(i), byte(count))
+		chunk = append(chunk, payload[i*size:end]...)
+		if _, err := w.conn.Write(chunk); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *gelfWriter) reset() {
+	if w.conn != nil {
+		w.conn.Close()
+		w.conn = nil
+	}
+}
+
+func (w *gelfWriter) close() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.reset()
+}
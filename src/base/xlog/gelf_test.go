@@ -0,0 +1,116 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package xlog
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGELFLogUDP(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	Assert(t, err == nil, "%v", err)
+	defer conn.Close()
+
+	log := NewGELFLog("udp://"+conn.LocalAddr().String(), Name("vectorsql"), GELFHost("node1"), GELFChunkSize(256), WithStacktrace(ERROR))
+	defer log.Close()
+	log.Named("storage").With("query_id", "q1").With("rows", 10).Warning("slow %s", "query")
+
+	msg := readGELFPacket(t, conn)
+	Assert(t, msg["version"] == "1.1", "%v", msg)
+	Assert(t, msg["host"] == "node1", "%v", msg)
+	Assert(t, msg["short_message"] == "slow query", "%v", msg)
+	Assert(t, msg["level"] == float64(4), "%v", msg)
+	Assert(t, msg["_logger"] == "vectorsql", "%v", msg)
+	Assert(t, msg["_component"] == "storage", "%v", msg)
+	Assert(t, msg["_query_id"] == "q1", "%v", msg)
+	Assert(t, msg["_rows"] == float64(10), "%v", msg)
+	Assert(t, msg["_file"] == "gelf_test.go", "%v", msg)
+	Assert(t, msg["_stacktrace"] == nil, "%v", msg)
+
+	// Filtered out by the level.
+	log.SetLevel("ERROR")
+	log.Info("dropped")
+	log.Error("first line\nsecond line")
+	msg = readGELFPacket(t, conn)
+	Assert(t, msg["short_message"] == "first line", "%v", msg)
+	Assert(t, msg["full_message"] == "first line\nsecond line", "%v", msg)
+	Assert(t, msg["level"] == float64(3), "%v", msg)
+	Assert(t, strings.HasPrefix(msg["_stacktrace"].(string), "base/xlog.TestGELFLogUDP\n"), "%v", msg)
+
+	// Chunked.
+	long := strings.Repeat("x", 1000)
+	log.Error("%s", long)
+	msg = readGELFPacket(t, conn)
+	Assert(t, msg["short_message"] == long, "%v", msg)
+}
+
+func TestGELFLogTCP(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	Assert(t, err == nil, "%v", err)
+	defer ln.Close()
+
+	log := NewGELFLog("tcp://"+ln.Addr().String(), GELFHost("node1"))
+	defer log.Close()
+	log.Debug("debug")
+	log.Println("raw line")
+
+	conn, err := ln.Accept()
+	Assert(t, err == nil, "%v", err)
+	defer conn.Close()
+	_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+
+	r := bufio.NewReader(conn)
+	for _, expect := range []struct {
+		msg   string
+		level float64
+	}{
+		{"debug", 7},
+		{"raw line", 6},
+	} {
+		frame, err := r.ReadBytes(0)
+		Assert(t, err == nil, "%v", err)
+		msg := map[string]interface{}{}
+		err = json.Unmarshal(frame[:len(frame)-1], &msg)
+		Assert(t, err == nil, "%v", err)
+		Assert(t, msg["short_message"] == expect.msg, "%v", msg)
+		Assert(t, msg["level"] == expect.level, "%v", msg)
+	}
+}
+
+func readGELFPacket(t *testing.T, conn net.PacketConn) map[string]interface{} {
+	_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+
+	var payload []byte
+	chunks := map[byte][]byte{}
+	buf := make([]byte, 65536)
+	for {
+		n, _, err := conn.ReadFrom(buf)
+		Assert(t, err == nil, "%v", err)
+		packet := buf[:n]
+		if !bytes.HasPrefix(packet, gelfChunkMagic) {
+			payload = packet
+			break
+		}
+		Assert(t, n <= 256, "chunk size:%v", n)
+		chunks[packet[10]] = append([]byte{}, packet[12:]...)
+		if count := int(packet[11]); len(chunks) == count {
+			for i := 0; i < count; i++ {
+				payload = append(payload, chunks[byte(i)]...)
+			}
+			break
+		}
+	}
+
+	msg := map[string]interface{}{}
+	err := json.Unmarshal(payload, &msg)
+	Assert(t, err == nil, "%v", err)
+	return msg
+}
@@ -3,6 +3,10 @@
 // Code is licensed under Apache License, Version 2.0.
 package xlog
 
+import (
+	"time"
+)
+
 var (
 	defaultName  = " "
 	defaultLevel = DEBUG
@@ -11,6 +15,17 @@ var (
 type Options struct {
 	Name  string
 	Level LogLevel
+
+	// StacktraceLevel attaches the stack to the entries at or above it, 0 is disabled.
+	StacktraceLevel LogLevel
+
+	// Each caller site writes up to the burst entries per interval, 0 is unlimited.
+	RateLimitBurst    int
+	RateLimitInterval time.Duration
+
+	// GELF
+	GELFHost      string
+	GELFChunkSize int
 }
 
 type Option func(*Options)
@@ -42,3 +57,33 @@ func Level(v LogLevel) Option {
 		o.Level = v
 	}
 }
+
+// WithStacktrace attaches the goroutine stack to the entries at or above the minLevel.
+func WithStacktrace(minLevel LogLevel) Option {
+	return func(o *Options) {
+		o.StacktraceLevel = minLevel
+	}
+}
+
+// WithRateLimit throttles each caller site to the burst entries per interval, so a chatty site doesn't
+// starve the others. The entries dropped are summarized as "repeated N times from file:line" every interval.
+func WithRateLimit(burst int, interval time.Duration) Option {
+	return func(o *Options) {
+		o.RateLimitBurst = burst
+		o.RateLimitInterval = interval
+	}
+}
+
+// GELFHost sets the 'host' of the GELF entries, default is the hostname.
+func GELFHost(v string) Option {
+	return func(o *Options) {
+		o.GELFHost = v
+	}
+}
+
+// GELFChunkSize sets the max UDP datagram size, the larger entries are chunked.
+func GELFChunkSize(v int) Option {
+	return func(o *Options) {
+		o.GELFChunkSize = v
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package xlog
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// siteLimiter throttles the entries per caller site: each file:line writes up to the burst entries
+// per interval, the entries beyond are dropped and counted. A chatty site doesn't starve the others,
+// they have their own budgets. The counts are written as the summaries every interval.
+type siteLimiter struct {
+	burst    int
+	interval time.Duration
+	now      func() time.Time
+
+	mu    sync.Mutex
+	sites map[caller]*siteBudget
+	done  chan struct{}
+	once  sync.Once
+	// Closed when the ticker goroutine exits, it references the log until then.
+	exited chan struct{}
+}
+
+type siteBudget struct {
+	start   time.Time
+	written int
+	dropped int
+	// The level of the last entry dropped, the summary is written at it.
+	level LogLevel
+}
+
+// newSiteLimiter returns the limiter of the rate limit option, nil if it's not set.
+func newSiteLimiter(opts *Options) *siteLimiter {
+	if opts.RateLimitBurst <= 0 || opts.RateLimitInterval <= 0 {
+		return nil
+	}
+	return &siteLimiter{
+		burst:    opts.RateLimitBurst,
+		interval: opts.RateLimitInterval,
+		now:      time.Now,
+		sites:    make(map[caller]*siteBudget),
+		done:     make(chan struct{}),
+		exited:   make(chan struct{}),
+	}
+}
+
+// allow is whether the site has the budget for the entry, the entry dropped is counted for the summary.
+func (l *siteLimiter) allow(c caller, level LogLevel) bool {
+	now := l.now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	site, ok := l.sites[c]
+	if !ok {
+		site = &siteBudget{start: now}
+		l.sites[c] = site
+	}
+	if now.Sub(site.start) >= l.interval {
+		site.start, site.written = now, 0
+	}
+	if site.written < l.burst {
+		site.written++
+		return true
+	}
+	site.dropped++
+	site.level = level
+	return false
+}
+
+// summaries takes the entries of the sites which dropped any since the last time, in the order of the sites.
+// The sites idle for an interval are forgotten.
+func (l *siteLimiter) summaries() []entry {
+	now := l.now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	var entries []entry
+	for c, site := range l.sites {
+		if site.dropped > 0 {
+			entries = append(entries, entry{
+				level:  site.level,
+				format: "repeated %d times from %s:%d",
+				args:   []interface{}{site.dropped, c.file, c.line},
+				caller: c,
+				time:   now,
+			})
+			site.dropped = 0
+		} else if now.Sub(site.start) >= l.interval {
+			delete(l.sites, c)
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		a, b := entries[i].caller, entries[j].caller
+		if a.file != b.file {
+			return a.file < b.file
+		}
+		return a.line < b.line
+	})
+	return entries
+}
+
+// start writes the summaries by the log every interval until stop.
+// The goroutine holds the log, the log is never collected before the stop.
+func (l *siteLimiter) start(t *Log) {
+	if l == nil {
+		return
+	}
+	go func() {
+		defer close(l.exited)
+		ticker := time.NewTicker(l.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				t.writeSummaries()
+			case <-l.done:
+				return
+			}
+		}
+	}()
+}
+
+// stop stops the ticker goroutine and waits for it, no summary is written by it after.
+func (l *siteLimiter) stop() {
+	l.once.Do(func() { close(l.done) })
+	<-l.exited
+}
+
+// writeSummaries writes the "repeated N times" entries of the sites throttled, past the limiter and the scope.
+func (t *Log) writeSummaries() {
+	for _, e := range t.limiter.summaries() {
+		t.write(e)
+	}
+}
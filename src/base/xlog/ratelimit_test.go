@@ -0,0 +1,107 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package xlog
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRateLimit(t *testing.T) {
+	out := &lockedBuffer{}
+	log := NewXLog(out, Level(DEBUG), WithRateLimit(2, time.Hour))
+	defer log.Close()
+	now := time.Now()
+	log.limiter.now = func() time.Time { return now }
+
+	noisy := func() {
+		log.Warning("noisy")
+	}
+	quiet := func() {
+		log.Info("quiet")
+	}
+
+	// The noisy site is throttled on its own, the quiet one keeps its budget.
+	for i := 0; i < 10; i++ {
+		noisy()
+	}
+	quiet()
+	quiet()
+	log.Named("child").Warning("child")
+	lines := strings.Split(strings.TrimSpace(string(out.Bytes())), "\n")
+	Assert(t, len(lines) == 5, "%q", lines)
+	Assert(t, strings.Count(string(out.Bytes()), "noisy") == 2, "%s", out.Bytes())
+
+	// The summary of the dropped entries, at the level of the site.
+	Assert(t, log.Flush() == nil, "")
+	lines = strings.Split(strings.TrimSpace(string(out.Bytes())), "\n")
+	Assert(t, len(lines) == 6, "%q", lines)
+	Assert(t, strings.Contains(lines[5], "[WARNING] \trepeated 8 times from ratelimit_test.go:"), "%s", lines[5])
+
+	// Nothing is dropped since.
+	Assert(t, log.Flush() == nil, "")
+	Assert(t, len(strings.Split(strings.TrimSpace(string(out.Bytes())), "\n")) == 6, "")
+
+	// The budget is back after the interval.
+	now = now.Add(time.Hour)
+	noisy()
+	Assert(t, strings.Count(string(out.Bytes()), "noisy") == 3, "%s", out.Bytes())
+
+	// The Fatal and Panic are never dropped.
+	func() {
+		defer func() { recover() }()
+		for i := 0; i < 3; i++ {
+			func() {
+				defer func() { recover() }()
+				log.Panic("panic")
+			}()
+		}
+	}()
+	Assert(t, strings.Count(string(out.Bytes()), "[PANIC]") == 3, "%s", out.Bytes())
+}
+
+func TestRateLimitTicker(t *testing.T) {
+	out := &lockedBuffer{}
+	log := NewXLog(out, Level(DEBUG), WithRateLimit(1, 10*time.Millisecond))
+	defer log.Close()
+
+	for i := 0; i < 3; i++ {
+		log.Error("noisy")
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for !strings.Contains(string(out.Bytes()), "repeated 2 times from ratelimit_test.go:") {
+		Assert(t, time.Now().Before(deadline), "%s", out.Bytes())
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestRateLimitClose(t *testing.T) {
+	dir, err := ioutil.TempDir("", "xlog")
+	Assert(t, err == nil, "%v", err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "vectorsql.log")
+	log, err := NewFileLog(path, WithRateLimit(1, time.Hour))
+	Assert(t, err == nil, "%v", err)
+	for i := 0; i < 3; i++ {
+		log.Error("noisy")
+	}
+
+	// The ticker goroutine is gone with the Close, it writes nothing to the closed file.
+	Assert(t, log.Close() == nil, "")
+	select {
+	case <-log.limiter.exited:
+	default:
+		t.Fatal("the ticker goroutine is running after the Close")
+	}
+	data, err := ioutil.ReadFile(path)
+	Assert(t, err == nil, "%v", err)
+	Assert(t, strings.Contains(string(data), "repeated 2 times from ratelimit_test.go:"), "%s", data)
+	Assert(t, log.Close() == nil, "")
+}
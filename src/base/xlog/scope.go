@@ -0,0 +1,138 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package xlog
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+)
+
+// scope keeps the entries of a BufferedScope and its children in the order they are logged.
+// The scope of a scoped logger hands its entries to the parent scope on Flush, in the order they were logged.
+type scope struct {
+	mu      sync.Mutex
+	parent  *scope
+	entries []scopedEntry
+}
+
+// scopedEntry is the entry with the logger which logged it, for its name and fields.
+type scopedEntry struct {
+	log   *Log
+	entry entry
+}
+
+func (s *scope) add(t *Log, e entry) {
+	// The message is formatted now, the arguments may change until the flush.
+	e.format, e.args = "%s", []interface{}{e.message()}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries = append(s.entries, scopedEntry{log: t, entry: e})
+}
+
+func (s *scope) take() []scopedEntry {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	entries := s.entries
+	s.entries = nil
+	return entries
+}
+
+// merge adds the entries of a child scope among its own by the time they were logged.
+func (s *scope) merge(entries []scopedEntry) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	merged := make([]scopedEntry, 0, len(s.entries)+len(entries))
+	i, j := 0, 0
+	for i < len(s.entries) && j < len(entries) {
+		if entries[j].entry.time.Before(s.entries[i].entry.time) {
+			merged = append(merged, entries[j])
+			j++
+		} else {
+			merged = append(merged, s.entries[i])
+			i++
+		}
+	}
+	merged = append(merged, s.entries[i:]...)
+	s.entries = append(merged, entries[j:]...)
+}
+
+// BufferedScope returns a child logger which keeps its entries, and the ones of its children,
+// until Flush writes them out together or Discard drops them, such as the logs of a request
+// only written if it fails. The level is checked when logging as usual,
+// the entries keep their level and time. The Fatal and Panic entries flush the scope at once.
+func (t *Log) BufferedScope() *Log {
+	child := t.clone()
+	child.scope = &scope{parent: t.scope}
+	return child
+}
+
+// Discard drops the entries kept by the BufferedScope, the logger goes on keeping the next ones.
+func (t *Log) Discard() {
+	if t.scope != nil {
+		t.scope.take()
+	}
+}
+
+// flushScope writes the entries of the scope, the ones for the writer in one block
+// so they are not interleaved with the other loggers.
+func (t *Log) flushScope() {
+	entries := t.scope.take()
+	if len(entries) == 0 {
+		return
+	}
+	if parent := t.scope.parent; parent != nil {
+		parent.merge(entries)
+		return
+	}
+
+	buf := getBuffer()
+	defer putBuffer(buf)
+	for _, s := range entries {
+		if s.log.gelf != nil || s.log.sink != nil {
+			// They take the entries one at a time, with the level.
+			s.log.write(s.entry)
+			continue
+		}
+		flags := s.log.Flags()
+		if flags&log.Lmsgprefix == 0 {
+			buf.WriteString(s.log.Prefix())
+		}
+		buf.Write(appendHeader(nil, flags, s.entry.time))
+		if flags&log.Lmsgprefix != 0 {
+			buf.WriteString(s.log.Prefix())
+		}
+		buf.WriteString("   ")
+		buf.WriteString(s.log.line(s.entry))
+		buf.WriteByte('\n')
+	}
+	if buf.Len() > 0 {
+		if _, err := t.Writer().Write(buf.Bytes()); err != nil {
+			fmt.Fprintf(os.Stderr, "xlog.scope write error:%v\n", err)
+		}
+	}
+}
+
+// appendHeader is the time the log.Logger writes by its flags, for the time of the entry rather than now.
+func appendHeader(buf []byte, flags int, t time.Time) []byte {
+	if flags&log.LUTC != 0 {
+		t = t.UTC()
+	}
+	if flags&log.Ldate != 0 {
+		buf = t.AppendFormat(buf, "2006/01/02 ")
+	}
+	if flags&(log.Ltime|log.Lmicroseconds) != 0 {
+		buf = t.AppendFormat(buf, "15:04:05")
+		if flags&log.Lmicroseconds != 0 {
+			buf = t.AppendFormat(buf, ".000000")
+		}
+		buf = append(buf, ' ')
+	}
+	return buf
+}
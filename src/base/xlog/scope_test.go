@@ -0,0 +1,81 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package xlog
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBufferedScope(t *testing.T) {
+	var buf bytes.Buffer
+	log := NewXLog(&buf, Level(INFO))
+
+	scoped := log.BufferedScope()
+	scoped.Debug("dropped by the level")
+	scoped.Info("first %d", 1)
+	scoped.With("query", 7).Warning("second")
+	logged := time.Now()
+	log.Info("unscoped")
+	Assert(t, strings.Count(buf.String(), "\n") == 1, "%v", buf.String())
+
+	time.Sleep(10 * time.Millisecond)
+	buf.Reset()
+	Assert(t, scoped.Flush() == nil, "")
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	Assert(t, len(lines) == 2, "%v", lines)
+	Assert(t, strings.Contains(lines[0], "\t [INFO] \tfirst 1 <TestBufferedScope@scope_test.go:"), "%v", lines[0])
+	Assert(t, strings.Contains(lines[1], "\t [WARNING] \tsecond query=7 <TestBufferedScope@scope_test.go:"), "%v", lines[1])
+
+	// The lines keep the time they were logged at.
+	for _, line := range lines {
+		at, err := time.ParseInLocation("2006/01/02 15:04:05.000000", strings.TrimSpace(line)[:26], time.Local)
+		Assert(t, err == nil, "%v", err)
+		Assert(t, !at.After(logged), "%v after %v", at, logged)
+	}
+
+	// The scope is empty after the flush, and the discarded entries are never written.
+	buf.Reset()
+	scoped.Error("discarded")
+	scoped.Discard()
+	Assert(t, scoped.Flush() == nil, "")
+	Assert(t, buf.Len() == 0, "%v", buf.String())
+}
+
+func TestBufferedScopeNested(t *testing.T) {
+	var buf bytes.Buffer
+	log := NewXLog(&buf, Level(INFO))
+
+	outer := log.BufferedScope()
+	inner := outer.Named("request").BufferedScope()
+	inner.Info("inner")
+	outer.Info("outer")
+
+	// The inner flush hands the entries to the outer scope.
+	inner.Flush()
+	Assert(t, buf.Len() == 0, "%v", buf.String())
+	outer.Flush()
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	Assert(t, len(lines) == 2, "%v", lines)
+	Assert(t, strings.Contains(lines[0], "[request] inner"), "%v", lines[0])
+	Assert(t, strings.Contains(lines[1], "outer"), "%v", lines[1])
+}
+
+func TestBufferedScopeSink(t *testing.T) {
+	sink := &recordSink{}
+	log := NewSinkLog(sink, Level(INFO))
+
+	scoped := log.BufferedScope()
+	scoped.Warning("warning")
+	scoped.Error("error")
+	Assert(t, len(sink.lines) == 0, "%v", sink.lines)
+
+	scoped.Flush()
+	Assert(t, len(sink.lines) == 2, "%v", sink.lines)
+	Assert(t, sink.levels[0] == WARNING && sink.levels[1] == ERROR, "%v", sink.levels)
+	Assert(t, strings.Contains(sink.lines[1], "\t [ERROR] \terror <"), "%v", sink.lines[1])
+}
@@ -0,0 +1,44 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package xlog
+
+import (
+	"os"
+	"os/signal"
+)
+
+// InstallSignalLevelToggle advances the level of the logger through the cycle on each signal,
+// such as 'kill -USR1' to switch between INFO and DEBUG during an incident.
+// A level out of the cycle goes to the first one, the change is always logged.
+func InstallSignalLevelToggle(l *Log, sig os.Signal, cycle []LogLevel) {
+	if len(cycle) == 0 {
+		return
+	}
+
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, sig)
+	go func() {
+		for s := range ch {
+			from := l.Level()
+			to := nextLevel(from, cycle)
+			l.setLevel(to)
+			l.levelChanged(s, from, to)
+		}
+	}()
+}
+
+func nextLevel(level LogLevel, cycle []LogLevel) LogLevel {
+	for i, v := range cycle {
+		if v == level {
+			return cycle[(i+1)%len(cycle)]
+		}
+	}
+	return cycle[0]
+}
+
+// levelChanged logs the change regardless of the new level.
+func (t *Log) levelChanged(sig os.Signal, from LogLevel, to LogLevel) {
+	t.output(WARNING, getCaller(), "Log level changed from %s to %s by signal %v", []interface{}{LevelNames[from], LevelNames[to], sig})
+}
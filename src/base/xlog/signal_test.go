@@ -0,0 +1,90 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package xlog
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+)
+
+// syncBuffer is the log output read while the toggle goroutine writes.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func TestNextLevel(t *testing.T) {
+	cycle := []LogLevel{INFO, DEBUG}
+	tests := []struct {
+		level  LogLevel
+		expect LogLevel
+	}{
+		{level: INFO, expect: DEBUG},
+		{level: DEBUG, expect: INFO},
+		{level: ERROR, expect: INFO},
+	}
+	for _, test := range tests {
+		got := nextLevel(test.level, cycle)
+		Assert(t, got == test.expect, "want[%v]!=got[%v]", test.expect, got)
+	}
+}
+
+func TestSignalLevelToggle(t *testing.T) {
+	buf := &syncBuffer{}
+	log := NewXLog(buf, Level(INFO))
+	defer func() { defaultlog = nil }()
+	InstallSignalLevelToggle(log, syscall.SIGUSR1, []LogLevel{INFO, DEBUG})
+
+	// Logging concurrently with the toggle.
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-done:
+				return
+			default:
+				log.Debug("debug")
+			}
+		}
+	}()
+
+	tests := []struct {
+		level  LogLevel
+		expect string
+	}{
+		{level: DEBUG, expect: "Log level changed from INFO to DEBUG by signal user defined signal 1"},
+		{level: INFO, expect: "Log level changed from DEBUG to INFO by signal user defined signal 1"},
+	}
+	for _, test := range tests {
+		Assert(t, syscall.Kill(syscall.Getpid(), syscall.SIGUSR1) == nil, "kill")
+		deadline := time.Now().Add(5 * time.Second)
+		for !strings.Contains(buf.String(), test.expect) && time.Now().Before(deadline) {
+			time.Sleep(time.Millisecond)
+		}
+		Assert(t, strings.Contains(buf.String(), test.expect), "log:%v", buf.String())
+		Assert(t, log.Level() == test.level, "want[%v]!=got[%v]", test.level, log.Level())
+	}
+	close(done)
+	wg.Wait()
+}
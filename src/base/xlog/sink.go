@@ -0,0 +1,103 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package xlog
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+)
+
+const (
+	sinkTimeLayout = "2006/01/02 15:04:05.000000 "
+
+	// Buffers grown above it are dropped instead of pooled.
+	maxPooledBufferSize = 64 << 10
+)
+
+// Sink receives the formatted entries.
+// The bytes come from a pooled buffer which is reused after Write returns,
+// the sink must not retain them.
+type Sink interface {
+	Write(level LogLevel, formatted []byte) error
+}
+
+var bufferPool = sync.Pool{
+	New: func() interface{} {
+		return new(bytes.Buffer)
+	},
+}
+
+func getBuffer() *bytes.Buffer {
+	buf := bufferPool.Get().(*bytes.Buffer)
+	buf.Reset()
+	return buf
+}
+
+func putBuffer(buf *bytes.Buffer) {
+	if buf.Cap() > maxPooledBufferSize {
+		return
+	}
+	bufferPool.Put(buf)
+}
+
+// NewSinkLog creates a logger which formats the entries into the pooled buffers and hands them to the sink,
+// the same layout as NewXLog without the intermediate strings.
+func NewSinkLog(sink Sink, opts ...Option) *Log {
+	options := newOptions(opts...)
+
+	l := &Log{
+		opts:    options,
+		comps:   newComponents(options.Level),
+		sink:    sink,
+		limiter: newSiteLimiter(options),
+	}
+	l.Logger = log.New(&sinkLineWriter{sink: sink}, l.opts.Name, D_LOG_FLAGS)
+	l.limiter.start(l)
+	return l
+}
+
+// sinkLineWriter passes the lines written by the embedded log.Logger as INFO entries.
+type sinkLineWriter struct {
+	sink Sink
+}
+
+func (w *sinkLineWriter) Write(p []byte) (int, error) {
+	if err := w.sink.Write(INFO, p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (t *Log) writeSink(e entry) {
+	var scratch [64]byte
+
+	buf := getBuffer()
+	defer putBuffer(buf)
+
+	buf.WriteString(t.opts.Name)
+	buf.Write(e.time.AppendFormat(scratch[:0], sinkTimeLayout))
+	buf.WriteString("   \t [")
+	buf.WriteString(e.label())
+	buf.WriteString("] \t")
+	if t.name != "" {
+		buf.WriteByte('[')
+		buf.WriteString(t.name)
+		buf.WriteString("] ")
+	}
+	fmt.Fprintf(buf, e.format, e.args...)
+	for _, f := range t.fields {
+		fmt.Fprintf(buf, " %s=%v", f.Key, f.Value)
+	}
+	fmt.Fprintf(buf, " <%s@%s:%d>", e.caller.fn, e.caller.file, e.caller.line)
+	buf.WriteString(indent(e.stack))
+	buf.WriteByte('\n')
+
+	if err := t.sink.Write(e.level, buf.Bytes()); err != nil {
+		fmt.Fprintf(os.Stderr, "xlog.sink write error:%v\n", err)
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package xlog
+
+import (
+	"strings"
+	"testing"
+)
+
+type recordSink struct {
+	levels []LogLevel
+	lines  []string
+}
+
+func (s *recordSink) Write(level LogLevel, formatted []byte) error {
+	s.levels = append(s.levels, level)
+	s.lines = append(s.lines, string(formatted))
+	return nil
+}
+
+type discardSink struct{}
+
+func (s discardSink) Write(level LogLevel, formatted []byte) error {
+	return nil
+}
+
+func TestSinkLog(t *testing.T) {
+	sink := &recordSink{}
+	log := NewSinkLog(sink, Level(INFO))
+
+	log.Debug("dropped")
+	log.Named("storage").With("rows", 3).Warning("slow %s", "query")
+	log.Println("raw line")
+
+	Assert(t, len(sink.lines) == 2, "%v", sink.lines)
+	{
+		want := WARNING
+		got := sink.levels[0]
+		Assert(t, want == got, "want[%v]!=got[%v]", want, got)
+	}
+	{
+		line := sink.lines[0]
+		Assert(t, strings.Contains(line, "\t [WARNING] \t[storage] slow query rows=3 <TestSinkLog@sink_test.go:"), "%v", line)
+		Assert(t, strings.HasSuffix(line, ">\n"), "%v", line)
+	}
+	{
+		want := INFO
+		got := sink.levels[1]
+		Assert(t, want == got, "want[%v]!=got[%v]", want, got)
+		Assert(t, strings.HasSuffix(sink.lines[1], "raw line\n"), "%v", sink.lines[1])
+	}
+}
+
+func BenchmarkSinkLog(b *testing.B) {
+	log := NewSinkLog(discardSink{}, Level(INFO))
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		log.Info("query:%v, rows:%v", "select 1", i)
+	}
+}
@@ -12,6 +12,9 @@ import (
 	"path/filepath"
 	"runtime"
 	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 )
 
 var (
@@ -42,8 +45,44 @@ const (
 	D_LOG_FLAGS int = log.LstdFlags | log.Lmicroseconds
 )
 
+// components holds the root level and the levels of the named loggers, shared by the root and all its children.
+// The root level is atomic, it may be changed while logging such as by the signal toggle.
+type components struct {
+	root   int32
+	mu     sync.RWMutex
+	levels map[string]LogLevel
+}
+
+func newComponents(root LogLevel) *components {
+	return &components{
+		root:   int32(root),
+		levels: make(map[string]LogLevel),
+	}
+}
+
+func (c *components) rootLevel() LogLevel {
+	return LogLevel(atomic.LoadInt32(&c.root))
+}
+
+// Field is a structured key/value attached to every entry of a logger.
+type Field struct {
+	Key   string
+	Value interface{}
+}
+
 type Log struct {
-	opts *Options
+	opts   *Options
+	name   string
+	comps  *components
+	fields []Field
+	gelf   *gelfWriter
+	sink   Sink
+	// The Fatal and Panic fail it, such as the test of the xlogtest.NewTestLog.
+	failer Failer
+	// The entries kept by the BufferedScope until its Flush.
+	scope *scope
+	// The budgets of the caller sites by WithRateLimit, shared with the children.
+	limiter *siteLimiter
 	*log.Logger
 }
 
@@ -55,19 +94,53 @@ func NewXLog(w io.Writer, opts ...Option) *Log {
 	options := newOptions(opts...)
 
 	l := &Log{
-		opts: options,
+		opts:    options,
+		comps:   newComponents(options.Level),
+		limiter: newSiteLimiter(options),
 	}
 	l.Logger = log.New(w, l.opts.Name, D_LOG_FLAGS)
+	l.limiter.start(l)
 	defaultlog = l
 	return l
 }
 
 func NewLog(w io.Writer, prefix string, flag int) *Log {
-	l := &Log{}
+	options := newOptions()
+	l := &Log{
+		opts:  options,
+		comps: newComponents(options.Level),
+	}
 	l.Logger = log.New(w, prefix, flag)
 	return l
 }
 
+// Failer is failed by the Fatal and Panic entries of the NewFailerLog, such as the testing.TB.
+type Failer interface {
+	// Fail is called before the Panic panics.
+	Fail()
+	// FailNow is called by the Fatal instead of exiting the process, it must not return.
+	FailNow()
+}
+
+// NewFailerLog creates the logger whose Fatal and Panic fail the failer, it's not the default logger.
+func NewFailerLog(w io.Writer, failer Failer, opts ...Option) *Log {
+	options := newOptions(opts...)
+	l := &Log{
+		opts:    options,
+		comps:   newComponents(options.Level),
+		failer:  failer,
+		limiter: newSiteLimiter(options),
+	}
+	l.Logger = log.New(w, l.opts.Name, log.Lmicroseconds)
+	l.limiter.start(l)
+	return l
+}
+
+// SetDefault makes the log the default logger of GetLog, NewXLog sets it too.
+func SetDefault(log *Log) {
+	defaultlog = log
+}
+
 func GetLog() *Log {
 	if defaultlog == nil {
 		log := NewStdLog(Level(INFO))
@@ -76,69 +149,267 @@ func GetLog() *Log {
 	return defaultlog
 }
 
+// Named returns a child logger for the component, the name is dot-joined with the parent's,
+// such as 'storage.memory'. The child shares the output with its parent.
+func (t *Log) Named(name string) *Log {
+	if t.name != "" {
+		name = t.name + "." + name
+	}
+	child := t.clone()
+	child.name = name
+	return child
+}
+
+// With returns a child logger which attaches the field to all its entries.
+func (t *Log) With(key string, value interface{}) *Log {
+	fields := make([]Field, len(t.fields), len(t.fields)+1)
+	copy(fields, t.fields)
+	child := t.clone()
+	child.fields = append(fields, Field{Key: key, Value: value})
+	return child
+}
+
+// clone returns a child sharing the options, levels and outputs.
+func (t *Log) clone() *Log {
+	child := *t
+	return &child
+}
+
+// Name returns the component name, empty for the root logger.
+func (t *Log) Name() string {
+	return t.name
+}
+
+// SetLevel sets the root level, or the component level if the logger is named.
 func (t *Log) SetLevel(level string) {
 	for i, v := range LevelNames {
 		if strings.EqualFold(level, v) {
-			t.opts.Level = LogLevel(i)
+			t.setLevel(LogLevel(i))
 			return
 		}
 	}
 }
 
+func (t *Log) setLevel(level LogLevel) {
+	if t.name != "" {
+		t.SetComponentLevel(t.name, level)
+	} else {
+		atomic.StoreInt32(&t.comps.root, int32(level))
+	}
+}
+
+// SetComponentLevel sets the level of the named child and its descendants
+// which have no level of their own.
+func (t *Log) SetComponentLevel(name string, level LogLevel) {
+	t.comps.mu.Lock()
+	defer t.comps.mu.Unlock()
+	t.comps.levels[name] = level
+}
+
+// Level returns the effective level, the nearest level set on the component
+// hierarchy ('storage.memory', then 'storage'), or the root level.
+func (t *Log) Level() LogLevel {
+	if t.name == "" {
+		return t.comps.rootLevel()
+	}
+
+	t.comps.mu.RLock()
+	defer t.comps.mu.RUnlock()
+	for name := t.name; len(t.comps.levels) > 0; {
+		if level, ok := t.comps.levels[name]; ok {
+			return level
+		}
+		i := strings.LastIndexByte(name, '.')
+		if i < 0 {
+			break
+		}
+		name = name[:i]
+	}
+	return t.comps.rootLevel()
+}
+
 func (t *Log) Debug(format string, v ...interface{}) {
-	if DEBUG < t.opts.Level {
+	if DEBUG < t.Level() {
 		return
 	}
-	t.log("\t [DEBUG] \t%s %s", fmt.Sprintf(format, v...), getFnName())
+	t.output(DEBUG, getCaller(), format, v)
 }
 
 func (t *Log) Info(format string, v ...interface{}) {
-	if INFO < t.opts.Level {
+	if INFO < t.Level() {
 		return
 	}
-	t.log("\t [INFO] \t%s %s", fmt.Sprintf(format, v...), getFnName())
+	t.output(INFO, getCaller(), format, v)
 }
 
 func (t *Log) Warning(format string, v ...interface{}) {
-	if WARNING < t.opts.Level {
+	if WARNING < t.Level() {
 		return
 	}
-	t.log("\t [WARNING] \t%s %s", fmt.Sprintf(format, v...), getFnName())
+	t.output(WARNING, getCaller(), format, v)
 }
 
 func (t *Log) Error(format string, v ...interface{}) {
-	if ERROR < t.opts.Level {
+	if ERROR < t.Level() {
 		return
 	}
-	t.log("\t [ERROR] \t%s %s", fmt.Sprintf(format, v...), getFnName())
+	t.output(ERROR, getCaller(), format, v)
 }
 
 func (t *Log) Fatal(format string, v ...interface{}) {
-	if FATAL < t.opts.Level {
+	if FATAL < t.Level() {
 		return
 	}
-	t.log("\t [FATAL+EXIT] \t%s %s", fmt.Sprintf(format, v...), getFnName())
+	t.output(FATAL, getCaller(), format, v)
+	if t.failer != nil {
+		t.failer.FailNow()
+	}
 	os.Exit(1)
 }
 
 func (t *Log) Panic(format string, v ...interface{}) {
-	if PANIC < t.opts.Level {
+	if PANIC < t.Level() {
 		return
 	}
-	msg := fmt.Sprintf("\t [PANIC] \t%s %s", fmt.Sprintf(format, v...), getFnName())
-	t.log(msg)
-	panic(msg)
+	msg := fmt.Sprintf(format, v...)
+	t.output(PANIC, getCaller(), "%s", []interface{}{msg})
+	if t.failer != nil {
+		t.failer.Fail()
+	}
+	panic(fmt.Sprintf("\t [PANIC] \t%s%s%s", t.component(), msg, t.fieldsText()))
 }
 
-func (t *Log) Close() {
-	// nothing
+func (t *Log) output(level LogLevel, c caller, format string, args []interface{}) {
+	// The Fatal and Panic are never dropped.
+	if t.limiter != nil && level < FATAL && !t.limiter.allow(c, level) {
+		return
+	}
+	e := entry{level: level, format: format, args: args, caller: c, time: time.Now()}
+	if t.opts.StacktraceLevel != 0 && level >= t.opts.StacktraceLevel {
+		// Skip runtime.Callers, stacktrace, output and the level method.
+		e.stack = stacktrace(4)
+	}
+
+	if t.scope != nil {
+		// The Fatal and Panic don't wait, the process or the goroutine ends after them.
+		if level < FATAL {
+			t.scope.add(t, e)
+			return
+		}
+		t.flushScope()
+	}
+	t.write(e)
+}
+
+func (t *Log) write(e entry) {
+	switch {
+	case t.gelf != nil:
+		t.gelf.write(t, e)
+	case t.sink != nil:
+		t.writeSink(e)
+	default:
+		t.log("%s", t.line(e))
+	}
+}
+
+// line is the entry as written after the prefix and the time of the log.Logger.
+func (t *Log) line(e entry) string {
+	return fmt.Sprintf("\t [%s] \t%s%s%s %s%s", e.label(), t.component(), e.message(), t.fieldsText(), e.caller, indent(e.stack))
+}
+
+func (t *Log) fieldsText() string {
+	var sb strings.Builder
+	for _, f := range t.fields {
+		fmt.Fprintf(&sb, " %s=%v", f.Key, f.Value)
+	}
+	return sb.String()
+}
+
+func (t *Log) component() string {
+	if t.name == "" {
+		return ""
+	}
+	return "[" + t.name + "] "
+}
+
+// Flush writes out the entries buffered by the backend, and the ones kept by the BufferedScope
+// and the summaries of the sites throttled first.
+func (t *Log) Flush() error {
+	if t.scope != nil {
+		t.flushScope()
+	}
+	if t.limiter != nil {
+		t.writeSummaries()
+	}
+	if f, ok := t.sink.(flusher); ok {
+		return f.Flush()
+	}
+	if f, ok := t.Writer().(flusher); ok {
+		return f.Flush()
+	}
+	return nil
+}
+
+// Close flushes and releases the backend, such as the file or the GELF connection.
+// The children share the backend with their parent, so it closes them too.
+// The writer passed to NewXLog is not owned by the logger and only flushed.
+func (t *Log) Close() error {
+	// The summaries are written by the Flush once the ticker is stopped, not after the backend is closed.
+	if t.limiter != nil {
+		t.limiter.stop()
+	}
+	err := t.Flush()
+	if t.gelf != nil {
+		t.gelf.close()
+	}
+	if c, ok := t.sink.(io.Closer); ok {
+		if cerr := c.Close(); err == nil {
+			err = cerr
+		}
+	}
+	return err
 }
 
 func (t *Log) log(format string, v ...interface{}) {
-	_ = t.Output(3, strings.Repeat(" ", 3)+fmt.Sprintf(format, v...)+"\n")
+	_ = t.Output(4, strings.Repeat(" ", 3)+fmt.Sprintf(format, v...)+"\n")
+}
+
+type flusher interface {
+	Flush() error
+}
+
+// entry is a single log record, the message is formatted lazily by the output.
+type entry struct {
+	level  LogLevel
+	format string
+	args   []interface{}
+	caller caller
+	stack  string
+	time   time.Time
+}
+
+func (e *entry) message() string {
+	return fmt.Sprintf(e.format, e.args...)
+}
+
+func (e *entry) label() string {
+	if e.level == FATAL {
+		return "FATAL+EXIT"
+	}
+	return LevelNames[e.level]
+}
+
+type caller struct {
+	fn   string
+	file string
+	line int
+}
+
+func (c caller) String() string {
+	return fmt.Sprintf("<%s@%s:%d>", c.fn, c.file, c.line)
 }
 
-func getFnName() string {
+func getCaller() caller {
 	var fnName string
 
 	pc, fn, line, _ := runtime.Caller(2)
@@ -149,5 +420,35 @@ func getFnName() string {
 		names := strings.Split(f.Name(), ".")
 		fnName = names[len(names)-1]
 	}
-	return fmt.Sprintf("<%s@%s:%d>", fnName, filepath.Base(fn), line)
+	return caller{fn: fnName, file: filepath.Base(fn), line: line}
+}
+
+// stacktrace returns the goroutine stack from the skip frame, the runtime frames are trimmed.
+func stacktrace(skip int) string {
+	pcs := make([]uintptr, 64)
+	n := runtime.Callers(skip, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+
+	var sb strings.Builder
+	for {
+		frame, more := frames.Next()
+		if !strings.HasPrefix(frame.Function, "runtime.") {
+			if sb.Len() > 0 {
+				sb.WriteByte('\n')
+			}
+			fmt.Fprintf(&sb, "%s\n\t%s:%d", frame.Function, frame.File, frame.Line)
+		}
+		if !more {
+			break
+		}
+	}
+	return sb.String()
+}
+
+// indent puts the stack on its own lines, indented under the entry.
+func indent(stack string) string {
+	if stack == "" {
+		return ""
+	}
+	return "\n\t" + strings.Replace(stack, "\n", "\n\t", -1)
 }
@@ -5,6 +5,8 @@
 package xlog
 
 import (
+	"bytes"
+	"strings"
 	"testing"
 )
 
@@ -65,28 +67,112 @@ func TestLogLevel(t *testing.T) {
 	{
 		log.SetLevel("DEBUG")
 		want := DEBUG
-		got := log.opts.Level
+		got := log.Level()
 		Assert(t, want == got, "want[%v]!=got[%v]", want, got)
 	}
 
 	{
 		log.SetLevel("DEBUGX")
 		want := DEBUG
-		got := log.opts.Level
+		got := log.Level()
 		Assert(t, want == got, "want[%v]!=got[%v]", want, got)
 	}
 
 	{
 		log.SetLevel("PANIC")
 		want := PANIC
-		got := log.opts.Level
+		got := log.Level()
 		Assert(t, want == got, "want[%v]!=got[%v]", want, got)
 	}
 
 	{
 		log.SetLevel("WARNING")
 		want := WARNING
-		got := log.opts.Level
+		got := log.Level()
 		Assert(t, want == got, "want[%v]!=got[%v]", want, got)
 	}
 }
+
+func TestNamedLogLevel(t *testing.T) {
+	buf := &bytes.Buffer{}
+	root := NewXLog(buf, Level(INFO))
+	storage := root.Named("storage")
+	memory := storage.Named("memory")
+	planner := root.Named("planner")
+
+	{
+		want := "storage.memory"
+		got := memory.Name()
+		Assert(t, want == got, "want[%v]!=got[%v]", want, got)
+	}
+
+	// Default to the parent's.
+	{
+		want := INFO
+		got := memory.Level()
+		Assert(t, want == got, "want[%v]!=got[%v]", want, got)
+	}
+
+	// Walk the prefix hierarchy.
+	root.SetComponentLevel("storage", DEBUG)
+	{
+		want := DEBUG
+		got := memory.Level()
+		Assert(t, want == got, "want[%v]!=got[%v]", want, got)
+	}
+	{
+		want := INFO
+		got := planner.Level()
+		Assert(t, want == got, "want[%v]!=got[%v]", want, got)
+	}
+
+	// Child level doesn't change the root.
+	memory.SetLevel("ERROR")
+	{
+		want := ERROR
+		got := memory.Level()
+		Assert(t, want == got, "want[%v]!=got[%v]", want, got)
+	}
+	{
+		want := DEBUG
+		got := storage.Level()
+		Assert(t, want == got, "want[%v]!=got[%v]", want, got)
+	}
+	{
+		want := INFO
+		got := root.Level()
+		Assert(t, want == got, "want[%v]!=got[%v]", want, got)
+	}
+
+	storage.Debug("storage-debug")
+	planner.Debug("planner-debug")
+	memory.Warning("memory-warning")
+	out := buf.String()
+	Assert(t, strings.Contains(out, "[storage] storage-debug"), "out:%v", out)
+	Assert(t, !strings.Contains(out, "planner-debug"), "out:%v", out)
+	Assert(t, !strings.Contains(out, "memory-warning"), "out:%v", out)
+}
+
+func TestStacktrace(t *testing.T) {
+	buf := &bytes.Buffer{}
+	log := NewXLog(buf, Level(DEBUG), WithStacktrace(ERROR))
+
+	log.Warning("no-stack")
+	{
+		out := buf.String()
+		Assert(t, !strings.Contains(out, "xlog.TestStacktrace"), "out:%v", out)
+	}
+
+	buf.Reset()
+	log.Error("with-stack")
+	{
+		out := buf.String()
+		lines := strings.Split(out, "\n")
+		Assert(t, strings.Contains(lines[0], "with-stack"), "out:%v", out)
+		// The first frame is the caller, not the logger's own.
+		Assert(t, strings.HasPrefix(lines[1], "\tbase/xlog.TestStacktrace"), "out:%v", out)
+		Assert(t, strings.HasPrefix(lines[2], "\t\t"), "out:%v", out)
+		Assert(t, !strings.Contains(out, "xlog.(*Log)"), "out:%v", out)
+		Assert(t, !strings.Contains(out, "runtime.goexit"), "out:%v", out)
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+// Package xlogtest is the xlog of the tests, the entries go to the testing.TB.
+package xlogtest
+
+import (
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+
+	"base/xlog"
+)
+
+// testWriter writes the entries to the test log, they are only shown on failure or with -v.
+// The entries after the test are dropped, testing panics on the Log of a completed test.
+type testWriter struct {
+	mu   sync.Mutex
+	tb   testing.TB
+	done bool
+}
+
+func (w *testWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if !w.done {
+		w.tb.Helper()
+		w.tb.Log(strings.TrimSuffix(string(p), "\n"))
+	}
+	return len(p), nil
+}
+
+func (w *testWriter) Fail() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if !w.done {
+		w.tb.Fail()
+	}
+}
+
+// FailNow fails the test and stops the goroutine, it never returns.
+func (w *testWriter) FailNow() {
+	w.mu.Lock()
+	done := w.done
+	w.mu.Unlock()
+
+	if !done {
+		w.tb.FailNow()
+	}
+	runtime.Goexit()
+}
+
+func (w *testWriter) cleanup() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.done = true
+}
+
+// NewTestLog creates the logger writing to the tb.Log, a Fatal or Panic entry fails the test.
+// The Fatal stops the goroutine by FailNow instead of exiting the process,
+// like tb.FailNow it only stops the test if called on the test goroutine.
+func NewTestLog(tb testing.TB, opts ...xlog.Option) *xlog.Log {
+	w := &testWriter{tb: tb}
+	tb.Cleanup(w.cleanup)
+	return xlog.NewFailerLog(w, w, opts...)
+}
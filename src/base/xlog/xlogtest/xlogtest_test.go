@@ -0,0 +1,107 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package xlogtest
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	"base/xlog"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeTB records the logs and the failures instead of failing the running test.
+type fakeTB struct {
+	testing.TB
+	logs     []string
+	failed   bool
+	cleanups []func()
+}
+
+func (tb *fakeTB) Helper()                     {}
+func (tb *fakeTB) Log(args ...interface{})     { tb.logs = append(tb.logs, args[0].(string)) }
+func (tb *fakeTB) Fail()                       { tb.failed = true }
+func (tb *fakeTB) Cleanup(f func())            { tb.cleanups = append(tb.cleanups, f) }
+func (tb *fakeTB) FailNow()                    { tb.Fail() }
+func (tb *fakeTB) Logf(string, ...interface{}) {}
+
+func TestTestLog(t *testing.T) {
+	tests := []struct {
+		name   string
+		fn     func(log *xlog.Log)
+		expect string
+		failed bool
+	}{
+		{
+			name:   "info",
+			fn:     func(log *xlog.Log) { log.Info("hello:%v", 1) },
+			expect: "[INFO] \thello:1",
+		},
+		{
+			name:   "debug-filtered",
+			fn:     func(log *xlog.Log) { log.Debug("hello") },
+			expect: "",
+		},
+		{
+			name: "panic",
+			fn: func(log *xlog.Log) {
+				defer func() { recover() }()
+				log.Panic("boom")
+			},
+			expect: "[PANIC] \tboom",
+			failed: true,
+		},
+		{
+			name: "fatal",
+			fn: func(log *xlog.Log) {
+				// The Fatal stops the goroutine, it never returns.
+				var wg sync.WaitGroup
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					log.Fatal("bye")
+					log.Info("unreachable")
+				}()
+				wg.Wait()
+			},
+			expect: "[FATAL+EXIT] \tbye",
+			failed: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tb := &fakeTB{TB: t}
+			test.fn(NewTestLog(tb, xlog.Level(xlog.INFO)))
+			logs := strings.Join(tb.logs, "\n")
+			if test.expect == "" {
+				assert.Equal(t, "", logs)
+			} else {
+				assert.Contains(t, logs, test.expect)
+				assert.NotContains(t, logs, "unreachable")
+			}
+			assert.Equal(t, test.failed, tb.failed)
+		})
+	}
+}
+
+func TestTestLogAfterCleanup(t *testing.T) {
+	tb := &fakeTB{TB: t}
+	log := NewTestLog(tb)
+	for _, f := range tb.cleanups {
+		f()
+	}
+
+	// A goroutine still logging after the test is silently dropped.
+	log.Info("late")
+	func() {
+		defer func() { recover() }()
+		log.Panic("late")
+	}()
+	assert.Empty(t, tb.logs)
+	assert.False(t, tb.failed)
+}
@@ -0,0 +1,145 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package zstd
+
+// The baselines and the extra bits of the literal length and the match length codes, RFC 8878 3.1.1.3.2.1.1.
+var (
+	literalLengthBase = []uint32{
+		0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15,
+		16, 18, 20, 22, 24, 28, 32, 40, 48, 64, 128, 256, 512, 1024, 2048, 4096,
+		8192, 16384, 32768, 65536,
+	}
+	literalLengthBits = []uint8{
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		1, 1, 1, 1, 2, 2, 3, 3, 4, 6, 7, 8, 9, 10, 11, 12,
+		13, 14, 15, 16,
+	}
+	matchLengthBase = []uint32{
+		3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18,
+		19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34,
+		35, 37, 39, 41, 43, 47, 51, 59, 67, 83, 99, 131, 259, 515, 1027, 2051,
+		4099, 8195, 16387, 32771, 65539,
+	}
+	matchLengthBits = []uint8{
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		1, 1, 1, 1, 2, 2, 3, 3, 4, 4, 5, 7, 8, 9, 10, 11,
+		12, 13, 14, 15, 16,
+	}
+)
+
+// The predefined distributions, RFC 8878 3.1.1.3.2.2, the -1 is the probability below 1.
+var (
+	literalLengthTable = newFSETable([]int16{
+		4, 3, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 1, 1, 1,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 3, 2, 1, 1, 1, 1, 1,
+		-1, -1, -1, -1,
+	}, 6)
+	matchLengthTable = newFSETable([]int16{
+		1, 4, 3, 2, 2, 2, 2, 2, 2, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, -1, -1,
+		-1, -1, -1, -1, -1,
+	}, 6)
+	offsetTable = newFSETable([]int16{
+		1, 1, 1, 1, 1, 1, 2, 2, 2, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, -1, -1, -1, -1, -1,
+	}, 5)
+)
+
+// fseTable is the FSE encoding table of a distribution: the states are from the table size
+// to twice of it, the state less the table size is the state of the decoder.
+type fseTable struct {
+	norm       []int16
+	tableLog   uint8
+	states     []uint16
+	transforms []fseTransform
+}
+
+type fseTransform struct {
+	deltaNbBits    uint32
+	deltaFindState int32
+}
+
+// newFSETable spreads the symbols as the decoder does, RFC 8878 4.1.1.
+func newFSETable(norm []int16, tableLog uint8) *fseTable {
+	size := 1 << tableLog
+	mask := size - 1
+	high := size - 1
+
+	// The symbols below 1 take the last cells.
+	symbols := make([]int, size)
+	cumul := make([]int, len(norm)+1)
+	for s, n := range norm {
+		if n == -1 {
+			cumul[s+1] = cumul[s] + 1
+			symbols[high] = s
+			high--
+		} else {
+			cumul[s+1] = cumul[s] + int(n)
+		}
+	}
+	step := size>>1 + size>>3 + 3
+	pos := 0
+	for s, n := range norm {
+		for i := 0; i < int(n); i++ {
+			symbols[pos] = s
+			pos = (pos + step) & mask
+			for pos > high {
+				pos = (pos + step) & mask
+			}
+		}
+	}
+
+	t := &fseTable{
+		norm:       norm,
+		tableLog:   tableLog,
+		states:     make([]uint16, size),
+		transforms: make([]fseTransform, len(norm)),
+	}
+	for u := 0; u < size; u++ {
+		s := symbols[u]
+		t.states[cumul[s]] = uint16(size + u)
+		cumul[s]++
+	}
+
+	total := 0
+	for s, n := range norm {
+		switch n {
+		case 0:
+		case -1, 1:
+			t.transforms[s] = fseTransform{
+				deltaNbBits:    uint32(tableLog)<<16 - uint32(size),
+				deltaFindState: int32(total - 1),
+			}
+			total++
+		default:
+			maxBitsOut := uint32(tableLog - highBit(uint32(n-1)))
+			minStatePlus := uint32(n) << maxBitsOut
+			t.transforms[s] = fseTransform{
+				deltaNbBits:    maxBitsOut<<16 - minStatePlus,
+				deltaFindState: int32(total - int(n)),
+			}
+			total += int(n)
+		}
+	}
+	return t
+}
+
+// init returns the state of the first symbol encoded, the last one the decoder reads.
+func (t *fseTable) init(symbol uint8) uint32 {
+	tt := t.transforms[symbol]
+	nbBitsOut := (tt.deltaNbBits + 1<<15) >> 16
+	value := nbBitsOut<<16 - tt.deltaNbBits
+	return uint32(t.states[int32(value>>nbBitsOut)+tt.deltaFindState])
+}
+
+// encode writes the bits of the state and returns the state of the symbol.
+func (t *fseTable) encode(bw *bitWriter, state uint32, symbol uint8) uint32 {
+	tt := t.transforms[symbol]
+	nbBitsOut := (state + tt.deltaNbBits) >> 16
+	bw.addBits(state, uint8(nbBitsOut))
+	return uint32(t.states[int32(state>>nbBitsOut)+tt.deltaFindState])
+}
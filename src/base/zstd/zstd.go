@@ -0,0 +1,359 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+// Package zstd implements a Zstandard (RFC 8878) stream compressor, without the decompressor.
+// The frames are read by any zstd decoder: the matches are found by the hash chains,
+// the literals are stored raw and the sequences are coded by the predefined FSE tables,
+// it's small and fast rather than as dense as the reference encoder.
+package zstd
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+const (
+	MinLevel     = 1
+	DefaultLevel = 3
+	MaxLevel     = 9
+
+	windowLog  = 18
+	windowSize = 1 << windowLog
+	// The most bytes a block regenerates.
+	maxBlockSize = 1 << 17
+	minMatch     = 4
+	hashLog      = 16
+
+	blockTypeRaw        = 0
+	blockTypeCompressed = 2
+)
+
+var (
+	// The 0xFD2FB528 little endian.
+	magicNumber = []byte{0x28, 0xB5, 0x2F, 0xFD}
+	errClosed   = errors.New("zstd: writer is closed")
+)
+
+// Writer compresses the bytes written into one zstd frame, Flush ends the block so far
+// and Close ends the frame. It's not safe for the concurrent use.
+type Writer struct {
+	w     io.Writer
+	depth int
+	err   error
+	// hist is the window before the pending bytes and the pending bytes from pending on.
+	hist    []byte
+	pending int
+	// table has the last position+1 of each hash, chain the previous position+1 of the same hash.
+	table []int32
+	chain []int32
+
+	started bool
+	closed  bool
+	out     []byte
+	lits    []byte
+	seqs    []sequence
+}
+
+type sequence struct {
+	litLen   int
+	offset   int
+	matchLen int
+}
+
+// NewWriter creates the writer of the level, from MinLevel to MaxLevel:
+// the higher one searches more matches for each position.
+func NewWriter(w io.Writer, level int) (*Writer, error) {
+	if level < MinLevel || level > MaxLevel {
+		return nil, fmt.Errorf("zstd: invalid compression level: %d", level)
+	}
+	return &Writer{
+		w:     w,
+		depth: 1 << uint(level-1),
+		table: make([]int32, 1<<hashLog),
+		chain: make([]int32, windowSize),
+	}, nil
+}
+
+// Write buffers the bytes, the full blocks are compressed and written as they fill up.
+func (z *Writer) Write(p []byte) (int, error) {
+	if z.closed {
+		return 0, errClosed
+	}
+	if z.err != nil {
+		return 0, z.err
+	}
+	z.hist = append(z.hist, p...)
+	for len(z.hist)-z.pending >= maxBlockSize {
+		if err := z.writeBlock(z.pending+maxBlockSize, false); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+// Flush writes the pending bytes as a block, the decoder gets all the bytes written so far.
+func (z *Writer) Flush() error {
+	if z.closed {
+		return errClosed
+	}
+	if z.err != nil || len(z.hist) == z.pending {
+		return z.err
+	}
+	return z.writeBlock(len(z.hist), false)
+}
+
+// Close writes the pending bytes as the last block of the frame, the underlying writer is not closed.
+func (z *Writer) Close() error {
+	if z.closed {
+		return z.err
+	}
+	if z.err == nil {
+		z.err = z.writeBlock(len(z.hist), true)
+	}
+	z.closed = true
+	z.hist, z.table, z.chain = nil, nil, nil
+	return z.err
+}
+
+// writeBlock compresses the pending bytes up to the end, raw if they don't compress.
+func (z *Writer) writeBlock(end int, last bool) error {
+	z.out = z.out[:0]
+	if !z.started {
+		z.out = append(z.out, magicNumber...)
+		// No content size, no checksum, no dictionary, the window descriptor follows.
+		z.out = append(z.out, 0, (windowLog-10)<<3)
+		z.started = true
+	}
+
+	src := z.hist[z.pending:end]
+	header := len(z.out)
+	z.out = append(z.out, 0, 0, 0)
+	typ := blockTypeRaw
+	if z.compress(z.pending, end) {
+		typ = blockTypeCompressed
+	} else {
+		z.out = append(z.out[:header+3], src...)
+	}
+	size := len(z.out) - header - 3
+	bh := uint32(size)<<3 | uint32(typ)<<1
+	if last {
+		bh |= 1
+	}
+	z.out[header], z.out[header+1], z.out[header+2] = byte(bh), byte(bh>>8), byte(bh>>16)
+
+	z.pending = end
+	z.slide()
+	if _, err := z.w.Write(z.out); err != nil {
+		z.err = err
+	}
+	return z.err
+}
+
+// slide drops the bytes beyond the window once the written ones are twice as large,
+// by the multiple of the window so the chain keeps its slots.
+func (z *Writer) slide() {
+	if z.pending < 2*windowSize {
+		return
+	}
+	shift := (z.pending - windowSize) &^ (windowSize - 1)
+	n := copy(z.hist, z.hist[shift:])
+	z.hist = z.hist[:n]
+	z.pending -= shift
+	for _, t := range [][]int32{z.table, z.chain} {
+		for i, v := range t {
+			if v = v - int32(shift); v < 0 {
+				v = 0
+			}
+			t[i] = v
+		}
+	}
+}
+
+func hash4(b []byte) uint32 {
+	return (binary.LittleEndian.Uint32(b) * 2654435761) >> (32 - hashLog)
+}
+
+func (z *Writer) insert(i int) {
+	h := hash4(z.hist[i:])
+	z.chain[i&(windowSize-1)] = z.table[h]
+	z.table[h] = int32(i + 1)
+}
+
+// findMatch returns the longest match of i in the window, up to the end.
+func (z *Writer) findMatch(i int, end int) (int, int) {
+	hist := z.hist
+	best, bestLen := 0, 0
+	ref := int(z.table[hash4(hist[i:])]) - 1
+	for tries := 0; ref >= 0 && ref < i && i-ref <= windowSize && tries < z.depth; tries++ {
+		if hist[ref+bestLen] == hist[i+bestLen] && binary.LittleEndian.Uint32(hist[ref:]) == binary.LittleEndian.Uint32(hist[i:]) {
+			n := minMatch
+			for i+n < end && hist[ref+n] == hist[i+n] {
+				n++
+			}
+			if n > bestLen {
+				best, bestLen = ref, n
+				if i+n == end {
+					break
+				}
+			}
+		}
+		next := int(z.chain[ref&(windowSize-1)]) - 1
+		if next >= ref {
+			break
+		}
+		ref = next
+	}
+	return best, bestLen
+}
+
+// compress appends the compressed block of the hist from start to end to the out,
+// false if it's not smaller than the raw block.
+func (z *Writer) compress(start int, end int) bool {
+	hist := z.hist
+	z.lits = z.lits[:0]
+	z.seqs = z.seqs[:0]
+
+	anchor := start
+	for i := start; i+minMatch <= end; {
+		ref, n := z.findMatch(i, end)
+		if n < minMatch {
+			z.insert(i)
+			i++
+			continue
+		}
+		// Extend backwards into the pending literals.
+		for i > anchor && ref > 0 && hist[i-1] == hist[ref-1] {
+			i--
+			ref--
+			n++
+		}
+		z.lits = append(z.lits, hist[anchor:i]...)
+		z.seqs = append(z.seqs, sequence{litLen: i - anchor, offset: i - ref, matchLen: n})
+		for j := i; j < i+n && j+minMatch <= end; j++ {
+			z.insert(j)
+		}
+		i += n
+		anchor = i
+	}
+	z.lits = append(z.lits, hist[anchor:end]...)
+	if len(z.seqs) == 0 {
+		return false
+	}
+
+	raw := len(z.out)
+	z.out = appendLiterals(z.out, z.lits)
+	z.out = appendSequences(z.out, z.seqs)
+	if len(z.out)-raw >= end-start {
+		z.out = z.out[:raw]
+		return false
+	}
+	return true
+}
+
+// appendLiterals appends the raw literals section.
+func appendLiterals(dst []byte, lits []byte) []byte {
+	n := len(lits)
+	switch {
+	case n < 1<<5:
+		dst = append(dst, byte(n<<3))
+	case n < 1<<12:
+		dst = append(dst, byte(n<<4)|1<<2, byte(n>>4))
+	default:
+		dst = append(dst, byte(n<<4)|3<<2, byte(n>>4), byte(n>>12))
+	}
+	return append(dst, lits...)
+}
+
+// appendSequences appends the sequences section coded by the predefined tables.
+func appendSequences(dst []byte, seqs []sequence) []byte {
+	n := len(seqs)
+	switch {
+	case n < 128:
+		dst = append(dst, byte(n))
+	case n < 0x7F00:
+		dst = append(dst, byte(n>>8)+128, byte(n))
+	default:
+		dst = append(dst, 255, byte(n-0x7F00), byte((n-0x7F00)>>8))
+	}
+	// The predefined mode of the literal lengths, the offsets and the match lengths.
+	dst = append(dst, 0)
+
+	bw := bitWriter{out: dst}
+	var llState, ofState, mlState uint32
+	for i := n - 1; i >= 0; i-- {
+		seq := seqs[i]
+		llCode, llExtra, llBits := lengthCode(seq.litLen, literalLengthBase, literalLengthBits)
+		mlCode, mlExtra, mlBits := lengthCode(seq.matchLen, matchLengthBase, matchLengthBits)
+		offValue := uint32(seq.offset + 3)
+		ofCode := highBit(offValue)
+
+		if i == n-1 {
+			llState = literalLengthTable.init(llCode)
+			ofState = offsetTable.init(ofCode)
+			mlState = matchLengthTable.init(mlCode)
+		} else {
+			ofState = offsetTable.encode(&bw, ofState, ofCode)
+			mlState = matchLengthTable.encode(&bw, mlState, mlCode)
+			llState = literalLengthTable.encode(&bw, llState, llCode)
+		}
+		bw.addBits(llExtra, llBits)
+		bw.addBits(mlExtra, mlBits)
+		bw.addBits(offValue, ofCode)
+	}
+	bw.addBits(mlState, matchLengthTable.tableLog)
+	bw.addBits(ofState, offsetTable.tableLog)
+	bw.addBits(llState, literalLengthTable.tableLog)
+	return bw.close()
+}
+
+// lengthCode returns the code of the literal or the match length, with its extra bits.
+func lengthCode(length int, base []uint32, bits []uint8) (uint8, uint32, uint8) {
+	lo, hi := 0, len(base)-1
+	for lo < hi {
+		mid := (lo + hi + 1) / 2
+		if base[mid] <= uint32(length) {
+			lo = mid
+		} else {
+			hi = mid - 1
+		}
+	}
+	return uint8(lo), uint32(length) - base[lo], bits[lo]
+}
+
+func highBit(v uint32) uint8 {
+	n := uint8(0)
+	for v > 1 {
+		v >>= 1
+		n++
+	}
+	return n
+}
+
+// bitWriter writes the bits from the least significant one, the decoder reads them backwards.
+type bitWriter struct {
+	out   []byte
+	bits  uint64
+	nbits uint8
+}
+
+func (b *bitWriter) addBits(v uint32, n uint8) {
+	b.bits |= (uint64(v) & (1<<n - 1)) << b.nbits
+	b.nbits += n
+	for b.nbits >= 8 {
+		b.out = append(b.out, byte(b.bits))
+		b.bits >>= 8
+		b.nbits -= 8
+	}
+}
+
+// close ends the stream by the 1 bit the decoder starts after.
+func (b *bitWriter) close() []byte {
+	b.addBits(1, 1)
+	if b.nbits > 0 {
+		b.out = append(b.out, byte(b.bits))
+	}
+	return b.out
+}
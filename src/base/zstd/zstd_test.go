@@ -0,0 +1,323 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package zstd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testLogs(n int) []byte {
+	var sb strings.Builder
+	r := rand.New(rand.NewSource(1))
+	for i := 0; sb.Len() < n; i++ {
+		fmt.Fprintf(&sb, "2020/03/04 05:06:%02d.%06d \t [DEBUG] \tHTTPHandler-Query->Enter:select * from t where id = %d, user:default <processQuery@http_query.go:%d>\n",
+			i%60, r.Intn(1000000), r.Intn(100000), 40+r.Intn(100))
+	}
+	return []byte(sb.String())
+}
+
+func TestZstdRoundtrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	random := make([]byte, 300000)
+	r.Read(random)
+	logs := testLogs(1 << 20)
+
+	tests := []struct {
+		name  string
+		src   []byte
+		chunk int
+		flush bool
+	}{
+		{name: "empty", src: []byte{}, chunk: 1},
+		{name: "short", src: []byte("abcdabcdabcd"), chunk: 1},
+		{name: "repeat", src: bytes.Repeat([]byte("a"), 500000), chunk: 100000},
+		{name: "random", src: random, chunk: 4096},
+		{name: "logs", src: logs, chunk: 1 << 20},
+		{name: "logs-flushed", src: logs[:200000], chunk: 137, flush: true},
+		{name: "mixed", src: append(append(append([]byte{}, logs[:200000]...), random[:100000]...), logs[:100000]...), chunk: 70000, flush: true},
+	}
+
+	for _, level := range []int{MinLevel, DefaultLevel, MaxLevel} {
+		for _, test := range tests {
+			name := fmt.Sprintf("%s-%d", test.name, level)
+			buf := new(bytes.Buffer)
+			w, err := NewWriter(buf, level)
+			assert.Nil(t, err)
+			for i := 0; i < len(test.src); i += test.chunk {
+				end := i + test.chunk
+				if end > len(test.src) {
+					end = len(test.src)
+				}
+				n, err := w.Write(test.src[i:end])
+				assert.Nil(t, err, name)
+				assert.Equal(t, end-i, n, name)
+				if test.flush {
+					assert.Nil(t, w.Flush(), name)
+				}
+			}
+			assert.Nil(t, w.Close(), name)
+
+			actual, err := decode(buf.Bytes(), false)
+			assert.Nil(t, err, name)
+			assert.True(t, bytes.Equal(test.src, actual), name)
+		}
+	}
+}
+
+func TestZstdRatio(t *testing.T) {
+	logs := testLogs(1 << 20)
+	buf := new(bytes.Buffer)
+	w, err := NewWriter(buf, DefaultLevel)
+	assert.Nil(t, err)
+	_, err = w.Write(logs)
+	assert.Nil(t, err)
+	assert.Nil(t, w.Close())
+	assert.True(t, buf.Len() < len(logs)/4, "%d -> %d", len(logs), buf.Len())
+}
+
+func TestZstdFlush(t *testing.T) {
+	buf := new(bytes.Buffer)
+	w, err := NewWriter(buf, DefaultLevel)
+	assert.Nil(t, err)
+
+	// The bytes flushed are decoded before the frame ends.
+	_, err = w.Write([]byte("select 1; select 1; select 1;\n"))
+	assert.Nil(t, err)
+	assert.Nil(t, w.Flush())
+	actual, err := decode(buf.Bytes(), true)
+	assert.Nil(t, err)
+	assert.Equal(t, "select 1; select 1; select 1;\n", string(actual))
+
+	// The flush without the pending bytes writes nothing.
+	n := buf.Len()
+	assert.Nil(t, w.Flush())
+	assert.Equal(t, n, buf.Len())
+
+	assert.Nil(t, w.Close())
+	assert.Nil(t, w.Close())
+	_, err = w.Write([]byte("x"))
+	assert.Equal(t, errClosed, err)
+	assert.Equal(t, errClosed, w.Flush())
+}
+
+func TestZstdFrame(t *testing.T) {
+	src := strings.Repeat("vectorsql vectorsql, abcabcabc; ", 3)
+	buf := new(bytes.Buffer)
+	w, err := NewWriter(buf, DefaultLevel)
+	assert.Nil(t, err)
+	_, err = w.Write([]byte(src))
+	assert.Nil(t, err)
+	assert.Nil(t, w.Close())
+
+	// The frame is decoded by the zstd decoder of the Go standard library, internal/zstd, as it is.
+	assert.Equal(t, "28b52ffd0040ed000080766563746f7273716c202c206162633b04001cea404e787558b72848", fmt.Sprintf("%x", buf.Bytes()))
+	actual, err := decode(buf.Bytes(), false)
+	assert.Nil(t, err)
+	assert.Equal(t, src, string(actual))
+}
+
+func TestZstdLevel(t *testing.T) {
+	for _, level := range []int{MinLevel - 1, MaxLevel + 1} {
+		_, err := NewWriter(new(bytes.Buffer), level)
+		assert.NotNil(t, err)
+	}
+}
+
+// decode decodes the frame of the Writer: the raw and the compressed blocks,
+// the raw literals and the sequences of the predefined tables. The partial frame has no last block.
+func decode(src []byte, partial bool) ([]byte, error) {
+	if len(src) < 6 || !bytes.Equal(src[:4], magicNumber) || src[4] != 0 {
+		return nil, errors.New("bad frame header")
+	}
+	src = src[6:]
+
+	var out []byte
+	for len(src) > 0 {
+		if len(src) < 3 {
+			return nil, errors.New("short block header")
+		}
+		bh := int(src[0]) | int(src[1])<<8 | int(src[2])<<16
+		last, typ, size := bh&1 == 1, bh>>1&3, bh>>3
+		src = src[3:]
+		if size > len(src) {
+			return nil, errors.New("short block")
+		}
+		switch typ {
+		case blockTypeRaw:
+			out = append(out, src[:size]...)
+		case blockTypeCompressed:
+			var err error
+			if out, err = decodeBlock(out, src[:size]); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, fmt.Errorf("unexpected block type:%d", typ)
+		}
+		src = src[size:]
+		if last {
+			if len(src) > 0 {
+				return nil, errors.New("bytes after the last block")
+			}
+			return out, nil
+		}
+	}
+	if !partial {
+		return nil, errors.New("no last block")
+	}
+	return out, nil
+}
+
+func decodeBlock(out []byte, src []byte) ([]byte, error) {
+	// Literals.
+	var n int
+	switch src[0] >> 2 & 3 {
+	case 0, 2:
+		n, src = int(src[0]>>3), src[1:]
+	case 1:
+		n, src = int(src[0]>>4)|int(src[1])<<4, src[2:]
+	case 3:
+		n, src = int(src[0]>>4)|int(src[1])<<4|int(src[2])<<12, src[3:]
+	}
+	lits := src[:n]
+	src = src[n:]
+
+	// Sequences.
+	var nseq int
+	switch {
+	case src[0] < 128:
+		nseq, src = int(src[0]), src[1:]
+	case src[0] < 255:
+		nseq, src = int(src[0]-128)<<8|int(src[1]), src[2:]
+	default:
+		nseq, src = int(src[1])|int(src[2])<<8+0x7F00, src[3:]
+	}
+	if nseq > 0 {
+		if src[0] != 0 {
+			return nil, errors.New("not the predefined tables")
+		}
+		br, err := newBitReader(src[1:])
+		if err != nil {
+			return nil, err
+		}
+		ll := newDecodeTable(literalLengthTable)
+		of := newDecodeTable(offsetTable)
+		ml := newDecodeTable(matchLengthTable)
+		llState := br.read(ll.log)
+		ofState := br.read(of.log)
+		mlState := br.read(ml.log)
+		for i := 0; i < nseq; i++ {
+			llCode, ofCode, mlCode := ll.cells[llState].symbol, of.cells[ofState].symbol, ml.cells[mlState].symbol
+			offset := int(1<<ofCode+br.read(ofCode)) - 3
+			matchLen := int(matchLengthBase[mlCode] + br.read(matchLengthBits[mlCode]))
+			litLen := int(literalLengthBase[llCode] + br.read(literalLengthBits[llCode]))
+			if i < nseq-1 {
+				llState = ll.next(llState, br)
+				mlState = ml.next(mlState, br)
+				ofState = of.next(ofState, br)
+			}
+			if offset <= 0 || offset > len(out)+litLen || litLen > len(lits) {
+				return nil, errors.New("bad sequence")
+			}
+			out = append(out, lits[:litLen]...)
+			lits = lits[litLen:]
+			for j := 0; j < matchLen; j++ {
+				out = append(out, out[len(out)-offset])
+			}
+		}
+		if br.pos != 0 {
+			return nil, errors.New("bits left")
+		}
+	}
+	return append(out, lits...), nil
+}
+
+type decodeCell struct {
+	symbol uint8
+	nbBits uint8
+	base   uint32
+}
+
+type decodeTable struct {
+	log   uint8
+	cells []decodeCell
+}
+
+// newDecodeTable builds the decoding table of the distribution as the RFC 8878 4.1.1 says.
+func newDecodeTable(t *fseTable) *decodeTable {
+	size := 1 << t.tableLog
+	mask := size - 1
+	high := size - 1
+	d := &decodeTable{log: t.tableLog, cells: make([]decodeCell, size)}
+
+	next := make([]uint32, len(t.norm))
+	for s, n := range t.norm {
+		if n == -1 {
+			d.cells[high].symbol = uint8(s)
+			high--
+			next[s] = 1
+		} else {
+			next[s] = uint32(n)
+		}
+	}
+	pos, step := 0, size>>1+size>>3+3
+	for s, n := range t.norm {
+		for i := 0; i < int(n); i++ {
+			d.cells[pos].symbol = uint8(s)
+			pos = (pos + step) & mask
+			for pos > high {
+				pos = (pos + step) & mask
+			}
+		}
+	}
+	for u := range d.cells {
+		cell := &d.cells[u]
+		x := next[cell.symbol]
+		next[cell.symbol]++
+		cell.nbBits = t.tableLog - highBit(x)
+		cell.base = x<<cell.nbBits - uint32(size)
+	}
+	return d
+}
+
+func (d *decodeTable) next(state uint32, br *bitReader) uint32 {
+	cell := d.cells[state]
+	return cell.base + br.read(cell.nbBits)
+}
+
+// bitReader reads the bits backwards from the 1 bit the stream ends with.
+type bitReader struct {
+	src []byte
+	pos int
+}
+
+func newBitReader(src []byte) (*bitReader, error) {
+	if len(src) == 0 || src[len(src)-1] == 0 {
+		return nil, errors.New("bad bitstream end")
+	}
+	pos := len(src)*8 - 1
+	for src[len(src)-1]&(1<<uint(pos%8)) == 0 {
+		pos--
+	}
+	return &bitReader{src: src, pos: pos}, nil
+}
+
+func (br *bitReader) read(n uint8) uint32 {
+	var v uint32
+	for j := 0; j < int(n); j++ {
+		k := br.pos - int(n) + j
+		if k >= 0 && br.src[k/8]&(1<<uint(k%8)) != 0 {
+			v |= 1 << uint(j)
+		}
+	}
+	br.pos -= int(n)
+	return v
+}
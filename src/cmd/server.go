@@ -17,6 +17,8 @@ import (
 	"base/xlog"
 	"config"
 	"databases"
+	"datablocks"
+	"dictionaries"
 	"servers"
 )
 
@@ -36,6 +38,7 @@ func usage() {
 func main() {
 	runtime.GOMAXPROCS(runtime.NumCPU())
 	log := xlog.NewStdLog(xlog.Level(xlog.DEBUG))
+	defer log.Close()
 
 	// Load config.
 	flag.Usage = func() { usage() }
@@ -50,12 +53,21 @@ func main() {
 	}
 	log.SetLevel(conf.Logger.Level)
 	log.Info("Config: %+v", conf)
+	datablocks.SetPoolDebug(conf.Server.DebugBlockPool)
+	// SIGUSR1 toggles the DEBUG level, the SIGHUP reloads the TLS certificate.
+	xlog.InstallSignalLevelToggle(log, syscall.SIGUSR1, []xlog.LogLevel{log.Level(), xlog.DEBUG})
 
 	// Load database.
 	if err := databases.Load(log, conf); err != nil {
 		log.Panic("%+v", err)
 	}
 
+	// Load dictionaries.
+	if err := dictionaries.Load(log, conf); err != nil {
+		log.Panic("%+v", err)
+	}
+	defer dictionaries.Close()
+
 	// Servers.
 	server := servers.NewServer(log, conf)
 	server.Start()
@@ -64,10 +76,11 @@ func main() {
 
 	go logMemStats(log)
 
-	// Handle signal.
+	// Handle signal, the deferred Stop drains the running queries.
 	ch := make(chan os.Signal, 1)
 	signal.Notify(ch, syscall.SIGINT, syscall.SIGTERM)
-	<-ch
+	sig := <-ch
+	log.Info("Received %v, shutting down...", sig)
 }
 
 func logMemStats(log *xlog.Log) {
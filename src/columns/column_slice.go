@@ -0,0 +1,82 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package columns
+
+import (
+	"datavalues"
+)
+
+// The accessors below take the values of a column at the seqs into a typed slice for the vectorized kernels,
+// the nil seqs are all the values. They return false at the first value of another type, such as a NULL,
+// the caller falls back to the values then.
+
+// AsInt64Slice takes the Int and Int32 values.
+func AsInt64Slice(values []datavalues.IDataValue, seqs []int) ([]int64, bool) {
+	n := len(values)
+	if seqs != nil {
+		n = len(seqs)
+	}
+
+	res := make([]int64, n)
+	for i := range res {
+		j := i
+		if seqs != nil {
+			j = seqs[i]
+		}
+		switch v := values[j].(type) {
+		case *datavalues.ValueInt:
+			res[i] = int64(*v)
+		case *datavalues.ValueInt32:
+			res[i] = int64(*v)
+		default:
+			return nil, false
+		}
+	}
+	return res, true
+}
+
+// AsFloat64Slice takes the Float values.
+func AsFloat64Slice(values []datavalues.IDataValue, seqs []int) ([]float64, bool) {
+	n := len(values)
+	if seqs != nil {
+		n = len(seqs)
+	}
+
+	res := make([]float64, n)
+	for i := range res {
+		j := i
+		if seqs != nil {
+			j = seqs[i]
+		}
+		v, ok := values[j].(*datavalues.ValueFloat)
+		if !ok {
+			return nil, false
+		}
+		res[i] = float64(*v)
+	}
+	return res, true
+}
+
+// AsStringSlice takes the String values.
+func AsStringSlice(values []datavalues.IDataValue, seqs []int) ([]string, bool) {
+	n := len(values)
+	if seqs != nil {
+		n = len(seqs)
+	}
+
+	res := make([]string, n)
+	for i := range res {
+		j := i
+		if seqs != nil {
+			j = seqs[i]
+		}
+		v, ok := values[j].(*datavalues.ValueString)
+		if !ok {
+			return nil, false
+		}
+		res[i] = string(*v)
+	}
+	return res, true
+}
@@ -0,0 +1,45 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package columns
+
+import (
+	"testing"
+
+	"datavalues"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestColumnSlices(t *testing.T) {
+	ints := []datavalues.IDataValue{datavalues.MakeInt(1), datavalues.MakeInt32(-2), datavalues.MakeInt(3)}
+	floats := []datavalues.IDataValue{datavalues.MakeFloat(1.5), datavalues.MakeFloat(-2)}
+	strs := []datavalues.IDataValue{datavalues.MakeString("a"), datavalues.MakeString("b")}
+
+	i64, ok := AsInt64Slice(ints, nil)
+	assert.True(t, ok)
+	assert.Equal(t, []int64{1, -2, 3}, i64)
+	i64, ok = AsInt64Slice(ints, []int{2, 0})
+	assert.True(t, ok)
+	assert.Equal(t, []int64{3, 1}, i64)
+
+	f64, ok := AsFloat64Slice(floats, nil)
+	assert.True(t, ok)
+	assert.Equal(t, []float64{1.5, -2}, f64)
+	f64, ok = AsFloat64Slice(floats, []int{1})
+	assert.True(t, ok)
+	assert.Equal(t, []float64{-2}, f64)
+
+	s, ok := AsStringSlice(strs, []int{1, 1})
+	assert.True(t, ok)
+	assert.Equal(t, []string{"b", "b"}, s)
+
+	// Another type or a NULL is not taken.
+	_, ok = AsInt64Slice(append(ints, datavalues.MakeNull()), nil)
+	assert.False(t, ok)
+	_, ok = AsFloat64Slice(ints, nil)
+	assert.False(t, ok)
+	_, ok = AsStringSlice(floats, []int{0})
+	assert.False(t, ok)
+}
@@ -0,0 +1,30 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package columns
+
+import (
+	"strings"
+
+	"base/errors"
+	"datatypes"
+)
+
+// ParseStructure parses the columns structure like 'a Int32, b String, c DateTime'.
+func ParseStructure(structure string) ([]*Column, error) {
+	var cols []*Column
+
+	for _, field := range strings.Split(structure, ",") {
+		parts := strings.Fields(field)
+		if len(parts) != 2 {
+			return nil, errors.Errorf("Invalid column structure:'%s', expected 'name Type'", strings.TrimSpace(field))
+		}
+		datatype, err := datatypes.DataTypeFactory(parts[1])
+		if err != nil {
+			return nil, err
+		}
+		cols = append(cols, NewColumn(parts[0], datatype))
+	}
+	return cols, nil
+}
@@ -0,0 +1,54 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package columns
+
+import (
+	"testing"
+
+	"datatypes"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseStructure(t *testing.T) {
+	tests := []struct {
+		name      string
+		structure string
+		expect    []*Column
+		errstring string
+	}{
+		{
+			name:      "simple",
+			structure: "a Int32, b String,c  DateTime",
+			expect: []*Column{
+				NewColumn("a", datatypes.NewInt32DataType()),
+				NewColumn("b", datatypes.NewStringDataType()),
+				NewColumn("c", datatypes.NewDateTimeDataType()),
+			},
+		},
+		{
+			name:      "missing-type",
+			structure: "a Int32, b",
+			errstring: "Invalid column structure:'b', expected 'name Type'",
+		},
+		{
+			name:      "unknown-type",
+			structure: "a Int8",
+			errstring: "Unsupported data type:Int8 (errno 50)",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := ParseStructure(test.structure)
+			if test.errstring != "" {
+				assert.Equal(t, test.errstring, err.Error())
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, test.expect, actual)
+			}
+		})
+	}
+}
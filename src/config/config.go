@@ -13,6 +13,9 @@ import (
 type Server struct {
 	TCPPort                 int
 	HTTPPort                int
+	MySQLPort               int
+	PostgreSQLPort          int
+	GRPCPort                int
 	DebugPort               int
 	Path                    string
 	TmpPath                 string
@@ -21,6 +24,37 @@ type Server struct {
 	DefaultDatabase         string
 	DefaultBlockSize        int
 	CalculateTextStackTrace bool
+	// The interval of the progress packets in microseconds.
+	InteractiveDelay int
+
+	// TLS listeners, enabled when the ports and the certificate are set.
+	HTTPSPort     int
+	TCPPortSecure int
+	TLSCertFile   string
+	TLSKeyFile    string
+	// The CA to verify the client certificates, verified only if set.
+	TLSCAFile string
+	// Requires the clients to present a certificate signed by the CA.
+	TLSRequireClientCert bool
+	// The minimum TLS version: 1.0, 1.1, 1.2 or 1.3.
+	TLSMinVersion string
+
+	// The HTTP sessions of the session_id parameter, the timeouts are in seconds.
+	DefaultSessionTimeout int
+	MaxSessionTimeout     int
+	// The maximum number of the HTTP sessions, 0 is unlimited.
+	MaxSessions int
+	// The bytes all the queries hold at once, 0 is unlimited.
+	MaxServerMemoryUsage int
+	// The bytes of the results kept by the query cache, in all and per query.
+	QueryCacheMaxSize      int
+	QueryCacheMaxEntrySize int
+	// Poisons the buffers of the released blocks to catch the use after the release, for debugging.
+	DebugBlockPool bool
+	// The seconds the shutdown waits for the running queries before cancelling them.
+	ShutdownWaitUnfinished int
+	// The queries running at once, the others wait in the queue, 0 is unlimited.
+	MaxConcurrentQueries int
 }
 
 func DefaultServerConfig() Server {
@@ -32,16 +66,55 @@ func DefaultServerConfig() Server {
 		DisplayName:      "VectorSQL",
 		DefaultDatabase:  "default",
 		DefaultBlockSize: 65536,
+		InteractiveDelay: 100000,
+		TLSMinVersion:    "1.2",
+
+		DefaultSessionTimeout: 60,
+		MaxSessionTimeout:     3600,
+		MaxSessions:           1000,
+
+		QueryCacheMaxSize:      64 << 20,
+		QueryCacheMaxEntrySize: 1 << 20,
+
+		ShutdownWaitUnfinished: 5,
 	}
 }
 
 type Runtime struct {
 	ParallelWorkerNumber int
+	// The seconds a query can run, 0 is unlimited.
+	MaxExecutionTime int
+	// The rows per second a query must read after the timeout (in seconds), 0 is not checked.
+	MinExecutionSpeed                   int
+	TimeoutBeforeCheckingExecutionSpeed int
+	// The blocks buffered on each edge of the pipeline, the sender waits beyond them.
+	MaxBlocksInFlight int
+	// The bytes a query holds at once, 0 is unlimited.
+	MaxMemoryUsage int
+	// The rows and the bytes a query reads from the storages, 0 is unlimited.
+	MaxRowsToRead  int
+	MaxBytesToRead int
+	// What a query does beyond them: "throw" fails it, "break" ends the read as if the source was finished.
+	ReadOverflowMode string
+	// The SELECT results are looked up in the query cache and stored into it, 0 is off.
+	UseQueryCache int
+	// The queries of a user running at once, 0 is unlimited.
+	MaxConcurrentQueriesForUser int
+	// The milliseconds a query waits in the queue for the concurrency limits, 0 fails it at once.
+	QueueMaxWaitMs int
+	// The bytes the ORDER BY buffers before it spills the sorted run to the temporary files, 0 is never.
+	MaxBytesBeforeExternalSort int
+	// The conjuncts of the filters are ordered by their cost, 0 keeps them as written.
+	OptimizeReorderConjuncts int
 }
 
 func DefaultRuntimeConfig() Runtime {
 	return Runtime{
-		ParallelWorkerNumber: 4,
+		ParallelWorkerNumber:                4,
+		TimeoutBeforeCheckingExecutionSpeed: 10,
+		MaxBlocksInFlight:                   2,
+		ReadOverflowMode:                    OverflowModeThrow,
+		OptimizeReorderConjuncts:            1,
 	}
 }
 
@@ -55,6 +128,50 @@ func DefaultLoggerConfig() Logger {
 	}
 }
 
+type DictionaryAttribute struct {
+	Name    string
+	Type    string
+	Default string
+}
+
+type Dictionary struct {
+	Name       string
+	Source     string
+	Path       string
+	Format     string
+	Key        string
+	KeyType    string
+	Attributes []DictionaryAttribute
+	Lifetime   int
+
+	// MySQL source.
+	Host     string
+	Port     int
+	User     string
+	Password string
+	Database string
+	Table    string
+}
+
+// Profile is the named settings applied to the queries of its users.
+type Profile struct {
+	Name     string
+	Settings map[string]string
+}
+
+// User is the account to authenticate the clients.
+// The password is given in plain, as the hex of its SHA256 or of SHA1(SHA1(password)) for the MySQL clients,
+// an empty Databases allows all the databases.
+type User struct {
+	Name                  string
+	Password              string
+	PasswordSHA256Hex     string
+	PasswordDoubleSHA1Hex string
+	Profile               string
+	Databases             []string
+	Readonly              bool
+}
+
 func DefaultConfig() *Config {
 	return &Config{
 		Server:  DefaultServerConfig(),
@@ -64,9 +181,12 @@ func DefaultConfig() *Config {
 }
 
 type Config struct {
-	Server  Server
-	Runtime Runtime
-	Logger  Logger
+	Server       Server
+	Runtime      Runtime
+	Logger       Logger
+	Dictionaries []Dictionary
+	Profiles     []Profile
+	Users        []User
 }
 
 func Load(file string) (*Config, error) {
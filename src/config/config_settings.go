@@ -0,0 +1,114 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package config
+
+import (
+	"sort"
+	"strconv"
+
+	"base/errors"
+)
+
+// The read_overflow_mode: what a query does beyond max_rows_to_read or max_bytes_to_read.
+const (
+	OverflowModeThrow = "throw"
+	OverflowModeBreak = "break"
+)
+
+type settingApplier func(conf *Config, v int)
+
+// modeSettingApplier applies the setting of the named modes, false if the mode isn't known.
+type modeSettingApplier func(conf *Config, v string) bool
+
+// querySettings are the settings a query can change, the zero is the server default.
+var querySettings = map[string]settingApplier{
+	"max_block_size": func(conf *Config, v int) {
+		if v > 0 {
+			conf.Server.DefaultBlockSize = v
+		}
+	},
+	"max_threads": func(conf *Config, v int) {
+		if v > 0 {
+			conf.Runtime.ParallelWorkerNumber = v
+		}
+	},
+	"max_blocks_in_flight": func(conf *Config, v int) {
+		if v > 0 {
+			conf.Runtime.MaxBlocksInFlight = v
+		}
+	},
+	"max_memory_usage": func(conf *Config, v int) {
+		conf.Runtime.MaxMemoryUsage = v
+	},
+	"max_rows_to_read": func(conf *Config, v int) {
+		conf.Runtime.MaxRowsToRead = v
+	},
+	"max_bytes_to_read": func(conf *Config, v int) {
+		conf.Runtime.MaxBytesToRead = v
+	},
+	"use_query_cache": func(conf *Config, v int) {
+		conf.Runtime.UseQueryCache = v
+	},
+	"max_execution_time": func(conf *Config, v int) {
+		conf.Runtime.MaxExecutionTime = v
+	},
+	"min_execution_speed": func(conf *Config, v int) {
+		conf.Runtime.MinExecutionSpeed = v
+	},
+	"timeout_before_checking_execution_speed": func(conf *Config, v int) {
+		conf.Runtime.TimeoutBeforeCheckingExecutionSpeed = v
+	},
+	"max_concurrent_queries_for_user": func(conf *Config, v int) {
+		conf.Runtime.MaxConcurrentQueriesForUser = v
+	},
+	"queue_max_wait_ms": func(conf *Config, v int) {
+		conf.Runtime.QueueMaxWaitMs = v
+	},
+	"max_bytes_before_external_sort": func(conf *Config, v int) {
+		conf.Runtime.MaxBytesBeforeExternalSort = v
+	},
+	"optimize_reorder_conjuncts": func(conf *Config, v int) {
+		conf.Runtime.OptimizeReorderConjuncts = v
+	},
+}
+
+var queryModeSettings = map[string]modeSettingApplier{
+	"read_overflow_mode": func(conf *Config, v string) bool {
+		switch v {
+		case OverflowModeThrow, OverflowModeBreak:
+			conf.Runtime.ReadOverflowMode = v
+			return true
+		}
+		return false
+	},
+}
+
+// WithSettings returns a copy of the config with the query settings applied,
+// the settings not known are returned in order, the caller decides to ignore them or not.
+func (conf *Config) WithSettings(settings map[string]string) (*Config, []string, error) {
+	var unknown []string
+
+	c := *conf
+	for name, value := range settings {
+		if apply, ok := queryModeSettings[name]; ok {
+			if !apply(&c, value) {
+				return nil, nil, errors.Errorf("Invalid setting %s:%s", name, value)
+			}
+			continue
+		}
+		apply, ok := querySettings[name]
+		if !ok {
+			unknown = append(unknown, name)
+			continue
+		}
+		v, err := strconv.Atoi(value)
+		if err != nil || v < 0 {
+			return nil, nil, errors.Errorf("Invalid setting %s:%s", name, value)
+		}
+		apply(&c, v)
+	}
+	sort.Strings(unknown)
+	return &c, unknown, nil
+}
@@ -14,3 +14,63 @@ func TestConfig(t *testing.T) {
 	_, err := Load("../../conf/vectorsql-default.toml")
 	assert.Nil(t, err)
 }
+
+func TestConfigWithSettings(t *testing.T) {
+	conf := DefaultConfig()
+
+	c, unknown, err := conf.WithSettings(map[string]string{
+		"max_block_size":       "1024",
+		"max_threads":          "1",
+		"max_blocks_in_flight": "8",
+		"max_execution_time":   "10",
+		"max_memory_usage":     "1048576",
+		"max_rows_to_read":     "100",
+		"max_bytes_to_read":    "4096",
+		"read_overflow_mode":   "break",
+		"use_query_cache":      "1",
+		"send_logs_level":      "trace",
+		"extremes":             "0",
+
+		"min_execution_speed":                     "1000",
+		"timeout_before_checking_execution_speed": "0",
+		"max_concurrent_queries_for_user":         "2",
+		"queue_max_wait_ms":                       "500",
+		"max_bytes_before_external_sort":          "65536",
+		"optimize_reorder_conjuncts":              "0",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 1024, c.Server.DefaultBlockSize)
+	assert.Equal(t, 1, c.Runtime.ParallelWorkerNumber)
+	assert.Equal(t, 8, c.Runtime.MaxBlocksInFlight)
+	assert.Equal(t, 10, c.Runtime.MaxExecutionTime)
+	assert.Equal(t, 1048576, c.Runtime.MaxMemoryUsage)
+	assert.Equal(t, 100, c.Runtime.MaxRowsToRead)
+	assert.Equal(t, 4096, c.Runtime.MaxBytesToRead)
+	assert.Equal(t, OverflowModeBreak, c.Runtime.ReadOverflowMode)
+	assert.Equal(t, 1, c.Runtime.UseQueryCache)
+	assert.Equal(t, 1000, c.Runtime.MinExecutionSpeed)
+	assert.Equal(t, 0, c.Runtime.TimeoutBeforeCheckingExecutionSpeed)
+	assert.Equal(t, 2, c.Runtime.MaxConcurrentQueriesForUser)
+	assert.Equal(t, 500, c.Runtime.QueueMaxWaitMs)
+	assert.Equal(t, 65536, c.Runtime.MaxBytesBeforeExternalSort)
+	assert.Equal(t, 0, c.Runtime.OptimizeReorderConjuncts)
+	assert.Equal(t, []string{"extremes", "send_logs_level"}, unknown)
+
+	// The server config is untouched.
+	assert.Equal(t, 65536, conf.Server.DefaultBlockSize)
+	assert.Equal(t, 4, conf.Runtime.ParallelWorkerNumber)
+	assert.Equal(t, 0, conf.Runtime.MaxExecutionTime)
+	assert.Equal(t, 1, conf.Runtime.OptimizeReorderConjuncts)
+	assert.Equal(t, OverflowModeThrow, conf.Runtime.ReadOverflowMode)
+
+	// The zero is the server default.
+	c, _, err = conf.WithSettings(map[string]string{"max_threads": "0"})
+	assert.Nil(t, err)
+	assert.Equal(t, 4, c.Runtime.ParallelWorkerNumber)
+
+	_, _, err = conf.WithSettings(map[string]string{"max_threads": "x"})
+	assert.Equal(t, "Invalid setting max_threads:x", err.Error())
+
+	_, _, err = conf.WithSettings(map[string]string{"read_overflow_mode": "any"})
+	assert.Equal(t, "Invalid setting read_overflow_mode:any", err.Error())
+}
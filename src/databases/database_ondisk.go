@@ -11,8 +11,6 @@ import (
 	"io/ioutil"
 	"path/filepath"
 
-	"columns"
-	"datatypes"
 	"parsers"
 	"storages"
 
@@ -105,7 +103,7 @@ func (database *OnDiskDatabase) GetStorage(tableName string) (storages.IStorage,
 
 	table, ok := database.tableCaches[tableName]
 	if !ok {
-		return nil, errors.Errorf("couldn't find table:%v storage", tableName)
+		return nil, errors.ErrorWithCode(errors.UNKNOWN_TABLE, "couldn't find table:%v storage", tableName)
 	}
 	return table.storage, nil
 }
@@ -120,29 +118,14 @@ func (database *OnDiskDatabase) attachTable(tableName string, node *sqlparser.DD
 	database.mu.Lock()
 	defer database.mu.Unlock()
 	if _, ok := database.tableCaches[tableName]; ok {
-		return errors.Errorf("%s.%s exists", dbName, tableName)
+		return errors.ErrorWithCode(errors.TABLE_ALREADY_EXISTS, "%s.%s exists", dbName, tableName)
 	}
 
-	var colDefinitions []*sqlparser.ColumnDefinition
-	if err := sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
-		switch node := node.(type) {
-		case *sqlparser.ColumnDefinition:
-			colDefinitions = append(colDefinitions, node)
-		}
-		return true, nil
-	}, node.TableSpec); err != nil {
+	cols, err := TableColumns(node)
+	if err != nil {
 		return err
 	}
 
-	cols := make([]*columns.Column, len(colDefinitions))
-	for i, coldef := range colDefinitions {
-		dataType, err := datatypes.DataTypeFactory(coldef.Type.Type)
-		if err != nil {
-			return err
-		}
-		cols[i] = columns.NewColumn(coldef.Name.String(), dataType)
-	}
-
 	storageCtx := storages.NewStorageContext(ctx.log, ctx.conf)
 	storage, err := storages.StorageFactory(storageCtx, engine, cols)
 	if err != nil {
@@ -162,7 +145,7 @@ func (database *OnDiskDatabase) detachTable(tableName string) error {
 	dbName := database.getDBName()
 	tbl, ok := database.tableCaches[tableName]
 	if !ok {
-		return errors.Errorf("%s.%s doesn't exists", dbName, tableName)
+		return errors.ErrorWithCode(errors.UNKNOWN_TABLE, "%s.%s doesn't exists", dbName, tableName)
 	}
 	tbl.storage.Close()
 	delete(database.tableCaches, tableName)
@@ -180,7 +163,7 @@ func (database *OnDiskDatabase) createDatabase() error {
 
 	// Check.
 	if _, err := GetDatabase(dbName); err == nil {
-		return errors.Errorf("database:%v exists", dbName)
+		return errors.ErrorWithCode(errors.DATABASE_ALREADY_EXISTS, "database:%v exists", dbName)
 	}
 
 	if err := os.MkdirAll(dataPath, os.ModePerm); err != nil {
@@ -58,6 +58,18 @@ func (database *SystemDatabase) Load() error {
 	if err := database.attachTable("numbers", storages.SystemNumbersStorageEngineName); err != nil {
 		return err
 	}
+	if err := database.attachTable("dictionaries", storages.SystemDictionariesStorageEngineName); err != nil {
+		return err
+	}
+	if err := database.attachTable("events", storages.SystemEventsStorageEngineName); err != nil {
+		return err
+	}
+	if err := database.attachTable("processes", storages.SystemProcessesStorageEngineName); err != nil {
+		return err
+	}
+	if err := database.attachTable("metrics", storages.SystemMetricsStorageEngineName); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -87,7 +99,7 @@ func (database *SystemDatabase) GetStorage(tableName string) (storages.IStorage,
 
 	table, ok := database.tableCaches[tableName]
 	if !ok {
-		return nil, errors.Errorf("Couldn't find table:%v storage", tableName)
+		return nil, errors.ErrorWithCode(errors.UNKNOWN_TABLE, "Couldn't find table:%v storage", tableName)
 	}
 	return table.storage, nil
 }
@@ -102,7 +114,7 @@ func (database *SystemDatabase) attachTable(tableName string, engine string) err
 	dbName := database.getDBName()
 
 	if _, ok := database.tableCaches[tableName]; ok {
-		return errors.Errorf("%s.%s exists", dbName, tableName)
+		return errors.ErrorWithCode(errors.TABLE_ALREADY_EXISTS, "%s.%s exists", dbName, tableName)
 	}
 
 	storageCtx := storages.NewStorageContext(ctx.log, ctx.conf)
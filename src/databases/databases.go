@@ -117,7 +117,7 @@ func (db *Databases) attachDatabase(dbname string, database IDatabase) error {
 	defer db.mu.Unlock()
 
 	if _, ok := db.databases[dbname]; ok {
-		return errors.Errorf("database:%s exists", dbname)
+		return errors.ErrorWithCode(errors.DATABASE_ALREADY_EXISTS, "database:%s exists", dbname)
 	}
 	db.databases[dbname] = database
 	return nil
@@ -128,7 +128,7 @@ func (db *Databases) detachDatabase(dbname string) error {
 	defer db.mu.Unlock()
 
 	if _, ok := db.databases[dbname]; !ok {
-		return errors.Errorf("database:%s doesn't exists", dbname)
+		return errors.ErrorWithCode(errors.UNKNOWN_DATABASE, "database:%s doesn't exists", dbname)
 	}
 	delete(db.databases, dbname)
 	return nil
@@ -139,7 +139,7 @@ func (db *Databases) getDatabase(dbname string) (IDatabase, error) {
 	defer db.mu.RUnlock()
 
 	if _, ok := db.databases[dbname]; !ok {
-		return nil, errors.Errorf("database:%s doesn't exists", dbname)
+		return nil, errors.ErrorWithCode(errors.UNKNOWN_DATABASE, "database:%s doesn't exists", dbname)
 	}
 	return db.databases[dbname], nil
 }
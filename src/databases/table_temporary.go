@@ -0,0 +1,59 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package databases
+
+import (
+	"strings"
+
+	"columns"
+	"datatypes"
+	"storages"
+
+	"base/errors"
+	"parsers/sqlparser"
+)
+
+// TableColumns builds the columns of the CREATE TABLE.
+func TableColumns(node *sqlparser.DDL) ([]*columns.Column, error) {
+	var colDefinitions []*sqlparser.ColumnDefinition
+	if err := sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.ColumnDefinition:
+			colDefinitions = append(colDefinitions, node)
+		}
+		return true, nil
+	}, node.TableSpec); err != nil {
+		return nil, err
+	}
+
+	cols := make([]*columns.Column, len(colDefinitions))
+	for i, coldef := range colDefinitions {
+		dataType, err := datatypes.DataTypeFactory(coldef.Type.Type)
+		if err != nil {
+			return nil, err
+		}
+		cols[i] = columns.NewColumn(coldef.Name.String(), dataType)
+	}
+	return cols, nil
+}
+
+// NewTemporaryStorage creates the storage of the CREATE TEMPORARY TABLE,
+// it belongs to the session not to a database so only the Memory engine is allowed.
+func NewTemporaryStorage(ctx *DatabaseContext, node *sqlparser.DDL) (storages.IStorage, error) {
+	engine := storages.MemoryStorageEngineName
+	if node.TableSpec.Options.Engine != "" {
+		engine = strings.ToUpper(node.TableSpec.Options.Engine)
+	}
+	if engine != storages.MemoryStorageEngineName {
+		return nil, errors.Errorf("Temporary table %s must be the Memory engine, got:%s", node.Table.Name.String(), node.TableSpec.Options.Engine)
+	}
+
+	cols, err := TableColumns(node)
+	if err != nil {
+		return nil, err
+	}
+	storageCtx := storages.NewStorageContext(ctx.log, ctx.conf)
+	return storages.StorageFactory(storageCtx, engine, cols)
+}
@@ -19,6 +19,9 @@ type DataBlock struct {
 	info       *DataBlockInfo
 	values     []*DataBlockValue
 	totalBytes uint64
+	// ownSeqs is whether the seqs are the block's own to give back by Release, not shared with another block.
+	ownSeqs  bool
+	released bool
 }
 
 func NewDataBlock(cols []*columns.Column) *DataBlock {
@@ -50,8 +53,8 @@ func (block *DataBlock) Clone() *DataBlock {
 func (block *DataBlock) DeepClone() *DataBlock {
 	clone := NewDataBlock(block.Columns())
 	clone.totalBytes = block.totalBytes
-	clone.seqs = make([]int, len(block.seqs))
-	copy(clone.seqs, block.seqs)
+	clone.seqs = append(getSeqs(len(block.seqs)), block.seqs...)
+	clone.ownSeqs = true
 
 	for i, value := range block.values {
 		clone.values[i] = value.DeepClone()
@@ -59,6 +62,35 @@ func (block *DataBlock) DeepClone() *DataBlock {
 	return clone
 }
 
+// DeepCloneColumns is DeepClone of the columns of the names only, in the order of the block.
+// The names the block doesn't have are ignored, the first column is kept if none is named for the rows.
+func (block *DataBlock) DeepCloneColumns(names []string) *DataBlock {
+	wanted := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		wanted[name] = struct{}{}
+	}
+	var values []*DataBlockValue
+	for _, value := range block.values {
+		if _, ok := wanted[value.column.Name]; ok {
+			values = append(values, value)
+		}
+	}
+	if len(values) == len(block.values) {
+		return block.DeepClone()
+	}
+	if len(values) == 0 && len(block.values) > 0 {
+		values = block.values[:1]
+	}
+
+	clone := newDataBlock(append(getSeqs(len(block.seqs)), block.seqs...), make([]*DataBlockValue, len(values)))
+	clone.ownSeqs = true
+	for i, value := range values {
+		clone.values[i] = value.DeepClone()
+		clone.totalBytes += value.totalBytes()
+	}
+	return clone
+}
+
 func (block *DataBlock) Info() *DataBlockInfo {
 	return block.info
 }
@@ -140,13 +172,71 @@ func (block *DataBlock) WriteRow(values []datavalues.IDataValue) error {
 
 	offset := len(block.values[0].values)
 	for i := 0; i < cols; i++ {
+		cv := block.values[i]
+		if cap(cv.values) == 0 {
+			cv.values, cv.owned = getValues(0), true
+		}
 		block.totalBytes += uint64(values[i].Size())
-		block.values[i].values = append(block.values[i].values, values[i])
+		cv.values = append(cv.values, values[i])
+	}
+	if cap(block.seqs) == 0 {
+		block.seqs, block.ownSeqs = getSeqs(0), true
 	}
 	block.seqs = append(block.seqs, offset)
 	return nil
 }
 
+// Materialize compacts the values to the rows of the seqs, in their order.
+// The filters and the limits only narrow the seqs and the values of the rows they drop are held until then,
+// so the blocks kept for long such as by the sort are compacted first.
+// The values may be shared with the blocks projected from the same one, they are copied rather than changed.
+// It returns false for the block which has all its rows in order already.
+func (block *DataBlock) Materialize() bool {
+	block.mu.Lock()
+	defer block.mu.Unlock()
+
+	if len(block.values) == 0 {
+		return false
+	}
+	if len(block.seqs) == len(block.values[0].values) {
+		identity := true
+		for i, seq := range block.seqs {
+			if seq != i {
+				identity = false
+				break
+			}
+		}
+		if identity {
+			return false
+		}
+	}
+
+	var totalBytes uint64
+	compacted := make([]*DataBlockValue, len(block.values))
+	for i, cv := range block.values {
+		values := getValues(len(block.seqs))
+		for _, seq := range block.seqs {
+			values = append(values, cv.values[seq])
+		}
+		compacted[i] = newDataBlockValueWithValues(cv.column, values)
+		compacted[i].owned = true
+		totalBytes += compacted[i].totalBytes()
+	}
+	block.values = compacted
+	block.seqs, block.ownSeqs = identitySeqs(len(block.seqs)), true
+	block.totalBytes = totalBytes
+	return true
+}
+
+// identitySeqs returns the seqs of all the rows in order, from the pool.
+func identitySeqs(rows int) []int {
+	seqs := getSeqs(rows)
+	for i := 0; i < rows; i++ {
+		seqs = append(seqs, i)
+	}
+	return seqs
+}
+
 func (block *DataBlock) Close() {
 	block.seqs = nil
 	block.values = nil
@@ -0,0 +1,56 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datablocks
+
+import (
+	"columns"
+	"datatypes"
+	"planners"
+)
+
+// CommonsByPlan returns the block with the columns of the commons appended, named by their expressions.
+// The commons are computed in order, one may be the variable of those before it.
+// It shares the seqs and the columns with the block, it's the block itself if there are no commons.
+func (block *DataBlock) CommonsByPlan(commons *planners.MapPlan) (*DataBlock, error) {
+	if commons == nil || commons.Length() == 0 {
+		return block, nil
+	}
+
+	if block.NumRows() == 0 {
+		// If empty, the columns of the commons are the header only.
+		exprs, err := planners.BuildExpressions(commons)
+		if err != nil {
+			return nil, err
+		}
+		columnValues := append([]*DataBlockValue{}, block.values...)
+		for _, expr := range exprs {
+			columnValues = append(columnValues, NewDataBlockValue(columns.NewColumn(expr.String(), datatypes.NewStringDataType())))
+		}
+		return newDataBlock(block.seqs, columnValues), nil
+	}
+
+	computed := block
+	for _, common := range commons.SubPlans {
+		fields, err := planners.BuildVariableValues(common)
+		if err != nil {
+			return nil, err
+		}
+		plan := planners.NewSelectionPlan(planners.NewMapPlan(common), planners.NewMapPlan())
+		if computed, err = computed.NormalSelectionByPlan(fields, plan); err != nil {
+			return nil, err
+		}
+	}
+	return computed, nil
+}
+
+// releaseCommons gives back the columns the commons appended to the block, which are not in it.
+func (block *DataBlock) releaseCommons(computed *DataBlock) {
+	if computed == block {
+		return
+	}
+	for _, cv := range computed.values[len(block.values):] {
+		cv.release()
+	}
+}
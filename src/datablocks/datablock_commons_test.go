@@ -0,0 +1,159 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datablocks
+
+import (
+	"testing"
+
+	"columns"
+	"datatypes"
+	"datavalues"
+	"planners"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func commonsTestRows(t testing.TB, block *DataBlock, names ...string) [][]datavalues.IDataValue {
+	it, err := block.MixsIterator(names)
+	assert.Nil(t, err)
+	var rows [][]datavalues.IDataValue
+	for it.Next() {
+		rows = append(rows, append([]datavalues.IDataValue{}, it.Value()...))
+	}
+	return rows
+}
+
+// newCommonsTestPlans returns the projects of the expensive expression repeated,
+// and the same projects with the expression as a common.
+func newCommonsTestPlans() (*planners.SelectionPlan, *planners.SelectionPlan) {
+	binary := planners.NewBinaryExpressionPlan
+	c0, c1 := planners.NewVariablePlan("c0"), planners.NewVariablePlan("c1")
+	constant := planners.NewConstantPlan
+
+	expensive := binary("*", binary("+", binary("*", c0, c1), binary("-", c0, c1)), binary("-", binary("*", c0, c1), binary("+", c0, c1)))
+	name := "(((c0*c1)+(c0-c1))*((c0*c1)-(c0+c1)))"
+	common := planners.NewVariablePlan(name)
+
+	repeated := planners.NewSelectionPlan(planners.NewMapPlan(
+		binary("+", expensive, constant(1)),
+		binary("+", expensive, constant(2)),
+		binary("+", expensive, constant(3)),
+	), planners.NewMapPlan())
+	shared := planners.NewSelectionPlan(planners.NewMapPlan(
+		binary("+", common, constant(1)),
+		binary("+", common, constant(2)),
+		binary("+", common, constant(3)),
+	), planners.NewMapPlan())
+	shared.Commons = planners.NewMapPlan(planners.NewAliasedExpressionPlan(name, expensive))
+	return repeated, shared
+}
+
+func TestCommonsByPlan(t *testing.T) {
+	binary := planners.NewBinaryExpressionPlan
+	c0, c1 := planners.NewVariablePlan("c0"), planners.NewVariablePlan("c1")
+
+	// The second common is of the variable of the first.
+	commons := planners.NewMapPlan(
+		planners.NewAliasedExpressionPlan("(c0+c1)", binary("+", c0, c1)),
+		planners.NewAliasedExpressionPlan("((c0+c1)*2)", binary("*", planners.NewVariablePlan("(c0+c1)"), planners.NewConstantPlan(2))),
+	)
+	block := newWideTestBlock(4, 2)
+	computed, err := block.CommonsByPlan(commons)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, block.NumColumns())
+	assert.Equal(t, 4, computed.NumColumns())
+	assert.Equal(t, 4, computed.NumRows())
+	for k, row := range commonsTestRows(t, computed, "(c0+c1)", "((c0+c1)*2)") {
+		assert.Equal(t, datavalues.MakeInt(int64(2*k+1)), row[0])
+		assert.Equal(t, datavalues.MakeInt(int64(4*k+2)), row[1])
+	}
+
+	// If empty, the columns of the commons are the header only.
+	empty := NewDataBlock([]*columns.Column{
+		{Name: "c0", DataType: datatypes.NewInt64DataType()},
+		{Name: "c1", DataType: datatypes.NewInt64DataType()},
+	})
+	computed, err = empty.CommonsByPlan(commons)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, computed.NumColumns())
+	assert.Equal(t, 0, computed.NumRows())
+
+	computed, err = block.CommonsByPlan(nil)
+	assert.Nil(t, err)
+	assert.True(t, computed == block)
+}
+
+func TestCommonsSelectionAndFilter(t *testing.T) {
+	repeated, shared := newCommonsTestPlans()
+	names := []string{
+		"((((c0*c1)+(c0-c1))*((c0*c1)-(c0+c1)))+1)",
+		"((((c0*c1)+(c0-c1))*((c0*c1)-(c0+c1)))+2)",
+		"((((c0*c1)+(c0-c1))*((c0*c1)-(c0+c1)))+3)",
+	}
+
+	block := newWideTestBlock(100, 2)
+	expect, err := block.NormalSelectionByPlan([]string{"c0", "c1"}, repeated)
+	assert.Nil(t, err)
+	fields, err := planners.BuildVariableValues(shared.Projects)
+	assert.Nil(t, err)
+	actual, err := block.NormalSelectionByPlan(fields, shared)
+	assert.Nil(t, err)
+	assert.Equal(t, commonsTestRows(t, expect, names...), commonsTestRows(t, actual, names...))
+
+	// The filter of the common gives its column back, the block keeps its columns.
+	common := planners.NewVariablePlan("(((c0*c1)+(c0-c1))*((c0*c1)-(c0+c1)))")
+	filter := planners.NewFilterPlan(planners.NewBinaryExpressionPlan("AND",
+		planners.NewBinaryExpressionPlan(">", common, planners.NewConstantPlan(400)),
+		planners.NewBinaryExpressionPlan("<", common, planners.NewConstantPlan(3000)),
+	))
+	filter.Commons = shared.Commons
+	fields, err = planners.BuildVariableValues(filter.SubPlan)
+	assert.Nil(t, err)
+	err = block.FilterByPlan(fields, filter)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, block.NumColumns())
+	// The common of the k is (k*(k+1)-1)*(k*(k+1)-(2k+1)), between 400 and 3000 for the k of 5 to 7.
+	assert.Equal(t, 3, block.NumRows())
+	for k, row := range commonsTestRows(t, block, "c0") {
+		assert.Equal(t, datavalues.MakeInt(int64(k+5)), row[0])
+	}
+}
+
+// BenchmarkSelectionRepeated is the selection of an expensive expression repeated in 3 projects, computed 3 times per row.
+func BenchmarkSelectionRepeated(b *testing.B) {
+	repeated, _ := newCommonsTestPlans()
+	block := newWideTestBlock(1<<14, 2)
+	fields := []string{"c0", "c1"}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		selected, err := block.NormalSelectionByPlan(fields, repeated)
+		if err != nil {
+			b.Fatal(err)
+		}
+		block.releaseCommons(selected)
+	}
+}
+
+// BenchmarkSelectionCommons is the same selection with the expression as a common, computed once per row.
+func BenchmarkSelectionCommons(b *testing.B) {
+	_, shared := newCommonsTestPlans()
+	block := newWideTestBlock(1<<14, 2)
+	fields, err := planners.BuildVariableValues(shared.Projects)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		selected, err := block.NormalSelectionByPlan(fields, shared)
+		if err != nil {
+			b.Fatal(err)
+		}
+		block.releaseCommons(selected)
+	}
+}
@@ -5,48 +5,244 @@
 package datablocks
 
 import (
+	"strings"
+
+	"columns"
 	"datavalues"
 	"expressions"
 	"planners"
 )
 
+// FilterByPlan narrows the seqs to the rows where the plan is true, the chained filters narrow them further.
+// The values are not copied, Materialize compacts them where the block is kept.
 func (block *DataBlock) FilterByPlan(fields []string, plan *planners.FilterPlan) error {
-	expr, err := planners.BuildExpression(plan.SubPlan)
+	// The predicate is evaluated on the block with the commons, they are given back after.
+	computed, err := block.CommonsByPlan(plan.Commons)
 	if err != nil {
 		return err
 	}
+	defer block.releaseCommons(computed)
+
+	// The conjuncts are evaluated in order, each one only for the rows the ones before keep.
+	// They are vectorized one by one, the others are evaluated row by row.
+	f := computed.newVectorFilter(fields)
+	sel := identityPositions(f.rows)
+	for _, conjunct := range planners.Conjuncts(plan.SubPlan) {
+		if len(sel) == 0 {
+			break
+		}
+		// The predicate narrows the positions in place, they are of the row by row if it fails.
+		next, ok := f.predicate(conjunct, append([]int(nil), sel...))
+		if !ok {
+			if next, err = computed.filterRows(fields, conjunct, sel); err != nil {
+				return err
+			}
+		}
+		sel = next
+	}
+
+	// In place filter of the selection.
+	seqs := block.seqs
+	for n, i := range sel {
+		seqs[n] = seqs[i]
+	}
+	block.mu.Lock()
+	block.seqs = seqs[:len(sel)]
+	block.mu.Unlock()
+	return nil
+}
 
-	i := 0
+// filter evaluates the expression row by row, it returns the positions of the rows kept.
+func (block *DataBlock) filter(fields []string, plan planners.IPlan) ([]int, error) {
+	return block.filterRows(fields, plan, identityPositions(block.NumRows()))
+}
+
+// filterRows evaluates the expression row by row for the positions of sel, in increasing order,
+// the other rows are skipped.
+func (block *DataBlock) filterRows(fields []string, plan planners.IPlan, sel []int) ([]int, error) {
+	expr, err := planners.BuildExpression(plan)
+	if err != nil {
+		return nil, err
+	}
+
+	i, j := 0, 0
 	params := make(expressions.Map)
-	checks := make([]datavalues.IDataValue, block.NumRows())
+	res := make([]int, 0, len(sel))
 	it, err := block.MixsIterator(fields)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	for it.Next() {
+	for j < len(sel) && it.Next() {
+		if sel[j] != i {
+			i++
+			continue
+		}
 		row := it.Value()
 		for k := range row {
 			params[it.Column(k).Name] = row[k]
 		}
 		v, err := expr.Update(params)
 		if err != nil {
-			return err
+			return nil, err
+		}
+		if datavalues.AsBool(v) {
+			res = append(res, i)
 		}
-		checks[i] = v
 		i++
+		j++
 	}
+	return res, nil
+}
 
-	// In place filter.
-	n := 0
-	seqs := block.seqs
-	for i, check := range checks {
-		if datavalues.AsBool(check) {
-			seqs[n] = seqs[i]
-			n++
+func identityPositions(n int) []int {
+	sel := make([]int, n)
+	for i := range sel {
+		sel[i] = i
+	}
+	return sel
+}
+
+// vectorizedFilter evaluates the comparisons, the integer and float arithmetic, the AND and the OR
+// on the typed slices of the columns, without a value per row.
+// It returns false for the other expressions and the columns of another type such as with a NULL,
+// the expression is evaluated row by row then.
+func (block *DataBlock) vectorizedFilter(fields []string, plan planners.IPlan) ([]int, bool) {
+	f := block.newVectorFilter(fields)
+	return f.predicate(plan, identityPositions(f.rows))
+}
+
+func (block *DataBlock) newVectorFilter(fields []string) *vectorFilter {
+	f := &vectorFilter{
+		block:   block,
+		fields:  make(map[string]struct{}, len(fields)),
+		vectors: make(map[string]*vector),
+		rows:    len(block.seqs),
+	}
+	for _, field := range fields {
+		f.fields[field] = struct{}{}
+	}
+	return f
+}
+
+type vectorFilter struct {
+	block   *DataBlock
+	fields  map[string]struct{}
+	vectors map[string]*vector
+	rows    int
+}
+
+// predicate keeps the rows of sel where the plan is true.
+func (f *vectorFilter) predicate(plan planners.IPlan, sel []int) ([]int, bool) {
+	binary, ok := plan.(*planners.BinaryExpressionPlan)
+	if !ok {
+		return nil, false
+	}
+
+	name := strings.ToUpper(binary.FuncName)
+	switch name {
+	case "AND":
+		if sel, ok = f.predicate(binary.Left, sel); !ok {
+			return nil, false
 		}
+		return f.predicate(binary.Right, sel)
+	case "OR":
+		left, ok := f.predicate(binary.Left, append([]int(nil), sel...))
+		if !ok {
+			return nil, false
+		}
+		// The right one is only for the rows the left one doesn't keep.
+		right, ok := f.predicate(binary.Right, selectNot(sel, left))
+		if !ok {
+			return nil, false
+		}
+		return selectOr(sel, left, right, f.rows), true
 	}
-	block.mu.Lock()
-	block.seqs = seqs[:n]
-	block.mu.Unlock()
-	return nil
+
+	op, ok := cmpOps[name]
+	if !ok {
+		return nil, false
+	}
+	left, ok := f.value(binary.Left, sel)
+	if !ok {
+		return nil, false
+	}
+	right, ok := f.value(binary.Right, sel)
+	if !ok {
+		return nil, false
+	}
+	if !(left.isInt() && right.isInt()) && left.typ != right.typ {
+		return nil, false
+	}
+	return selectCompare(op, left, right, sel), true
+}
+
+// value evaluates the plan for the rows of sel, the columns are taken once.
+func (f *vectorFilter) value(plan planners.IPlan, sel []int) (*vector, bool) {
+	switch plan := plan.(type) {
+	case *planners.VariablePlan:
+		if _, ok := f.fields[plan.Value]; !ok {
+			return nil, false
+		}
+		if v, ok := f.vectors[plan.Value]; ok {
+			return v, true
+		}
+		cv, err := f.block.DataBlockValue(plan.Value)
+		if err != nil || f.rows == 0 {
+			return nil, false
+		}
+		v, ok := newVector(cv.values, f.block.seqs)
+		if !ok {
+			return nil, false
+		}
+		f.vectors[plan.Value] = v
+		return v, true
+	case *planners.ConstantPlan:
+		value, err := datavalues.TryToValue(plan.Value)
+		if err != nil {
+			return nil, false
+		}
+		v, ok := newVector([]datavalues.IDataValue{value}, nil)
+		if !ok {
+			return nil, false
+		}
+		v.scalar = true
+		return v, true
+	case *planners.BinaryExpressionPlan:
+		switch plan.FuncName {
+		case "+", "-", "*", "/":
+			left, ok := f.value(plan.Left, sel)
+			if !ok {
+				return nil, false
+			}
+			right, ok := f.value(plan.Right, sel)
+			if !ok {
+				return nil, false
+			}
+			return arithmetic(plan.FuncName, left, right, sel, f.rows)
+		}
+	}
+	return nil, false
+}
+
+// newVector takes the values at the seqs by the type of the first one.
+func newVector(values []datavalues.IDataValue, seqs []int) (*vector, bool) {
+	first := values[0]
+	if seqs != nil {
+		first = values[seqs[0]]
+	}
+	if first == nil {
+		return nil, false
+	}
+
+	var ok bool
+	v := &vector{typ: first.Type()}
+	switch v.typ {
+	case datavalues.TypeInt, datavalues.TypeInt32:
+		v.ints, ok = columns.AsInt64Slice(values, seqs)
+	case datavalues.TypeFloat:
+		v.floats, ok = columns.AsFloat64Slice(values, seqs)
+	case datavalues.TypeString:
+		v.strs, ok = columns.AsStringSlice(values, seqs)
+	}
+	return v, ok
 }
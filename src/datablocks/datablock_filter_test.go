@@ -0,0 +1,188 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datablocks
+
+import (
+	"math"
+	"testing"
+
+	"columns"
+	"datatypes"
+	"datavalues"
+	"planners"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newFilterTestBlock(rows int) *DataBlock {
+	block := NewDataBlock([]*columns.Column{
+		{Name: "i", DataType: datatypes.NewInt64DataType()},
+		{Name: "j", DataType: datatypes.NewInt32DataType()},
+		{Name: "f", DataType: datatypes.NewFloat64DataType()},
+		{Name: "s", DataType: datatypes.NewStringDataType()},
+	})
+	for k := 0; k < rows; k++ {
+		f := float64(k) / 2
+		if k%7 == 0 {
+			f = math.NaN()
+		}
+		block.WriteRow([]datavalues.IDataValue{
+			datavalues.MakeInt(int64(k)),
+			datavalues.MakeInt32(int32(math.MaxInt32 - k)),
+			datavalues.MakeFloat(f),
+			datavalues.MakeString(string(rune('a' + k%26))),
+		})
+	}
+	return block
+}
+
+func TestVectorizedFilter(t *testing.T) {
+	binary := planners.NewBinaryExpressionPlan
+	i, j, f, s := planners.NewVariablePlan("i"), planners.NewVariablePlan("j"), planners.NewVariablePlan("f"), planners.NewVariablePlan("s")
+	constant := planners.NewConstantPlan
+
+	tests := []struct {
+		name       string
+		plan       planners.IPlan
+		vectorized bool
+	}{
+		{name: "and", plan: binary("AND", binary(">", i, constant(5)), binary("<", i, constant(100))), vectorized: true},
+		{name: "or", plan: binary("OR", binary("<=", i, constant(3)), binary(">=", i, constant(90))), vectorized: true},
+		{name: "constant-left", plan: binary("<", constant(50), i), vectorized: true},
+		{name: "constants", plan: binary("=", constant(1), constant(1)), vectorized: true},
+		{name: "columns", plan: binary("!=", i, binary("-", i, constant(0))), vectorized: true},
+		{name: "int32-overflow", plan: binary("<", binary("+", j, constant(10)), constant(0)), vectorized: true},
+		{name: "float-nan", plan: binary("OR", binary("=", f, constant(1.5)), binary(">=", f, constant(40.0))), vectorized: true},
+		{name: "float-arithmetic", plan: binary("<>", binary("*", f, constant(2.0)), binary("/", i, constant(1))), vectorized: true},
+		{name: "string", plan: binary("AND", binary(">", s, constant("w")), binary("<>", s, constant("y"))), vectorized: true},
+		{name: "int-float", plan: binary(">", i, constant(2.5))},
+		{name: "like", plan: binary("LIKE", s, constant("a%"))},
+		{name: "or-like", plan: binary("OR", binary("LIKE", s, constant("a%")), binary(">", i, constant(5)))},
+	}
+
+	fields := []string{"i", "j", "f", "s"}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			block := newFilterTestBlock(100)
+			expect, err := block.filter(fields, test.plan)
+			if test.vectorized {
+				assert.Nil(t, err)
+			}
+
+			actual, ok := block.vectorizedFilter(fields, test.plan)
+			assert.Equal(t, test.vectorized, ok)
+			if ok {
+				assert.Equal(t, expect, actual)
+			}
+		})
+	}
+
+	// The NULL falls back to the row by row filter.
+	block := newFilterTestBlock(10)
+	block.WriteRow([]datavalues.IDataValue{datavalues.MakeNull(), datavalues.MakeInt32(1), datavalues.MakeFloat(1), datavalues.MakeString("")})
+	_, ok := block.vectorizedFilter(fields, binary(">", i, constant(5)))
+	assert.False(t, ok)
+
+	// The seqs are filtered in place.
+	block = newFilterTestBlock(10)
+	assert.Nil(t, block.FilterByPlan(fields, planners.NewFilterPlan(binary(">", i, constant(6)))))
+	assert.Equal(t, []int{7, 8, 9}, block.seqs)
+	assert.Nil(t, block.FilterByPlan(fields, planners.NewFilterPlan(binary("<", i, constant(9)))))
+	assert.Equal(t, []int{7, 8}, block.seqs)
+}
+
+func TestFilterConjuncts(t *testing.T) {
+	binary := planners.NewBinaryExpressionPlan
+	i, s := planners.NewVariablePlan("i"), planners.NewVariablePlan("s")
+	constant := planners.NewConstantPlan
+	fields := []string{"i", "j", "f", "s"}
+
+	// The last row can't compare its s, the string one fails all over.
+	block := newFilterTestBlock(10)
+	block.WriteRow([]datavalues.IDataValue{datavalues.MakeInt(100), datavalues.MakeInt32(1), datavalues.MakeFloat(1), datavalues.MakeInt(1)})
+	plan := binary("AND", binary("<", i, constant(5)), binary("<", s, constant("c")))
+	_, err := block.filter(fields, plan)
+	assert.NotNil(t, err)
+
+	// The row by row conjunct is only for the rows the vectorized one keeps.
+	assert.Nil(t, block.FilterByPlan(fields, planners.NewFilterPlan(plan)))
+	assert.Equal(t, []int{0, 1}, block.seqs)
+
+	// The mixed conjuncts keep the rows as the whole expression does.
+	for _, plan := range []planners.IPlan{
+		binary("AND", binary("LIKE", s, constant("%")), binary(">", i, constant(20))),
+		binary("AND", binary(">", i, constant(20)), binary("AND", binary("LIKE", s, constant("b%")), binary("<", i, constant(80)))),
+		binary("AND", binary("<", i, constant(50)), binary("OR", binary("LIKE", s, constant("a%")), binary("=", i, constant(3)))),
+	} {
+		block := newFilterTestBlock(100)
+		expect, err := block.filter(fields, plan)
+		assert.Nil(t, err)
+		assert.Nil(t, block.FilterByPlan(fields, planners.NewFilterPlan(plan)))
+		assert.Equal(t, expect, block.seqs)
+	}
+}
+
+var filterBenchmarkPlan = planners.NewBinaryExpressionPlan("AND",
+	planners.NewBinaryExpressionPlan(">", planners.NewVariablePlan("i"), planners.NewConstantPlan(5)),
+	planners.NewBinaryExpressionPlan("<", planners.NewVariablePlan("i"), planners.NewConstantPlan(100)),
+)
+
+func BenchmarkFilterVectorized(b *testing.B) {
+	block := newFilterTestBlock(1 << 16)
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		if _, ok := block.vectorizedFilter([]string{"i"}, filterBenchmarkPlan); !ok {
+			b.Fatal("not vectorized")
+		}
+	}
+}
+
+func BenchmarkFilterRowByRow(b *testing.B) {
+	block := newFilterTestBlock(1 << 16)
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		if _, err := block.filter([]string{"i"}, filterBenchmarkPlan); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestFilterSelection(t *testing.T) {
+	binary := planners.NewBinaryExpressionPlan
+	i := planners.NewVariablePlan("i")
+	constant := planners.NewConstantPlan
+	fields := []string{"i"}
+
+	block := newFilterTestBlock(100)
+	values := block.values[0].values
+	totalBytes := block.TotalBytes()
+
+	// The chained filters narrow the same seqs, the values are not copied.
+	assert.Nil(t, block.FilterByPlan(fields, planners.NewFilterPlan(binary(">=", i, constant(10)))))
+	assert.Nil(t, block.FilterByPlan(fields, planners.NewFilterPlan(binary("<", i, constant(15)))))
+	assert.Equal(t, []int{10, 11, 12, 13, 14}, block.seqs)
+	assert.Equal(t, 100, len(block.values[0].values))
+	assert.True(t, &values[0] == &block.values[0].values[0])
+	assert.Equal(t, totalBytes, block.TotalBytes())
+
+	// Materialize compacts the selected rows, the projected block keeps the values it shares.
+	projected, err := block.ProjectionByPlan(planners.NewMapPlan(i))
+	assert.Nil(t, err)
+	assert.True(t, block.Materialize())
+	assert.Equal(t, []int{0, 1, 2, 3, 4}, block.seqs)
+	assert.Equal(t, 5, len(block.values[0].values))
+	assert.True(t, block.TotalBytes() < totalBytes)
+	assert.Equal(t, 100, len(projected.values[0].values))
+
+	var rows []int64
+	it := block.RowIterator()
+	for it.Next() {
+		rows = append(rows, datavalues.AsInt(it.Value()[0]))
+	}
+	assert.Equal(t, []int64{10, 11, 12, 13, 14}, rows)
+
+	// Nothing to compact once all the rows are in order.
+	assert.False(t, block.Materialize())
+}
@@ -0,0 +1,424 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datablocks
+
+import (
+	"datavalues"
+)
+
+// The kernels below evaluate the typed vectors for the rows of the selection vector,
+// the positions of the rows in the block order kept in increasing order.
+// The comparisons keep the selected rows in place and return them,
+// they match Compare of the values, the NaN is neither greater nor less than the others.
+
+// vector is a column computed by the vectorized filter, one element per row of the block.
+// The scalar one is a constant with its only element at index 0.
+type vector struct {
+	typ    datavalues.Type
+	ints   []int64
+	floats []float64
+	strs   []string
+	scalar bool
+}
+
+func (v *vector) isInt() bool {
+	return v.typ == datavalues.TypeInt || v.typ == datavalues.TypeInt32
+}
+
+type cmpOp int
+
+const (
+	opEQ cmpOp = iota
+	opNE
+	opGT
+	opGE
+	opLT
+	opLE
+)
+
+var cmpOps = map[string]cmpOp{
+	"=":  opEQ,
+	"!=": opNE,
+	"<>": opNE,
+	">":  opGT,
+	">=": opGE,
+	"<":  opLT,
+	"<=": opLE,
+}
+
+// flip is the op with the operands swapped, 1 < x is x > 1.
+func (op cmpOp) flip() cmpOp {
+	switch op {
+	case opGT:
+		return opLT
+	case opGE:
+		return opLE
+	case opLT:
+		return opGT
+	case opLE:
+		return opGE
+	}
+	return op
+}
+
+// selectCompare keeps the rows where a op b, the scalar a is swapped to the right.
+func selectCompare(op cmpOp, a, b *vector, sel []int) []int {
+	if a.scalar && !b.scalar {
+		a, b, op = b, a, op.flip()
+	}
+	if a.scalar {
+		// Both constant, all the rows or none.
+		if compareAt(op, a, b, 0) {
+			return sel
+		}
+		return sel[:0]
+	}
+
+	switch {
+	case a.isInt():
+		if b.scalar {
+			return selectInt64Scalar(op, a.ints, b.ints[0], sel)
+		}
+		return selectInt64(op, a.ints, b.ints, sel)
+	case a.typ == datavalues.TypeFloat:
+		if b.scalar {
+			return selectFloat64Scalar(op, a.floats, b.floats[0], sel)
+		}
+		return selectFloat64(op, a.floats, b.floats, sel)
+	}
+
+	n := 0
+	for _, i := range sel {
+		if compareAt(op, a, b, i) {
+			sel[n] = i
+			n++
+		}
+	}
+	return sel[:n]
+}
+
+// compareAt is the comparison of one row, for the strings and the constants.
+func compareAt(op cmpOp, a, b *vector, i int) bool {
+	ai, bi := i, i
+	if a.scalar {
+		ai = 0
+	}
+	if b.scalar {
+		bi = 0
+	}
+
+	var gt, lt bool
+	switch {
+	case a.isInt():
+		gt, lt = a.ints[ai] > b.ints[bi], a.ints[ai] < b.ints[bi]
+	case a.typ == datavalues.TypeFloat:
+		gt, lt = a.floats[ai] > b.floats[bi], a.floats[ai] < b.floats[bi]
+	default:
+		gt, lt = a.strs[ai] > b.strs[bi], a.strs[ai] < b.strs[bi]
+	}
+	switch op {
+	case opEQ:
+		return !gt && !lt
+	case opNE:
+		return gt || lt
+	case opGT:
+		return gt
+	case opGE:
+		return !lt
+	case opLT:
+		return lt
+	}
+	return !gt
+}
+
+func selectInt64Scalar(op cmpOp, a []int64, y int64, sel []int) []int {
+	n := 0
+	switch op {
+	case opEQ:
+		for _, i := range sel {
+			if a[i] == y {
+				sel[n] = i
+				n++
+			}
+		}
+	case opNE:
+		for _, i := range sel {
+			if a[i] != y {
+				sel[n] = i
+				n++
+			}
+		}
+	case opGT:
+		for _, i := range sel {
+			if a[i] > y {
+				sel[n] = i
+				n++
+			}
+		}
+	case opGE:
+		for _, i := range sel {
+			if a[i] >= y {
+				sel[n] = i
+				n++
+			}
+		}
+	case opLT:
+		for _, i := range sel {
+			if a[i] < y {
+				sel[n] = i
+				n++
+			}
+		}
+	case opLE:
+		for _, i := range sel {
+			if a[i] <= y {
+				sel[n] = i
+				n++
+			}
+		}
+	}
+	return sel[:n]
+}
+
+func selectInt64(op cmpOp, a []int64, b []int64, sel []int) []int {
+	n := 0
+	switch op {
+	case opEQ:
+		for _, i := range sel {
+			if a[i] == b[i] {
+				sel[n] = i
+				n++
+			}
+		}
+	case opNE:
+		for _, i := range sel {
+			if a[i] != b[i] {
+				sel[n] = i
+				n++
+			}
+		}
+	case opGT:
+		for _, i := range sel {
+			if a[i] > b[i] {
+				sel[n] = i
+				n++
+			}
+		}
+	case opGE:
+		for _, i := range sel {
+			if a[i] >= b[i] {
+				sel[n] = i
+				n++
+			}
+		}
+	case opLT:
+		for _, i := range sel {
+			if a[i] < b[i] {
+				sel[n] = i
+				n++
+			}
+		}
+	case opLE:
+		for _, i := range sel {
+			if a[i] <= b[i] {
+				sel[n] = i
+				n++
+			}
+		}
+	}
+	return sel[:n]
+}
+
+// The float ones write >= as not <, so the NaN is equal to all as in ValueFloat.Compare.
+func selectFloat64Scalar(op cmpOp, a []float64, y float64, sel []int) []int {
+	n := 0
+	switch op {
+	case opEQ:
+		for _, i := range sel {
+			if !(a[i] > y) && !(a[i] < y) {
+				sel[n] = i
+				n++
+			}
+		}
+	case opNE:
+		for _, i := range sel {
+			if a[i] > y || a[i] < y {
+				sel[n] = i
+				n++
+			}
+		}
+	case opGT:
+		for _, i := range sel {
+			if a[i] > y {
+				sel[n] = i
+				n++
+			}
+		}
+	case opGE:
+		for _, i := range sel {
+			if !(a[i] < y) {
+				sel[n] = i
+				n++
+			}
+		}
+	case opLT:
+		for _, i := range sel {
+			if a[i] < y {
+				sel[n] = i
+				n++
+			}
+		}
+	case opLE:
+		for _, i := range sel {
+			if !(a[i] > y) {
+				sel[n] = i
+				n++
+			}
+		}
+	}
+	return sel[:n]
+}
+
+func selectFloat64(op cmpOp, a []float64, b []float64, sel []int) []int {
+	n := 0
+	switch op {
+	case opEQ:
+		for _, i := range sel {
+			if !(a[i] > b[i]) && !(a[i] < b[i]) {
+				sel[n] = i
+				n++
+			}
+		}
+	case opNE:
+		for _, i := range sel {
+			if a[i] > b[i] || a[i] < b[i] {
+				sel[n] = i
+				n++
+			}
+		}
+	case opGT:
+		for _, i := range sel {
+			if a[i] > b[i] {
+				sel[n] = i
+				n++
+			}
+		}
+	case opGE:
+		for _, i := range sel {
+			if !(a[i] < b[i]) {
+				sel[n] = i
+				n++
+			}
+		}
+	case opLT:
+		for _, i := range sel {
+			if a[i] < b[i] {
+				sel[n] = i
+				n++
+			}
+		}
+	case opLE:
+		for _, i := range sel {
+			if !(a[i] > b[i]) {
+				sel[n] = i
+				n++
+			}
+		}
+	}
+	return sel[:n]
+}
+
+// selectOr keeps the rows of sel in either of the selections, through a bitmap of the rows.
+func selectOr(sel []int, left []int, right []int, rows int) []int {
+	bitmap := make([]uint64, (rows+63)/64)
+	for _, i := range left {
+		bitmap[i/64] |= 1 << uint(i%64)
+	}
+	for _, i := range right {
+		bitmap[i/64] |= 1 << uint(i%64)
+	}
+
+	n := 0
+	for _, i := range sel {
+		if bitmap[i/64]&(1<<uint(i%64)) != 0 {
+			sel[n] = i
+			n++
+		}
+	}
+	return sel[:n]
+}
+
+// selectNot returns the rows of sel not in the subset, both in increasing order.
+func selectNot(sel []int, subset []int) []int {
+	res := make([]int, 0, len(sel)-len(subset))
+	j := 0
+	for _, i := range sel {
+		if j < len(subset) && subset[j] == i {
+			j++
+			continue
+		}
+		res = append(res, i)
+	}
+	return res
+}
+
+// arithmetic computes a op b for the selected rows, as Add/Sub/Mul/Div of the values:
+// the integers keep the type of the left one, the division of them is Float.
+// It returns false for the operands Add and the others don't compute alike.
+func arithmetic(op string, a, b *vector, sel []int, rows int) (*vector, bool) {
+	scalar := a.scalar && b.scalar
+	if scalar {
+		sel, rows = []int{0}, 1
+	}
+	at := func(v *vector, i int) int {
+		if v.scalar {
+			return 0
+		}
+		return i
+	}
+
+	switch {
+	case a.isInt() && b.isInt() && op == "/":
+		res := &vector{typ: datavalues.TypeFloat, floats: make([]float64, rows), scalar: scalar}
+		for _, i := range sel {
+			res.floats[i] = float64(a.ints[at(a, i)]) / float64(b.ints[at(b, i)])
+		}
+		return res, true
+	case a.isInt() && b.isInt():
+		res := &vector{typ: a.typ, ints: make([]int64, rows), scalar: scalar}
+		for _, i := range sel {
+			x, y := a.ints[at(a, i)], b.ints[at(b, i)]
+			switch op {
+			case "+":
+				res.ints[i] = x + y
+			case "-":
+				res.ints[i] = x - y
+			case "*":
+				res.ints[i] = x * y
+			}
+			if res.typ == datavalues.TypeInt32 {
+				res.ints[i] = int64(int32(res.ints[i]))
+			}
+		}
+		return res, true
+	case a.typ == datavalues.TypeFloat && b.typ == datavalues.TypeFloat:
+		res := &vector{typ: datavalues.TypeFloat, floats: make([]float64, rows), scalar: scalar}
+		for _, i := range sel {
+			x, y := a.floats[at(a, i)], b.floats[at(b, i)]
+			switch op {
+			case "+":
+				res.floats[i] = x + y
+			case "-":
+				res.floats[i] = x - y
+			case "*":
+				res.floats[i] = x * y
+			case "/":
+				res.floats[i] = x / y
+			}
+		}
+		return res, true
+	}
+	return nil, false
+}
@@ -0,0 +1,99 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datablocks
+
+import (
+	"base/binary"
+	"base/errors"
+	"columns"
+	"datatypes"
+)
+
+// WriteNative writes the block in the Native format, without the block info:
+// the columns and rows count, then per column the name, the type name and the values.
+func (block *DataBlock) WriteNative(writer *binary.Writer) error {
+	// NumColumns.
+	if err := writer.Uvarint(uint64(block.NumColumns())); err != nil {
+		return errors.Wrap(err)
+	}
+	// NumRows.
+	if err := writer.Uvarint(uint64(block.NumRows())); err != nil {
+		return errors.Wrap(err)
+	}
+
+	// Values.
+	for _, it := range block.ColumnIterators() {
+		column := it.Column()
+		datatype := column.DataType
+
+		// Column name.
+		if err := writer.String(column.Name); err != nil {
+			return errors.Wrap(err)
+		}
+
+		// Datatype name.
+		if err := writer.String(datatype.Name()); err != nil {
+			return errors.Wrap(err)
+		}
+
+		for it.Next() {
+			// Data serialize.
+			if err := datatype.Serialize(writer, it.Value()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ReadNative reads the block written by WriteNative, the block of no columns is nil.
+func ReadNative(reader *binary.Reader) (*DataBlock, error) {
+	var err error
+	var numRows uint64
+	var numColumns uint64
+
+	// NumColumns.
+	if numColumns, err = reader.Uvarint(); err != nil {
+		return nil, errors.Wrap(err)
+	}
+	// NumRows.
+	if numRows, err = reader.Uvarint(); err != nil {
+		return nil, errors.Wrap(err)
+	}
+
+	// The columns are read into the buffers of the pool, the block owns them.
+	block := newDataBlock(nil, make([]*DataBlockValue, numColumns))
+	for i := 0; i < int(numColumns); i++ {
+		colName, err := reader.String()
+		if err != nil {
+			return nil, err
+		}
+		typeName, err := reader.String()
+		if err != nil {
+			return nil, err
+		}
+		dt, err := datatypes.DataTypeFactory(typeName)
+		if err != nil {
+			return nil, err
+		}
+		values := getValues(int(numRows))
+		for j := 0; j < int(numRows); j++ {
+			val, err := dt.Deserialize(reader)
+			if err != nil {
+				return nil, err
+			}
+			block.totalBytes += uint64(val.Size())
+			values = append(values, val)
+		}
+		block.values[i] = newDataBlockValueWithValues(columns.NewColumn(colName, dt), values)
+		block.values[i].owned = true
+	}
+
+	if numColumns > 0 {
+		block.seqs, block.ownSeqs = identitySeqs(int(numRows)), true
+		return block, nil
+	}
+	return nil, nil
+}
@@ -18,14 +18,9 @@ import (
 func (block *DataBlock) OrderByPlan(fields []string, plan *planners.OrderByPlan) error {
 	defer expvar.Get(metric_datablock_filter_sec).(metric.Metric).Record(time.Now())
 
-	// Build the orderby to IExpression.
-	exprs := make([]expressions.IExpression, len(plan.Orders))
-	for i, order := range plan.Orders {
-		expr, err := planners.BuildExpression(order.Expression)
-		if err != nil {
-			return err
-		}
-		exprs[i] = expr
+	cmp, err := newOrderByComparator(fields, plan)
+	if err != nil {
+		return err
 	}
 
 	// Orderby column value.
@@ -62,45 +57,10 @@ func (block *DataBlock) OrderByPlan(fields []string, plan *planners.OrderByPlan)
 		return err
 	}
 
-	// Params.
-	iparams := make(expressions.Map, len(fields))
-	jparams := make(expressions.Map, len(fields))
-
 	// Sort.
 	matrix := datavalues.AsSlice(result)
 	sort.Slice(matrix[:], func(i, j int) bool {
-		irows := datavalues.AsSlice(matrix[i])
-		jrows := datavalues.AsSlice(matrix[j])
-		for k := 0; k < len(fields); k++ {
-			iparams[fields[k]] = irows[k]
-			jparams[fields[k]] = jrows[k]
-		}
-
-		for k, order := range plan.Orders {
-			ival, err := exprs[k].Update(iparams)
-			if err != nil {
-				return false
-			}
-			jval, err := exprs[k].Update(jparams)
-			if err != nil {
-				return false
-			}
-
-			cmp, err := ival.Compare(jval)
-			if err != nil {
-				return false
-			}
-			if cmp == datavalues.Equal {
-				continue
-			}
-			switch order.Direction {
-			case "desc":
-				return cmp == datavalues.GreaterThan
-			default:
-				return cmp == datavalues.LessThan
-			}
-		}
-		return false
+		return cmp.less(datavalues.AsSlice(matrix[i]), datavalues.AsSlice(matrix[j]))
 	})
 
 	// Final.
@@ -111,3 +71,67 @@ func (block *DataBlock) OrderByPlan(fields []string, plan *planners.OrderByPlan)
 	block.seqs = finalSeqs
 	return nil
 }
+
+// orderByComparator compares the rows of the fields values by the orders of the plan,
+// the sort of a block and the merge of the sorted runs order the rows the same.
+type orderByComparator struct {
+	fields  []string
+	orders  []planners.Order
+	exprs   []expressions.IExpression
+	iparams expressions.Map
+	jparams expressions.Map
+}
+
+func newOrderByComparator(fields []string, plan *planners.OrderByPlan) (*orderByComparator, error) {
+	// Build the orderby to IExpression.
+	exprs := make([]expressions.IExpression, len(plan.Orders))
+	for i, order := range plan.Orders {
+		expr, err := planners.BuildExpression(order.Expression)
+		if err != nil {
+			return nil, err
+		}
+		exprs[i] = expr
+	}
+	return &orderByComparator{
+		fields:  fields,
+		orders:  plan.Orders,
+		exprs:   exprs,
+		iparams: make(expressions.Map, len(fields)),
+		jparams: make(expressions.Map, len(fields)),
+	}, nil
+}
+
+// less is whether the row i sorts before the row j, the rows start with the values of the fields.
+// The values failing to evaluate or to compare are not less.
+func (c *orderByComparator) less(irow []datavalues.IDataValue, jrow []datavalues.IDataValue) bool {
+	for k, field := range c.fields {
+		c.iparams[field] = irow[k]
+		c.jparams[field] = jrow[k]
+	}
+
+	for k, order := range c.orders {
+		ival, err := c.exprs[k].Update(c.iparams)
+		if err != nil {
+			return false
+		}
+		jval, err := c.exprs[k].Update(c.jparams)
+		if err != nil {
+			return false
+		}
+
+		cmp, err := datavalues.CompareWithCollation(ival, jval, order.Collation)
+		if err != nil {
+			return false
+		}
+		if cmp == datavalues.Equal {
+			continue
+		}
+		switch order.Direction {
+		case "desc":
+			return cmp == datavalues.GreaterThan
+		default:
+			return cmp == datavalues.LessThan
+		}
+	}
+	return false
+}
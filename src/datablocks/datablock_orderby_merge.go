@@ -0,0 +1,157 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datablocks
+
+import (
+	"container/heap"
+
+	"base/errors"
+	"columns"
+	"datavalues"
+	"planners"
+)
+
+// OrderByMerger merges the runs sorted by OrderByPlan into the blocks of all the rows in order,
+// it holds one block of each run at a time. The rows equal by the orders come in the order of the runs.
+type OrderByMerger struct {
+	cmp       *orderByComparator
+	cols      []*columns.Column
+	blockSize int
+	cursors   mergeCursors
+}
+
+// mergeCursor is the row of a run the merge is at.
+type mergeCursor struct {
+	run   int
+	next  func() (*DataBlock, error)
+	it    *DataBlockRowIterator
+	row   []datavalues.IDataValue
+	key   []datavalues.IDataValue
+	index []int
+}
+
+// NewOrderByMerger creates the merger of the runs, each next returns the next block of its run and nil at the end.
+func NewOrderByMerger(fields []string, plan *planners.OrderByPlan, runs []func() (*DataBlock, error), blockSize int) (*OrderByMerger, error) {
+	cmp, err := newOrderByComparator(fields, plan)
+	if err != nil {
+		return nil, err
+	}
+
+	merger := &OrderByMerger{
+		cmp:       cmp,
+		blockSize: blockSize,
+	}
+	merger.cursors.cmp = cmp
+	for i, next := range runs {
+		cursor := &mergeCursor{run: i, next: next}
+		ok, err := merger.advance(cursor)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			merger.cursors.items = append(merger.cursors.items, cursor)
+		}
+	}
+	heap.Init(&merger.cursors)
+	return merger, nil
+}
+
+// advance moves the cursor to the next row of its run, false at the end of the run.
+func (m *OrderByMerger) advance(cursor *mergeCursor) (bool, error) {
+	for cursor.it == nil || !cursor.it.Next() {
+		block, err := cursor.next()
+		if err != nil {
+			return false, err
+		}
+		if block == nil {
+			return false, nil
+		}
+		if m.cols == nil {
+			m.cols = block.Columns()
+		}
+		if cursor.index == nil {
+			cursor.index = make([]int, len(m.cmp.fields))
+			for i, field := range m.cmp.fields {
+				cursor.index[i] = -1
+				for j, col := range block.Columns() {
+					if col.Name == field {
+						cursor.index[i] = j
+					}
+				}
+				if cursor.index[i] < 0 {
+					return false, errors.Errorf("Can't find column:%v", field)
+				}
+			}
+			cursor.key = make([]datavalues.IDataValue, len(m.cmp.fields))
+		}
+		cursor.it = block.RowIterator()
+	}
+	cursor.row = cursor.it.Value()
+	for i, j := range cursor.index {
+		cursor.key[i] = cursor.row[j]
+	}
+	return true, nil
+}
+
+// Next returns the next block of up to the block size rows, nil after the last one.
+func (m *OrderByMerger) Next() (*DataBlock, error) {
+	if m.cursors.Len() == 0 {
+		return nil, nil
+	}
+
+	block := NewDataBlock(m.cols)
+	for block.NumRows() < m.blockSize && m.cursors.Len() > 0 {
+		cursor := m.cursors.items[0]
+		if err := block.WriteRow(cursor.row); err != nil {
+			return nil, err
+		}
+		ok, err := m.advance(cursor)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			heap.Fix(&m.cursors, 0)
+		} else {
+			heap.Pop(&m.cursors)
+		}
+	}
+	return block, nil
+}
+
+// mergeCursors is the heap of the cursors by their rows.
+type mergeCursors struct {
+	cmp   *orderByComparator
+	items []*mergeCursor
+}
+
+func (c *mergeCursors) Len() int {
+	return len(c.items)
+}
+
+func (c *mergeCursors) Less(i, j int) bool {
+	a, b := c.items[i], c.items[j]
+	if c.cmp.less(a.key, b.key) {
+		return true
+	}
+	if c.cmp.less(b.key, a.key) {
+		return false
+	}
+	return a.run < b.run
+}
+
+func (c *mergeCursors) Swap(i, j int) {
+	c.items[i], c.items[j] = c.items[j], c.items[i]
+}
+
+func (c *mergeCursors) Push(x interface{}) {
+	c.items = append(c.items, x.(*mergeCursor))
+}
+
+func (c *mergeCursors) Pop() interface{} {
+	n := len(c.items)
+	x := c.items[n-1]
+	c.items = c.items[:n-1]
+	return x
+}
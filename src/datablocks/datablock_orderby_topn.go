@@ -0,0 +1,146 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datablocks
+
+import (
+	"container/heap"
+
+	"base/errors"
+	"columns"
+	"datavalues"
+	"planners"
+)
+
+// OrderByTopN keeps the first limit rows by OrderByPlan of the blocks pushed, in a heap of at most limit rows
+// with the last one on top. The rows equal by the orders come in the order they are pushed.
+type OrderByTopN struct {
+	cmp   *orderByComparator
+	limit int
+	cols  []*columns.Column
+	index []int
+	seq   int
+	rows  topNRows
+}
+
+type topNRow struct {
+	seq int
+	row []datavalues.IDataValue
+	key []datavalues.IDataValue
+}
+
+func NewOrderByTopN(fields []string, plan *planners.OrderByPlan, limit int) (*OrderByTopN, error) {
+	cmp, err := newOrderByComparator(fields, plan)
+	if err != nil {
+		return nil, err
+	}
+	return &OrderByTopN{
+		cmp:   cmp,
+		limit: limit,
+		rows:  topNRows{cmp: cmp},
+	}, nil
+}
+
+// Push takes the rows of the block which sort before the last one kept, the others are dropped.
+// The empty blocks are skipped, the selections send them with their outputs only.
+func (t *OrderByTopN) Push(block *DataBlock) error {
+	if block.NumRows() == 0 {
+		return nil
+	}
+	if t.cols == nil {
+		t.cols = block.Columns()
+		t.index = make([]int, len(t.cmp.fields))
+		for i, field := range t.cmp.fields {
+			t.index[i] = -1
+			for j, col := range t.cols {
+				if col.Name == field {
+					t.index[i] = j
+				}
+			}
+			if t.index[i] < 0 {
+				return errors.Errorf("Can't find column:%v", field)
+			}
+		}
+	}
+
+	it := block.RowIterator()
+	for it.Next() {
+		row := it.Value()
+		key := make([]datavalues.IDataValue, len(t.index))
+		for i, j := range t.index {
+			key[i] = row[j]
+		}
+		t.seq++
+
+		if t.rows.Len() < t.limit {
+			heap.Push(&t.rows, &topNRow{seq: t.seq, row: row, key: key})
+			continue
+		}
+		if top := t.rows.items[0]; t.cmp.less(key, top.key) {
+			top.seq, top.row, top.key = t.seq, row, key
+			heap.Fix(&t.rows, 0)
+		}
+	}
+	return nil
+}
+
+// Len returns the rows kept.
+func (t *OrderByTopN) Len() int {
+	return t.rows.Len()
+}
+
+// Block returns the rows kept in order and empties the heap, nil if nothing was pushed.
+func (t *OrderByTopN) Block() (*DataBlock, error) {
+	if t.cols == nil {
+		return nil, nil
+	}
+
+	rows := make([][]datavalues.IDataValue, t.rows.Len())
+	for i := len(rows) - 1; i >= 0; i-- {
+		rows[i] = heap.Pop(&t.rows).(*topNRow).row
+	}
+	block := NewDataBlock(t.cols)
+	for _, row := range rows {
+		if err := block.WriteRow(row); err != nil {
+			return nil, err
+		}
+	}
+	return block, nil
+}
+
+// topNRows is the heap of the rows kept, the row sorting last is the least.
+type topNRows struct {
+	cmp   *orderByComparator
+	items []*topNRow
+}
+
+func (r *topNRows) Len() int {
+	return len(r.items)
+}
+
+func (r *topNRows) Less(i, j int) bool {
+	a, b := r.items[i], r.items[j]
+	if r.cmp.less(b.key, a.key) {
+		return true
+	}
+	if r.cmp.less(a.key, b.key) {
+		return false
+	}
+	return a.seq > b.seq
+}
+
+func (r *topNRows) Swap(i, j int) {
+	r.items[i], r.items[j] = r.items[j], r.items[i]
+}
+
+func (r *topNRows) Push(x interface{}) {
+	r.items = append(r.items, x.(*topNRow))
+}
+
+func (r *topNRows) Pop() interface{} {
+	n := len(r.items)
+	x := r.items[n-1]
+	r.items = r.items[:n-1]
+	return x
+}
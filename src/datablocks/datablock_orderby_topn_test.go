@@ -0,0 +1,72 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datablocks
+
+import (
+	"testing"
+
+	"columns"
+	"datatypes"
+	"datavalues"
+	"planners"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderByTopN(t *testing.T) {
+	cols := []*columns.Column{
+		{Name: "a", DataType: datatypes.NewInt64DataType()},
+		{Name: "b", DataType: datatypes.NewStringDataType()},
+	}
+	plan := planners.NewOrderByPlan(
+		planners.Order{
+			Expression: planners.NewVariablePlan("a"),
+			Direction:  "desc",
+		},
+	)
+
+	topN, err := NewOrderByTopN([]string{"a"}, plan, 3)
+	assert.Nil(t, err)
+	block, err := topN.Block()
+	assert.Nil(t, err)
+	assert.Nil(t, block)
+
+	// The empty block of the other columns is skipped.
+	assert.Nil(t, topN.Push(NewDataBlock([]*columns.Column{{Name: "b", DataType: datatypes.NewStringDataType()}})))
+	block, err = topN.Block()
+	assert.Nil(t, err)
+	assert.Nil(t, block)
+
+	rows := [][]interface{}{{1, "a"}, {5, "b"}, {3, "c"}, {5, "d"}, {2, "e"}, {4, "f"}, {5, "g"}}
+	for i := 0; i < len(rows); i += 2 {
+		end := i + 2
+		if end > len(rows) {
+			end = len(rows)
+		}
+		block := NewDataBlock(cols)
+		for _, row := range rows[i:end] {
+			assert.Nil(t, block.WriteRow([]datavalues.IDataValue{datavalues.ToValue(row[0]), datavalues.ToValue(row[1])}))
+		}
+		assert.Nil(t, topN.Push(block))
+		assert.True(t, topN.Len() <= 3)
+	}
+
+	// The rows equal by the orders are kept in the order pushed.
+	block, err = topN.Block()
+	assert.Nil(t, err)
+	var actual []string
+	it := block.RowIterator()
+	for it.Next() {
+		actual = append(actual, it.Value()[0].String()+it.Value()[1].String())
+	}
+	assert.Equal(t, []string{"5b", "5d", "5g"}, actual)
+	assert.Equal(t, 0, topN.Len())
+
+	topN, err = NewOrderByTopN([]string{"x"}, plan, 3)
+	assert.Nil(t, err)
+	block = NewDataBlock(cols)
+	assert.Nil(t, block.WriteRow([]datavalues.IDataValue{datavalues.ToValue(1), datavalues.ToValue("a")}))
+	assert.NotNil(t, topN.Push(block))
+}
@@ -0,0 +1,184 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datablocks
+
+import (
+	"sync"
+
+	"base/docs"
+	"base/sync2"
+	"datavalues"
+)
+
+// The column buffers, the seqs and the arenas of the blocks are reused through the pools,
+// the blocks give them back by Release when the output stream is done with them.
+// A block only gives back the buffers it owns: the ones it got from the pool or grew by WriteRow,
+// not the ones it shares such as the columns of the block it's projected from.
+const (
+	// The capacity of the new buffers, WriteRow grows them as usual.
+	pooledCapacity = 64
+	// The buffers beyond it are left to the GC rather than kept by the pool.
+	maxPooledCapacity = 1 << 20
+)
+
+var (
+	valuesPool = sync.Pool{
+		New: func() interface{} {
+			values := make([]datavalues.IDataValue, 0, pooledCapacity)
+			return &values
+		},
+	}
+	seqsPool = sync.Pool{
+		New: func() interface{} {
+			seqs := make([]int, 0, pooledCapacity)
+			return &seqs
+		},
+	}
+	arenaPool = sync.Pool{
+		New: func() interface{} {
+			return datavalues.NewArena()
+		},
+	}
+
+	poolDebug sync2.AtomicBool
+)
+
+// SetPoolDebug turns on the checks of the released blocks: the buffers given back are poisoned,
+// a value read from them panics, the arenas are not reused and the second Release panics.
+// It's for the tests and the debug builds, to catch a block used after it's released.
+func SetPoolDebug(on bool) {
+	poolDebug.Set(on)
+}
+
+// getValues returns an empty buffer of at least the capacity.
+func getValues(capacity int) []datavalues.IDataValue {
+	values := *(valuesPool.Get().(*[]datavalues.IDataValue))
+	if cap(values) < capacity {
+		values = make([]datavalues.IDataValue, 0, capacity)
+	}
+	return values[:0]
+}
+
+func putValues(values []datavalues.IDataValue) {
+	if cap(values) == 0 || cap(values) > maxPooledCapacity {
+		return
+	}
+	values = values[:cap(values)]
+	fill := datavalues.IDataValue(nil)
+	if poolDebug.Get() {
+		fill = poisoned
+	}
+	// Cleared so the pool doesn't keep the values alive.
+	for i := range values {
+		values[i] = fill
+	}
+	values = values[:0]
+	valuesPool.Put(&values)
+}
+
+// getSeqs returns an empty seqs of at least the capacity.
+func getSeqs(capacity int) []int {
+	seqs := *(seqsPool.Get().(*[]int))
+	if cap(seqs) < capacity {
+		seqs = make([]int, 0, capacity)
+	}
+	return seqs[:0]
+}
+
+func putSeqs(seqs []int) {
+	if cap(seqs) == 0 || cap(seqs) > maxPooledCapacity {
+		return
+	}
+	if poolDebug.Get() {
+		seqs = seqs[:cap(seqs)]
+		for i := range seqs {
+			seqs[i] = -1
+		}
+	}
+	seqs = seqs[:0]
+	seqsPool.Put(&seqs)
+}
+
+func getArena() *datavalues.Arena {
+	return arenaPool.Get().(*datavalues.Arena)
+}
+
+func putArena(arena *datavalues.Arena) {
+	if poolDebug.Get() {
+		// The values of the arena may still be read by a block used after the release,
+		// they are left as they are.
+		return
+	}
+	arena.Reset()
+	arenaPool.Put(arena)
+}
+
+// Release gives the buffers the block owns back to the pools, the block is empty after.
+// The values of the block must not be used after, neither by the blocks sharing its columns.
+// It's called by the owner of the block once it's written, such as the output stream of the result.
+func (block *DataBlock) Release() {
+	block.mu.Lock()
+	defer block.mu.Unlock()
+
+	if block.released {
+		if poolDebug.Get() {
+			panic("datablocks: the block is released twice")
+		}
+		return
+	}
+	for _, cv := range block.values {
+		cv.release()
+	}
+	if block.ownSeqs {
+		putSeqs(block.seqs)
+	}
+	block.seqs = nil
+	block.values = nil
+	block.totalBytes = 0
+	block.ownSeqs = false
+	block.released = true
+}
+
+// release gives back the buffer and the arena of the column, once even if it's in many blocks.
+func (v *DataBlockValue) release() {
+	if v.owned {
+		putValues(v.values)
+		v.values = nil
+		v.owned = false
+	}
+	if v.arena != nil {
+		putArena(v.arena)
+		v.arena = nil
+	}
+}
+
+// poisonedValue fills the buffers given back in the debug mode, reading it is the use of a released block.
+type poisonedValue struct{}
+
+var poisoned datavalues.IDataValue = poisonedValue{}
+
+func (poisonedValue) Size() uintptr {
+	panic("datablocks: use of a released block")
+}
+
+func (poisonedValue) Type() datavalues.Type {
+	panic("datablocks: use of a released block")
+}
+
+func (poisonedValue) Family() datavalues.Family {
+	panic("datablocks: use of a released block")
+}
+
+func (poisonedValue) String() string {
+	panic("datablocks: use of a released block")
+}
+
+func (poisonedValue) Compare(datavalues.IDataValue) (datavalues.Comparison, error) {
+	panic("datablocks: use of a released block")
+}
+
+func (poisonedValue) Document() docs.Documentation {
+	panic("datablocks: use of a released block")
+}
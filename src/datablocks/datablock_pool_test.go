@@ -0,0 +1,213 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datablocks
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"base/binary"
+	"columns"
+	"datatypes"
+	"datavalues"
+	"planners"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockRelease(t *testing.T) {
+	block := newFilterTestBlock(10)
+	assert.True(t, block.ownSeqs)
+	for _, cv := range block.values {
+		assert.True(t, cv.owned)
+	}
+
+	// The projected block shares the columns, a column in it twice is given back once.
+	projected := newDataBlock(block.seqs, []*DataBlockValue{block.values[0], block.values[0]})
+	projected.Release()
+	assert.Equal(t, 0, projected.NumRows())
+	assert.Nil(t, block.values[0].values)
+	assert.False(t, block.values[0].owned)
+
+	// The clone owns its buffers, the source keeps its own.
+	block = newFilterTestBlock(10)
+	clone := block.DeepClone()
+	clone.Release()
+	assert.Equal(t, 10, block.NumRows())
+	assert.Equal(t, int64(9), datavalues.AsInt(block.values[0].values[9]))
+	block.Release()
+	block.Release()
+}
+
+func TestBlockReleaseDebug(t *testing.T) {
+	SetPoolDebug(true)
+	defer SetPoolDebug(false)
+
+	block := newFilterTestBlock(10)
+	held := block.values[3].values
+	block.Release()
+
+	// The buffer still held is poisoned.
+	assert.Panics(t, func() {
+		_ = held[0].String()
+	})
+	assert.Panics(t, func() {
+		block.Release()
+	})
+}
+
+func TestNormalSelectionArena(t *testing.T) {
+	binary := planners.NewBinaryExpressionPlan
+	i := planners.NewVariablePlan("i")
+	fields := []string{"i"}
+
+	// The computed column of the filtered block, indexed by its seqs.
+	block := newFilterTestBlock(10)
+	assert.Nil(t, block.FilterByPlan(fields, planners.NewFilterPlan(binary(">", i, planners.NewConstantPlan(6)))))
+	plan := planners.NewSelectionPlan(planners.NewMapPlan(binary("+", i, planners.NewConstantPlan(1))), nil)
+	selected, err := block.NormalSelectionByPlan(fields, plan)
+	assert.Nil(t, err)
+
+	cv, err := selected.DataBlockValue("(i+1)")
+	assert.Nil(t, err)
+	assert.NotNil(t, cv.arena)
+
+	var rows []int64
+	it, err := selected.ColumnIterator("(i+1)")
+	assert.Nil(t, err)
+	for it.Next() {
+		rows = append(rows, datavalues.AsInt(it.Value()))
+	}
+	assert.Equal(t, []int64{8, 9, 10}, rows)
+
+	selected.Release()
+	assert.Nil(t, cv.arena)
+}
+
+func TestReadNative(t *testing.T) {
+	block := newFilterTestBlock(10)
+	assert.Nil(t, block.FilterByPlan([]string{"i"}, planners.NewFilterPlan(planners.NewBinaryExpressionPlan(">", planners.NewVariablePlan("i"), planners.NewConstantPlan(6)))))
+
+	buf := new(bytes.Buffer)
+	assert.Nil(t, block.WriteNative(binary.NewWriter(buf)))
+	actual, err := ReadNative(binary.NewReader(bytes.NewReader(buf.Bytes())))
+	assert.Nil(t, err)
+	assert.Equal(t, []int{0, 1, 2}, actual.seqs)
+	assert.True(t, actual.ownSeqs)
+	assert.Equal(t, 4, actual.NumColumns())
+	assert.True(t, actual.values[0].owned)
+	assert.Equal(t, int64(7), datavalues.AsInt(actual.values[0].values[0]))
+	assert.True(t, actual.TotalBytes() > 0)
+}
+
+// BenchmarkInsert reads the Native blocks of the INSERT, the storage keeps them.
+func BenchmarkInsert(b *testing.B) {
+	buf := new(bytes.Buffer)
+	if err := newFilterTestBlock(1 << 16).WriteNative(binary.NewWriter(buf)); err != nil {
+		b.Fatal(err)
+	}
+	data := buf.Bytes()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		if _, err := ReadNative(binary.NewReader(bytes.NewReader(data))); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkFullScan is the scan of a Memory table with a computed column, the result is released once written.
+func BenchmarkFullScan(b *testing.B) {
+	block := newFilterTestBlock(1 << 16)
+	i := planners.NewVariablePlan("i")
+	add := planners.NewBinaryExpressionPlan("+", i, planners.NewConstantPlan(1))
+	selection := planners.NewSelectionPlan(planners.NewMapPlan(i, add), nil)
+	projection := planners.NewMapPlan(i, add)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		scanned := block.DeepClone()
+		selected, err := scanned.NormalSelectionByPlan([]string{"i"}, selection)
+		if err != nil {
+			b.Fatal(err)
+		}
+		projected, err := selected.ProjectionByPlan(projection)
+		if err != nil {
+			b.Fatal(err)
+		}
+		projected.Release()
+	}
+}
+
+func TestDeepCloneColumns(t *testing.T) {
+	block := newFilterTestBlock(10)
+	assert.Nil(t, block.FilterByPlan([]string{"i"}, planners.NewFilterPlan(
+		planners.NewBinaryExpressionPlan(">", planners.NewVariablePlan("i"), planners.NewConstantPlan(6)),
+	)))
+
+	// The columns in the order of the block, the rows by the seqs.
+	pruned := block.DeepCloneColumns([]string{"s", "i", "x"})
+	assert.Equal(t, 2, pruned.NumColumns())
+	assert.Equal(t, "i", pruned.Columns()[0].Name)
+	assert.Equal(t, "s", pruned.Columns()[1].Name)
+	assert.Equal(t, 3, pruned.NumRows())
+	it := pruned.RowIterator()
+	assert.True(t, it.Next())
+	assert.Equal(t, int64(7), datavalues.AsInt(it.Value()[0]))
+	assert.Equal(t, "h", datavalues.AsString(it.Value()[1]))
+	assert.True(t, pruned.TotalBytes() > 0 && pruned.TotalBytes() < block.TotalBytes())
+	assert.True(t, pruned.ownSeqs)
+	assert.True(t, pruned.values[0].owned)
+
+	// One column is kept for the rows.
+	pruned = block.DeepCloneColumns(nil)
+	assert.Equal(t, 1, pruned.NumColumns())
+	assert.Equal(t, 3, pruned.NumRows())
+
+	pruned = block.DeepCloneColumns([]string{"i", "j", "f", "s"})
+	assert.Equal(t, block.Columns(), pruned.Columns())
+}
+
+func newWideTestBlock(rows int, width int) *DataBlock {
+	cols := make([]*columns.Column, width)
+	for i := range cols {
+		cols[i] = columns.NewColumn(fmt.Sprintf("c%d", i), datatypes.NewInt64DataType())
+	}
+	block := NewDataBlock(cols)
+	row := make([]datavalues.IDataValue, width)
+	for k := 0; k < rows; k++ {
+		for i := range row {
+			row[i] = datavalues.MakeInt(int64(k + i))
+		}
+		block.WriteRow(row)
+	}
+	return block
+}
+
+// BenchmarkScanAllColumns is the scan of a Memory table of 50 columns copying all of them.
+func BenchmarkScanAllColumns(b *testing.B) {
+	block := newWideTestBlock(1<<14, 50)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		block.DeepClone().Release()
+	}
+}
+
+// BenchmarkScanPrunedColumns is the scan of the same table copying the 2 columns referenced.
+func BenchmarkScanPrunedColumns(b *testing.B) {
+	block := newWideTestBlock(1<<14, 50)
+	names := []string{"c3", "c17"}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		block.DeepCloneColumns(names).Release()
+	}
+}
@@ -28,6 +28,7 @@ func (block *DataBlock) ProjectionByPlan(plan *planners.MapPlan) (*DataBlock, er
 		}
 		return NewDataBlock(cols), nil
 	} else {
+		var totalBytes uint64
 		columnValues := make([]*DataBlockValue, len(exprs))
 		for i, expr := range exprs {
 			columnValue, err := block.DataBlockValue(expr.String())
@@ -35,7 +36,10 @@ func (block *DataBlock) ProjectionByPlan(plan *planners.MapPlan) (*DataBlock, er
 				return nil, err
 			}
 			columnValues[i] = columnValue
+			totalBytes += columnValue.totalBytes()
 		}
-		return newDataBlock(block.seqs, columnValues), nil
+		projected := newDataBlock(block.seqs, columnValues)
+		projected.totalBytes = totalBytes
+		return projected, nil
 	}
 }
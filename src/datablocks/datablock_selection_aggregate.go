@@ -18,6 +18,11 @@ func (block *DataBlock) AggregateSelectionByPlan(fields []string, plan *planners
 	var mu sync.Mutex
 	projects := plan.Projects
 
+	// The values of the commons may be kept by the aggregates, the columns are not given back.
+	block, err := block.CommonsByPlan(plan.Commons)
+	if err != nil {
+		return nil, err
+	}
 	projectExprs, err := planners.BuildExpressions(projects)
 	if err != nil {
 		return nil, err
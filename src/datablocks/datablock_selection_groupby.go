@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"base/collections"
+	"datavalues"
 	"expressions"
 	"planners"
 )
@@ -19,6 +20,11 @@ func (block *DataBlock) GroupBySelectionByPlan(plan *planners.SelectionPlan) (*c
 	params := make(expressions.Map)
 	hashmap := collections.NewHashMap()
 
+	// The values of the commons may be kept by the aggregates, the columns are not given back.
+	block, err := block.CommonsByPlan(plan.Commons)
+	if err != nil {
+		return nil, err
+	}
 	groupbyExprs, err := planners.BuildExpressions(groupbys)
 	if err != nil {
 		return nil, err
@@ -39,7 +45,7 @@ func (block *DataBlock) GroupBySelectionByPlan(plan *planners.SelectionPlan) (*c
 			if err != nil {
 				return nil, err
 			}
-			groupbykeys[i] = val.String()
+			groupbykeys[i] = groupbyKey(val)
 		}
 		key := strings.Join(groupbykeys, "")
 		projectExprs, hash, ok, err := hashmap.Get(key)
@@ -64,3 +70,12 @@ func (block *DataBlock) GroupBySelectionByPlan(plan *planners.SelectionPlan) (*c
 	}
 	return hashmap, nil
 }
+
+// groupbyKey is the text of the value in the group key, the Bytes are of their raw bytes
+// rather than of the hex, so they group with the String of the same bytes as they are Equals to.
+func groupbyKey(v datavalues.IDataValue) string {
+	if v.Family() == datavalues.FamilyString {
+		return datavalues.AsString(v)
+	}
+	return v.String()
+}
@@ -0,0 +1,50 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datablocks
+
+import (
+	"testing"
+
+	"columns"
+	"datatypes"
+	"datavalues"
+	"expressions"
+	"planners"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupBySelectionBytes(t *testing.T) {
+	block := NewDataBlock([]*columns.Column{
+		{Name: "s", DataType: datatypes.NewStringDataType()},
+	})
+	for _, v := range []datavalues.IDataValue{
+		datavalues.MakeBytes([]byte{0xab}),
+		datavalues.MakeString("\xab"),
+		datavalues.MakeString("AB"),
+	} {
+		assert.Nil(t, block.WriteRow([]datavalues.IDataValue{v}))
+	}
+
+	// The Bytes group with the String of the same bytes, not with the String of their hex.
+	s := planners.NewVariablePlan("s")
+	plan := planners.NewSelectionPlan(
+		planners.NewMapPlan(planners.NewUnaryExpressionPlan("count", planners.NewConstantPlan(1))),
+		planners.NewMapPlan(s),
+	)
+	hashmap, err := block.GroupBySelectionByPlan(plan)
+	assert.Nil(t, err)
+
+	counts := make(map[string]int64)
+	iter := hashmap.GetIterator()
+	for {
+		key, exprs, ok := iter.Next()
+		if !ok {
+			break
+		}
+		counts[key] = datavalues.AsInt(exprs.([]expressions.IExpression)[0].Result())
+	}
+	assert.Equal(t, map[string]int64{"\xab": 2, "AB": 1}, counts)
+}
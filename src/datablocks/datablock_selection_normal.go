@@ -7,7 +7,6 @@ package datablocks
 import (
 	"columns"
 	"datatypes"
-	"datavalues"
 	"expressions"
 	"planners"
 )
@@ -15,6 +14,11 @@ import (
 func (block *DataBlock) NormalSelectionByPlan(fields []string, plan *planners.SelectionPlan) (*DataBlock, error) {
 	projects := plan.Projects
 
+	// The projects repeating a common are its variable.
+	block, err := block.CommonsByPlan(plan.Commons)
+	if err != nil {
+		return nil, err
+	}
 	projectExprs, err := planners.BuildExpressions(projects)
 	if err != nil {
 		return nil, err
@@ -36,6 +40,7 @@ func (block *DataBlock) NormalSelectionByPlan(fields []string, plan *planners.Se
 	} else {
 		params := make(expressions.Map)
 		columnValues := make([]*DataBlockValue, 0, 8)
+		totalBytes := block.TotalBytes()
 
 		// Copy the colums from old.
 		columnValues = append(columnValues, block.values...)
@@ -55,7 +60,15 @@ func (block *DataBlock) NormalSelectionByPlan(fields []string, plan *planners.Se
 				return nil, err
 			}
 
-			values := make([]datavalues.IDataValue, rows)
+			// The values are indexed by the seqs, as the rows of the columns copied.
+			// They are made by the arena of the column, given back with it by Release.
+			n := len(block.values[0].values)
+			values := getValues(n)[:n]
+			for i := range values {
+				values[i] = nil
+			}
+			arena := getArena()
+			expressions.SetArena(expr, arena)
 			for it.Next() {
 				row := it.Value()
 				for j := range row {
@@ -79,11 +92,14 @@ func (block *DataBlock) NormalSelectionByPlan(fields []string, plan *planners.Se
 				return nil, err
 			}
 			columnValue := newDataBlockValueWithValues(columns.NewColumn(name, dtype), values)
+			columnValue.owned, columnValue.arena = true, arena
 			columnValues = append(columnValues, columnValue)
+			totalBytes += columnValue.totalBytes()
 		}
 		return &DataBlock{
-			seqs:   block.seqs,
-			values: columnValues,
+			seqs:       block.seqs,
+			values:     columnValues,
+			totalBytes: totalBytes,
 		}, nil
 	}
 }
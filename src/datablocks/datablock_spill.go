@@ -0,0 +1,287 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datablocks
+
+import (
+	"io"
+	"time"
+
+	"base/binary"
+	"base/errors"
+	"columns"
+	"datatypes"
+	"datavalues"
+)
+
+// WriteSpill writes the rows of the block from the offset in the order of the seqs, up to the limit,
+// for the temporary files of the external sort to read back by ReadSpill.
+// Unlike the Native format each value is written with its type, so the NULLs and the values
+// computed of another type than the column's read back as they were.
+func (block *DataBlock) WriteSpill(writer *binary.Writer, offset int, limit int) error {
+	block.mu.RLock()
+	defer block.mu.RUnlock()
+
+	seqs := block.seqs[offset:]
+	if limit < len(seqs) {
+		seqs = seqs[:limit]
+	}
+	if err := writer.Uvarint(uint64(len(block.values))); err != nil {
+		return errors.Wrap(err)
+	}
+	if err := writer.Uvarint(uint64(len(seqs))); err != nil {
+		return errors.Wrap(err)
+	}
+	for _, cv := range block.values {
+		if err := writer.String(cv.column.Name); err != nil {
+			return errors.Wrap(err)
+		}
+		if err := writer.String(cv.column.DataType.Name()); err != nil {
+			return errors.Wrap(err)
+		}
+		for _, seq := range seqs {
+			if err := writeSpillValue(writer, cv.values[seq]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ReadSpill reads the block written by WriteSpill, nil at the end of the file.
+func ReadSpill(reader *binary.Reader) (*DataBlock, error) {
+	numColumns, err := reader.Uvarint()
+	if err == io.EOF {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	numRows, err := reader.Uvarint()
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+
+	block := newDataBlock(nil, make([]*DataBlockValue, numColumns))
+	for i := 0; i < int(numColumns); i++ {
+		colName, err := reader.String()
+		if err != nil {
+			return nil, errors.Wrap(err)
+		}
+		typeName, err := reader.String()
+		if err != nil {
+			return nil, errors.Wrap(err)
+		}
+		dt, err := datatypes.DataTypeFactory(typeName)
+		if err != nil {
+			return nil, err
+		}
+		values := getValues(int(numRows))
+		for j := 0; j < int(numRows); j++ {
+			val, err := readSpillValue(reader)
+			if err != nil {
+				return nil, err
+			}
+			block.totalBytes += uint64(val.Size())
+			values = append(values, val)
+		}
+		block.values[i] = newDataBlockValueWithValues(columns.NewColumn(colName, dt), values)
+		block.values[i].owned = true
+	}
+	block.seqs, block.ownSeqs = identitySeqs(int(numRows)), true
+	return block, nil
+}
+
+func writeSpillValue(writer *binary.Writer, v datavalues.IDataValue) error {
+	if datavalues.IsZero(v) {
+		v = datavalues.MakeNull()
+	}
+	if err := writer.UInt8(uint8(v.Type())); err != nil {
+		return errors.Wrap(err)
+	}
+
+	var err error
+	switch v.Type() {
+	case datavalues.TypeNull:
+	case datavalues.TypeInt:
+		err = writer.Int64(datavalues.AsInt(v))
+	case datavalues.TypeInt32:
+		err = writer.Int32(int32(datavalues.AsInt(v)))
+	case datavalues.TypeFloat:
+		err = writer.Float64(datavalues.AsFloat(v))
+	case datavalues.TypeBool:
+		err = writer.Bool(datavalues.AsBool(v))
+	case datavalues.TypeString:
+		err = writer.String(datavalues.AsString(v))
+	case datavalues.TypeBytes:
+		err = writer.Bytes(datavalues.AsBytes(v))
+	case datavalues.TypeTime:
+		// The zone offset is kept, the time is shown as it was.
+		var data []byte
+		if data, err = datavalues.AsTime(v).MarshalBinary(); err != nil {
+			return errors.Wrap(err)
+		}
+		if err = writer.Bytes(data); err == nil {
+			err = writer.Int8(int8(v.(*datavalues.ValueTime).Precision()))
+		}
+	case datavalues.TypeTuple:
+		fields := datavalues.AsSlice(v)
+		if err := writer.Uvarint(uint64(len(fields))); err != nil {
+			return errors.Wrap(err)
+		}
+		for _, field := range fields {
+			if err := writeSpillValue(writer, field); err != nil {
+				return err
+			}
+		}
+	case datavalues.TypeObject:
+		object := v.(*datavalues.ValueObject)
+		keys := object.Keys()
+		if err := writer.Uvarint(uint64(len(keys))); err != nil {
+			return errors.Wrap(err)
+		}
+		for _, k := range keys {
+			field := object.AsMap()[k]
+			if err := writer.String(k); err != nil {
+				return errors.Wrap(err)
+			}
+			if err := writeSpillValue(writer, field); err != nil {
+				return err
+			}
+		}
+	case datavalues.TypeExtension:
+		// The payload is read back by the registered type of the id.
+		id, data, err := datavalues.MarshalExtension(v)
+		if err != nil {
+			return err
+		}
+		if err := writer.Uvarint(uint64(id)); err != nil {
+			return errors.Wrap(err)
+		}
+		if err := writer.Bytes(data); err != nil {
+			return errors.Wrap(err)
+		}
+	default:
+		return errors.Errorf("Unsupported value to spill:%v", v)
+	}
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	return nil
+}
+
+func readSpillValue(reader *binary.Reader) (datavalues.IDataValue, error) {
+	typ, err := reader.UInt8()
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+
+	switch datavalues.Type(typ) {
+	case datavalues.TypeNull:
+		return datavalues.MakeNull(), nil
+	case datavalues.TypeInt:
+		v, err := reader.Int64()
+		if err != nil {
+			return nil, errors.Wrap(err)
+		}
+		return datavalues.MakeInt(v), nil
+	case datavalues.TypeInt32:
+		v, err := reader.Int32()
+		if err != nil {
+			return nil, errors.Wrap(err)
+		}
+		return datavalues.MakeInt32(v), nil
+	case datavalues.TypeFloat:
+		v, err := reader.Float64()
+		if err != nil {
+			return nil, errors.Wrap(err)
+		}
+		return datavalues.MakeFloat(v), nil
+	case datavalues.TypeBool:
+		v, err := reader.Bool()
+		if err != nil {
+			return nil, errors.Wrap(err)
+		}
+		return datavalues.MakeBool(v), nil
+	case datavalues.TypeString:
+		v, err := reader.String()
+		if err != nil {
+			return nil, errors.Wrap(err)
+		}
+		return datavalues.MakeString(v), nil
+	case datavalues.TypeBytes:
+		n, err := reader.Uvarint()
+		if err != nil {
+			return nil, errors.Wrap(err)
+		}
+		v, err := reader.Bytes(int(n))
+		if err != nil {
+			return nil, errors.Wrap(err)
+		}
+		return datavalues.MakeBytes(v), nil
+	case datavalues.TypeTime:
+		n, err := reader.Uvarint()
+		if err != nil {
+			return nil, errors.Wrap(err)
+		}
+		data, err := reader.Bytes(int(n))
+		if err != nil {
+			return nil, errors.Wrap(err)
+		}
+		var t time.Time
+		if err := t.UnmarshalBinary(data); err != nil {
+			return nil, errors.Wrap(err)
+		}
+		precision, err := reader.Int8()
+		if err != nil {
+			return nil, errors.Wrap(err)
+		}
+		if precision < 0 {
+			return datavalues.MakeTime(t), nil
+		}
+		return datavalues.MakeTimeWithPrecision(t, int(precision)), nil
+	case datavalues.TypeTuple:
+		n, err := reader.Uvarint()
+		if err != nil {
+			return nil, errors.Wrap(err)
+		}
+		fields := make([]datavalues.IDataValue, n)
+		for i := range fields {
+			if fields[i], err = readSpillValue(reader); err != nil {
+				return nil, err
+			}
+		}
+		return datavalues.MakeTuple(fields...), nil
+	case datavalues.TypeObject:
+		n, err := reader.Uvarint()
+		if err != nil {
+			return nil, errors.Wrap(err)
+		}
+		fields := make(map[string]datavalues.IDataValue, n)
+		for i := 0; i < int(n); i++ {
+			k, err := reader.String()
+			if err != nil {
+				return nil, errors.Wrap(err)
+			}
+			if fields[k], err = readSpillValue(reader); err != nil {
+				return nil, err
+			}
+		}
+		return datavalues.MakeObject(fields), nil
+	case datavalues.TypeExtension:
+		id, err := reader.Uvarint()
+		if err != nil {
+			return nil, errors.Wrap(err)
+		}
+		n, err := reader.Uvarint()
+		if err != nil {
+			return nil, errors.Wrap(err)
+		}
+		data, err := reader.Bytes(int(n))
+		if err != nil {
+			return nil, errors.Wrap(err)
+		}
+		return datavalues.UnmarshalExtension(uint32(id), data)
+	}
+	return nil, errors.Errorf("Unsupported value type in the spill:%v", typ)
+}
@@ -0,0 +1,120 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datablocks
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"base/binary"
+	"columns"
+	"datatypes"
+	"datavalues"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpill(t *testing.T) {
+	block := NewDataBlock([]*columns.Column{
+		{Name: "a", DataType: datatypes.NewStringDataType()},
+		{Name: "b", DataType: datatypes.NewInt64DataType()},
+	})
+	at := time.Date(2020, 3, 4, 5, 6, 7, 123456789, time.FixedZone("", 8*3600))
+	rows := [][]datavalues.IDataValue{
+		{datavalues.MakeString("x"), datavalues.MakeInt(1)},
+		{datavalues.MakeNull(), datavalues.MakeInt32(2)},
+		{datavalues.MakeBytes([]byte{0, 1}), datavalues.MakeFloat(1.5)},
+		{datavalues.MakeTime(at), datavalues.MakeTimeWithPrecision(at, 3)},
+		{datavalues.MakeTuple(datavalues.MakeInt(1), datavalues.MakeNull()), datavalues.MakeObject(map[string]datavalues.IDataValue{"k": datavalues.MakeBool(true)})},
+	}
+	for _, row := range rows {
+		assert.Nil(t, block.WriteRow(row))
+	}
+	block.seqs = []int{4, 3, 2, 1, 0}
+
+	// The rows are written in the order of the seqs, 2 at a time.
+	buf := new(bytes.Buffer)
+	writer := binary.NewWriter(buf)
+	for offset := 0; offset < block.NumRows(); offset += 2 {
+		assert.Nil(t, block.WriteSpill(writer, offset, 2))
+	}
+
+	var actual [][]datavalues.IDataValue
+	reader := binary.NewReader(buf)
+	for {
+		read, err := ReadSpill(reader)
+		assert.Nil(t, err)
+		if read == nil {
+			break
+		}
+		assert.Equal(t, block.Columns(), read.Columns())
+		it := read.RowIterator()
+		for it.Next() {
+			actual = append(actual, it.Value())
+		}
+	}
+	assert.Equal(t, len(rows), len(actual))
+	for i, row := range actual {
+		expect := rows[len(rows)-1-i]
+		for j := range row {
+			assert.Equal(t, expect[j].Type(), row[j].Type())
+			assert.Equal(t, expect[j].String(), row[j].String())
+		}
+	}
+	assert.Equal(t, at.UnixNano(), datavalues.AsTime(actual[1][0]).UnixNano())
+	assert.Equal(t, 3, actual[1][1].(*datavalues.ValueTime).Precision())
+}
+
+// pointType is the coordinates, the payload is the [2]float64.
+type pointType struct{}
+
+func (pointType) ID() uint32   { return 2001 }
+func (pointType) Name() string { return "Point" }
+
+func (pointType) Make(v interface{}) (interface{}, error) { return v.([2]float64), nil }
+
+func (pointType) Show(payload interface{}) string {
+	p := payload.([2]float64)
+	return fmt.Sprintf("POINT(%v %v)", p[0], p[1])
+}
+
+func (pointType) Compare(a, b interface{}) (datavalues.Comparison, error) {
+	return datavalues.Equal, nil
+}
+
+func (pointType) MarshalBinary(payload interface{}) ([]byte, error) {
+	p := payload.([2]float64)
+	return []byte(fmt.Sprintf("%v %v", p[0], p[1])), nil
+}
+
+func (pointType) UnmarshalBinary(data []byte) (interface{}, error) {
+	var p [2]float64
+	_, err := fmt.Sscanf(string(data), "%v %v", &p[0], &p[1])
+	return p, err
+}
+
+func TestSpillExtension(t *testing.T) {
+	assert.Nil(t, datavalues.RegisterExtensionType(pointType{}))
+	point, err := datavalues.MakeExtension(2001, [2]float64{1.5, -2})
+	assert.Nil(t, err)
+
+	block := NewDataBlock([]*columns.Column{
+		{Name: "p", DataType: datatypes.NewStringDataType()},
+	})
+	assert.Nil(t, block.WriteRow([]datavalues.IDataValue{datavalues.MakeTuple(point, datavalues.MakeInt(1))}))
+	buf := new(bytes.Buffer)
+	assert.Nil(t, block.WriteSpill(binary.NewWriter(buf), 0, 1))
+
+	read, err := ReadSpill(binary.NewReader(buf))
+	assert.Nil(t, err)
+	it := read.RowIterator()
+	assert.True(t, it.Next())
+	assert.Equal(t, "(POINT(1.5 -2), 1)", datavalues.Show(it.Value()[0]))
+	fields := datavalues.AsSlice(it.Value()[0])
+	assert.Equal(t, datavalues.TypeExtension, fields[0].Type())
+	assert.Equal(t, [2]float64{1.5, -2}, fields[0].(*datavalues.ValueExtension).Payload())
+}
@@ -17,6 +17,10 @@ type ColumnIndex struct {
 type DataBlockValue struct {
 	column *columns.Column
 	values []datavalues.IDataValue
+	// owned is whether the values are the column's own to give back by Release,
+	// and the arena the values computed for the column are made by.
+	owned bool
+	arena *datavalues.Arena
 }
 
 func NewDataBlockValue(col *columns.Column) *DataBlockValue {
@@ -38,10 +42,20 @@ func (v *DataBlockValue) ColumnName() string {
 }
 
 func (v *DataBlockValue) DeepClone() *DataBlockValue {
-	clone := &DataBlockValue{
+	return &DataBlockValue{
 		column: v.column,
-		values: make([]datavalues.IDataValue, len(v.values)),
+		values: append(getValues(len(v.values)), v.values...),
+		owned:  true,
+	}
+}
+
+// totalBytes sums the sizes of the values, for the blocks built from the columns rather than by WriteRow.
+func (v *DataBlockValue) totalBytes() uint64 {
+	var bytes uint64
+	for _, value := range v.values {
+		if value != nil {
+			bytes += uint64(value.Size())
+		}
 	}
-	copy(clone.values, v.values)
-	return clone
+	return bytes
 }
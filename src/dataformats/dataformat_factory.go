@@ -6,20 +6,53 @@ package dataformats
 
 import (
 	"io"
+
+	"datablocks"
 )
 
 type (
-	InputCreator  func(reader io.Reader) IDataBlockInputFormat
-	OutputCreator func(writer io.Writer) IDataBlockOutputFormat
+	InputCreator  func(header *datablocks.DataBlock, reader io.Reader, settings *FormatSettings) IDataBlockInputFormat
+	OutputCreator func(header *datablocks.DataBlock, writer io.Writer, settings *FormatSettings) IDataBlockOutputFormat
 )
 
 var (
-	inputTable  = map[string]InputCreator{}
+	inputTable = map[string]InputCreator{
+		"TSV":                   NewTSVInputFormat,
+		"TabSeparated":          NewTSVInputFormat,
+		"TSVWithNames":          NewTSVWithNamesInputFormat,
+		"TabSeparatedWithNames": NewTSVWithNamesInputFormat,
+		"CSV":                   NewCSVInputFormat,
+		"CSVWithNames":          NewCSVWithNamesInputFormat,
+		"JSONEachRow":           NewJSONEachRowInputFormat,
+		"Native":                NewNativeInputFormat,
+	}
 	outputTable = map[string]OutputCreator{
-		"TSV":                   NewTSVOutputFormat,
-		"TabSeparated":          NewTSVOutputFormat,
-		"TSVWithNames":          NewTSVWithNamesOutputFormat,
-		"TabSeparatedWithNames": NewTSVWithNamesOutputFormat,
+		"TSV":                    NewTSVOutputFormat,
+		"TabSeparated":           NewTSVOutputFormat,
+		"TSVWithNames":           NewTSVWithNamesOutputFormat,
+		"TabSeparatedWithNames":  NewTSVWithNamesOutputFormat,
+		"CSV":                    NewCSVOutputFormat,
+		"CSVWithNames":           NewCSVWithNamesOutputFormat,
+		"JSON":                   NewJSONOutputFormat,
+		"JSONEachRow":            NewJSONEachRowOutputFormat,
+		"Native":                 NewNativeOutputFormat,
+		"Pretty":                 NewPrettyOutputFormat,
+		"PrettyNoEscapes":        NewPrettyOutputFormat,
+		"PrettyCompact":          NewPrettyCompactOutputFormat,
+		"PrettyCompactNoEscapes": NewPrettyCompactOutputFormat,
+		"PrettySpace":            NewPrettySpaceOutputFormat,
+		"PrettySpaceNoEscapes":   NewPrettySpaceOutputFormat,
+	}
+	contentTypeTable = map[string]string{
+		"TSV":                   "text/tab-separated-values; charset=UTF-8",
+		"TabSeparated":          "text/tab-separated-values; charset=UTF-8",
+		"TSVWithNames":          "text/tab-separated-values; charset=UTF-8",
+		"TabSeparatedWithNames": "text/tab-separated-values; charset=UTF-8",
+		"CSV":                   "text/csv; charset=UTF-8; header=absent",
+		"CSVWithNames":          "text/csv; charset=UTF-8; header=present",
+		"JSON":                  "application/json; charset=UTF-8",
+		"JSONEachRow":           "application/x-ndjson; charset=UTF-8",
+		"Native":                "application/octet-stream",
 	}
 )
 
@@ -30,3 +63,11 @@ func FactoryGetInput(name string) InputCreator {
 func FactoryGetOutput(name string) OutputCreator {
 	return outputTable[name]
 }
+
+// FactoryGetContentType returns the HTTP Content-Type of the output format.
+func FactoryGetContentType(name string) string {
+	if contentType, ok := contentTypeTable[name]; ok {
+		return contentType
+	}
+	return "text/plain; charset=UTF-8"
+}
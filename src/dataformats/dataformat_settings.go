@@ -0,0 +1,54 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package dataformats
+
+import (
+	"time"
+)
+
+const (
+	DefaultMaxInsertBlockSize      = 1048576
+	DefaultPrettyMaxRows           = 10000
+	DefaultPrettyMaxColumnPadWidth = 250
+)
+
+type FormatSettings struct {
+	// JSONQuote64bitIntegers quotes the Int64/UInt64 in the JSON formats,
+	// JavaScript numbers lose the precision above 2^53.
+	JSONQuote64bitIntegers bool
+
+	// MaxInsertBlockSize is the max rows of the blocks read by the input formats.
+	MaxInsertBlockSize int
+	// InputSkipUnknownFields skips the fields of no column, instead of the parse error.
+	InputSkipUnknownFields bool
+
+	// PrettyMaxRows is the max rows of the Pretty formats, the rest is only counted.
+	PrettyMaxRows int
+	// PrettyMaxColumnPadWidth is the max width of the Pretty cells, the wider ones are cut.
+	PrettyMaxColumnPadWidth int
+}
+
+func DefaultFormatSettings() *FormatSettings {
+	return &FormatSettings{
+		JSONQuote64bitIntegers: true,
+		MaxInsertBlockSize:     DefaultMaxInsertBlockSize,
+
+		PrettyMaxRows:           DefaultPrettyMaxRows,
+		PrettyMaxColumnPadWidth: DefaultPrettyMaxColumnPadWidth,
+	}
+}
+
+// Statistics is the query summary, written by the formats which have a statistics suffix, such as JSON.
+type Statistics struct {
+	Elapsed         time.Duration
+	RowsRead        int64
+	BytesRead       int64
+	AppliedLimit    bool
+	RowsBeforeLimit int64
+}
+
+type IStatisticsOutputFormat interface {
+	SetStatistics(*Statistics)
+}
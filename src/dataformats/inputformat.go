@@ -0,0 +1,108 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package dataformats
+
+import (
+	"io"
+
+	"datablocks"
+	"datatypes"
+	"datavalues"
+
+	"base/errors"
+)
+
+const (
+	maxParseErrorSnippet = 128
+)
+
+// rowInputFormat reads the rows one by one and cuts them into the blocks of MaxInsertBlockSize rows,
+// so the input is parsed in streaming fashion.
+type rowInputFormat struct {
+	header   *datablocks.DataBlock
+	settings *FormatSettings
+	line     int
+	// readRow returns the next row, io.EOF at the end.
+	readRow func() ([]datavalues.IDataValue, error)
+}
+
+func newRowInputFormat(header *datablocks.DataBlock, settings *FormatSettings) rowInputFormat {
+	if settings == nil {
+		settings = DefaultFormatSettings()
+	}
+	return rowInputFormat{
+		header:   header,
+		settings: settings,
+	}
+}
+
+func (format *rowInputFormat) ReadPrefix() error {
+	return nil
+}
+
+func (format *rowInputFormat) Read() (*datablocks.DataBlock, error) {
+	max := format.settings.MaxInsertBlockSize
+	if max <= 0 {
+		max = DefaultMaxInsertBlockSize
+	}
+
+	block := format.header.Clone()
+	for block.NumRows() < max {
+		row, err := format.readRow()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if err := block.WriteRow(row); err != nil {
+			return nil, err
+		}
+	}
+	if block.NumRows() == 0 {
+		return nil, nil
+	}
+	return block, nil
+}
+
+func (format *rowInputFormat) ReadSuffix() error {
+	return nil
+}
+
+// parseFields deserializes the text fields by the header column types.
+func (format *rowInputFormat) parseFields(fields []string, row string) ([]datavalues.IDataValue, error) {
+	cols := format.header.Columns()
+	if len(fields) != len(cols) {
+		return nil, format.parseError(row, errors.Errorf("expected %d fields, but got %d", len(cols), len(fields)))
+	}
+	values := make([]datavalues.IDataValue, len(cols))
+	for i, col := range cols {
+		v, err := col.DataType.DeserializeText(fields[i])
+		if err != nil {
+			return nil, format.parseError(row, errors.Errorf("column:%s %v", col.Name, err))
+		}
+		values[i] = v
+	}
+	return values, nil
+}
+
+func (format *rowInputFormat) parseError(row string, err error) error {
+	if len(row) > maxParseErrorSnippet {
+		row = row[:maxParseErrorSnippet] + "..."
+	}
+	return errors.ErrorWithCode(errors.CANNOT_PARSE_TEXT, "Cannot parse input at line %d: %v, row: %q", format.line, err, row)
+}
+
+// defaultValue is the value of the columns absent from the input.
+func defaultValue(datatype datatypes.IDataType) datavalues.IDataValue {
+	switch datatype.Name() {
+	case datatypes.DataTypeStringName:
+		return datavalues.MakeString("")
+	case datatypes.DataTypeDateTimeName:
+		return datavalues.ZeroTime()
+	}
+	v, _ := datatype.DeserializeText("0")
+	return v
+}
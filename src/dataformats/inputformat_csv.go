@@ -0,0 +1,79 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package dataformats
+
+import (
+	"encoding/csv"
+	"io"
+	"strings"
+
+	"datablocks"
+	"datavalues"
+
+	"base/errors"
+)
+
+type CSVInputFormat struct {
+	rowInputFormat
+	reader    *csv.Reader
+	withNames bool
+}
+
+func NewCSVInputFormat(header *datablocks.DataBlock, reader io.Reader, settings *FormatSettings) IDataBlockInputFormat {
+	return newCSVInputFormat(header, reader, settings, false)
+}
+
+func NewCSVWithNamesInputFormat(header *datablocks.DataBlock, reader io.Reader, settings *FormatSettings) IDataBlockInputFormat {
+	return newCSVInputFormat(header, reader, settings, true)
+}
+
+func newCSVInputFormat(header *datablocks.DataBlock, reader io.Reader, settings *FormatSettings, withNames bool) IDataBlockInputFormat {
+	r := csv.NewReader(reader)
+	r.FieldsPerRecord = -1
+	r.ReuseRecord = true
+	format := &CSVInputFormat{
+		rowInputFormat: newRowInputFormat(header, settings),
+		reader:         r,
+		withNames:      withNames,
+	}
+	format.readRow = format.next
+	return format
+}
+
+// ReadPrefix skips the names row.
+func (format *CSVInputFormat) ReadPrefix() error {
+	if !format.withNames {
+		return nil
+	}
+	_, err := format.next0()
+	if err == io.EOF {
+		return nil
+	}
+	return err
+}
+
+func (format *CSVInputFormat) next0() ([]string, error) {
+	record, err := format.reader.Read()
+	if err == io.EOF {
+		return nil, io.EOF
+	}
+	if err != nil {
+		if perr, ok := err.(*csv.ParseError); ok {
+			format.line = perr.Line
+			return nil, format.parseError("", perr.Err)
+		}
+		return nil, errors.Wrap(err)
+	}
+	format.line, _ = format.reader.FieldPos(0)
+	return record, nil
+}
+
+func (format *CSVInputFormat) next() ([]datavalues.IDataValue, error) {
+	record, err := format.next0()
+	if err != nil {
+		return nil, err
+	}
+	return format.parseFields(record, strings.Join(record, ","))
+}
@@ -0,0 +1,115 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package dataformats
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+	"strings"
+
+	"datablocks"
+	"datavalues"
+
+	"base/errors"
+)
+
+// JSONEachRowInputFormat reads one JSON object per line,
+// the absent columns are filled with the defaults and the unknown fields skipped by the settings.
+type JSONEachRowInputFormat struct {
+	rowInputFormat
+	reader  *bufio.Reader
+	columns map[string]int
+}
+
+func NewJSONEachRowInputFormat(header *datablocks.DataBlock, reader io.Reader, settings *FormatSettings) IDataBlockInputFormat {
+	columns := make(map[string]int)
+	for i, col := range header.Columns() {
+		columns[col.Name] = i
+	}
+	format := &JSONEachRowInputFormat{
+		rowInputFormat: newRowInputFormat(header, settings),
+		reader:         bufio.NewReader(reader),
+		columns:        columns,
+	}
+	format.readRow = format.next
+	return format
+}
+
+func (format *JSONEachRowInputFormat) next() ([]datavalues.IDataValue, error) {
+	for {
+		line, err := format.reader.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return nil, errors.Wrap(err)
+		}
+		if err == io.EOF && len(line) == 0 {
+			return nil, io.EOF
+		}
+		format.line++
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+		return format.parseObject(line)
+	}
+}
+
+func (format *JSONEachRowInputFormat) parseObject(line []byte) ([]datavalues.IDataValue, error) {
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(line, &object); err != nil {
+		return nil, format.parseError(string(line), err)
+	}
+
+	cols := format.header.Columns()
+	values := make([]datavalues.IDataValue, len(cols))
+	for name, raw := range object {
+		idx, ok := format.columns[name]
+		if !ok {
+			if format.settings.InputSkipUnknownFields {
+				continue
+			}
+			return nil, format.parseError(string(line), errors.Errorf("unknown field:%s", name))
+		}
+		text, isNull, err := jsonText(raw)
+		if err != nil {
+			return nil, format.parseError(string(line), errors.Errorf("field:%s %v", name, err))
+		}
+		if isNull {
+			continue
+		}
+		if values[idx], err = cols[idx].DataType.DeserializeText(text); err != nil {
+			return nil, format.parseError(string(line), errors.Errorf("field:%s %v", name, err))
+		}
+	}
+	for i, col := range cols {
+		if values[i] == nil {
+			values[i] = defaultValue(col.DataType)
+		}
+	}
+	return values, nil
+}
+
+// jsonText returns the text of the JSON scalar, the strings are unquoted.
+func jsonText(raw json.RawMessage) (string, bool, error) {
+	s := strings.TrimSpace(string(raw))
+	switch {
+	case s == "null":
+		return "", true, nil
+	case strings.HasPrefix(s, `"`):
+		var text string
+		if err := json.Unmarshal(raw, &text); err != nil {
+			return "", false, err
+		}
+		return text, false, nil
+	case s == "true":
+		return "1", false, nil
+	case s == "false":
+		return "0", false, nil
+	case strings.HasPrefix(s, "{"), strings.HasPrefix(s, "["):
+		return "", false, errors.New("nested values are not supported")
+	}
+	return s, false, nil
+}
@@ -0,0 +1,98 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package dataformats
+
+import (
+	"bufio"
+	"io"
+
+	"base/binary"
+	"base/errors"
+	"datablocks"
+	"datavalues"
+)
+
+// NativeInputFormat reads the blocks written by the NativeOutputFormat,
+// the columns are matched by name to the header and the absent ones are filled with the defaults.
+type NativeInputFormat struct {
+	header *datablocks.DataBlock
+	input  *bufio.Reader
+	reader *binary.Reader
+}
+
+func NewNativeInputFormat(header *datablocks.DataBlock, reader io.Reader, settings *FormatSettings) IDataBlockInputFormat {
+	input := bufio.NewReader(reader)
+	return &NativeInputFormat{
+		header: header,
+		input:  input,
+		reader: binary.NewReader(input),
+	}
+}
+
+func (format *NativeInputFormat) ReadPrefix() error {
+	return nil
+}
+
+func (format *NativeInputFormat) Read() (*datablocks.DataBlock, error) {
+	for {
+		if _, err := format.input.Peek(1); err == io.EOF {
+			return nil, nil
+		}
+		block, err := datablocks.ReadNative(format.reader)
+		if err != nil {
+			return nil, err
+		}
+		if block == nil || block.NumRows() == 0 {
+			continue
+		}
+		return format.convert(block)
+	}
+}
+
+func (format *NativeInputFormat) ReadSuffix() error {
+	return nil
+}
+
+// convert reorders the columns of the block to the header.
+func (format *NativeInputFormat) convert(block *datablocks.DataBlock) (*datablocks.DataBlock, error) {
+	header := format.header.Columns()
+	indexes := make(map[string]int, len(header))
+	for i, col := range header {
+		indexes[col.Name] = i
+	}
+
+	positions := make([]int, len(header))
+	for i := range positions {
+		positions[i] = -1
+	}
+	for j, col := range block.Columns() {
+		i, ok := indexes[col.Name]
+		if !ok {
+			return nil, errors.ErrorWithCode(errors.NO_SUCH_COLUMN_IN_TABLE, "No such column %s in table", col.Name)
+		}
+		if expect := header[i].DataType.Name(); col.DataType.Name() != expect {
+			return nil, errors.ErrorWithCode(errors.TYPE_MISMATCH, "Type mismatch of column %s, expected %s, but got %s", col.Name, expect, col.DataType.Name())
+		}
+		positions[i] = j
+	}
+
+	res := format.header.Clone()
+	iter := block.RowIterator()
+	for iter.Next() {
+		values := iter.Value()
+		row := make([]datavalues.IDataValue, len(header))
+		for i, j := range positions {
+			if j < 0 {
+				row[i] = defaultValue(header[i].DataType)
+			} else {
+				row[i] = values[j]
+			}
+		}
+		if err := res.WriteRow(row); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
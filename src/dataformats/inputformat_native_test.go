@@ -0,0 +1,94 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package dataformats
+
+import (
+	"bytes"
+	"testing"
+
+	"columns"
+	"datablocks"
+	"datatypes"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNativeFormat(t *testing.T) {
+	tests := []struct {
+		name   string
+		header []*columns.Column
+		expect string
+		err    string
+	}{
+		{
+			name: "same",
+			header: []*columns.Column{
+				{Name: "name", DataType: datatypes.NewStringDataType()},
+				{Name: "age", DataType: datatypes.NewInt32DataType()},
+			},
+			expect: "x\\ty\\\\z\t11\na \"b\", c\\nd\t-13\n",
+		},
+		{
+			name: "reorder-and-default",
+			header: []*columns.Column{
+				{Name: "id", DataType: datatypes.NewUInt64DataType()},
+				{Name: "age", DataType: datatypes.NewInt32DataType()},
+				{Name: "name", DataType: datatypes.NewStringDataType()},
+			},
+			expect: "0\t11\tx\\ty\\\\z\n0\t-13\ta \"b\", c\\nd\n",
+		},
+		{
+			name: "unknown-column",
+			header: []*columns.Column{
+				{Name: "name", DataType: datatypes.NewStringDataType()},
+			},
+			err: "No such column age in table (errno 16)",
+		},
+		{
+			name: "type-mismatch",
+			header: []*columns.Column{
+				{Name: "name", DataType: datatypes.NewStringDataType()},
+				{Name: "age", DataType: datatypes.NewInt64DataType()},
+			},
+			err: "Type mismatch of column age, expected Int64, but got Int32 (errno 53)",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Two blocks of the Native format.
+			native := new(bytes.Buffer)
+			output := FactoryGetOutput("Native")(nil, native, nil)
+			assert.Nil(t, output.WritePrefix())
+			assert.Nil(t, output.Write(testTextBlock(t)))
+			assert.Nil(t, output.WriteSuffix())
+			data := native.Bytes()
+			data = append(data, data...)
+
+			header := datablocks.NewDataBlock(test.header)
+			input := FactoryGetInput("Native")(header, bytes.NewReader(data), nil)
+			assert.Nil(t, input.ReadPrefix())
+			buf := new(bytes.Buffer)
+			text := FactoryGetOutput("TSV")(header, buf, nil)
+			blocks := 0
+			for {
+				block, err := input.Read()
+				if test.err != "" {
+					assert.Equal(t, test.err, err.Error())
+					return
+				}
+				assert.Nil(t, err)
+				if block == nil {
+					break
+				}
+				assert.Nil(t, text.Write(block))
+				blocks++
+			}
+			assert.Nil(t, input.ReadSuffix())
+			assert.Equal(t, 2, blocks)
+			assert.Equal(t, test.expect+test.expect, buf.String())
+		})
+	}
+}
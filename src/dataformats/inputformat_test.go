@@ -0,0 +1,125 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package dataformats
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInputFormat(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		input  string
+		blocks int
+		expect string
+		err    string
+		// skipUnknown is the input_format_skip_unknown_fields.
+		skipUnknown bool
+	}{
+		{
+			name:   "TSV",
+			format: "TSV",
+			input:  "x\\ty\\\\z\t11\na \"b\", c\\nd\t-13\ne\t1\n",
+			blocks: 2,
+			expect: "x\\ty\\\\z\t11\na \"b\", c\\nd\t-13\ne\t1\n",
+		},
+		{
+			name:   "TSVWithNames",
+			format: "TSVWithNames",
+			input:  "name\tage\ne\t1",
+			blocks: 1,
+			expect: "e\t1\n",
+		},
+		{
+			name:   "TSV-columns-mismatch",
+			format: "TabSeparated",
+			input:  "e\t1\nf\n",
+			err:    "Cannot parse input at line 2: expected 2 fields, but got 1, row: \"f\" (errno 6)",
+		},
+		{
+			name:   "CSV",
+			format: "CSV",
+			input:  "\"x\ty\\z\",11\n\"a \"\"b\"\", c\nd\",-13\ne,1\n",
+			blocks: 2,
+			expect: "x\\ty\\\\z\t11\na \"b\", c\\nd\t-13\ne\t1\n",
+		},
+		{
+			name:   "CSVWithNames",
+			format: "CSVWithNames",
+			input:  "name,age\ne,1\n",
+			blocks: 1,
+			expect: "e\t1\n",
+		},
+		{
+			name:   "CSV-parse-error",
+			format: "CSV",
+			input:  "e,1\nf,1\ng,x\n",
+			err:    "Cannot parse input at line 3: column:age strconv.ParseInt: parsing \"x\": invalid syntax, row: \"g,x\" (errno 6)",
+		},
+		{
+			name:   "JSONEachRow",
+			format: "JSONEachRow",
+			input:  "{\"name\":\"x\\ty\",\"age\":11}\n\n{\"age\":-13}\n{\"name\":\"e\",\"age\":null}\n",
+			blocks: 2,
+			expect: "x\\ty\t11\n\t-13\ne\t0\n",
+		},
+		{
+			name:   "JSONEachRow-unknown-field",
+			format: "JSONEachRow",
+			input:  "{\"name\":\"x\",\"age\":11}\n{\"name\":\"y\",\"sex\":1}\n",
+			err:    "Cannot parse input at line 2: unknown field:sex, row: \"{\\\"name\\\":\\\"y\\\",\\\"sex\\\":1}\" (errno 6)",
+		},
+		{
+			name:        "JSONEachRow-skip-unknown-field",
+			format:      "JSONEachRow",
+			input:       "{\"name\":\"x\",\"age\":11}\n{\"name\":\"y\",\"sex\":1}\n",
+			skipUnknown: true,
+			blocks:      1,
+			expect:      "x\t11\ny\t0\n",
+		},
+		{
+			name:   "JSONEachRow-parse-error",
+			format: "JSONEachRow",
+			input:  "{\"name\":\"x\",\"age\":11}\n\n{\"name\":\"y\",\"age\":\"z\"}\n",
+			err:    "Cannot parse input at line 3: field:age strconv.ParseInt: parsing \"z\": invalid syntax, row: \"{\\\"name\\\":\\\"y\\\",\\\"age\\\":\\\"z\\\"}\" (errno 6)",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			header := testTextBlock(t).Clone()
+			settings := DefaultFormatSettings()
+			settings.MaxInsertBlockSize = 2
+			settings.InputSkipUnknownFields = test.skipUnknown
+			format := FactoryGetInput(test.format)(header, strings.NewReader(test.input), settings)
+
+			buf := new(bytes.Buffer)
+			output := FactoryGetOutput("TSV")(header, buf, nil)
+			blocks := 0
+			err := format.ReadPrefix()
+			for err == nil {
+				block, rerr := format.Read()
+				if err = rerr; err != nil || block == nil {
+					break
+				}
+				assert.Nil(t, output.Write(block))
+				blocks++
+			}
+			if test.err != "" {
+				assert.Equal(t, test.err, err.Error())
+				return
+			}
+			assert.Nil(t, err)
+			assert.Nil(t, format.ReadSuffix())
+			assert.Equal(t, test.blocks, blocks)
+			assert.Equal(t, test.expect, buf.String())
+		})
+	}
+}
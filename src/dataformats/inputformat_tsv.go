@@ -0,0 +1,121 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package dataformats
+
+import (
+	"bufio"
+	"io"
+	"strings"
+
+	"datablocks"
+	"datavalues"
+
+	"base/errors"
+)
+
+type TSVInputFormat struct {
+	rowInputFormat
+	reader    *bufio.Reader
+	withNames bool
+}
+
+func NewTSVInputFormat(header *datablocks.DataBlock, reader io.Reader, settings *FormatSettings) IDataBlockInputFormat {
+	return newTSVInputFormat(header, reader, settings, false)
+}
+
+func NewTSVWithNamesInputFormat(header *datablocks.DataBlock, reader io.Reader, settings *FormatSettings) IDataBlockInputFormat {
+	return newTSVInputFormat(header, reader, settings, true)
+}
+
+func newTSVInputFormat(header *datablocks.DataBlock, reader io.Reader, settings *FormatSettings, withNames bool) IDataBlockInputFormat {
+	format := &TSVInputFormat{
+		rowInputFormat: newRowInputFormat(header, settings),
+		reader:         bufio.NewReader(reader),
+		withNames:      withNames,
+	}
+	format.readRow = format.next
+	return format
+}
+
+// ReadPrefix skips the names row.
+func (format *TSVInputFormat) ReadPrefix() error {
+	if !format.withNames {
+		return nil
+	}
+	_, err := format.readLine()
+	if err == io.EOF {
+		return nil
+	}
+	return err
+}
+
+func (format *TSVInputFormat) readLine() (string, error) {
+	line, err := format.reader.ReadString('\n')
+	if err != nil && err != io.EOF {
+		return "", errors.Wrap(err)
+	}
+	if err == io.EOF && line == "" {
+		return "", io.EOF
+	}
+	format.line++
+	return strings.TrimSuffix(strings.TrimSuffix(line, "\n"), "\r"), nil
+}
+
+func (format *TSVInputFormat) next() ([]datavalues.IDataValue, error) {
+	for {
+		line, err := format.readLine()
+		if err != nil {
+			return nil, err
+		}
+		if line == "" {
+			continue
+		}
+
+		fields := strings.Split(line, "\t")
+		for i := range fields {
+			if fields[i], err = unescapeTSV(fields[i]); err != nil {
+				return nil, format.parseError(line, err)
+			}
+		}
+		return format.parseFields(fields, line)
+	}
+}
+
+// unescapeTSV unescapes the ClickHouse style TSV field.
+func unescapeTSV(s string) (string, error) {
+	if strings.IndexByte(s, '\\') < 0 {
+		return s, nil
+	}
+
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c != '\\' {
+			sb.WriteByte(c)
+			continue
+		}
+		i++
+		if i == len(s) {
+			return "", errors.New("unterminated escape sequence")
+		}
+		switch s[i] {
+		case 't':
+			sb.WriteByte('\t')
+		case 'n':
+			sb.WriteByte('\n')
+		case 'r':
+			sb.WriteByte('\r')
+		case 'b':
+			sb.WriteByte('\b')
+		case 'f':
+			sb.WriteByte('\f')
+		case '0':
+			sb.WriteByte(0)
+		default:
+			sb.WriteByte(s[i])
+		}
+	}
+	return sb.String(), nil
+}
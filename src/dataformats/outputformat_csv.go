@@ -0,0 +1,116 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package dataformats
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"sync"
+
+	"datablocks"
+	"datatypes"
+)
+
+type CSVOutputFormat struct {
+	mu        sync.RWMutex
+	writer    io.Writer
+	header    *datablocks.DataBlock
+	withNames bool
+	buf       bytes.Buffer
+	text      bytes.Buffer
+}
+
+func NewCSVOutputFormat(header *datablocks.DataBlock, writer io.Writer, settings *FormatSettings) IDataBlockOutputFormat {
+	return &CSVOutputFormat{
+		writer:    writer,
+		header:    header,
+		withNames: false,
+	}
+}
+
+func NewCSVWithNamesOutputFormat(header *datablocks.DataBlock, writer io.Writer, settings *FormatSettings) IDataBlockOutputFormat {
+	return &CSVOutputFormat{
+		writer:    writer,
+		header:    header,
+		withNames: true,
+	}
+}
+
+func (format *CSVOutputFormat) WritePrefix() error {
+	format.mu.Lock()
+	defer format.mu.Unlock()
+
+	if !format.withNames || format.header == nil {
+		return nil
+	}
+
+	buf := &format.buf
+	buf.Reset()
+	for i, column := range format.header.Columns() {
+		if i != 0 {
+			buf.WriteByte(',')
+		}
+		writeCSVString(buf, column.Name)
+	}
+	buf.WriteByte('\n')
+	_, err := format.writer.Write(buf.Bytes())
+	return err
+}
+
+func (format *CSVOutputFormat) Write(block *datablocks.DataBlock) error {
+	format.mu.Lock()
+	defer format.mu.Unlock()
+
+	buf := &format.buf
+	buf.Reset()
+	iters := block.ColumnIterators()
+	for i := 0; i < block.NumRows(); i++ {
+		for j, it := range iters {
+			if j != 0 {
+				buf.WriteByte(',')
+			}
+			column := it.Column()
+			if it.Next() {
+				if isNumericType(column.DataType) {
+					if err := column.DataType.SerializeText(buf, it.Value()); err != nil {
+						return err
+					}
+					continue
+				}
+				text := &format.text
+				text.Reset()
+				if err := column.DataType.SerializeText(text, it.Value()); err != nil {
+					return err
+				}
+				writeCSVString(buf, text.String())
+			}
+		}
+		buf.WriteByte('\n')
+	}
+	_, err := format.writer.Write(buf.Bytes())
+	return err
+}
+
+func (format *CSVOutputFormat) WriteSuffix() error {
+	return nil
+}
+
+func isNumericType(datatype datatypes.IDataType) bool {
+	switch datatype.Name() {
+	case datatypes.DataTypeInt32Name, datatypes.DataTypeUInt32Name,
+		datatypes.DataTypeInt64Name, datatypes.DataTypeUInt64Name,
+		datatypes.DataTypeFloat64Name:
+		return true
+	}
+	return false
+}
+
+// writeCSVString writes the RFC 4180 quoted field, the embedded quotes are doubled.
+func writeCSVString(buf *bytes.Buffer, s string) {
+	buf.WriteByte('"')
+	buf.WriteString(strings.Replace(s, `"`, `""`, -1))
+	buf.WriteByte('"')
+}
@@ -0,0 +1,55 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package dataformats
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCSVOutputFormat(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		expect string
+		header bool
+	}{
+		{
+			name:   "CSV",
+			format: "CSV",
+			expect: "\"x\ty\\z\",11\n\"a \"\"b\"\", c\nd\",-13\n",
+		},
+		{
+			name:   "CSVWithNames",
+			format: "CSVWithNames",
+			expect: "\"name\",\"age\"\n\"x\ty\\z\",11\n\"a \"\"b\"\", c\nd\",-13\n",
+			header: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			block := testTextBlock(t)
+			buf := new(bytes.Buffer)
+			format := FactoryGetOutput(test.format)(block.Clone(), buf, nil)
+			assert.Nil(t, format.WritePrefix())
+			assert.Nil(t, format.Write(block))
+			assert.Nil(t, format.WriteSuffix())
+			assert.Equal(t, test.expect, buf.String())
+
+			// RFC 4180 round trip.
+			records, err := csv.NewReader(bytes.NewReader(buf.Bytes())).ReadAll()
+			assert.Nil(t, err)
+			if test.header {
+				assert.Equal(t, []string{"name", "age"}, records[0])
+				records = records[1:]
+			}
+			assert.Equal(t, [][]string{{"x\ty\\z", "11"}, {"a \"b\", c\nd", "-13"}}, records)
+		})
+	}
+}
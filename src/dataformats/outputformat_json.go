@@ -0,0 +1,186 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package dataformats
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"sync"
+
+	"datablocks"
+	"datatypes"
+	"datavalues"
+)
+
+// JSONOutputFormat writes the ClickHouse JSON envelope:
+// {"meta": [...], "data": [...], "rows": N, "rows_before_limit_at_least": N, "statistics": {...}}
+type JSONOutputFormat struct {
+	mu         sync.RWMutex
+	writer     io.Writer
+	header     *datablocks.DataBlock
+	settings   *FormatSettings
+	statistics *Statistics
+	rows       int
+	buf        bytes.Buffer
+}
+
+func NewJSONOutputFormat(header *datablocks.DataBlock, writer io.Writer, settings *FormatSettings) IDataBlockOutputFormat {
+	if settings == nil {
+		settings = DefaultFormatSettings()
+	}
+	return &JSONOutputFormat{
+		writer:   writer,
+		header:   header,
+		settings: settings,
+	}
+}
+
+func (format *JSONOutputFormat) SetStatistics(statistics *Statistics) {
+	format.mu.Lock()
+	defer format.mu.Unlock()
+	format.statistics = statistics
+}
+
+func (format *JSONOutputFormat) WritePrefix() error {
+	format.mu.Lock()
+	defer format.mu.Unlock()
+
+	buf := &format.buf
+	buf.Reset()
+	buf.WriteString("{\n\t\"meta\":\n\t[")
+	if format.header != nil {
+		for i, column := range format.header.Columns() {
+			if i > 0 {
+				buf.WriteString(",")
+			}
+			buf.WriteString("\n\t\t{\n\t\t\t\"name\": ")
+			writeJSONString(buf, column.Name)
+			buf.WriteString(",\n\t\t\t\"type\": ")
+			writeJSONString(buf, column.DataType.Name())
+			buf.WriteString("\n\t\t}")
+		}
+	}
+	buf.WriteString("\n\t],\n\n\t\"data\":\n\t[")
+	_, err := format.writer.Write(buf.Bytes())
+	return err
+}
+
+func (format *JSONOutputFormat) Write(block *datablocks.DataBlock) error {
+	format.mu.Lock()
+	defer format.mu.Unlock()
+
+	buf := &format.buf
+	buf.Reset()
+	iters := block.ColumnIterators()
+	for i := 0; i < block.NumRows(); i++ {
+		if format.rows > 0 {
+			buf.WriteString(",")
+		}
+		buf.WriteString("\n\t\t{")
+		for j, it := range iters {
+			if j > 0 {
+				buf.WriteString(",")
+			}
+			column := it.Column()
+			buf.WriteString("\n\t\t\t")
+			writeJSONString(buf, column.Name)
+			buf.WriteString(": ")
+			if it.Next() {
+				if err := writeJSONValue(buf, format.settings, column.DataType, it.Value()); err != nil {
+					return err
+				}
+			}
+		}
+		buf.WriteString("\n\t\t}")
+		format.rows++
+	}
+	_, err := format.writer.Write(buf.Bytes())
+	return err
+}
+
+// writeJSONValue writes the numbers as they are, the 64-bit integers quoted by the settings,
+// and the others as the strings of their text.
+func writeJSONValue(buf *bytes.Buffer, settings *FormatSettings, datatype datatypes.IDataType, v datavalues.IDataValue) error {
+	switch datatype.Name() {
+	case datatypes.DataTypeInt32Name, datatypes.DataTypeUInt32Name:
+		return datatype.SerializeText(buf, v)
+	case datatypes.DataTypeInt64Name, datatypes.DataTypeUInt64Name:
+		if settings.JSONQuote64bitIntegers {
+			buf.WriteByte('"')
+			defer buf.WriteByte('"')
+		}
+		return datatype.SerializeText(buf, v)
+	case datatypes.DataTypeFloat64Name:
+		if f := datavalues.AsFloat(v); math.IsNaN(f) || math.IsInf(f, 0) {
+			buf.WriteString("null")
+			return nil
+		}
+		return datatype.SerializeText(buf, v)
+	default:
+		var text bytes.Buffer
+		if err := datatype.SerializeText(&text, v); err != nil {
+			return err
+		}
+		writeJSONString(buf, text.String())
+		return nil
+	}
+}
+
+func (format *JSONOutputFormat) WriteSuffix() error {
+	format.mu.Lock()
+	defer format.mu.Unlock()
+
+	buf := &format.buf
+	buf.Reset()
+	fmt.Fprintf(buf, "\n\t],\n\n\t\"rows\": %d", format.rows)
+	if stats := format.statistics; stats != nil {
+		if stats.AppliedLimit {
+			fmt.Fprintf(buf, ",\n\n\t\"rows_before_limit_at_least\": %d", stats.RowsBeforeLimit)
+		}
+		fmt.Fprintf(buf, ",\n\n\t\"statistics\":\n\t{\n\t\t\"elapsed\": %f,\n\t\t\"rows_read\": %d,\n\t\t\"bytes_read\": %d\n\t}", stats.Elapsed.Seconds(), stats.RowsRead, stats.BytesRead)
+	}
+	buf.WriteString("\n}\n")
+	_, err := format.writer.Write(buf.Bytes())
+	return err
+}
+
+// writeJSONString writes the ClickHouse style escaped JSON string.
+func writeJSONString(buf *bytes.Buffer, s string) {
+	const hex = "0123456789abcdef"
+
+	buf.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch c {
+		case '"':
+			buf.WriteString("\\\"")
+		case '\\':
+			buf.WriteString("\\\\")
+		case '/':
+			buf.WriteString("\\/")
+		case '\b':
+			buf.WriteString("\\b")
+		case '\f':
+			buf.WriteString("\\f")
+		case '\n':
+			buf.WriteString("\\n")
+		case '\r':
+			buf.WriteString("\\r")
+		case '\t':
+			buf.WriteString("\\t")
+		default:
+			if c < 0x20 {
+				buf.WriteString("\\u00")
+				buf.WriteByte(hex[c>>4])
+				buf.WriteByte(hex[c&0xf])
+			} else {
+				buf.WriteByte(c)
+			}
+		}
+	}
+	buf.WriteByte('"')
+}
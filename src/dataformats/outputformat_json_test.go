@@ -0,0 +1,175 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package dataformats
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"columns"
+	"datablocks"
+	"datatypes"
+	"datavalues"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSONOutputFormat(t *testing.T) {
+	cols := []*columns.Column{
+		{Name: "name", DataType: datatypes.NewStringDataType()},
+		{Name: "age", DataType: datatypes.NewInt32DataType()},
+		{Name: "id", DataType: datatypes.NewUInt64DataType()},
+	}
+
+	tests := []struct {
+		name     string
+		settings *FormatSettings
+		stats    *Statistics
+		expect   string
+	}{
+		{
+			name:     "quote-64bit",
+			settings: DefaultFormatSettings(),
+			stats: &Statistics{
+				Elapsed:         1500 * time.Microsecond,
+				RowsRead:        3,
+				BytesRead:       64,
+				AppliedLimit:    true,
+				RowsBeforeLimit: 3,
+			},
+			expect: `{
+	"meta":
+	[
+		{
+			"name": "name",
+			"type": "String"
+		},
+		{
+			"name": "age",
+			"type": "Int32"
+		},
+		{
+			"name": "id",
+			"type": "UInt64"
+		}
+	],
+
+	"data":
+	[
+		{
+			"name": "x\"y\/z",
+			"age": 11,
+			"id": "9007199254740993"
+		},
+		{
+			"name": "z\t",
+			"age": 13,
+			"id": "1"
+		}
+	],
+
+	"rows": 2,
+
+	"rows_before_limit_at_least": 3,
+
+	"statistics":
+	{
+		"elapsed": 0.001500,
+		"rows_read": 3,
+		"bytes_read": 64
+	}
+}
+`,
+		},
+		{
+			name:     "no-quote-64bit",
+			settings: &FormatSettings{JSONQuote64bitIntegers: false},
+			stats:    &Statistics{},
+			expect: `{
+	"meta":
+	[
+		{
+			"name": "name",
+			"type": "String"
+		},
+		{
+			"name": "age",
+			"type": "Int32"
+		},
+		{
+			"name": "id",
+			"type": "UInt64"
+		}
+	],
+
+	"data":
+	[
+		{
+			"name": "x\"y\/z",
+			"age": 11,
+			"id": 9007199254740993
+		},
+		{
+			"name": "z\t",
+			"age": 13,
+			"id": 1
+		}
+	],
+
+	"rows": 2,
+
+	"statistics":
+	{
+		"elapsed": 0.000000,
+		"rows_read": 0,
+		"bytes_read": 0
+	}
+}
+`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			block1 := datablocks.NewDataBlock(cols)
+			assert.Nil(t, block1.WriteRow([]datavalues.IDataValue{datavalues.MakeString("x\"y/z"), datavalues.MakeInt32(11), datavalues.ToValue(uint64(9007199254740993))}))
+			block2 := datablocks.NewDataBlock(cols)
+			assert.Nil(t, block2.WriteRow([]datavalues.IDataValue{datavalues.MakeString("z\t"), datavalues.MakeInt32(13), datavalues.ToValue(uint64(1))}))
+
+			buf := new(bytes.Buffer)
+			format := NewJSONOutputFormat(block1.Clone(), buf, test.settings)
+			assert.Nil(t, format.WritePrefix())
+			assert.Nil(t, format.Write(block1))
+			assert.Nil(t, format.Write(block2))
+			format.(IStatisticsOutputFormat).SetStatistics(test.stats)
+			assert.Nil(t, format.WriteSuffix())
+			assert.Equal(t, test.expect, buf.String())
+			assert.True(t, json.Valid(buf.Bytes()))
+		})
+	}
+}
+
+func TestJSONOutputFormatEmpty(t *testing.T) {
+	buf := new(bytes.Buffer)
+	format := NewJSONOutputFormat(nil, buf, nil)
+	assert.Nil(t, format.WritePrefix())
+	assert.Nil(t, format.WriteSuffix())
+
+	expect := `{
+	"meta":
+	[
+	],
+
+	"data":
+	[
+	],
+
+	"rows": 0
+}
+`
+	assert.Equal(t, expect, buf.String())
+}
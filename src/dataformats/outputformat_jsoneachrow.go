@@ -0,0 +1,70 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package dataformats
+
+import (
+	"bytes"
+	"io"
+	"sync"
+
+	"datablocks"
+)
+
+// JSONEachRowOutputFormat writes one compact JSON object per line, with no envelope,
+// so the rows are streamed as newline-delimited JSON.
+type JSONEachRowOutputFormat struct {
+	mu       sync.Mutex
+	writer   io.Writer
+	header   *datablocks.DataBlock
+	settings *FormatSettings
+	buf      bytes.Buffer
+}
+
+func NewJSONEachRowOutputFormat(header *datablocks.DataBlock, writer io.Writer, settings *FormatSettings) IDataBlockOutputFormat {
+	if settings == nil {
+		settings = DefaultFormatSettings()
+	}
+	return &JSONEachRowOutputFormat{
+		writer:   writer,
+		header:   header,
+		settings: settings,
+	}
+}
+
+func (format *JSONEachRowOutputFormat) WritePrefix() error {
+	return nil
+}
+
+func (format *JSONEachRowOutputFormat) Write(block *datablocks.DataBlock) error {
+	format.mu.Lock()
+	defer format.mu.Unlock()
+
+	buf := &format.buf
+	buf.Reset()
+	iters := block.ColumnIterators()
+	for i := 0; i < block.NumRows(); i++ {
+		buf.WriteByte('{')
+		for j, it := range iters {
+			if j > 0 {
+				buf.WriteByte(',')
+			}
+			column := it.Column()
+			writeJSONString(buf, column.Name)
+			buf.WriteByte(':')
+			if it.Next() {
+				if err := writeJSONValue(buf, format.settings, column.DataType, it.Value()); err != nil {
+					return err
+				}
+			}
+		}
+		buf.WriteString("}\n")
+	}
+	_, err := format.writer.Write(buf.Bytes())
+	return err
+}
+
+func (format *JSONEachRowOutputFormat) WriteSuffix() error {
+	return nil
+}
@@ -0,0 +1,66 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package dataformats
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"columns"
+	"datablocks"
+	"datatypes"
+	"datavalues"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSONEachRowOutputFormat(t *testing.T) {
+	cols := []*columns.Column{
+		{Name: "name", DataType: datatypes.NewStringDataType()},
+		{Name: "id", DataType: datatypes.NewUInt64DataType()},
+		{Name: "ts", DataType: datatypes.NewDateTimeDataType()},
+	}
+	ts := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		settings *FormatSettings
+		expect   string
+	}{
+		{
+			name:     "quote-64bit",
+			settings: nil,
+			expect:   "{\"name\":\"x\\ty\",\"id\":\"1\",\"ts\":\"2020-01-02 03:04:05\"}\n{\"name\":\"a\\\"b\",\"id\":\"2\",\"ts\":\"2020-01-02 03:04:05\"}\n",
+		},
+		{
+			name:     "no-quote-64bit",
+			settings: &FormatSettings{},
+			expect:   "{\"name\":\"x\\ty\",\"id\":1,\"ts\":\"2020-01-02 03:04:05\"}\n{\"name\":\"a\\\"b\",\"id\":2,\"ts\":\"2020-01-02 03:04:05\"}\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			block := datablocks.NewDataBlock(cols)
+			assert.Nil(t, block.WriteRow([]datavalues.IDataValue{datavalues.MakeString("x\ty"), datavalues.MakeInt(1), datavalues.MakeTime(ts)}))
+			assert.Nil(t, block.WriteRow([]datavalues.IDataValue{datavalues.MakeString("a\"b"), datavalues.MakeInt(2), datavalues.MakeTime(ts)}))
+
+			buf := new(bytes.Buffer)
+			format := FactoryGetOutput("JSONEachRow")(block.Clone(), buf, test.settings)
+			assert.Nil(t, format.WritePrefix())
+			assert.Nil(t, format.Write(block))
+			assert.Nil(t, format.WriteSuffix())
+			assert.Equal(t, test.expect, buf.String())
+
+			// Round trip by the input format.
+			input := FactoryGetInput("JSONEachRow")(block.Clone(), strings.NewReader(buf.String()), nil)
+			read, err := input.Read()
+			assert.Nil(t, err)
+			assert.Equal(t, 2, read.NumRows())
+		})
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package dataformats
+
+import (
+	"io"
+	"sync"
+
+	"base/binary"
+	"datablocks"
+)
+
+// NativeOutputFormat writes the blocks in the Native format of the HTTP interface,
+// the same columns encoding as the TCP Data packets but without the block info.
+type NativeOutputFormat struct {
+	mu     sync.Mutex
+	writer *binary.Writer
+}
+
+func NewNativeOutputFormat(header *datablocks.DataBlock, writer io.Writer, settings *FormatSettings) IDataBlockOutputFormat {
+	return &NativeOutputFormat{
+		writer: binary.NewWriter(writer),
+	}
+}
+
+func (format *NativeOutputFormat) WritePrefix() error {
+	return nil
+}
+
+func (format *NativeOutputFormat) Write(block *datablocks.DataBlock) error {
+	format.mu.Lock()
+	defer format.mu.Unlock()
+	return block.WriteNative(format.writer)
+}
+
+func (format *NativeOutputFormat) WriteSuffix() error {
+	return nil
+}
@@ -0,0 +1,294 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package dataformats
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"columns"
+	"datablocks"
+	"datatypes"
+)
+
+type prettyStyle int
+
+const (
+	prettyFull prettyStyle = iota
+	prettyCompact
+	prettySpace
+)
+
+// PrettyOutputFormat writes the blocks as the tables of clickhouse-client, without colors.
+// The column widths are computed per block, the numbers are right aligned.
+type PrettyOutputFormat struct {
+	mu       sync.Mutex
+	writer   io.Writer
+	header   *datablocks.DataBlock
+	style    prettyStyle
+	maxRows  int
+	maxWidth int
+	// The rows written and all the rows of the blocks, beyond the maxRows.
+	rows  int
+	total int
+	buf   bytes.Buffer
+	text  bytes.Buffer
+}
+
+func newPrettyOutputFormat(header *datablocks.DataBlock, writer io.Writer, settings *FormatSettings, style prettyStyle) IDataBlockOutputFormat {
+	if settings == nil {
+		settings = DefaultFormatSettings()
+	}
+	return &PrettyOutputFormat{
+		writer:   writer,
+		header:   header,
+		style:    style,
+		maxRows:  settings.PrettyMaxRows,
+		maxWidth: settings.PrettyMaxColumnPadWidth,
+	}
+}
+
+func NewPrettyOutputFormat(header *datablocks.DataBlock, writer io.Writer, settings *FormatSettings) IDataBlockOutputFormat {
+	return newPrettyOutputFormat(header, writer, settings, prettyFull)
+}
+
+func NewPrettyCompactOutputFormat(header *datablocks.DataBlock, writer io.Writer, settings *FormatSettings) IDataBlockOutputFormat {
+	return newPrettyOutputFormat(header, writer, settings, prettyCompact)
+}
+
+func NewPrettySpaceOutputFormat(header *datablocks.DataBlock, writer io.Writer, settings *FormatSettings) IDataBlockOutputFormat {
+	return newPrettyOutputFormat(header, writer, settings, prettySpace)
+}
+
+func (format *PrettyOutputFormat) WritePrefix() error {
+	return nil
+}
+
+// Write writes the block as one table, up to the max rows of the whole output.
+func (format *PrettyOutputFormat) Write(block *datablocks.DataBlock) error {
+	format.mu.Lock()
+	defer format.mu.Unlock()
+
+	n := block.NumRows()
+	format.total += n
+	if n > format.maxRows-format.rows {
+		n = format.maxRows - format.rows
+	}
+	if n <= 0 {
+		return nil
+	}
+
+	cols := block.Columns()
+	right := make([]bool, len(cols))
+	widths := make([]int, len(cols))
+	names := make([]string, len(cols))
+	for j, col := range cols {
+		right[j] = isPrettyNumber(col)
+		names[j] = format.cell(col.Name, true)
+		widths[j] = displayWidth(names[j])
+	}
+
+	cells := make([][]string, n)
+	iters := block.ColumnIterators()
+	for i := range cells {
+		cells[i] = make([]string, len(cols))
+		for j, it := range iters {
+			if !it.Next() {
+				continue
+			}
+			text := &format.text
+			text.Reset()
+			if err := it.Column().DataType.SerializeText(text, it.Value()); err != nil {
+				return err
+			}
+			cells[i][j] = format.cell(text.String(), !right[j])
+			if w := displayWidth(cells[i][j]); w > widths[j] {
+				widths[j] = w
+			}
+		}
+	}
+
+	buf := &format.buf
+	buf.Reset()
+	switch format.style {
+	case prettyFull:
+		writePrettyLine(buf, widths, "┏", "━", "┳", "┓")
+		writePrettyRow(buf, names, widths, right, "┃")
+		writePrettyLine(buf, widths, "┡", "━", "╇", "┩")
+		for i, row := range cells {
+			if i > 0 {
+				writePrettyLine(buf, widths, "├", "─", "┼", "┤")
+			}
+			writePrettyRow(buf, row, widths, right, "│")
+		}
+		writePrettyLine(buf, widths, "└", "─", "┴", "┘")
+	case prettyCompact:
+		buf.WriteString("┌")
+		for j, name := range names {
+			if j > 0 {
+				buf.WriteString("┬")
+			}
+			pad := strings.Repeat("─", widths[j]-displayWidth(name))
+			buf.WriteString("─")
+			if right[j] {
+				buf.WriteString(pad + name)
+			} else {
+				buf.WriteString(name + pad)
+			}
+			buf.WriteString("─")
+		}
+		buf.WriteString("┐\n")
+		for _, row := range cells {
+			writePrettyRow(buf, row, widths, right, "│")
+		}
+		writePrettyLine(buf, widths, "└", "─", "┴", "┘")
+	case prettySpace:
+		if format.rows > 0 {
+			buf.WriteString("\n")
+		}
+		writePrettySpaceRow(buf, names, widths, right)
+		buf.WriteString("\n")
+		for _, row := range cells {
+			writePrettySpaceRow(buf, row, widths, right)
+		}
+	}
+	format.rows += n
+
+	_, err := format.writer.Write(buf.Bytes())
+	return err
+}
+
+// WriteSuffix writes the footer with the row count, and the cutoff if the rows are beyond the max.
+func (format *PrettyOutputFormat) WriteSuffix() error {
+	format.mu.Lock()
+	defer format.mu.Unlock()
+
+	buf := &format.buf
+	buf.Reset()
+	if format.total > format.rows {
+		fmt.Fprintf(buf, "Showed first %d.\n", format.rows)
+	}
+	if format.total == 1 {
+		buf.WriteString("1 row in set.\n")
+	} else {
+		fmt.Fprintf(buf, "%d rows in set.\n", format.total)
+	}
+	_, err := format.writer.Write(buf.Bytes())
+	return err
+}
+
+// cell escapes the control characters like TSV and cuts the text wider than the max with an ellipsis,
+// the numbers are never cut.
+func (format *PrettyOutputFormat) cell(s string, cut bool) string {
+	var escaped bytes.Buffer
+	writeTSVString(&escaped, s)
+	s = escaped.String()
+	if !cut || format.maxWidth <= 0 || displayWidth(s) <= format.maxWidth {
+		return s
+	}
+
+	width := 0
+	for i, r := range s {
+		w := runeWidth(r)
+		if width+w > format.maxWidth-1 {
+			return s[:i] + "…"
+		}
+		width += w
+	}
+	return s
+}
+
+func writePrettyLine(buf *bytes.Buffer, widths []int, left, fill, middle, end string) {
+	buf.WriteString(left)
+	for j, w := range widths {
+		if j > 0 {
+			buf.WriteString(middle)
+		}
+		buf.WriteString(strings.Repeat(fill, w+2))
+	}
+	buf.WriteString(end)
+	buf.WriteString("\n")
+}
+
+func writePrettyRow(buf *bytes.Buffer, row []string, widths []int, right []bool, border string) {
+	buf.WriteString(border)
+	for j, s := range row {
+		if j > 0 {
+			buf.WriteString(border)
+		}
+		buf.WriteString(" ")
+		writePrettyCell(buf, s, widths[j], right[j])
+		buf.WriteString(" ")
+	}
+	buf.WriteString(border)
+	buf.WriteString("\n")
+}
+
+func writePrettySpaceRow(buf *bytes.Buffer, row []string, widths []int, right []bool) {
+	for j, s := range row {
+		if j > 0 {
+			buf.WriteString("   ")
+		}
+		writePrettyCell(buf, s, widths[j], right[j])
+	}
+	buf.WriteString("\n")
+}
+
+func writePrettyCell(buf *bytes.Buffer, s string, width int, right bool) {
+	pad := strings.Repeat(" ", width-displayWidth(s))
+	if right {
+		buf.WriteString(pad + s)
+	} else {
+		buf.WriteString(s + pad)
+	}
+}
+
+func isPrettyNumber(col *columns.Column) bool {
+	switch col.DataType.Name() {
+	case datatypes.DataTypeInt32Name, datatypes.DataTypeUInt32Name, datatypes.DataTypeInt64Name, datatypes.DataTypeUInt64Name, datatypes.DataTypeFloat64Name:
+		return true
+	}
+	return false
+}
+
+// displayWidth is the terminal columns of the text, the East Asian wide characters take two
+// and the combining marks none.
+func displayWidth(s string) int {
+	width := 0
+	for _, r := range s {
+		width += runeWidth(r)
+	}
+	return width
+}
+
+func runeWidth(r rune) int {
+	switch {
+	case r == utf8.RuneError:
+		return 1
+	case unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf):
+		return 0
+	case r >= 0x1100 && r <= 0x115f,
+		r >= 0x2e80 && r <= 0x303e,
+		r >= 0x3041 && r <= 0x33ff,
+		r >= 0x3400 && r <= 0x4dbf,
+		r >= 0x4e00 && r <= 0x9fff,
+		r >= 0xa000 && r <= 0xa4cf,
+		r >= 0xac00 && r <= 0xd7a3,
+		r >= 0xf900 && r <= 0xfaff,
+		r >= 0xfe30 && r <= 0xfe4f,
+		r >= 0xff00 && r <= 0xff60,
+		r >= 0xffe0 && r <= 0xffe6,
+		r >= 0x1f300 && r <= 0x1f64f,
+		r >= 0x1f900 && r <= 0x1f9ff,
+		r >= 0x20000 && r <= 0x3fffd:
+		return 2
+	}
+	return 1
+}
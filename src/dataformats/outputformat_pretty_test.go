@@ -0,0 +1,104 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package dataformats
+
+import (
+	"bytes"
+	"testing"
+
+	"columns"
+	"datablocks"
+	"datatypes"
+	"datavalues"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testPrettyBlock(t *testing.T) *datablocks.DataBlock {
+	block := datablocks.NewDataBlock([]*columns.Column{
+		{Name: "name", DataType: datatypes.NewStringDataType()},
+		{Name: "age", DataType: datatypes.NewInt32DataType()},
+	})
+	assert.Nil(t, block.WriteRow([]datavalues.IDataValue{datavalues.MakeString("x\ty"), datavalues.MakeInt32(11)}))
+	assert.Nil(t, block.WriteRow([]datavalues.IDataValue{datavalues.MakeString("向量"), datavalues.MakeInt32(-1300)}))
+	return block
+}
+
+func TestPrettyOutputFormat(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   string
+		settings *FormatSettings
+		expect   string
+	}{
+		{
+			name:   "Pretty",
+			format: "Pretty",
+			expect: "" +
+				"┏━━━━━━┳━━━━━━━┓\n" +
+				"┃ name ┃   age ┃\n" +
+				"┡━━━━━━╇━━━━━━━┩\n" +
+				"│ x\\ty │    11 │\n" +
+				"├──────┼───────┤\n" +
+				"│ 向量 │ -1300 │\n" +
+				"└──────┴───────┘\n" +
+				"2 rows in set.\n",
+		},
+		{
+			name:   "PrettyCompact",
+			format: "PrettyCompact",
+			expect: "" +
+				"┌─name─┬───age─┐\n" +
+				"│ x\\ty │    11 │\n" +
+				"│ 向量 │ -1300 │\n" +
+				"└──────┴───────┘\n" +
+				"2 rows in set.\n",
+		},
+		{
+			name:   "PrettySpace",
+			format: "PrettySpace",
+			expect: "" +
+				"name     age\n" +
+				"\n" +
+				"x\\ty      11\n" +
+				"向量   -1300\n" +
+				"2 rows in set.\n",
+		},
+		{
+			name:     "PrettyCompact-max-rows",
+			format:   "PrettyCompact",
+			settings: &FormatSettings{PrettyMaxRows: 1, PrettyMaxColumnPadWidth: 250},
+			expect: "" +
+				"┌─name─┬─age─┐\n" +
+				"│ x\\ty │  11 │\n" +
+				"└──────┴─────┘\n" +
+				"Showed first 1.\n" +
+				"2 rows in set.\n",
+		},
+		{
+			name:     "PrettyCompact-max-width",
+			format:   "PrettyCompact",
+			settings: &FormatSettings{PrettyMaxRows: 10, PrettyMaxColumnPadWidth: 3},
+			expect: "" +
+				"┌─na…─┬───age─┐\n" +
+				"│ x\\… │    11 │\n" +
+				"│ 向… │ -1300 │\n" +
+				"└─────┴───────┘\n" +
+				"2 rows in set.\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			block := testPrettyBlock(t)
+			buf := new(bytes.Buffer)
+			format := FactoryGetOutput(test.format)(block.Clone(), buf, test.settings)
+			assert.Nil(t, format.WritePrefix())
+			assert.Nil(t, format.Write(block))
+			assert.Nil(t, format.WriteSuffix())
+			assert.Equal(t, test.expect, buf.String())
+		})
+	}
+}
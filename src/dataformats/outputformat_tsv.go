@@ -5,6 +5,7 @@
 package dataformats
 
 import (
+	"bytes"
 	"io"
 	"sync"
 
@@ -14,57 +15,104 @@ import (
 type TSVOutputFormat struct {
 	mu        sync.RWMutex
 	writer    io.Writer
+	header    *datablocks.DataBlock
 	withNames bool
+	buf       bytes.Buffer
+	text      bytes.Buffer
 }
 
-func NewTSVOutputFormat(writer io.Writer) IDataBlockOutputFormat {
+func NewTSVOutputFormat(header *datablocks.DataBlock, writer io.Writer, settings *FormatSettings) IDataBlockOutputFormat {
 	return &TSVOutputFormat{
 		writer:    writer,
+		header:    header,
 		withNames: false,
 	}
 }
 
-func NewTSVWithNamesOutputFormat(writer io.Writer) IDataBlockOutputFormat {
+func NewTSVWithNamesOutputFormat(header *datablocks.DataBlock, writer io.Writer, settings *FormatSettings) IDataBlockOutputFormat {
 	return &TSVOutputFormat{
 		writer:    writer,
+		header:    header,
 		withNames: true,
 	}
 }
 
 func (format *TSVOutputFormat) WritePrefix() error {
-	return nil
+	format.mu.Lock()
+	defer format.mu.Unlock()
+
+	if !format.withNames || format.header == nil {
+		return nil
+	}
+
+	buf := &format.buf
+	buf.Reset()
+	for i, column := range format.header.Columns() {
+		if i != 0 {
+			buf.WriteByte('\t')
+		}
+		writeTSVString(buf, column.Name)
+	}
+	buf.WriteByte('\n')
+	_, err := format.writer.Write(buf.Bytes())
+	return err
 }
 
+// Write serializes the block into a row buffer and flushes it to the writer once per block,
+// so the memory is bounded by the block size.
 func (format *TSVOutputFormat) Write(block *datablocks.DataBlock) error {
 	format.mu.Lock()
 	defer format.mu.Unlock()
 
-	writer := format.writer
+	buf := &format.buf
+	buf.Reset()
 	iters := block.ColumnIterators()
 	for i := 0; i < block.NumRows(); i++ {
-		for i, it := range iters {
-			if i != 0 {
-				if _, err := writer.Write([]byte("\t")); err != nil {
-					return err
-				}
+		for j, it := range iters {
+			if j != 0 {
+				buf.WriteByte('\t')
 			}
 			column := it.Column()
-			datatype := column.DataType
-
 			if it.Next() {
 				// Data serialize.
-				if err := datatype.SerializeText(writer, it.Value()); err != nil {
+				text := &format.text
+				text.Reset()
+				if err := column.DataType.SerializeText(text, it.Value()); err != nil {
 					return err
 				}
+				writeTSVString(buf, text.String())
 			}
 		}
-		if _, err := writer.Write([]byte("\n")); err != nil {
-			return err
-		}
+		buf.WriteByte('\n')
 	}
-	return nil
+	_, err := format.writer.Write(buf.Bytes())
+	return err
 }
 
 func (format *TSVOutputFormat) WriteSuffix() error {
 	return nil
 }
+
+// writeTSVString writes the ClickHouse style escaped TSV field.
+func writeTSVString(buf *bytes.Buffer, s string) {
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '\\':
+			buf.WriteString("\\\\")
+		case '\t':
+			buf.WriteString("\\t")
+		case '\n':
+			buf.WriteString("\\n")
+		case '\r':
+			buf.WriteString("\\r")
+		case '\b':
+			buf.WriteString("\\b")
+		case '\f':
+			buf.WriteString("\\f")
+		case 0:
+			buf.WriteString("\\0")
+		default:
+			buf.WriteByte(c)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package dataformats
+
+import (
+	"bytes"
+	"testing"
+
+	"columns"
+	"datablocks"
+	"datatypes"
+	"datavalues"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testTextBlock(t *testing.T) *datablocks.DataBlock {
+	block := datablocks.NewDataBlock([]*columns.Column{
+		{Name: "name", DataType: datatypes.NewStringDataType()},
+		{Name: "age", DataType: datatypes.NewInt32DataType()},
+	})
+	assert.Nil(t, block.WriteRow([]datavalues.IDataValue{datavalues.MakeString("x\ty\\z"), datavalues.MakeInt32(11)}))
+	assert.Nil(t, block.WriteRow([]datavalues.IDataValue{datavalues.MakeString("a \"b\", c\nd"), datavalues.MakeInt32(-13)}))
+	return block
+}
+
+func TestTSVOutputFormat(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		expect string
+	}{
+		{
+			name:   "TSV",
+			format: "TSV",
+			expect: "x\\ty\\\\z\t11\na \"b\", c\\nd\t-13\n",
+		},
+		{
+			name:   "TSVWithNames",
+			format: "TSVWithNames",
+			expect: "name\tage\nx\\ty\\\\z\t11\na \"b\", c\\nd\t-13\n",
+		},
+		{
+			name:   "TabSeparatedWithNames",
+			format: "TabSeparatedWithNames",
+			expect: "name\tage\nx\\ty\\\\z\t11\na \"b\", c\\nd\t-13\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			block := testTextBlock(t)
+			buf := new(bytes.Buffer)
+			format := FactoryGetOutput(test.format)(block.Clone(), buf, nil)
+			assert.Nil(t, format.WritePrefix())
+			assert.Nil(t, format.Write(block))
+			assert.Nil(t, format.WriteSuffix())
+			assert.Equal(t, test.expect, buf.String())
+		})
+	}
+}
@@ -17,6 +17,11 @@ type IDataBlockInputStream interface {
 	Close()
 }
 
+// IBlockSizeInputStream is the input stream generating its blocks, sized by the max_block_size of the query.
+type IBlockSizeInputStream interface {
+	SetMaxBlockSize(rows int)
+}
+
 type IDataBlockOutputStream interface {
 	Name() string
 	Write(*datablocks.DataBlock) error
@@ -0,0 +1,59 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datastreams
+
+import (
+	"io"
+	"sync"
+
+	"datablocks"
+	"dataformats"
+)
+
+type CustomFormatBlockInputStream struct {
+	mu sync.Mutex
+
+	formatName string
+	readPrefix bool
+	readSuffix bool
+	format     dataformats.IDataBlockInputFormat
+}
+
+func NewCustomFormatBlockInputStream(header *datablocks.DataBlock, reader io.Reader, formatName string, settings *dataformats.FormatSettings) IDataBlockInputStream {
+	return &CustomFormatBlockInputStream{
+		formatName: formatName,
+		format:     dataformats.FactoryGetInput(formatName)(header, reader, settings),
+	}
+}
+
+func (stream *CustomFormatBlockInputStream) Name() string {
+	return stream.formatName + "BlockInputStream"
+}
+
+func (stream *CustomFormatBlockInputStream) Read() (*datablocks.DataBlock, error) {
+	stream.mu.Lock()
+	defer stream.mu.Unlock()
+
+	if !stream.readPrefix {
+		if err := stream.format.ReadPrefix(); err != nil {
+			return nil, err
+		}
+		stream.readPrefix = true
+	}
+
+	block, err := stream.format.Read()
+	if err != nil {
+		return nil, err
+	}
+	if block == nil && !stream.readSuffix {
+		if err := stream.format.ReadSuffix(); err != nil {
+			return nil, err
+		}
+		stream.readSuffix = true
+	}
+	return block, nil
+}
+
+func (stream *CustomFormatBlockInputStream) Close() {}
@@ -24,14 +24,15 @@ type CustomFormatBlockOutputStream struct {
 }
 
 func NewCustomFormatBlockOutputStream(header *datablocks.DataBlock, writer io.Writer, formatName string) IDataBlockOutputStream {
-	if formatName == "Native" {
-		return NewNativeBlockOutputStream(header, writer)
-	}
+	return NewCustomFormatBlockOutputStreamWithSettings(header, writer, formatName, dataformats.DefaultFormatSettings())
+}
+
+func NewCustomFormatBlockOutputStreamWithSettings(header *datablocks.DataBlock, writer io.Writer, formatName string, settings *dataformats.FormatSettings) IDataBlockOutputStream {
 	return &CustomFormatBlockOutputStream{
 		writer:     writer,
 		formatName: formatName,
 		header:     header,
-		format:     dataformats.FactoryGetOutput(formatName)(writer),
+		format:     dataformats.FactoryGetOutput(formatName)(header, writer, settings),
 	}
 }
 
@@ -56,7 +57,23 @@ func (stream *CustomFormatBlockOutputStream) Write(block *datablocks.DataBlock)
 	return nil
 }
 
+// SetStatistics passes the query statistics to the format if it writes them, such as JSON.
+func (stream *CustomFormatBlockOutputStream) SetStatistics(statistics *dataformats.Statistics) {
+	if format, ok := stream.format.(dataformats.IStatisticsOutputFormat); ok {
+		format.SetStatistics(statistics)
+	}
+}
+
 func (stream *CustomFormatBlockOutputStream) Finalize() error {
+	stream.mu.Lock()
+	defer stream.mu.Unlock()
+
+	if !stream.writePrefix {
+		if err := stream.format.WritePrefix(); err != nil {
+			return err
+		}
+		stream.writePrefix = true
+	}
 	if !stream.writeSuffix {
 		if err := stream.format.WriteSuffix(); err != nil {
 			return err
@@ -8,7 +8,6 @@ import (
 	"io"
 
 	"base/binary"
-	"base/errors"
 	"datablocks"
 )
 
@@ -36,39 +35,7 @@ func (stream *NativeBlockOutputStream) Write(block *datablocks.DataBlock) error
 	if err := info.Write(writer); err != nil {
 		return err
 	}
-
-	// NumColumns.
-	if err := writer.Uvarint(uint64(block.NumColumns())); err != nil {
-		return errors.Wrap(err)
-	}
-	// NumRows.
-	if err := writer.Uvarint(uint64(block.NumRows())); err != nil {
-		return errors.Wrap(err)
-	}
-
-	// Values.
-	for _, it := range block.ColumnIterators() {
-		column := it.Column()
-		datatype := column.DataType
-
-		// Column name.
-		if err := writer.String(column.Name); err != nil {
-			return errors.Wrap(err)
-		}
-
-		// Datatype name.
-		if err := writer.String(datatype.Name()); err != nil {
-			return errors.Wrap(err)
-		}
-
-		for it.Next() {
-			// Data serialize.
-			if err := datatype.Serialize(writer, it.Value()); err != nil {
-				return err
-			}
-		}
-	}
-	return nil
+	return block.WriteNative(writer)
 }
 
 func (stream *NativeBlockOutputStream) Finalize() error {
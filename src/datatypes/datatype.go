@@ -16,11 +16,12 @@ type IDataType interface {
 	Serialize(*binary.Writer, datavalues.IDataValue) error
 	SerializeText(io.Writer, datavalues.IDataValue) error
 	Deserialize(*binary.Reader) (datavalues.IDataValue, error)
+	DeserializeText(string) (datavalues.IDataValue, error)
 }
 
 func GetDataTypeByValue(val datavalues.IDataValue) (IDataType, error) {
 	switch val.Type() {
-	case datavalues.TypeString:
+	case datavalues.TypeString, datavalues.TypeBytes:
 		return NewStringDataType(), nil
 	case datavalues.TypeInt:
 		return NewInt64DataType(), nil
@@ -28,6 +29,8 @@ func GetDataTypeByValue(val datavalues.IDataValue) (IDataType, error) {
 		return NewInt32DataType(), nil
 	case datavalues.TypeFloat:
 		return NewFloat64DataType(), nil
+	case datavalues.TypeTime:
+		return NewDateTimeDataType(), nil
 	default:
 		return nil, errors.Errorf("Unsupported value type:%v", val.Type())
 	}
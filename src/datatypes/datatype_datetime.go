@@ -0,0 +1,55 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datatypes
+
+import (
+	"io"
+	"time"
+
+	"base/binary"
+	"base/errors"
+	"datavalues"
+)
+
+const (
+	DataTypeDateTimeName = "DateTime"
+)
+
+// DateTimeDataType is the seconds since the epoch, stored as UInt32 and shown in UTC.
+type DateTimeDataType struct {
+}
+
+func NewDateTimeDataType() IDataType {
+	return &DateTimeDataType{}
+}
+
+func (datatype *DateTimeDataType) Name() string {
+	return DataTypeDateTimeName
+}
+
+func (datatype *DateTimeDataType) Serialize(writer *binary.Writer, v datavalues.IDataValue) error {
+	return writer.UInt32(uint32(datavalues.AsTime(v).Unix()))
+}
+
+func (datatype *DateTimeDataType) SerializeText(writer io.Writer, v datavalues.IDataValue) error {
+	_, err := writer.Write([]byte(datavalues.AsTime(v).UTC().Format(datavalues.TimeLayout)))
+	return err
+}
+
+func (datatype *DateTimeDataType) Deserialize(reader *binary.Reader) (datavalues.IDataValue, error) {
+	if res, err := reader.UInt32(); err != nil {
+		return nil, errors.Wrap(err)
+	} else {
+		return datavalues.MakeTime(time.Unix(int64(res), 0).UTC()), nil
+	}
+}
+
+func (datatype *DateTimeDataType) DeserializeText(s string) (datavalues.IDataValue, error) {
+	res, err := time.ParseInLocation(datavalues.TimeLayout, s, time.UTC)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	return datavalues.MakeTime(res), nil
+}
@@ -0,0 +1,58 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datatypes
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"base/binary"
+	"datavalues"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataTypeDateTime(t *testing.T) {
+	tests := []struct {
+		name   string
+		expect datavalues.IDataValue
+		text   string
+	}{
+		{
+			name:   "DataTypeDateTime-passed",
+			expect: datavalues.MakeTime(time.Date(2020, 2, 3, 4, 5, 6, 0, time.UTC)),
+			text:   "2020-02-03 04:05:06",
+		},
+		{
+			name:   "DataTypeDateTime-epoch-passed",
+			expect: datavalues.ZeroTime(),
+			text:   "1970-01-01 00:00:00",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dt, err := DataTypeFactory(DataTypeDateTimeName)
+			assert.Nil(t, err)
+
+			buf := &bytes.Buffer{}
+			err = dt.Serialize(binary.NewWriter(buf), test.expect)
+			assert.Nil(t, err)
+			actual, err := dt.Deserialize(binary.NewReader(buf))
+			assert.Nil(t, err)
+			assert.Equal(t, test.expect, actual)
+
+			text := &bytes.Buffer{}
+			err = dt.SerializeText(text, test.expect)
+			assert.Nil(t, err)
+			assert.Equal(t, test.text, text.String())
+
+			actual, err = dt.DeserializeText(test.text)
+			assert.Nil(t, err)
+			assert.Equal(t, test.expect, actual)
+		})
+	}
+}
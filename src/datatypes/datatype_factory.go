@@ -12,19 +12,20 @@ type dataTypeCreator func() IDataType
 
 var (
 	table = map[string]dataTypeCreator{
-		NewStringDataType().Name():  NewStringDataType,
-		NewInt32DataType().Name():   NewInt32DataType,
-		NewUInt32DataType().Name():  NewUInt32DataType,
-		NewInt64DataType().Name():   NewInt64DataType,
-		NewUInt64DataType().Name():  NewUInt64DataType,
-		NewFloat64DataType().Name(): NewFloat64DataType,
+		NewStringDataType().Name():   NewStringDataType,
+		NewInt32DataType().Name():    NewInt32DataType,
+		NewUInt32DataType().Name():   NewUInt32DataType,
+		NewInt64DataType().Name():    NewInt64DataType,
+		NewUInt64DataType().Name():   NewUInt64DataType,
+		NewFloat64DataType().Name():  NewFloat64DataType,
+		NewDateTimeDataType().Name(): NewDateTimeDataType,
 	}
 )
 
 func DataTypeFactory(name string) (IDataType, error) {
 	dt, ok := table[name]
 	if !ok {
-		return nil, errors.Errorf("Unsupported data type:%s", name)
+		return nil, errors.ErrorWithCode(errors.UNKNOWN_TYPE, "Unsupported data type:%s", name)
 	}
 	return dt(), nil
 }
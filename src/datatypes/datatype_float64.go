@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"strconv"
 
 	"base/binary"
 	"base/errors"
@@ -46,3 +47,11 @@ func (datatype *Float64DataType) Deserialize(reader *binary.Reader) (datavalues.
 		return datavalues.MakeFloat(res), nil
 	}
 }
+
+func (datatype *Float64DataType) DeserializeText(s string) (datavalues.IDataValue, error) {
+	res, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	return datavalues.MakeFloat(res), nil
+}
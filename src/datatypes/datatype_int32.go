@@ -7,6 +7,7 @@ package datatypes
 import (
 	"fmt"
 	"io"
+	"strconv"
 
 	"base/binary"
 	"base/errors"
@@ -44,3 +45,11 @@ func (datatype *Int32DataType) Deserialize(reader *binary.Reader) (datavalues.ID
 		return datavalues.MakeInt32(res), nil
 	}
 }
+
+func (datatype *Int32DataType) DeserializeText(s string) (datavalues.IDataValue, error) {
+	res, err := strconv.ParseInt(s, 10, 32)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	return datavalues.MakeInt32(int32(res)), nil
+}
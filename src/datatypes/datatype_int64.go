@@ -7,6 +7,7 @@ package datatypes
 import (
 	"fmt"
 	"io"
+	"strconv"
 
 	"base/binary"
 	"base/errors"
@@ -44,3 +45,11 @@ func (datatype *Int64DataType) Deserialize(reader *binary.Reader) (datavalues.ID
 		return datavalues.ToValue(res), nil
 	}
 }
+
+func (datatype *Int64DataType) DeserializeText(s string) (datavalues.IDataValue, error) {
+	res, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	return datavalues.ToValue(res), nil
+}
@@ -43,3 +43,7 @@ func (datatype *StringDataType) Deserialize(reader *binary.Reader) (datavalues.I
 		return datavalues.MakeString(res), nil
 	}
 }
+
+func (datatype *StringDataType) DeserializeText(s string) (datavalues.IDataValue, error) {
+	return datavalues.MakeString(s), nil
+}
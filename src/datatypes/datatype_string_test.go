@@ -24,6 +24,10 @@ func TestDataTypeString(t *testing.T) {
 			name:   "DataTypeString-passed",
 			expect: datavalues.ToValue("string"),
 		},
+		{
+			name:   "DataTypeString-binary-passed",
+			expect: datavalues.ToValue([]byte{0x0a, 0xff, 0x00}),
+		},
 	}
 
 	for _, test := range tests {
@@ -48,3 +48,68 @@ func TestDataType(t *testing.T) {
 		})
 	}
 }
+
+func TestDataTypeDeserializeText(t *testing.T) {
+	tests := []struct {
+		name   string
+		text   string
+		expect datavalues.IDataValue
+		errStr string
+	}{
+		{
+			name:   "Int32",
+			text:   "-32",
+			expect: datavalues.MakeInt32(-32),
+		},
+		{
+			name:   "UInt32",
+			text:   "32",
+			expect: datavalues.ToValue(uint32(32)),
+		},
+		{
+			name:   "Int64",
+			text:   "-64",
+			expect: datavalues.MakeInt(-64),
+		},
+		{
+			name:   "UInt64",
+			text:   "64",
+			expect: datavalues.MakeInt(64),
+		},
+		{
+			name:   "Float64",
+			text:   "64.1",
+			expect: datavalues.MakeFloat(64.1),
+		},
+		{
+			name:   "String",
+			text:   "string",
+			expect: datavalues.MakeString("string"),
+		},
+		{
+			name:   "Int32",
+			text:   "x",
+			errStr: "strconv.ParseInt: parsing \"x\": invalid syntax",
+		},
+		{
+			name:   "UInt32",
+			text:   "-1",
+			errStr: "strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dt, err := DataTypeFactory(test.name)
+			assert.Nil(t, err)
+
+			actual, err := dt.DeserializeText(test.text)
+			if test.errStr != "" {
+				assert.Equal(t, test.errStr, err.Error())
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, test.expect, actual)
+			}
+		})
+	}
+}
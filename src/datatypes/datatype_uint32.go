@@ -7,6 +7,7 @@ package datatypes
 import (
 	"fmt"
 	"io"
+	"strconv"
 
 	"base/binary"
 	"base/errors"
@@ -44,3 +45,11 @@ func (datatype *UInt32DataType) Deserialize(reader *binary.Reader) (datavalues.I
 		return datavalues.ToValue(res), nil
 	}
 }
+
+func (datatype *UInt32DataType) DeserializeText(s string) (datavalues.IDataValue, error) {
+	res, err := strconv.ParseUint(s, 10, 32)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	return datavalues.ToValue(uint32(res)), nil
+}
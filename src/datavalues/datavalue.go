@@ -5,9 +5,14 @@
 package datavalues
 
 import (
-	"fmt"
+	"encoding/json"
+	"net/netip"
+	"reflect"
+	"strconv"
+	"time"
 
 	"base/docs"
+	"base/errors"
 )
 
 type Type int
@@ -25,6 +30,12 @@ const (
 	TypeDuration
 	TypeTuple
 	TypeObject
+	TypeBytes
+	TypeIPv4
+	TypeIPv6
+	TypePrefix
+	TypeExtension
+	TypeError
 )
 
 type Comparison int
@@ -43,8 +54,17 @@ const (
 	FamilyFloat
 	FamilyString
 	FamilyTuple
+	FamilyTime
+	FamilyNull
+	FamilyObject
+	FamilyIP
+	FamilyExtension
+	FamilyError
+	FamilyDuration
 )
 
+// IDataValue is implemented by the pointers to the values, the methods and the As* helpers
+// read through the pointer and never copy the value, the tuples and the objects included.
 type IDataValue interface {
 	Size() uintptr
 	Type() Type
@@ -56,42 +76,135 @@ type IDataValue interface {
 
 // NormalizeType brings various primitive types into the type we want them to be.
 // All types coming out of data sources have to be already normalized this way.
+// It panics on the unsupported types, TryToValue returns the error.
 func ToValue(value interface{}) IDataValue {
+	v, err := TryToValue(value)
+	if err != nil {
+		panic(err.Error())
+	}
+	return v
+}
+
+// TryToValue is ToValue returning the error of the unsupported types, also inside the tuples and objects.
+// The pointers are dereferenced, the nil pointer is NULL.
+func TryToValue(value interface{}) (IDataValue, error) {
 	switch value := value.(type) {
 	case bool:
-		return MakeBool(value)
+		return MakeBool(value), nil
 	case int:
-		return MakeInt32(int32(value))
+		return MakeInt32(int32(value)), nil
 	case int8:
-		return MakeInt32(int32(value))
+		return MakeInt32(int32(value)), nil
 	case int16:
-		return MakeInt32(int32(value))
+		return MakeInt32(int32(value)), nil
 	case int32:
-		return MakeInt32(value)
+		return MakeInt32(value), nil
 	case int64:
-		return MakeInt(int64(value))
+		return MakeInt(int64(value)), nil
 	case uint8:
-		return MakeInt(int64(value))
+		return MakeInt(int64(value)), nil
 	case uint32:
-		return MakeInt(int64(value))
+		return MakeInt(int64(value)), nil
 	case uint64:
-		return MakeInt(int64(value))
+		return MakeInt(int64(value)), nil
 	case float32:
-		return MakeFloat(float64(value))
+		return MakeFloat(float64(value)), nil
 	case float64:
-		return MakeFloat(value)
+		return MakeFloat(value), nil
 	case []byte:
-		return MakeString(string(value))
+		return MakeString(string(value)), nil
+	case Binary:
+		return MakeBytes(value), nil
 	case string:
-		return MakeString(value)
+		return MakeString(value), nil
+	case time.Time:
+		return MakeTime(value), nil
+	case time.Duration:
+		return MakeDuration(value), nil
+	case json.Number:
+		return jsonNumberToValue(value)
+	case netip.Addr:
+		// The zero Addr is no address.
+		if !value.IsValid() {
+			return MakeNull(), nil
+		}
+		return MakeIP(value), nil
+	case netip.Prefix:
+		if !value.IsValid() {
+			return MakeNull(), nil
+		}
+		return MakePrefix(value), nil
 	case []interface{}:
 		out := make([]IDataValue, len(value))
 		for i := range value {
-			out[i] = ToValue(value[i])
+			v, err := TryToValue(value[i])
+			if err != nil {
+				return nil, err
+			}
+			out[i] = v
 		}
-		return MakeTuple(out...)
+		return MakeTuple(out...), nil
+	case map[string]interface{}:
+		out := make(map[string]IDataValue, len(value))
+		for k, v := range value {
+			x, err := TryToValue(v)
+			if err != nil {
+				return nil, err
+			}
+			out[k] = x
+		}
+		return MakeObject(out), nil
 	case IDataValue:
-		return value
+		return value, nil
+	case error:
+		return MakeError(value), nil
+	}
+	// The pointers of the nullable fields, such as *int and *string: nil is NULL.
+	if rv := reflect.ValueOf(value); rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return MakeNull(), nil
+		}
+		return TryToValue(rv.Elem().Interface())
+	}
+	return nil, errors.Errorf("unreachable:%T", value)
+}
+
+// ToRows converts the rows to Object values with the normalization of ToValue.
+// The objects are allocated in one slice and the rows share the key strings,
+// only the field maps are allocated per row.
+func ToRows(rows []map[string]interface{}) []IDataValue {
+	res := make([]IDataValue, len(rows))
+	objects := make([]ValueObject, len(rows))
+	keys := make(map[string]string)
+	for i, row := range rows {
+		fields := make(map[string]IDataValue, len(row))
+		for k, v := range row {
+			key, ok := keys[k]
+			if !ok {
+				key = k
+				keys[k] = k
+			}
+			fields[key] = ToValue(v)
+		}
+		objects[i].fields = fields
+		res[i] = &objects[i]
+	}
+	return res
+}
+
+// jsonNumberToValue keeps the integers exact as Int, the ones int64 can't hold are an error rather
+// than wrapped or rounded, only the fractional and exponent numbers go through float64.
+func jsonNumberToValue(value json.Number) (IDataValue, error) {
+	s := string(value)
+	i, err := strconv.ParseInt(s, 10, 64)
+	if err == nil {
+		return MakeInt(i), nil
+	}
+	if err.(*strconv.NumError).Err == strconv.ErrRange {
+		return nil, errors.ErrorWithCode(errors.ARGUMENT_OUT_OF_BOUND, "json.Number %s is out of the range of Int64", s)
+	}
+	if f, err := strconv.ParseFloat(s, 64); err == nil {
+		return MakeFloat(f), nil
 	}
-	panic(fmt.Sprintf("unreachable:%T", value))
+	return nil, errors.Errorf("invalid json.Number:%q", s)
 }
@@ -8,6 +8,29 @@ import (
 	"base/errors"
 )
 
+// maker makes the results of the arithmetic, the heap one by the package constructors or an Arena.
+type maker interface {
+	MakeInt(v int64) IDataValue
+	MakeInt32(v int32) IDataValue
+	MakeFloat(v float64) IDataValue
+}
+
+type heapMaker struct{}
+
+var heap heapMaker
+
+func (heapMaker) MakeInt(v int64) IDataValue {
+	return MakeInt(v)
+}
+
+func (heapMaker) MakeInt32(v int32) IDataValue {
+	return MakeInt32(v)
+}
+
+func (heapMaker) MakeFloat(v float64) IDataValue {
+	return MakeFloat(v)
+}
+
 func IsIntegral(v IDataValue) bool {
 	typ := v.Type()
 	return typ == TypeInt || typ == TypeInt32
@@ -22,6 +45,19 @@ func IsNumber(v IDataValue) bool {
 }
 
 func Add(v1 IDataValue, v2 IDataValue) (IDataValue, error) {
+	return add(heap, v1, v2)
+}
+
+// Add is Add of the package with the result made by the arena.
+func (a *Arena) Add(v1 IDataValue, v2 IDataValue) (IDataValue, error) {
+	return add(a, v1, v2)
+}
+
+func add(m maker, v1 IDataValue, v2 IDataValue) (IDataValue, error) {
+	if e := FirstError(v1, v2); e != nil {
+		return e, nil
+	}
+	v1, v2, _ = coerceBool(v1, v2)
 	if !IsNumber(v1) || !IsNumber(v2) {
 		return nil, errors.Errorf("Unsupported type:(%v,%v)", v1.Type(), v2.Type())
 	}
@@ -30,20 +66,33 @@ func Add(v1 IDataValue, v2 IDataValue) (IDataValue, error) {
 	case TypeInt:
 		v1 := AsInt(v1)
 		v2 := AsInt(v2)
-		return MakeInt(v1 + v2), nil
+		return m.MakeInt(v1 + v2), nil
 	case TypeInt32:
 		v1 := AsInt(v1)
 		v2 := AsInt(v2)
-		return MakeInt32(int32(v1 + v2)), nil
+		return m.MakeInt32(int32(v1 + v2)), nil
 	case TypeFloat:
 		v1 := AsFloat(v1)
 		v2 := AsFloat(v2)
-		return MakeFloat(v1 + v2), nil
+		return m.MakeFloat(v1 + v2), nil
 	}
 	return nil, errors.Errorf("Unsupported type:(%v,%v)", v1.Type(), v2.Type())
 }
 
 func Sub(v1 IDataValue, v2 IDataValue) (IDataValue, error) {
+	return sub(heap, v1, v2)
+}
+
+// Sub is Sub of the package with the result made by the arena.
+func (a *Arena) Sub(v1 IDataValue, v2 IDataValue) (IDataValue, error) {
+	return sub(a, v1, v2)
+}
+
+func sub(m maker, v1 IDataValue, v2 IDataValue) (IDataValue, error) {
+	if e := FirstError(v1, v2); e != nil {
+		return e, nil
+	}
+	v1, v2, _ = coerceBool(v1, v2)
 	if !IsNumber(v1) || !IsNumber(v2) {
 		return nil, errors.Errorf("Unsupported type:(%v,%v)", v1.Type(), v2.Type())
 	}
@@ -52,20 +101,33 @@ func Sub(v1 IDataValue, v2 IDataValue) (IDataValue, error) {
 	case TypeInt:
 		v1 := AsInt(v1)
 		v2 := AsInt(v2)
-		return MakeInt(v1 - v2), nil
+		return m.MakeInt(v1 - v2), nil
 	case TypeInt32:
 		v1 := AsInt(v1)
 		v2 := AsInt(v2)
-		return MakeInt32(int32(v1 - v2)), nil
+		return m.MakeInt32(int32(v1 - v2)), nil
 	case TypeFloat:
 		v1 := AsFloat(v1)
 		v2 := AsFloat(v2)
-		return MakeFloat(v1 - v2), nil
+		return m.MakeFloat(v1 - v2), nil
 	}
 	return nil, errors.Errorf("Unsupported type:(%v,%v)", v1.Type(), v2.Type())
 }
 
 func Mul(v1 IDataValue, v2 IDataValue) (IDataValue, error) {
+	return mul(heap, v1, v2)
+}
+
+// Mul is Mul of the package with the result made by the arena.
+func (a *Arena) Mul(v1 IDataValue, v2 IDataValue) (IDataValue, error) {
+	return mul(a, v1, v2)
+}
+
+func mul(m maker, v1 IDataValue, v2 IDataValue) (IDataValue, error) {
+	if e := FirstError(v1, v2); e != nil {
+		return e, nil
+	}
+	v1, v2, _ = coerceBool(v1, v2)
 	if !IsNumber(v1) || !IsNumber(v2) {
 		return nil, errors.Errorf("Unsupported type:(%v,%v)", v1.Type(), v2.Type())
 	}
@@ -74,20 +136,33 @@ func Mul(v1 IDataValue, v2 IDataValue) (IDataValue, error) {
 	case TypeInt:
 		v1 := AsInt(v1)
 		v2 := AsInt(v2)
-		return MakeInt(v1 * v2), nil
+		return m.MakeInt(v1 * v2), nil
 	case TypeInt32:
 		v1 := AsInt(v1)
 		v2 := AsInt(v2)
-		return MakeInt32(int32(v1 * v2)), nil
+		return m.MakeInt32(int32(v1 * v2)), nil
 	case TypeFloat:
 		v1 := AsFloat(v1)
 		v2 := AsFloat(v2)
-		return MakeFloat(v1 * v2), nil
+		return m.MakeFloat(v1 * v2), nil
 	}
 	return nil, errors.Errorf("Unsupported type:(%v,%v)", v1.Type(), v2.Type())
 }
 
 func Div(v1 IDataValue, v2 IDataValue) (IDataValue, error) {
+	return div(heap, v1, v2)
+}
+
+// Div is Div of the package with the result made by the arena.
+func (a *Arena) Div(v1 IDataValue, v2 IDataValue) (IDataValue, error) {
+	return div(a, v1, v2)
+}
+
+func div(m maker, v1 IDataValue, v2 IDataValue) (IDataValue, error) {
+	if e := FirstError(v1, v2); e != nil {
+		return e, nil
+	}
+	v1, v2, _ = coerceBool(v1, v2)
 	if !IsNumber(v1) || !IsNumber(v2) {
 		return nil, errors.Errorf("Unsupported type:(%v,%v)", v1.Type(), v2.Type())
 	}
@@ -96,11 +171,11 @@ func Div(v1 IDataValue, v2 IDataValue) (IDataValue, error) {
 	case TypeInt, TypeInt32:
 		v1 := AsInt(v1)
 		v2 := AsInt(v2)
-		return MakeFloat(float64(v1) / float64(v2)), nil
+		return m.MakeFloat(float64(v1) / float64(v2)), nil
 	case TypeFloat:
 		v1 := AsFloat(v1)
 		v2 := AsFloat(v2)
-		return MakeFloat(v1 / v2), nil
+		return m.MakeFloat(v1 / v2), nil
 	}
 	return nil, errors.Errorf("Unsupported type:(%v,%v)", v1.Type(), v2.Type())
 }
@@ -0,0 +1,333 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datavalues
+
+import (
+	"time"
+)
+
+const defaultArenaChunk = 1024
+
+// Arena allocates the values of one batch from the chunks it keeps, the batch is freed at once by Reset.
+//
+// The values made by the arena are only valid until the next Reset: it reuses the same memory for the
+// next batch, so a value kept beyond it, such as in a map of the aggregation, silently changes.
+// The values to keep are copied with the package constructors, such as MakeInt(AsInt(v)).
+// The arena is not safe for the concurrent use, one per goroutine.
+type Arena struct {
+	chunk   int
+	ints    intSlab
+	int32s  int32Slab
+	floats  floatSlab
+	bools   boolSlab
+	strings stringSlab
+	times   timeSlab
+	tuples  tupleSlab
+	objects objectSlab
+	fields  fieldSlab
+	maps    []map[string]IDataValue
+	nmaps   int
+}
+
+// NewArena creates the arena allocating the chunks of 1024 values of each type.
+func NewArena() *Arena {
+	return NewArenaWithChunk(defaultArenaChunk)
+}
+
+// NewArenaWithChunk creates the arena allocating the chunks of the values of each type.
+func NewArenaWithChunk(chunk int) *Arena {
+	if chunk < 1 {
+		chunk = defaultArenaChunk
+	}
+	return &Arena{chunk: chunk}
+}
+
+// Reset frees all the values at once, the chunks are reused by the next batch.
+// The slots used are cleared so the arena doesn't keep the strings and the values they point to.
+func (a *Arena) Reset() {
+	a.ints.reset()
+	a.int32s.reset()
+	a.floats.reset()
+	a.bools.reset()
+	a.strings.reset()
+	a.times.reset()
+	a.tuples.reset()
+	a.objects.reset()
+	a.fields.reset()
+	for _, m := range a.maps[:a.nmaps] {
+		for k := range m {
+			delete(m, k)
+		}
+	}
+	a.nmaps = 0
+}
+
+func (a *Arena) MakeInt(v int64) IDataValue {
+	r := a.ints.alloc(a.chunk)
+	*r = ValueInt(v)
+	return r
+}
+
+func (a *Arena) MakeInt32(v int32) IDataValue {
+	r := a.int32s.alloc(a.chunk)
+	*r = ValueInt32(v)
+	return r
+}
+
+func (a *Arena) MakeFloat(v float64) IDataValue {
+	r := a.floats.alloc(a.chunk)
+	*r = ValueFloat(v)
+	return r
+}
+
+func (a *Arena) MakeBool(v bool) IDataValue {
+	r := a.bools.alloc(a.chunk)
+	*r = ValueBool(v)
+	return r
+}
+
+func (a *Arena) MakeString(v string) IDataValue {
+	r := a.strings.alloc(a.chunk)
+	*r = ValueString(v)
+	return r
+}
+
+// MakeTime is MakeTime of the package, without the monotonic clock reading.
+func (a *Arena) MakeTime(v time.Time) IDataValue {
+	r := a.times.alloc(a.chunk)
+	*r = ValueTime{t: v.Round(0), precision: timePrecisionNone}
+	return r
+}
+
+// MakeTuple copies the values into the backing slice of the arena.
+func (a *Arena) MakeTuple(v ...IDataValue) IDataValue {
+	r := a.tuples.alloc(a.chunk)
+	fields := a.fields.alloc(a.chunk, len(v))
+	copy(fields, v)
+	*r = ValueTuple{fields: fields}
+	return r
+}
+
+// MakeObject makes the Object of the fields, the map comes from NewObjectFields to be reused.
+func (a *Arena) MakeObject(v map[string]IDataValue) IDataValue {
+	r := a.objects.alloc(a.chunk)
+	*r = ValueObject{fields: v}
+	return r
+}
+
+// NewObjectFields returns an empty map for MakeObject, the maps are cleared and reused after Reset.
+func (a *Arena) NewObjectFields() map[string]IDataValue {
+	if a.nmaps == len(a.maps) {
+		a.maps = append(a.maps, make(map[string]IDataValue))
+	}
+	m := a.maps[a.nmaps]
+	a.nmaps++
+	return m
+}
+
+// The slabs hand out the values of their chunks in turn, n is the values used.
+
+type intSlab struct {
+	chunks [][]ValueInt
+	n      int
+}
+
+func (s *intSlab) alloc(size int) *ValueInt {
+	c, i := s.n/size, s.n%size
+	if c == len(s.chunks) {
+		s.chunks = append(s.chunks, make([]ValueInt, size))
+	}
+	s.n++
+	return &s.chunks[c][i]
+}
+
+func (s *intSlab) reset() {
+	s.n = 0
+}
+
+type int32Slab struct {
+	chunks [][]ValueInt32
+	n      int
+}
+
+func (s *int32Slab) alloc(size int) *ValueInt32 {
+	c, i := s.n/size, s.n%size
+	if c == len(s.chunks) {
+		s.chunks = append(s.chunks, make([]ValueInt32, size))
+	}
+	s.n++
+	return &s.chunks[c][i]
+}
+
+func (s *int32Slab) reset() {
+	s.n = 0
+}
+
+type floatSlab struct {
+	chunks [][]ValueFloat
+	n      int
+}
+
+func (s *floatSlab) alloc(size int) *ValueFloat {
+	c, i := s.n/size, s.n%size
+	if c == len(s.chunks) {
+		s.chunks = append(s.chunks, make([]ValueFloat, size))
+	}
+	s.n++
+	return &s.chunks[c][i]
+}
+
+func (s *floatSlab) reset() {
+	s.n = 0
+}
+
+type boolSlab struct {
+	chunks [][]ValueBool
+	n      int
+}
+
+func (s *boolSlab) alloc(size int) *ValueBool {
+	c, i := s.n/size, s.n%size
+	if c == len(s.chunks) {
+		s.chunks = append(s.chunks, make([]ValueBool, size))
+	}
+	s.n++
+	return &s.chunks[c][i]
+}
+
+func (s *boolSlab) reset() {
+	s.n = 0
+}
+
+type stringSlab struct {
+	chunks [][]ValueString
+	n      int
+}
+
+func (s *stringSlab) alloc(size int) *ValueString {
+	c, i := s.n/size, s.n%size
+	if c == len(s.chunks) {
+		s.chunks = append(s.chunks, make([]ValueString, size))
+	}
+	s.n++
+	return &s.chunks[c][i]
+}
+
+func (s *stringSlab) reset() {
+	for i := 0; i < s.n; i++ {
+		size := len(s.chunks[0])
+		s.chunks[i/size][i%size] = ""
+	}
+	s.n = 0
+}
+
+type timeSlab struct {
+	chunks [][]ValueTime
+	n      int
+}
+
+func (s *timeSlab) alloc(size int) *ValueTime {
+	c, i := s.n/size, s.n%size
+	if c == len(s.chunks) {
+		s.chunks = append(s.chunks, make([]ValueTime, size))
+	}
+	s.n++
+	return &s.chunks[c][i]
+}
+
+func (s *timeSlab) reset() {
+	for i := 0; i < s.n; i++ {
+		size := len(s.chunks[0])
+		s.chunks[i/size][i%size] = ValueTime{}
+	}
+	s.n = 0
+}
+
+type tupleSlab struct {
+	chunks [][]ValueTuple
+	n      int
+}
+
+func (s *tupleSlab) alloc(size int) *ValueTuple {
+	c, i := s.n/size, s.n%size
+	if c == len(s.chunks) {
+		s.chunks = append(s.chunks, make([]ValueTuple, size))
+	}
+	s.n++
+	return &s.chunks[c][i]
+}
+
+func (s *tupleSlab) reset() {
+	for i := 0; i < s.n; i++ {
+		size := len(s.chunks[0])
+		s.chunks[i/size][i%size] = ValueTuple{}
+	}
+	s.n = 0
+}
+
+type objectSlab struct {
+	chunks [][]ValueObject
+	n      int
+}
+
+func (s *objectSlab) alloc(size int) *ValueObject {
+	c, i := s.n/size, s.n%size
+	if c == len(s.chunks) {
+		s.chunks = append(s.chunks, make([]ValueObject, size))
+	}
+	s.n++
+	return &s.chunks[c][i]
+}
+
+func (s *objectSlab) reset() {
+	for i := 0; i < s.n; i++ {
+		size := len(s.chunks[0])
+		s.chunks[i/size][i%size] = ValueObject{}
+	}
+	s.n = 0
+}
+
+// fieldSlab is the backing of the tuples, a tuple takes its fields in one piece of a chunk.
+type fieldSlab struct {
+	chunks [][]IDataValue
+	chunk  int
+	used   int
+}
+
+func (s *fieldSlab) alloc(size int, n int) []IDataValue {
+	if n > size {
+		// Beyond a chunk, it's not reused.
+		return make([]IDataValue, n)
+	}
+	if len(s.chunks) == 0 || s.used+n > size {
+		if len(s.chunks) > 0 {
+			s.chunk++
+		}
+		if s.chunk == len(s.chunks) {
+			s.chunks = append(s.chunks, make([]IDataValue, size))
+		}
+		s.used = 0
+	}
+	fields := s.chunks[s.chunk][s.used : s.used+n : s.used+n]
+	s.used += n
+	return fields
+}
+
+func (s *fieldSlab) reset() {
+	if len(s.chunks) == 0 {
+		return
+	}
+	for i := 0; i < s.chunk; i++ {
+		clearFields(s.chunks[i])
+	}
+	clearFields(s.chunks[s.chunk][:s.used])
+	s.chunk, s.used = 0, 0
+}
+
+func clearFields(fields []IDataValue) {
+	for i := range fields {
+		fields[i] = nil
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datavalues
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArena(t *testing.T) {
+	arena := NewArenaWithChunk(2)
+	now := time.Now()
+
+	tests := []struct {
+		name   string
+		actual IDataValue
+		expect IDataValue
+	}{
+		{name: "int", actual: arena.MakeInt(-1), expect: MakeInt(-1)},
+		{name: "int32", actual: arena.MakeInt32(2), expect: MakeInt32(2)},
+		{name: "float", actual: arena.MakeFloat(1.5), expect: MakeFloat(1.5)},
+		{name: "bool", actual: arena.MakeBool(true), expect: MakeBool(true)},
+		{name: "string", actual: arena.MakeString("a"), expect: MakeString("a")},
+		{name: "time", actual: arena.MakeTime(now), expect: MakeTime(now)},
+		{name: "tuple", actual: arena.MakeTuple(arena.MakeInt(1), arena.MakeString("b")), expect: MakeTuple(MakeInt(1), MakeString("b"))},
+		{name: "tuple-empty", actual: arena.MakeTuple(), expect: MakeTuple()},
+		{name: "tuple-beyond-chunk", actual: arena.MakeTuple(MakeInt(1), MakeInt(2), MakeInt(3)), expect: MakeTuple(MakeInt(1), MakeInt(2), MakeInt(3))},
+		{
+			name: "object",
+			actual: func() IDataValue {
+				fields := arena.NewObjectFields()
+				fields["a"] = arena.MakeInt(1)
+				return arena.MakeObject(fields)
+			}(),
+			expect: MakeObject(map[string]IDataValue{"a": MakeInt(1)}),
+		},
+	}
+
+	// The values of more than one chunk are kept apart.
+	ints := []IDataValue{arena.MakeInt(10), arena.MakeInt(11), arena.MakeInt(12)}
+	assert.Equal(t, []int64{10, 11, 12}, []int64{AsInt(ints[0]), AsInt(ints[1]), AsInt(ints[2])})
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.True(t, Equals(test.expect, test.actual), "%v!=%v", test.expect, test.actual)
+			assert.Equal(t, test.expect.String(), test.actual.String())
+		})
+	}
+}
+
+func TestArenaReset(t *testing.T) {
+	arena := NewArena()
+
+	a := arena.MakeInt(1)
+	tuple := arena.MakeTuple(MakeString("x"))
+	fields := arena.NewObjectFields()
+	fields["a"] = MakeInt(1)
+	arena.Reset()
+
+	// The next batch gets the same memory.
+	b := arena.MakeInt(2)
+	assert.True(t, a == b)
+	assert.Equal(t, int64(2), AsInt(a))
+	assert.Equal(t, 0, len(fields))
+	assert.Equal(t, 0, len(arena.NewObjectFields()))
+	reused := arena.MakeTuple(MakeString("y"))
+	assert.True(t, tuple == reused)
+	assert.Equal(t, "y", AsString(AsSlice(tuple)[0]))
+
+	// The batches after the first allocate nothing.
+	batch := func() {
+		arena.Reset()
+		for i := 0; i < 3000; i++ {
+			arena.MakeInt(int64(i))
+			arena.MakeFloat(float64(i))
+			arena.MakeString("s")
+		}
+		arena.MakeTuple(arena.MakeInt(1), arena.MakeInt(2))
+	}
+	batch()
+	assert.Equal(t, float64(0), testing.AllocsPerRun(10, batch))
+}
+
+func TestArenaArithmetic(t *testing.T) {
+	arena := NewArenaWithChunk(2)
+	batch := func() {
+		for i := 0; i < 4; i++ {
+			v, err := arena.Add(MakeInt(1), MakeInt(2))
+			assert.Nil(t, err)
+			assert.Equal(t, int64(3), AsInt(v))
+		}
+		v, err := arena.Div(MakeInt(1), MakeInt(2))
+		assert.Nil(t, err)
+		assert.Equal(t, 0.5, AsFloat(v))
+		v, err = arena.Mul(MakeInt32(math.MaxInt32), MakeInt32(2))
+		assert.Nil(t, err)
+		assert.Equal(t, MakeInt32(-2), v)
+		_, err = arena.Sub(MakeString("a"), MakeInt(1))
+		assert.NotNil(t, err)
+		arena.Reset()
+	}
+	batch()
+
+	// The chunks are reused after the Reset.
+	one, two := MakeInt(1), MakeInt(2)
+	assert.Equal(t, float64(0), testing.AllocsPerRun(10, func() {
+		for i := 0; i < 4; i++ {
+			arena.Add(one, two)
+		}
+		arena.Reset()
+	}))
+}
+
+func BenchmarkArenaMakeInt(b *testing.B) {
+	arena := NewArena()
+	values := make([]IDataValue, 65536)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		arena.Reset()
+		for j := range values {
+			values[j] = arena.MakeInt(int64(j))
+		}
+	}
+}
+
+func BenchmarkMakeInt(b *testing.B) {
+	values := make([]IDataValue, 65536)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j := range values {
+			values[j] = MakeInt(int64(j))
+		}
+	}
+}
@@ -0,0 +1,269 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datavalues
+
+import (
+	"encoding/binary"
+	"math"
+	"sort"
+	"time"
+
+	"base/errors"
+)
+
+// ArrowType is the type of an ArrowArray, the subset of the Arrow types the values map to.
+type ArrowType int
+
+const (
+	ArrowNull ArrowType = iota
+	ArrowInt64
+	ArrowFloat64
+	ArrowUtf8
+	// ArrowTimestamp is the nanoseconds since the epoch, in UTC.
+	ArrowTimestamp
+	ArrowStruct
+	ArrowList
+)
+
+// ArrowArray is an array in the Arrow columnar format: the buffers are laid out as the specification says,
+// little endian and padded to 8 bytes, so an Arrow library can take them as they are,
+// such as through the C data interface.
+type ArrowArray struct {
+	Type      ArrowType
+	Length    int
+	NullCount int
+	// Validity has a bit per element, least significant first, set for the non-null ones.
+	// It's nil without a null.
+	Validity []byte
+	// Offsets are the Length+1 int32 offsets of the Utf8 into Data and of the List into its child.
+	Offsets []byte
+	// Data are the fixed width values, or the bytes of the Utf8.
+	Data []byte
+	// Fields are the names of the Struct children, sorted.
+	Fields   []string
+	Children []*ArrowArray
+}
+
+// IsValid is whether the element i is not null.
+func (a *ArrowArray) IsValid(i int) bool {
+	if a.Type == ArrowNull {
+		return false
+	}
+	return a.Validity == nil || a.Validity[i/8]&(1<<uint(i%8)) != 0
+}
+
+func (a *ArrowArray) offset(i int) int {
+	return int(int32(binary.LittleEndian.Uint32(a.Offsets[4*i:])))
+}
+
+// ValuesToArrow builds the Arrow array of the values of the type:
+// Int and Int32 to Int64, Float to Float64, String to Utf8, Time to Timestamp, Null to Null,
+// Object to Struct with the fields of all the objects and Tuple to List of the type of its first element.
+// The NULLs are the unset bits of the validity, the values of another type fail with TYPE_MISMATCH.
+func ValuesToArrow(vals []IDataValue, t Type) (*ArrowArray, error) {
+	a := &ArrowArray{Length: len(vals)}
+	validity := make([]byte, pad8((len(vals)+7)/8))
+	for i, v := range vals {
+		if v == nil || isNullOrZero(v) {
+			a.NullCount++
+			continue
+		}
+		if v.Type() != t && !(t == TypeInt && v.Type() == TypeInt32) {
+			return nil, errors.ErrorWithCode(errors.TYPE_MISMATCH, "Cannot convert %v to Arrow %v at %d", typeName(v), typeNames[t], i)
+		}
+		validity[i/8] |= 1 << uint(i%8)
+	}
+	if a.NullCount > 0 {
+		a.Validity = validity
+	}
+
+	switch t {
+	case TypeNull:
+		a.Type, a.NullCount, a.Validity = ArrowNull, len(vals), nil
+	case TypeInt, TypeInt32:
+		a.Type = ArrowInt64
+		a.Data = make([]byte, pad8(8*len(vals)))
+		for i, v := range vals {
+			if a.IsValid(i) {
+				binary.LittleEndian.PutUint64(a.Data[8*i:], uint64(AsInt(v)))
+			}
+		}
+	case TypeFloat:
+		a.Type = ArrowFloat64
+		a.Data = make([]byte, pad8(8*len(vals)))
+		for i, v := range vals {
+			if a.IsValid(i) {
+				binary.LittleEndian.PutUint64(a.Data[8*i:], math.Float64bits(AsFloat(v)))
+			}
+		}
+	case TypeTime:
+		a.Type = ArrowTimestamp
+		a.Data = make([]byte, pad8(8*len(vals)))
+		for i, v := range vals {
+			if a.IsValid(i) {
+				binary.LittleEndian.PutUint64(a.Data[8*i:], uint64(AsTime(v).UnixNano()))
+			}
+		}
+	case TypeString:
+		a.Type = ArrowUtf8
+		a.Offsets = make([]byte, pad8(4*(len(vals)+1)))
+		var data []byte
+		for i, v := range vals {
+			if a.IsValid(i) {
+				data = append(data, AsString(v)...)
+			}
+			binary.LittleEndian.PutUint32(a.Offsets[4*(i+1):], uint32(len(data)))
+		}
+		a.Data = append(data, make([]byte, pad8(len(data))-len(data))...)
+	case TypeObject:
+		return a, objectsToArrow(a, vals)
+	case TypeTuple:
+		return a, tuplesToArrow(a, vals)
+	default:
+		return nil, errors.ErrorWithCode(errors.TYPE_MISMATCH, "Cannot convert %v to Arrow", typeNames[t])
+	}
+	return a, nil
+}
+
+func objectsToArrow(a *ArrowArray, vals []IDataValue) error {
+	a.Type = ArrowStruct
+
+	// The fields of all the objects, the type of each by its first non-null value.
+	types := make(map[string]Type)
+	for i, v := range vals {
+		if !a.IsValid(i) {
+			continue
+		}
+		for k, field := range AsMap(v) {
+			if _, ok := types[k]; !ok || types[k] == TypeNull {
+				types[k] = TypeNull
+				if field != nil && !isNullOrZero(field) {
+					types[k] = field.Type()
+				}
+			}
+		}
+	}
+	for k := range types {
+		a.Fields = append(a.Fields, k)
+	}
+	sort.Strings(a.Fields)
+
+	// The null object and the missing field are null in the child.
+	for _, k := range a.Fields {
+		fields := make([]IDataValue, len(vals))
+		for i, v := range vals {
+			fields[i] = MakeNull()
+			if a.IsValid(i) {
+				if field, ok := AsMap(v)[k]; ok {
+					fields[i] = field
+				}
+			}
+		}
+		child, err := ValuesToArrow(fields, types[k])
+		if err != nil {
+			return errors.Wrapf(err, "field %s", k)
+		}
+		a.Children = append(a.Children, child)
+	}
+	return nil
+}
+
+func tuplesToArrow(a *ArrowArray, vals []IDataValue) error {
+	a.Type = ArrowList
+	a.Offsets = make([]byte, pad8(4*(len(vals)+1)))
+
+	t := TypeNull
+	var elements []IDataValue
+	for i, v := range vals {
+		if a.IsValid(i) {
+			for _, e := range AsSlice(v) {
+				if t == TypeNull && e != nil && !isNullOrZero(e) {
+					t = e.Type()
+				}
+				elements = append(elements, e)
+			}
+		}
+		binary.LittleEndian.PutUint32(a.Offsets[4*(i+1):], uint32(len(elements)))
+	}
+	child, err := ValuesToArrow(elements, t)
+	if err != nil {
+		return err
+	}
+	a.Children = []*ArrowArray{child}
+	return nil
+}
+
+// ArrowToValues is the inverse of ValuesToArrow, the null elements are NULL
+// and the Struct is the Object with all the fields, the null ones included.
+func ArrowToValues(a *ArrowArray) ([]IDataValue, error) {
+	vals := make([]IDataValue, a.Length)
+	for i := range vals {
+		if !a.IsValid(i) {
+			vals[i] = MakeNull()
+		}
+	}
+
+	switch a.Type {
+	case ArrowNull:
+	case ArrowInt64, ArrowFloat64, ArrowTimestamp:
+		for i := range vals {
+			if vals[i] != nil {
+				continue
+			}
+			u := binary.LittleEndian.Uint64(a.Data[8*i:])
+			switch a.Type {
+			case ArrowInt64:
+				vals[i] = MakeInt(int64(u))
+			case ArrowFloat64:
+				vals[i] = MakeFloat(math.Float64frombits(u))
+			default:
+				vals[i] = MakeTime(time.Unix(0, int64(u)).UTC())
+			}
+		}
+	case ArrowUtf8:
+		for i := range vals {
+			if vals[i] == nil {
+				vals[i] = MakeString(string(a.Data[a.offset(i):a.offset(i+1)]))
+			}
+		}
+	case ArrowStruct:
+		children := make([][]IDataValue, len(a.Children))
+		for j, child := range a.Children {
+			values, err := ArrowToValues(child)
+			if err != nil {
+				return nil, err
+			}
+			children[j] = values
+		}
+		for i := range vals {
+			if vals[i] != nil {
+				continue
+			}
+			fields := make(map[string]IDataValue, len(a.Fields))
+			for j, k := range a.Fields {
+				fields[k] = children[j][i]
+			}
+			vals[i] = MakeObject(fields)
+		}
+	case ArrowList:
+		elements, err := ArrowToValues(a.Children[0])
+		if err != nil {
+			return nil, err
+		}
+		for i := range vals {
+			if vals[i] == nil {
+				vals[i] = MakeTuple(elements[a.offset(i):a.offset(i+1)]...)
+			}
+		}
+	default:
+		return nil, errors.Errorf("Unsupported Arrow type:%v", a.Type)
+	}
+	return vals, nil
+}
+
+// pad8 rounds the size of a buffer up to 8 bytes, the alignment of the Arrow buffers.
+func pad8(n int) int {
+	return (n + 7) &^ 7
+}
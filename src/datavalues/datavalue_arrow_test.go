@@ -0,0 +1,97 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datavalues
+
+import (
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"base/errors"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValuesToArrow(t *testing.T) {
+	now := time.Date(2020, 3, 4, 5, 6, 7, 8, time.UTC)
+	tests := []struct {
+		name   string
+		typ    Type
+		vals   []IDataValue
+		arrow  ArrowType
+		nulls  int
+		expect []IDataValue
+	}{
+		{name: "int", typ: TypeInt, vals: []IDataValue{MakeInt(-1), MakeNull(), MakeInt32(3)}, arrow: ArrowInt64, nulls: 1,
+			expect: []IDataValue{MakeInt(-1), MakeNull(), MakeInt(3)}},
+		{name: "float", typ: TypeFloat, vals: []IDataValue{MakeFloat(1.5), MakeFloat(-2)}, arrow: ArrowFloat64},
+		{name: "string", typ: TypeString, vals: []IDataValue{MakeString("ab"), MakeNull(), MakeString(""), MakeString("c")}, arrow: ArrowUtf8, nulls: 1},
+		{name: "time", typ: TypeTime, vals: []IDataValue{MakeTime(now), nil}, arrow: ArrowTimestamp, nulls: 1,
+			expect: []IDataValue{MakeTime(now), MakeNull()}},
+		{name: "null", typ: TypeNull, vals: []IDataValue{MakeNull(), MakeNull()}, arrow: ArrowNull, nulls: 2},
+		{name: "tuple", typ: TypeTuple, vals: []IDataValue{MakeTuple(MakeInt(1), MakeInt(2)), MakeNull(), MakeTuple(), MakeTuple(MakeInt(3))}, arrow: ArrowList, nulls: 1},
+		{name: "object", typ: TypeObject, arrow: ArrowStruct, nulls: 1,
+			vals: []IDataValue{
+				MakeObject(map[string]IDataValue{"a": MakeInt(1), "b": MakeString("x")}),
+				MakeNull(),
+				MakeObject(map[string]IDataValue{"a": MakeInt(2), "c": MakeObject(map[string]IDataValue{"d": MakeFloat(1)})}),
+			},
+			expect: []IDataValue{
+				MakeObject(map[string]IDataValue{"a": MakeInt(1), "b": MakeString("x"), "c": MakeNull()}),
+				MakeNull(),
+				MakeObject(map[string]IDataValue{"a": MakeInt(2), "b": MakeNull(), "c": MakeObject(map[string]IDataValue{"d": MakeFloat(1)})}),
+			}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			a, err := ValuesToArrow(test.vals, test.typ)
+			assert.Nil(t, err)
+			assert.Equal(t, test.arrow, a.Type)
+			assert.Equal(t, len(test.vals), a.Length)
+			assert.Equal(t, test.nulls, a.NullCount)
+			assert.Equal(t, 0, len(a.Validity)%8)
+			assert.Equal(t, 0, len(a.Data)%8)
+			assert.Equal(t, 0, len(a.Offsets)%8)
+
+			expect := test.expect
+			if expect == nil {
+				expect = test.vals
+			}
+			actual, err := ArrowToValues(a)
+			assert.Nil(t, err)
+			assert.Equal(t, len(expect), len(actual))
+			for i := range expect {
+				assert.True(t, Equals(expect[i], actual[i]), "%v!=%v", expect[i], actual[i])
+			}
+		})
+	}
+}
+
+func TestValuesToArrowLayout(t *testing.T) {
+	a, err := ValuesToArrow([]IDataValue{MakeString("ab"), MakeNull(), MakeString("cde")}, TypeString)
+	assert.Nil(t, err)
+	assert.Equal(t, byte(0x5), a.Validity[0])
+	offsets := []uint32{}
+	for i := 0; i <= a.Length; i++ {
+		offsets = append(offsets, binary.LittleEndian.Uint32(a.Offsets[4*i:]))
+	}
+	assert.Equal(t, []uint32{0, 2, 2, 5}, offsets)
+	assert.Equal(t, "abcde", string(a.Data[:5]))
+
+	a, err = ValuesToArrow([]IDataValue{MakeInt(1), MakeInt(-1)}, TypeInt)
+	assert.Nil(t, err)
+	assert.Nil(t, a.Validity)
+	assert.Equal(t, []byte{1, 0, 0, 0, 0, 0, 0, 0, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, a.Data)
+
+	// The values of another type.
+	_, err = ValuesToArrow([]IDataValue{MakeInt(1), MakeString("a")}, TypeInt)
+	assert.Equal(t, errors.TYPE_MISMATCH, errors.Code(err))
+	assert.Equal(t, "Cannot convert String to Arrow Int at 1 (errno 53)", err.Error())
+	_, err = ValuesToArrow([]IDataValue{MakeTuple(MakeInt(1), MakeString("a"))}, TypeTuple)
+	assert.Equal(t, errors.TYPE_MISMATCH, errors.Code(err))
+	_, err = ValuesToArrow([]IDataValue{MakeBool(true)}, TypeBool)
+	assert.Equal(t, "Cannot convert Bool to Arrow (errno 53)", err.Error())
+}
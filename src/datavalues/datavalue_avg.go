@@ -0,0 +1,101 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datavalues
+
+import (
+	"math"
+
+	"base/errors"
+)
+
+// IAccumulator is the incremental state of an aggregate, the partial ones are merged for the parallel aggregation.
+type IAccumulator interface {
+	Add(v IDataValue) error
+	Merge(other IAccumulator) error
+	Result() IDataValue
+}
+
+// AvgAccumulator is the average of the Int and the Float values added, by the Neumaier compensated sum:
+// the error of the naive sum grows with the rows, the compensation keeps it to the rounding of the result.
+// The NULLs are skipped. The sum is halved as it would overflow, so the average of the large values is finite.
+type AvgAccumulator struct {
+	sum          float64
+	compensation float64
+	// The sum and the compensation are of the values divided by 2^scale.
+	scale int
+	count int64
+}
+
+// Add takes the value into the average.
+func (a *AvgAccumulator) Add(v IDataValue) error {
+	if isNullOrZero(v) {
+		return nil
+	}
+	switch v.Family() {
+	case FamilyInt:
+		a.add(float64(AsInt(v)))
+	case FamilyFloat:
+		a.add(AsFloat(v))
+	default:
+		return errors.ErrorWithCode(errors.TYPE_MISMATCH, "Unsupported type of avg:%v", typeNames[v.Type()])
+	}
+	a.count++
+	return nil
+}
+
+// Merge takes the values of the other into the average, for the parallel aggregation.
+func (a *AvgAccumulator) Merge(arg IAccumulator) error {
+	other, ok := arg.(*AvgAccumulator)
+	if !ok {
+		return errors.Errorf("Can't merge %T into the avg", arg)
+	}
+	for a.scale < other.scale {
+		a.halve()
+	}
+	shift := other.scale - a.scale
+	a.addScaled(math.Ldexp(other.sum, shift))
+	a.compensation += math.Ldexp(other.compensation, shift)
+	a.count += other.count
+	return nil
+}
+
+// Count returns the values added, without the NULLs.
+func (a *AvgAccumulator) Count() int64 {
+	return a.count
+}
+
+// Result returns the Float average, NULL if no value was added.
+func (a *AvgAccumulator) Result() IDataValue {
+	if a.count == 0 {
+		return MakeNull()
+	}
+	avg := (a.sum + a.compensation) / float64(a.count)
+	return MakeFloat(math.Ldexp(avg, a.scale))
+}
+
+func (a *AvgAccumulator) add(x float64) {
+	a.addScaled(math.Ldexp(x, -a.scale))
+}
+
+func (a *AvgAccumulator) addScaled(x float64) {
+	t := a.sum + x
+	if math.IsInf(t, 0) && !math.IsInf(a.sum, 0) && !math.IsInf(x, 0) {
+		a.halve()
+		x /= 2
+		t = a.sum + x
+	}
+	if math.Abs(a.sum) >= math.Abs(x) {
+		a.compensation += (a.sum - t) + x
+	} else {
+		a.compensation += (x - t) + a.sum
+	}
+	a.sum = t
+}
+
+func (a *AvgAccumulator) halve() {
+	a.sum /= 2
+	a.compensation /= 2
+	a.scale++
+}
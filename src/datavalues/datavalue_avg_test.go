@@ -0,0 +1,84 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datavalues
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAvgAccumulator(t *testing.T) {
+	// The large magnitudes cancel out, the naive sum loses the tiny ones to them.
+	var values []IDataValue
+	for i := 0; i < 1000; i++ {
+		values = append(values, MakeFloat(1e16), MakeFloat(1.0), MakeFloat(-1e16), MakeFloat(1e-3), MakeInt(int64(i%3)))
+	}
+
+	reference := new(big.Float).SetPrec(256)
+	var naive float64
+	for _, v := range values {
+		var x float64
+		if v.Family() == FamilyInt {
+			x = float64(AsInt(v))
+		} else {
+			x = AsFloat(v)
+		}
+		reference.Add(reference, new(big.Float).SetPrec(256).SetFloat64(x))
+		naive += x
+	}
+	reference.Quo(reference, new(big.Float).SetPrec(256).SetInt64(int64(len(values))))
+	expect, _ := reference.Float64()
+	assert.True(t, math.Abs(naive/float64(len(values))-expect) > 1e-3)
+
+	acc := &AvgAccumulator{}
+	for _, v := range values {
+		assert.Nil(t, acc.Add(v))
+	}
+	assert.Equal(t, int64(len(values)), acc.Count())
+	assert.InEpsilon(t, expect, AsFloat(acc.Result()), 1e-12)
+
+	// The partial ones of the parallel aggregation.
+	parts := []*AvgAccumulator{{}, {}, {}}
+	for i, v := range values {
+		assert.Nil(t, parts[i%len(parts)].Add(v))
+	}
+	merged := &AvgAccumulator{}
+	for _, part := range parts {
+		assert.Nil(t, merged.Merge(part))
+	}
+	assert.Equal(t, acc.Count(), merged.Count())
+	assert.InEpsilon(t, expect, AsFloat(merged.Result()), 1e-12)
+}
+
+func TestAvgAccumulatorOverflow(t *testing.T) {
+	acc := &AvgAccumulator{}
+	for i := 0; i < 4; i++ {
+		assert.Nil(t, acc.Add(MakeFloat(math.MaxFloat64)))
+	}
+	assert.Nil(t, acc.Add(MakeFloat(-math.MaxFloat64)))
+	assert.InEpsilon(t, math.MaxFloat64*0.6, AsFloat(acc.Result()), 1e-15)
+
+	other := &AvgAccumulator{}
+	assert.Nil(t, other.Add(MakeFloat(1)))
+	assert.Nil(t, other.Merge(acc))
+	assert.InEpsilon(t, math.MaxFloat64/2, AsFloat(other.Result()), 1e-15)
+}
+
+func TestAvgAccumulatorNull(t *testing.T) {
+	acc := &AvgAccumulator{}
+	assert.Equal(t, MakeNull(), acc.Result())
+
+	assert.Nil(t, acc.Add(MakeNull()))
+	assert.Nil(t, acc.Add(MakeInt(1)))
+	assert.Nil(t, acc.Add(MakeInt(2)))
+	assert.Equal(t, int64(2), acc.Count())
+	assert.Equal(t, MakeFloat(1.5), acc.Result())
+
+	assert.NotNil(t, acc.Add(MakeString("a")))
+	assert.NotNil(t, acc.Merge(nil))
+}
@@ -0,0 +1,152 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datavalues
+
+import (
+	"base/errors"
+)
+
+// The column helpers below evaluate two columns element by element.
+// The fast path takes the concrete values without the per-element dispatch and
+// allocates the results in one slice, once a pair of another type shows up
+// the rest falls back to the general Add/Sub/Mul/Compare.
+
+func AddIntColumn(a, b []IDataValue) ([]IDataValue, error) {
+	return intColumn(a, b, func(x, y int64) int64 { return x + y }, Add)
+}
+
+func SubIntColumn(a, b []IDataValue) ([]IDataValue, error) {
+	return intColumn(a, b, func(x, y int64) int64 { return x - y }, Sub)
+}
+
+func MulIntColumn(a, b []IDataValue) ([]IDataValue, error) {
+	return intColumn(a, b, func(x, y int64) int64 { return x * y }, Mul)
+}
+
+func AddFloatColumn(a, b []IDataValue) ([]IDataValue, error) {
+	return floatColumn(a, b, func(x, y float64) float64 { return x + y }, Add)
+}
+
+func SubFloatColumn(a, b []IDataValue) ([]IDataValue, error) {
+	return floatColumn(a, b, func(x, y float64) float64 { return x - y }, Sub)
+}
+
+func MulFloatColumn(a, b []IDataValue) ([]IDataValue, error) {
+	return floatColumn(a, b, func(x, y float64) float64 { return x * y }, Mul)
+}
+
+// CompareIntColumn compares the Int columns pairwise.
+func CompareIntColumn(a, b []IDataValue) ([]Comparison, error) {
+	if err := checkColumns(a, b); err != nil {
+		return nil, err
+	}
+
+	res := make([]Comparison, len(a))
+	for i := range a {
+		x, ok1 := a[i].(*ValueInt)
+		y, ok2 := b[i].(*ValueInt)
+		if !ok1 || !ok2 {
+			return compareColumnFrom(res, a, b, i)
+		}
+		switch {
+		case *x > *y:
+			res[i] = GreaterThan
+		case *x < *y:
+			res[i] = LessThan
+		}
+	}
+	return res, nil
+}
+
+// CompareFloatColumn compares the Float columns pairwise.
+func CompareFloatColumn(a, b []IDataValue) ([]Comparison, error) {
+	if err := checkColumns(a, b); err != nil {
+		return nil, err
+	}
+
+	res := make([]Comparison, len(a))
+	for i := range a {
+		x, ok1 := a[i].(*ValueFloat)
+		y, ok2 := b[i].(*ValueFloat)
+		if !ok1 || !ok2 {
+			return compareColumnFrom(res, a, b, i)
+		}
+		switch {
+		case *x > *y:
+			res[i] = GreaterThan
+		case *x < *y:
+			res[i] = LessThan
+		}
+	}
+	return res, nil
+}
+
+func intColumn(a, b []IDataValue, fn func(x, y int64) int64, general func(v1, v2 IDataValue) (IDataValue, error)) ([]IDataValue, error) {
+	if err := checkColumns(a, b); err != nil {
+		return nil, err
+	}
+
+	res := make([]IDataValue, len(a))
+	values := make([]ValueInt, len(a))
+	for i := range a {
+		x, ok1 := a[i].(*ValueInt)
+		y, ok2 := b[i].(*ValueInt)
+		if !ok1 || !ok2 {
+			return columnFrom(res, a, b, i, general)
+		}
+		values[i] = ValueInt(fn(int64(*x), int64(*y)))
+		res[i] = &values[i]
+	}
+	return res, nil
+}
+
+func floatColumn(a, b []IDataValue, fn func(x, y float64) float64, general func(v1, v2 IDataValue) (IDataValue, error)) ([]IDataValue, error) {
+	if err := checkColumns(a, b); err != nil {
+		return nil, err
+	}
+
+	res := make([]IDataValue, len(a))
+	values := make([]ValueFloat, len(a))
+	for i := range a {
+		x, ok1 := a[i].(*ValueFloat)
+		y, ok2 := b[i].(*ValueFloat)
+		if !ok1 || !ok2 {
+			return columnFrom(res, a, b, i, general)
+		}
+		values[i] = ValueFloat(fn(float64(*x), float64(*y)))
+		res[i] = &values[i]
+	}
+	return res, nil
+}
+
+// columnFrom is the general path from the row i on.
+func columnFrom(res []IDataValue, a, b []IDataValue, i int, general func(v1, v2 IDataValue) (IDataValue, error)) ([]IDataValue, error) {
+	for ; i < len(a); i++ {
+		v, err := general(a[i], b[i])
+		if err != nil {
+			return nil, err
+		}
+		res[i] = v
+	}
+	return res, nil
+}
+
+func compareColumnFrom(res []Comparison, a, b []IDataValue, i int) ([]Comparison, error) {
+	for ; i < len(a); i++ {
+		cmp, err := a[i].Compare(b[i])
+		if err != nil {
+			return nil, err
+		}
+		res[i] = cmp
+	}
+	return res, nil
+}
+
+func checkColumns(a, b []IDataValue) error {
+	if len(a) != len(b) {
+		return errors.Errorf("Column length mismatch:(%v,%v)", len(a), len(b))
+	}
+	return nil
+}
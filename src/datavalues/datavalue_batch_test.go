@@ -0,0 +1,152 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datavalues
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestColumnArithmetic(t *testing.T) {
+	tests := []struct {
+		name   string
+		fn     func(a, b []IDataValue) ([]IDataValue, error)
+		a      []IDataValue
+		b      []IDataValue
+		expect []IDataValue
+		err    string
+	}{
+		{
+			name:   "add-int",
+			fn:     AddIntColumn,
+			a:      []IDataValue{MakeInt(1), MakeInt(2), MakeInt(-3)},
+			b:      []IDataValue{MakeInt(10), MakeInt(20), MakeInt(30)},
+			expect: []IDataValue{MakeInt(11), MakeInt(22), MakeInt(27)},
+		},
+		{
+			name:   "sub-int",
+			fn:     SubIntColumn,
+			a:      []IDataValue{MakeInt(1), MakeInt(2)},
+			b:      []IDataValue{MakeInt(10), MakeInt(20)},
+			expect: []IDataValue{MakeInt(-9), MakeInt(-18)},
+		},
+		{
+			name:   "mul-int-mixed",
+			fn:     MulIntColumn,
+			a:      []IDataValue{MakeInt(2), MakeInt32(3), MakeInt(4)},
+			b:      []IDataValue{MakeInt(5), MakeInt32(6), MakeInt(7)},
+			expect: []IDataValue{MakeInt(10), MakeInt32(18), MakeInt(28)},
+		},
+		{
+			name:   "add-float",
+			fn:     AddFloatColumn,
+			a:      []IDataValue{MakeFloat(1.5), MakeFloat(2)},
+			b:      []IDataValue{MakeFloat(1), MakeFloat(0.25)},
+			expect: []IDataValue{MakeFloat(2.5), MakeFloat(2.25)},
+		},
+		{
+			name:   "sub-float",
+			fn:     SubFloatColumn,
+			a:      []IDataValue{MakeFloat(1.5)},
+			b:      []IDataValue{MakeFloat(1)},
+			expect: []IDataValue{MakeFloat(0.5)},
+		},
+		{
+			name:   "mul-float-mixed",
+			fn:     MulFloatColumn,
+			a:      []IDataValue{MakeFloat(1.5), MakeInt(2)},
+			b:      []IDataValue{MakeFloat(2), MakeInt(3)},
+			expect: []IDataValue{MakeFloat(3), MakeInt(6)},
+		},
+		{
+			name: "unsupported",
+			fn:   AddIntColumn,
+			a:    []IDataValue{MakeInt(1), MakeString("x")},
+			b:    []IDataValue{MakeInt(1), MakeInt(2)},
+			err:  "Unsupported type:(7,3)",
+		},
+		{
+			name: "length",
+			fn:   AddFloatColumn,
+			a:    []IDataValue{MakeFloat(1)},
+			b:    []IDataValue{},
+			err:  "Column length mismatch:(1,0)",
+		},
+	}
+
+	for _, test := range tests {
+		actual, err := test.fn(test.a, test.b)
+		if test.err != "" {
+			assert.NotNil(t, err, test.name)
+			assert.Equal(t, test.err, err.Error(), test.name)
+			continue
+		}
+		assert.Nil(t, err, test.name)
+		assert.Equal(t, test.expect, actual, test.name)
+	}
+}
+
+func TestColumnCompare(t *testing.T) {
+	{
+		a := []IDataValue{MakeInt(1), MakeInt(2), MakeInt32(3)}
+		b := []IDataValue{MakeInt(2), MakeInt(2), MakeInt(1)}
+		actual, err := CompareIntColumn(a, b)
+		assert.Nil(t, err)
+		assert.Equal(t, []Comparison{LessThan, Equal, GreaterThan}, actual)
+	}
+	{
+		a := []IDataValue{MakeFloat(1), MakeFloat(2), MakeFloat(3)}
+		b := []IDataValue{MakeFloat(2), MakeFloat(2), MakeFloat(1)}
+		actual, err := CompareFloatColumn(a, b)
+		assert.Nil(t, err)
+		assert.Equal(t, []Comparison{LessThan, Equal, GreaterThan}, actual)
+	}
+	{
+		a := []IDataValue{MakeFloat(1), MakeString("x")}
+		b := []IDataValue{MakeFloat(2), MakeFloat(2)}
+		_, err := CompareFloatColumn(a, b)
+		assert.NotNil(t, err)
+	}
+}
+
+func benchmarkIntColumns(n int) ([]IDataValue, []IDataValue) {
+	a := make([]IDataValue, n)
+	b := make([]IDataValue, n)
+	for i := 0; i < n; i++ {
+		a[i] = MakeInt(int64(i))
+		b[i] = MakeInt(int64(n - i))
+	}
+	return a, b
+}
+
+func BenchmarkAddIntColumn(b *testing.B) {
+	x, y := benchmarkIntColumns(1000000)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := AddIntColumn(x, y); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkAddIntPerElement(b *testing.B) {
+	x, y := benchmarkIntColumns(1000000)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		res := make([]IDataValue, len(x))
+		for j := range x {
+			v, err := Add(x[j], y[j])
+			if err != nil {
+				b.Fatal(err)
+			}
+			res[j] = v
+		}
+	}
+}
@@ -49,6 +49,9 @@ func (v *ValueBool) String() string {
 }
 
 func (v *ValueBool) Compare(other IDataValue) (Comparison, error) {
+	if a, b, ok := coerceBool(v, other); ok {
+		return a.Compare(b)
+	}
 	if other.Type() != TypeBool {
 		return 0, errors.Errorf("type mismatch between values")
 	}
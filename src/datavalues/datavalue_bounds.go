@@ -0,0 +1,63 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datavalues
+
+import (
+	"math"
+	"time"
+)
+
+// The seconds between the year 1 and the Unix epoch, time.Time counts from the year 1.
+const secondsToUnix = 62135596800
+
+var (
+	minTime = time.Unix(math.MinInt64+secondsToUnix, 0).UTC()
+	maxTime = time.Unix(math.MaxInt64-secondsToUnix, 999999999).UTC()
+)
+
+// maxString is greater than every valid UTF-8 string, whose first byte is never 0xff.
+const maxString = "\xff"
+
+// MinValue returns the smallest value of the type, the range [MinValue, x) has all the values below x.
+// It's nil for the types without order or bounds.
+func MinValue(t Type) IDataValue {
+	switch t {
+	case TypeInt:
+		return MakeInt(math.MinInt64)
+	case TypeInt32:
+		return MakeInt32(math.MinInt32)
+	case TypeFloat:
+		return MakeFloat(math.Inf(-1))
+	case TypeBool:
+		return MakeBool(false)
+	case TypeString:
+		return MakeString("")
+	case TypeBytes:
+		return MakeBytes(nil)
+	case TypeTime:
+		return MakeTime(minTime)
+	}
+	return nil
+}
+
+// MaxValue returns the largest value of the type, the String one is a sentinel for the UTF-8 strings.
+// It's nil for the types without order or bounds.
+func MaxValue(t Type) IDataValue {
+	switch t {
+	case TypeInt:
+		return MakeInt(math.MaxInt64)
+	case TypeInt32:
+		return MakeInt32(math.MaxInt32)
+	case TypeFloat:
+		return MakeFloat(math.Inf(1))
+	case TypeBool:
+		return MakeBool(true)
+	case TypeString:
+		return MakeString(maxString)
+	case TypeTime:
+		return MakeTime(maxTime)
+	}
+	return nil
+}
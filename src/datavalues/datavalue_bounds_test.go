@@ -0,0 +1,72 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datavalues
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMinMaxValue(t *testing.T) {
+	tests := []struct {
+		name   string
+		typ    Type
+		values []IDataValue
+	}{
+		{
+			name:   "int",
+			typ:    TypeInt,
+			values: []IDataValue{MakeInt(math.MinInt64 + 1), MakeInt(0), MakeInt(math.MaxInt64 - 1)},
+		},
+		{
+			name:   "int32",
+			typ:    TypeInt32,
+			values: []IDataValue{MakeInt32(math.MinInt32 + 1), MakeInt32(0), MakeInt32(math.MaxInt32 - 1)},
+		},
+		{
+			name:   "float",
+			typ:    TypeFloat,
+			values: []IDataValue{MakeFloat(-math.MaxFloat64), MakeFloat(0), MakeFloat(math.MaxFloat64)},
+		},
+		{
+			name:   "string",
+			typ:    TypeString,
+			values: []IDataValue{MakeString("a"), MakeString("\U0010ffff\U0010ffff"), MakeString("\xef\xbf\xbf")},
+		},
+		{
+			name: "time",
+			typ:  TypeTime,
+			values: []IDataValue{
+				MakeTime(time.Time{}),
+				MakeTime(time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC)),
+				MakeTimeWithPrecision(time.Date(9999, 12, 31, 23, 59, 59, 999999999, time.UTC), 9),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		min, max := MinValue(test.typ), MaxValue(test.typ)
+		assert.Equal(t, test.typ, min.Type(), test.name)
+		assert.Equal(t, test.typ, max.Type(), test.name)
+		for _, v := range test.values {
+			cmp, err := min.Compare(v)
+			assert.Nil(t, err, test.name)
+			assert.Equal(t, LessThan, cmp, test.name)
+			cmp, err = max.Compare(v)
+			assert.Nil(t, err, test.name)
+			assert.Equal(t, GreaterThan, cmp, test.name)
+		}
+	}
+
+	assert.Equal(t, MakeBool(false), MinValue(TypeBool))
+	assert.Equal(t, MakeBool(true), MaxValue(TypeBool))
+	assert.Equal(t, MakeString(""), MinValue(TypeString))
+	assert.Nil(t, MaxValue(TypeBytes))
+	assert.Nil(t, MinValue(TypeTuple))
+	assert.Nil(t, MaxValue(TypeNull))
+}
@@ -0,0 +1,77 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datavalues
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+	"unsafe"
+
+	"base/docs"
+	"base/errors"
+)
+
+// ValueBytes is the binary string, such as a protobuf blob in a String column.
+// It's in the string family, but shown as hex since the bytes may not be valid UTF-8.
+// It's only made explicitly, by MakeBytes or the Binary of ToValue, the String columns are read as String.
+type ValueBytes []byte
+
+// Binary is the Go type which ToValue takes as the Bytes, the plain []byte is the String.
+type Binary []byte
+
+func MakeBytes(v []byte) IDataValue {
+	r := ValueBytes(v)
+	return &r
+}
+
+func ZeroBytes() IDataValue {
+	r := ValueBytes(nil)
+	return &r
+}
+
+func (v *ValueBytes) Size() uintptr {
+	return unsafe.Sizeof(v) + uintptr(len(*v))
+}
+
+func (v *ValueBytes) String() string {
+	return strings.ToUpper(hex.EncodeToString(*v))
+}
+
+func (v *ValueBytes) Type() Type {
+	return TypeBytes
+}
+
+func (v *ValueBytes) Family() Family {
+	return FamilyString
+}
+
+// AsBytes returns the underlying bytes without copy, they must not be modified.
+func (v *ValueBytes) AsBytes() []byte {
+	return []byte(*v)
+}
+
+func (v *ValueBytes) Compare(other IDataValue) (Comparison, error) {
+	if other.Family() != FamilyString {
+		return 0, errors.Errorf("type mismatch between values, got:%v", other.Type())
+	}
+	return Comparison(bytes.Compare(*v, AsBytes(other))), nil
+}
+
+func (v *ValueBytes) Document() docs.Documentation {
+	return docs.Text("Bytes")
+}
+
+// AsBytes returns the bytes of the string family value,
+// without copy for the Bytes, the String has to be copied since the Go strings are immutable.
+func AsBytes(v IDataValue) []byte {
+	switch t := v.(type) {
+	case *ValueBytes:
+		return []byte(*t)
+	case *ValueString:
+		return []byte(*t)
+	}
+	return nil
+}
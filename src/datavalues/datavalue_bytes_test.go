@@ -0,0 +1,48 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datavalues
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValueBytes(t *testing.T) {
+	blob := []byte{0x0a, 0x03, 0xff, 'v', 0x00}
+	v := ToValue(Binary(blob))
+	assert.Equal(t, TypeBytes, v.Type())
+	assert.Equal(t, FamilyString, v.Family())
+	assert.Equal(t, "0A03FF7600", v.String())
+	assert.Equal(t, string(blob), AsString(v))
+
+	// No copy.
+	bs := AsBytes(v)
+	assert.Equal(t, blob, bs)
+	assert.True(t, &blob[0] == &bs[0])
+
+	// The plain []byte is the String, as the String columns are.
+	plain := ToValue(blob)
+	assert.Equal(t, TypeString, plain.Type())
+	assert.Equal(t, string(blob), plain.String())
+	assert.True(t, Equals(v, plain))
+
+	s := ToValue("abc")
+	assert.Equal(t, TypeString, s.Type())
+	assert.Equal(t, []byte("abc"), AsBytes(s))
+	assert.Nil(t, AsBytes(MakeInt(1)))
+
+	cmp, err := MakeBytes([]byte("abc")).Compare(s)
+	assert.Nil(t, err)
+	assert.Equal(t, Equal, cmp)
+	cmp, err = s.Compare(MakeBytes([]byte("abd")))
+	assert.Nil(t, err)
+	assert.Equal(t, LessThan, cmp)
+	_, err = v.Compare(MakeInt(1))
+	assert.NotNil(t, err)
+
+	assert.True(t, Equals(MakeBytes([]byte("abc")), s))
+	assert.Equal(t, Hash(MakeBytes([]byte("abc"))), Hash(s))
+}
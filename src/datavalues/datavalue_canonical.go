@@ -0,0 +1,91 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datavalues
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	canonicalNull       = "null"
+	canonicalTimeLayout = "2006-01-02T15:04:05.000000000Z"
+)
+
+// Canonical returns the deterministic type tagged text of the value, to be used as the map key:
+// the values which Equals have the same canonical text, the others differ.
+// It's not for humans, the String is.
+func Canonical(v IDataValue) string {
+	var sb strings.Builder
+	writeCanonical(&sb, v)
+	return sb.String()
+}
+
+func writeCanonical(sb *strings.Builder, v IDataValue) {
+	if isNullOrZero(v) {
+		sb.WriteString(canonicalNull)
+		return
+	}
+
+	switch v.Family() {
+	case FamilyInt:
+		sb.WriteString("i:")
+		sb.WriteString(strconv.FormatInt(AsInt(v), 10))
+	case FamilyFloat:
+		sb.WriteString("f:")
+		f := AsFloat(v)
+		switch {
+		case f == 0:
+			sb.WriteString("0")
+		case math.IsNaN(f):
+			sb.WriteString("NaN")
+		default:
+			sb.WriteString(strconv.FormatFloat(f, 'g', -1, 64))
+		}
+	case FamilyBool:
+		sb.WriteString("b:")
+		sb.WriteString(strconv.FormatBool(AsBool(v)))
+	case FamilyString:
+		sb.WriteString("s:")
+		sb.WriteString(strconv.Quote(AsString(v)))
+	case FamilyTime:
+		sb.WriteString("t:")
+		sb.WriteString(AsTime(v).UTC().Format(canonicalTimeLayout))
+	case FamilyTuple:
+		sb.WriteString("(")
+		for i, field := range AsSlice(v) {
+			if i > 0 {
+				sb.WriteString(",")
+			}
+			writeCanonical(sb, field)
+		}
+		sb.WriteString(")")
+	case FamilyObject:
+		fields := AsMap(v)
+		keys := make([]string, 0, len(fields))
+		for k := range fields {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		sb.WriteString("{")
+		for i, k := range keys {
+			if i > 0 {
+				sb.WriteString(",")
+			}
+			sb.WriteString(strconv.Quote(k))
+			sb.WriteString(":")
+			writeCanonical(sb, fields[k])
+		}
+		sb.WriteString("}")
+	default:
+		sb.WriteString("?")
+		sb.WriteString(strconv.Itoa(int(v.Type())))
+		sb.WriteString(":")
+		sb.WriteString(strconv.Quote(v.String()))
+	}
+}
+
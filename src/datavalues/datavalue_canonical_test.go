@@ -0,0 +1,170 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datavalues
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanonical(t *testing.T) {
+	tests := []struct {
+		name   string
+		value  IDataValue
+		expect string
+	}{
+		{
+			name:   "null",
+			value:  MakeNull(),
+			expect: "null",
+		},
+		{
+			name:   "nil",
+			value:  nil,
+			expect: "null",
+		},
+		{
+			name:   "int",
+			value:  MakeInt(-1),
+			expect: "i:-1",
+		},
+		{
+			name:   "int32",
+			value:  MakeInt32(-1),
+			expect: "i:-1",
+		},
+		{
+			name:   "float",
+			value:  MakeFloat(1.5),
+			expect: "f:1.5",
+		},
+		{
+			name:   "float-negative-zero",
+			value:  MakeFloat(math.Copysign(0, -1)),
+			expect: "f:0",
+		},
+		{
+			name:   "float-nan",
+			value:  MakeFloat(math.NaN()),
+			expect: "f:NaN",
+		},
+		{
+			name:   "bool",
+			value:  MakeBool(true),
+			expect: "b:true",
+		},
+		{
+			name:   "string",
+			value:  MakeString("1"),
+			expect: "s:\"1\"",
+		},
+		{
+			name:   "string-null",
+			value:  MakeString("null"),
+			expect: "s:\"null\"",
+		},
+		{
+			name:   "bytes",
+			value:  MakeBytes([]byte{'a', 0xff}),
+			expect: "s:\"a\\xff\"",
+		},
+		{
+			name:   "time",
+			value:  MakeTime(time.Date(2020, 1, 2, 3, 4, 5, 6, time.FixedZone("UTC+8", 8*3600))),
+			expect: "t:2020-01-01T19:04:05.000000006Z",
+		},
+		{
+			name:   "tuple",
+			value:  MakeTuple(MakeInt(1), MakeString("a,b"), MakeNull()),
+			expect: "(i:1,s:\"a,b\",null)",
+		},
+		{
+			name: "object",
+			value: ToValue(map[string]interface{}{
+				"b": "x",
+				"a": []interface{}{1, 2.5},
+				"c": map[string]interface{}{"z": true, "y": int64(1)},
+			}),
+			expect: "{\"a\":(i:1,f:2.5),\"b\":s:\"x\",\"c\":{\"y\":i:1,\"z\":b:true}}",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expect, Canonical(test.value))
+		})
+	}
+}
+
+func TestCanonicalEquals(t *testing.T) {
+	values := []IDataValue{
+		MakeNull(),
+		MakeInt(1),
+		MakeInt32(1),
+		MakeFloat(1),
+		MakeString("1"),
+		MakeBytes([]byte("1")),
+		MakeBool(true),
+		MakeTime(time.Unix(1, 0)),
+		MakeTime(time.Unix(1, 0).UTC()),
+		MakeTuple(MakeInt(1)),
+		MakeTuple(MakeString("1")),
+		ToValue(map[string]interface{}{"a": 1, "b": "x"}),
+		ToValue(map[string]interface{}{"b": "x", "a": int64(1)}),
+		ToValue(map[string]interface{}{"a": "1"}),
+	}
+
+	for _, a := range values {
+		for _, b := range values {
+			equals := Equals(a, b)
+			assert.Equal(t, equals, Canonical(a) == Canonical(b), "%v vs %v", Canonical(a), Canonical(b))
+			if equals {
+				assert.Equal(t, Hash(a), Hash(b))
+			}
+		}
+	}
+}
+
+func TestEqualsLoose(t *testing.T) {
+	tests := []struct {
+		name   string
+		a      IDataValue
+		b      IDataValue
+		expect bool
+	}{
+		{name: "int-float", a: MakeInt(1), b: MakeFloat(1.0), expect: true},
+		{name: "float-int32", a: MakeFloat(-3), b: MakeInt32(-3), expect: true},
+		{name: "int-float-fraction", a: MakeInt(1), b: MakeFloat(1.5), expect: false},
+		{name: "int-float-nan", a: MakeInt(0), b: MakeFloat(math.NaN()), expect: false},
+		{name: "int-float-inf", a: MakeInt(math.MaxInt64), b: MakeFloat(math.Inf(1)), expect: false},
+		{name: "int-string", a: MakeInt(1), b: MakeString("1"), expect: false},
+		{name: "null-null", a: MakeNull(), b: MakeNull(), expect: true},
+		{name: "null-int", a: MakeNull(), b: MakeInt(0), expect: false},
+		{name: "tuple", a: MakeTuple(MakeInt(1), MakeString("x")), b: MakeTuple(MakeFloat(1), MakeString("x")), expect: true},
+		{name: "tuple-length", a: MakeTuple(MakeInt(1)), b: MakeTuple(MakeFloat(1), MakeFloat(2)), expect: false},
+		{
+			name:   "object",
+			a:      ToValue(map[string]interface{}{"a": 1, "b": []interface{}{int64(2), "y"}}),
+			b:      ToValue(map[string]interface{}{"b": []interface{}{2.0, "y"}, "a": 1.0}),
+			expect: true,
+		},
+		{
+			name:   "object-keys",
+			a:      ToValue(map[string]interface{}{"a": 1}),
+			b:      ToValue(map[string]interface{}{"b": 1.0}),
+			expect: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expect, EqualsLoose(test.a, test.b))
+			assert.Equal(t, test.expect, EqualsLoose(test.b, test.a))
+		})
+	}
+}
@@ -0,0 +1,203 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datavalues
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"base/errors"
+	"base/sync2"
+)
+
+// DefaultCastTimeLayouts are the layouts the strings cast to Time are tried by, in order.
+// The layouts without the zone are of UTC, the fractional seconds are taken by all of them.
+var DefaultCastTimeLayouts = []string{
+	time.RFC3339Nano,
+	TimeLayout,
+	"2006-01-02T15:04:05",
+	"2006-01-02",
+}
+
+var (
+	castNullString  = sync2.NewAtomicString("")
+	castEpochMillis = sync2.NewAtomicBool(false)
+	castTimeLayouts = struct {
+		mu      sync.RWMutex
+		layouts []string
+	}{layouts: DefaultCastTimeLayouts}
+)
+
+// SetCastNullString sets the text the NULL casts to String as, of the whole process. It's empty by default,
+// such as `\N` for the TSV.
+func SetCastNullString(s string) {
+	castNullString.Set(s)
+}
+
+func GetCastNullString() string {
+	return castNullString.Get()
+}
+
+// SetCastTimeLayouts sets the layouts of the strings cast to Time, of the whole process, tried in order.
+// No layout is the DefaultCastTimeLayouts.
+func SetCastTimeLayouts(layouts ...string) {
+	if len(layouts) == 0 {
+		layouts = DefaultCastTimeLayouts
+	}
+	castTimeLayouts.mu.Lock()
+	defer castTimeLayouts.mu.Unlock()
+	castTimeLayouts.layouts = append([]string(nil), layouts...)
+}
+
+func GetCastTimeLayouts() []string {
+	castTimeLayouts.mu.RLock()
+	defer castTimeLayouts.mu.RUnlock()
+	return castTimeLayouts.layouts
+}
+
+// SetCastEpochMillis sets the numbers cast to Time as the milliseconds since the epoch, of the whole process.
+// They are the seconds by default.
+func SetCastEpochMillis(millis bool) {
+	castEpochMillis.Set(millis)
+}
+
+func GetCastEpochMillis() bool {
+	return castEpochMillis.Get()
+}
+
+// Cast converts the value to the type explicitly, as the CAST of the SQL.
+// Casting to the type of the value returns the value itself. The unsupported ones fail with TYPE_MISMATCH.
+// The strings cast to Time by the cast time layouts, the numbers as the epoch, the NULL stays NULL.
+// The strings cast to Duration as the Go or the ISO 8601 durations, the numbers as the seconds.
+// The error values stay themselves.
+func Cast(v IDataValue, typ Type) (IDataValue, error) {
+	if v != nil && (v.Type() == typ || v.Type() == TypeError) {
+		return v, nil
+	}
+
+	switch typ {
+	case TypeString:
+		return MakeString(castString(v)), nil
+	case TypeTime:
+		switch {
+		case isNullOrZero(v):
+			return MakeNull(), nil
+		case v.Type() == TypeString:
+			return castTime(AsString(v))
+		case IsIntegral(v):
+			return MakeTime(epochTime(AsInt(v), 0)), nil
+		case v.Type() == TypeFloat:
+			return castEpochFloat(AsFloat(v))
+		}
+	case TypeDuration:
+		switch {
+		case isNullOrZero(v):
+			return MakeNull(), nil
+		case v.Type() == TypeString:
+			return castDuration(AsString(v))
+		case IsIntegral(v):
+			return castDurationSeconds(AsInt(v))
+		case v.Type() == TypeFloat:
+			return castDurationFloat(AsFloat(v))
+		}
+	}
+	from := "NULL"
+	if !isNullOrZero(v) {
+		from = typeName(v)
+	}
+	return nil, errors.ErrorWithCode(errors.TYPE_MISMATCH, "Cannot cast %v to %v", from, typeNames[typ])
+}
+
+// CastOrError is the best-effort Cast, the failure is the error value of the cell rather than of the batch.
+func CastOrError(v IDataValue, typ Type) IDataValue {
+	r, err := Cast(v, typ)
+	if err != nil {
+		return MakeError(err)
+	}
+	return r
+}
+
+// castString is the text of the value as the String, to build the strings from, unlike the Show which quotes them:
+// the integers without the decimals, the floats in the shortest form parsed back to them,
+// the times in RFC3339, the bools as true and false, the NULL as the cast null string.
+// The tuples and the objects are as the Show, their fields are quoted.
+func castString(v IDataValue) string {
+	switch {
+	case isNullOrZero(v):
+		return GetCastNullString()
+	case v.Type() == TypeString:
+		return AsString(v)
+	case IsIntegral(v):
+		return strconv.FormatInt(AsInt(v), 10)
+	case v.Type() == TypeFloat:
+		return strconv.FormatFloat(AsFloat(v), 'g', -1, 64)
+	case v.Type() == TypeBool:
+		return strconv.FormatBool(AsBool(v))
+	case v.Type() == TypeTime:
+		t := v.(*ValueTime)
+		return t.AsTime().Format(rfc3339Layout(t.Precision()))
+	case v.Type() == TypeBytes:
+		return string(AsBytes(v))
+	case v.Family() == FamilyTuple, v.Family() == FamilyObject:
+		return Show(v)
+	}
+	return v.String()
+}
+
+// rfc3339Layout is the RFC3339 with the fraction of the precision digits.
+func rfc3339Layout(precision int) string {
+	if precision <= 0 {
+		return time.RFC3339
+	}
+	return "2006-01-02T15:04:05." + strings.Repeat("0", precision) + "Z07:00"
+}
+
+// castTime parses the string by the cast time layouts in order, then as the number of the epoch.
+func castTime(s string) (IDataValue, error) {
+	s = strings.TrimSpace(s)
+	layouts := GetCastTimeLayouts()
+	for _, layout := range layouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return MakeTime(t), nil
+		}
+	}
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return MakeTime(epochTime(i, 0)), nil
+	}
+	if f, err := strconv.ParseFloat(s, 64); err == nil {
+		return castEpochFloat(f)
+	}
+
+	epoch := "epoch seconds"
+	if GetCastEpochMillis() {
+		epoch = "epoch milliseconds"
+	}
+	return nil, errors.ErrorWithCode(errors.CANNOT_PARSE_TEXT, "Cannot parse %q as Time, tried the layouts %q and the %v",
+		s, layouts, epoch)
+}
+
+// castEpochFloat is the time of the seconds or the milliseconds with the fraction, to the nanosecond.
+func castEpochFloat(f float64) (IDataValue, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return nil, errors.ErrorWithCode(errors.CANNOT_PARSE_TEXT, "Cannot cast %v to Time", f)
+	}
+	whole, frac := math.Modf(f)
+	scale := 1e9
+	if GetCastEpochMillis() {
+		scale = 1e6
+	}
+	return MakeTime(epochTime(int64(whole), int64(math.Round(frac*scale)))), nil
+}
+
+// epochTime is the UTC time of the seconds, or of the milliseconds if the epoch is of them, and the nanoseconds beyond.
+func epochTime(v int64, nsec int64) time.Time {
+	if GetCastEpochMillis() {
+		return time.UnixMilli(v).Add(time.Duration(nsec)).UTC()
+	}
+	return time.Unix(v, nsec).UTC()
+}
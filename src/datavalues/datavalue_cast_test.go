@@ -0,0 +1,206 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datavalues
+
+import (
+	"math"
+	"net/netip"
+	"testing"
+	"time"
+
+	"base/errors"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCastString(t *testing.T) {
+	at := time.Date(2020, 1, 2, 3, 4, 5, 123456789, time.UTC)
+	tests := []struct {
+		name   string
+		value  IDataValue
+		expect string
+	}{
+		{name: "int", value: MakeInt(42), expect: "42"},
+		{name: "int-negative", value: MakeInt(-7), expect: "-7"},
+		{name: "int32", value: MakeInt32(42), expect: "42"},
+		{name: "float", value: MakeFloat(0.1), expect: "0.1"},
+		{name: "float-integral", value: MakeFloat(5), expect: "5"},
+		{name: "float-large", value: MakeFloat(1e21), expect: "1e+21"},
+		{name: "float-inf", value: MakeFloat(math.Inf(-1)), expect: "-Inf"},
+		{name: "bool", value: MakeBool(true), expect: "true"},
+		{name: "time", value: MakeTime(at), expect: "2020-01-02T03:04:05Z"},
+		{name: "time-precision", value: MakeTimeWithPrecision(at, 3), expect: "2020-01-02T03:04:05.123Z"},
+		{name: "bytes", value: MakeBytes([]byte("ab")), expect: "ab"},
+		{name: "ip", value: MakeIP(netip.MustParseAddr("10.0.0.1")), expect: "10.0.0.1"},
+		{name: "tuple", value: MakeTuple(MakeInt(1), MakeString("a")), expect: `(1, "a")`},
+		{name: "null", value: MakeNull(), expect: ""},
+		{name: "zero", value: nil, expect: ""},
+	}
+
+	for _, test := range tests {
+		actual, err := Cast(test.value, TypeString)
+		assert.Nil(t, err, test.name)
+		assert.Equal(t, MakeString(test.expect), actual, test.name)
+	}
+
+	// The String casts to itself, unquoted unlike the Show.
+	s := MakeString("a\"b")
+	actual, err := Cast(s, TypeString)
+	assert.Nil(t, err)
+	assert.True(t, actual == s)
+	assert.Equal(t, `"a\"b"`, Show(s))
+
+	a, b := 0.1, 0.2
+	f, err := Cast(MakeFloat(a+b), TypeString)
+	assert.Nil(t, err)
+	assert.Equal(t, MakeString("0.30000000000000004"), f)
+
+	SetCastNullString(`\N`)
+	defer SetCastNullString("")
+	actual, err = Cast(MakeNull(), TypeString)
+	assert.Nil(t, err)
+	assert.Equal(t, MakeString(`\N`), actual)
+}
+
+func TestCastTime(t *testing.T) {
+	at := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	tests := []struct {
+		name   string
+		value  IDataValue
+		expect time.Time
+	}{
+		{name: "rfc3339", value: MakeString("2020-01-02T03:04:05Z"), expect: at},
+		{name: "rfc3339-zone", value: MakeString("2020-01-02T11:04:05+08:00"), expect: at},
+		{name: "rfc3339-nano", value: MakeString("2020-01-02T03:04:05.123456789Z"), expect: at.Add(123456789)},
+		{name: "datetime", value: MakeString("2020-01-02 03:04:05"), expect: at},
+		{name: "datetime-fraction", value: MakeString("2020-01-02 03:04:05.5"), expect: at.Add(500 * time.Millisecond)},
+		{name: "datetime-t", value: MakeString("2020-01-02T03:04:05"), expect: at},
+		{name: "date", value: MakeString(" 2020-01-02 "), expect: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{name: "epoch-string", value: MakeString("1577934245"), expect: at},
+		{name: "epoch-int", value: MakeInt(1577934245), expect: at},
+		{name: "epoch-int32", value: MakeInt32(1577934245), expect: at},
+		{name: "epoch-float", value: MakeFloat(1577934245.25), expect: at.Add(250 * time.Millisecond)},
+		{name: "epoch-negative", value: MakeInt(-1), expect: time.Date(1969, 12, 31, 23, 59, 59, 0, time.UTC)},
+	}
+	for _, test := range tests {
+		actual, err := Cast(test.value, TypeTime)
+		assert.Nil(t, err, test.name)
+		assert.True(t, test.expect.Equal(AsTime(actual)), test.name)
+	}
+	// The zone of the string is kept, the others are of UTC.
+	actual, err := Cast(MakeString("2020-01-02T11:04:05+08:00"), TypeTime)
+	assert.Nil(t, err)
+	assert.Equal(t, "2020-01-02 11:04:05", actual.String())
+	actual, err = Cast(MakeInt(1577934245), TypeTime)
+	assert.Nil(t, err)
+	assert.Equal(t, time.UTC, AsTime(actual).Location())
+
+	// The NULL passes, the Time casts to itself.
+	actual, err = Cast(MakeNull(), TypeTime)
+	assert.Nil(t, err)
+	assert.True(t, IsNull(actual))
+	v := MakeTime(at)
+	actual, err = Cast(v, TypeTime)
+	assert.Nil(t, err)
+	assert.True(t, actual == v)
+
+	_, err = Cast(MakeString("02/01/2020"), TypeTime)
+	assert.Equal(t, errors.CANNOT_PARSE_TEXT, errors.Code(err))
+	assert.Equal(t, `Cannot parse "02/01/2020" as Time, tried the layouts ["2006-01-02T15:04:05.999999999Z07:00" "2006-01-02 15:04:05" "2006-01-02T15:04:05" "2006-01-02"] and the epoch seconds (errno 6)`, err.Error())
+	_, err = Cast(MakeFloat(math.NaN()), TypeTime)
+	assert.Equal(t, errors.CANNOT_PARSE_TEXT, errors.Code(err))
+	_, err = Cast(MakeBool(true), TypeTime)
+	assert.Equal(t, errors.TYPE_MISMATCH, errors.Code(err))
+
+	// The layouts are tried in order, the first one matching wins.
+	SetCastTimeLayouts("02/01/2006", "01/02/2006")
+	defer SetCastTimeLayouts()
+	actual, err = Cast(MakeString("02/01/2020"), TypeTime)
+	assert.Nil(t, err)
+	assert.Equal(t, time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC), AsTime(actual))
+	_, err = Cast(MakeString("2020-01-02"), TypeTime)
+	assert.NotNil(t, err)
+	SetCastTimeLayouts()
+	assert.Equal(t, DefaultCastTimeLayouts, GetCastTimeLayouts())
+
+	SetCastEpochMillis(true)
+	defer SetCastEpochMillis(false)
+	for _, v := range []IDataValue{MakeInt(1577934245250), MakeFloat(1577934245250), MakeString("1577934245250")} {
+		actual, err = Cast(v, TypeTime)
+		assert.Nil(t, err)
+		assert.Equal(t, at.Add(250*time.Millisecond), AsTime(actual))
+	}
+	actual, err = Cast(MakeFloat(1577934245250.5), TypeTime)
+	assert.Nil(t, err)
+	assert.Equal(t, at.Add(250*time.Millisecond+500*time.Microsecond), AsTime(actual))
+}
+
+func TestCastDuration(t *testing.T) {
+	tests := []struct {
+		name   string
+		value  IDataValue
+		expect time.Duration
+	}{
+		{name: "go", value: MakeString("1h30m"), expect: 90 * time.Minute},
+		{name: "go-fraction", value: MakeString(" 1.5s "), expect: 1500 * time.Millisecond},
+		{name: "go-negative", value: MakeString("-2m"), expect: -2 * time.Minute},
+		{name: "iso-hour", value: MakeString("PT1H"), expect: time.Hour},
+		{name: "iso-time", value: MakeString("PT1H30M15S"), expect: time.Hour + 30*time.Minute + 15*time.Second},
+		{name: "iso-date", value: MakeString("P1W2DT3H"), expect: 9*24*time.Hour + 3*time.Hour},
+		{name: "iso-fraction", value: MakeString("PT0.25S"), expect: 250 * time.Millisecond},
+		{name: "iso-comma", value: MakeString("PT1,5M"), expect: 90 * time.Second},
+		{name: "iso-negative", value: MakeString("-PT5M"), expect: -5 * time.Minute},
+		{name: "seconds-string", value: MakeString("90"), expect: 90 * time.Second},
+		{name: "seconds-float-string", value: MakeString("0.5"), expect: 500 * time.Millisecond},
+		{name: "int", value: MakeInt(3600), expect: time.Hour},
+		{name: "int32", value: MakeInt32(-60), expect: -time.Minute},
+		{name: "float", value: MakeFloat(1.25), expect: 1250 * time.Millisecond},
+	}
+	for _, test := range tests {
+		actual, err := Cast(test.value, TypeDuration)
+		assert.Nil(t, err, test.name)
+		assert.Equal(t, MakeDuration(test.expect), actual, test.name)
+	}
+
+	// The NULL passes, the Duration casts to itself and round trips through the String.
+	actual, err := Cast(MakeNull(), TypeDuration)
+	assert.Nil(t, err)
+	assert.True(t, IsNull(actual))
+	v := MakeDuration(90 * time.Minute)
+	actual, err = Cast(v, TypeDuration)
+	assert.Nil(t, err)
+	assert.True(t, actual == v)
+	s, err := Cast(v, TypeString)
+	assert.Nil(t, err)
+	assert.Equal(t, MakeString("1h30m0s"), s)
+	actual, err = Cast(s, TypeDuration)
+	assert.Nil(t, err)
+	assert.Equal(t, v, actual)
+
+	// The years and the months have no fixed length.
+	for _, s := range []string{"", "1x", "P", "PT", "P1Y", "P1M", "PT1H1H", "PT1M1H", "P1H", "PT1D", "PT1.5H30M",
+		"P1DT", "PT.5S", "1M", "abc", "PT99999999999H"} {
+		_, err = Cast(MakeString(s), TypeDuration)
+		assert.Equal(t, errors.CANNOT_PARSE_TEXT, errors.Code(err), s)
+	}
+	for _, v := range []IDataValue{MakeInt(math.MaxInt64), MakeFloat(1e19), MakeFloat(math.NaN()), MakeFloat(math.Inf(-1))} {
+		_, err = Cast(v, TypeDuration)
+		assert.Equal(t, errors.CANNOT_PARSE_TEXT, errors.Code(err), v.String())
+	}
+	_, err = Cast(MakeBool(true), TypeDuration)
+	assert.Equal(t, errors.TYPE_MISMATCH, errors.Code(err))
+}
+
+func TestCastUnsupported(t *testing.T) {
+	_, err := Cast(MakeString("1"), TypeIPv6)
+	assert.Equal(t, errors.TYPE_MISMATCH, errors.Code(err))
+	_, err = Cast(MakeNull(), TypePrefix)
+	assert.Equal(t, errors.TYPE_MISMATCH, errors.Code(err))
+
+	v := MakeInt(1)
+	actual, err := Cast(v, TypeInt)
+	assert.Nil(t, err)
+	assert.True(t, actual == v)
+}
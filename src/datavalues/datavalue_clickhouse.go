@@ -0,0 +1,527 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datavalues
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"base/errors"
+)
+
+const (
+	clickHouseDateLayout = "2006-01-02"
+)
+
+// ClickHouseType is the ClickHouse type of the text, such as 'Array(Nullable(Int64))'.
+// The Type of the values has no element types, which the arrays and tuples need to be parsed back.
+type ClickHouseType struct {
+	Name  string
+	Elems []*ClickHouseType
+}
+
+var clickHouseIntBits = map[string]int{
+	"Int8":  8,
+	"Int16": 16,
+	"Int32": 32,
+	"Int64": 64,
+}
+
+var clickHouseUIntBits = map[string]int{
+	"UInt8":  8,
+	"UInt16": 16,
+	"UInt32": 32,
+	"UInt64": 64,
+}
+
+// ParseClickHouseType parses the type name, the Nullable and Array have one element type
+// and the Tuple at least one.
+func ParseClickHouseType(name string) (*ClickHouseType, error) {
+	p := &clickHouseTypeParser{s: name}
+	t, err := p.parse()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos != len(p.s) {
+		return nil, errors.Errorf("Invalid ClickHouse type:%s", name)
+	}
+	return t, nil
+}
+
+func (t *ClickHouseType) String() string {
+	if len(t.Elems) == 0 {
+		return t.Name
+	}
+	elems := make([]string, len(t.Elems))
+	for i, elem := range t.Elems {
+		elems[i] = elem.String()
+	}
+	return t.Name + "(" + strings.Join(elems, ", ") + ")"
+}
+
+type clickHouseTypeParser struct {
+	s   string
+	pos int
+}
+
+func (p *clickHouseTypeParser) parse() (*ClickHouseType, error) {
+	p.skipSpaces()
+	start := p.pos
+	for p.pos < len(p.s) && !strings.ContainsRune("(), ", rune(p.s[p.pos])) {
+		p.pos++
+	}
+	t := &ClickHouseType{Name: p.s[start:p.pos]}
+	p.skipSpaces()
+
+	if p.pos < len(p.s) && p.s[p.pos] == '(' {
+		p.pos++
+		for {
+			elem, err := p.parse()
+			if err != nil {
+				return nil, err
+			}
+			t.Elems = append(t.Elems, elem)
+			if p.pos == len(p.s) {
+				return nil, errors.Errorf("Invalid ClickHouse type:%s", p.s)
+			}
+			c := p.s[p.pos]
+			p.pos++
+			if c == ')' {
+				break
+			}
+			if c != ',' {
+				return nil, errors.Errorf("Invalid ClickHouse type:%s", p.s)
+			}
+		}
+		p.skipSpaces()
+	}
+
+	switch t.Name {
+	case "Nullable", "Array":
+		if len(t.Elems) != 1 {
+			return nil, errors.Errorf("ClickHouse type %s must have one element type:%s", t.Name, p.s)
+		}
+	case "Tuple":
+		if len(t.Elems) == 0 {
+			return nil, errors.Errorf("ClickHouse type Tuple must have the element types:%s", p.s)
+		}
+	case "String", "Float32", "Float64", "Bool", "Date", "DateTime":
+		if len(t.Elems) != 0 {
+			return nil, errors.Errorf("Invalid ClickHouse type:%s", p.s)
+		}
+	default:
+		_, isInt := clickHouseIntBits[t.Name]
+		_, isUInt := clickHouseUIntBits[t.Name]
+		if (!isInt && !isUInt) || len(t.Elems) != 0 {
+			return nil, errors.Errorf("Unsupported ClickHouse type:%s", p.s)
+		}
+	}
+	return t, nil
+}
+
+func (p *clickHouseTypeParser) skipSpaces() {
+	for p.pos < len(p.s) && p.s[p.pos] == ' ' {
+		p.pos++
+	}
+}
+
+// ParseClickHouseText parses the field of the ClickHouse TabSeparated format:
+// the NULL is \N, the strings are backslash-escaped, the arrays are [1,2] and the tuples (1,'a'),
+// whose strings and times are quoted.
+func ParseClickHouseText(s string, t *ClickHouseType) (IDataValue, error) {
+	switch t.Name {
+	case "Nullable":
+		if s == `\N` {
+			return MakeNull(), nil
+		}
+		return ParseClickHouseText(s, t.Elems[0])
+	case "Array", "Tuple":
+		p := &clickHouseTextParser{s: s}
+		v, err := p.parseQuoted(t)
+		if err != nil {
+			return nil, err
+		}
+		if p.pos != len(s) {
+			return nil, errors.Errorf("Cannot parse %q as %s: unexpected data after the end", s, t)
+		}
+		return v, nil
+	case "String":
+		return MakeString(unescapeClickHouseString(s)), nil
+	}
+	return parseClickHouseScalar(s, t)
+}
+
+func parseClickHouseScalar(s string, t *ClickHouseType) (IDataValue, error) {
+	switch t.Name {
+	case "String":
+		return MakeString(s), nil
+	case "Float32", "Float64":
+		var f float64
+		switch s {
+		case "inf", "+inf":
+			f = math.Inf(1)
+		case "-inf":
+			f = math.Inf(-1)
+		case "nan":
+			f = math.NaN()
+		default:
+			var err error
+			if f, err = strconv.ParseFloat(s, 64); err != nil {
+				return nil, errors.Errorf("Cannot parse %q as %s", s, t)
+			}
+		}
+		return MakeFloat(f), nil
+	case "Bool":
+		switch s {
+		case "true", "1":
+			return MakeBool(true), nil
+		case "false", "0":
+			return MakeBool(false), nil
+		}
+	case "Date":
+		if v, err := time.ParseInLocation(clickHouseDateLayout, s, time.UTC); err == nil {
+			return MakeTime(v), nil
+		}
+	case "DateTime":
+		if v, err := time.ParseInLocation(TimeLayout, s, time.UTC); err == nil {
+			return MakeTime(v), nil
+		}
+	default:
+		if bits, ok := clickHouseIntBits[t.Name]; ok {
+			v, err := strconv.ParseInt(s, 10, bits)
+			if err != nil {
+				break
+			}
+			if bits == 32 {
+				return MakeInt32(int32(v)), nil
+			}
+			return MakeInt(v), nil
+		}
+		if bits, ok := clickHouseUIntBits[t.Name]; ok {
+			// The UInt64 beyond the Int64 keeps its bits, it is formatted back as unsigned.
+			v, err := strconv.ParseUint(s, 10, bits)
+			if err != nil {
+				break
+			}
+			return MakeInt(int64(v)), nil
+		}
+	}
+	return nil, errors.Errorf("Cannot parse %q as %s", s, t)
+}
+
+// clickHouseTextParser parses the quoted values inside the arrays and tuples.
+type clickHouseTextParser struct {
+	s   string
+	pos int
+}
+
+func (p *clickHouseTextParser) parseQuoted(t *ClickHouseType) (IDataValue, error) {
+	p.skipSpaces()
+	switch t.Name {
+	case "Nullable":
+		if strings.HasPrefix(p.s[p.pos:], "NULL") {
+			p.pos += len("NULL")
+			return MakeNull(), nil
+		}
+		return p.parseQuoted(t.Elems[0])
+	case "Array":
+		var elems []IDataValue
+		if err := p.expect('['); err != nil {
+			return nil, err
+		}
+		p.skipSpaces()
+		if p.peek() == ']' {
+			p.pos++
+			return MakeTuple(elems...), nil
+		}
+		for {
+			elem, err := p.parseQuoted(t.Elems[0])
+			if err != nil {
+				return nil, err
+			}
+			elems = append(elems, elem)
+			p.skipSpaces()
+			if p.peek() == ']' {
+				p.pos++
+				return MakeTuple(elems...), nil
+			}
+			if err := p.expect(','); err != nil {
+				return nil, err
+			}
+		}
+	case "Tuple":
+		elems := make([]IDataValue, len(t.Elems))
+		if err := p.expect('('); err != nil {
+			return nil, err
+		}
+		for i, elemType := range t.Elems {
+			if i > 0 {
+				p.skipSpaces()
+				if err := p.expect(','); err != nil {
+					return nil, err
+				}
+			}
+			elem, err := p.parseQuoted(elemType)
+			if err != nil {
+				return nil, err
+			}
+			elems[i] = elem
+		}
+		p.skipSpaces()
+		if err := p.expect(')'); err != nil {
+			return nil, err
+		}
+		return MakeTuple(elems...), nil
+	case "String", "Date", "DateTime":
+		s, err := p.quotedString()
+		if err != nil {
+			return nil, err
+		}
+		return parseClickHouseScalar(s, t)
+	}
+
+	start := p.pos
+	for p.pos < len(p.s) && !strings.ContainsRune(",)] ", rune(p.s[p.pos])) {
+		p.pos++
+	}
+	return parseClickHouseScalar(p.s[start:p.pos], t)
+}
+
+// quotedString reads the single-quoted string and unescapes it.
+func (p *clickHouseTextParser) quotedString() (string, error) {
+	if err := p.expect('\''); err != nil {
+		return "", err
+	}
+	start := p.pos
+	for p.pos < len(p.s) {
+		switch p.s[p.pos] {
+		case '\\':
+			p.pos += 2
+		case '\'':
+			s := p.s[start:p.pos]
+			p.pos++
+			return unescapeClickHouseString(s), nil
+		default:
+			p.pos++
+		}
+	}
+	return "", errors.Errorf("Cannot parse %q: unterminated quoted string", p.s)
+}
+
+func (p *clickHouseTextParser) expect(c byte) error {
+	if p.peek() != c {
+		return errors.Errorf("Cannot parse %q: expected '%c' at position %d", p.s, c, p.pos)
+	}
+	p.pos++
+	return nil
+}
+
+func (p *clickHouseTextParser) peek() byte {
+	if p.pos < len(p.s) {
+		return p.s[p.pos]
+	}
+	return 0
+}
+
+func (p *clickHouseTextParser) skipSpaces() {
+	for p.pos < len(p.s) && p.s[p.pos] == ' ' {
+		p.pos++
+	}
+}
+
+// unescapeClickHouseString decodes the backslash escapes, \xHH is the byte
+// and the other escaped characters are themselves.
+func unescapeClickHouseString(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i+1 == len(s) {
+			sb.WriteByte(s[i])
+			continue
+		}
+		i++
+		switch s[i] {
+		case 'b':
+			sb.WriteByte('\b')
+		case 'f':
+			sb.WriteByte('\f')
+		case 'n':
+			sb.WriteByte('\n')
+		case 'r':
+			sb.WriteByte('\r')
+		case 't':
+			sb.WriteByte('\t')
+		case '0':
+			sb.WriteByte(0)
+		case 'a':
+			sb.WriteByte('\a')
+		case 'v':
+			sb.WriteByte('\v')
+		case 'x':
+			if i+2 < len(s) {
+				if b, err := strconv.ParseUint(s[i+1:i+3], 16, 8); err == nil {
+					sb.WriteByte(byte(b))
+					i += 2
+					continue
+				}
+			}
+			sb.WriteByte('x')
+		default:
+			sb.WriteByte(s[i])
+		}
+	}
+	return sb.String()
+}
+
+// FormatClickHouseText formats the value as the field of the ClickHouse TabSeparated format,
+// ParseClickHouseText of the result with the same type gives the value back.
+func FormatClickHouseText(v IDataValue, t *ClickHouseType) (string, error) {
+	var sb strings.Builder
+	if err := formatClickHouseText(&sb, v, t, false); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
+
+func formatClickHouseText(sb *strings.Builder, v IDataValue, t *ClickHouseType, quoted bool) error {
+	if t.Name == "Nullable" {
+		if IsNull(v) {
+			if quoted {
+				sb.WriteString("NULL")
+			} else {
+				sb.WriteString(`\N`)
+			}
+			return nil
+		}
+		return formatClickHouseText(sb, v, t.Elems[0], quoted)
+	}
+	if IsNull(v) {
+		return errors.Errorf("Cannot format NULL as %s", t)
+	}
+
+	switch t.Name {
+	case "Array", "Tuple":
+		if v.Type() != TypeTuple {
+			break
+		}
+		elems := AsSlice(v)
+		open, close := byte('['), byte(']')
+		if t.Name == "Tuple" {
+			if len(elems) != len(t.Elems) {
+				return errors.Errorf("Cannot format the tuple of %d elements as %s", len(elems), t)
+			}
+			open, close = '(', ')'
+		}
+		sb.WriteByte(open)
+		for i, elem := range elems {
+			if i > 0 {
+				sb.WriteByte(',')
+			}
+			elemType := t.Elems[0]
+			if t.Name == "Tuple" {
+				elemType = t.Elems[i]
+			}
+			if err := formatClickHouseText(sb, elem, elemType, true); err != nil {
+				return err
+			}
+		}
+		sb.WriteByte(close)
+		return nil
+	case "String":
+		if v.Type() != TypeString {
+			break
+		}
+		if quoted {
+			sb.WriteByte('\'')
+		}
+		escapeClickHouseString(sb, AsString(v))
+		if quoted {
+			sb.WriteByte('\'')
+		}
+		return nil
+	case "Date", "DateTime":
+		if v.Type() != TypeTime {
+			break
+		}
+		layout := TimeLayout
+		if t.Name == "Date" {
+			layout = clickHouseDateLayout
+		}
+		if quoted {
+			sb.WriteByte('\'')
+		}
+		sb.WriteString(AsTime(v).UTC().Format(layout))
+		if quoted {
+			sb.WriteByte('\'')
+		}
+		return nil
+	case "Float32", "Float64":
+		if v.Type() != TypeFloat {
+			break
+		}
+		f := AsFloat(v)
+		switch {
+		case math.IsInf(f, 1):
+			sb.WriteString("inf")
+		case math.IsInf(f, -1):
+			sb.WriteString("-inf")
+		case math.IsNaN(f):
+			sb.WriteString("nan")
+		case t.Name == "Float32":
+			sb.WriteString(strconv.FormatFloat(f, 'g', -1, 32))
+		default:
+			sb.WriteString(strconv.FormatFloat(f, 'g', -1, 64))
+		}
+		return nil
+	case "Bool":
+		if v.Type() != TypeBool {
+			break
+		}
+		sb.WriteString(strconv.FormatBool(AsBool(v)))
+		return nil
+	default:
+		if v.Type() != TypeInt && v.Type() != TypeInt32 {
+			break
+		}
+		if _, ok := clickHouseUIntBits[t.Name]; ok {
+			sb.WriteString(strconv.FormatUint(uint64(AsInt(v)), 10))
+		} else {
+			sb.WriteString(strconv.FormatInt(AsInt(v), 10))
+		}
+		return nil
+	}
+	return errors.Errorf("Cannot format the value:%v as %s", v, t)
+}
+
+// escapeClickHouseString escapes the control characters, the backslash and the single quote.
+func escapeClickHouseString(sb *strings.Builder, s string) {
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '\b':
+			sb.WriteString(`\b`)
+		case '\f':
+			sb.WriteString(`\f`)
+		case '\n':
+			sb.WriteString(`\n`)
+		case '\r':
+			sb.WriteString(`\r`)
+		case '\t':
+			sb.WriteString(`\t`)
+		case 0:
+			sb.WriteString(`\0`)
+		case '\\':
+			sb.WriteString(`\\`)
+		case '\'':
+			sb.WriteString(`\'`)
+		default:
+			sb.WriteByte(c)
+		}
+	}
+}
@@ -0,0 +1,248 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datavalues
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClickHouseText(t *testing.T) {
+	tests := []struct {
+		name   string
+		typ    string
+		text   string
+		expect IDataValue
+	}{
+		{
+			name:   "int64",
+			typ:    "Int64",
+			text:   "-9223372036854775808",
+			expect: MakeInt(math.MinInt64),
+		},
+		{
+			name:   "int32",
+			typ:    "Int32",
+			text:   "-7",
+			expect: MakeInt32(-7),
+		},
+		{
+			name:   "uint64",
+			typ:    "UInt64",
+			text:   "18446744073709551615",
+			expect: MakeInt(-1),
+		},
+		{
+			name:   "float64",
+			typ:    "Float64",
+			text:   "0.1",
+			expect: MakeFloat(0.1),
+		},
+		{
+			name:   "float64-inf",
+			typ:    "Float64",
+			text:   "-inf",
+			expect: MakeFloat(math.Inf(-1)),
+		},
+		{
+			name:   "bool",
+			typ:    "Bool",
+			text:   "true",
+			expect: MakeBool(true),
+		},
+		{
+			name:   "string-escaped",
+			typ:    "String",
+			text:   `a\tb\nc\\d\'e\0`,
+			expect: MakeString("a\tb\nc\\d'e\x00"),
+		},
+		{
+			name:   "date",
+			typ:    "Date",
+			text:   "2020-02-29",
+			expect: MakeTime(time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC)),
+		},
+		{
+			name:   "datetime",
+			typ:    "DateTime",
+			text:   "2020-02-29 12:30:01",
+			expect: MakeTime(time.Date(2020, 2, 29, 12, 30, 1, 0, time.UTC)),
+		},
+		{
+			name:   "nullable-null",
+			typ:    "Nullable(String)",
+			text:   `\N`,
+			expect: MakeNull(),
+		},
+		{
+			name:   "nullable-value",
+			typ:    "Nullable(Int64)",
+			text:   "3",
+			expect: MakeInt(3),
+		},
+		{
+			name:   "array",
+			typ:    "Array(Int64)",
+			text:   "[1,2,3]",
+			expect: MakeTuple(MakeInt(1), MakeInt(2), MakeInt(3)),
+		},
+		{
+			name:   "array-empty",
+			typ:    "Array(String)",
+			text:   "[]",
+			expect: MakeTuple(),
+		},
+		{
+			name:   "array-nullable-string",
+			typ:    "Array(Nullable(String))",
+			text:   `['a,b',NULL,'it\'s]']`,
+			expect: MakeTuple(MakeString("a,b"), MakeNull(), MakeString("it's]")),
+		},
+		{
+			name: "array-of-tuples",
+			typ:  "Array(Tuple(String, Nullable(Int64), Array(DateTime)))",
+			text: `[('x',1,['2020-01-02 03:04:05']),('y\ty',NULL,[])]`,
+			expect: MakeTuple(
+				MakeTuple(MakeString("x"), MakeInt(1), MakeTuple(MakeTime(time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)))),
+				MakeTuple(MakeString("y\ty"), MakeNull(), MakeTuple()),
+			),
+		},
+	}
+
+	for _, test := range tests {
+		typ, err := ParseClickHouseType(test.typ)
+		assert.Nil(t, err, test.name)
+		assert.Equal(t, test.typ, typ.String(), test.name)
+
+		actual, err := ParseClickHouseText(test.text, typ)
+		assert.Nil(t, err, test.name)
+		assert.Equal(t, test.expect, actual, test.name)
+
+		text, err := FormatClickHouseText(actual, typ)
+		assert.Nil(t, err, test.name)
+		assert.Equal(t, test.text, text, test.name)
+	}
+}
+
+func TestClickHouseTextUnescape(t *testing.T) {
+	typ, err := ParseClickHouseType("String")
+	assert.Nil(t, err)
+
+	actual, err := ParseClickHouseText(`\x41\a\v\/\"\q`, typ)
+	assert.Nil(t, err)
+	assert.Equal(t, MakeString("A\a\v/\"q"), actual)
+}
+
+func TestClickHouseTextError(t *testing.T) {
+	tests := []struct {
+		name string
+		typ  string
+		text string
+		err  string
+	}{
+		{
+			name: "null-not-nullable",
+			typ:  "Int64",
+			text: `\N`,
+			err:  `Cannot parse "\\N" as Int64`,
+		},
+		{
+			name: "int8-overflow",
+			typ:  "Int8",
+			text: "128",
+			err:  `Cannot parse "128" as Int8`,
+		},
+		{
+			name: "uint-negative",
+			typ:  "UInt32",
+			text: "-1",
+			err:  `Cannot parse "-1" as UInt32`,
+		},
+		{
+			name: "array-unterminated",
+			typ:  "Array(Int64)",
+			text: "[1,2",
+			err:  `Cannot parse "[1,2": expected ',' at position 4`,
+		},
+		{
+			name: "array-trailing",
+			typ:  "Array(Int64)",
+			text: "[1]x",
+			err:  `Cannot parse "[1]x" as Array(Int64): unexpected data after the end`,
+		},
+		{
+			name: "tuple-arity",
+			typ:  "Tuple(Int64, String)",
+			text: "(1)",
+			err:  `Cannot parse "(1)": expected ',' at position 2`,
+		},
+		{
+			name: "string-unterminated",
+			typ:  "Array(String)",
+			text: `['a\']`,
+			err:  `Cannot parse "['a\\']": unterminated quoted string`,
+		},
+	}
+
+	for _, test := range tests {
+		typ, err := ParseClickHouseType(test.typ)
+		assert.Nil(t, err, test.name)
+		_, err = ParseClickHouseText(test.text, typ)
+		assert.NotNil(t, err, test.name)
+		if err != nil {
+			assert.Equal(t, test.err, err.Error(), test.name)
+		}
+	}
+}
+
+func TestClickHouseTypeError(t *testing.T) {
+	tests := []struct {
+		name string
+		typ  string
+		err  string
+	}{
+		{
+			name: "unknown",
+			typ:  "Decimal(10, 2)",
+			err:  "Unsupported ClickHouse type:Decimal(10, 2)",
+		},
+		{
+			name: "array-arity",
+			typ:  "Array(Int64, String)",
+			err:  "ClickHouse type Array must have one element type:Array(Int64, String)",
+		},
+		{
+			name: "unterminated",
+			typ:  "Nullable(Int64",
+			err:  "Invalid ClickHouse type:Nullable(Int64",
+		},
+	}
+
+	for _, test := range tests {
+		_, err := ParseClickHouseType(test.typ)
+		assert.NotNil(t, err, test.name)
+		if err != nil {
+			assert.Equal(t, test.err, err.Error(), test.name)
+		}
+	}
+}
+
+func TestClickHouseTextFormatError(t *testing.T) {
+	typ, err := ParseClickHouseType("Int64")
+	assert.Nil(t, err)
+	_, err = FormatClickHouseText(MakeNull(), typ)
+	assert.Equal(t, "Cannot format NULL as Int64", err.Error())
+
+	_, err = FormatClickHouseText(MakeString("x"), typ)
+	assert.Equal(t, "Cannot format the value:x as Int64", err.Error())
+
+	typ, err = ParseClickHouseType("Tuple(Int64, Int64)")
+	assert.Nil(t, err)
+	_, err = FormatClickHouseText(MakeTuple(MakeInt(1)), typ)
+	assert.Equal(t, "Cannot format the tuple of 1 elements as Tuple(Int64, Int64)", err.Error())
+}
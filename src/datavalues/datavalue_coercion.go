@@ -0,0 +1,146 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datavalues
+
+import (
+	"math"
+
+	"base/errors"
+	"base/sync2"
+)
+
+// Policy is whether the Bool and the Int convert into each other implicitly,
+// it's consulted by Coerce, the arithmetic and the comparisons.
+type Policy int32
+
+const (
+	// PolicyNever never converts them, mixing them fails as the other types do. It's the default.
+	PolicyNever Policy = iota
+	// PolicyStrict converts only without loss: the Bool to Int as 0 or 1,
+	// the Int to Bool only from 0 and 1.
+	PolicyStrict
+	// PolicyImplicit converts both ways, any Int other than 0 is true.
+	PolicyImplicit
+)
+
+var coercionPolicy = sync2.NewAtomicInt32(int32(PolicyNever))
+
+// SetCoercionPolicy sets the policy of the whole process, such as for a SQL dialect.
+func SetCoercionPolicy(p Policy) {
+	coercionPolicy.Set(int32(p))
+}
+
+func GetCoercionPolicy() Policy {
+	return Policy(coercionPolicy.Get())
+}
+
+// Coerce converts the value to the type implicitly, as a function argument or an INSERT column is.
+// The integers convert into each other in range and to Float, the Bool and the Int as the policy says,
+// the NULL stays NULL. The others fail with TYPE_MISMATCH.
+func Coerce(v IDataValue, typ Type) (IDataValue, error) {
+	if isNullOrZero(v) || v.Type() == typ {
+		return v, nil
+	}
+
+	policy := GetCoercionPolicy()
+	switch {
+	case IsIntegral(v):
+		i := AsInt(v)
+		switch typ {
+		case TypeInt:
+			return MakeInt(i), nil
+		case TypeInt32:
+			if i >= math.MinInt32 && i <= math.MaxInt32 {
+				return MakeInt32(int32(i)), nil
+			}
+			return nil, errors.ErrorWithCode(errors.TYPE_MISMATCH, "Cannot convert %v to Int32 implicitly, out of range", i)
+		case TypeFloat:
+			return MakeFloat(float64(i)), nil
+		case TypeBool:
+			switch {
+			case policy == PolicyImplicit, policy == PolicyStrict && (i == 0 || i == 1):
+				return MakeBool(i != 0), nil
+			case policy == PolicyStrict:
+				return nil, errors.ErrorWithCode(errors.TYPE_MISMATCH, "Cannot convert %v to Bool implicitly, only 0 and 1 are", i)
+			}
+		}
+	case v.Type() == TypeBool && policy != PolicyNever:
+		switch typ {
+		case TypeInt:
+			return MakeInt(boolToInt(v)), nil
+		case TypeInt32:
+			return MakeInt32(int32(boolToInt(v))), nil
+		}
+	}
+	return nil, errors.ErrorWithCode(errors.TYPE_MISMATCH, "Cannot convert %v to %v implicitly", typeName(v), typeNames[typ])
+}
+
+// CommonType is the least type all the types convert to by Coerce, such as of the branches of a CASE:
+// the Int32 and the Int are Int, the integers and the Float are Float, the Bool and the integers are the integer
+// as the policy allows. The NULLs take the type of the others, they are Null if there's none.
+// The types without a common one fail with TYPE_MISMATCH.
+func CommonType(types ...Type) (Type, error) {
+	res := TypeNull
+	for _, typ := range types {
+		if typ == TypeZero || typ == TypeNull {
+			continue
+		}
+		common, ok := commonType(res, typ)
+		if !ok {
+			return 0, errors.ErrorWithCode(errors.TYPE_MISMATCH, "There is no common type of %v and %v", typeNames[res], typeNames[typ])
+		}
+		res = common
+	}
+	return res, nil
+}
+
+func commonType(a, b Type) (Type, bool) {
+	if a == TypeNull || a == b {
+		return b, true
+	}
+	if b == TypeBool {
+		a, b = b, a
+	}
+
+	switch {
+	case isIntegralType(a) && isIntegralType(b):
+		return TypeInt, true
+	case isNumberType(a) && isNumberType(b):
+		return TypeFloat, true
+	case a == TypeBool && isIntegralType(b) && GetCoercionPolicy() != PolicyNever:
+		return b, true
+	}
+	return 0, false
+}
+
+func isIntegralType(t Type) bool {
+	return t == TypeInt || t == TypeInt32
+}
+
+func isNumberType(t Type) bool {
+	return isIntegralType(t) || t == TypeFloat
+}
+
+// coerceBool converts the Bool operand to Int when the other is a number and the policy allows it,
+// ok is false when nothing is converted.
+func coerceBool(a, b IDataValue) (IDataValue, IDataValue, bool) {
+	if GetCoercionPolicy() == PolicyNever {
+		return a, b, false
+	}
+	switch {
+	case a.Type() == TypeBool && IsNumber(b):
+		return MakeInt(boolToInt(a)), b, true
+	case b.Type() == TypeBool && IsNumber(a):
+		return a, MakeInt(boolToInt(b)), true
+	}
+	return a, b, false
+}
+
+func boolToInt(v IDataValue) int64 {
+	if AsBool(v) {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,150 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datavalues
+
+import (
+	"testing"
+
+	"base/errors"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCoerce(t *testing.T) {
+	defer SetCoercionPolicy(GetCoercionPolicy())
+
+	tests := []struct {
+		name   string
+		policy Policy
+		v      IDataValue
+		typ    Type
+		expect IDataValue
+		err    string
+	}{
+		{name: "same", policy: PolicyNever, v: MakeString("a"), typ: TypeString, expect: MakeString("a")},
+		{name: "null", policy: PolicyNever, v: MakeNull(), typ: TypeInt, expect: MakeNull()},
+		{name: "int-to-float", policy: PolicyNever, v: MakeInt32(3), typ: TypeFloat, expect: MakeFloat(3)},
+		{name: "int-to-int32", policy: PolicyNever, v: MakeInt(3), typ: TypeInt32, expect: MakeInt32(3)},
+		{name: "int-to-int32-overflow", policy: PolicyNever, v: MakeInt(1 << 40), typ: TypeInt32, err: "Cannot convert 1099511627776 to Int32 implicitly, out of range (errno 53)"},
+		{name: "never-bool-to-int", policy: PolicyNever, v: MakeBool(true), typ: TypeInt, err: "Cannot convert Bool to Int implicitly (errno 53)"},
+		{name: "never-int-to-bool", policy: PolicyNever, v: MakeInt(1), typ: TypeBool, err: "Cannot convert Int to Bool implicitly (errno 53)"},
+		{name: "strict-bool-to-int", policy: PolicyStrict, v: MakeBool(true), typ: TypeInt32, expect: MakeInt32(1)},
+		{name: "strict-int-to-bool", policy: PolicyStrict, v: MakeInt(0), typ: TypeBool, expect: MakeBool(false)},
+		{name: "strict-int-to-bool-lossy", policy: PolicyStrict, v: MakeInt(2), typ: TypeBool, err: "Cannot convert 2 to Bool implicitly, only 0 and 1 are (errno 53)"},
+		{name: "implicit-int-to-bool", policy: PolicyImplicit, v: MakeInt(-2), typ: TypeBool, expect: MakeBool(true)},
+		{name: "implicit-bool-to-int", policy: PolicyImplicit, v: MakeBool(false), typ: TypeInt, expect: MakeInt(0)},
+		{name: "string", policy: PolicyImplicit, v: MakeString("1"), typ: TypeInt, err: "Cannot convert String to Int implicitly (errno 53)"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			SetCoercionPolicy(test.policy)
+			actual, err := Coerce(test.v, test.typ)
+			if test.err != "" {
+				assert.Equal(t, test.err, err.Error())
+				assert.Equal(t, errors.TYPE_MISMATCH, errors.Code(err))
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, test.expect.Type(), actual.Type())
+				assert.True(t, Equals(test.expect, actual), "%v!=%v", test.expect, actual)
+			}
+		})
+	}
+}
+
+func TestCoercionPolicyOperators(t *testing.T) {
+	defer SetCoercionPolicy(GetCoercionPolicy())
+
+	// Never, the default: Bool and Int don't mix.
+	SetCoercionPolicy(PolicyNever)
+	_, err := Add(MakeBool(true), MakeInt(1))
+	assert.NotNil(t, err)
+	_, err = MakeInt(1).Compare(MakeBool(true))
+	assert.NotNil(t, err)
+	_, err = CompareTyped(MakeBool(true), MakeInt(1))
+	assert.Equal(t, errors.TYPE_MISMATCH, errors.Code(err))
+
+	for _, policy := range []Policy{PolicyStrict, PolicyImplicit} {
+		SetCoercionPolicy(policy)
+
+		sum, err := Add(MakeBool(true), MakeInt(1))
+		assert.Nil(t, err)
+		assert.Equal(t, int64(2), AsInt(sum))
+		diff, err := Sub(MakeInt32(5), MakeBool(true))
+		assert.Nil(t, err)
+		assert.Equal(t, int64(4), AsInt(diff))
+
+		cmp, err := MakeInt(1).Compare(MakeBool(true))
+		assert.Nil(t, err)
+		assert.Equal(t, Equal, cmp)
+		cmp, err = MakeBool(false).Compare(MakeInt32(2))
+		assert.Nil(t, err)
+		assert.Equal(t, LessThan, cmp)
+		cmp, err = CompareTyped(MakeBool(true), MakeFloat(0.5))
+		assert.Nil(t, err)
+		assert.Equal(t, GreaterThan, cmp)
+
+		// Bool with Bool is as before.
+		cmp, err = MakeBool(true).Compare(MakeBool(false))
+		assert.Nil(t, err)
+		assert.Equal(t, GreaterThan, cmp)
+	}
+}
+
+func TestCommonType(t *testing.T) {
+	defer SetCoercionPolicy(GetCoercionPolicy())
+
+	tests := []struct {
+		name   string
+		policy Policy
+		types  []Type
+		expect Type
+		err    string
+	}{
+		{name: "none", expect: TypeNull},
+		{name: "nulls", types: []Type{TypeNull, TypeNull}, expect: TypeNull},
+		{name: "same", types: []Type{TypeString, TypeString}, expect: TypeString},
+		{name: "null-string", types: []Type{TypeNull, TypeString, TypeNull}, expect: TypeString},
+		{name: "int32-int", types: []Type{TypeInt32, TypeInt}, expect: TypeInt},
+		{name: "int32-int32", types: []Type{TypeInt32, TypeNull, TypeInt32}, expect: TypeInt32},
+		{name: "int-float", types: []Type{TypeInt, TypeFloat}, expect: TypeFloat},
+		{name: "int32-int-float", types: []Type{TypeInt32, TypeFloat, TypeInt}, expect: TypeFloat},
+		{name: "never-bool-int", types: []Type{TypeBool, TypeInt}, err: "There is no common type of Bool and Int (errno 53)"},
+		{name: "strict-bool-int32", policy: PolicyStrict, types: []Type{TypeBool, TypeInt32}, expect: TypeInt32},
+		{name: "implicit-int-bool", policy: PolicyImplicit, types: []Type{TypeInt, TypeBool}, expect: TypeInt},
+		{name: "implicit-bool-float", policy: PolicyImplicit, types: []Type{TypeBool, TypeFloat}, err: "There is no common type of Bool and Float (errno 53)"},
+		{name: "string-int", types: []Type{TypeNull, TypeString, TypeInt}, err: "There is no common type of String and Int (errno 53)"},
+		{name: "time-duration", types: []Type{TypeTime, TypeDuration}, err: "There is no common type of Time and Duration (errno 53)"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			SetCoercionPolicy(test.policy)
+			actual, err := CommonType(test.types...)
+			if test.err != "" {
+				assert.Equal(t, test.err, err.Error())
+				assert.Equal(t, errors.TYPE_MISMATCH, errors.Code(err))
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, test.expect, actual)
+		})
+	}
+
+	// The values of the types all convert to the common one.
+	SetCoercionPolicy(PolicyStrict)
+	values := []IDataValue{MakeInt32(1), MakeBool(true), MakeNull(), MakeInt(1 << 40)}
+	var types []Type
+	for _, v := range values {
+		types = append(types, v.Type())
+	}
+	typ, err := CommonType(types...)
+	assert.Nil(t, err)
+	assert.Equal(t, TypeInt, typ)
+	for _, v := range values {
+		_, err := Coerce(v, typ)
+		assert.Nil(t, err)
+	}
+}
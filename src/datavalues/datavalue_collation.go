@@ -0,0 +1,177 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datavalues
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"base/errors"
+)
+
+// Collation is the order of the strings, the values of the other families are compared as without it.
+type Collation int
+
+const (
+	// CollationBinary compares the bytes, the default.
+	CollationBinary Collation = iota
+	// CollationNoCase compares the case folded runes, "a" and "A" are equal.
+	CollationNoCase
+	// CollationUnicode compares as the locale does: the letters first without their accents and case,
+	// then by the accents, then the lower case before the upper one.
+	CollationUnicode
+)
+
+var collationNames = map[string]Collation{
+	"binary":  CollationBinary,
+	"nocase":  CollationNoCase,
+	"unicode": CollationUnicode,
+}
+
+// ParseCollation takes the collation by its name in any case.
+func ParseCollation(name string) (Collation, error) {
+	c, ok := collationNames[strings.ToLower(name)]
+	if !ok {
+		return 0, errors.ErrorWithCode(errors.NOT_IMPLEMENTED, "Unsupported collation:%v", name)
+	}
+	return c, nil
+}
+
+func (c Collation) String() string {
+	for name, x := range collationNames {
+		if x == c {
+			return name
+		}
+	}
+	return "unknown"
+}
+
+// CompareWithCollation compares the strings by the collation, the others as a.Compare(b).
+func CompareWithCollation(a, b IDataValue, c Collation) (Comparison, error) {
+	if c == CollationBinary || a.Family() != FamilyString || b.Family() != FamilyString {
+		return a.Compare(b)
+	}
+
+	x, y := AsString(a), AsString(b)
+	switch c {
+	case CollationNoCase:
+		return compareRunes(x, y, foldCase), nil
+	case CollationUnicode:
+		if cmp := compareRunes(x, y, primaryKey); cmp != Equal {
+			return cmp, nil
+		}
+		if cmp := compareRunes(x, y, secondaryKey); cmp != Equal {
+			return cmp, nil
+		}
+		if cmp := compareRunes(x, y, tertiaryKey); cmp != Equal {
+			return cmp, nil
+		}
+	}
+	return a.Compare(b)
+}
+
+// SortValues sorts the values in place by CompareWithCollation, the binary collation if none is given.
+// The values failing to compare keep their order.
+func SortValues(values []IDataValue, collation ...Collation) {
+	c := CollationBinary
+	if len(collation) > 0 {
+		c = collation[0]
+	}
+	sort.SliceStable(values, func(i, j int) bool {
+		cmp, err := CompareWithCollation(values[i], values[j], c)
+		return err == nil && cmp == LessThan
+	})
+}
+
+// compareRunes compares the strings rune by rune by the weights of the key.
+func compareRunes(x, y string, key func(rune) rune) Comparison {
+	a, b := []rune(x), []rune(y)
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if cmp := compareInt(int64(key(a[i])), int64(key(b[i]))); cmp != Equal {
+			return cmp
+		}
+	}
+	return compareInt(int64(len(a)), int64(len(b)))
+}
+
+func foldCase(r rune) rune {
+	return unicode.ToLower(unicode.ToUpper(r))
+}
+
+// primaryKey is the letter without its accent and case.
+func primaryKey(r rune) rune {
+	if base, ok := latinBase[r]; ok {
+		r = base
+	}
+	return foldCase(r)
+}
+
+// secondaryKey tells the accents apart, the letters without one first.
+func secondaryKey(r rune) rune {
+	if _, ok := latinBase[foldCase(r)]; ok {
+		return foldCase(r)
+	}
+	return 0
+}
+
+// tertiaryKey puts the lower case before the upper one.
+func tertiaryKey(r rune) rune {
+	if unicode.IsUpper(r) {
+		return 1
+	}
+	return 0
+}
+
+// latinBase is the letters of the Latin-1 supplement and the Latin Extended-A with their base letters.
+var latinBase = func() map[rune]rune {
+	groups := map[rune]string{
+		'a': "àáâãäåāăą",
+		'A': "ÀÁÂÃÄÅĀĂĄ",
+		'c': "çćĉċč",
+		'C': "ÇĆĈĊČ",
+		'd': "ďđ",
+		'D': "ĎĐ",
+		'e': "èéêëēĕėęě",
+		'E': "ÈÉÊËĒĔĖĘĚ",
+		'g': "ĝğġģ",
+		'G': "ĜĞĠĢ",
+		'h': "ĥħ",
+		'H': "ĤĦ",
+		'i': "ìíîïĩīĭįı",
+		'I': "ÌÍÎÏĨĪĬĮİ",
+		'j': "ĵ",
+		'J': "Ĵ",
+		'k': "ķ",
+		'K': "Ķ",
+		'l': "ĺļľŀł",
+		'L': "ĹĻĽĿŁ",
+		'n': "ñńņňŉ",
+		'N': "ÑŃŅŇ",
+		'o': "òóôõöøōŏő",
+		'O': "ÒÓÔÕÖØŌŎŐ",
+		'r': "ŕŗř",
+		'R': "ŔŖŘ",
+		's': "śŝşš",
+		'S': "ŚŜŞŠ",
+		't': "ţťŧ",
+		'T': "ŢŤŦ",
+		'u': "ùúûüũūŭůűų",
+		'U': "ÙÚÛÜŨŪŬŮŰŲ",
+		'w': "ŵ",
+		'W': "Ŵ",
+		'y': "ýÿŷ",
+		'Y': "ÝŶŸ",
+		'z': "źżž",
+		'Z': "ŹŻŽ",
+	}
+	m := make(map[rune]rune)
+	for base, letters := range groups {
+		for _, r := range letters {
+			m[r] = base
+		}
+	}
+	return m
+}()
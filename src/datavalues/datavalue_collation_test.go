@@ -0,0 +1,83 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datavalues
+
+import (
+	"testing"
+
+	"base/errors"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompareWithCollation(t *testing.T) {
+	tests := []struct {
+		name      string
+		a         IDataValue
+		b         IDataValue
+		collation Collation
+		expect    Comparison
+	}{
+		{name: "binary-case", a: MakeString("B"), b: MakeString("a"), collation: CollationBinary, expect: LessThan},
+		{name: "nocase", a: MakeString("B"), b: MakeString("a"), collation: CollationNoCase, expect: GreaterThan},
+		{name: "nocase-equal", a: MakeString("Abc"), b: MakeString("aBC"), collation: CollationNoCase, expect: Equal},
+		{name: "nocase-prefix", a: MakeString("AB"), b: MakeString("abc"), collation: CollationNoCase, expect: LessThan},
+		{name: "nocase-accent", a: MakeString("É"), b: MakeString("é"), collation: CollationNoCase, expect: Equal},
+		{name: "unicode-accent", a: MakeString("éclair"), b: MakeString("ezra"), collation: CollationUnicode, expect: LessThan},
+		{name: "unicode-accent-after", a: MakeString("é"), b: MakeString("e"), collation: CollationUnicode, expect: GreaterThan},
+		{name: "unicode-lower-first", a: MakeString("a"), b: MakeString("A"), collation: CollationUnicode, expect: LessThan},
+		{name: "unicode-case-after-letters", a: MakeString("Ab"), b: MakeString("aC"), collation: CollationUnicode, expect: LessThan},
+		{name: "unicode-equal", a: MakeString("Straße"), b: MakeString("Straße"), collation: CollationUnicode, expect: Equal},
+		{name: "int", a: MakeInt(2), b: MakeInt(10), collation: CollationNoCase, expect: LessThan},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := CompareWithCollation(test.a, test.b, test.collation)
+			assert.Nil(t, err)
+			assert.Equal(t, test.expect, actual)
+		})
+	}
+
+	_, err := CompareWithCollation(MakeString("a"), MakeInt(1), CollationNoCase)
+	assert.NotNil(t, err)
+}
+
+func TestSortValues(t *testing.T) {
+	values := func() []IDataValue {
+		return []IDataValue{MakeString("b"), MakeString("B"), MakeString("a"), MakeString("é"), MakeString("A"), MakeString("f")}
+	}
+	strs := func(values []IDataValue) []string {
+		var res []string
+		for _, v := range values {
+			res = append(res, AsString(v))
+		}
+		return res
+	}
+
+	binary := values()
+	SortValues(binary)
+	assert.Equal(t, []string{"A", "B", "a", "b", "f", "é"}, strs(binary))
+
+	nocase := values()
+	SortValues(nocase, CollationNoCase)
+	assert.Equal(t, []string{"a", "A", "b", "B", "f", "é"}, strs(nocase))
+
+	unicode := values()
+	SortValues(unicode, CollationUnicode)
+	assert.Equal(t, []string{"a", "A", "b", "B", "é", "f"}, strs(unicode))
+}
+
+func TestParseCollation(t *testing.T) {
+	for name, expect := range map[string]Collation{"BINARY": CollationBinary, "NoCase": CollationNoCase, "unicode": CollationUnicode} {
+		actual, err := ParseCollation(name)
+		assert.Nil(t, err)
+		assert.Equal(t, expect, actual)
+	}
+	assert.Equal(t, "nocase", CollationNoCase.String())
+
+	_, err := ParseCollation("utf8_bin")
+	assert.Equal(t, errors.NOT_IMPLEMENTED, errors.Code(err))
+}
@@ -0,0 +1,281 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datavalues
+
+import (
+	"base/errors"
+)
+
+// Column is the values of one type laid out by their type rather than a value per row:
+// the Int and Float in contiguous slices, the String in one byte buffer with the offsets,
+// the NULLs in a bitmap apart. It's built by a ColumnBuilder and not changed after.
+type Column interface {
+	Type() Type
+	Len() int
+	NullCount() int
+	IsNull(i int) bool
+	// Value makes the value at i, the NULL for the null one.
+	Value(i int) IDataValue
+}
+
+// nullBitmap has a bit per row set for the NULLs, least significant first.
+// The bits are nil until the first NULL, the column without a NULL doesn't pay for it.
+type nullBitmap struct {
+	bits  []uint64
+	count int
+}
+
+func (b *nullBitmap) set(i int) {
+	for len(b.bits) <= i/64 {
+		b.bits = append(b.bits, 0)
+	}
+	b.bits[i/64] |= 1 << uint(i%64)
+	b.count++
+}
+
+// NullCount returns the number of the NULLs.
+func (b *nullBitmap) NullCount() int {
+	return b.count
+}
+
+// IsNull is whether the row i is NULL.
+func (b *nullBitmap) IsNull(i int) bool {
+	return i/64 < len(b.bits) && b.bits[i/64]&(1<<uint(i%64)) != 0
+}
+
+// IntColumn is the column of the Int or the Int32, both kept as int64.
+type IntColumn struct {
+	nullBitmap
+	typ  Type
+	ints []int64
+}
+
+func (c *IntColumn) Type() Type {
+	return c.typ
+}
+
+func (c *IntColumn) Len() int {
+	return len(c.ints)
+}
+
+// Ints returns the values, the ones of the NULLs are 0.
+func (c *IntColumn) Ints() []int64 {
+	return c.ints
+}
+
+func (c *IntColumn) Value(i int) IDataValue {
+	switch {
+	case c.IsNull(i):
+		return MakeNull()
+	case c.typ == TypeInt32:
+		return MakeInt32(int32(c.ints[i]))
+	}
+	return MakeInt(c.ints[i])
+}
+
+// FloatColumn is the column of the Float.
+type FloatColumn struct {
+	nullBitmap
+	floats []float64
+}
+
+func (c *FloatColumn) Type() Type {
+	return TypeFloat
+}
+
+func (c *FloatColumn) Len() int {
+	return len(c.floats)
+}
+
+// Floats returns the values, the ones of the NULLs are 0.
+func (c *FloatColumn) Floats() []float64 {
+	return c.floats
+}
+
+func (c *FloatColumn) Value(i int) IDataValue {
+	if c.IsNull(i) {
+		return MakeNull()
+	}
+	return MakeFloat(c.floats[i])
+}
+
+// BoolColumn is the column of the Bool.
+type BoolColumn struct {
+	nullBitmap
+	bools []bool
+}
+
+func (c *BoolColumn) Type() Type {
+	return TypeBool
+}
+
+func (c *BoolColumn) Len() int {
+	return len(c.bools)
+}
+
+// Bools returns the values, the ones of the NULLs are false.
+func (c *BoolColumn) Bools() []bool {
+	return c.bools
+}
+
+func (c *BoolColumn) Value(i int) IDataValue {
+	if c.IsNull(i) {
+		return MakeNull()
+	}
+	return MakeBool(c.bools[i])
+}
+
+// StringColumn is the column of the String, the strings are in one buffer one after the other.
+type StringColumn struct {
+	nullBitmap
+	data []byte
+	// offsets are the Len+1 offsets of the strings into data, the NULL is the empty string.
+	offsets []int
+}
+
+func (c *StringColumn) Type() Type {
+	return TypeString
+}
+
+func (c *StringColumn) Len() int {
+	return len(c.offsets) - 1
+}
+
+// Bytes returns the bytes of the string at i without a copy, they must not be changed.
+func (c *StringColumn) Bytes(i int) []byte {
+	return c.data[c.offsets[i]:c.offsets[i+1]]
+}
+
+func (c *StringColumn) Value(i int) IDataValue {
+	if c.IsNull(i) {
+		return MakeNull()
+	}
+	return MakeString(string(c.Bytes(i)))
+}
+
+// ValuesColumn is the column of the types without a flat layout, such as the Tuple and the Object,
+// the values are kept as they are.
+type ValuesColumn struct {
+	nullBitmap
+	typ    Type
+	values []IDataValue
+}
+
+func (c *ValuesColumn) Type() Type {
+	return c.typ
+}
+
+func (c *ValuesColumn) Len() int {
+	return len(c.values)
+}
+
+func (c *ValuesColumn) Value(i int) IDataValue {
+	if c.IsNull(i) {
+		return MakeNull()
+	}
+	return c.values[i]
+}
+
+// ColumnBuilder accumulates the values of one type into a Column.
+// The Int column takes the Int32 too, the NULL and the uninitialized value go to the null bitmap,
+// the values of another type fail with TYPE_MISMATCH.
+// The builder is not safe for the concurrent use.
+type ColumnBuilder struct {
+	typ     Type
+	n       int
+	nulls   nullBitmap
+	ints    []int64
+	floats  []float64
+	bools   []bool
+	data    []byte
+	offsets []int
+	values  []IDataValue
+}
+
+// NewColumnBuilder creates the builder of the column of the type.
+func NewColumnBuilder(t Type) *ColumnBuilder {
+	b := &ColumnBuilder{typ: t}
+	b.reset()
+	return b
+}
+
+func (b *ColumnBuilder) reset() {
+	b.n = 0
+	b.nulls = nullBitmap{}
+	b.ints, b.floats, b.bools, b.data, b.values = nil, nil, nil, nil, nil
+	b.offsets = nil
+	if b.typ == TypeString {
+		b.offsets = []int{0}
+	}
+}
+
+// Type returns the type of the column.
+func (b *ColumnBuilder) Type() Type {
+	return b.typ
+}
+
+// Len returns the number of the values appended.
+func (b *ColumnBuilder) Len() int {
+	return b.n
+}
+
+// Append adds the value at the end of the column.
+func (b *ColumnBuilder) Append(v IDataValue) error {
+	null := isNullOrZero(v)
+	if !null && v.Type() != b.typ && !(b.typ == TypeInt && v.Type() == TypeInt32) {
+		return errors.ErrorWithCode(errors.TYPE_MISMATCH, "Cannot append %v to the %v column at %d", typeName(v), typeNames[b.typ], b.n)
+	}
+	if null {
+		b.nulls.set(b.n)
+	}
+
+	switch b.typ {
+	case TypeNull:
+	case TypeInt, TypeInt32:
+		var x int64
+		if !null {
+			x = AsInt(v)
+		}
+		b.ints = append(b.ints, x)
+	case TypeFloat:
+		var x float64
+		if !null {
+			x = AsFloat(v)
+		}
+		b.floats = append(b.floats, x)
+	case TypeBool:
+		b.bools = append(b.bools, !null && AsBool(v))
+	case TypeString:
+		if !null {
+			b.data = append(b.data, AsString(v)...)
+		}
+		b.offsets = append(b.offsets, len(b.data))
+	default:
+		b.values = append(b.values, v)
+	}
+	b.n++
+	return nil
+}
+
+// Build returns the column of the values appended, the builder is empty after and can build the next one.
+func (b *ColumnBuilder) Build() Column {
+	var c Column
+	switch b.typ {
+	case TypeInt, TypeInt32:
+		c = &IntColumn{nullBitmap: b.nulls, typ: b.typ, ints: b.ints}
+	case TypeFloat:
+		c = &FloatColumn{nullBitmap: b.nulls, floats: b.floats}
+	case TypeBool:
+		c = &BoolColumn{nullBitmap: b.nulls, bools: b.bools}
+	case TypeString:
+		c = &StringColumn{nullBitmap: b.nulls, data: b.data, offsets: b.offsets}
+	case TypeNull:
+		c = &ValuesColumn{nullBitmap: b.nulls, typ: b.typ, values: make([]IDataValue, b.n)}
+	default:
+		c = &ValuesColumn{nullBitmap: b.nulls, typ: b.typ, values: b.values}
+	}
+	b.reset()
+	return c
+}
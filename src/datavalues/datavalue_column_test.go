@@ -0,0 +1,95 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datavalues
+
+import (
+	"testing"
+
+	"base/errors"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestColumnBuilder(t *testing.T) {
+	tests := []struct {
+		name   string
+		typ    Type
+		vals   []IDataValue
+		nulls  int
+		expect []IDataValue
+	}{
+		{name: "int", typ: TypeInt, vals: []IDataValue{MakeInt(-1), MakeNull(), MakeInt32(3), nil}, nulls: 2,
+			expect: []IDataValue{MakeInt(-1), MakeNull(), MakeInt(3), MakeNull()}},
+		{name: "int32", typ: TypeInt32, vals: []IDataValue{MakeInt32(1), MakeInt32(-2)}},
+		{name: "float", typ: TypeFloat, vals: []IDataValue{MakeFloat(1.5), MakeNull(), MakeFloat(-2)}, nulls: 1},
+		{name: "bool", typ: TypeBool, vals: []IDataValue{MakeBool(true), MakeBool(false), MakeNull()}, nulls: 1},
+		{name: "string", typ: TypeString, vals: []IDataValue{MakeString("ab"), MakeNull(), MakeString(""), MakeString("c")}, nulls: 1},
+		{name: "null", typ: TypeNull, vals: []IDataValue{MakeNull(), MakeNull()}, nulls: 2},
+		{name: "tuple", typ: TypeTuple, vals: []IDataValue{MakeTuple(MakeInt(1)), MakeNull(), MakeTuple()}, nulls: 1},
+		{name: "empty", typ: TypeString},
+	}
+
+	for _, test := range tests {
+		b := NewColumnBuilder(test.typ)
+		for _, v := range test.vals {
+			assert.Nil(t, b.Append(v), test.name)
+		}
+		assert.Equal(t, len(test.vals), b.Len(), test.name)
+
+		c := b.Build()
+		assert.Equal(t, 0, b.Len(), test.name)
+		assert.Equal(t, test.typ, c.Type(), test.name)
+		assert.Equal(t, len(test.vals), c.Len(), test.name)
+		assert.Equal(t, test.nulls, c.NullCount(), test.name)
+
+		expect := test.expect
+		if expect == nil {
+			expect = test.vals
+		}
+		for i := range expect {
+			assert.Equal(t, IsNull(expect[i]), c.IsNull(i), test.name)
+			assert.Equal(t, expect[i], c.Value(i), test.name)
+		}
+	}
+}
+
+func TestColumnBuilderLayout(t *testing.T) {
+	b := NewColumnBuilder(TypeInt)
+	for i := 0; i < 100; i++ {
+		assert.Nil(t, b.Append(MakeInt(int64(i))))
+	}
+	c := b.Build().(*IntColumn)
+	assert.Nil(t, c.bits)
+	assert.Equal(t, int64(99), c.Ints()[99])
+
+	// The builder is reused for the next column.
+	assert.Nil(t, b.Append(MakeInt(7)))
+	assert.Nil(t, b.Append(MakeNull()))
+	c = b.Build().(*IntColumn)
+	assert.Equal(t, []int64{7, 0}, c.Ints())
+	assert.Equal(t, 1, c.NullCount())
+
+	s := NewColumnBuilder(TypeString)
+	for _, v := range []string{"a", "bc", "", "d"} {
+		assert.Nil(t, s.Append(MakeString(v)))
+	}
+	sc := s.Build().(*StringColumn)
+	assert.Equal(t, "abcd", string(sc.data))
+	assert.Equal(t, []int{0, 1, 3, 3, 4}, sc.offsets)
+	assert.Equal(t, "bc", string(sc.Bytes(1)))
+}
+
+func TestColumnBuilderMismatch(t *testing.T) {
+	b := NewColumnBuilder(TypeInt32)
+	assert.Nil(t, b.Append(MakeInt32(1)))
+	err := b.Append(MakeInt(2))
+	assert.NotNil(t, err)
+	assert.Equal(t, errors.TYPE_MISMATCH, errors.Code(err))
+	assert.Equal(t, 1, b.Len())
+
+	b = NewColumnBuilder(TypeFloat)
+	assert.NotNil(t, b.Append(MakeString("x")))
+	assert.Equal(t, 0, b.Len())
+}
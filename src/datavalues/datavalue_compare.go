@@ -0,0 +1,131 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datavalues
+
+import (
+	"math"
+	"strings"
+
+	"base/docs"
+	"base/errors"
+)
+
+// CompareTyped is the strict comparison for the ORDER BY and the typed planners:
+// the values of the different families fail with TYPE_MISMATCH rather than getting an arbitrary order,
+// only the Int and the Float are compared across, exactly, and the Bool with them as the coercion policy says.
+// The NULLs sort first as in ValueNull.Compare, then the errors as in ValueError.Compare,
+// the tuples are compared element by element and the shorter first.
+func CompareTyped(a, b IDataValue) (Comparison, error) {
+	switch {
+	case isNullOrZero(a) && isNullOrZero(b):
+		return Equal, nil
+	case isNullOrZero(a):
+		return LessThan, nil
+	case isNullOrZero(b):
+		return GreaterThan, nil
+	case IsError(a):
+		return a.Compare(b)
+	case IsError(b):
+		cmp, err := b.Compare(a)
+		return -cmp, err
+	}
+
+	a, b, _ = coerceBool(a, b)
+	switch {
+	case IsNumber(a) && IsNumber(b):
+		switch {
+		case IsIntegral(a) && IsIntegral(b):
+			return compareInt(AsInt(a), AsInt(b)), nil
+		case IsIntegral(a):
+			return compareIntFloat(AsInt(a), AsFloat(b)), nil
+		case IsIntegral(b):
+			return -compareIntFloat(AsInt(b), AsFloat(a)), nil
+		}
+		return a.Compare(b)
+	case a.Family() == FamilyTuple && b.Family() == FamilyTuple:
+		x, y := AsSlice(a), AsSlice(b)
+		for i := 0; i < len(x) && i < len(y); i++ {
+			cmp, err := CompareTyped(x[i], y[i])
+			if err != nil {
+				return 0, err
+			}
+			if cmp != Equal {
+				return cmp, nil
+			}
+		}
+		return compareInt(int64(len(x)), int64(len(y))), nil
+	case a.Family() == b.Family():
+		return a.Compare(b)
+	}
+	return 0, errors.ErrorWithCode(errors.TYPE_MISMATCH, "Cannot compare %v with %v", typeName(a), typeName(b))
+}
+
+// CompareRows compares the key columns of the rows in the order of keyIdx by Compare, it's -1, 0 or 1.
+// The NULLs equal each other and sort before the other values if nullsFirst, else after,
+// the sort and the merge-join of its outputs must place them the same.
+// The values failing to compare are equal, as the sort takes them.
+func CompareRows(a, b []IDataValue, keyIdx []int, nullsFirst bool) int {
+	for _, k := range keyIdx {
+		x, y := a[k], b[k]
+		xnull, ynull := isNullOrZero(x), isNullOrZero(y)
+		switch {
+		case xnull && ynull:
+			continue
+		case xnull != ynull:
+			if xnull == nullsFirst {
+				return int(LessThan)
+			}
+			return int(GreaterThan)
+		}
+
+		cmp, err := x.Compare(y)
+		if err != nil || cmp == Equal {
+			continue
+		}
+		return int(cmp)
+	}
+	return int(Equal)
+}
+
+func compareInt(a, b int64) Comparison {
+	switch {
+	case a > b:
+		return GreaterThan
+	case a < b:
+		return LessThan
+	}
+	return Equal
+}
+
+// compareIntFloat compares without converting the int to float64, which loses the digits beyond 2^53.
+// The NaN is neither greater nor less, as in ValueFloat.Compare.
+func compareIntFloat(i int64, f float64) Comparison {
+	switch {
+	case math.IsNaN(f):
+		return Equal
+	case f >= math.MaxInt64:
+		return LessThan
+	case f < math.MinInt64:
+		return GreaterThan
+	}
+	t := math.Trunc(f)
+	if cmp := compareInt(i, int64(t)); cmp != Equal {
+		return cmp
+	}
+	switch {
+	case f > t:
+		return LessThan
+	case f < t:
+		return GreaterThan
+	}
+	return Equal
+}
+
+// typeName renders the documentation of the value type, such as Int.
+func typeName(v IDataValue) string {
+	var sb strings.Builder
+	docs.RenderDocumentation(v.Document(), &sb)
+	return sb.String()
+}
@@ -0,0 +1,86 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datavalues
+
+import (
+	"math"
+	"testing"
+
+	"base/errors"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompareTyped(t *testing.T) {
+	tests := []struct {
+		name   string
+		a      IDataValue
+		b      IDataValue
+		expect Comparison
+		err    string
+	}{
+		{name: "int", a: MakeInt(1), b: MakeInt32(2), expect: LessThan},
+		{name: "int-float", a: MakeInt(2), b: MakeFloat(1.5), expect: GreaterThan},
+		{name: "float-int", a: MakeFloat(1.5), b: MakeInt(2), expect: LessThan},
+		{name: "int-float-equal", a: MakeInt32(3), b: MakeFloat(3), expect: Equal},
+		{name: "int-float-fraction", a: MakeInt(-3), b: MakeFloat(-3.5), expect: GreaterThan},
+		{name: "int-float-exact", a: MakeInt(1<<53 + 1), b: MakeFloat(1 << 53), expect: GreaterThan},
+		{name: "int-float-beyond", a: MakeInt(math.MaxInt64), b: MakeFloat(math.MaxInt64), expect: LessThan},
+		{name: "float", a: MakeFloat(1), b: MakeFloat(0.5), expect: GreaterThan},
+		{name: "string", a: MakeString("a"), b: MakeString("b"), expect: LessThan},
+		{name: "null-first", a: MakeNull(), b: MakeInt(1), expect: LessThan},
+		{name: "null-last", a: MakeString("a"), b: MakeNull(), expect: GreaterThan},
+		{name: "null-null", a: MakeNull(), b: MakeNull(), expect: Equal},
+		{name: "tuple", a: MakeTuple(MakeInt(1), MakeFloat(2.5)), b: MakeTuple(MakeFloat(1), MakeInt(2)), expect: GreaterThan},
+		{name: "tuple-shorter", a: MakeTuple(MakeInt(1)), b: MakeTuple(MakeInt(1), MakeInt(2)), expect: LessThan},
+		{name: "object-int", a: MakeObject(map[string]IDataValue{"a": MakeInt(1)}), b: MakeInt(1), err: "Cannot compare Object with Int (errno 53)"},
+		{name: "string-int", a: MakeString("1"), b: MakeInt(1), err: "Cannot compare String with Int (errno 53)"},
+		{name: "tuple-element", a: MakeTuple(MakeInt(1)), b: MakeTuple(MakeString("1")), err: "Cannot compare Int with String (errno 53)"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := CompareTyped(test.a, test.b)
+			if test.err != "" {
+				assert.NotNil(t, err)
+				assert.Equal(t, errors.TYPE_MISMATCH, errors.Code(err))
+				assert.Equal(t, test.err, err.Error())
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, test.expect, actual)
+		})
+	}
+}
+
+func TestCompareRows(t *testing.T) {
+	row := func(values ...IDataValue) []IDataValue { return values }
+	tests := []struct {
+		name       string
+		a, b       []IDataValue
+		keys       []int
+		nullsFirst bool
+		expect     int
+	}{
+		{name: "first-key", a: row(MakeInt(1), MakeString("b")), b: row(MakeInt(2), MakeString("a")), keys: []int{0, 1}, expect: -1},
+		{name: "second-key", a: row(MakeInt(1), MakeString("b")), b: row(MakeInt(1), MakeString("a")), keys: []int{0, 1}, expect: 1},
+		{name: "key-order", a: row(MakeInt(1), MakeString("b")), b: row(MakeInt(2), MakeString("a")), keys: []int{1, 0}, expect: 1},
+		{name: "equal", a: row(MakeInt(1), MakeString("x")), b: row(MakeInt(1), MakeString("y")), keys: []int{0}, expect: 0},
+		{name: "no-keys", a: row(MakeInt(1)), b: row(MakeInt(2)), expect: 0},
+		{name: "nulls-first", a: row(MakeNull()), b: row(MakeInt(1)), keys: []int{0}, nullsFirst: true, expect: -1},
+		{name: "nulls-last", a: row(MakeNull()), b: row(MakeInt(1)), keys: []int{0}, expect: 1},
+		{name: "nulls-last-right", a: row(MakeInt(1)), b: row(MakeNull()), keys: []int{0}, expect: -1},
+		{name: "nulls-equal", a: row(MakeNull(), MakeInt(1)), b: row(MakeNull(), MakeInt(2)), keys: []int{0, 1}, expect: -1},
+		{name: "zero-is-null", a: row(nil), b: row(MakeInt(1)), keys: []int{0}, nullsFirst: true, expect: -1},
+		{name: "mismatch-equal", a: row(MakeString("1"), MakeInt(1)), b: row(MakeInt(1), MakeInt(2)), keys: []int{0, 1}, expect: -1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expect, CompareRows(test.a, test.b, test.keys, test.nullsFirst))
+			assert.Equal(t, -test.expect, CompareRows(test.b, test.a, test.keys, test.nullsFirst))
+		})
+	}
+}
@@ -0,0 +1,210 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datavalues
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unsafe"
+
+	"base/docs"
+	"base/errors"
+)
+
+// ValueDuration is the elapsed time in nanoseconds, as the time.Duration.
+type ValueDuration time.Duration
+
+func MakeDuration(v time.Duration) IDataValue {
+	r := ValueDuration(v)
+	return &r
+}
+
+func ZeroDuration() IDataValue {
+	return MakeDuration(0)
+}
+
+func (v *ValueDuration) Size() uintptr {
+	return unsafe.Sizeof(*v)
+}
+
+// String shows the duration as the time.Duration, such as 1h30m0s.
+func (v *ValueDuration) String() string {
+	return time.Duration(*v).String()
+}
+
+func (v *ValueDuration) Type() Type {
+	return TypeDuration
+}
+
+func (v *ValueDuration) Family() Family {
+	return FamilyDuration
+}
+
+func (v *ValueDuration) AsDuration() time.Duration {
+	return time.Duration(*v)
+}
+
+func (v *ValueDuration) Compare(other IDataValue) (Comparison, error) {
+	if other.Type() != TypeDuration {
+		return 0, errors.Errorf("type mismatch between values, got:%v", other.Type())
+	}
+
+	a, b := time.Duration(*v), AsDuration(other)
+	switch {
+	case a > b:
+		return 1, nil
+	case a < b:
+		return -1, nil
+	default:
+		return 0, nil
+	}
+}
+
+func (v *ValueDuration) Document() docs.Documentation {
+	return docs.Text("Duration")
+}
+
+func AsDuration(v IDataValue) time.Duration {
+	if d, ok := v.(*ValueDuration); ok {
+		return time.Duration(*d)
+	}
+	return 0
+}
+
+// castDuration parses the string as the Go duration (1h30m), then as the ISO 8601 duration (PT1H30M),
+// then as the number of the seconds.
+func castDuration(s string) (IDataValue, error) {
+	s = strings.TrimSpace(s)
+	if d, err := time.ParseDuration(s); err == nil {
+		return MakeDuration(d), nil
+	}
+	if d, ok := parseISODuration(s); ok {
+		return MakeDuration(d), nil
+	}
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return castDurationSeconds(i)
+	}
+	if f, err := strconv.ParseFloat(s, 64); err == nil {
+		return castDurationFloat(f)
+	}
+	return nil, errors.ErrorWithCode(errors.CANNOT_PARSE_TEXT, "Cannot parse %q as Duration, "+
+		"expected the Go duration like 1h30m, the ISO 8601 duration like PT1H30M or the number of the seconds", s)
+}
+
+// castDurationSeconds is the duration of the integral seconds.
+func castDurationSeconds(i int64) (IDataValue, error) {
+	if i > math.MaxInt64/int64(time.Second) || i < math.MinInt64/int64(time.Second) {
+		return nil, errors.ErrorWithCode(errors.CANNOT_PARSE_TEXT, "Cannot cast %v seconds to Duration, out of range", i)
+	}
+	return MakeDuration(time.Duration(i) * time.Second), nil
+}
+
+// castDurationFloat is the duration of the seconds with the fraction, to the nanosecond.
+func castDurationFloat(f float64) (IDataValue, error) {
+	ns := math.Round(f * float64(time.Second))
+	if math.IsNaN(ns) || ns >= math.MaxInt64 || ns < math.MinInt64 {
+		return nil, errors.ErrorWithCode(errors.CANNOT_PARSE_TEXT, "Cannot cast %v seconds to Duration, out of range", f)
+	}
+	return MakeDuration(time.Duration(ns)), nil
+}
+
+// isoDurationUnits are the units of the ISO 8601 duration of the fixed length, the date ones before the T.
+// The years and the months have no fixed length, they are rejected as ambiguous.
+var isoDurationUnits = []struct {
+	designator byte
+	time       bool
+	unit       time.Duration
+}{
+	{designator: 'W', unit: 7 * 24 * time.Hour},
+	{designator: 'D', unit: 24 * time.Hour},
+	{designator: 'H', time: true, unit: time.Hour},
+	{designator: 'M', time: true, unit: time.Minute},
+	{designator: 'S', time: true, unit: time.Second},
+}
+
+// parseISODuration parses the ISO 8601 duration of the weeks, the days, the hours, the minutes and the seconds,
+// like P1DT12H or -PT1.5S. The units are in order, only the last one has the fraction of . or ,.
+func parseISODuration(s string) (time.Duration, bool) {
+	negative := false
+	if strings.HasPrefix(s, "-") {
+		negative, s = true, s[1:]
+	}
+	if len(s) < 2 || s[0] != 'P' {
+		return 0, false
+	}
+	s = s[1:]
+
+	var total time.Duration
+	next, inTime, components, fraction := 0, false, 0, false
+	for len(s) > 0 {
+		if s[0] == 'T' {
+			if inTime {
+				return 0, false
+			}
+			inTime, s = true, s[1:]
+			if len(s) == 0 {
+				return 0, false
+			}
+			continue
+		}
+		if fraction {
+			return 0, false
+		}
+
+		i := 0
+		for i < len(s) && (s[i] >= '0' && s[i] <= '9' || s[i] == '.' || s[i] == ',') {
+			i++
+		}
+		if i == 0 || i == len(s) {
+			return 0, false
+		}
+		number, designator := strings.Replace(s[:i], ",", ".", 1), s[i]
+		s = s[i+1:]
+
+		k := next
+		for k < len(isoDurationUnits) && (isoDurationUnits[k].designator != designator || isoDurationUnits[k].time != inTime) {
+			k++
+		}
+		if k == len(isoDurationUnits) {
+			return 0, false
+		}
+		next = k + 1
+		unit := isoDurationUnits[k].unit
+
+		whole, frac := number, ""
+		if dot := strings.IndexByte(number, '.'); dot >= 0 {
+			whole, frac, fraction = number[:dot], number[dot+1:], true
+			if whole == "" || frac == "" || strings.ContainsAny(frac, ".,") {
+				return 0, false
+			}
+		}
+		n, err := strconv.ParseInt(whole, 10, 64)
+		if err != nil || n > int64((math.MaxInt64-total)/unit) {
+			return 0, false
+		}
+		d := time.Duration(n) * unit
+		if frac != "" {
+			f, err := strconv.ParseFloat("0."+frac, 64)
+			if err != nil {
+				return 0, false
+			}
+			d += time.Duration(math.Round(f * float64(unit)))
+		}
+		if d < 0 || total > math.MaxInt64-d {
+			return 0, false
+		}
+		total += d
+		components++
+	}
+	if components == 0 {
+		return 0, false
+	}
+	if negative {
+		total = -total
+	}
+	return total, true
+}
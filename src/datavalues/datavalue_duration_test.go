@@ -0,0 +1,31 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datavalues
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDurationValue(t *testing.T) {
+	v := MakeDuration(90 * time.Minute)
+	assert.Equal(t, TypeDuration, v.Type())
+	assert.Equal(t, FamilyDuration, v.Family())
+	assert.Equal(t, "1h30m0s", v.String())
+	assert.Equal(t, 90*time.Minute, AsDuration(v))
+	assert.Equal(t, time.Duration(0), AsDuration(MakeInt(1)))
+	assert.Equal(t, v, ToValue(90*time.Minute))
+
+	cmp, err := v.Compare(MakeDuration(time.Hour))
+	assert.Nil(t, err)
+	assert.Equal(t, GreaterThan, cmp)
+	cmp, err = v.Compare(MakeDuration(90 * time.Minute))
+	assert.Nil(t, err)
+	assert.Equal(t, Equal, cmp)
+	_, err = v.Compare(MakeInt(1))
+	assert.NotNil(t, err)
+}
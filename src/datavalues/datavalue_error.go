@@ -0,0 +1,85 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datavalues
+
+import (
+	"strings"
+	"unsafe"
+
+	"base/docs"
+)
+
+// ValueError is the failure of one cell carried as the value, such as a failed cast,
+// so the batch goes on and the failure shows up in the row.
+// The arithmetic and the comparisons of it are the error itself, as the NULL but distinguishable from it.
+type ValueError struct {
+	err error
+}
+
+// MakeError makes the value of the error, the nil error is the NULL.
+func MakeError(err error) IDataValue {
+	if err == nil {
+		return MakeNull()
+	}
+	return &ValueError{err: err}
+}
+
+func (v *ValueError) Size() uintptr {
+	return unsafe.Sizeof(*v) + uintptr(len(v.err.Error()))
+}
+
+func (v *ValueError) String() string {
+	return "<error: " + v.err.Error() + ">"
+}
+
+func (v *ValueError) Type() Type {
+	return TypeError
+}
+
+func (v *ValueError) Family() Family {
+	return FamilyError
+}
+
+func (v *ValueError) AsError() error {
+	return v.err
+}
+
+// Compare sorts the errors after the NULLs and before the others, the errors by their messages.
+func (v *ValueError) Compare(other IDataValue) (Comparison, error) {
+	switch {
+	case isNullOrZero(other):
+		return GreaterThan, nil
+	case other.Type() != TypeError:
+		return LessThan, nil
+	}
+	return Comparison(strings.Compare(v.err.Error(), AsError(other).Error())), nil
+}
+
+func (v *ValueError) Document() docs.Documentation {
+	return docs.Text("Error")
+}
+
+// AsError is the error of the value, nil if it's not an error value.
+func AsError(v IDataValue) error {
+	if x, ok := v.(*ValueError); ok {
+		return x.err
+	}
+	return nil
+}
+
+func IsError(v IDataValue) bool {
+	return v != nil && v.Type() == TypeError
+}
+
+// FirstError is the first error value of the values, nil if none is.
+// The functions of them return it as their result.
+func FirstError(values ...IDataValue) IDataValue {
+	for _, v := range values {
+		if IsError(v) {
+			return v
+		}
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datavalues
+
+import (
+	"sort"
+	"testing"
+
+	"base/errors"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorValue(t *testing.T) {
+	cause := errors.New("bad cell")
+	v := MakeError(cause)
+	assert.Equal(t, TypeError, v.Type())
+	assert.Equal(t, FamilyError, v.Family())
+	assert.True(t, IsError(v))
+	assert.False(t, IsNull(v))
+	assert.Equal(t, cause, AsError(v))
+	assert.Equal(t, cause, v.(*ValueError).AsError())
+	assert.Nil(t, AsError(MakeInt(1)))
+	assert.Equal(t, "<error: bad cell>", Show(v))
+	assert.Equal(t, `(1, <error: bad cell>)`, Show(MakeTuple(MakeInt(1), v)))
+	assert.Equal(t, v, ToValue(cause))
+	assert.True(t, IsNull(MakeError(nil)))
+}
+
+func TestErrorValuePropagation(t *testing.T) {
+	v := MakeError(errors.New("bad cell"))
+	arena := NewArena()
+	for _, fn := range []func(a, b IDataValue) (IDataValue, error){Add, Sub, Mul, Div, arena.Add, arena.Div} {
+		actual, err := fn(MakeInt(1), v)
+		assert.Nil(t, err)
+		assert.True(t, actual == v)
+		actual, err = fn(v, MakeFloat(1))
+		assert.Nil(t, err)
+		assert.True(t, actual == v)
+	}
+	other := MakeError(errors.New("other"))
+	actual, err := Add(v, other)
+	assert.Nil(t, err)
+	assert.True(t, actual == v)
+
+	actual, err = Cast(v, TypeString)
+	assert.Nil(t, err)
+	assert.True(t, actual == v)
+	assert.Equal(t, MakeString("1"), CastOrError(MakeInt(1), TypeString))
+	actual = CastOrError(MakeString("x"), TypeTime)
+	assert.Equal(t, errors.CANNOT_PARSE_TEXT, errors.Code(AsError(actual)))
+}
+
+func TestErrorValueCompare(t *testing.T) {
+	a := MakeError(errors.New("a"))
+	b := MakeError(errors.New("b"))
+	values := []IDataValue{MakeInt(1), b, MakeNull(), a}
+	sort.SliceStable(values, func(i, j int) bool {
+		cmp, err := CompareTyped(values[i], values[j])
+		assert.Nil(t, err)
+		return cmp == LessThan
+	})
+	assert.Equal(t, []IDataValue{MakeNull(), a, b, MakeInt(1)}, values)
+
+	cmp, err := a.Compare(MakeError(errors.New("a")))
+	assert.Nil(t, err)
+	assert.Equal(t, Equal, cmp)
+	cmp, err = MakeNull().Compare(a)
+	assert.Nil(t, err)
+	assert.Equal(t, LessThan, cmp)
+	assert.True(t, Equals(a, MakeError(errors.New("a"))))
+	assert.False(t, Equals(a, b))
+	assert.False(t, Equals(a, MakeNull()))
+}
@@ -0,0 +1,157 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datavalues
+
+import (
+	"sync"
+	"unsafe"
+
+	"base/docs"
+	"base/errors"
+)
+
+// IExtensionType is a value type defined out of the package, such as the money and the coordinates.
+// Its values are of the TypeExtension and the FamilyExtension, their payloads are what the type makes of them.
+type IExtensionType interface {
+	// ID is the stable id of the type, the spilled values are read back by it.
+	// It must not change nor be reused for another type.
+	ID() uint32
+	Name() string
+	// Make is the payload of the Go value, it fails if the type can't take it.
+	Make(v interface{}) (interface{}, error)
+	Show(payload interface{}) string
+	Compare(a, b interface{}) (Comparison, error)
+	MarshalBinary(payload interface{}) ([]byte, error)
+	UnmarshalBinary(data []byte) (interface{}, error)
+}
+
+var extensionTypes = struct {
+	mu    sync.RWMutex
+	ids   map[uint32]IExtensionType
+	names map[string]IExtensionType
+}{
+	ids:   make(map[uint32]IExtensionType),
+	names: make(map[string]IExtensionType),
+}
+
+// RegisterExtensionType adds the type to the registry of the whole process, usually in the init of its package.
+// It fails if the id or the name is already registered.
+func RegisterExtensionType(typ IExtensionType) error {
+	extensionTypes.mu.Lock()
+	defer extensionTypes.mu.Unlock()
+
+	if old, ok := extensionTypes.ids[typ.ID()]; ok {
+		return errors.Errorf("extension type id %v is already registered as %v", typ.ID(), old.Name())
+	}
+	if _, ok := extensionTypes.names[typ.Name()]; ok {
+		return errors.Errorf("extension type %v is already registered", typ.Name())
+	}
+	extensionTypes.ids[typ.ID()] = typ
+	extensionTypes.names[typ.Name()] = typ
+	return nil
+}
+
+func LookupExtensionType(id uint32) (IExtensionType, bool) {
+	extensionTypes.mu.RLock()
+	defer extensionTypes.mu.RUnlock()
+	typ, ok := extensionTypes.ids[id]
+	return typ, ok
+}
+
+func LookupExtensionTypeByName(name string) (IExtensionType, bool) {
+	extensionTypes.mu.RLock()
+	defer extensionTypes.mu.RUnlock()
+	typ, ok := extensionTypes.names[name]
+	return typ, ok
+}
+
+type ValueExtension struct {
+	typ     IExtensionType
+	payload interface{}
+}
+
+// MakeExtension makes the value of the registered type of the id from the Go value.
+func MakeExtension(id uint32, v interface{}) (IDataValue, error) {
+	typ, ok := LookupExtensionType(id)
+	if !ok {
+		return nil, errors.Errorf("Unknown extension type id:%v", id)
+	}
+	payload, err := typ.Make(v)
+	if err != nil {
+		return nil, err
+	}
+	return &ValueExtension{typ: typ, payload: payload}, nil
+}
+
+func (v *ValueExtension) Size() uintptr {
+	return unsafe.Sizeof(*v)
+}
+
+func (v *ValueExtension) String() string {
+	return v.typ.Show(v.payload)
+}
+
+func (v *ValueExtension) Type() Type {
+	return TypeExtension
+}
+
+func (v *ValueExtension) Family() Family {
+	return FamilyExtension
+}
+
+// Compare is of the values of the same extension type only.
+func (v *ValueExtension) Compare(other IDataValue) (Comparison, error) {
+	x, ok := other.(*ValueExtension)
+	if !ok || x.typ.ID() != v.typ.ID() {
+		return 0, errors.Errorf("type mismatch between values, got:%v", typeName(other))
+	}
+	return v.typ.Compare(v.payload, x.payload)
+}
+
+func (v *ValueExtension) Document() docs.Documentation {
+	return docs.Text(v.typ.Name())
+}
+
+func (v *ValueExtension) ExtensionType() IExtensionType {
+	return v.typ
+}
+
+func (v *ValueExtension) Payload() interface{} {
+	return v.payload
+}
+
+// ExtensionTypeOf is the extension type of the value, it's false for the builtin ones.
+func ExtensionTypeOf(v IDataValue) (IExtensionType, bool) {
+	if x, ok := v.(*ValueExtension); ok {
+		return x.typ, true
+	}
+	return nil, false
+}
+
+// MarshalExtension is the id of the type of the value and the binary of its payload.
+func MarshalExtension(v IDataValue) (uint32, []byte, error) {
+	x, ok := v.(*ValueExtension)
+	if !ok {
+		return 0, nil, errors.Errorf("Not an extension value:%v", v)
+	}
+	data, err := x.typ.MarshalBinary(x.payload)
+	if err != nil {
+		return 0, nil, err
+	}
+	return x.typ.ID(), data, nil
+}
+
+// UnmarshalExtension reads the value back by the registered type of the id.
+func UnmarshalExtension(id uint32, data []byte) (IDataValue, error) {
+	typ, ok := LookupExtensionType(id)
+	if !ok {
+		return nil, errors.Errorf("Unknown extension type id:%v", id)
+	}
+	payload, err := typ.UnmarshalBinary(data)
+	if err != nil {
+		return nil, err
+	}
+	return &ValueExtension{typ: typ, payload: payload}, nil
+}
@@ -0,0 +1,117 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datavalues
+
+import (
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// moneyType is the money in the cents.
+type moneyType struct{}
+
+func (moneyType) ID() uint32   { return 1001 }
+func (moneyType) Name() string { return "Money" }
+
+func (moneyType) Make(v interface{}) (interface{}, error) {
+	cents, ok := v.(int64)
+	if !ok {
+		return nil, fmt.Errorf("money of %T", v)
+	}
+	return cents, nil
+}
+
+func (moneyType) Show(payload interface{}) string {
+	cents := payload.(int64)
+	return fmt.Sprintf("$%d.%02d", cents/100, cents%100)
+}
+
+func (moneyType) Compare(a, b interface{}) (Comparison, error) {
+	return compareInt(a.(int64), b.(int64)), nil
+}
+
+func (moneyType) MarshalBinary(payload interface{}) ([]byte, error) {
+	return binary.AppendVarint(nil, payload.(int64)), nil
+}
+
+func (moneyType) UnmarshalBinary(data []byte) (interface{}, error) {
+	cents, n := binary.Varint(data)
+	if n <= 0 {
+		return nil, fmt.Errorf("bad money:%v", data)
+	}
+	return cents, nil
+}
+
+func init() {
+	if err := RegisterExtensionType(moneyType{}); err != nil {
+		panic(err)
+	}
+}
+
+func TestExtensionRegistry(t *testing.T) {
+	typ, ok := LookupExtensionType(1001)
+	assert.True(t, ok)
+	assert.Equal(t, "Money", typ.Name())
+	typ, ok = LookupExtensionTypeByName("Money")
+	assert.True(t, ok)
+	assert.Equal(t, uint32(1001), typ.ID())
+	_, ok = LookupExtensionType(1002)
+	assert.False(t, ok)
+
+	err := RegisterExtensionType(moneyType{})
+	assert.Equal(t, "extension type id 1001 is already registered as Money", err.Error())
+
+	_, err = MakeExtension(1002, int64(1))
+	assert.Equal(t, "Unknown extension type id:1002", err.Error())
+	_, err = MakeExtension(1001, "1.23")
+	assert.Equal(t, "money of string", err.Error())
+}
+
+func TestExtensionValue(t *testing.T) {
+	a, err := MakeExtension(1001, int64(123))
+	assert.Nil(t, err)
+	b, err := MakeExtension(1001, int64(4500))
+	assert.Nil(t, err)
+
+	assert.Equal(t, TypeExtension, a.Type())
+	assert.Equal(t, FamilyExtension, a.Family())
+	assert.Equal(t, "$1.23", a.String())
+	assert.Equal(t, "($1.23, $45.00)", Show(MakeTuple(a, b)))
+	assert.Equal(t, "$45.00", Sprintf("%v", b))
+	typ, ok := ExtensionTypeOf(a)
+	assert.True(t, ok)
+	assert.Equal(t, "Money", typ.Name())
+	_, ok = ExtensionTypeOf(MakeInt(1))
+	assert.False(t, ok)
+
+	cmp, err := a.Compare(b)
+	assert.Nil(t, err)
+	assert.Equal(t, LessThan, cmp)
+	cmp, err = CompareTyped(b, a)
+	assert.Nil(t, err)
+	assert.Equal(t, GreaterThan, cmp)
+	_, err = CompareTyped(a, MakeInt(123))
+	assert.Equal(t, "Cannot compare Money with Int (errno 53)", err.Error())
+
+	same, err := MakeExtension(1001, int64(123))
+	assert.Nil(t, err)
+	assert.True(t, Equals(a, same))
+	assert.False(t, Equals(a, b))
+	assert.Equal(t, Hash(a), Hash(same))
+
+	id, data, err := MarshalExtension(b)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(1001), id)
+	back, err := UnmarshalExtension(id, data)
+	assert.Nil(t, err)
+	assert.True(t, Equals(b, back))
+	_, err = UnmarshalExtension(1002, data)
+	assert.Equal(t, "Unknown extension type id:1002", err.Error())
+	_, _, err = MarshalExtension(MakeInt(1))
+	assert.Equal(t, "Not an extension value:1", err.Error())
+}
@@ -0,0 +1,256 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datavalues
+
+import (
+	"encoding/binary"
+	"hash"
+	"hash/fnv"
+	"hash/maphash"
+	"io"
+	"math"
+)
+
+// Hash returns the hash of the value, keeping with Equals:
+// the equal values have the same hash, the values of different families never equal.
+func Hash(v IDataValue) uint64 {
+	h := fnv.New64a()
+	hashTo(v, &hash64Writer{h: h})
+	return h.Sum64()
+}
+
+// HashInto writes the bytes Hash sums into the hasher, so the values of a composite key,
+// such as the GROUP BY columns, are hashed by one running hasher rather than by combining their hashes.
+// The bytes of each value are prefixed by its family and the strings by their length,
+// so ("ab", "c") and ("a", "bc") differ. It doesn't allocate with a *maphash.Hash but for the sorted keys
+// of the objects, the other hashers take the bytes through Write.
+func HashInto(v IDataValue, h hash.Hash64) {
+	if mh, ok := h.(*maphash.Hash); ok {
+		hashTo(v, maphashWriter{h: mh})
+		return
+	}
+	hashTo(v, &hash64Writer{h: h})
+}
+
+// hashWriter takes the canonical bytes of the values, a header is the family and a 64 bits field.
+type hashWriter interface {
+	writeFamily(family Family)
+	writeBool(b bool)
+	writeHeader(family Family, u uint64)
+	writeString(s string)
+}
+
+type hash64Writer struct {
+	h   hash.Hash64
+	buf [9]byte
+}
+
+func (w *hash64Writer) writeFamily(family Family) {
+	w.buf[0] = byte(family)
+	_, _ = w.h.Write(w.buf[:1])
+}
+
+func (w *hash64Writer) writeBool(b bool) {
+	w.buf[0], w.buf[1] = byte(FamilyBool), 0
+	if b {
+		w.buf[1] = 1
+	}
+	_, _ = w.h.Write(w.buf[:2])
+}
+
+func (w *hash64Writer) writeHeader(family Family, u uint64) {
+	w.buf[0] = byte(family)
+	binary.LittleEndian.PutUint64(w.buf[1:], u)
+	_, _ = w.h.Write(w.buf[:])
+}
+
+func (w *hash64Writer) writeString(s string) {
+	_, _ = io.WriteString(w.h, s)
+}
+
+type maphashWriter struct {
+	h *maphash.Hash
+}
+
+func (w maphashWriter) writeFamily(family Family) {
+	_ = w.h.WriteByte(byte(family))
+}
+
+func (w maphashWriter) writeBool(b bool) {
+	_ = w.h.WriteByte(byte(FamilyBool))
+	if b {
+		_ = w.h.WriteByte(1)
+	} else {
+		_ = w.h.WriteByte(0)
+	}
+}
+
+func (w maphashWriter) writeHeader(family Family, u uint64) {
+	var buf [9]byte
+	buf[0] = byte(family)
+	binary.LittleEndian.PutUint64(buf[1:], u)
+	_, _ = w.h.Write(buf[:])
+}
+
+func (w maphashWriter) writeString(s string) {
+	_, _ = w.h.WriteString(s)
+}
+
+func hashTo(v IDataValue, w hashWriter) {
+	if isNullOrZero(v) {
+		w.writeFamily(FamilyNull)
+		return
+	}
+
+	family := v.Family()
+	switch family {
+	case FamilyInt:
+		w.writeHeader(family, uint64(AsInt(v)))
+	case FamilyFloat:
+		w.writeHeader(family, floatBits(AsFloat(v)))
+	case FamilyBool:
+		w.writeBool(AsBool(v))
+	case FamilyTime:
+		w.writeHeader(family, uint64(AsTime(v).UnixNano()))
+	case FamilyTuple:
+		fields := AsSlice(v)
+		w.writeHeader(family, uint64(len(fields)))
+		for _, field := range fields {
+			hashTo(field, w)
+		}
+	case FamilyObject:
+		obj := v.(*ValueObject)
+		keys := obj.Keys()
+		w.writeHeader(family, uint64(len(keys)))
+		for _, k := range keys {
+			w.writeHeader(family, uint64(len(k)))
+			w.writeString(k)
+			hashTo(obj.fields[k], w)
+		}
+	case FamilyString:
+		s := AsString(v)
+		w.writeHeader(family, uint64(len(s)))
+		w.writeString(s)
+	default:
+		s := v.String()
+		w.writeHeader(family, uint64(len(s)))
+		w.writeString(s)
+	}
+}
+
+// floatBits folds -0 into 0 and all the NaNs into one, so they hash the same as they equal.
+func floatBits(f float64) uint64 {
+	switch {
+	case f == 0:
+		return 0
+	case math.IsNaN(f):
+		return math.Float64bits(math.NaN())
+	}
+	return math.Float64bits(f)
+}
+
+// Equals checks if the values are the same for the grouping purpose:
+// the NULLs equal each other, so do the NaNs, and the values of different families never equal,
+// integer 1 is not string "1".
+func Equals(a, b IDataValue) bool {
+	if isNullOrZero(a) || isNullOrZero(b) {
+		return isNullOrZero(a) && isNullOrZero(b)
+	}
+	if a.Family() != b.Family() {
+		return false
+	}
+
+	switch a.Family() {
+	case FamilyInt:
+		return AsInt(a) == AsInt(b)
+	case FamilyFloat:
+		x, y := AsFloat(a), AsFloat(b)
+		return x == y || (math.IsNaN(x) && math.IsNaN(y))
+	case FamilyBool:
+		return AsBool(a) == AsBool(b)
+	case FamilyTime:
+		return AsTime(a).Equal(AsTime(b))
+	case FamilyTuple:
+		x, y := AsSlice(a), AsSlice(b)
+		if len(x) != len(y) {
+			return false
+		}
+		for i := range x {
+			if !Equals(x[i], y[i]) {
+				return false
+			}
+		}
+		return true
+	case FamilyString:
+		return AsString(a) == AsString(b)
+	case FamilyObject:
+		x, y := AsMap(a), AsMap(b)
+		if len(x) != len(y) {
+			return false
+		}
+		for k, xv := range x {
+			yv, ok := y[k]
+			if !ok || !Equals(xv, yv) {
+				return false
+			}
+		}
+		return true
+	}
+	cmp, err := a.Compare(b)
+	return err == nil && cmp == Equal
+}
+
+// EqualsLoose is Equals with the numeric coercion for the assertions:
+// Int 1 equals Float 1.0, also inside the tuples and objects.
+func EqualsLoose(a, b IDataValue) bool {
+	if isNullOrZero(a) || isNullOrZero(b) {
+		return Equals(a, b)
+	}
+
+	switch {
+	case IsNumber(a) && IsNumber(b):
+		switch {
+		case IsIntegral(a) && IsIntegral(b):
+			return AsInt(a) == AsInt(b)
+		case IsIntegral(a):
+			return intEqualsFloat(AsInt(a), AsFloat(b))
+		case IsIntegral(b):
+			return intEqualsFloat(AsInt(b), AsFloat(a))
+		}
+		return Equals(a, b)
+	case a.Family() == FamilyTuple && b.Family() == FamilyTuple:
+		x, y := AsSlice(a), AsSlice(b)
+		if len(x) != len(y) {
+			return false
+		}
+		for i := range x {
+			if !EqualsLoose(x[i], y[i]) {
+				return false
+			}
+		}
+		return true
+	case a.Family() == FamilyObject && b.Family() == FamilyObject:
+		x, y := AsMap(a), AsMap(b)
+		if len(x) != len(y) {
+			return false
+		}
+		for k, xv := range x {
+			yv, ok := y[k]
+			if !ok || !EqualsLoose(xv, yv) {
+				return false
+			}
+		}
+		return true
+	}
+	return Equals(a, b)
+}
+
+// intEqualsFloat compares exactly, the floats beyond the int64 range or with a fraction never equal.
+func intEqualsFloat(i int64, f float64) bool {
+	if f != math.Trunc(f) || f < math.MinInt64 || f >= math.MaxInt64 {
+		return false
+	}
+	return int64(f) == i
+}
@@ -0,0 +1,67 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datavalues
+
+import (
+	"hash"
+	"hash/fnv"
+	"hash/maphash"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHashInto(t *testing.T) {
+	values := []IDataValue{
+		MakeNull(),
+		MakeInt(1),
+		MakeInt32(1),
+		MakeFloat(-0.0),
+		MakeFloat(math.NaN()),
+		MakeBool(true),
+		MakeString("ab"),
+		MakeTime(time.Unix(1, 0)),
+		MakeTuple(MakeInt(1), MakeString("a")),
+		MakeObject(map[string]IDataValue{"a": MakeInt(1), "b": MakeNull()}),
+	}
+
+	// The bytes are the ones Hash sums.
+	for _, v := range values {
+		h := fnv.New64a()
+		HashInto(v, h)
+		assert.Equal(t, Hash(v), h.Sum64(), "%v", v)
+	}
+
+	seed := maphash.MakeSeed()
+	key := func(h hash.Hash64, vals ...IDataValue) uint64 {
+		h.Reset()
+		for _, v := range vals {
+			HashInto(v, h)
+		}
+		return h.Sum64()
+	}
+	for _, h := range []hash.Hash64{fnv.New64a(), func() *maphash.Hash { h := &maphash.Hash{}; h.SetSeed(seed); return h }()} {
+		// The equal keys hash the same.
+		assert.Equal(t, key(h, MakeInt(1), MakeFloat(0)), key(h, MakeInt32(1), MakeFloat(-0.0)))
+		assert.Equal(t, key(h, MakeFloat(math.NaN()), MakeNull()), key(h, MakeFloat(math.NaN()), MakeNull()))
+
+		// The strings are prefixed by their length, the values by their family.
+		assert.NotEqual(t, key(h, MakeString("ab"), MakeString("c")), key(h, MakeString("a"), MakeString("bc")))
+		assert.NotEqual(t, key(h, MakeInt(1)), key(h, MakeFloat(1)))
+		assert.NotEqual(t, key(h, MakeInt(1), MakeNull()), key(h, MakeNull(), MakeInt(1)))
+	}
+
+	// No allocation with maphash, but for the sorted keys of the objects.
+	h := &maphash.Hash{}
+	assert.Equal(t, float64(0), testing.AllocsPerRun(100, func() {
+		h.Reset()
+		for _, v := range values[:len(values)-1] {
+			HashInto(v, h)
+		}
+		_ = h.Sum64()
+	}))
+}
@@ -45,6 +45,9 @@ func (v *ValueInt32) AsInt() int32 {
 }
 
 func (v *ValueInt32) Compare(other IDataValue) (Comparison, error) {
+	if a, b, ok := coerceBool(v, other); ok {
+		return a.Compare(b)
+	}
 	if !IsIntegral(other) {
 		return 0, errors.Errorf("type mismatch between values, got:%v", other.Type())
 	}
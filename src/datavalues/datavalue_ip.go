@@ -0,0 +1,160 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datavalues
+
+import (
+	"net/netip"
+	"unsafe"
+
+	"base/docs"
+	"base/errors"
+)
+
+// ValueIP is the IP address, the IPv4 one is of the TypeIPv4 and the others, with the IPv4-mapped IPv6 ones,
+// of the TypeIPv6. The addresses are ordered as netip does: the IPv4 ones first, "10.0.0.1" and "::ffff:10.0.0.1"
+// are not equal, IPInPrefix matches them the same.
+type ValueIP struct {
+	addr netip.Addr
+}
+
+func MakeIP(v netip.Addr) IDataValue {
+	return &ValueIP{addr: v}
+}
+
+func (v *ValueIP) Size() uintptr {
+	return unsafe.Sizeof(*v)
+}
+
+func (v *ValueIP) String() string {
+	return v.addr.String()
+}
+
+func (v *ValueIP) Type() Type {
+	if v.addr.Is4() {
+		return TypeIPv4
+	}
+	return TypeIPv6
+}
+
+func (v *ValueIP) Family() Family {
+	return FamilyIP
+}
+
+func (v *ValueIP) Compare(other IDataValue) (Comparison, error) {
+	ip, ok := other.(*ValueIP)
+	if !ok {
+		return 0, errors.Errorf("type mismatch between values, got:%v", other.Type())
+	}
+	return Comparison(v.addr.Compare(ip.addr)), nil
+}
+
+func (v *ValueIP) Document() docs.Documentation {
+	return docs.Text(typeNames[v.Type()])
+}
+
+// ValuePrefix is the CIDR, the address and the number of its leading bits, such as "10.0.0.0/8".
+// The address is kept as given, "10.0.0.1/8" is not masked.
+type ValuePrefix struct {
+	prefix netip.Prefix
+}
+
+func MakePrefix(v netip.Prefix) IDataValue {
+	return &ValuePrefix{prefix: v}
+}
+
+func (v *ValuePrefix) Size() uintptr {
+	return unsafe.Sizeof(*v)
+}
+
+func (v *ValuePrefix) String() string {
+	return v.prefix.String()
+}
+
+func (v *ValuePrefix) Type() Type {
+	return TypePrefix
+}
+
+func (v *ValuePrefix) Family() Family {
+	return FamilyIP
+}
+
+// Compare orders the prefixes by their addresses, then by their bits.
+func (v *ValuePrefix) Compare(other IDataValue) (Comparison, error) {
+	p, ok := other.(*ValuePrefix)
+	if !ok {
+		return 0, errors.Errorf("type mismatch between values, got:%v", other.Type())
+	}
+	if cmp := v.prefix.Addr().Compare(p.prefix.Addr()); cmp != 0 {
+		return Comparison(cmp), nil
+	}
+	return compareInt(int64(v.prefix.Bits()), int64(p.prefix.Bits())), nil
+}
+
+func (v *ValuePrefix) Document() docs.Documentation {
+	return docs.Text("Prefix")
+}
+
+func AsIP(v IDataValue) netip.Addr {
+	if ip, ok := v.(*ValueIP); ok {
+		return ip.addr
+	}
+	return netip.Addr{}
+}
+
+func AsPrefix(v IDataValue) netip.Prefix {
+	if p, ok := v.(*ValuePrefix); ok {
+		return p.prefix
+	}
+	return netip.Prefix{}
+}
+
+// IPInPrefix returns whether the address is in the prefix, the strings are parsed as them.
+// The IPv4 addresses and prefixes match as their IPv4-mapped IPv6 ones, "10.0.0.1" is in "::ffff:10.0.0.0/104"
+// and "::ffff:10.0.0.1" in "10.0.0.0/8". It's NULL if either is NULL.
+func IPInPrefix(addr, prefix IDataValue) (IDataValue, error) {
+	if isNullOrZero(addr) || isNullOrZero(prefix) {
+		return MakeNull(), nil
+	}
+
+	var a netip.Addr
+	switch v := addr.(type) {
+	case *ValueIP:
+		a = v.addr
+	case *ValueString:
+		x, err := netip.ParseAddr(string(*v))
+		if err != nil {
+			return nil, errors.ErrorWithCode(errors.CANNOT_PARSE_TEXT, "Cannot parse IP address:%v", string(*v))
+		}
+		a = x
+	default:
+		return nil, errors.ErrorWithCode(errors.TYPE_MISMATCH, "Expected IP address, got:%v", typeNames[addr.Type()])
+	}
+
+	var p netip.Prefix
+	switch v := prefix.(type) {
+	case *ValuePrefix:
+		p = v.prefix
+	case *ValueString:
+		x, err := netip.ParsePrefix(string(*v))
+		if err != nil {
+			return nil, errors.ErrorWithCode(errors.CANNOT_PARSE_TEXT, "Cannot parse IP prefix:%v", string(*v))
+		}
+		p = x
+	default:
+		return nil, errors.ErrorWithCode(errors.TYPE_MISMATCH, "Expected IP prefix, got:%v", typeNames[prefix.Type()])
+	}
+	if !a.IsValid() || !p.IsValid() {
+		return MakeBool(false), nil
+	}
+
+	// Both in the 16 bytes form, the zones don't matter.
+	a = netip.AddrFrom16(a.As16())
+	if p.Addr().Is4() {
+		p = netip.PrefixFrom(netip.AddrFrom16(p.Addr().As16()), p.Bits()+96)
+	} else {
+		p = netip.PrefixFrom(p.Addr().WithZone(""), p.Bits())
+	}
+	return MakeBool(p.Contains(a)), nil
+}
@@ -0,0 +1,138 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datavalues
+
+import (
+	"net/netip"
+	"testing"
+
+	"base/errors"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValueIP(t *testing.T) {
+	v4 := ToValue(netip.MustParseAddr("10.0.0.1"))
+	assert.Equal(t, TypeIPv4, v4.Type())
+	assert.Equal(t, FamilyIP, v4.Family())
+	assert.Equal(t, "10.0.0.1", v4.String())
+	assert.Equal(t, netip.MustParseAddr("10.0.0.1"), AsIP(v4))
+
+	mapped := ToValue(netip.MustParseAddr("::ffff:10.0.0.1"))
+	assert.Equal(t, TypeIPv6, mapped.Type())
+	assert.Equal(t, "::ffff:10.0.0.1", mapped.String())
+	v6 := ToValue(netip.MustParseAddr("2001:db8::1"))
+	assert.Equal(t, TypeIPv6, v6.Type())
+
+	prefix := ToValue(netip.MustParsePrefix("10.0.0.0/8"))
+	assert.Equal(t, TypePrefix, prefix.Type())
+	assert.Equal(t, FamilyIP, prefix.Family())
+	assert.Equal(t, "10.0.0.0/8", prefix.String())
+	assert.Equal(t, netip.MustParsePrefix("10.0.0.0/8"), AsPrefix(prefix))
+
+	// The zero ones are NULL.
+	assert.Equal(t, TypeNull, ToValue(netip.Addr{}).Type())
+	assert.Equal(t, TypeNull, ToValue(netip.Prefix{}).Type())
+
+	cmp, err := v4.Compare(ToValue(netip.MustParseAddr("10.0.0.2")))
+	assert.Nil(t, err)
+	assert.Equal(t, LessThan, cmp)
+	cmp, err = v4.Compare(v6)
+	assert.Nil(t, err)
+	assert.Equal(t, LessThan, cmp)
+	assert.False(t, Equals(v4, mapped))
+	assert.True(t, Equals(v4, MakeIP(netip.MustParseAddr("10.0.0.1"))))
+	_, err = v4.Compare(MakeString("10.0.0.1"))
+	assert.NotNil(t, err)
+	_, err = v4.Compare(prefix)
+	assert.NotNil(t, err)
+
+	cmp, err = prefix.Compare(ToValue(netip.MustParsePrefix("10.0.0.0/16")))
+	assert.Nil(t, err)
+	assert.Equal(t, LessThan, cmp)
+}
+
+func TestIPInPrefix(t *testing.T) {
+	tests := []struct {
+		name   string
+		addr   IDataValue
+		prefix IDataValue
+		expect IDataValue
+	}{
+		{
+			name:   "ipv4",
+			addr:   MakeIP(netip.MustParseAddr("10.0.0.1")),
+			prefix: MakePrefix(netip.MustParsePrefix("10.0.0.0/8")),
+			expect: MakeBool(true),
+		},
+		{
+			name:   "ipv4-out",
+			addr:   MakeIP(netip.MustParseAddr("11.0.0.1")),
+			prefix: MakePrefix(netip.MustParsePrefix("10.0.0.0/8")),
+			expect: MakeBool(false),
+		},
+		{
+			name:   "mapped-addr",
+			addr:   MakeIP(netip.MustParseAddr("::ffff:10.0.0.1")),
+			prefix: MakePrefix(netip.MustParsePrefix("10.0.0.0/8")),
+			expect: MakeBool(true),
+		},
+		{
+			name:   "mapped-prefix",
+			addr:   MakeIP(netip.MustParseAddr("10.0.0.1")),
+			prefix: MakePrefix(netip.MustParsePrefix("::ffff:10.0.0.0/104")),
+			expect: MakeBool(true),
+		},
+		{
+			name:   "mapped-both",
+			addr:   MakeIP(netip.MustParseAddr("::ffff:10.255.0.1")),
+			prefix: MakePrefix(netip.MustParsePrefix("::ffff:10.0.0.0/104")),
+			expect: MakeBool(true),
+		},
+		{
+			name:   "ipv6",
+			addr:   MakeIP(netip.MustParseAddr("2001:db8::1")),
+			prefix: MakePrefix(netip.MustParsePrefix("2001:db8::/32")),
+			expect: MakeBool(true),
+		},
+		{
+			name:   "ipv6-not-ipv4",
+			addr:   MakeIP(netip.MustParseAddr("2001:db8::1")),
+			prefix: MakePrefix(netip.MustParsePrefix("0.0.0.0/0")),
+			expect: MakeBool(false),
+		},
+		{
+			name:   "zone",
+			addr:   MakeIP(netip.MustParseAddr("fe80::1%eth0")),
+			prefix: MakePrefix(netip.MustParsePrefix("fe80::/10")),
+			expect: MakeBool(true),
+		},
+		{
+			name:   "strings",
+			addr:   MakeString("10.1.2.3"),
+			prefix: MakeString("10.0.0.0/8"),
+			expect: MakeBool(true),
+		},
+		{
+			name:   "null",
+			addr:   MakeNull(),
+			prefix: MakeString("10.0.0.0/8"),
+			expect: MakeNull(),
+		},
+	}
+
+	for _, test := range tests {
+		actual, err := IPInPrefix(test.addr, test.prefix)
+		assert.Nil(t, err, test.name)
+		assert.Equal(t, test.expect, actual, test.name)
+	}
+
+	_, err := IPInPrefix(MakeString("10.0.0"), MakeString("10.0.0.0/8"))
+	assert.Equal(t, errors.CANNOT_PARSE_TEXT, errors.Code(err))
+	_, err = IPInPrefix(MakeString("10.0.0.1"), MakeString("10.0.0.0"))
+	assert.Equal(t, errors.CANNOT_PARSE_TEXT, errors.Code(err))
+	_, err = IPInPrefix(MakeInt(1), MakeString("10.0.0.0/8"))
+	assert.Equal(t, errors.TYPE_MISMATCH, errors.Code(err))
+}
@@ -0,0 +1,275 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datavalues
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"math"
+	"strconv"
+	"time"
+
+	"base/errors"
+)
+
+// EncodeJSONL writes the Object rows as JSON Lines, one object per line, until the rows are closed.
+// The fields are written sorted, the Float keeps its fraction so it's read back as Float,
+// the NaN and the infinities are null, the Time is RFC3339 and the Bytes are base64.
+// It returns at the first row which is not an Object or the first write error, the rows left are not read.
+func EncodeJSONL(w io.Writer, rows <-chan IDataValue) error {
+	var buf []byte
+	var n int
+
+	writer := bufio.NewWriter(w)
+	for row := range rows {
+		n++
+		if row == nil || row.Type() != TypeObject {
+			return errors.Errorf("JSONL row %d is not an Object", n)
+		}
+		buf = appendJSON(buf[:0], row)
+		buf = append(buf, '\n')
+		if _, err := writer.Write(buf); err != nil {
+			return errors.Wrap(err)
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		return errors.Wrap(err)
+	}
+	return nil
+}
+
+func appendJSON(buf []byte, v IDataValue) []byte {
+	if isNullOrZero(v) {
+		return append(buf, "null"...)
+	}
+
+	switch v.Type() {
+	case TypeInt, TypeInt32:
+		return strconv.AppendInt(buf, AsInt(v), 10)
+	case TypeFloat:
+		f := AsFloat(v)
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return append(buf, "null"...)
+		}
+		start := len(buf)
+		buf = strconv.AppendFloat(buf, f, 'g', -1, 64)
+		if bytes.IndexAny(buf[start:], ".e") < 0 {
+			buf = append(buf, ".0"...)
+		}
+		return buf
+	case TypeBool:
+		return strconv.AppendBool(buf, AsBool(v))
+	case TypeString:
+		return appendJSONString(buf, AsString(v))
+	case TypeBytes:
+		return appendJSONString(buf, base64.StdEncoding.EncodeToString(AsBytes(v)))
+	case TypeTime:
+		return appendJSONString(buf, AsTime(v).Format(time.RFC3339Nano))
+	case TypeTuple:
+		buf = append(buf, '[')
+		for i, field := range AsSlice(v) {
+			if i > 0 {
+				buf = append(buf, ',')
+			}
+			buf = appendJSON(buf, field)
+		}
+		return append(buf, ']')
+	case TypeObject:
+		fields := AsMap(v)
+		buf = append(buf, '{')
+		for i, k := range v.(*ValueObject).Keys() {
+			if i > 0 {
+				buf = append(buf, ',')
+			}
+			buf = appendJSONString(buf, k)
+			buf = append(buf, ':')
+			buf = appendJSON(buf, fields[k])
+		}
+		return append(buf, '}')
+	}
+	return appendJSONString(buf, v.String())
+}
+
+func appendJSONString(buf []byte, s string) []byte {
+	// The marshal of a string never fails.
+	quoted, _ := json.Marshal(s)
+	return append(buf, quoted...)
+}
+
+// DecodeJSONL reads the JSON Lines into Object rows, one line at a time.
+// The fields of the schema are decoded to their type, the others as TryToValue does,
+// the null is NULL whatever the type and the empty lines are skipped.
+// A malformed line is sent on the errors with its line number and the decoding goes on with the next line,
+// the read error ends it. Both channels are closed at the end, the caller reads both until then.
+func DecodeJSONL(r io.Reader, schema map[string]Type) (<-chan IDataValue, <-chan error) {
+	rows := make(chan IDataValue)
+	errs := make(chan error)
+
+	go func() {
+		defer close(errs)
+		defer close(rows)
+
+		reader := bufio.NewReader(r)
+		for line := 1; ; line++ {
+			data, err := reader.ReadBytes('\n')
+			if err != nil && err != io.EOF {
+				errs <- errors.Wrapf(err, "JSONL line %d", line)
+				return
+			}
+			if data = bytes.TrimSpace(data); len(data) > 0 {
+				row, perr := decodeJSONLine(data, schema)
+				if perr != nil {
+					errs <- errors.ErrorWithCode(errors.CANNOT_PARSE_TEXT, "Cannot parse JSONL line %d: %v", line, perr)
+				} else {
+					rows <- row
+				}
+			}
+			if err == io.EOF {
+				return
+			}
+		}
+	}()
+	return rows, errs
+}
+
+func decodeJSONLine(data []byte, schema map[string]Type) (IDataValue, error) {
+	var object map[string]interface{}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&object); err != nil {
+		return nil, err
+	}
+	if object == nil {
+		return nil, errors.New("expected an object, got null")
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, errors.New("unexpected data after the object")
+	}
+
+	fields := make(map[string]IDataValue, len(object))
+	for k, x := range object {
+		typ, ok := schema[k]
+		if !ok {
+			v, err := TryToValue(x)
+			if err != nil {
+				return nil, errors.Errorf("field %s: %v", k, err)
+			}
+			fields[k] = v
+			continue
+		}
+		v, err := jsonToTyped(x, typ)
+		if err != nil {
+			return nil, errors.Errorf("field %s: %v", k, err)
+		}
+		fields[k] = v
+	}
+	return MakeObject(fields), nil
+}
+
+// jsonToTyped converts the decoded JSON to the value of the type, the JSON of another type fails.
+func jsonToTyped(x interface{}, typ Type) (IDataValue, error) {
+	if x == nil {
+		return MakeNull(), nil
+	}
+
+	switch typ {
+	case TypeInt, TypeInt32:
+		if n, ok := x.(json.Number); ok {
+			bits := 64
+			if typ == TypeInt32 {
+				bits = 32
+			}
+			i, err := strconv.ParseInt(string(n), 10, bits)
+			if err != nil {
+				return nil, errors.Errorf("expected an integer of %d bits, got %s", bits, n)
+			}
+			if typ == TypeInt32 {
+				return MakeInt32(int32(i)), nil
+			}
+			return MakeInt(i), nil
+		}
+	case TypeFloat:
+		if n, ok := x.(json.Number); ok {
+			f, err := strconv.ParseFloat(string(n), 64)
+			if err != nil {
+				return nil, err
+			}
+			return MakeFloat(f), nil
+		}
+	case TypeBool:
+		if b, ok := x.(bool); ok {
+			return MakeBool(b), nil
+		}
+	case TypeString:
+		if s, ok := x.(string); ok {
+			return MakeString(s), nil
+		}
+	case TypeBytes:
+		if s, ok := x.(string); ok {
+			b, err := base64.StdEncoding.DecodeString(s)
+			if err != nil {
+				return nil, errors.Errorf("expected base64, got %q", s)
+			}
+			return MakeBytes(b), nil
+		}
+	case TypeTime:
+		if s, ok := x.(string); ok {
+			t, err := time.Parse(time.RFC3339Nano, s)
+			if err != nil {
+				return nil, errors.Errorf("expected a RFC3339 time, got %q", s)
+			}
+			return MakeTime(t), nil
+		}
+	case TypeTuple:
+		if _, ok := x.([]interface{}); ok {
+			return TryToValue(x)
+		}
+	case TypeObject:
+		if _, ok := x.(map[string]interface{}); ok {
+			return TryToValue(x)
+		}
+	default:
+		return TryToValue(x)
+	}
+	return nil, errors.Errorf("expected %s, got %s", typeNames[typ], jsonKind(x))
+}
+
+// typeNames are the names of the types in the errors.
+var typeNames = map[Type]string{
+	TypeNull:      "Null",
+	TypeInt:       "Int",
+	TypeInt32:     "Int32",
+	TypeFloat:     "Float",
+	TypeBool:      "Bool",
+	TypeString:    "String",
+	TypeBytes:     "Bytes",
+	TypeTime:      "Time",
+	TypeDuration:  "Duration",
+	TypeTuple:     "Tuple",
+	TypeObject:    "Object",
+	TypeIPv4:      "IPv4",
+	TypeIPv6:      "IPv6",
+	TypePrefix:    "Prefix",
+	TypeExtension: "Extension",
+	TypeError:     "Error",
+}
+
+func jsonKind(x interface{}) string {
+	switch x.(type) {
+	case json.Number:
+		return "a number"
+	case bool:
+		return "a bool"
+	case string:
+		return "a string"
+	case []interface{}:
+		return "an array"
+	}
+	return "an object"
+}
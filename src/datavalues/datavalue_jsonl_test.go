@@ -0,0 +1,144 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datavalues
+
+import (
+	"bytes"
+	"io"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"base/errors"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeJSONL(t *testing.T) {
+	now := time.Date(2020, 3, 4, 5, 6, 7, 8, time.UTC)
+	rows := make(chan IDataValue, 3)
+	rows <- MakeObject(map[string]IDataValue{
+		"i": MakeInt(-1),
+		"f": MakeFloat(2),
+		"s": MakeString("a\"\n"),
+		"b": MakeBool(true),
+		"t": MakeTime(now),
+		"x": MakeBytes([]byte{0, 1}),
+		"n": MakeNull(),
+	})
+	rows <- MakeObject(map[string]IDataValue{
+		"a": MakeTuple(MakeInt32(1), MakeFloat(math.NaN())),
+		"o": MakeObject(map[string]IDataValue{"k": MakeFloat(1.5)}),
+	})
+	close(rows)
+
+	var buf bytes.Buffer
+	assert.Nil(t, EncodeJSONL(&buf, rows))
+	expect := `{"b":true,"f":2.0,"i":-1,"n":null,"s":"a\"\n","t":"2020-03-04T05:06:07.000000008Z","x":"AAE="}
+{"a":[1,null],"o":{"k":1.5}}
+`
+	assert.Equal(t, expect, buf.String())
+
+	// Only the Object rows.
+	rows = make(chan IDataValue, 1)
+	rows <- MakeInt(1)
+	close(rows)
+	assert.Equal(t, "JSONL row 1 is not an Object", EncodeJSONL(&buf, rows).Error())
+}
+
+func TestDecodeJSONL(t *testing.T) {
+	input := `{"i":1,"f":2,"s":"a","t":"2020-03-04T05:06:07Z","x":"AAE=","other":1.5}
+
+{"i":null,"a":[1,"b"],"o":{"k":true}}
+{"i":
+{"i":"1"}
+[1]
+{"i":1} {"i":2}
+{"i":2147483648}
+{"i":3}`
+	schema := map[string]Type{
+		"i": TypeInt32,
+		"f": TypeFloat,
+		"s": TypeString,
+		"t": TypeTime,
+		"x": TypeBytes,
+	}
+
+	var actual []IDataValue
+	var failures []string
+	rows, errs := DecodeJSONL(strings.NewReader(input), schema)
+	for rows != nil || errs != nil {
+		select {
+		case row, ok := <-rows:
+			if !ok {
+				rows = nil
+				continue
+			}
+			actual = append(actual, row)
+		case err, ok := <-errs:
+			if !ok {
+				errs = nil
+				continue
+			}
+			assert.Equal(t, errors.CANNOT_PARSE_TEXT, errors.Code(err))
+			failures = append(failures, err.Error())
+		}
+	}
+
+	expect := []IDataValue{
+		MakeObject(map[string]IDataValue{
+			"i":     MakeInt32(1),
+			"f":     MakeFloat(2),
+			"s":     MakeString("a"),
+			"t":     MakeTime(time.Date(2020, 3, 4, 5, 6, 7, 0, time.UTC)),
+			"x":     MakeBytes([]byte{0, 1}),
+			"other": MakeFloat(1.5),
+		}),
+		MakeObject(map[string]IDataValue{
+			"i": MakeNull(),
+			"a": MakeTuple(MakeInt(1), MakeString("b")),
+			"o": MakeObject(map[string]IDataValue{"k": MakeBool(true)}),
+		}),
+		MakeObject(map[string]IDataValue{"i": MakeInt32(3)}),
+	}
+	assert.Equal(t, len(expect), len(actual))
+	for i := range expect {
+		assert.True(t, Equals(expect[i], actual[i]), "%v!=%v", expect[i], actual[i])
+	}
+	assert.Equal(t, []string{
+		"Cannot parse JSONL line 4: unexpected EOF (errno 6)",
+		"Cannot parse JSONL line 5: field i: expected Int32, got a string (errno 6)",
+		"Cannot parse JSONL line 6: json: cannot unmarshal array into Go value of type map[string]interface {} (errno 6)",
+		"Cannot parse JSONL line 7: unexpected data after the object (errno 6)",
+		"Cannot parse JSONL line 8: field i: expected an integer of 32 bits, got 2147483648 (errno 6)",
+	}, failures)
+}
+
+func TestJSONLRoundTrip(t *testing.T) {
+	reader, writer := io.Pipe()
+	rows := make(chan IDataValue)
+
+	go func() {
+		for i := 0; i < 10000; i++ {
+			rows <- MakeObject(map[string]IDataValue{"i": MakeInt(int64(i)), "f": MakeFloat(float64(i))})
+		}
+		close(rows)
+	}()
+	go func() {
+		writer.CloseWithError(EncodeJSONL(writer, rows))
+	}()
+
+	var n int
+	decoded, errs := DecodeJSONL(reader, nil)
+	for row := range decoded {
+		fields := AsMap(row)
+		assert.Equal(t, int64(n), AsInt(fields["i"]))
+		assert.Equal(t, TypeFloat, fields["f"].Type())
+		n++
+	}
+	assert.Nil(t, <-errs)
+	assert.Equal(t, 10000, n)
+}
@@ -5,47 +5,148 @@
 package datavalues
 
 import (
-	"fmt"
 	"regexp"
 	"strings"
 
 	"base/lru"
 )
 
-var (
-	re      = regexp.MustCompile(`([^\\]?|[\\]{2})[%_]`)
-	reCache = lru.New(65536)
-)
+var likeCache = lru.New(65536)
+
+type likeCacheKey struct {
+	pattern         string
+	caseInsensitive bool
+}
 
-func replacer(s string) string {
-	if strings.HasPrefix(s, `\\`) {
-		return s[2:]
+// LikeMatcher matches the strings by the pattern of the LIKE: the % is any string, the _ is any one character
+// and the backslash escapes the next character.
+// The patterns of the % only are matched by the prefix, the suffix and the substrings, the others by the regular expression.
+type LikeMatcher struct {
+	match func(s string) bool
+}
+
+// CompileLike compiles the pattern of the LIKE, the case-insensitive one is of the ILIKE.
+func CompileLike(pattern string, caseInsensitive bool) *LikeMatcher {
+	parts, single := splitLike(pattern)
+	if single || caseInsensitive {
+		re := likeToRegexp(pattern, caseInsensitive)
+		return &LikeMatcher{match: re.MatchString}
 	}
 
-	result := strings.Replace(s, "%", ".*", -1)
-	result = strings.Replace(result, "_", ".", -1)
-	return result
+	switch {
+	case len(parts) == 1:
+		return &LikeMatcher{match: func(s string) bool { return s == parts[0] }}
+	case len(parts) == 2 && parts[1] == "":
+		return &LikeMatcher{match: func(s string) bool { return strings.HasPrefix(s, parts[0]) }}
+	case len(parts) == 2 && parts[0] == "":
+		return &LikeMatcher{match: func(s string) bool { return strings.HasSuffix(s, parts[1]) }}
+	case len(parts) == 3 && parts[0] == "" && parts[2] == "":
+		return &LikeMatcher{match: func(s string) bool { return strings.Contains(s, parts[1]) }}
+	}
+	return &LikeMatcher{match: func(s string) bool { return matchLikeParts(parts, s) }}
 }
 
-func LikeToRegexp(likeExpr string) *regexp.Regexp {
-	if likeExpr == "" {
-		return regexp.MustCompile("^.*$") // Can never fail
+func (m *LikeMatcher) Match(s string) bool {
+	return m.match(s)
+}
+
+// splitLike splits the pattern by the %, the escapes are resolved and the adjacent % are one.
+// The single is true if the pattern has the _.
+func splitLike(pattern string) (parts []string, single bool) {
+	var part strings.Builder
+
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; {
+		case c == '\\' && i+1 < len(pattern):
+			i++
+			part.WriteByte(pattern[i])
+		case c == '%':
+			if len(parts) == 0 || part.Len() > 0 {
+				parts = append(parts, part.String())
+				part.Reset()
+			}
+		case c == '_':
+			single = true
+			part.WriteByte(c)
+		default:
+			part.WriteByte(c)
+		}
 	}
+	return append(parts, part.String()), single
+}
 
-	keyPattern := regexp.QuoteMeta(likeExpr)
-	keyPattern = re.ReplaceAllStringFunc(keyPattern, replacer)
-	keyPattern = fmt.Sprintf("^%s$", keyPattern)
-	return regexp.MustCompile(keyPattern) // Can never fail
+// matchLikeParts checks the string starts with the first part, ends with the last one
+// and has the ones between in order, without the overlaps.
+func matchLikeParts(parts []string, s string) bool {
+	first, last := parts[0], parts[len(parts)-1]
+	if len(s) < len(first)+len(last) || !strings.HasPrefix(s, first) || !strings.HasSuffix(s, last) {
+		return false
+	}
+	s = s[len(first) : len(s)-len(last)]
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(s, part)
+		if i < 0 {
+			return false
+		}
+		s = s[i+len(part):]
+	}
+	return true
 }
 
-func Like(likeExpr string, x IDataValue) bool {
-	var re *regexp.Regexp
+// LikeToRegexp converts the pattern of the LIKE to the regular expression matching the whole string.
+func LikeToRegexp(pattern string) *regexp.Regexp {
+	return likeToRegexp(pattern, false)
+}
+
+func likeToRegexp(pattern string, caseInsensitive bool) *regexp.Regexp {
+	var expr strings.Builder
+
+	expr.WriteString("(?s)")
+	if caseInsensitive {
+		expr.WriteString("(?i)")
+	}
+	expr.WriteByte('^')
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; {
+		case c == '\\' && i+1 < len(pattern):
+			i++
+			expr.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+		case c == '%':
+			expr.WriteString(".*")
+		case c == '_':
+			expr.WriteByte('.')
+		default:
+			expr.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+		}
+	}
+	expr.WriteByte('$')
+	return regexp.MustCompile(expr.String()) // Can never fail
+}
+
+// Like checks if the text of the value matches the pattern of the LIKE, the compiled patterns are cached.
+func Like(pattern string, x IDataValue) bool {
+	return cachedLike(pattern, false).Match(likeText(x))
+}
+
+// ILike is the Like ignoring the case.
+func ILike(pattern string, x IDataValue) bool {
+	return cachedLike(pattern, true).Match(likeText(x))
+}
+
+func cachedLike(pattern string, caseInsensitive bool) *LikeMatcher {
+	key := likeCacheKey{pattern: pattern, caseInsensitive: caseInsensitive}
+	if m, ok := likeCache.Get(key); ok {
+		return m.(*LikeMatcher)
+	}
+	m := CompileLike(pattern, caseInsensitive)
+	likeCache.Add(key, m)
+	return m
+}
 
-	if rex, ok := reCache.Get(likeExpr); ok {
-		re = rex.(*regexp.Regexp)
-	} else {
-		re = LikeToRegexp(likeExpr)
-		reCache.Add(likeExpr, re)
+// likeText is the text of the value, the raw bytes of the Bytes rather than their hex.
+func likeText(x IDataValue) string {
+	if x.Family() == FamilyString {
+		return AsString(x)
 	}
-	return re.Match([]byte(x.String()))
+	return x.String()
 }
@@ -0,0 +1,92 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datavalues
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLike(t *testing.T) {
+	tests := []struct {
+		pattern string
+		s       string
+		expect  bool
+	}{
+		{pattern: "", s: "", expect: true},
+		{pattern: "", s: "a", expect: false},
+		{pattern: "abc", s: "abc", expect: true},
+		{pattern: "abc", s: "abcd", expect: false},
+		{pattern: "%", s: "", expect: true},
+		{pattern: "%%", s: "abc", expect: true},
+		{pattern: "ab%", s: "abc", expect: true},
+		{pattern: "ab%", s: "cab", expect: false},
+		{pattern: "%bc", s: "abc", expect: true},
+		{pattern: "%bc", s: "bca", expect: false},
+		{pattern: "%/checkout%", s: "https://shop/checkout?id=1", expect: true},
+		{pattern: "%/checkout%", s: "https://shop/cart", expect: false},
+		{pattern: "a%c", s: "abbc", expect: true},
+		{pattern: "a%a", s: "a", expect: false},
+		{pattern: "a%b%c", s: "aXbYc", expect: true},
+		{pattern: "a%b%c", s: "acb", expect: false},
+		{pattern: "%a%%b%", s: "xaxbx", expect: true},
+		{pattern: "a_c", s: "abc", expect: true},
+		{pattern: "a_c", s: "ac", expect: false},
+		{pattern: "_", s: "é", expect: true},
+		{pattern: "a_%", s: "ab\ncd", expect: true},
+		{pattern: `100\%`, s: "100%", expect: true},
+		{pattern: `100\%`, s: "1000", expect: false},
+		{pattern: `a\_c`, s: "abc", expect: false},
+		{pattern: `a\_c`, s: "a_c", expect: true},
+		{pattern: `a\\%`, s: `a\bc`, expect: true},
+		{pattern: `a\`, s: `a\`, expect: true},
+		{pattern: "a.c", s: "abc", expect: false},
+		{pattern: "(a|b)*", s: "(a|b)*", expect: true},
+		{pattern: "ABC", s: "abc", expect: false},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expect, Like(test.pattern, MakeString(test.s)), "%q LIKE %q", test.s, test.pattern)
+		assert.Equal(t, test.expect, CompileLike(test.pattern, false).Match(test.s), "%q LIKE %q", test.s, test.pattern)
+		assert.Equal(t, test.expect, LikeToRegexp(test.pattern).MatchString(test.s), "%q LIKE %q", test.s, test.pattern)
+	}
+	assert.True(t, Like("1%", MakeInt(123)))
+
+	// The Bytes match by their bytes, not by the hex they are shown as.
+	assert.True(t, Like("%\xab%", MakeBytes([]byte{'a', 0xab})))
+	assert.False(t, Like("%AB%", MakeBytes([]byte{'a', 0xab})))
+}
+
+func TestILike(t *testing.T) {
+	assert.True(t, ILike("abc", MakeString("ABC")))
+	assert.True(t, ILike("%CHECKOUT%", MakeString("/Checkout/1")))
+	assert.True(t, ILike("ä_", MakeString("Äb")))
+	assert.False(t, ILike("a_c", MakeString("ABBC")))
+	assert.False(t, Like("%CHECKOUT%", MakeString("/Checkout/1")))
+}
+
+func BenchmarkLikeSubstring(b *testing.B) {
+	m := CompileLike("%/checkout%", false)
+	s := strings.Repeat("x", 64) + "/checkout" + strings.Repeat("y", 64)
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = m.Match(s)
+	}
+}
+
+func BenchmarkLikeSubstringRegexp(b *testing.B) {
+	re := LikeToRegexp("%/checkout%")
+	s := strings.Repeat("x", 64) + "/checkout" + strings.Repeat("y", 64)
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = re.MatchString(s)
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datavalues
+
+import (
+	"unsafe"
+
+	"base/docs"
+)
+
+type ValueNull struct{}
+
+var null = &ValueNull{}
+
+func MakeNull() IDataValue {
+	return null
+}
+
+func (v *ValueNull) Size() uintptr {
+	return unsafe.Sizeof(v)
+}
+
+func (v *ValueNull) String() string {
+	return "NULL"
+}
+
+func (v *ValueNull) Type() Type {
+	return TypeNull
+}
+
+func (v *ValueNull) Family() Family {
+	return FamilyNull
+}
+
+// Compare sorts the NULLs first.
+func (v *ValueNull) Compare(other IDataValue) (Comparison, error) {
+	if isNullOrZero(other) {
+		return Equal, nil
+	}
+	return LessThan, nil
+}
+
+func (v *ValueNull) Document() docs.Documentation {
+	return docs.Text("Null")
+}
+
+// IsNull checks if the value is the SQL NULL made by MakeNull.
+// The uninitialized value is not the NULL, see IsZero.
+func IsNull(v IDataValue) bool {
+	return v != nil && v.Type() == TypeNull
+}
+
+// IsZero checks if the value is uninitialized: the nil IDataValue or a value of TypeZero.
+// It's not a SQL value at all, it shows up when a default-constructed value
+// flows out of a forgotten assignment, while the NULL is a real value of the nullable column.
+func IsZero(v IDataValue) bool {
+	return v == nil || v.Type() == TypeZero
+}
+
+// isNullOrZero is for the helpers which group, hash and render the uninitialized value as the NULL.
+func isNullOrZero(v IDataValue) bool {
+	return IsZero(v) || IsNull(v)
+}
@@ -0,0 +1,85 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datavalues
+
+import (
+	"sort"
+	"strings"
+	"unsafe"
+
+	"base/docs"
+	"base/errors"
+)
+
+type ValueObject struct {
+	fields map[string]IDataValue
+}
+
+func MakeObject(v map[string]IDataValue) IDataValue {
+	return &ValueObject{fields: v}
+}
+
+func ZeroObject() IDataValue {
+	return &ValueObject{fields: map[string]IDataValue{}}
+}
+
+func (v *ValueObject) Size() uintptr {
+	size := unsafe.Sizeof(*v)
+	for k, field := range v.fields {
+		size += uintptr(len(k)) + field.Size()
+	}
+	return size
+}
+
+// String shows the fields by the key order.
+func (v *ValueObject) String() string {
+	keys := v.Keys()
+	result := make([]string, len(keys))
+	for i, k := range keys {
+		result[i] = k + ":" + v.fields[k].String()
+	}
+	return "{" + strings.Join(result, ", ") + "}"
+}
+
+func (v *ValueObject) Type() Type {
+	return TypeObject
+}
+
+func (v *ValueObject) Family() Family {
+	return FamilyObject
+}
+
+func (v *ValueObject) AsMap() map[string]IDataValue {
+	return v.fields
+}
+
+// Keys returns the sorted keys.
+func (v *ValueObject) Keys() []string {
+	keys := make([]string, 0, len(v.fields))
+	for k := range v.fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Compare orders the objects by their canonical forms, only the equality is meaningful.
+func (v *ValueObject) Compare(other IDataValue) (Comparison, error) {
+	if other.Type() != TypeObject {
+		return 0, errors.Errorf("type mismatch between values, got:%v", other.Type())
+	}
+	return Comparison(strings.Compare(Canonical(v), Canonical(other))), nil
+}
+
+func (v *ValueObject) Document() docs.Documentation {
+	return docs.Text("Object")
+}
+
+func AsMap(v IDataValue) map[string]IDataValue {
+	if t, ok := v.(*ValueObject); ok {
+		return t.fields
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datavalues
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Pretty renders the value for the debugging, the nested tuples and objects go across the lines
+// indented by the indent per level, the object keys are sorted and the strings are quoted.
+func Pretty(v IDataValue, indent string) string {
+	var sb strings.Builder
+	writePretty(&sb, v, indent, "")
+	return sb.String()
+}
+
+func writePretty(sb *strings.Builder, v IDataValue, indent string, prefix string) {
+	switch {
+	case isNullOrZero(v):
+		sb.WriteString("NULL")
+	case v.Type() == TypeString:
+		sb.WriteString(strconv.Quote(AsString(v)))
+	case v.Family() == FamilyTuple:
+		fields := AsSlice(v)
+		if len(fields) == 0 {
+			sb.WriteString("()")
+			return
+		}
+		sb.WriteString("(\n")
+		for i, field := range fields {
+			sb.WriteString(prefix + indent)
+			writePretty(sb, field, indent, prefix+indent)
+			if i < len(fields)-1 {
+				sb.WriteString(",")
+			}
+			sb.WriteString("\n")
+		}
+		sb.WriteString(prefix + ")")
+	case v.Family() == FamilyObject:
+		object := v.(*ValueObject)
+		keys := object.Keys()
+		if len(keys) == 0 {
+			sb.WriteString("{}")
+			return
+		}
+		sb.WriteString("{\n")
+		for i, k := range keys {
+			sb.WriteString(prefix + indent)
+			sb.WriteString(strconv.Quote(k))
+			sb.WriteString(": ")
+			writePretty(sb, object.fields[k], indent, prefix+indent)
+			if i < len(keys)-1 {
+				sb.WriteString(",")
+			}
+			sb.WriteString("\n")
+		}
+		sb.WriteString(prefix + "}")
+	default:
+		sb.WriteString(v.String())
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datavalues
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPretty(t *testing.T) {
+	tests := []struct {
+		name   string
+		value  IDataValue
+		expect string
+	}{
+		{
+			name:   "scalar",
+			value:  MakeInt(1),
+			expect: "1",
+		},
+		{
+			name:   "string",
+			value:  MakeString("a\"b"),
+			expect: "\"a\\\"b\"",
+		},
+		{
+			name:   "null",
+			value:  MakeNull(),
+			expect: "NULL",
+		},
+		{
+			name:   "empty",
+			value:  MakeTuple(ZeroTuple(), ZeroObject()),
+			expect: "(\n  (),\n  {}\n)",
+		},
+		{
+			name: "nested",
+			value: ToValue(map[string]interface{}{
+				"name": "x",
+				"ids":  []interface{}{1, []interface{}{2, 3}},
+				"attr": map[string]interface{}{"b": 1.5, "a": true},
+			}),
+			expect: `{
+  "attr": {
+    "a": true,
+    "b": 1.5E+00
+  },
+  "ids": (
+    1,
+    (
+      2,
+      3
+    )
+  ),
+  "name": "x"
+}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expect, Pretty(test.value, "  "))
+		})
+	}
+}
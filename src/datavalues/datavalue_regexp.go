@@ -0,0 +1,79 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datavalues
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Extract is the first capture group of the first match, the whole match if the regexp has no groups.
+// It's empty if nothing matches.
+func Extract(re *regexp.Regexp, s string) string {
+	m := re.FindStringSubmatch(s)
+	switch {
+	case m == nil:
+		return ""
+	case len(m) > 1:
+		return m[1]
+	}
+	return m[0]
+}
+
+// ExtractAll is the Extract of all the matches, which don't overlap.
+func ExtractAll(re *regexp.Regexp, s string) []string {
+	group := 0
+	if re.NumSubexp() > 0 {
+		group = 1
+	}
+	matches := re.FindAllStringSubmatch(s, -1)
+	out := make([]string, len(matches))
+	for i, m := range matches {
+		out[i] = m[group]
+	}
+	return out
+}
+
+// RegexpTemplate converts the replacement with \0 for the whole match and \1 to \9 for the groups, as of ClickHouse,
+// to the template of regexp.Expand. The \\ is the backslash, the $ is literal.
+func RegexpTemplate(replacement string) string {
+	var sb strings.Builder
+	for i := 0; i < len(replacement); i++ {
+		c := replacement[i]
+		switch {
+		case c == '\\' && i+1 < len(replacement) && replacement[i+1] >= '0' && replacement[i+1] <= '9':
+			i++
+			sb.WriteString("${")
+			sb.WriteByte(replacement[i])
+			sb.WriteString("}")
+		case c == '\\' && i+1 < len(replacement) && replacement[i+1] == '\\':
+			i++
+			sb.WriteByte('\\')
+		case c == '$':
+			sb.WriteString("$$")
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	return sb.String()
+}
+
+// ReplaceRegexpOne replaces the first match by the template of RegexpTemplate.
+func ReplaceRegexpOne(re *regexp.Regexp, s string, template string) string {
+	loc := re.FindStringSubmatchIndex(s)
+	if loc == nil {
+		return s
+	}
+	b := make([]byte, 0, len(s)+len(template))
+	b = append(b, s[:loc[0]]...)
+	b = re.ExpandString(b, template, s, loc)
+	b = append(b, s[loc[1]:]...)
+	return string(b)
+}
+
+// ReplaceRegexpAll replaces all the matches by the template of RegexpTemplate.
+func ReplaceRegexpAll(re *regexp.Regexp, s string, template string) string {
+	return re.ReplaceAllString(s, template)
+}
@@ -0,0 +1,48 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datavalues
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtract(t *testing.T) {
+	assert.Equal(t, "123", Extract(regexp.MustCompile(`id=(\d+)`), "/a?id=123&id=456"))
+	assert.Equal(t, "id=123", Extract(regexp.MustCompile(`id=\d+`), "/a?id=123&id=456"))
+	assert.Equal(t, "", Extract(regexp.MustCompile(`x(\d+)`), "/a?id=123"))
+	// The group not taking part in the match is empty.
+	assert.Equal(t, "", Extract(regexp.MustCompile(`(x)?\d`), "1"))
+
+	assert.Equal(t, []string{"123", "456"}, ExtractAll(regexp.MustCompile(`id=(\d+)`), "/a?id=123&id=456"))
+	assert.Equal(t, []string{"id=123", "id=456"}, ExtractAll(regexp.MustCompile(`id=\d+`), "/a?id=123&id=456"))
+	assert.Equal(t, []string{}, ExtractAll(regexp.MustCompile(`x`), "abc"))
+}
+
+func TestReplaceRegexp(t *testing.T) {
+	tests := []struct {
+		replacement string
+		template    string
+		one         string
+		all         string
+	}{
+		{replacement: `<\1>`, template: `<${1}>`, one: "a<1>b2", all: "a<1>b<2>"},
+		{replacement: `[\0]`, template: `[${0}]`, one: "a[1]b2", all: "a[1]b[2]"},
+		{replacement: `$1`, template: `$$1`, one: "a$1b2", all: "a$1b$1"},
+		{replacement: `\\`, template: `\`, one: `a\b2`, all: `a\b\`},
+		{replacement: `\x`, template: `\x`, one: `a\xb2`, all: `a\xb\x`},
+	}
+
+	re := regexp.MustCompile(`(\d)`)
+	for _, test := range tests {
+		template := RegexpTemplate(test.replacement)
+		assert.Equal(t, test.template, template, test.replacement)
+		assert.Equal(t, test.one, ReplaceRegexpOne(re, "a1b2", template), test.replacement)
+		assert.Equal(t, test.all, ReplaceRegexpAll(re, "a1b2", template), test.replacement)
+	}
+	assert.Equal(t, "abc", ReplaceRegexpOne(re, "abc", "x"))
+}
@@ -0,0 +1,76 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datavalues
+
+// ValueSet is the set of the values by Hash and Equals, keeping the insertion order.
+type ValueSet struct {
+	buckets map[uint64][]int
+	values  []IDataValue
+}
+
+func NewValueSet(values ...IDataValue) *ValueSet {
+	set := &ValueSet{
+		buckets: make(map[uint64][]int),
+	}
+	for _, v := range values {
+		set.Add(v)
+	}
+	return set
+}
+
+// Add adds the value, returns false if it's already in the set.
+func (set *ValueSet) Add(v IDataValue) bool {
+	h := Hash(v)
+	if set.find(h, v) {
+		return false
+	}
+	set.buckets[h] = append(set.buckets[h], len(set.values))
+	set.values = append(set.values, v)
+	return true
+}
+
+func (set *ValueSet) Contains(v IDataValue) bool {
+	return set.find(Hash(v), v)
+}
+
+func (set *ValueSet) find(h uint64, v IDataValue) bool {
+	for _, i := range set.buckets[h] {
+		if Equals(set.values[i], v) {
+			return true
+		}
+	}
+	return false
+}
+
+func (set *ValueSet) Len() int {
+	return len(set.values)
+}
+
+// Union returns a new set with the values of both, this set's values first.
+func (set *ValueSet) Union(other *ValueSet) *ValueSet {
+	result := NewValueSet(set.values...)
+	for _, v := range other.values {
+		result.Add(v)
+	}
+	return result
+}
+
+// Intersect returns a new set with the values in both, in this set's order.
+func (set *ValueSet) Intersect(other *ValueSet) *ValueSet {
+	result := NewValueSet()
+	for _, v := range set.values {
+		if other.Contains(v) {
+			result.Add(v)
+		}
+	}
+	return result
+}
+
+// ToSlice returns the values in the insertion order.
+func (set *ValueSet) ToSlice() []IDataValue {
+	out := make([]IDataValue, len(set.values))
+	copy(out, set.values)
+	return out
+}
@@ -0,0 +1,96 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datavalues
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValueSet(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []IDataValue
+		expect []IDataValue
+	}{
+		{
+			name:   "int-string",
+			values: []IDataValue{MakeInt(1), MakeString("1"), MakeInt32(1), MakeString("1")},
+			expect: []IDataValue{MakeInt(1), MakeString("1")},
+		},
+		{
+			name:   "null",
+			values: []IDataValue{MakeNull(), MakeInt(0), nil, MakeString(""), MakeNull()},
+			expect: []IDataValue{MakeNull(), MakeInt(0), MakeString("")},
+		},
+		{
+			name:   "float",
+			values: []IDataValue{MakeFloat(math.NaN()), MakeFloat(0), MakeFloat(math.Copysign(0, -1)), MakeFloat(math.NaN()), MakeInt(0)},
+			expect: []IDataValue{MakeFloat(math.NaN()), MakeFloat(0), MakeInt(0)},
+		},
+		{
+			name:   "bool-time",
+			values: []IDataValue{MakeBool(true), MakeInt(1), MakeBool(true), MakeTime(time.Unix(1, 0)), MakeTime(time.Unix(1, 0).UTC())},
+			expect: []IDataValue{MakeBool(true), MakeInt(1), MakeTime(time.Unix(1, 0))},
+		},
+		{
+			name: "tuple",
+			values: []IDataValue{
+				MakeTuple(MakeInt(1), MakeString("a")),
+				MakeTuple(MakeInt(1)),
+				MakeTuple(MakeInt32(1), MakeString("a")),
+				MakeTuple(MakeString("a"), MakeInt(1)),
+			},
+			expect: []IDataValue{
+				MakeTuple(MakeInt(1), MakeString("a")),
+				MakeTuple(MakeInt(1)),
+				MakeTuple(MakeString("a"), MakeInt(1)),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			set := NewValueSet(test.values...)
+			actual := set.ToSlice()
+			assert.Equal(t, len(test.expect), set.Len())
+			assert.Equal(t, len(test.expect), len(actual))
+			for i := range test.expect {
+				assert.True(t, Equals(test.expect[i], actual[i]), "%v!=%v", test.expect[i], actual[i])
+				assert.True(t, set.Contains(test.expect[i]))
+			}
+		})
+	}
+}
+
+func TestValueSetOperations(t *testing.T) {
+	a := NewValueSet(MakeInt(1), MakeInt(2), MakeNull(), MakeString("x"))
+	b := NewValueSet(MakeString("1"), MakeInt32(2), MakeNull(), MakeInt(3))
+
+	assert.False(t, a.Add(MakeInt32(2)))
+	assert.True(t, a.Add(MakeString("2")))
+	assert.False(t, a.Contains(MakeString("1")))
+	assert.True(t, a.Contains(nil))
+
+	union := a.Union(b)
+	assert.Equal(t, "1 2 NULL x 2 1 3", join(union.ToSlice()))
+	intersect := a.Intersect(b)
+	assert.Equal(t, "2 NULL", join(intersect.ToSlice()))
+	assert.Equal(t, 5, a.Len())
+}
+
+func join(values []IDataValue) string {
+	s := ""
+	for i, v := range values {
+		if i > 0 {
+			s += " "
+		}
+		s += v.String()
+	}
+	return s
+}
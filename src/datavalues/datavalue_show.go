@@ -0,0 +1,85 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datavalues
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Show renders the value in one line for the messages: the strings are quoted, the NULL is NULL,
+// the tuples are (1, "a") and the objects {"a": 1} with the keys sorted.
+func Show(v IDataValue) string {
+	var sb strings.Builder
+	writeShow(&sb, v)
+	return sb.String()
+}
+
+func writeShow(sb *strings.Builder, v IDataValue) {
+	switch {
+	case isNullOrZero(v):
+		sb.WriteString("NULL")
+	case v.Type() == TypeString:
+		sb.WriteString(strconv.Quote(AsString(v)))
+	case v.Family() == FamilyTuple:
+		sb.WriteString("(")
+		for i, field := range AsSlice(v) {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			writeShow(sb, field)
+		}
+		sb.WriteString(")")
+	case v.Family() == FamilyObject:
+		object := v.(*ValueObject)
+		sb.WriteString("{")
+		for i, k := range object.Keys() {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			sb.WriteString(strconv.Quote(k))
+			sb.WriteString(": ")
+			writeShow(sb, object.fields[k])
+		}
+		sb.WriteString("}")
+	default:
+		sb.WriteString(v.String())
+	}
+}
+
+// Sprintf is fmt.Sprintf of the values, the %v and the %s render them by Show.
+// The other verbs take the Go value of the int, the float and the bool, such as the %d and the %.2f,
+// and the String of the others.
+func Sprintf(format string, args ...IDataValue) string {
+	shown := make([]interface{}, len(args))
+	for i, arg := range args {
+		shown[i] = showFormatter{arg}
+	}
+	return fmt.Sprintf(format, shown...)
+}
+
+type showFormatter struct {
+	v IDataValue
+}
+
+func (s showFormatter) Format(f fmt.State, verb rune) {
+	directive := fmt.FormatString(f, verb)
+	v := s.v
+	switch {
+	case verb == 'v' || verb == 's':
+		fmt.Fprintf(f, directive, Show(v))
+	case isNullOrZero(v):
+		fmt.Fprintf(f, directive, "NULL")
+	case v.Family() == FamilyInt:
+		fmt.Fprintf(f, directive, AsInt(v))
+	case v.Family() == FamilyFloat:
+		fmt.Fprintf(f, directive, AsFloat(v))
+	case v.Family() == FamilyBool:
+		fmt.Fprintf(f, directive, AsBool(v))
+	default:
+		fmt.Fprintf(f, directive, v.String())
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datavalues
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShow(t *testing.T) {
+	tests := []struct {
+		name   string
+		value  IDataValue
+		expect string
+	}{
+		{
+			name:   "int",
+			value:  MakeInt(1),
+			expect: "1",
+		},
+		{
+			name:   "string",
+			value:  MakeString("a\"b"),
+			expect: `"a\"b"`,
+		},
+		{
+			name:   "null",
+			value:  MakeNull(),
+			expect: "NULL",
+		},
+		{
+			name:   "zero",
+			value:  nil,
+			expect: "NULL",
+		},
+		{
+			name: "nested",
+			value: ToValue(map[string]interface{}{
+				"name": "x",
+				"ids":  []interface{}{1, []interface{}{2, "3"}, MakeNull()},
+			}),
+			expect: `{"ids": (1, (2, "3"), NULL), "name": "x"}`,
+		},
+		{
+			name:   "empty",
+			value:  MakeTuple(ZeroTuple(), ZeroObject()),
+			expect: "((), {})",
+		},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expect, Show(test.value), test.name)
+	}
+}
+
+func TestSprintf(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		args   []IDataValue
+		expect string
+	}{
+		{
+			name:   "show",
+			format: "Cannot convert %v to %s",
+			args:   []IDataValue{MakeString("abc"), MakeNull()},
+			expect: `Cannot convert "abc" to NULL`,
+		},
+		{
+			name:   "width",
+			format: "[%8v]",
+			args:   []IDataValue{MakeString("a")},
+			expect: `[     "a"]`,
+		},
+		{
+			name:   "native",
+			format: "%d rows, %.2f%%, %t, %x",
+			args:   []IDataValue{MakeInt(3), MakeFloat(0.5), MakeBool(true), MakeString("a")},
+			expect: "3 rows, 0.50%, true, 61",
+		},
+		{
+			name:   "index",
+			format: "%[2]v after %[1]v",
+			args:   []IDataValue{MakeInt(1), MakeTuple(MakeInt(2), MakeString("b"))},
+			expect: `(2, "b") after 1`,
+		},
+		{
+			name:   "missing",
+			format: "%v %v",
+			args:   []IDataValue{MakeInt(1)},
+			expect: "1 %!v(MISSING)",
+		},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expect, Sprintf(test.format, test.args...), test.name)
+	}
+}
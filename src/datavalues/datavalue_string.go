@@ -43,8 +43,13 @@ func (v *ValueString) AsString() string {
 	return string(*v)
 }
 
+// AsBytes returns a copy of the string bytes.
+func (v *ValueString) AsBytes() []byte {
+	return []byte(*v)
+}
+
 func (v *ValueString) Compare(other IDataValue) (Comparison, error) {
-	if other.Type() != TypeString {
+	if other.Family() != FamilyString {
 		return 0, errors.Errorf("type mismatch between values")
 	}
 
@@ -65,7 +70,10 @@ func (v *ValueString) Document() docs.Documentation {
 }
 
 func AsString(v IDataValue) string {
-	if t, ok := v.(*ValueString); ok {
+	switch t := v.(type) {
+	case *ValueString:
+		return string(*t)
+	case *ValueBytes:
 		return string(*t)
 	}
 	return ""
@@ -0,0 +1,102 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datavalues
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Lower maps the ASCII letters to the lower case, the other bytes are kept as they are.
+// The string is returned as is if there is nothing to map.
+func Lower(s string) string {
+	return mapASCII(s, 'A', 'Z', 'a'-'A')
+}
+
+// Upper maps the ASCII letters to the upper case, the other bytes are kept as they are.
+func Upper(s string) string {
+	return mapASCII(s, 'a', 'z', -('a' - 'A'))
+}
+
+func mapASCII(s string, from, to byte, delta int) string {
+	i := 0
+	for i < len(s) && (s[i] < from || s[i] > to) {
+		i++
+	}
+	if i == len(s) {
+		return s
+	}
+
+	b := make([]byte, len(s))
+	copy(b, s[:i])
+	for ; i < len(s); i++ {
+		c := s[i]
+		if c >= from && c <= to {
+			c = byte(int(c) + delta)
+		}
+		b[i] = c
+	}
+	return string(b)
+}
+
+// LowerUTF8 is the Unicode lower case mapping of the code points, the invalid UTF-8 bytes are kept.
+func LowerUTF8(s string) string {
+	return mapUTF8(s, strings.ToLower)
+}
+
+// UpperUTF8 is the Unicode upper case mapping of the code points, the invalid UTF-8 bytes are kept.
+func UpperUTF8(s string) string {
+	return mapUTF8(s, strings.ToUpper)
+}
+
+// mapUTF8 maps the valid runs of the string, strings.ToLower and strings.ToUpper replace the invalid bytes.
+func mapUTF8(s string, fn func(string) string) string {
+	if utf8.ValidString(s) {
+		return fn(s)
+	}
+
+	var sb strings.Builder
+	sb.Grow(len(s))
+	start := 0
+	for i := 0; i < len(s); {
+		r, n := utf8.DecodeRuneInString(s[i:])
+		if r == utf8.RuneError && n == 1 {
+			sb.WriteString(fn(s[start:i]))
+			sb.WriteByte(s[i])
+			start = i + 1
+		}
+		i += n
+	}
+	sb.WriteString(fn(s[start:]))
+	return sb.String()
+}
+
+// LengthUTF8 is the number of the code points, an invalid UTF-8 byte is one.
+func LengthUTF8(s string) int {
+	return utf8.RuneCountInString(s)
+}
+
+// Substring is the length bytes of the string from the offset, 1-based: the offset 1 is the first byte
+// and the negative one counts from the end, -1 is the last byte. The length is to the end of the string at most.
+// The out of the range ones are empty: the offset 0, beyond either end, or the negative length.
+func Substring(s string, offset int64, length int64) string {
+	n := int64(len(s))
+	var start int64
+	switch {
+	case offset > 0:
+		start = offset - 1
+	case offset < 0:
+		start = n + offset
+	default:
+		return ""
+	}
+	if start < 0 || start >= n || length < 0 {
+		return ""
+	}
+	if length > n-start {
+		length = n - start
+	}
+	return s[start : start+length]
+}
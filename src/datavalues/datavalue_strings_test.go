@@ -0,0 +1,62 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datavalues
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStringCases(t *testing.T) {
+	tests := []struct {
+		s                    string
+		lower, upper         string
+		lowerUTF8, upperUTF8 string
+	}{
+		{s: "", lower: "", upper: "", lowerUTF8: "", upperUTF8: ""},
+		{s: "Hello, World!", lower: "hello, world!", upper: "HELLO, WORLD!", lowerUTF8: "hello, world!", upperUTF8: "HELLO, WORLD!"},
+		{s: "ÀÉÎõü", lower: "ÀÉÎõü", upper: "ÀÉÎõü", lowerUTF8: "àéîõü", upperUTF8: "ÀÉÎÕÜ"},
+		{s: "Straße Σ", lower: "straße Σ", upper: "STRAßE Σ", lowerUTF8: "straße σ", upperUTF8: "STRAßE Σ"},
+		{s: "Ab\xffCd", lower: "ab\xffcd", upper: "AB\xffCD", lowerUTF8: "ab\xffcd", upperUTF8: "AB\xffCD"},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.lower, Lower(test.s), test.s)
+		assert.Equal(t, test.upper, Upper(test.s), test.s)
+		assert.Equal(t, test.lowerUTF8, LowerUTF8(test.s), test.s)
+		assert.Equal(t, test.upperUTF8, UpperUTF8(test.s), test.s)
+	}
+}
+
+func TestStringLengthAndSubstring(t *testing.T) {
+	assert.Equal(t, 0, LengthUTF8(""))
+	assert.Equal(t, 5, LengthUTF8("héllo"))
+	assert.Equal(t, 6, len("héllo"))
+	assert.Equal(t, 2, LengthUTF8("日本"))
+	assert.Equal(t, 3, LengthUTF8("a\xffb"))
+
+	tests := []struct {
+		s              string
+		offset, length int64
+		expect         string
+	}{
+		{s: "hello", offset: 1, length: 2, expect: "he"},
+		{s: "hello", offset: 2, length: 100, expect: "ello"},
+		{s: "hello", offset: 5, length: 1, expect: "o"},
+		{s: "hello", offset: -1, length: 1, expect: "o"},
+		{s: "hello", offset: -3, length: 2, expect: "ll"},
+		{s: "hello", offset: -5, length: 5, expect: "hello"},
+		{s: "hello", offset: 3, length: 0, expect: ""},
+		{s: "hello", offset: 0, length: 2, expect: ""},
+		{s: "hello", offset: 6, length: 2, expect: ""},
+		{s: "hello", offset: -6, length: 2, expect: ""},
+		{s: "hello", offset: 1, length: -1, expect: ""},
+		{s: "", offset: 1, length: 1, expect: ""},
+		{s: "héllo", offset: 2, length: 2, expect: "é"},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.expect, Substring(test.s, test.offset, test.length), "%q %v %v", test.s, test.offset, test.length)
+	}
+}
@@ -0,0 +1,343 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datavalues
+
+import (
+	"reflect"
+	"strings"
+	"time"
+
+	"base/errors"
+)
+
+const structTag = "vsql"
+
+var (
+	timeType      = reflect.TypeOf(time.Time{})
+	dataValueType = reflect.TypeOf((*IDataValue)(nil)).Elem()
+	binaryType    = reflect.TypeOf(Binary(nil))
+)
+
+// structField is the exported field of a struct with its key, from the vsql tag:
+// `vsql:"name"`, `vsql:"name,omitempty"`, `vsql:",omitempty"` or `vsql:"-"` to skip it.
+type structField struct {
+	index     int
+	key       string
+	omitEmpty bool
+}
+
+func structFields(t reflect.Type) []structField {
+	var fields []structField
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" {
+			continue
+		}
+		field := structField{index: i, key: f.Name}
+		if tag, ok := f.Tag.Lookup(structTag); ok {
+			if tag == "-" {
+				continue
+			}
+			parts := strings.Split(tag, ",")
+			if parts[0] != "" {
+				field.key = parts[0]
+			}
+			for _, opt := range parts[1:] {
+				if opt == "omitempty" {
+					field.omitEmpty = true
+				}
+			}
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// StructToValue converts the struct, or the pointer to it, to an Object of its exported fields.
+// The nested structs, slices, maps and pointers are converted too, the nil pointer is NULL,
+// the values go through the normalization of ToValue.
+func StructToValue(v interface{}) (IDataValue, error) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct || rv.Type() == timeType {
+		return nil, errors.Errorf("StructToValue expects a struct, got:%T", v)
+	}
+	return reflectToValue(rv, rv.Type().Name())
+}
+
+func reflectToValue(rv reflect.Value, path string) (IDataValue, error) {
+	if rv.Type().Implements(dataValueType) {
+		if (rv.Kind() == reflect.Ptr || rv.Kind() == reflect.Interface) && rv.IsNil() {
+			return MakeNull(), nil
+		}
+		return rv.Interface().(IDataValue), nil
+	}
+
+	switch rv.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if rv.IsNil() {
+			return MakeNull(), nil
+		}
+		return reflectToValue(rv.Elem(), path)
+	case reflect.Struct:
+		if rv.Type() == timeType {
+			return MakeTime(rv.Interface().(time.Time)), nil
+		}
+		fields := structFields(rv.Type())
+		out := make(map[string]IDataValue, len(fields))
+		for _, field := range fields {
+			fv := rv.Field(field.index)
+			if field.omitEmpty && fv.IsZero() {
+				continue
+			}
+			v, err := reflectToValue(fv, path+"."+field.key)
+			if err != nil {
+				return nil, err
+			}
+			out[field.key] = v
+		}
+		return MakeObject(out), nil
+	case reflect.Slice, reflect.Array:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			// The Binary fields are the Bytes, the other byte slices the String as ToValue does.
+			if rv.Type() == binaryType {
+				return MakeBytes(rv.Bytes()), nil
+			}
+			if rv.Kind() == reflect.Slice {
+				return MakeString(string(rv.Bytes())), nil
+			}
+			bs := make([]byte, rv.Len())
+			reflect.Copy(reflect.ValueOf(bs), rv)
+			return MakeString(string(bs)), nil
+		}
+		out := make([]IDataValue, rv.Len())
+		for i := range out {
+			v, err := reflectToValue(rv.Index(i), path)
+			if err != nil {
+				return nil, err
+			}
+			out[i] = v
+		}
+		return MakeTuple(out...), nil
+	case reflect.Map:
+		if rv.Type().Key().Kind() != reflect.String {
+			return nil, errors.Errorf("Unsupported map key type:%v of %s", rv.Type().Key(), path)
+		}
+		out := make(map[string]IDataValue, rv.Len())
+		iter := rv.MapRange()
+		for iter.Next() {
+			key := iter.Key().String()
+			v, err := reflectToValue(iter.Value(), path+"."+key)
+			if err != nil {
+				return nil, err
+			}
+			out[key] = v
+		}
+		return MakeObject(out), nil
+	case reflect.Bool:
+		return MakeBool(rv.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32:
+		return MakeInt32(int32(rv.Int())), nil
+	case reflect.Int64:
+		return MakeInt(rv.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return MakeInt(int64(rv.Uint())), nil
+	case reflect.Float32, reflect.Float64:
+		return MakeFloat(rv.Float()), nil
+	case reflect.String:
+		return MakeString(rv.String()), nil
+	}
+	return nil, errors.Errorf("Unsupported type:%v of %s", rv.Type(), path)
+}
+
+// ValueToStruct sets the fields of the struct dest points to from the Object, the inverse of StructToValue.
+// The keys missing in the object leave the fields as they are, NULL sets the zero value.
+func ValueToStruct(val IDataValue, dest interface{}) error {
+	rv := reflect.ValueOf(dest)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return errors.Errorf("ValueToStruct expects a pointer to struct, got:%T", dest)
+	}
+	return valueToReflect(val, rv.Elem(), rv.Elem().Type().Name())
+}
+
+func valueToReflect(v IDataValue, rv reflect.Value, path string) error {
+	t := rv.Type()
+	if t == dataValueType {
+		rv.Set(reflect.ValueOf(v))
+		return nil
+	}
+	if IsNull(v) {
+		rv.Set(reflect.Zero(t))
+		return nil
+	}
+
+	mismatch := func() error {
+		return errors.Errorf("Cannot set %s of type %v from %v", path, t, v.Type())
+	}
+	switch t.Kind() {
+	case reflect.Ptr:
+		elem := reflect.New(t.Elem())
+		if err := valueToReflect(v, elem.Elem(), path); err != nil {
+			return err
+		}
+		rv.Set(elem)
+		return nil
+	case reflect.Interface:
+		if t.NumMethod() != 0 {
+			return mismatch()
+		}
+		x, err := toInterface(v)
+		if err != nil {
+			return err
+		}
+		rv.Set(reflect.ValueOf(x))
+		return nil
+	case reflect.Struct:
+		if t == timeType {
+			if v.Type() != TypeTime {
+				return mismatch()
+			}
+			rv.Set(reflect.ValueOf(AsTime(v)))
+			return nil
+		}
+		if v.Type() != TypeObject {
+			return mismatch()
+		}
+		fields := AsMap(v)
+		for _, field := range structFields(t) {
+			if x, ok := fields[field.key]; ok {
+				if err := valueToReflect(x, rv.Field(field.index), path+"."+field.key); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 && v.Family() == FamilyString {
+			rv.SetBytes(append([]byte(nil), stringOrBytes(v)...))
+			return nil
+		}
+		if v.Type() != TypeTuple {
+			return mismatch()
+		}
+		items := AsSlice(v)
+		slice := reflect.MakeSlice(t, len(items), len(items))
+		for i, item := range items {
+			if err := valueToReflect(item, slice.Index(i), path); err != nil {
+				return err
+			}
+		}
+		rv.Set(slice)
+		return nil
+	case reflect.Map:
+		if t.Key().Kind() != reflect.String || v.Type() != TypeObject {
+			return mismatch()
+		}
+		fields := AsMap(v)
+		m := reflect.MakeMapWithSize(t, len(fields))
+		for k, x := range fields {
+			elem := reflect.New(t.Elem()).Elem()
+			if err := valueToReflect(x, elem, path+"."+k); err != nil {
+				return err
+			}
+			m.SetMapIndex(reflect.ValueOf(k).Convert(t.Key()), elem)
+		}
+		rv.Set(m)
+		return nil
+	case reflect.Bool:
+		if v.Family() != FamilyBool {
+			return mismatch()
+		}
+		rv.SetBool(AsBool(v))
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if v.Family() != FamilyInt {
+			return mismatch()
+		}
+		if rv.OverflowInt(AsInt(v)) {
+			return errors.Errorf("Value %v overflows %s of type %v", v, path, t)
+		}
+		rv.SetInt(AsInt(v))
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if v.Family() != FamilyInt {
+			return mismatch()
+		}
+		// The uint64 beyond int64 wrapped as the negative Int, see ToValue.
+		if t.Kind() != reflect.Uint64 && (AsInt(v) < 0 || rv.OverflowUint(uint64(AsInt(v)))) {
+			return errors.Errorf("Value %v overflows %s of type %v", v, path, t)
+		}
+		rv.SetUint(uint64(AsInt(v)))
+		return nil
+	case reflect.Float32, reflect.Float64:
+		switch v.Family() {
+		case FamilyFloat:
+			rv.SetFloat(AsFloat(v))
+		case FamilyInt:
+			rv.SetFloat(float64(AsInt(v)))
+		default:
+			return mismatch()
+		}
+		return nil
+	case reflect.String:
+		if v.Family() != FamilyString {
+			return mismatch()
+		}
+		rv.SetString(AsString(v))
+		return nil
+	}
+	return errors.Errorf("Unsupported type:%v of %s", t, path)
+}
+
+// toInterface is the plain Go value of the value, for the interface{} fields.
+func toInterface(v IDataValue) (interface{}, error) {
+	switch v.Family() {
+	case FamilyBool:
+		return AsBool(v), nil
+	case FamilyInt:
+		return AsInt(v), nil
+	case FamilyFloat:
+		return AsFloat(v), nil
+	case FamilyString:
+		if v.Type() == TypeBytes {
+			return AsBytes(v), nil
+		}
+		return AsString(v), nil
+	case FamilyTime:
+		return AsTime(v), nil
+	case FamilyTuple:
+		items := AsSlice(v)
+		out := make([]interface{}, len(items))
+		for i, item := range items {
+			x, err := toInterface(item)
+			if err != nil {
+				return nil, err
+			}
+			out[i] = x
+		}
+		return out, nil
+	case FamilyObject:
+		fields := AsMap(v)
+		out := make(map[string]interface{}, len(fields))
+		for k, item := range fields {
+			x, err := toInterface(item)
+			if err != nil {
+				return nil, err
+			}
+			out[k] = x
+		}
+		return out, nil
+	}
+	return nil, errors.Errorf("Unsupported value type:%v", v.Type())
+}
+
+func stringOrBytes(v IDataValue) []byte {
+	if v.Type() == TypeBytes {
+		return AsBytes(v)
+	}
+	return []byte(AsString(v))
+}
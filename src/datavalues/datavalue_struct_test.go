@@ -0,0 +1,148 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datavalues
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testAddress struct {
+	City string `vsql:"city"`
+	Zip  *int   `vsql:"zip"`
+}
+
+type testUser struct {
+	ID       int64             `vsql:"id"`
+	Name     string            `vsql:"name"`
+	Nick     string            `vsql:"nick,omitempty"`
+	Age      uint8             `vsql:"age"`
+	Score    float64           `vsql:"score"`
+	Admin    bool              `vsql:"admin"`
+	Tags     []string          `vsql:"tags"`
+	Raw      []byte            `vsql:"raw"`
+	Blob     Binary            `vsql:"blob"`
+	Joined   time.Time         `vsql:"joined"`
+	Address  testAddress       `vsql:"address"`
+	Previous *testAddress      `vsql:"previous"`
+	Labels   map[string]string `vsql:"labels,omitempty"`
+	Extra    interface{}       `vsql:"extra"`
+	Value    IDataValue        `vsql:"value"`
+	Ignored  string            `vsql:"-"`
+	Plain    int32
+	secret   string
+}
+
+func TestStructToValue(t *testing.T) {
+	zip := 10001
+	joined := time.Date(2020, 2, 3, 4, 5, 6, 0, time.UTC)
+	user := testUser{
+		ID:      1,
+		Name:    "alice",
+		Age:     30,
+		Score:   1.5,
+		Admin:   true,
+		Tags:    []string{"a", "b"},
+		Raw:     []byte{1, 2},
+		Blob:    Binary{3, 4},
+		Joined:  joined,
+		Address: testAddress{City: "NYC", Zip: &zip},
+		Extra:   "x",
+		Value:   MakeInt(7),
+		Ignored: "ignored",
+		Plain:   3,
+		secret:  "secret",
+	}
+
+	actual, err := StructToValue(&user)
+	assert.Nil(t, err)
+	expect := MakeObject(map[string]IDataValue{
+		"id":       MakeInt(1),
+		"name":     MakeString("alice"),
+		"age":      MakeInt(30),
+		"score":    MakeFloat(1.5),
+		"admin":    MakeBool(true),
+		"tags":     MakeTuple(MakeString("a"), MakeString("b")),
+		"raw":      MakeString("\x01\x02"),
+		"blob":     MakeBytes([]byte{3, 4}),
+		"joined":   MakeTime(joined),
+		"address":  MakeObject(map[string]IDataValue{"city": MakeString("NYC"), "zip": MakeInt32(10001)}),
+		"previous": MakeNull(),
+		"extra":    MakeString("x"),
+		"value":    MakeInt(7),
+		"Plain":    MakeInt32(3),
+	})
+	assert.Equal(t, expect, actual)
+
+	// And back.
+	var back testUser
+	assert.Nil(t, ValueToStruct(actual, &back))
+	user.Ignored, user.secret = "", ""
+	user.Extra = "x"
+	assert.Equal(t, user, back)
+
+	// The errors.
+	_, err = StructToValue(1)
+	assert.Equal(t, "StructToValue expects a struct, got:int", err.Error())
+	_, err = StructToValue(struct{ C chan int }{})
+	assert.Equal(t, "Unsupported type:chan int of .C", err.Error())
+	_, err = StructToValue(struct{ M map[int]int }{M: map[int]int{}})
+	assert.Equal(t, "Unsupported map key type:int of .M", err.Error())
+}
+
+func TestValueToStruct(t *testing.T) {
+	tests := []struct {
+		name  string
+		value IDataValue
+		err   string
+	}{
+		{
+			name:  "type-mismatch",
+			value: MakeObject(map[string]IDataValue{"name": MakeInt(1)}),
+			err:   "Cannot set testUser.name of type string from 3",
+		},
+		{
+			name:  "overflow",
+			value: MakeObject(map[string]IDataValue{"age": MakeInt(300)}),
+			err:   "Value 300 overflows testUser.age of type uint8",
+		},
+		{
+			name:  "negative-unsigned",
+			value: MakeObject(map[string]IDataValue{"age": MakeInt(-1)}),
+			err:   "Value -1 overflows testUser.age of type uint8",
+		},
+		{
+			name:  "nested-mismatch",
+			value: MakeObject(map[string]IDataValue{"address": MakeObject(map[string]IDataValue{"city": MakeBool(true)})}),
+			err:   "Cannot set testUser.address.city of type string from 6",
+		},
+		{
+			name:  "not-object",
+			value: MakeInt(1),
+			err:   "Cannot set testUser of type datavalues.testUser from 3",
+		},
+	}
+
+	for _, test := range tests {
+		var user testUser
+		err := ValueToStruct(test.value, &user)
+		assert.NotNil(t, err, test.name)
+		assert.Equal(t, test.err, err.Error(), test.name)
+	}
+
+	// NULL is the zero, the missing keys are kept, the ints fill the floats.
+	user := testUser{Name: "kept", Score: 2, Tags: []string{"x"}}
+	err := ValueToStruct(MakeObject(map[string]IDataValue{
+		"tags":  MakeNull(),
+		"score": MakeInt(3),
+		"extra": MakeTuple(MakeInt(1), MakeString("a")),
+	}), &user)
+	assert.Nil(t, err)
+	assert.Equal(t, testUser{Name: "kept", Score: 3, Extra: []interface{}{int64(1), "a"}}, user)
+
+	assert.Equal(t, "ValueToStruct expects a pointer to struct, got:datavalues.testUser", ValueToStruct(MakeNull(), user).Error())
+}
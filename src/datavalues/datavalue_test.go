@@ -5,9 +5,169 @@
 package datavalues
 
 import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
 	"testing"
+	"time"
+
+	"base/errors"
+
+	"github.com/stretchr/testify/assert"
 )
 
+func TestToValueJSONNumber(t *testing.T) {
+	tests := []struct {
+		name   string
+		number json.Number
+		expect IDataValue
+	}{
+		{
+			name:   "int",
+			number: json.Number("-42"),
+			expect: MakeInt(-42),
+		},
+		{
+			name:   "int-beyond-float64",
+			number: json.Number("9007199254740993"),
+			expect: MakeInt(9007199254740993),
+		},
+		{
+			name:   "float",
+			number: json.Number("1.5"),
+			expect: MakeFloat(1.5),
+		},
+		{
+			name:   "exponent",
+			number: json.Number("1e3"),
+			expect: MakeFloat(1000),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expect, ToValue(test.number))
+		})
+	}
+
+	// Decoded with UseNumber.
+	decoder := json.NewDecoder(strings.NewReader(`{"id": 9007199254740993}`))
+	decoder.UseNumber()
+	var object map[string]interface{}
+	assert.Nil(t, decoder.Decode(&object))
+	assert.Equal(t, int64(9007199254740993), AsInt(AsMap(ToValue(object))["id"]))
+
+	assert.Panics(t, func() { ToValue(json.Number("x")) })
+
+	// The integers int64 can't hold are not wrapped or rounded.
+	for _, number := range []json.Number{"9223372036854775808", "18446744073709551615", "99999999999999999999999", "-9223372036854775809"} {
+		_, err := TryToValue(number)
+		assert.Equal(t, errors.ARGUMENT_OUT_OF_BOUND, errors.Code(err), string(number))
+		assert.Contains(t, err.Error(), "out of the range of Int64")
+	}
+	actual, err := TryToValue(json.Number("-9223372036854775808"))
+	assert.Nil(t, err)
+	assert.Equal(t, MakeInt(math.MinInt64), actual)
+}
+
+func TestTryToValue(t *testing.T) {
+	tests := []struct {
+		name   string
+		value  interface{}
+		expect IDataValue
+		err    string
+	}{
+		{name: "int", value: 1, expect: MakeInt32(1)},
+		{name: "string", value: "a", expect: MakeString("a")},
+		{name: "tuple", value: []interface{}{int64(1), "b"}, expect: MakeTuple(MakeInt(1), MakeString("b"))},
+		{name: "unsupported", value: struct{}{}, err: "unreachable:struct {}"},
+		{name: "uint16", value: uint16(1), err: "unreachable:uint16"},
+		{name: "nil", value: nil, err: "unreachable:<nil>"},
+		{name: "tuple-unsupported", value: []interface{}{1, complex(1, 2)}, err: "unreachable:complex128"},
+		{name: "object-unsupported", value: map[string]interface{}{"a": []int{1}}, err: "unreachable:[]int"},
+		{name: "json-number-invalid", value: json.Number("x"), err: "invalid json.Number:\"x\""},
+		{name: "ptr-int", value: intPtr(7), expect: MakeInt32(7)},
+		{name: "ptr-string", value: stringPtr("a"), expect: MakeString("a")},
+		{name: "ptr-bool", value: boolPtr(true), expect: MakeBool(true)},
+		{name: "ptr-ptr", value: func() **string { p := stringPtr("b"); return &p }(), expect: MakeString("b")},
+		{name: "ptr-nil-int", value: (*int)(nil), expect: MakeNull()},
+		{name: "ptr-nil-string", value: (*string)(nil), expect: MakeNull()},
+		{name: "object-ptr", value: map[string]interface{}{"a": (*int64)(nil), "b": intPtr(1)}, expect: MakeObject(map[string]IDataValue{"a": MakeNull(), "b": MakeInt32(1)})},
+		{name: "ptr-unsupported", value: func() *uint16 { v := uint16(1); return &v }(), err: "unreachable:uint16"},
+	}
+
+	for _, test := range tests {
+		actual, err := TryToValue(test.value)
+		if test.err != "" {
+			assert.NotNil(t, err, test.name)
+			assert.Equal(t, test.err, err.Error(), test.name)
+			assert.Nil(t, actual, test.name)
+			assert.Panics(t, func() { ToValue(test.value) }, test.name)
+		} else {
+			assert.Nil(t, err, test.name)
+			assert.Equal(t, test.expect, actual, test.name)
+		}
+	}
+}
+
+func intPtr(v int) *int          { return &v }
+func stringPtr(v string) *string { return &v }
+func boolPtr(v bool) *bool       { return &v }
+
+func TestIsZero(t *testing.T) {
+	tests := []struct {
+		name   string
+		value  IDataValue
+		isZero bool
+		isNull bool
+	}{
+		{name: "nil", value: nil, isZero: true, isNull: false},
+		{name: "null", value: MakeNull(), isZero: false, isNull: true},
+		{name: "int-zero", value: MakeInt(0), isZero: false, isNull: false},
+		{name: "string-empty", value: MakeString(""), isZero: false, isNull: false},
+		{name: "tuple-empty", value: MakeTuple(), isZero: false, isNull: false},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.isZero, IsZero(test.value), test.name)
+		assert.Equal(t, test.isNull, IsNull(test.value), test.name)
+	}
+}
+
+func TestToRows(t *testing.T) {
+	ts := time.Date(2020, 2, 3, 4, 5, 6, 0, time.UTC)
+	rows := []map[string]interface{}{
+		{"id": 1, "name": "a", "tags": []interface{}{"x", int64(2)}},
+		{"id": json.Number("9007199254740993"), "at": ts, "nested": map[string]interface{}{"ok": true}},
+		{},
+		nil,
+	}
+
+	actual := ToRows(rows)
+	assert.Equal(t, len(rows), len(actual))
+	for i, row := range rows {
+		assert.Equal(t, ToValue(map[string]interface{}(row)), actual[i], "row %d", i)
+	}
+	assert.Equal(t, "{id:1, name:a, tags:x2}", actual[0].String())
+	assert.Equal(t, int64(9007199254740993), AsInt(AsMap(actual[1])["id"]))
+	assert.Equal(t, 0, len(AsMap(actual[3])))
+	assert.Equal(t, 0, len(ToRows(nil)))
+}
+
+func BenchmarkToRows(b *testing.B) {
+	rows := make([]map[string]interface{}, 1024)
+	for i := range rows {
+		rows[i] = map[string]interface{}{"id": int64(i), "name": "name", "score": 1.5}
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = ToRows(rows)
+	}
+}
+
 func BenchmarkDatavalue(b *testing.B) {
 	b.ReportAllocs()
 
@@ -15,3 +175,71 @@ func BenchmarkDatavalue(b *testing.B) {
 		_ = ToValue(1)
 	}
 }
+
+// The values are fmt.Stringer by their String, %v and %s print the value rather than the struct.
+var _ fmt.Stringer = IDataValue(nil)
+
+func TestValueFormat(t *testing.T) {
+	tests := []struct {
+		value  IDataValue
+		expect string
+	}{
+		{value: MakeInt(-1), expect: "-1"},
+		{value: MakeInt32(2), expect: "2"},
+		{value: MakeFloat(1.5), expect: "1.5E+00"},
+		{value: MakeBool(true), expect: "true"},
+		{value: MakeString("vectorsql"), expect: "vectorsql"},
+		{value: MakeBytes([]byte{0xab}), expect: "AB"},
+		{value: MakeNull(), expect: "NULL"},
+		{value: MakeObject(map[string]IDataValue{"b": MakeInt(2), "a": MakeObject(map[string]IDataValue{"c": MakeNull()})}), expect: "{a:{c:NULL}, b:2}"},
+		{value: MakeTime(time.Date(2020, 3, 4, 5, 6, 7, 0, time.UTC)), expect: "2020-03-04 05:06:07"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expect, fmt.Sprintf("%v", test.value))
+		assert.Equal(t, test.expect, fmt.Sprintf("%s", test.value))
+		assert.Equal(t, test.expect, test.value.String())
+	}
+	assert.Equal(t, "[1 NULL]", fmt.Sprintf("%v", []IDataValue{MakeInt(1), MakeNull()}))
+}
+
+func TestValueAccessNoAlloc(t *testing.T) {
+	values := []IDataValue{
+		MakeInt(1),
+		MakeString("vectorsql"),
+		MakeTuple(MakeInt(1), MakeString("a")),
+		MakeObject(map[string]IDataValue{"a": MakeInt(1)}),
+	}
+	assert.Equal(t, float64(0), testing.AllocsPerRun(100, func() {
+		for _, v := range values {
+			_ = v.Type()
+			_ = v.Family()
+			_ = AsInt(v)
+			_ = AsString(v)
+			_ = AsSlice(v)
+			_ = AsMap(v)
+		}
+	}))
+}
+
+func BenchmarkValueType(b *testing.B) {
+	values := []IDataValue{MakeInt(1), MakeTuple(MakeInt(1), MakeString("a")), MakeObject(map[string]IDataValue{"a": MakeInt(1)})}
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = values[i%len(values)].Type()
+	}
+}
+
+func BenchmarkAsInt(b *testing.B) {
+	v := MakeInt(1)
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	var sum int64
+	for i := 0; i < b.N; i++ {
+		sum += AsInt(v)
+	}
+	_ = sum
+}
@@ -0,0 +1,201 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datavalues
+
+import (
+	"strings"
+	"time"
+	"unsafe"
+
+	"base/docs"
+	"base/errors"
+)
+
+const (
+	TimeLayout = "2006-01-02 15:04:05"
+)
+
+const (
+	// The precision of MakeTime, the nanoseconds are kept and shown as the seconds.
+	timePrecisionNone = -1
+	maxTimePrecision  = 9
+)
+
+// ValueTime is the time with an optional fractional-second precision, like DateTime64(N).
+type ValueTime struct {
+	t         time.Time
+	precision int
+}
+
+// MakeTime keeps the wall clock only, without the monotonic clock reading of time.Now,
+// the value equals the same instant after the serialization round trip.
+func MakeTime(v time.Time) IDataValue {
+	return &ValueTime{t: v.Round(0), precision: timePrecisionNone}
+}
+
+// MakeTimeWithPrecision truncates the time to the digits of the fractional second (0 to 9) and records them.
+func MakeTimeWithPrecision(v time.Time, digits int) IDataValue {
+	if digits < 0 {
+		digits = 0
+	}
+	if digits > maxTimePrecision {
+		digits = maxTimePrecision
+	}
+	return &ValueTime{t: truncatePrecision(v.Round(0), digits), precision: digits}
+}
+
+func ZeroTime() IDataValue {
+	return MakeTime(time.Unix(0, 0).UTC())
+}
+
+func (v *ValueTime) Size() uintptr {
+	return unsafe.Sizeof(*v)
+}
+
+// String shows exactly the digits of the precision, the time without precision shows the seconds.
+func (v *ValueTime) String() string {
+	if v.precision <= 0 {
+		return v.t.Format(TimeLayout)
+	}
+	return v.t.Format(TimeLayout + "." + strings.Repeat("0", v.precision))
+}
+
+func (v *ValueTime) Type() Type {
+	return TypeTime
+}
+
+func (v *ValueTime) Family() Family {
+	return FamilyTime
+}
+
+func (v *ValueTime) AsTime() time.Time {
+	return v.t
+}
+
+// Precision returns the digits of the fractional second, -1 if it's not recorded.
+func (v *ValueTime) Precision() int {
+	return v.precision
+}
+
+// Compare compares the instants exactly, keeping with the Hash and the Canonical.
+// The values of a precision are truncated to it already, the literal to match them is made with the same precision.
+func (v *ValueTime) Compare(other IDataValue) (Comparison, error) {
+	if other.Type() != TypeTime {
+		return 0, errors.Errorf("type mismatch between values, got:%v", other.Type())
+	}
+
+	a, b := v.t, AsTime(other)
+	switch {
+	case a.After(b):
+		return 1, nil
+	case a.Before(b):
+		return -1, nil
+	default:
+		return 0, nil
+	}
+}
+
+func (v *ValueTime) Document() docs.Documentation {
+	return docs.Text("Time")
+}
+
+func AsTime(v IDataValue) time.Time {
+	if t, ok := v.(*ValueTime); ok {
+		return t.t
+	}
+	return time.Time{}
+}
+
+// TruncateTime truncates the time to the start of the unit:
+// second, minute, hour, day, week (from Monday), month or year.
+// The day and the longer units follow the calendar of the loc, the nil loc is the time's own location.
+// The NULL passes through.
+func TruncateTime(v IDataValue, unit string, loc *time.Location) (IDataValue, error) {
+	if IsNull(v) {
+		return v, nil
+	}
+	if v.Type() != TypeTime {
+		return nil, errors.Errorf("TruncateTime expects Time, got:%v", v.Type())
+	}
+
+	t := AsTime(v)
+	if loc != nil {
+		t = t.In(loc)
+	}
+	switch strings.ToLower(unit) {
+	case "second":
+		return MakeTime(truncateClock(t, time.Second)), nil
+	case "minute":
+		return MakeTime(truncateClock(t, time.Minute)), nil
+	case "hour":
+		return MakeTime(truncateClock(t, time.Hour)), nil
+	case "day":
+		return MakeTime(time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())), nil
+	case "week":
+		days := (int(t.Weekday()) + 6) % 7
+		return MakeTime(time.Date(t.Year(), t.Month(), t.Day()-days, 0, 0, 0, 0, t.Location())), nil
+	case "month":
+		return MakeTime(time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())), nil
+	case "year":
+		return MakeTime(time.Date(t.Year(), time.January, 1, 0, 0, 0, 0, t.Location())), nil
+	}
+	return nil, errors.Errorf("Unsupported truncate unit:%s", unit)
+}
+
+// truncateClock truncates on the wall clock, the zones like +05:30 have their own hour boundaries.
+func truncateClock(t time.Time, d time.Duration) time.Time {
+	_, offset := t.Zone()
+	shift := time.Duration(offset) * time.Second
+	return t.Add(shift).Truncate(d).Add(-shift)
+}
+
+// TimePart extracts the part of the time as Int:
+// year, month, day, hour, minute, second, dayofweek (Monday is 1), dayofyear or week (ISO 8601).
+// The parts follow the calendar of the loc, the nil loc is the time's own location.
+// The NULL passes through.
+func TimePart(v IDataValue, part string, loc *time.Location) (IDataValue, error) {
+	if IsNull(v) {
+		return v, nil
+	}
+	if v.Type() != TypeTime {
+		return nil, errors.Errorf("TimePart expects Time, got:%v", v.Type())
+	}
+
+	t := AsTime(v)
+	if loc != nil {
+		t = t.In(loc)
+	}
+	switch strings.ToLower(part) {
+	case "year":
+		return MakeInt(int64(t.Year())), nil
+	case "month":
+		return MakeInt(int64(t.Month())), nil
+	case "day":
+		return MakeInt(int64(t.Day())), nil
+	case "hour":
+		return MakeInt(int64(t.Hour())), nil
+	case "minute":
+		return MakeInt(int64(t.Minute())), nil
+	case "second":
+		return MakeInt(int64(t.Second())), nil
+	case "dayofweek":
+		return MakeInt(int64((int(t.Weekday())+6)%7 + 1)), nil
+	case "dayofyear":
+		return MakeInt(int64(t.YearDay())), nil
+	case "week":
+		_, week := t.ISOWeek()
+		return MakeInt(int64(week)), nil
+	}
+	return nil, errors.Errorf("Unsupported time part:%s", part)
+}
+
+// truncatePrecision truncates the time to the digits of the fractional second.
+func truncatePrecision(t time.Time, digits int) time.Time {
+	d := time.Duration(1)
+	for i := digits; i < maxTimePrecision; i++ {
+		d *= 10
+	}
+	return t.Truncate(d)
+}
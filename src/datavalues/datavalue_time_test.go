@@ -0,0 +1,173 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datavalues
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTruncateTime(t *testing.T) {
+	kolkata := time.FixedZone("IST", 5*3600+1800)
+	ts := time.Date(2020, time.March, 18, 13, 47, 25, 123456789, time.UTC)
+
+	tests := []struct {
+		name   string
+		value  time.Time
+		unit   string
+		loc    *time.Location
+		expect time.Time
+	}{
+		{name: "second", value: ts, unit: "second", expect: time.Date(2020, 3, 18, 13, 47, 25, 0, time.UTC)},
+		{name: "minute", value: ts, unit: "minute", expect: time.Date(2020, 3, 18, 13, 47, 0, 0, time.UTC)},
+		{name: "hour", value: ts, unit: "HOUR", expect: time.Date(2020, 3, 18, 13, 0, 0, 0, time.UTC)},
+		{name: "day", value: ts, unit: "day", expect: time.Date(2020, 3, 18, 0, 0, 0, 0, time.UTC)},
+		{name: "week", value: ts, unit: "week", expect: time.Date(2020, 3, 16, 0, 0, 0, 0, time.UTC)},
+		{name: "week-sunday", value: time.Date(2020, 3, 1, 10, 0, 0, 0, time.UTC), unit: "week", expect: time.Date(2020, 2, 24, 0, 0, 0, 0, time.UTC)},
+		{name: "month", value: ts, unit: "month", expect: time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "year", value: ts, unit: "year", expect: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "hour-half-zone", value: ts, unit: "hour", loc: kolkata, expect: time.Date(2020, 3, 18, 19, 0, 0, 0, kolkata)},
+		{name: "day-zone", value: time.Date(2020, 3, 18, 20, 0, 0, 0, time.UTC), unit: "day", loc: kolkata, expect: time.Date(2020, 3, 19, 0, 0, 0, 0, kolkata)},
+		{name: "month-zone", value: time.Date(2020, 2, 29, 20, 0, 0, 0, time.UTC), unit: "month", loc: kolkata, expect: time.Date(2020, 3, 1, 0, 0, 0, 0, kolkata)},
+	}
+
+	for _, test := range tests {
+		actual, err := TruncateTime(MakeTime(test.value), test.unit, test.loc)
+		assert.Nil(t, err, test.name)
+		assert.True(t, test.expect.Equal(AsTime(actual)), "%v: want %v, got %v", test.name, test.expect, AsTime(actual))
+	}
+
+	// NULL passes through.
+	actual, err := TruncateTime(MakeNull(), "day", nil)
+	assert.Nil(t, err)
+	assert.True(t, IsNull(actual))
+
+	_, err = TruncateTime(MakeInt(1), "day", nil)
+	assert.Equal(t, "TruncateTime expects Time, got:3", err.Error())
+	_, err = TruncateTime(MakeTime(ts), "quarter", nil)
+	assert.Equal(t, "Unsupported truncate unit:quarter", err.Error())
+}
+
+func TestTimePart(t *testing.T) {
+	kolkata := time.FixedZone("IST", 5*3600+1800)
+	ts := time.Date(2020, time.March, 18, 13, 47, 25, 123456789, time.UTC)
+
+	tests := []struct {
+		name   string
+		value  time.Time
+		part   string
+		loc    *time.Location
+		expect int64
+	}{
+		{name: "year", value: ts, part: "year", expect: 2020},
+		{name: "month", value: ts, part: "MONTH", expect: 3},
+		{name: "day", value: ts, part: "day", expect: 18},
+		{name: "hour", value: ts, part: "hour", expect: 13},
+		{name: "minute", value: ts, part: "minute", expect: 47},
+		{name: "second", value: ts, part: "second", expect: 25},
+		{name: "dayofweek", value: ts, part: "dayofweek", expect: 3},
+		{name: "dayofweek-sunday", value: time.Date(2020, 3, 1, 10, 0, 0, 0, time.UTC), part: "dayofweek", expect: 7},
+		{name: "dayofyear", value: ts, part: "dayofyear", expect: 78},
+		{name: "week", value: ts, part: "week", expect: 12},
+		{name: "week-previous-year", value: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), part: "week", expect: 53},
+		{name: "hour-zone", value: ts, part: "hour", loc: kolkata, expect: 19},
+		{name: "year-zone", value: time.Date(2019, 12, 31, 20, 0, 0, 0, time.UTC), part: "year", loc: kolkata, expect: 2020},
+	}
+
+	for _, test := range tests {
+		actual, err := TimePart(MakeTime(test.value), test.part, test.loc)
+		assert.Nil(t, err, test.name)
+		assert.Equal(t, MakeInt(test.expect), actual, test.name)
+	}
+
+	// NULL passes through.
+	actual, err := TimePart(MakeNull(), "year", nil)
+	assert.Nil(t, err)
+	assert.True(t, IsNull(actual))
+
+	_, err = TimePart(MakeInt(1), "year", nil)
+	assert.Equal(t, "TimePart expects Time, got:3", err.Error())
+	_, err = TimePart(MakeTime(ts), "quarter", nil)
+	assert.Equal(t, "Unsupported time part:quarter", err.Error())
+}
+
+func TestMakeTimeStripMonotonic(t *testing.T) {
+	now := MakeTime(time.Now())
+
+	bs, err := AsTime(now).MarshalBinary()
+	assert.Nil(t, err)
+	var parsed time.Time
+	assert.Nil(t, parsed.UnmarshalBinary(bs))
+
+	actual := MakeTime(parsed)
+	assert.True(t, Equals(now, actual))
+	assert.Equal(t, now, actual)
+	cmp, err := now.Compare(actual)
+	assert.Nil(t, err)
+	assert.Equal(t, Equal, cmp)
+	assert.Equal(t, Hash(now), Hash(actual))
+}
+
+func TestMakeTimeWithPrecision(t *testing.T) {
+	ts := time.Date(2020, time.March, 18, 13, 47, 25, 123456789, time.UTC)
+
+	tests := []struct {
+		name   string
+		digits int
+		show   string
+		nanos  int
+	}{
+		{name: "seconds", digits: 0, show: "2020-03-18 13:47:25", nanos: 0},
+		{name: "millis", digits: 3, show: "2020-03-18 13:47:25.123", nanos: 123000000},
+		{name: "micros", digits: 6, show: "2020-03-18 13:47:25.123456", nanos: 123456000},
+		{name: "nanos", digits: 9, show: "2020-03-18 13:47:25.123456789", nanos: 123456789},
+		{name: "clamp-high", digits: 12, show: "2020-03-18 13:47:25.123456789", nanos: 123456789},
+		{name: "clamp-low", digits: -1, show: "2020-03-18 13:47:25", nanos: 0},
+	}
+
+	for _, test := range tests {
+		actual := MakeTimeWithPrecision(ts, test.digits)
+		assert.Equal(t, test.show, actual.String(), test.name)
+		assert.Equal(t, test.nanos, AsTime(actual).Nanosecond(), test.name)
+	}
+
+	// The 3 digits source equals the literal of the same precision, not the nanosecond one.
+	source := MakeTimeWithPrecision(time.Date(2020, time.March, 18, 13, 47, 25, 123000000, time.UTC), 3)
+	literal := MakeTime(ts)
+	assert.True(t, Equals(source, MakeTimeWithPrecision(ts, 3)))
+	assert.False(t, Equals(source, literal))
+	cmp, err := literal.Compare(source)
+	assert.Nil(t, err)
+	assert.Equal(t, GreaterThan, cmp)
+	assert.Equal(t, "2020-03-18 13:47:25", literal.String())
+
+	later := MakeTime(ts.Add(time.Millisecond))
+	cmp, err = source.Compare(later)
+	assert.Nil(t, err)
+	assert.Equal(t, LessThan, cmp)
+	assert.False(t, Equals(MakeTime(ts), later))
+
+	// The equal values of the different precisions have the same hash and canonical text.
+	values := []IDataValue{
+		MakeTime(time.Date(2020, time.March, 18, 13, 47, 25, 123000000, time.UTC)),
+		MakeTimeWithPrecision(ts, 3),
+		MakeTimeWithPrecision(ts.Add(400*time.Microsecond), 3),
+	}
+	for _, a := range values {
+		for _, b := range values {
+			assert.True(t, Equals(a, b))
+			assert.Equal(t, Hash(a), Hash(b))
+			assert.Equal(t, Canonical(a), Canonical(b))
+		}
+	}
+	set := NewValueSet()
+	set.Add(values[1])
+	assert.True(t, set.Contains(values[0]))
+	assert.False(t, set.Contains(literal))
+	assert.Equal(t, 3, source.(*ValueTime).Precision())
+	assert.Equal(t, -1, literal.(*ValueTime).Precision())
+}
@@ -9,6 +9,7 @@ import (
 	"unsafe"
 
 	"base/docs"
+	"base/errors"
 )
 
 type ValueTuple struct {
@@ -51,6 +52,37 @@ func (v *ValueTuple) AsSlice() []IDataValue {
 	return v.fields
 }
 
+// ElementAt returns the element by the SQL index, which is 1-based as arr[1] is the first,
+// and the negative index counts from the end as arr[-1] is the last.
+// The index 0 and the out-of-range ones are errors instead of panics.
+func (v *ValueTuple) ElementAt(i int) (IDataValue, error) {
+	idx, ok := v.index(i)
+	if !ok {
+		return nil, errors.ErrorWithCode(errors.ARGUMENT_OUT_OF_BOUND, "Array index %d is out of range [1, %d]", i, len(v.fields))
+	}
+	return v.fields[idx], nil
+}
+
+// ElementAtOrNull is the ElementAt returning Null for the out-of-range index.
+func (v *ValueTuple) ElementAtOrNull(i int) IDataValue {
+	idx, ok := v.index(i)
+	if !ok {
+		return MakeNull()
+	}
+	return v.fields[idx]
+}
+
+func (v *ValueTuple) index(i int) (int, bool) {
+	n := len(v.fields)
+	switch {
+	case i > 0 && i <= n:
+		return i - 1, true
+	case i < 0 && -i <= n:
+		return n + i, true
+	}
+	return 0, false
+}
+
 func (v *ValueTuple) Compare(other IDataValue) (Comparison, error) {
 	otherv := other.(*ValueTuple)
 	for i := range v.fields {
@@ -72,6 +104,15 @@ func (v *ValueTuple) Document() docs.Documentation {
 	return docs.Text("Tuple")
 }
 
+// ElementAt returns the element of the tuple by the SQL index, see ValueTuple.ElementAt.
+func ElementAt(v IDataValue, i int) (IDataValue, error) {
+	t, ok := v.(*ValueTuple)
+	if !ok {
+		return nil, errors.Errorf("Cannot get the element %d of non-array type:%v", i, v.Type())
+	}
+	return t.ElementAt(i)
+}
+
 func AsSlice(v IDataValue) []IDataValue {
 	if t, ok := v.(*ValueTuple); ok {
 		return t.fields
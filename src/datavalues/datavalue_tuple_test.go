@@ -0,0 +1,78 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datavalues
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTupleElementAt(t *testing.T) {
+	tuple := MakeTuple(MakeInt(1), MakeInt(2), MakeInt(3))
+
+	tests := []struct {
+		name   string
+		index  int
+		expect IDataValue
+		err    string
+	}{
+		{
+			name:   "first",
+			index:  1,
+			expect: MakeInt(1),
+		},
+		{
+			name:   "last",
+			index:  3,
+			expect: MakeInt(3),
+		},
+		{
+			name:   "negative-last",
+			index:  -1,
+			expect: MakeInt(3),
+		},
+		{
+			name:   "negative-first",
+			index:  -3,
+			expect: MakeInt(1),
+		},
+		{
+			name:  "zero",
+			index: 0,
+			err:   "Array index 0 is out of range [1, 3] (errno 12)",
+		},
+		{
+			name:  "out-of-range",
+			index: 99,
+			err:   "Array index 99 is out of range [1, 3] (errno 12)",
+		},
+		{
+			name:  "negative-out-of-range",
+			index: -4,
+			err:   "Array index -4 is out of range [1, 3] (errno 12)",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := ElementAt(tuple, test.index)
+			orNull := tuple.(*ValueTuple).ElementAtOrNull(test.index)
+			if test.err != "" {
+				assert.Equal(t, test.err, err.Error())
+				assert.True(t, IsNull(orNull))
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, test.expect, actual)
+			assert.Equal(t, test.expect, orNull)
+		})
+	}
+
+	_, err := ElementAt(ZeroTuple(), 1)
+	assert.Equal(t, "Array index 1 is out of range [1, 0] (errno 12)", err.Error())
+	_, err = ElementAt(MakeInt(1), 1)
+	assert.NotNil(t, err)
+}
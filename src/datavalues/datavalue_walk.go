@@ -0,0 +1,99 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datavalues
+
+import (
+	"base/errors"
+)
+
+// ErrStopWalk stops the Walk or Transform early without failing them.
+var ErrStopWalk = errors.New("stop walk")
+
+// WalkFunc is called with every scalar of the nested value and its path,
+// the path elements are the string keys of the objects and the 0-based int indices of the tuples.
+// The path is reused between the calls, it must be copied to be retained.
+type WalkFunc func(path []interface{}, v IDataValue) error
+
+// TransformFunc returns the replacement of the scalar, see WalkFunc for the path.
+type TransformFunc func(path []interface{}, v IDataValue) (IDataValue, error)
+
+// Walk visits all the scalars of the value in order, the object keys are sorted.
+// Returning ErrStopWalk from fn stops the walk, Walk returns nil then.
+func Walk(v IDataValue, fn WalkFunc) error {
+	_, err := Transform(v, func(path []interface{}, v IDataValue) (IDataValue, error) {
+		return v, fn(path, v)
+	})
+	return err
+}
+
+// Transform returns the value rebuilt with the scalars replaced by fn.
+// Returning ErrStopWalk from fn keeps the rest of the scalars unchanged.
+func Transform(v IDataValue, fn TransformFunc) (IDataValue, error) {
+	t := &transformer{fn: fn}
+	result, err := t.transform(v)
+	if err == ErrStopWalk {
+		err = nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+type transformer struct {
+	fn      TransformFunc
+	path    []interface{}
+	stopped bool
+}
+
+func (t *transformer) transform(v IDataValue) (IDataValue, error) {
+	if t.stopped {
+		return v, nil
+	}
+
+	switch x := v.(type) {
+	case *ValueTuple:
+		fields := make([]IDataValue, len(x.fields))
+		for i, field := range x.fields {
+			t.path = append(t.path, i)
+			result, err := t.transform(field)
+			t.path = t.path[:len(t.path)-1]
+			if err != nil && err != ErrStopWalk {
+				return nil, err
+			}
+			fields[i] = result
+		}
+		return MakeTuple(fields...), t.stopErr()
+	case *ValueObject:
+		fields := make(map[string]IDataValue, len(x.fields))
+		for _, k := range x.Keys() {
+			t.path = append(t.path, k)
+			result, err := t.transform(x.fields[k])
+			t.path = t.path[:len(t.path)-1]
+			if err != nil && err != ErrStopWalk {
+				return nil, err
+			}
+			fields[k] = result
+		}
+		return MakeObject(fields), t.stopErr()
+	}
+
+	result, err := t.fn(t.path, v)
+	switch {
+	case err == ErrStopWalk:
+		t.stopped = true
+		return result, err
+	case err != nil:
+		return nil, err
+	}
+	return result, nil
+}
+
+func (t *transformer) stopErr() error {
+	if t.stopped {
+		return ErrStopWalk
+	}
+	return nil
+}
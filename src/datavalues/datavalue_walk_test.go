@@ -0,0 +1,89 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package datavalues
+
+import (
+	"fmt"
+	"testing"
+
+	"base/errors"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testNestedValue() IDataValue {
+	return ToValue(map[string]interface{}{
+		"name":   "x",
+		"ids":    []interface{}{1, []interface{}{2, 3}},
+		"secret": map[string]interface{}{"token": "t"},
+	})
+}
+
+func TestWalk(t *testing.T) {
+	var visits []string
+	err := Walk(testNestedValue(), func(path []interface{}, v IDataValue) error {
+		visits = append(visits, fmt.Sprintf("%v=%v", path, v))
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"[ids 0]=1", "[ids 1 0]=2", "[ids 1 1]=3", "[name]=x", "[secret token]=t"}, visits)
+
+	// Stop.
+	visits = nil
+	err = Walk(testNestedValue(), func(path []interface{}, v IDataValue) error {
+		visits = append(visits, fmt.Sprintf("%v", path))
+		if len(visits) == 2 {
+			return ErrStopWalk
+		}
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"[ids 0]", "[ids 1 0]"}, visits)
+
+	// Error.
+	err = Walk(testNestedValue(), func(path []interface{}, v IDataValue) error {
+		return errors.New("invalid")
+	})
+	assert.Equal(t, "invalid", err.Error())
+
+	// Scalar.
+	err = Walk(MakeInt(1), func(path []interface{}, v IDataValue) error {
+		assert.Equal(t, 0, len(path))
+		return nil
+	})
+	assert.Nil(t, err)
+}
+
+func TestTransform(t *testing.T) {
+	value := testNestedValue()
+
+	// Redaction.
+	actual, err := Transform(value, func(path []interface{}, v IDataValue) (IDataValue, error) {
+		if path[0] == "secret" {
+			return MakeString("***"), nil
+		}
+		if IsIntegral(v) {
+			return MakeInt(AsInt(v) * 10), nil
+		}
+		return v, nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"ids":(i:10,(i:20,i:30)),"name":s:"x","secret":{"token":s:"***"}}`, Canonical(actual))
+	// The source is unchanged.
+	assert.Equal(t, `{"ids":(i:1,(i:2,i:3)),"name":s:"x","secret":{"token":s:"t"}}`, Canonical(value))
+
+	// Stop.
+	actual, err = Transform(value, func(path []interface{}, v IDataValue) (IDataValue, error) {
+		return MakeNull(), ErrStopWalk
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"ids":(null,(i:2,i:3)),"name":s:"x","secret":{"token":s:"t"}}`, Canonical(actual))
+
+	// Error.
+	_, err = Transform(value, func(path []interface{}, v IDataValue) (IDataValue, error) {
+		return nil, errors.Errorf("invalid at %v", path)
+	})
+	assert.Equal(t, "invalid at [ids 0]", err.Error())
+}
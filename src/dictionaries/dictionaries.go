@@ -0,0 +1,92 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package dictionaries
+
+import (
+	"sort"
+	"sync"
+
+	"config"
+
+	"base/errors"
+	"base/xlog"
+)
+
+var (
+	dictionaries = NewDictionaries()
+)
+
+type Dictionaries struct {
+	mu           sync.RWMutex
+	dictionaries map[string]*Dictionary
+}
+
+func NewDictionaries() *Dictionaries {
+	return &Dictionaries{
+		dictionaries: make(map[string]*Dictionary),
+	}
+}
+
+// Load creates all the dictionaries from the config and starts their refreshing.
+// A dictionary which fails on the first load is still attached with the FAILED status.
+func Load(log *xlog.Log, conf *config.Config) error {
+	for _, dictConf := range conf.Dictionaries {
+		dict, err := NewDictionary(log, dictConf)
+		if err != nil {
+			return err
+		}
+		if err := dictionaries.attach(dict); err != nil {
+			return err
+		}
+		dict.Start()
+	}
+	return nil
+}
+
+// Close stops all the dictionaries refreshing and detaches them.
+func Close() {
+	dictionaries.mu.Lock()
+	defer dictionaries.mu.Unlock()
+
+	for name, dict := range dictionaries.dictionaries {
+		dict.Close()
+		delete(dictionaries.dictionaries, name)
+	}
+}
+
+func GetDictionary(name string) (*Dictionary, error) {
+	dictionaries.mu.RLock()
+	defer dictionaries.mu.RUnlock()
+
+	dict, ok := dictionaries.dictionaries[name]
+	if !ok {
+		return nil, errors.Errorf("Dictionary:%s doesn't exist", name)
+	}
+	return dict, nil
+}
+
+// GetDictionaries returns all the dictionaries sorted by name.
+func GetDictionaries() []*Dictionary {
+	dictionaries.mu.RLock()
+	defer dictionaries.mu.RUnlock()
+
+	var dicts []*Dictionary
+	for _, dict := range dictionaries.dictionaries {
+		dicts = append(dicts, dict)
+	}
+	sort.Slice(dicts, func(i, j int) bool { return dicts[i].Name() < dicts[j].Name() })
+	return dicts
+}
+
+func (dicts *Dictionaries) attach(dict *Dictionary) error {
+	dicts.mu.Lock()
+	defer dicts.mu.Unlock()
+
+	if _, ok := dicts.dictionaries[dict.Name()]; ok {
+		return errors.Errorf("Dictionary:%s exists", dict.Name())
+	}
+	dicts.dictionaries[dict.Name()] = dict
+	return nil
+}
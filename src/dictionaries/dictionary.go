@@ -0,0 +1,323 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package dictionaries
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"config"
+	"datatypes"
+	"datavalues"
+
+	"base/errors"
+	"base/xlog"
+)
+
+const (
+	KeyTypeUInt64 = "UInt64"
+	KeyTypeString = "String"
+)
+
+type Status string
+
+const (
+	StatusNotLoaded Status = "NOT_LOADED"
+	StatusLoading   Status = "LOADING"
+	StatusLoaded    Status = "LOADED"
+	StatusFailed    Status = "FAILED"
+)
+
+type Attribute struct {
+	Name     string
+	DataType datatypes.IDataType
+	Default  datavalues.IDataValue
+}
+
+// snapshot is an immutable, fully loaded copy of the dictionary data.
+// Lookups always go to the current snapshot, a reload swaps it only on success.
+type snapshot struct {
+	intIndex map[uint64][]datavalues.IDataValue
+	strIndex map[string][]datavalues.IDataValue
+	rows     int
+	bytes    int
+}
+
+type Dictionary struct {
+	mu            sync.RWMutex
+	log           *xlog.Log
+	conf          config.Dictionary
+	source        ISource
+	keyType       datatypes.IDataType
+	attributes    []*Attribute
+	data          *snapshot
+	status        Status
+	lastException error
+	loadedAt      time.Time
+	loadDuration  time.Duration
+	done          chan struct{}
+	wg            sync.WaitGroup
+}
+
+func NewDictionary(log *xlog.Log, conf config.Dictionary) (*Dictionary, error) {
+	if conf.Name == "" {
+		return nil, errors.New("Dictionary name can't be empty")
+	}
+	if conf.Key == "" {
+		return nil, errors.Errorf("Dictionary:%s key can't be empty", conf.Name)
+	}
+
+	keyType := conf.KeyType
+	if keyType == "" {
+		keyType = KeyTypeUInt64
+	}
+	if keyType != KeyTypeUInt64 && keyType != KeyTypeString {
+		return nil, errors.Errorf("Dictionary:%s unsupported key type:%s, must be UInt64 or String", conf.Name, keyType)
+	}
+	kt, err := datatypes.DataTypeFactory(keyType)
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := make([]*Attribute, len(conf.Attributes))
+	for i, attr := range conf.Attributes {
+		dt, err := datatypes.DataTypeFactory(attr.Type)
+		if err != nil {
+			return nil, err
+		}
+		attribute := &Attribute{Name: attr.Name, DataType: dt}
+		if attribute.Default, err = dt.DeserializeText(attr.Default); err != nil {
+			if attr.Default != "" {
+				return nil, errors.Errorf("Dictionary:%s attribute:%s invalid default:%v", conf.Name, attr.Name, err)
+			}
+			// Empty default for the numeric types is zero.
+			attribute.Default, _ = dt.DeserializeText("0")
+		}
+		attributes[i] = attribute
+	}
+
+	source, err := SourceFactory(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Dictionary{
+		log:        log,
+		conf:       conf,
+		source:     source,
+		keyType:    kt,
+		attributes: attributes,
+		status:     StatusNotLoaded,
+		done:       make(chan struct{}),
+	}, nil
+}
+
+func (dict *Dictionary) Name() string {
+	return dict.conf.Name
+}
+
+// Load reads the whole source into a new snapshot.
+// On failure the previous snapshot (if any) keeps serving lookups.
+func (dict *Dictionary) Load() error {
+	log := dict.log
+	start := time.Now()
+
+	dict.mu.Lock()
+	if dict.status != StatusLoaded {
+		dict.status = StatusLoading
+	}
+	dict.mu.Unlock()
+
+	data, err := dict.load()
+
+	dict.mu.Lock()
+	defer dict.mu.Unlock()
+	if err != nil {
+		dict.lastException = err
+		if dict.data == nil {
+			dict.status = StatusFailed
+		}
+		log.Error("Dictionary->Load:%s error:%+v", dict.conf.Name, err)
+		return err
+	}
+	dict.data = data
+	dict.status = StatusLoaded
+	dict.lastException = nil
+	dict.loadedAt = start
+	dict.loadDuration = time.Since(start)
+	log.Info("Dictionary->Load:%s, elements:%v, cost:%v", dict.conf.Name, data.rows, dict.loadDuration)
+	return nil
+}
+
+func (dict *Dictionary) load() (*snapshot, error) {
+	records, err := dict.source.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	data := &snapshot{}
+	if dict.conf.KeyType == KeyTypeString {
+		data.strIndex = make(map[string][]datavalues.IDataValue, len(records))
+	} else {
+		data.intIndex = make(map[uint64][]datavalues.IDataValue, len(records))
+	}
+
+	want := len(dict.attributes) + 1
+	for i, record := range records {
+		if len(record) != want {
+			return nil, errors.Errorf("Dictionary:%s record:%d expected %d fields, but got %d", dict.conf.Name, i+1, want, len(record))
+		}
+		key, err := dict.keyType.DeserializeText(record[0])
+		if err != nil {
+			return nil, errors.Errorf("Dictionary:%s record:%d invalid key:%v", dict.conf.Name, i+1, err)
+		}
+		row := make([]datavalues.IDataValue, len(dict.attributes))
+		for j, attr := range dict.attributes {
+			if row[j], err = attr.DataType.DeserializeText(record[j+1]); err != nil {
+				return nil, errors.Errorf("Dictionary:%s record:%d attribute:%s invalid value:%v", dict.conf.Name, i+1, attr.Name, err)
+			}
+			data.bytes += len(record[j+1])
+		}
+		if data.strIndex != nil {
+			data.strIndex[datavalues.AsString(key)] = row
+		} else {
+			data.intIndex[uint64(datavalues.AsInt(key))] = row
+		}
+	}
+	if data.strIndex != nil {
+		data.rows = len(data.strIndex)
+	} else {
+		data.rows = len(data.intIndex)
+	}
+	return data, nil
+}
+
+// Start loads the dictionary and refreshes it every Lifetime seconds.
+func (dict *Dictionary) Start() {
+	_ = dict.Load()
+
+	if dict.conf.Lifetime <= 0 {
+		return
+	}
+	dict.wg.Add(1)
+	go func() {
+		defer dict.wg.Done()
+
+		t := time.NewTicker(time.Duration(dict.conf.Lifetime) * time.Second)
+		defer t.Stop()
+		for {
+			select {
+			case <-t.C:
+				_ = dict.Load()
+			case <-dict.done:
+				return
+			}
+		}
+	}()
+}
+
+func (dict *Dictionary) Close() {
+	close(dict.done)
+	dict.wg.Wait()
+}
+
+func (dict *Dictionary) attributeIndex(name string) (int, error) {
+	for i, attr := range dict.attributes {
+		if strings.EqualFold(attr.Name, name) {
+			return i, nil
+		}
+	}
+	return -1, errors.Errorf("Dictionary:%s has no attribute:%s", dict.conf.Name, name)
+}
+
+func (dict *Dictionary) lookup(key datavalues.IDataValue) ([]datavalues.IDataValue, bool, error) {
+	dict.mu.RLock()
+	data, status, lastException := dict.data, dict.status, dict.lastException
+	dict.mu.RUnlock()
+
+	if data == nil {
+		if lastException != nil {
+			return nil, false, errors.Errorf("Dictionary:%s not loaded, status:%s, exception:%v", dict.conf.Name, status, lastException)
+		}
+		return nil, false, errors.Errorf("Dictionary:%s not loaded, status:%s", dict.conf.Name, status)
+	}
+
+	var row []datavalues.IDataValue
+	var ok bool
+	if data.strIndex != nil {
+		if key.Family() != datavalues.FamilyString {
+			return nil, false, errors.Errorf("Dictionary:%s key type mismatch, expected String, but got:%v", dict.conf.Name, key.Type())
+		}
+		row, ok = data.strIndex[datavalues.AsString(key)]
+	} else {
+		if !datavalues.IsIntegral(key) {
+			return nil, false, errors.Errorf("Dictionary:%s key type mismatch, expected UInt64, but got:%v", dict.conf.Name, key.Type())
+		}
+		row, ok = data.intIndex[uint64(datavalues.AsInt(key))]
+	}
+	return row, ok, nil
+}
+
+// Get returns the attribute value for the key, or the attribute default if the key is absent.
+func (dict *Dictionary) Get(attr string, key datavalues.IDataValue) (datavalues.IDataValue, error) {
+	idx, err := dict.attributeIndex(attr)
+	if err != nil {
+		return nil, err
+	}
+	row, ok, err := dict.lookup(key)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return dict.attributes[idx].Default, nil
+	}
+	return row[idx], nil
+}
+
+func (dict *Dictionary) Has(key datavalues.IDataValue) (bool, error) {
+	_, ok, err := dict.lookup(key)
+	return ok, err
+}
+
+type DictionaryStatus struct {
+	Name           string
+	Source         string
+	KeyType        string
+	Attributes     []string
+	Status         Status
+	ElementCount   int
+	BytesAllocated int
+	LoadedAt       time.Time
+	LoadDuration   time.Duration
+	LastException  string
+}
+
+func (dict *Dictionary) Status() DictionaryStatus {
+	dict.mu.RLock()
+	defer dict.mu.RUnlock()
+
+	attributes := make([]string, len(dict.attributes))
+	for i, attr := range dict.attributes {
+		attributes[i] = attr.Name
+	}
+	status := DictionaryStatus{
+		Name:         dict.conf.Name,
+		Source:       dict.source.Name(),
+		KeyType:      dict.keyType.Name(),
+		Attributes:   attributes,
+		Status:       dict.status,
+		LoadedAt:     dict.loadedAt,
+		LoadDuration: dict.loadDuration,
+	}
+	if dict.data != nil {
+		status.ElementCount = dict.data.rows
+		status.BytesAllocated = dict.data.bytes
+	}
+	if dict.lastException != nil {
+		status.LastException = dict.lastException.Error()
+	}
+	return status
+}
@@ -0,0 +1,37 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package dictionaries
+
+import (
+	"strings"
+
+	"config"
+
+	"base/errors"
+)
+
+// ISource loads all the dictionary records, each record is the key followed by the attributes in text form.
+type ISource interface {
+	Name() string
+	Load() ([][]string, error)
+}
+
+type sourceCreator func(conf config.Dictionary) ISource
+
+var (
+	sourceTable = map[string]sourceCreator{
+		FileSourceName:  NewFileSource,
+		MySQLSourceName: NewMySQLSource,
+	}
+)
+
+func SourceFactory(conf config.Dictionary) (ISource, error) {
+	name := strings.ToUpper(conf.Source)
+	creator, ok := sourceTable[name]
+	if !ok {
+		return nil, errors.Errorf("Unsupported dictionary source:%s", conf.Source)
+	}
+	return creator(conf), nil
+}
@@ -0,0 +1,188 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package dictionaries
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"base/xlog"
+	"config"
+	"datavalues"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDictionaryFileSource(t *testing.T) {
+	log := xlog.NewStdLog(xlog.Level(xlog.PANIC))
+	dir, err := ioutil.TempDir("", "vectorsql-dict")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	tsvPath := filepath.Join(dir, "regions.tsv")
+	err = ioutil.WriteFile(tsvPath, []byte("1\tbeijing\t10.5\n2\tshanghai\t20\n"), 0644)
+	assert.Nil(t, err)
+	csvPath := filepath.Join(dir, "users.csv")
+	err = ioutil.WriteFile(csvPath, []byte("alice,\"a, b\"\nbob,c\n"), 0644)
+	assert.Nil(t, err)
+
+	tests := []struct {
+		name   string
+		conf   config.Dictionary
+		key    datavalues.IDataValue
+		attr   string
+		expect datavalues.IDataValue
+		has    bool
+	}{
+		{
+			name: "tsv-uint64-key",
+			conf: config.Dictionary{
+				Name:   "regions",
+				Source: "file",
+				Path:   tsvPath,
+				Key:    "id",
+				Attributes: []config.DictionaryAttribute{
+					{Name: "name", Type: "String"},
+					{Name: "weight", Type: "Float64"},
+				},
+			},
+			key:    datavalues.ToValue(2),
+			attr:   "name",
+			expect: datavalues.MakeString("shanghai"),
+			has:    true,
+		},
+		{
+			name: "tsv-default",
+			conf: config.Dictionary{
+				Name:   "regions",
+				Source: "file",
+				Path:   tsvPath,
+				Key:    "id",
+				Attributes: []config.DictionaryAttribute{
+					{Name: "name", Type: "String", Default: "unknown"},
+					{Name: "weight", Type: "Float64"},
+				},
+			},
+			key:    datavalues.ToValue(3),
+			attr:   "name",
+			expect: datavalues.MakeString("unknown"),
+			has:    false,
+		},
+		{
+			name: "csv-string-key",
+			conf: config.Dictionary{
+				Name:    "users",
+				Source:  "file",
+				Path:    csvPath,
+				Format:  "CSV",
+				Key:     "name",
+				KeyType: "String",
+				Attributes: []config.DictionaryAttribute{
+					{Name: "tags", Type: "String"},
+				},
+			},
+			key:    datavalues.MakeString("alice"),
+			attr:   "tags",
+			expect: datavalues.MakeString("a, b"),
+			has:    true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dict, err := NewDictionary(log, test.conf)
+			assert.Nil(t, err)
+			err = dict.Load()
+			assert.Nil(t, err)
+
+			actual, err := dict.Get(test.attr, test.key)
+			assert.Nil(t, err)
+			assert.Equal(t, test.expect, actual)
+
+			has, err := dict.Has(test.key)
+			assert.Nil(t, err)
+			assert.Equal(t, test.has, has)
+			assert.Equal(t, StatusLoaded, dict.Status().Status)
+		})
+	}
+}
+
+func TestDictionaryReloadFailure(t *testing.T) {
+	log := xlog.NewStdLog(xlog.Level(xlog.PANIC))
+	dir, err := ioutil.TempDir("", "vectorsql-dict")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "regions.tsv")
+	err = ioutil.WriteFile(path, []byte("1\tbeijing\n"), 0644)
+	assert.Nil(t, err)
+
+	dict, err := NewDictionary(log, config.Dictionary{
+		Name:       "regions",
+		Source:     "file",
+		Path:       path,
+		Key:        "id",
+		Attributes: []config.DictionaryAttribute{{Name: "name", Type: "String"}},
+	})
+	assert.Nil(t, err)
+
+	// Not loaded yet.
+	_, err = dict.Get("name", datavalues.ToValue(1))
+	assert.NotNil(t, err)
+
+	err = dict.Load()
+	assert.Nil(t, err)
+
+	// Broken reload keeps the previous snapshot.
+	err = ioutil.WriteFile(path, []byte("x\tbeijing\n"), 0644)
+	assert.Nil(t, err)
+	err = dict.Load()
+	assert.NotNil(t, err)
+
+	actual, err := dict.Get("name", datavalues.ToValue(1))
+	assert.Nil(t, err)
+	assert.Equal(t, datavalues.MakeString("beijing"), actual)
+
+	status := dict.Status()
+	assert.Equal(t, StatusLoaded, status.Status)
+	assert.Equal(t, 1, status.ElementCount)
+	assert.NotEqual(t, "", status.LastException)
+
+	// Missing attribute and key type mismatch.
+	_, err = dict.Get("nothing", datavalues.ToValue(1))
+	assert.NotNil(t, err)
+	_, err = dict.Has(datavalues.MakeString("1"))
+	assert.NotNil(t, err)
+}
+
+func TestDictionaries(t *testing.T) {
+	log := xlog.NewStdLog(xlog.Level(xlog.PANIC))
+	conf := config.DefaultConfig()
+	conf.Dictionaries = []config.Dictionary{
+		{
+			Name:   "missing",
+			Source: "file",
+			Path:   "/not/exists.tsv",
+			Key:    "id",
+		},
+	}
+
+	err := Load(log, conf)
+	assert.Nil(t, err)
+	defer Close()
+
+	dict, err := GetDictionary("missing")
+	assert.Nil(t, err)
+	assert.Equal(t, StatusFailed, dict.Status().Status)
+	assert.Equal(t, 1, len(GetDictionaries()))
+
+	_, err = GetDictionary("none")
+	assert.NotNil(t, err)
+
+	_, err = SourceFactory(config.Dictionary{Source: "http"})
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,108 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package dictionaries
+
+import (
+	"bufio"
+	"encoding/csv"
+	"os"
+	"strings"
+
+	"config"
+
+	"base/errors"
+)
+
+const (
+	FileSourceName = "FILE"
+)
+
+// FileSource reads records from a local TSV(default) or CSV file.
+type FileSource struct {
+	path   string
+	format string
+}
+
+func NewFileSource(conf config.Dictionary) ISource {
+	format := strings.ToUpper(conf.Format)
+	if format == "" {
+		format = "TSV"
+	}
+	return &FileSource{
+		path:   conf.Path,
+		format: format,
+	}
+}
+
+func (source *FileSource) Name() string {
+	return "File: " + source.path
+}
+
+func (source *FileSource) Load() ([][]string, error) {
+	f, err := os.Open(source.path)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	defer f.Close()
+
+	switch source.format {
+	case "CSV":
+		reader := csv.NewReader(f)
+		reader.FieldsPerRecord = -1
+		records, err := reader.ReadAll()
+		if err != nil {
+			return nil, errors.Wrap(err)
+		}
+		return records, nil
+	case "TSV", "TABSEPARATED":
+		var records [][]string
+		scanner := bufio.NewScanner(f)
+		scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+		for scanner.Scan() {
+			line := scanner.Text()
+			if line == "" {
+				continue
+			}
+			fields := strings.Split(line, "\t")
+			for i := range fields {
+				fields[i] = unescapeTSV(fields[i])
+			}
+			records = append(records, fields)
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, errors.Wrap(err)
+		}
+		return records, nil
+	default:
+		return nil, errors.Errorf("Unsupported dictionary file format:%s", source.format)
+	}
+}
+
+func unescapeTSV(s string) string {
+	if !strings.Contains(s, "\\") {
+		return s
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) {
+			i++
+			switch s[i] {
+			case 't':
+				b.WriteByte('\t')
+			case 'n':
+				b.WriteByte('\n')
+			case 'r':
+				b.WriteByte('\r')
+			case '0':
+				b.WriteByte(0)
+			default:
+				b.WriteByte(s[i])
+			}
+			continue
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
@@ -0,0 +1,417 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package dictionaries
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+
+	"config"
+
+	"base/errors"
+)
+
+const (
+	MySQLSourceName = "MYSQL"
+)
+
+const (
+	mysqlClientLongPassword     = 0x00000001
+	mysqlClientConnectWithDB    = 0x00000008
+	mysqlClientProtocol41       = 0x00000200
+	mysqlClientTransactions     = 0x00002000
+	mysqlClientSecureConnection = 0x00008000
+	mysqlClientPluginAuth       = 0x00080000
+
+	mysqlComQuit  = 0x01
+	mysqlComQuery = 0x03
+
+	mysqlMaxPacketSize = 1<<24 - 1
+)
+
+// MySQLSource loads the records with a plain 'SELECT key, attrs... FROM table' over the MySQL text protocol.
+// It supports mysql_native_password and the fast path of caching_sha2_password.
+type MySQLSource struct {
+	conf config.Dictionary
+}
+
+func NewMySQLSource(conf config.Dictionary) ISource {
+	return &MySQLSource{
+		conf: conf,
+	}
+}
+
+func (source *MySQLSource) Name() string {
+	return fmt.Sprintf("MySQL: %s.%s", source.conf.Database, source.conf.Table)
+}
+
+func (source *MySQLSource) query() string {
+	conf := source.conf
+	fields := []string{quoteMySQL(conf.Key)}
+	for _, attr := range conf.Attributes {
+		fields = append(fields, quoteMySQL(attr.Name))
+	}
+	return fmt.Sprintf("SELECT %s FROM %s", strings.Join(fields, ", "), quoteMySQL(conf.Table))
+}
+
+func (source *MySQLSource) Load() ([][]string, error) {
+	conf := source.conf
+	port := conf.Port
+	if port == 0 {
+		port = 3306
+	}
+
+	nc, err := net.DialTimeout("tcp", net.JoinHostPort(conf.Host, fmt.Sprintf("%d", port)), 10*time.Second)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	defer nc.Close()
+
+	conn := &mysqlConn{rw: bufio.NewReadWriter(bufio.NewReader(nc), bufio.NewWriter(nc))}
+	if err := conn.handshake(conf.User, conf.Password, conf.Database); err != nil {
+		return nil, err
+	}
+	defer conn.quit()
+	return conn.query(source.query())
+}
+
+func quoteMySQL(name string) string {
+	return "`" + strings.Replace(name, "`", "``", -1) + "`"
+}
+
+type mysqlConn struct {
+	rw  *bufio.ReadWriter
+	seq byte
+}
+
+func (c *mysqlConn) readPacket() ([]byte, error) {
+	var payload []byte
+	for {
+		var header [4]byte
+		if _, err := io.ReadFull(c.rw, header[:]); err != nil {
+			return nil, errors.Wrap(err)
+		}
+		size := int(header[0]) | int(header[1])<<8 | int(header[2])<<16
+		c.seq = header[3] + 1
+
+		data := make([]byte, size)
+		if _, err := io.ReadFull(c.rw, data); err != nil {
+			return nil, errors.Wrap(err)
+		}
+		payload = append(payload, data...)
+		if size < mysqlMaxPacketSize {
+			return payload, nil
+		}
+	}
+}
+
+func (c *mysqlConn) writePacket(payload []byte) error {
+	for {
+		size := len(payload)
+		if size > mysqlMaxPacketSize {
+			size = mysqlMaxPacketSize
+		}
+		header := []byte{byte(size), byte(size >> 8), byte(size >> 16), c.seq}
+		if _, err := c.rw.Write(header); err != nil {
+			return errors.Wrap(err)
+		}
+		if _, err := c.rw.Write(payload[:size]); err != nil {
+			return errors.Wrap(err)
+		}
+		c.seq++
+		payload = payload[size:]
+		if size < mysqlMaxPacketSize {
+			break
+		}
+	}
+	if err := c.rw.Flush(); err != nil {
+		return errors.Wrap(err)
+	}
+	return nil
+}
+
+func (c *mysqlConn) handshake(user string, password string, database string) error {
+	data, err := c.readPacket()
+	if err != nil {
+		return err
+	}
+	if len(data) > 0 && data[0] == 0xff {
+		return mysqlError(data)
+	}
+	if len(data) < 1 || data[0] != 10 {
+		return errors.New("MySQL unsupported handshake protocol")
+	}
+
+	// Server version.
+	pos := 1 + bytes.IndexByte(data[1:], 0) + 1
+	// Connection id.
+	pos += 4
+	if len(data) < pos+8+1+2 {
+		return errors.New("MySQL malformed handshake packet")
+	}
+	scramble := append([]byte{}, data[pos:pos+8]...)
+	pos += 8 + 1
+	capabilities := uint32(binary.LittleEndian.Uint16(data[pos:]))
+	pos += 2
+	plugin := "mysql_native_password"
+	if len(data) > pos+5 {
+		// Charset, status, capability upper, auth data length, reserved.
+		capabilities |= uint32(binary.LittleEndian.Uint16(data[pos+3:])) << 16
+		authLen := int(data[pos+5])
+		pos += 1 + 2 + 2 + 1 + 10
+		if capabilities&mysqlClientSecureConnection != 0 {
+			n := authLen - 8
+			if n < 13 {
+				n = 13
+			}
+			if len(data) >= pos+n {
+				scramble = append(scramble, bytes.TrimRight(data[pos:pos+n], "\x00")...)
+				pos += n
+			}
+		}
+		if capabilities&mysqlClientPluginAuth != 0 && pos < len(data) {
+			if end := bytes.IndexByte(data[pos:], 0); end >= 0 {
+				plugin = string(data[pos : pos+end])
+			} else {
+				plugin = string(data[pos:])
+			}
+		}
+	}
+
+	auth, err := mysqlScramble(plugin, scramble, password)
+	if err != nil {
+		return err
+	}
+
+	flags := uint32(mysqlClientLongPassword | mysqlClientProtocol41 | mysqlClientTransactions | mysqlClientSecureConnection | mysqlClientPluginAuth)
+	if database != "" {
+		flags |= mysqlClientConnectWithDB
+	}
+	var buf bytes.Buffer
+	_ = binary.Write(&buf, binary.LittleEndian, flags)
+	_ = binary.Write(&buf, binary.LittleEndian, uint32(mysqlMaxPacketSize))
+	// utf8mb4_general_ci.
+	buf.WriteByte(45)
+	buf.Write(make([]byte, 23))
+	buf.WriteString(user)
+	buf.WriteByte(0)
+	buf.WriteByte(byte(len(auth)))
+	buf.Write(auth)
+	if database != "" {
+		buf.WriteString(database)
+		buf.WriteByte(0)
+	}
+	buf.WriteString(plugin)
+	buf.WriteByte(0)
+	if err := c.writePacket(buf.Bytes()); err != nil {
+		return err
+	}
+	return c.readAuthResult(plugin, password)
+}
+
+func (c *mysqlConn) readAuthResult(plugin string, password string) error {
+	for {
+		data, err := c.readPacket()
+		if err != nil {
+			return err
+		}
+		if len(data) == 0 {
+			return errors.New("MySQL empty auth response")
+		}
+		switch data[0] {
+		case 0x00:
+			return nil
+		case 0xff:
+			return mysqlError(data)
+		case 0xfe:
+			// Auth switch request.
+			end := bytes.IndexByte(data[1:], 0)
+			if end < 0 {
+				return errors.New("MySQL malformed auth switch request")
+			}
+			plugin = string(data[1 : 1+end])
+			scramble := bytes.TrimRight(data[1+end+1:], "\x00")
+			auth, err := mysqlScramble(plugin, scramble, password)
+			if err != nil {
+				return err
+			}
+			if err := c.writePacket(auth); err != nil {
+				return err
+			}
+		case 0x01:
+			// caching_sha2_password: 3 is fast auth success, 4 requires the full auth over TLS or RSA.
+			if len(data) > 1 && data[1] == 3 {
+				continue
+			}
+			return errors.Errorf("MySQL auth plugin:%s full authentication is not supported", plugin)
+		default:
+			return errors.Errorf("MySQL unexpected auth response:%x", data[0])
+		}
+	}
+}
+
+func (c *mysqlConn) query(query string) ([][]string, error) {
+	c.seq = 0
+	if err := c.writePacket(append([]byte{mysqlComQuery}, query...)); err != nil {
+		return nil, err
+	}
+
+	data, err := c.readPacket()
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, errors.New("MySQL empty query response")
+	}
+	if data[0] == 0xff {
+		return nil, mysqlError(data)
+	}
+	if data[0] == 0x00 {
+		return nil, nil
+	}
+	columns, _, _ := readLengthEncodedInt(data)
+
+	// Column definitions.
+	for {
+		data, err := c.readPacket()
+		if err != nil {
+			return nil, err
+		}
+		if isEOFPacket(data) {
+			break
+		}
+	}
+
+	var records [][]string
+	for {
+		data, err := c.readPacket()
+		if err != nil {
+			return nil, err
+		}
+		if isEOFPacket(data) {
+			break
+		}
+		if len(data) == 0 || data[0] == 0xff {
+			return nil, mysqlError(data)
+		}
+
+		pos := 0
+		record := make([]string, columns)
+		for i := range record {
+			if pos >= len(data) {
+				return nil, errors.New("MySQL malformed row packet")
+			}
+			if data[pos] == 0xfb {
+				// NULL.
+				pos++
+				continue
+			}
+			n, size, err := readLengthEncodedInt(data[pos:])
+			if err != nil {
+				return nil, err
+			}
+			pos += size
+			if pos+int(n) > len(data) {
+				return nil, errors.New("MySQL malformed row packet")
+			}
+			record[i] = string(data[pos : pos+int(n)])
+			pos += int(n)
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+func (c *mysqlConn) quit() {
+	c.seq = 0
+	_ = c.writePacket([]byte{mysqlComQuit})
+}
+
+func isEOFPacket(data []byte) bool {
+	return len(data) > 0 && len(data) < 9 && data[0] == 0xfe
+}
+
+func readLengthEncodedInt(data []byte) (uint64, int, error) {
+	if len(data) == 0 {
+		return 0, 0, errors.New("MySQL malformed length encoded integer")
+	}
+	switch data[0] {
+	case 0xfc:
+		if len(data) < 3 {
+			break
+		}
+		return uint64(binary.LittleEndian.Uint16(data[1:])), 3, nil
+	case 0xfd:
+		if len(data) < 4 {
+			break
+		}
+		return uint64(data[1]) | uint64(data[2])<<8 | uint64(data[3])<<16, 4, nil
+	case 0xfe:
+		if len(data) < 9 {
+			break
+		}
+		return binary.LittleEndian.Uint64(data[1:]), 9, nil
+	default:
+		return uint64(data[0]), 1, nil
+	}
+	return 0, 0, errors.New("MySQL malformed length encoded integer")
+}
+
+func mysqlError(data []byte) error {
+	if len(data) < 3 {
+		return errors.New("MySQL malformed error packet")
+	}
+	code := binary.LittleEndian.Uint16(data[1:])
+	msg := data[3:]
+	if len(msg) > 0 && msg[0] == '#' && len(msg) >= 6 {
+		msg = msg[6:]
+	}
+	return errors.Errorf("MySQL error %d: %s", code, msg)
+}
+
+func mysqlScramble(plugin string, scramble []byte, password string) ([]byte, error) {
+	if password == "" {
+		return nil, nil
+	}
+	if len(scramble) < 20 {
+		return nil, errors.Errorf("MySQL auth plugin:%s scramble too short:%d", plugin, len(scramble))
+	}
+	switch plugin {
+	case "mysql_native_password":
+		// SHA1(password) XOR SHA1(scramble + SHA1(SHA1(password))).
+		stage1 := sha1.Sum([]byte(password))
+		stage2 := sha1.Sum(stage1[:])
+		h := sha1.New()
+		h.Write(scramble[:20])
+		h.Write(stage2[:])
+		res := h.Sum(nil)
+		for i := range res {
+			res[i] ^= stage1[i]
+		}
+		return res, nil
+	case "caching_sha2_password":
+		// SHA256(password) XOR SHA256(SHA256(SHA256(password)) + scramble).
+		stage1 := sha256.Sum256([]byte(password))
+		stage2 := sha256.Sum256(stage1[:])
+		h := sha256.New()
+		h.Write(stage2[:])
+		h.Write(scramble[:20])
+		res := h.Sum(nil)
+		for i := range res {
+			res[i] ^= stage1[i]
+		}
+		return res, nil
+	default:
+		return nil, errors.Errorf("MySQL unsupported auth plugin:%s", plugin)
+	}
+}
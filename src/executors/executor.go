@@ -5,8 +5,11 @@
 package executors
 
 import (
+	"fmt"
+
 	"datastreams"
 	"processors"
+	"sessions"
 )
 
 type IExecutor interface {
@@ -14,6 +17,42 @@ type IExecutor interface {
 	Execute() (*Result, error)
 }
 
+// ILaneExecutor is the executor whose transform can run once per lane of the pipeline,
+// each Execute builds the transform of one more lane.
+type ILaneExecutor interface {
+	IExecutor
+	PerLane() bool
+}
+
+// IMergeExecutor is the lane executor whose lanes are merged by a transform of its own,
+// the plain merge of the next stage loses the order of the rows in the lanes.
+type IMergeExecutor interface {
+	ILaneExecutor
+	MergeLanes() (*Result, error)
+}
+
+type statsTransform interface {
+	Stats() sessions.ProgressValues
+}
+
+// lanesString shows the transforms of the lanes as one, with the stats of all.
+func lanesString(transformers []processors.IProcessor) string {
+	if len(transformers) == 0 {
+		return ""
+	}
+
+	name := transformers[0].Name()
+	if len(transformers) > 1 {
+		name = fmt.Sprintf("%s x %d", name, len(transformers))
+	}
+	stats := sessions.ProgressValues{}
+	for _, transformer := range transformers {
+		lane := transformer.(statsTransform).Stats()
+		stats.Merge(&lane)
+	}
+	return fmt.Sprintf("(%v, stats:%+v)", name, stats)
+}
+
 type Result struct {
 	In  processors.IProcessor
 	Out datastreams.IDataBlockOutputStream
@@ -0,0 +1,66 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package executors
+
+import (
+	"planners"
+)
+
+// checkAccess checks the plan by the privileges of the session user,
+// the readonly users can't write and all the users are limited to their databases.
+func checkAccess(ctx *ExecutorContext, plan planners.IPlan) error {
+	user := ctx.session.GetUser()
+	if user == nil {
+		return nil
+	}
+
+	var databases []string
+	current := ctx.session.GetDatabase()
+	schemaOr := func(schema string) string {
+		if schema == "" {
+			return current
+		}
+		return schema
+	}
+
+	switch plan := plan.(type) {
+	case *planners.UsePlan:
+		databases = append(databases, plan.Ast.DBName.String())
+	case *planners.CreateDatabasePlan:
+		databases = append(databases, plan.Ast.DBName)
+	case *planners.DropDatabasePlan:
+		databases = append(databases, plan.Ast.DBName)
+	case *planners.CreateTablePlan:
+		databases = append(databases, schemaOr(plan.Ast.Table.Qualifier.String()))
+	case *planners.DropTablePlan:
+		databases = append(databases, schemaOr(plan.Ast.Table.Qualifier.String()))
+	case *planners.InsertPlan:
+		databases = append(databases, schemaOr(plan.Schema))
+	}
+
+	switch plan.(type) {
+	case *planners.CreateDatabasePlan, *planners.DropDatabasePlan, *planners.CreateTablePlan, *planners.DropTablePlan, *planners.InsertPlan:
+		if err := user.CheckWrite(); err != nil {
+			return err
+		}
+	}
+
+	// The tables read by the plan.
+	if err := planners.Walk(func(plan planners.IPlan) (bool, error) {
+		if scan, ok := plan.(*planners.ScanPlan); ok {
+			databases = append(databases, schemaOr(scan.Schema))
+		}
+		return true, nil
+	}, plan); err != nil {
+		return err
+	}
+
+	for _, database := range databases {
+		if err := user.CheckDatabase(database); err != nil {
+			return err
+		}
+	}
+	return nil
+}
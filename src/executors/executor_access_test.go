@@ -0,0 +1,100 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package executors
+
+import (
+	"testing"
+
+	"config"
+	"mocks"
+	"planners"
+	"sessions"
+	"users"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecutorAccess(t *testing.T) {
+	tests := []struct {
+		name  string
+		user  string
+		query string
+		err   string
+	}{
+		{
+			name:  "reader-select",
+			user:  "reader",
+			query: "select * from db1.t1",
+		},
+		{
+			name:  "reader-select-system",
+			user:  "reader",
+			query: "select name from system.databases",
+		},
+		{
+			name:  "reader-select-denied",
+			user:  "reader",
+			query: "select * from db2.t1 where a > 1 order by a limit 1",
+			err:   "reader: Not enough privileges to access database db2 (errno 497)",
+		},
+		{
+			name:  "reader-use-denied",
+			user:  "reader",
+			query: "use db2",
+			err:   "reader: Not enough privileges to access database db2 (errno 497)",
+		},
+		{
+			name:  "reader-insert",
+			user:  "reader",
+			query: "insert into db1.t1 values",
+			err:   "reader: Cannot execute query in readonly mode (errno 164)",
+		},
+		{
+			name:  "reader-create",
+			user:  "reader",
+			query: "create database db1",
+			err:   "reader: Cannot execute query in readonly mode (errno 164)",
+		},
+		{
+			name:  "writer-drop-table",
+			user:  "writer",
+			query: "drop table db1.t1",
+		},
+		{
+			name:  "writer-create-table-denied",
+			user:  "writer",
+			query: "create table db2.t1(a UInt32) Engine=Memory",
+			err:   "writer: Not enough privileges to access database db2 (errno 497)",
+		},
+	}
+
+	mock, cleanup := mocks.NewMock()
+	defer cleanup()
+
+	conf := config.DefaultConfig()
+	conf.Users = []config.User{
+		{Name: "reader", Databases: []string{"db1"}, Readonly: true},
+		{Name: "writer", Databases: []string{"db1"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			user, err := users.Authenticate(conf, test.user, "")
+			assert.Nil(t, err)
+			session := sessions.NewSession()
+			defer session.Close()
+			session.SetUser(user)
+
+			plan, err := planners.PlanFactory(test.query)
+			assert.Nil(t, err)
+			ctx := NewExecutorContext(mock.Ctx, mock.Log, mock.Conf, session)
+			_, err = ExecutorFactory(ctx, plan)
+			if test.err != "" {
+				assert.Equal(t, test.err, err.Error())
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
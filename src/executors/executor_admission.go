@@ -0,0 +1,94 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package executors
+
+import (
+	"sync"
+	"time"
+
+	"config"
+	"sessions"
+
+	"base/errors"
+)
+
+// admission counts the queries running by max_concurrent_queries and max_concurrent_queries_for_user,
+// the ones beyond the limits wait for a query to leave up to queue_max_wait_ms.
+type admission struct {
+	mu      sync.Mutex
+	running int
+	users   map[string]int
+	// left is closed and replaced each time a query leaves, the waiting ones check the limits again.
+	left chan struct{}
+}
+
+var globalAdmission = &admission{
+	users: make(map[string]int),
+	left:  make(chan struct{}),
+}
+
+// enter admits the query of the user, it waits in the queue while the limits are reached.
+func (a *admission) enter(conf *config.Config, user string) error {
+	limit, userLimit := conf.Server.MaxConcurrentQueries, conf.Runtime.MaxConcurrentQueriesForUser
+	wait := time.Duration(conf.Runtime.QueueMaxWaitMs) * time.Millisecond
+
+	var timer *time.Timer
+	a.mu.Lock()
+	for {
+		err := a.check(limit, userLimit, user)
+		if err == nil {
+			a.running++
+			a.users[user]++
+			sessions.QueryMetric.Add(1)
+			if timer != nil {
+				timer.Stop()
+				sessions.QueryQueuedMetric.Add(-1)
+			}
+			a.mu.Unlock()
+			return nil
+		}
+		if wait <= 0 {
+			a.mu.Unlock()
+			return err
+		}
+		if timer == nil {
+			timer = time.NewTimer(wait)
+			sessions.QueryQueuedMetric.Add(1)
+		}
+		left := a.left
+		a.mu.Unlock()
+
+		select {
+		case <-left:
+			a.mu.Lock()
+		case <-timer.C:
+			sessions.QueryQueuedMetric.Add(-1)
+			return err
+		}
+	}
+}
+
+func (a *admission) check(limit int, userLimit int, user string) error {
+	if limit > 0 && a.running >= limit {
+		return errors.ErrorWithCode(errors.TOO_MANY_SIMULTANEOUS_QUERIES, "Too many simultaneous queries. Maximum: %d", limit)
+	}
+	if userLimit > 0 && a.users[user] >= userLimit {
+		return errors.ErrorWithCode(errors.TOO_MANY_SIMULTANEOUS_QUERIES, "Too many simultaneous queries for user %s. Current: %d, maximum: %d", user, a.users[user], userLimit)
+	}
+	return nil
+}
+
+// leave gives the slot of the query back and wakes the waiting ones.
+func (a *admission) leave(user string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.running--
+	if a.users[user]--; a.users[user] <= 0 {
+		delete(a.users, user)
+	}
+	sessions.QueryMetric.Add(-1)
+	close(a.left)
+	a.left = make(chan struct{})
+}
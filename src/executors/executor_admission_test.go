@@ -0,0 +1,96 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package executors
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"config"
+	"sessions"
+	"users"
+
+	"base/errors"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdmission(t *testing.T) {
+	conf := config.DefaultConfig()
+	conf.Server.MaxConcurrentQueries = 2
+	conf.Runtime.MaxConcurrentQueriesForUser = 1
+
+	alice := sessions.NewSession()
+	defer alice.Close()
+	alice.SetUser(&users.User{Name: "alice"})
+	bob := sessions.NewSession()
+	defer bob.Close()
+	bob.SetUser(&users.User{Name: "bob"})
+	carol := sessions.NewSession()
+	defer carol.Close()
+	carol.SetUser(&users.User{Name: "carol"})
+
+	start := func(conf *config.Config, session *sessions.Session) (*ExecutionLimits, error) {
+		_, limits := NewExecutionLimits(context.Background(), conf)
+		if _, err := limits.StartQuery("", "select 1", session); err != nil {
+			limits.Cancel()
+			return nil, err
+		}
+		return limits, nil
+	}
+	running := sessions.QueryMetric.Value()
+
+	// The limit of the user.
+	q1, err := start(conf, alice)
+	assert.Nil(t, err)
+	_, err = start(conf, alice)
+	assert.Equal(t, errors.TOO_MANY_SIMULTANEOUS_QUERIES, errors.Code(err))
+	assert.Equal(t, "Too many simultaneous queries for user alice. Current: 1, maximum: 1 (errno 202)", err.Error())
+
+	// The limit of the server.
+	q2, err := start(conf, bob)
+	assert.Nil(t, err)
+	assert.Equal(t, running+2, sessions.QueryMetric.Value())
+	_, err = start(conf, carol)
+	assert.Equal(t, "Too many simultaneous queries. Maximum: 2 (errno 202)", err.Error())
+
+	// The queued query waits for a slot.
+	queued := *conf
+	queued.Runtime.QueueMaxWaitMs = 1000
+	done := make(chan error)
+	var q3 *ExecutionLimits
+	go func() {
+		var err error
+		q3, err = start(&queued, carol)
+		done <- err
+	}()
+	for sessions.QueryQueuedMetric.Value() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	q1.Cancel()
+	q1.Cancel()
+	assert.Nil(t, <-done)
+	assert.Equal(t, int64(0), sessions.QueryQueuedMetric.Value())
+
+	// The queued one beyond the wait fails.
+	queued.Runtime.QueueMaxWaitMs = 10
+	_, err = start(&queued, alice)
+	assert.Equal(t, errors.TOO_MANY_SIMULTANEOUS_QUERIES, errors.Code(err))
+	assert.Equal(t, int64(0), sessions.QueryQueuedMetric.Value())
+
+	// The query panicking gives its slot back by the deferred Cancel.
+	func() {
+		defer func() { recover() }()
+		_, limits := NewExecutionLimits(context.Background(), conf)
+		defer limits.Cancel()
+		q2.Cancel()
+		_, err := limits.StartQuery("", "select 1", alice)
+		assert.Nil(t, err)
+		panic("query")
+	}()
+	q3.Cancel()
+	assert.Equal(t, running, sessions.QueryMetric.Value())
+}
@@ -9,7 +9,9 @@ import (
 
 	"base/xlog"
 	"config"
+	"databases"
 	"sessions"
+	"storages"
 )
 
 type ExecutorContext struct {
@@ -18,17 +20,44 @@ type ExecutorContext struct {
 	conf             *config.Config
 	session          *sessions.Session
 	progressCallback func(values *sessions.ProgressValues)
+	profileValues    *sessions.ProfileValues
 }
 
 func NewExecutorContext(ctx context.Context, log *xlog.Log, conf *config.Config, session *sessions.Session) *ExecutorContext {
 	return &ExecutorContext{
-		log:     log,
-		ctx:     ctx,
-		conf:    conf,
-		session: session,
+		log:           log,
+		ctx:           ctx,
+		conf:          conf,
+		session:       session,
+		profileValues: &sessions.ProfileValues{},
 	}
 }
 
 func (ctx *ExecutorContext) SetProgressCallback(fn func(pv *sessions.ProgressValues)) {
 	ctx.progressCallback = fn
 }
+
+// withConf returns the context of the query with its own settings.
+func (ctx *ExecutorContext) withConf(conf *config.Config) *ExecutorContext {
+	c := *ctx
+	c.conf = conf
+	return &c
+}
+
+// ProfileValues returns the result statistics collected by the transforms, such as the rows before limit.
+func (ctx *ExecutorContext) ProfileValues() *sessions.ProfileValues {
+	return ctx.profileValues
+}
+
+// getStorage returns the storage of the table, the unqualified name is looked up
+// in the session temporary tables first and then in the current database.
+func (ctx *ExecutorContext) getStorage(schema string, table string) (storages.IStorage, error) {
+	if schema == "" {
+		if temporary, ok := ctx.session.GetTemporaryTable(table); ok {
+			return temporary.(storages.IStorage), nil
+		}
+		schema = ctx.session.GetDatabase()
+	}
+	databaseCtx := databases.NewDatabaseContext(ctx.log, ctx.conf)
+	return databases.GetStorage(databaseCtx, schema, table)
+}
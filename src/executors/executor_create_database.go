@@ -33,6 +33,7 @@ func (executor *CreateDatabaseExecutor) Execute() (*Result, error) {
 	if err := database.Executor().CreateDatabase(); err != nil {
 		return nil, err
 	}
+	globalTableVersions.bump(database.Name(), "")
 
 	result := NewResult()
 	return result, nil
@@ -26,7 +26,7 @@ func TestCreateDatabaseExecutor(t *testing.T) {
 		{
 			name:  "create-exists",
 			query: "create database db1",
-			err:   "database:db1 exists",
+			err:   "database:db1 exists (errno 82)",
 		},
 		{
 			name:  "drop-db",
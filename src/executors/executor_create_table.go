@@ -5,6 +5,7 @@
 package executors
 
 import (
+	"base/errors"
 	"databases"
 	"planners"
 )
@@ -25,6 +26,10 @@ func (executor *CreateTableExecutor) Execute() (*Result, error) {
 	ectx := executor.ctx
 	ast := executor.plan.Ast
 
+	if ast.Temporary {
+		return executor.createTemporary()
+	}
+
 	schema := ectx.session.GetDatabase()
 	if !ast.Table.Qualifier.IsEmpty() {
 		schema = ast.Table.Qualifier.String()
@@ -37,11 +42,33 @@ func (executor *CreateTableExecutor) Execute() (*Result, error) {
 	if err := database.Executor().CreateTable(ast); err != nil {
 		return nil, err
 	}
+	globalTableVersions.bump(schema, ast.Table.Name.String())
 
 	result := NewResult()
 	return result, nil
 }
 
+// createTemporary creates the table visible only within the session, dropped when the session closes.
+func (executor *CreateTableExecutor) createTemporary() (*Result, error) {
+	ectx := executor.ctx
+	ast := executor.plan.Ast
+
+	if !ast.Table.Qualifier.IsEmpty() {
+		return nil, errors.Errorf("Temporary table %s can't have the database:%s", ast.Table.Name.String(), ast.Table.Qualifier.String())
+	}
+	name := ast.Table.Name.String()
+	databaseCtx := databases.NewDatabaseContext(ectx.log, ectx.conf)
+	storage, err := databases.NewTemporaryStorage(databaseCtx, ast)
+	if err != nil {
+		return nil, err
+	}
+	if err := ectx.session.AddTemporaryTable(name, storage); err != nil {
+		storage.Close()
+		return nil, err
+	}
+	return NewResult(), nil
+}
+
 func (executor *CreateTableExecutor) String() string {
 	return ""
 }
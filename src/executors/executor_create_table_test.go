@@ -30,7 +30,7 @@ func TestCreateTableExecutor(t *testing.T) {
 		{
 			name:  "create-table-exists",
 			query: "create table db1.t1(a UInt32) Engine=Memory",
-			err:   "db1.t1 exists",
+			err:   "db1.t1 exists (errno 57)",
 		},
 		{
 			name:  "drop",
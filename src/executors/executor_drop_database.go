@@ -33,6 +33,7 @@ func (executor *DropDatabaseExecutor) Execute() (*Result, error) {
 	if err := database.Executor().DropDatabase(); err != nil {
 		return nil, err
 	}
+	globalTableVersions.bump(database.Name(), "")
 
 	result := NewResult()
 	return result, nil
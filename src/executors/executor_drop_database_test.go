@@ -30,7 +30,7 @@ func TestDropDatabaseExecutor(t *testing.T) {
 		{
 			name:  "drop-not-exists",
 			query: "drop database xxdb1",
-			err:   "database:xxdb1 doesn't exists",
+			err:   "database:xxdb1 doesn't exists (errno 81)",
 		},
 	}
 
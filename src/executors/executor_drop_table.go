@@ -25,6 +25,11 @@ func (executor *DropTableExecutor) Execute() (*Result, error) {
 	ectx := executor.ctx
 	ast := executor.plan.Ast
 
+	// The temporary table hides the table of the current database.
+	if ast.FromTables[0].Qualifier.IsEmpty() && ectx.session.DropTemporaryTable(ast.FromTables[0].Name.String()) {
+		return NewResult(), nil
+	}
+
 	schema := ectx.session.GetDatabase()
 	if !ast.FromTables[0].Qualifier.IsEmpty() {
 		schema = ast.FromTables[0].Qualifier.String()
@@ -38,6 +43,7 @@ func (executor *DropTableExecutor) Execute() (*Result, error) {
 	if err := database.Executor().DropTable(table); err != nil {
 		return nil, err
 	}
+	globalTableVersions.bump(schema, table)
 
 	result := NewResult()
 	return result, nil
@@ -34,7 +34,7 @@ func TestDropTableExecutor(t *testing.T) {
 		{
 			name:  "drop-table-not-exists",
 			query: "drop table db1.t111",
-			err:   "db1.t111 doesn't exists",
+			err:   "db1.t111 doesn't exists (errno 60)",
 		},
 		{
 			name:  "drop",
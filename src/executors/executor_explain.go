@@ -0,0 +1,62 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package executors
+
+import (
+	"columns"
+	"datablocks"
+	"datastreams"
+	"datatypes"
+	"datavalues"
+	"planners"
+	"processors"
+	"transforms"
+)
+
+type ExplainExecutor struct {
+	ctx  *ExecutorContext
+	plan *planners.ExplainPlan
+}
+
+func NewExplainExecutor(ctx *ExecutorContext, plan planners.IPlan) IExecutor {
+	return &ExplainExecutor{
+		ctx:  ctx,
+		plan: plan.(*planners.ExplainPlan),
+	}
+}
+
+// Execute builds the pipeline of the SELECT without running it, the result is one row per stage.
+func (executor *ExplainExecutor) Execute() (*Result, error) {
+	ectx := executor.ctx
+
+	selector := NewSelectExecutor(ectx, executor.plan.SubPlan).(*SelectExecutor)
+	pipeline, err := selector.buildPipeline()
+	if err != nil {
+		return nil, err
+	}
+
+	block := datablocks.NewDataBlock([]*columns.Column{
+		columns.NewColumn("explain", datatypes.NewStringDataType()),
+	})
+	for _, stage := range pipeline.Explain() {
+		if err := block.WriteRow([]datavalues.IDataValue{datavalues.MakeString(stage)}); err != nil {
+			return nil, err
+		}
+	}
+
+	transformCtx := transforms.NewTransformContext(ectx.ctx, ectx.log, ectx.conf)
+	explain := processors.NewPipeline(ectx.ctx).
+		Add(transforms.NewDataSourceTransform(transformCtx, datastreams.NewOneBlockInputStream(block))).
+		Add(processors.NewSink("transforms_sink"))
+	explain.Run()
+
+	result := NewResult()
+	result.SetInput(explain.Last())
+	return result, nil
+}
+
+func (executor *ExplainExecutor) String() string {
+	return ""
+}
@@ -0,0 +1,167 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package executors
+
+import (
+	"testing"
+
+	"datablocks"
+	"datavalues"
+	"mocks"
+	"optimizers"
+	"planners"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExplainPipelineExecutor(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		optimize bool
+		expect   []string
+	}{
+		{
+			name:  "max-threads",
+			query: "EXPLAIN PIPELINE SELECT number FROM system.numbers WHERE number > 1 LIMIT 3 SETTINGS max_threads = 4",
+			expect: []string{
+				"transform_datasource",
+				"resize 1 -> 4",
+				"transform_filter x 4",
+				"transform_normal_selection x 4",
+				"transform_limit",
+				"transform_projection",
+				"transforms_sink",
+			},
+		},
+		{
+			name:  "single-thread",
+			query: "EXPLAIN PIPELINE SELECT number FROM system.numbers WHERE number > 1 LIMIT 3 SETTINGS max_threads = 1",
+			expect: []string{
+				"transform_datasource",
+				"transform_filter",
+				"transform_normal_selection",
+				"transform_limit",
+				"transform_projection",
+				"transforms_sink",
+			},
+		},
+		{
+			name:  "aggregate",
+			query: "EXPLAIN PIPELINE SELECT count(number) FROM system.numbers WHERE number > 1 SETTINGS max_threads = 2",
+			expect: []string{
+				"transform_datasource",
+				"resize 1 -> 2",
+				"transform_filter x 2",
+				"transform_aggregate_selection",
+				"transform_projection",
+				"transforms_sink",
+			},
+		},
+		{
+			name:     "predicate-pushdown",
+			query:    "EXPLAIN PIPELINE SELECT number FROM system.numbers WHERE number > 1 AND number < 10 SETTINGS max_threads = 1",
+			optimize: true,
+			expect: []string{
+				"transform_datasource (pushed: (number>1) AND (number<10))",
+				"transform_normal_selection",
+				"transform_projection",
+				"transforms_sink",
+			},
+		},
+		{
+			name:     "constant-folding",
+			query:    "EXPLAIN PIPELINE SELECT number FROM system.numbers WHERE 1 = 1 AND number > 2 * 3 SETTINGS max_threads = 1",
+			optimize: true,
+			expect: []string{
+				"transform_datasource (pushed: (number>6))",
+				"transform_normal_selection",
+				"transform_projection",
+				"transforms_sink",
+			},
+		},
+		{
+			name:     "constant-false",
+			query:    "EXPLAIN PIPELINE SELECT number FROM system.numbers WHERE number > 1 AND 1 > 2 SETTINGS max_threads = 1",
+			optimize: true,
+			expect: []string{
+				"transform_datasource (pushed: false)",
+				"transform_normal_selection",
+				"transform_projection",
+				"transforms_sink",
+			},
+		},
+		{
+			name:     "limit-pushdown",
+			query:    "EXPLAIN PIPELINE SELECT number FROM system.numbers WHERE number > 1 LIMIT 3 SETTINGS max_threads = 1",
+			optimize: true,
+			expect: []string{
+				"transform_datasource (pushed: (number>1), limit 3)",
+				"transform_normal_selection",
+				"transform_limit",
+				"transform_projection",
+				"transforms_sink",
+			},
+		},
+		{
+			name:     "partial-sort",
+			query:    "EXPLAIN PIPELINE SELECT number FROM system.numbers ORDER BY number DESC LIMIT 2, 3 SETTINGS max_threads = 4",
+			optimize: true,
+			expect: []string{
+				"transform_datasource",
+				"resize 1 -> 4",
+				"transform_normal_selection x 4",
+				"transform_orderby (partial sort, limit 5) x 4",
+				"transform_orderby (partial sort, limit 5)",
+				"transform_limit",
+				"transform_projection",
+				"transforms_sink",
+			},
+		},
+		{
+			name:     "partial-sort-single-thread",
+			query:    "EXPLAIN PIPELINE SELECT number FROM system.numbers ORDER BY number DESC LIMIT 3 SETTINGS max_threads = 1",
+			optimize: true,
+			expect: []string{
+				"transform_datasource",
+				"transform_normal_selection",
+				"transform_orderby (partial sort, limit 3)",
+				"transform_limit",
+				"transform_projection",
+				"transforms_sink",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mock, cleanup := mocks.NewMock()
+			defer cleanup()
+
+			plan, err := planners.PlanFactory(test.query)
+			assert.Nil(t, err)
+			if test.optimize {
+				plan = optimizers.Optimize(mock.Conf, plan, optimizers.DefaultOptimizers)
+			}
+
+			ctx := NewExecutorContext(mock.Ctx, mock.Log, mock.Conf, mock.Session)
+			executor, err := ExecutorFactory(ctx, plan)
+			assert.Nil(t, err)
+
+			result, err := executor.Execute()
+			assert.Nil(t, err)
+
+			var actual []string
+			for x := range result.Read() {
+				block := x.(*datablocks.DataBlock)
+				iter := block.RowIterator()
+				for iter.Next() {
+					actual = append(actual, datavalues.AsString(iter.Value()[0]))
+				}
+			}
+			assert.Equal(t, test.expect, actual)
+		})
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package executors
+
+import (
+	"fmt"
+	"strings"
+
+	"base/errors"
+	"base/xlog"
+	"columns"
+	"config"
+	"datastreams"
+	"datatypes"
+	"sessions"
+	"storages"
+)
+
+// ExternalTables are the temporary tables shipped along with the query,
+// such as the multipart files of HTTP or the named Data packets of TCP.
+// They are added to the session before the planning and dropped when the query ends.
+type ExternalTables struct {
+	log     *xlog.Log
+	conf    *config.Config
+	session *sessions.Session
+	tables  map[string]storages.IStorage
+	names   []string
+}
+
+func NewExternalTables(log *xlog.Log, conf *config.Config, session *sessions.Session) *ExternalTables {
+	return &ExternalTables{
+		log:     log,
+		conf:    conf,
+		session: session,
+		tables:  make(map[string]storages.IStorage),
+	}
+}
+
+// Write appends the blocks of the input to the table, the table is created by the columns
+// on the first write and the next writes must have the same columns.
+func (external *ExternalTables) Write(name string, cols []*columns.Column, input datastreams.IDataBlockInputStream) error {
+	storage, err := external.table(name, cols)
+	if err != nil {
+		return err
+	}
+	output, err := storage.GetOutputStream(external.session)
+	if err != nil {
+		return err
+	}
+
+	for {
+		block, err := input.Read()
+		if err != nil {
+			return err
+		}
+		if block == nil {
+			break
+		}
+		if err := output.Write(block); err != nil {
+			return err
+		}
+	}
+	return output.Finalize()
+}
+
+func (external *ExternalTables) table(name string, cols []*columns.Column) (storages.IStorage, error) {
+	if name == "" {
+		return nil, errors.New("External table name is empty")
+	}
+	if storage, ok := external.tables[name]; ok {
+		if columnsText(storage.Columns()) != columnsText(cols) {
+			return nil, errors.Errorf("External table %s structure mismatch: %s, but got %s", name, columnsText(storage.Columns()), columnsText(cols))
+		}
+		return storage, nil
+	}
+
+	storageCtx := storages.NewStorageContext(external.log, external.conf)
+	storage, err := storages.StorageFactory(storageCtx, storages.MemoryStorageEngineName, cols)
+	if err != nil {
+		return nil, err
+	}
+	if err := external.session.AddTemporaryTable(name, storage); err != nil {
+		storage.Close()
+		return nil, err
+	}
+	external.tables[name] = storage
+	external.names = append(external.names, name)
+	return storage, nil
+}
+
+// Close drops the tables from the session.
+func (external *ExternalTables) Close() {
+	for _, name := range external.names {
+		external.session.DropTemporaryTable(name)
+	}
+	external.tables = make(map[string]storages.IStorage)
+	external.names = nil
+}
+
+// ParseStructure parses the columns of the '_structure' parameter, such as 'id UInt32, name String'.
+func ParseStructure(structure string) ([]*columns.Column, error) {
+	var cols []*columns.Column
+	for _, field := range strings.Split(structure, ",") {
+		parts := strings.Fields(field)
+		if len(parts) != 2 {
+			return nil, errors.Errorf("Invalid external table structure:%s", structure)
+		}
+		datatype, err := datatypes.DataTypeFactory(parts[1])
+		if err != nil {
+			return nil, err
+		}
+		cols = append(cols, columns.NewColumn(parts[0], datatype))
+	}
+	return cols, nil
+}
+
+// ParseTypes parses the columns of the '_types' parameter, such as 'UInt32,String',
+// the columns are named _1, _2 and so on.
+func ParseTypes(types string) ([]*columns.Column, error) {
+	var cols []*columns.Column
+	for i, name := range strings.Split(types, ",") {
+		datatype, err := datatypes.DataTypeFactory(strings.TrimSpace(name))
+		if err != nil {
+			return nil, err
+		}
+		cols = append(cols, columns.NewColumn(fmt.Sprintf("_%d", i+1), datatype))
+	}
+	return cols, nil
+}
+
+func columnsText(cols []*columns.Column) string {
+	fields := make([]string, len(cols))
+	for i, col := range cols {
+		fields[i] = col.Name + " " + col.DataType.Name()
+	}
+	return strings.Join(fields, ", ")
+}
@@ -0,0 +1,122 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package executors
+
+import (
+	"testing"
+
+	"columns"
+	"datablocks"
+	"datastreams"
+	"datatypes"
+	"datavalues"
+	"mocks"
+	"planners"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseStructure(t *testing.T) {
+	tests := []struct {
+		name      string
+		structure string
+		types     string
+		expect    string
+		err       string
+	}{
+		{
+			name:      "structure",
+			structure: "id UInt32, name String",
+			expect:    "id UInt32, name String",
+		},
+		{
+			name:   "types",
+			types:  "UInt32, String",
+			expect: "_1 UInt32, _2 String",
+		},
+		{
+			name:      "structure-invalid",
+			structure: "id",
+			err:       "Invalid external table structure:id",
+		},
+		{
+			name:      "structure-unknown-type",
+			structure: "id XX",
+			err:       "Unsupported data type:XX (errno 50)",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var cols []*columns.Column
+			var err error
+			if test.structure != "" {
+				cols, err = ParseStructure(test.structure)
+			} else {
+				cols, err = ParseTypes(test.types)
+			}
+			if test.err != "" {
+				assert.Equal(t, test.err, err.Error())
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, test.expect, columnsText(cols))
+		})
+	}
+}
+
+func TestExternalTables(t *testing.T) {
+	mock, cleanup := mocks.NewMock()
+	defer cleanup()
+
+	cols := []*columns.Column{
+		columns.NewColumn("id", datatypes.NewUInt32DataType()),
+	}
+	newBlock := func(ids ...int) *datablocks.DataBlock {
+		block := datablocks.NewDataBlock(cols)
+		for _, id := range ids {
+			assert.Nil(t, block.WriteRow([]datavalues.IDataValue{datavalues.MakeInt(int64(id))}))
+		}
+		return block
+	}
+
+	external := NewExternalTables(mock.Log, mock.Conf, mock.Session)
+	assert.Nil(t, external.Write("ids", cols, datastreams.NewOneBlockInputStream(newBlock(1, 2))))
+	assert.Nil(t, external.Write("ids", cols, datastreams.NewOneBlockInputStream(newBlock(3))))
+
+	// The next writes must have the same columns.
+	other := []*columns.Column{columns.NewColumn("name", datatypes.NewStringDataType())}
+	err := external.Write("ids", other, datastreams.NewOneBlockInputStream(datablocks.NewDataBlock(other)))
+	assert.Equal(t, "External table ids structure mismatch: id UInt32, but got name String", err.Error())
+
+	count := func() (int, error) {
+		plan, err := planners.PlanFactory("select id from ids")
+		assert.Nil(t, err)
+		ctx := NewExecutorContext(mock.Ctx, mock.Log, mock.Conf, mock.Session)
+		executor, err := ExecutorFactory(ctx, plan)
+		assert.Nil(t, err)
+		result, err := executor.Execute()
+		if err != nil {
+			return 0, err
+		}
+		rows := 0
+		for x := range result.In.In().Recv() {
+			if block, ok := x.(*datablocks.DataBlock); ok {
+				rows += block.NumRows()
+			}
+		}
+		return rows, nil
+	}
+	rows, err := count()
+	assert.Nil(t, err)
+	assert.Equal(t, 3, rows)
+
+	// Dropped when the query ends.
+	external.Close()
+	_, ok := mock.Session.GetTemporaryTable("ids")
+	assert.False(t, ok)
+	_, err = count()
+	assert.NotNil(t, err)
+}
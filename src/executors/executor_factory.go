@@ -24,12 +24,17 @@ var table = map[reflect.Type]executorCreator{
 	reflect.TypeOf(&planners.ShowDatabasesPlan{}):  NewShowDatabasesExecutor,
 	reflect.TypeOf(&planners.ShowTablesPlan{}):     NewShowTablesExecutor,
 	reflect.TypeOf(&planners.InsertPlan{}):         NewInsertExecutor,
+	reflect.TypeOf(&planners.SetPlan{}):            NewSetExecutor,
+	reflect.TypeOf(&planners.ExplainPlan{}):        NewExplainExecutor,
 }
 
 func ExecutorFactory(ctx *ExecutorContext, plan planners.IPlan) (IExecutor, error) {
 	creator, ok := table[reflect.TypeOf(plan)]
 	if !ok {
-		return nil, errors.Errorf("Couldn't get the executor:%T", plan)
+		return nil, errors.ErrorWithCode(errors.NOT_IMPLEMENTED, "Couldn't get the executor:%T", plan)
+	}
+	if err := checkAccess(ctx, plan); err != nil {
+		return nil, err
 	}
 	return creator(ctx, plan), nil
 }
@@ -5,17 +5,15 @@
 package executors
 
 import (
-	"fmt"
-
 	"planners"
 	"processors"
 	"transforms"
 )
 
 type FilterExecutor struct {
-	ctx         *ExecutorContext
-	filter      *planners.FilterPlan
-	transformer processors.IProcessor
+	ctx          *ExecutorContext
+	filter       *planners.FilterPlan
+	transformers []processors.IProcessor
 }
 
 func NewFilterExecutor(ctx *ExecutorContext, filter *planners.FilterPlan) IExecutor {
@@ -31,14 +29,17 @@ func (executor *FilterExecutor) Execute() (*Result, error) {
 
 	transformCtx := transforms.NewTransformContext(executor.ctx.ctx, log, conf)
 	transform := transforms.NewFilterTransform(transformCtx, executor.filter)
-	executor.transformer = transform
+	executor.transformers = append(executor.transformers, transform)
 
 	result := NewResult()
 	result.SetInput(transform)
 	return result, nil
 }
 
+func (executor *FilterExecutor) PerLane() bool {
+	return true
+}
+
 func (executor *FilterExecutor) String() string {
-	transformer := executor.transformer.(*transforms.FilterTransform)
-	return fmt.Sprintf("(%v, stats:%+v)", transformer.Name(), transformer.Stats())
+	return lanesString(executor.transformers)
 }
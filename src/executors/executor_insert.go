@@ -5,13 +5,18 @@
 package executors
 
 import (
-	"databases"
+	"fmt"
+
+	"base/errors"
+	"datablocks"
+	"datastreams"
 	"planners"
 )
 
 type InsertExecutor struct {
-	ctx  *ExecutorContext
-	plan *planners.InsertPlan
+	ctx      *ExecutorContext
+	plan     *planners.InsertPlan
+	selector IExecutor
 }
 
 func NewInsertExecutor(ctx *ExecutorContext, plan planners.IPlan) IExecutor {
@@ -22,19 +27,10 @@ func NewInsertExecutor(ctx *ExecutorContext, plan planners.IPlan) IExecutor {
 }
 
 func (executor *InsertExecutor) Execute() (*Result, error) {
-	log := executor.ctx.log
 	plan := executor.plan
-	conf := executor.ctx.conf
 	session := executor.ctx.session
 
-	schema := session.GetDatabase()
-	if plan.Schema != "" {
-		schema = plan.Schema
-	}
-	table := plan.Table
-
-	databaseCtx := databases.NewDatabaseContext(log, conf)
-	storage, err := databases.GetStorage(databaseCtx, schema, table)
+	storage, err := executor.ctx.getStorage(plan.Schema, plan.Table)
 	if err != nil {
 		return nil, err
 	}
@@ -43,12 +39,59 @@ func (executor *InsertExecutor) Execute() (*Result, error) {
 	if err != nil {
 		return nil, err
 	}
+	schema := plan.Schema
+	if schema == "" {
+		schema = session.GetDatabase()
+	}
+	output = &versionedOutputStream{IDataBlockOutputStream: output, database: schema, table: plan.Table}
 
 	result := NewResult()
+	if plan.SubPlan != nil {
+		if err := executor.insertSelect(output); err != nil {
+			return nil, err
+		}
+		return result, nil
+	}
 	result.SetOutput(output)
 	return result, nil
 }
 
+// insertSelect writes all the blocks of the SELECT into the table output,
+// the columns are matched by position and the values are re-typed to the table columns.
+func (executor *InsertExecutor) insertSelect(output datastreams.IDataBlockOutputStream) error {
+	executor.selector = NewSelectExecutor(executor.ctx, executor.plan.SubPlan)
+	result, err := executor.selector.Execute()
+	if err != nil {
+		return err
+	}
+
+	header := output.SampleBlock()
+	for x := range result.Read() {
+		switch x := x.(type) {
+		case error:
+			return x
+		case *datablocks.DataBlock:
+			if x.NumColumns() != header.NumColumns() {
+				return errors.Errorf("Number of columns doesn't match, table:%v, select:%v", header.NumColumns(), x.NumColumns())
+			}
+			block := header.Clone()
+			it := x.RowIterator()
+			for it.Next() {
+				if err := block.WriteRow(it.Value()); err != nil {
+					return err
+				}
+			}
+			if err := output.Write(block); err != nil {
+				return err
+			}
+		}
+	}
+	return output.Finalize()
+}
+
 func (executor *InsertExecutor) String() string {
+	if executor.selector != nil {
+		return fmt.Sprintf("(insert select: %v)", executor.selector.String())
+	}
 	return ""
 }
@@ -31,6 +31,19 @@ func TestInsertExecutor(t *testing.T) {
 			name:  "insert into db1.t1 values",
 			query: "insert into db1.t1 values",
 		},
+		{
+			name:  "create-table",
+			query: "create table db1.t2(a Int32, b String, c DateTime) Engine=Memory",
+		},
+		{
+			name:  "insert into db1.t2 select",
+			query: "insert into db1.t2 select * from generateRandom('a Int32, b String, c DateTime', 1, 8) limit 100",
+		},
+		{
+			name:  "insert into db1.t2 select columns mismatch",
+			query: "insert into db1.t2 select * from generateRandom('a Int32', 1, 8) limit 100",
+			err:   "Number of columns doesn't match, table:3, select:1",
+		},
 		{
 			name:  "drop",
 			query: "drop database db1",
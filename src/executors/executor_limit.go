@@ -30,6 +30,7 @@ func (executor *LimitExecutor) Execute() (*Result, error) {
 	conf := executor.ctx.conf
 
 	transformCtx := transforms.NewTransformContext(executor.ctx.ctx, log, conf)
+	transformCtx.SetProfileValues(executor.ctx.profileValues)
 	transform := transforms.NewLimitransform(transformCtx, executor.plan)
 	executor.transformer = transform
 
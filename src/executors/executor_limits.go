@@ -0,0 +1,158 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package executors
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"config"
+	"sessions"
+
+	"base/errors"
+)
+
+// ExecutionLimits enforces the max_execution_time, min_execution_speed, max_memory_usage
+// and max_rows_to_read/max_bytes_to_read of a query.
+// The deadline is on the query context, the transforms stop between the blocks once it's done.
+// The memory tracker and the read quota are on the query context too, the transforms charge them.
+type ExecutionLimits struct {
+	mu     sync.Mutex
+	conf   *config.Config
+	start  time.Time
+	memory *sessions.MemoryTracker
+	quota  *sessions.ReadQuota
+	cancel context.CancelFunc
+	// The query in system.processes, from StartQuery.
+	process *sessions.Process
+	// The user of the query admitted by StartQuery, Cancel gives its slot back.
+	admitted bool
+	user     string
+	// The error of the speed check, the context is cancelled with it.
+	err error
+}
+
+// NewExecutionLimits derives the query context from the parent, Cancel releases it.
+func NewExecutionLimits(parent context.Context, conf *config.Config) (context.Context, *ExecutionLimits) {
+	runtime := conf.Runtime
+	limits := &ExecutionLimits{
+		conf:   conf,
+		start:  time.Now(),
+		memory: sessions.NewMemoryTracker("query", int64(runtime.MaxMemoryUsage), sessions.ServerMemoryTracker()),
+		quota:  sessions.NewReadQuota(int64(runtime.MaxRowsToRead), int64(runtime.MaxBytesToRead), runtime.ReadOverflowMode == config.OverflowModeBreak),
+	}
+	parent = sessions.WithMemoryTracker(parent, limits.memory)
+	parent = sessions.WithReadQuota(parent, limits.quota)
+
+	var ctx context.Context
+	if runtime.MaxExecutionTime > 0 {
+		ctx, limits.cancel = context.WithTimeout(parent, time.Duration(runtime.MaxExecutionTime)*time.Second)
+	} else {
+		ctx, limits.cancel = context.WithCancel(parent)
+	}
+	globalRunningQueries.add(limits)
+	return ctx, limits
+}
+
+// StartQuery admits the query by max_concurrent_queries and max_concurrent_queries_for_user, waiting for
+// queue_max_wait_ms beyond them, then registers it for system.processes by the query_id of the client,
+// a new one if it's empty, it returns the query_id. The query_id of a query still running fails.
+// Cancel gives the slot back and unregisters the query.
+func (limits *ExecutionLimits) StartQuery(id string, query string, session *sessions.Session) (string, error) {
+	var user string
+	if u := session.GetUser(); u != nil {
+		user = u.Name
+	}
+	if err := globalAdmission.enter(limits.conf, user); err != nil {
+		return "", err
+	}
+	limits.mu.Lock()
+	limits.admitted, limits.user = true, user
+	limits.mu.Unlock()
+
+	process, err := sessions.RegisterProcess(id, query, session, limits.memory)
+	if err != nil {
+		return "", err
+	}
+	limits.mu.Lock()
+	limits.process = process
+	limits.mu.Unlock()
+	return process.QueryID(), nil
+}
+
+// Cancel releases the query context, the memory still charged is given back to the server.
+// The query is not running any more for the shutdown and system.processes.
+func (limits *ExecutionLimits) Cancel() {
+	limits.cancel()
+	limits.memory.Detach()
+	globalRunningQueries.remove(limits)
+
+	limits.mu.Lock()
+	process, admitted := limits.process, limits.admitted
+	limits.process, limits.admitted = nil, false
+	limits.mu.Unlock()
+	if process != nil {
+		process.Unregister()
+	}
+	if admitted {
+		globalAdmission.leave(limits.user)
+	}
+}
+
+// abort cancels the query context with the error Error returns for it, the first one wins.
+func (limits *ExecutionLimits) abort(err error) {
+	limits.mu.Lock()
+	defer limits.mu.Unlock()
+	if limits.err == nil {
+		limits.err = err
+		limits.cancel()
+	}
+}
+
+// MemoryTracker returns the tracker of the query, with its peak usage.
+func (limits *ExecutionLimits) MemoryTracker() *sessions.MemoryTracker {
+	return limits.memory
+}
+
+// ReadQuota returns the rows and the bytes the sources of the query read.
+func (limits *ExecutionLimits) ReadQuota() *sessions.ReadQuota {
+	return limits.quota
+}
+
+// CheckSpeed is called with the progress of the reads, the query is cancelled if it reads
+// slower than min_execution_speed rows per second after timeout_before_checking_execution_speed.
+func (limits *ExecutionLimits) CheckSpeed(pv *sessions.ProgressValues) {
+	runtime := limits.conf.Runtime
+	if runtime.MinExecutionSpeed <= 0 {
+		return
+	}
+
+	elapsed := time.Since(limits.start)
+	if elapsed < time.Duration(runtime.TimeoutBeforeCheckingExecutionSpeed)*time.Second {
+		return
+	}
+	speed := float64(pv.ReadRows.Get()) / elapsed.Seconds()
+	if speed >= float64(runtime.MinExecutionSpeed) {
+		return
+	}
+
+	limits.abort(errors.ErrorWithCode(errors.TOO_SLOW, "Query is executing too slow: %.2f rows/sec., minimum: %d", speed, runtime.MinExecutionSpeed))
+}
+
+// Error translates the error of the query context,
+// the deadline is TIMEOUT_EXCEEDED with the elapsed time and the rows read so far.
+func (limits *ExecutionLimits) Error(err error, pv *sessions.ProgressValues) error {
+	limits.mu.Lock()
+	defer limits.mu.Unlock()
+
+	switch {
+	case limits.err != nil && err == context.Canceled:
+		return limits.err
+	case err == context.DeadlineExceeded:
+		return errors.ErrorWithCode(errors.TIMEOUT_EXCEEDED, "Timeout exceeded: elapsed %.3f seconds, maximum: %d seconds, rows read: %d", time.Since(limits.start).Seconds(), limits.conf.Runtime.MaxExecutionTime, pv.ReadRows.Get())
+	}
+	return err
+}
@@ -0,0 +1,189 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package executors
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"config"
+	"datablocks"
+	"mocks"
+	"parsers"
+	"parsers/sqlparser"
+	"planners"
+	"sessions"
+
+	"base/errors"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecutionLimits(t *testing.T) {
+	conf := config.DefaultConfig()
+	pv := &sessions.ProgressValues{}
+	pv.ReadRows.Set(100)
+
+	// Unlimited.
+	ctx, limits := NewExecutionLimits(context.Background(), conf)
+	_, ok := ctx.Deadline()
+	assert.False(t, ok)
+	limits.CheckSpeed(pv)
+	assert.Nil(t, ctx.Err())
+	limits.Cancel()
+	assert.Equal(t, context.Canceled, limits.Error(ctx.Err(), pv))
+
+	// The deadline of max_execution_time.
+	conf.Runtime.MaxExecutionTime = 5
+	ctx, limits = NewExecutionLimits(context.Background(), conf)
+	_, ok = ctx.Deadline()
+	assert.True(t, ok)
+	err := limits.Error(context.DeadlineExceeded, pv)
+	assert.Equal(t, errors.TIMEOUT_EXCEEDED, err.(*errors.Error).Code())
+	assert.True(t, strings.HasPrefix(err.Error(), "Timeout exceeded: elapsed 0."), err.Error())
+	assert.True(t, strings.HasSuffix(err.Error(), "maximum: 5 seconds, rows read: 100 (errno 159)"), err.Error())
+	limits.Cancel()
+
+	// The speed is checked after the timeout.
+	conf.Runtime.MinExecutionSpeed = 1 << 40
+	ctx, limits = NewExecutionLimits(context.Background(), conf)
+	limits.CheckSpeed(pv)
+	assert.Nil(t, ctx.Err())
+	limits.Cancel()
+
+	conf.Runtime.TimeoutBeforeCheckingExecutionSpeed = 0
+	ctx, limits = NewExecutionLimits(context.Background(), conf)
+	limits.CheckSpeed(pv)
+	assert.Equal(t, context.Canceled, ctx.Err())
+	err = limits.Error(ctx.Err(), pv)
+	assert.Equal(t, errors.TOO_SLOW, err.(*errors.Error).Code())
+	assert.True(t, strings.HasPrefix(err.Error(), "Query is executing too slow: "), err.Error())
+
+	// The other errors pass through.
+	other := errors.New("other")
+	assert.Equal(t, other, limits.Error(other, pv))
+}
+
+func TestMemoryLimit(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		limit int
+		err   bool
+	}{
+		{name: "orderby", query: "SELECT i FROM rangetable(rows->10000, i->'Int32') order by i desc", limit: 1024, err: true},
+		{name: "groupby", query: "SELECT server, sum(response_time) FROM logmock(rows->1000) group by server", limit: 64, err: true},
+		{name: "orderby-unlimited", query: "SELECT i FROM rangetable(rows->10000, i->'Int32') order by i desc"},
+		{name: "groupby-unlimited", query: "SELECT server, sum(response_time) FROM logmock(rows->1000) group by server"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mock, cleanup := mocks.NewMock()
+			defer cleanup()
+
+			conf := *mock.Conf
+			conf.Runtime.MaxMemoryUsage = test.limit
+			qctx, limits := NewExecutionLimits(mock.Ctx, &conf)
+			defer limits.Cancel()
+
+			statement, err := parsers.Parse(test.query)
+			assert.Nil(t, err)
+			plan := planners.NewSelectPlan(statement.(*sqlparser.Select))
+			assert.Nil(t, plan.Build())
+
+			ctx := NewExecutorContext(qctx, mock.Log, &conf, mock.Session)
+			result, err := NewSelectExecutor(ctx, plan).Execute()
+			assert.Nil(t, err)
+
+			var failure error
+			for x := range result.Read() {
+				if err, ok := x.(error); ok && failure == nil {
+					failure = err
+				}
+			}
+
+			memory := limits.MemoryTracker()
+			if test.err {
+				assert.NotNil(t, failure)
+				assert.Equal(t, errors.MEMORY_LIMIT_EXCEEDED, errors.Code(failure), "%v", failure)
+				assert.True(t, strings.HasPrefix(fmt.Sprint(failure), "Memory limit (for query) exceeded: would use "), "%v", failure)
+				assert.True(t, memory.Peak() <= int64(test.limit))
+			} else {
+				assert.Nil(t, failure)
+				assert.True(t, memory.Peak() > 0)
+			}
+			// All charged is given back once the transforms finish.
+			assert.Equal(t, int64(0), memory.Used())
+		})
+	}
+}
+
+func TestReadLimit(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		maxRows  int
+		maxBytes int
+		mode     string
+		rows     int
+		err      string
+	}{
+		{name: "rows", query: "SELECT i FROM rangetable(rows->1000, i->'Int32')", maxRows: 250, err: "Limit for rows to read exceeded: read 300 rows, maximum: 250 (errno 158)"},
+		{name: "bytes", query: "SELECT i FROM rangetable(rows->1000, i->'Int32')", maxBytes: 100, err: "Limit for bytes to read exceeded: read "},
+		{name: "rows-break", query: "SELECT i FROM rangetable(rows->1000, i->'Int32')", maxRows: 250, mode: config.OverflowModeBreak, rows: 200},
+		{name: "numbers-break", query: "SELECT * FROM system.numbers", maxRows: 1000, mode: config.OverflowModeBreak, rows: 1000},
+		{name: "unlimited", query: "SELECT i FROM rangetable(rows->1000, i->'Int32')", rows: 1000},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mock, cleanup := mocks.NewMock()
+			defer cleanup()
+
+			conf := *mock.Conf
+			conf.Server.DefaultBlockSize = 100
+			conf.Runtime.MaxRowsToRead = test.maxRows
+			conf.Runtime.MaxBytesToRead = test.maxBytes
+			if test.mode != "" {
+				conf.Runtime.ReadOverflowMode = test.mode
+			}
+			qctx, limits := NewExecutionLimits(mock.Ctx, &conf)
+			defer limits.Cancel()
+
+			statement, err := parsers.Parse(test.query)
+			assert.Nil(t, err)
+			plan := planners.NewSelectPlan(statement.(*sqlparser.Select))
+			assert.Nil(t, plan.Build())
+
+			ctx := NewExecutorContext(qctx, mock.Log, &conf, mock.Session)
+			result, err := NewSelectExecutor(ctx, plan).Execute()
+			assert.Nil(t, err)
+
+			var rows int
+			var failure error
+			for x := range result.Read() {
+				switch y := x.(type) {
+				case *datablocks.DataBlock:
+					rows += y.NumRows()
+				case error:
+					if failure == nil {
+						failure = y
+					}
+				}
+			}
+
+			if test.err != "" {
+				assert.NotNil(t, failure)
+				assert.True(t, strings.HasPrefix(fmt.Sprint(failure), test.err), "%v", failure)
+			} else {
+				assert.Nil(t, failure)
+				assert.Equal(t, test.rows, rows)
+			}
+		})
+	}
+}
@@ -13,9 +13,10 @@ import (
 )
 
 type OrderByExecutor struct {
-	ctx         *ExecutorContext
-	plan        *planners.OrderByPlan
-	transformer processors.IProcessor
+	ctx          *ExecutorContext
+	plan         *planners.OrderByPlan
+	transformers []processors.IProcessor
+	merger       processors.IProcessor
 }
 
 func NewOrderByExecutor(ctx *ExecutorContext, plan *planners.OrderByPlan) IExecutor {
@@ -31,14 +32,36 @@ func (executor *OrderByExecutor) Execute() (*Result, error) {
 
 	transformCtx := transforms.NewTransformContext(executor.ctx.ctx, log, conf)
 	transform := transforms.NewOrderByTransform(transformCtx, executor.plan)
-	executor.transformer = transform
+	executor.transformers = append(executor.transformers, transform)
 
 	result := NewResult()
 	result.SetInput(transform)
 	return result, nil
 }
 
+// PerLane is true for the partial sort, each lane keeps its first rows of the limit.
+func (executor *OrderByExecutor) PerLane() bool {
+	return executor.plan.Limit > 0
+}
+
+// MergeLanes builds the partial sort of the first rows of all the lanes.
+func (executor *OrderByExecutor) MergeLanes() (*Result, error) {
+	log := executor.ctx.log
+	conf := executor.ctx.conf
+
+	transformCtx := transforms.NewTransformContext(executor.ctx.ctx, log, conf)
+	executor.merger = transforms.NewOrderByTransform(transformCtx, executor.plan)
+
+	result := NewResult()
+	result.SetInput(executor.merger)
+	return result, nil
+}
+
 func (executor *OrderByExecutor) String() string {
-	transformer := executor.transformer.(*transforms.OrderByTransform)
-	return fmt.Sprintf("(%v, stats:%+v)", transformer.Name(), transformer.Stats())
+	res := lanesString(executor.transformers)
+	if executor.merger != nil {
+		transformer := executor.merger.(*transforms.OrderByTransform)
+		res += fmt.Sprintf(" -> (%v, stats:%+v)", transformer.Name(), transformer.Stats())
+	}
+	return res
 }
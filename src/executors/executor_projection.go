@@ -5,17 +5,15 @@
 package executors
 
 import (
-	"fmt"
-
 	"planners"
 	"processors"
 	"transforms"
 )
 
 type ProjectionExecutor struct {
-	ctx         *ExecutorContext
-	plan        *planners.ProjectionPlan
-	transformer processors.IProcessor
+	ctx          *ExecutorContext
+	plan         *planners.ProjectionPlan
+	transformers []processors.IProcessor
 }
 
 func NewProjectionExecutor(ctx *ExecutorContext, plan *planners.ProjectionPlan) IExecutor {
@@ -31,14 +29,17 @@ func (executor *ProjectionExecutor) Execute() (*Result, error) {
 
 	transformCtx := transforms.NewTransformContext(executor.ctx.ctx, log, conf)
 	transform := transforms.NewProjectionTransform(transformCtx, executor.plan)
-	executor.transformer = transform
+	executor.transformers = append(executor.transformers, transform)
 
 	result := NewResult()
 	result.SetInput(transform)
 	return result, nil
 }
 
+func (executor *ProjectionExecutor) PerLane() bool {
+	return true
+}
+
 func (executor *ProjectionExecutor) String() string {
-	transformer := executor.transformer.(*transforms.ProjectionTransform)
-	return fmt.Sprintf("(%v, stats:%+v)", transformer.Name(), transformer.Stats())
+	return lanesString(executor.transformers)
 }
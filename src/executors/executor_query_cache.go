@@ -0,0 +1,231 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package executors
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"sync"
+
+	"base/binary"
+	"base/lru"
+	"databases"
+	"datablocks"
+	"datastreams"
+	"expressions"
+	"planners"
+	"processors"
+	"transforms"
+)
+
+// queryCache keeps the serialized result blocks of the SELECTs run with use_query_cache,
+// the least recently used ones are evicted beyond query_cache_max_size.
+type queryCache struct {
+	mu    sync.Mutex
+	lru   *lru.Cache
+	bytes int
+}
+
+type queryCacheEntry struct {
+	blocks          [][]byte
+	bytes           int
+	appliedLimit    bool
+	rowsBeforeLimit int64
+}
+
+var globalQueryCache = newQueryCache()
+
+func newQueryCache() *queryCache {
+	cache := &queryCache{lru: lru.New(0)}
+	cache.lru.OnEvicted = func(key lru.Key, value interface{}) {
+		cache.bytes -= value.(*queryCacheEntry).bytes
+	}
+	return cache
+}
+
+func (cache *queryCache) get(key string) (*queryCacheEntry, bool) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	if value, ok := cache.lru.Get(key); ok {
+		return value.(*queryCacheEntry), true
+	}
+	return nil, false
+}
+
+// put adds the entry and evicts the oldest ones until all fit in maxBytes.
+func (cache *queryCache) put(key string, entry *queryCacheEntry, maxBytes int) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	if entry.bytes > maxBytes {
+		return
+	}
+	// The entry replaced is evicted first, for its bytes.
+	cache.lru.Remove(key)
+	cache.lru.Add(key, entry)
+	cache.bytes += entry.bytes
+	for cache.bytes > maxBytes {
+		cache.lru.RemoveOldest()
+	}
+}
+
+// tableVersions counts the changes of the tables and the databases, the INSERT and the DDL bump them.
+// The versions are in the keys of the query cache, so the results of the tables changed since are not found any more.
+type tableVersions struct {
+	mu       sync.Mutex
+	versions map[string]uint64
+}
+
+var globalTableVersions = &tableVersions{versions: make(map[string]uint64)}
+
+// bump counts a change of the table, the table "" is the database itself.
+func (v *tableVersions) bump(database string, table string) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.versions[database+"."+table]++
+}
+
+func (v *tableVersions) get(database string, table string) uint64 {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return v.versions[database+"."+table]
+}
+
+// versionedOutputStream bumps the version of the table after the blocks are written,
+// the results read before are cached with the old version.
+type versionedOutputStream struct {
+	datastreams.IDataBlockOutputStream
+	database string
+	table    string
+}
+
+func (stream *versionedOutputStream) Write(block *datablocks.DataBlock) error {
+	defer globalTableVersions.bump(stream.database, stream.table)
+	return stream.IDataBlockOutputStream.Write(block)
+}
+
+func (stream *versionedOutputStream) Finalize() error {
+	defer globalTableVersions.bump(stream.database, stream.table)
+	return stream.IDataBlockOutputStream.Finalize()
+}
+
+// queryCacheKey returns the key of the SELECT by its plan, its settings and the versions of the tables it reads.
+// The query is not cached if it calls a non-deterministic function or reads a system or a temporary table.
+func queryCacheKey(ctx *ExecutorContext, plan *planners.SelectPlan) (string, bool) {
+	conf := ctx.conf
+	if conf.Runtime.UseQueryCache == 0 {
+		return "", false
+	}
+
+	var tables []string
+	var visit planners.Visit
+	current := ctx.session.GetDatabase()
+	cacheable := true
+	visit = func(plan planners.IPlan) (bool, error) {
+		switch plan := plan.(type) {
+		case *planners.ScanPlan:
+			schema := plan.Schema
+			if schema == "" {
+				if _, ok := ctx.session.GetTemporaryTable(plan.Table); ok {
+					cacheable = false
+				}
+				schema = current
+			}
+			if strings.EqualFold(schema, databases.SystemDatabaseName) {
+				cacheable = false
+			}
+			tables = append(tables, fmt.Sprintf("%s.%s@%d.%d", schema, plan.Table, globalTableVersions.get(schema, ""), globalTableVersions.get(schema, plan.Table)))
+			// The pushed down filter and projection are not walked by the scan.
+			if plan.Filter != nil {
+				if err := planners.Walk(visit, plan.Filter); err != nil {
+					return false, err
+				}
+			}
+			if plan.Project != nil {
+				if err := planners.Walk(visit, plan.Project); err != nil {
+					return false, err
+				}
+			}
+		case *planners.FunctionExpressionPlan:
+			if !expressions.IsDeterministic(plan.FuncName) {
+				cacheable = false
+			}
+		case *planners.TableValuedFunctionPlan:
+			if !expressions.IsDeterministic(plan.FuncName) {
+				cacheable = false
+			}
+		}
+		return cacheable, nil
+	}
+	if err := planners.Walk(visit, plan); err != nil || !cacheable {
+		return "", false
+	}
+	return fmt.Sprintf("%s\n%s\n%+v\n%d\n%s", plan.String(), current, conf.Runtime, conf.Server.DefaultBlockSize, strings.Join(tables, ",")), true
+}
+
+// queryCacheInputStream reads the blocks of the cached result, each read decodes a new block.
+type queryCacheInputStream struct {
+	blocks [][]byte
+}
+
+func (stream *queryCacheInputStream) Name() string {
+	return "QueryCacheInputStream"
+}
+
+func (stream *queryCacheInputStream) Read() (*datablocks.DataBlock, error) {
+	if len(stream.blocks) == 0 {
+		return nil, nil
+	}
+	data := stream.blocks[0]
+	stream.blocks = stream.blocks[1:]
+	return datablocks.ReadNative(binary.NewReader(bytes.NewReader(data)))
+}
+
+func (stream *queryCacheInputStream) Close() {}
+
+// queryCacheExecutor stores the result of the SELECT into the query cache, it's the last before the sink.
+type queryCacheExecutor struct {
+	ctx         *ExecutorContext
+	key         string
+	transformer processors.IProcessor
+}
+
+func newQueryCacheExecutor(ctx *ExecutorContext, key string) IExecutor {
+	return &queryCacheExecutor{
+		ctx: ctx,
+		key: key,
+	}
+}
+
+func (executor *queryCacheExecutor) Execute() (*Result, error) {
+	ectx := executor.ctx
+	conf := ectx.conf
+
+	store := func(blocks [][]byte) {
+		entry := &queryCacheEntry{
+			blocks:          blocks,
+			appliedLimit:    ectx.profileValues.AppliedLimit.Get(),
+			rowsBeforeLimit: ectx.profileValues.RowsBeforeLimit.Get(),
+		}
+		for _, block := range blocks {
+			entry.bytes += len(block)
+		}
+		globalQueryCache.put(executor.key, entry, conf.Server.QueryCacheMaxSize)
+	}
+	transformCtx := transforms.NewTransformContext(ectx.ctx, ectx.log, conf)
+	transform := transforms.NewQueryCacheTransform(transformCtx, conf.Server.QueryCacheMaxEntrySize, store)
+	executor.transformer = transform
+
+	result := NewResult()
+	result.SetInput(transform)
+	return result, nil
+}
+
+func (executor *queryCacheExecutor) String() string {
+	transformer := executor.transformer.(*transforms.QueryCacheTransform)
+	return fmt.Sprintf("(%v, stats:%+v)", transformer.Name(), transformer.Stats())
+}
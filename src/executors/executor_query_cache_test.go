@@ -0,0 +1,78 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package executors
+
+import (
+	"testing"
+
+	"datablocks"
+	"mocks"
+	"planners"
+	"sessions"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueryCache(t *testing.T) {
+	tests := []struct {
+		name   string
+		query  string
+		rows   int
+		hits   int64
+		misses int64
+	}{
+		{name: "create-db", query: "create database db1"},
+		{name: "create-table", query: "create table db1.t1(a Int32) Engine=Memory"},
+		{name: "insert", query: "insert into db1.t1 select i from rangetable(rows->10, i->'Int32')"},
+		{name: "miss", query: "select a from db1.t1 settings use_query_cache = 1", rows: 10, misses: 1},
+		{name: "hit", query: "select a from db1.t1 settings use_query_cache = 1", rows: 10, hits: 1},
+		{name: "off", query: "select a from db1.t1", rows: 10},
+		{name: "other-settings", query: "select a from db1.t1 settings use_query_cache = 1, max_threads = 1", rows: 10, misses: 1},
+		{name: "invalidated-by-insert", query: "insert into db1.t1 select i from rangetable(rows->5, i->'Int32')"},
+		{name: "miss-after-insert", query: "select a from db1.t1 settings use_query_cache = 1", rows: 15, misses: 1},
+		{name: "hit-after-insert", query: "select a from db1.t1 settings use_query_cache = 1", rows: 15, hits: 1},
+		{name: "non-deterministic", query: "select * from randtable(rows->10, a->'Int32') settings use_query_cache = 1", rows: 10},
+		{name: "system", query: "select * from system.events settings use_query_cache = 1", rows: 4},
+		{name: "drop-table", query: "drop table db1.t1"},
+		{name: "recreate-table", query: "create table db1.t1(a Int32) Engine=Memory"},
+		{name: "miss-after-drop", query: "select a from db1.t1 settings use_query_cache = 1", misses: 1},
+		{name: "drop-db", query: "drop database db1"},
+	}
+
+	mock, cleanup := mocks.NewMock()
+	defer cleanup()
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			hits, misses := sessions.QueryCacheHits.Value(), sessions.QueryCacheMisses.Value()
+
+			plan, err := planners.PlanFactory(test.query)
+			assert.Nil(t, err)
+
+			ctx := NewExecutorContext(mock.Ctx, mock.Log, mock.Conf, mock.Session)
+			executor, err := ExecutorFactory(ctx, plan)
+			assert.Nil(t, err)
+
+			result, err := executor.Execute()
+			assert.Nil(t, err)
+
+			var rows int
+			if result.In != nil {
+				for x := range result.Read() {
+					switch x := x.(type) {
+					case error:
+						assert.Nil(t, x)
+					case *datablocks.DataBlock:
+						rows += x.NumRows()
+					}
+				}
+			}
+			if _, ok := plan.(*planners.SelectPlan); ok {
+				assert.Equal(t, test.rows, rows)
+			}
+			assert.Equal(t, test.hits, sessions.QueryCacheHits.Value()-hits)
+			assert.Equal(t, test.misses, sessions.QueryCacheMisses.Value()-misses)
+		})
+	}
+}
@@ -7,9 +7,10 @@ package executors
 import (
 	"fmt"
 
-	"databases"
+	"datastreams"
 	"planners"
 	"processors"
+	"storages"
 	"transforms"
 )
 
@@ -32,23 +33,40 @@ func (executor *ScanExecutor) Execute() (*Result, error) {
 	plan := executor.plan
 	session := executor.ctx.session
 
-	if plan.Schema == "" {
-		plan.Schema = session.GetDatabase()
-	}
-
-	databaseCtx := databases.NewDatabaseContext(log, conf)
-	storage, err := databases.GetStorage(databaseCtx, plan.Schema, plan.Table)
+	storage, err := executor.ctx.getStorage(plan.Schema, plan.Table)
 	if err != nil {
 		return nil, err
 	}
 
-	input, err := storage.GetInputStream(session)
+	// The storage skips the blocks by the predicates pushed down and reads the columns referenced if it can,
+	// the source filters the rows. The filter folded to false matches no row, nothing is read.
+	var input datastreams.IDataBlockInputStream
+	if constant, ok := filterConstant(plan); ok && constant.Value == false {
+		input = datastreams.NewOneBlockInputStream()
+	} else if scanner, ok := storage.(storages.IScanStorage); ok && (plan.Filter != nil || plan.Columns != nil) {
+		input, err = scanner.GetScanInputStream(session, plan)
+	} else {
+		input, err = storage.GetInputStream(session)
+	}
 	if err != nil {
 		return nil, err
 	}
+	if sized, ok := input.(datastreams.IBlockSizeInputStream); ok {
+		sized.SetMaxBlockSize(conf.Server.DefaultBlockSize)
+	}
 	transformCtx := transforms.NewTransformContext(executor.ctx.ctx, log, conf)
 	transformCtx.SetProgressCallback(executor.ctx.progressCallback)
-	transform := transforms.NewDataSourceTransform(transformCtx, input)
+	var transform processors.IProcessor
+	if plan.Filter != nil || plan.Limit > 0 {
+		if transform, err = transforms.NewPushedDataSourceTransform(transformCtx, input, plan.Filter, plan.Limit); err != nil {
+			return nil, err
+		}
+	} else {
+		transform = transforms.NewDataSourceTransform(transformCtx, input)
+	}
+	if counter, ok := storage.(storages.IRowsCountStorage); ok {
+		transform.(*transforms.DataSourceTransform).SetTotalRowsToRead(counter.TotalRows())
+	}
 	executor.transformer = transform
 
 	result := NewResult()
@@ -56,6 +74,14 @@ func (executor *ScanExecutor) Execute() (*Result, error) {
 	return result, nil
 }
 
+func filterConstant(plan *planners.ScanPlan) (*planners.ConstantPlan, bool) {
+	if plan.Filter == nil {
+		return nil, false
+	}
+	constant, ok := plan.Filter.SubPlan.(*planners.ConstantPlan)
+	return constant, ok
+}
+
 func (executor *ScanExecutor) String() string {
 	transformer := executor.transformer.(*transforms.DataSourceTransform)
 	return fmt.Sprintf("(%v, stats:%+v, cost:%v)", transformer.Name(), transformer.Stats(), transformer.Duration())
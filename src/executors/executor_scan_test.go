@@ -6,12 +6,15 @@ package executors
 
 import (
 	"mocks"
+	"strings"
 	"testing"
 
 	"columns"
 	"datablocks"
 	"datatypes"
+	"optimizers"
 	"planners"
+	"transforms"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -64,3 +67,165 @@ func TestScanExecutor(t *testing.T) {
 		})
 	}
 }
+
+func TestScanExecutorPushDown(t *testing.T) {
+	mock, cleanup := mocks.NewMock()
+	defer cleanup()
+	mock.Conf.Server.DefaultBlockSize = 10
+
+	run := func(query string, optimize bool) (int, *SelectExecutor) {
+		plan, err := planners.PlanFactory(query)
+		assert.Nil(t, err)
+		if optimize {
+			plan = optimizers.Optimize(mock.Conf, plan, optimizers.DefaultOptimizers)
+		}
+		ctx := NewExecutorContext(mock.Ctx, mock.Log, mock.Conf, mock.Session)
+		executor, err := ExecutorFactory(ctx, plan)
+		assert.Nil(t, err)
+		result, err := executor.Execute()
+		assert.Nil(t, err)
+
+		var rows int
+		if result.In != nil {
+			for x := range result.Read() {
+				switch x := x.(type) {
+				case error:
+					assert.Nil(t, x, query)
+				case *datablocks.DataBlock:
+					rows += x.NumRows()
+				}
+			}
+		}
+		selector, _ := executor.(*SelectExecutor)
+		return rows, selector
+	}
+
+	run("create database db1", false)
+	run("create table db1.t1(a Int32, b String) Engine=Memory", false)
+	run("insert into db1.t1 select i, s from rangetable(rows->30, i->'Int32', s->'String')", false)
+	defer run("drop database db1", false)
+
+	tests := []struct {
+		name  string
+		query string
+		rows  int
+		read  int64
+	}{
+		{name: "skip", query: "select a from db1.t1 where a >= 12 and a < 15", rows: 3, read: 10},
+		{name: "reversed", query: "select a from db1.t1 where 25 <= a", rows: 5, read: 10},
+		{name: "equal", query: "select a from db1.t1 where a = 7 and b = 'string-7'", rows: 1, read: 10},
+		{name: "none", query: "select a from db1.t1 where a > 100", read: 0},
+		{name: "or", query: "select a from db1.t1 where a < 2 or a > 27", rows: 4, read: 30},
+		// The constants are folded, the filter always false reads nothing.
+		{name: "true", query: "select a from db1.t1 where 1 = 1 and a < 1 + 2", rows: 3, read: 10},
+		{name: "false", query: "select a from db1.t1 where a < 20 and 1 = 0", read: 0},
+		// The scan stops as it has sent the rows of the limit.
+		{name: "limit", query: "select a from db1.t1 limit 5", rows: 5, read: 10},
+		{name: "limit-offset", query: "select a from db1.t1 limit 12, 3", rows: 3, read: 20},
+		{name: "limit-filter", query: "select a from db1.t1 where a >= 25 limit 3", rows: 3, read: 10},
+		// The HAVING is over the aggregates, it's not pushed even if the columns are the scanned ones.
+		{name: "having", query: "select a, count(a) as c from db1.t1 where a < 20 group by a having c > 1", rows: 0, read: 20},
+		{name: "having-column", query: "select a, count(a) from db1.t1 group by a having a > 25", rows: 4, read: 30},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rows, _ := run(test.query, false)
+			assert.Equal(t, test.rows, rows)
+
+			rows, selector := run(test.query, true)
+			assert.Equal(t, test.rows, rows)
+			scan := selector.tree.subExecutors[0].(*ScanExecutor).transformer.(*transforms.DataSourceTransform)
+			stats := scan.Stats()
+			assert.Equal(t, test.read, stats.ReadRows.Get())
+		})
+	}
+}
+
+func TestScanExecutorColumnPruning(t *testing.T) {
+	mock, cleanup := mocks.NewMock()
+	defer cleanup()
+	mock.Conf.Server.DefaultBlockSize = 10
+
+	// The blocks of the query and the bytes the scan reads.
+	run := func(query string, optimize bool) ([]*datablocks.DataBlock, int64) {
+		plan, err := planners.PlanFactory(query)
+		assert.Nil(t, err)
+		if optimize {
+			plan = optimizers.Optimize(mock.Conf, plan, optimizers.DefaultOptimizers)
+		}
+		ctx := NewExecutorContext(mock.Ctx, mock.Log, mock.Conf, mock.Session)
+		executor, err := ExecutorFactory(ctx, plan)
+		assert.Nil(t, err)
+		result, err := executor.Execute()
+		assert.Nil(t, err)
+
+		var blocks []*datablocks.DataBlock
+		if result.In != nil {
+			for x := range result.Read() {
+				switch x := x.(type) {
+				case error:
+					assert.Nil(t, x, query)
+				case *datablocks.DataBlock:
+					blocks = append(blocks, x)
+				}
+			}
+		}
+		var read int64
+		if selector, ok := executor.(*SelectExecutor); ok {
+			stats := selector.tree.subExecutors[0].(*ScanExecutor).transformer.(*transforms.DataSourceTransform).Stats()
+			read = stats.ReadBytes.Get()
+		}
+		return blocks, read
+	}
+
+	run("create database db1", false)
+	run("create table db1.t1(a Int32, b String, c Int32, d String) Engine=Memory", false)
+	run("insert into db1.t1 select i, s, i, s from rangetable(rows->30, i->'Int32', s->'String')", false)
+	defer run("drop database db1", false)
+
+	tests := []struct {
+		name    string
+		query   string
+		columns int
+	}{
+		{name: "where", query: "select a from db1.t1 where c > 20", columns: 1},
+		{name: "orderby", query: "select b from db1.t1 order by a desc limit 5", columns: 1},
+		{name: "groupby", query: "select c, count(a) from db1.t1 group by c", columns: 2},
+		{name: "constant", query: "select 1 from db1.t1", columns: 1},
+		{name: "all", query: "select * from db1.t1", columns: 4},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			expect, all := run(test.query, false)
+			actual, pruned := run(test.query, true)
+			if test.name == "all" {
+				assert.Equal(t, all, pruned)
+			} else {
+				assert.True(t, pruned < all)
+			}
+			// The lanes deliver the blocks in any order, the rows and the columns of the blocks are compared.
+			summary := func(blocks []*datablocks.DataBlock) (int, map[string]bool) {
+				rows, columns := 0, make(map[string]bool)
+				for _, block := range blocks {
+					rows += block.NumRows()
+					var names []string
+					for _, column := range block.Columns() {
+						names = append(names, column.Name+" "+column.DataType.Name())
+					}
+					columns[strings.Join(names, ",")] = true
+				}
+				return rows, columns
+			}
+			expectRows, expectColumns := summary(expect)
+			actualRows, actualColumns := summary(actual)
+			assert.Equal(t, expectRows, actualRows)
+			assert.Equal(t, expectColumns, actualColumns)
+			for _, block := range actual {
+				// The empty blocks have the columns of the selection only.
+				if block.NumRows() > 0 {
+					assert.Equal(t, test.columns, block.NumColumns())
+				}
+			}
+		})
+	}
+}
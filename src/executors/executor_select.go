@@ -7,12 +7,16 @@ package executors
 import (
 	"base/errors"
 	"planners"
+	"processors"
+	"sessions"
+	"transforms"
 )
 
 type SelectExecutor struct {
-	ctx  *ExecutorContext
-	plan *planners.SelectPlan
-	tree *ExecutorTree
+	ctx      *ExecutorContext
+	plan     *planners.SelectPlan
+	tree     *ExecutorTree
+	cacheHit bool
 }
 
 func NewSelectExecutor(ctx *ExecutorContext, plan planners.IPlan) IExecutor {
@@ -24,6 +28,75 @@ func NewSelectExecutor(ctx *ExecutorContext, plan planners.IPlan) IExecutor {
 }
 
 func (executor *SelectExecutor) Execute() (*Result, error) {
+	if err := executor.applySettings(); err != nil {
+		return nil, err
+	}
+
+	key, cacheable := queryCacheKey(executor.ctx, executor.plan)
+	if cacheable {
+		if entry, ok := globalQueryCache.get(key); ok {
+			sessions.QueryCacheHits.Add(1)
+			return executor.readCache(entry), nil
+		}
+		sessions.QueryCacheMisses.Add(1)
+	}
+
+	pipeline, err := executor.buildTree(key, cacheable)
+	if err != nil {
+		return nil, err
+	}
+	pipeline.Run()
+
+	result := NewResult()
+	result.SetInput(pipeline.Last())
+	return result, nil
+}
+
+// buildPipeline builds the transforms of the plan without running them.
+func (executor *SelectExecutor) buildPipeline() (*processors.Pipeline, error) {
+	if err := executor.applySettings(); err != nil {
+		return nil, err
+	}
+	return executor.buildTree("", false)
+}
+
+// applySettings applies the SETTINGS of the query, they are for this query only,
+// the unknown ones are ignored like the session ones.
+func (executor *SelectExecutor) applySettings() error {
+	if settings := executor.plan.Settings; len(settings) > 0 {
+		conf, _, err := executor.ctx.conf.WithSettings(settings)
+		if err != nil {
+			return err
+		}
+		executor.ctx = executor.ctx.withConf(conf)
+		executor.tree.ctx = executor.ctx
+	}
+	return nil
+}
+
+// readCache runs the pipeline reading the cached result, it's not counted in the read limits.
+func (executor *SelectExecutor) readCache(entry *queryCacheEntry) *Result {
+	ectx := executor.ctx
+	executor.cacheHit = true
+	ectx.profileValues.AppliedLimit.Set(entry.appliedLimit)
+	ectx.profileValues.RowsBeforeLimit.Set(entry.rowsBeforeLimit)
+
+	transformCtx := transforms.NewTransformContext(sessions.WithReadQuota(ectx.ctx, nil), ectx.log, ectx.conf)
+	source := transforms.NewDataSourceTransform(transformCtx, &queryCacheInputStream{blocks: entry.blocks})
+	sink := processors.NewSink("transforms_sink")
+
+	pipeline := processors.NewPipeline(ectx.ctx)
+	pipeline.Add(source)
+	pipeline.Add(sink)
+	pipeline.Run()
+
+	result := NewResult()
+	result.SetInput(pipeline.Last())
+	return result
+}
+
+// buildTree builds the pipeline of the plan, the cacheable result is stored with the key.
+func (executor *SelectExecutor) buildTree(key string, cacheable bool) (*processors.Pipeline, error) {
 	ectx := executor.ctx
 	tree := executor.tree
 
@@ -37,6 +110,13 @@ func (executor *SelectExecutor) Execute() (*Result, error) {
 		case *planners.ScanPlan:
 			executor := NewScanExecutor(ectx, plan)
 			tree.Add(executor)
+		case *planners.TrivialCountPlan:
+			if executor, ok := NewTrivialCountExecutor(ectx, plan); ok {
+				tree.Add(executor)
+			} else {
+				tree.Add(NewScanExecutor(ectx, plan.Scan))
+				tree.Add(NewSelectionExecutor(ectx, plan.Selection))
+			}
 		case *planners.FilterPlan:
 			executor := NewFilterExecutor(ectx, plan)
 			tree.Add(executor)
@@ -53,24 +133,22 @@ func (executor *SelectExecutor) Execute() (*Result, error) {
 			executor := NewProjectionExecutor(ectx, plan)
 			tree.Add(executor)
 		case *planners.SinkPlan:
+			if cacheable {
+				tree.Add(newQueryCacheExecutor(ectx, key))
+			}
 			executor := NewSinkExecutor(ectx, plan)
 			tree.Add(executor)
 		default:
-			return nil, errors.Errorf("Unsupported plan:%T", plan)
+			return nil, errors.ErrorWithCode(errors.NOT_IMPLEMENTED, "Unsupported plan:%T", plan)
 		}
 	}
-	pipeline, err := tree.BuildPipeline()
-	if err != nil {
-		return nil, err
-	}
-	pipeline.Run()
-
-	result := NewResult()
-	result.SetInput(pipeline.Last())
-	return result, nil
+	return tree.BuildPipeline()
 }
 
 func (executor *SelectExecutor) String() string {
+	if executor.cacheHit {
+		return "(query cache hit) -> "
+	}
 	res := ""
 	for _, t := range executor.tree.subExecutors {
 		res += t.String()
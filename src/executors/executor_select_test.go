@@ -5,11 +5,15 @@
 package executors
 
 import (
+	"fmt"
+	"io/ioutil"
 	"mocks"
+	"runtime"
 	"testing"
 
 	"columns"
 	"datablocks"
+	"datastreams"
 	"datatypes"
 	"parsers"
 	"parsers/sqlparser"
@@ -78,7 +82,7 @@ func TestSelectExecutor(t *testing.T) {
 		},
 		{
 			name:  "system.numbers-pass",
-			query: "SELECT number,(number+1) FROM system.numbers limit 3",
+			query: "SELECT number,(number+1) FROM system.numbers limit 3 settings max_threads = 1",
 			expect: mocks.NewBlockFromSlice(
 				[]*columns.Column{
 					{Name: "number", DataType: datatypes.NewUInt64DataType()},
@@ -100,6 +104,83 @@ func TestSelectExecutor(t *testing.T) {
 				[]interface{}{"192.168.0.2", 170},
 			),
 		},
+		{
+			name:  "avg-pass",
+			query: "SELECT server, AVG(response_time) AS avg FROM logmock(rows -> 15) GROUP BY server ORDER BY server ASC",
+			expect: mocks.NewBlockFromSlice(
+				[]*columns.Column{
+					{Name: "server", DataType: datatypes.NewStringDataType()},
+					{Name: "avg", DataType: datatypes.NewFloat64DataType()},
+				},
+				[]interface{}{"192.168.0.1", 11.444444444444445},
+				[]interface{}{"192.168.0.2", 11.166666666666666},
+			),
+		},
+		{
+			name:  "string-functions-pass",
+			query: "SELECT lower(method) AS m, length(path) AS l, substring(server, -3, 2) AS s FROM logmock(rows -> 15) WHERE upper(path) = '/LOGIN' AND status = 500 ORDER BY s ASC",
+			expect: mocks.NewBlockFromSlice(
+				[]*columns.Column{
+					{Name: "m", DataType: datatypes.NewStringDataType()},
+					{Name: "l", DataType: datatypes.NewInt64DataType()},
+					{Name: "s", DataType: datatypes.NewStringDataType()},
+				},
+				[]interface{}{"post", 6, "0."},
+				[]interface{}{"post", 6, "0."},
+				[]interface{}{"post", 6, "0."},
+			),
+		},
+		{
+			name:  "like-pass",
+			query: "SELECT server, status FROM logmock(rows -> 15) WHERE path LIKE '/log%' AND method ILIKE 'p_st' AND server NOT LIKE '%.2' AND notILike(path, '%INDEX%') ORDER BY status ASC",
+			expect: mocks.NewBlockFromSlice(
+				[]*columns.Column{
+					{Name: "server", DataType: datatypes.NewStringDataType()},
+					{Name: "status", DataType: datatypes.NewInt32DataType()},
+				},
+				[]interface{}{"192.168.0.1", 200},
+				[]interface{}{"192.168.0.1", 500},
+				[]interface{}{"192.168.0.1", 500},
+			),
+		},
+		{
+			name:  "regexp-pass",
+			query: "SELECT extract(server, '\\\\.(\\\\d+)$') AS host, replaceRegexpAll(path, '^/(.*)', '<\\\\1>') AS p FROM logmock(rows -> 15) WHERE match(path, '^/lo') AND status = 500 ORDER BY host ASC",
+			expect: mocks.NewBlockFromSlice(
+				[]*columns.Column{
+					{Name: "host", DataType: datatypes.NewStringDataType()},
+					{Name: "p", DataType: datatypes.NewStringDataType()},
+				},
+				[]interface{}{"1", "<login>"},
+				[]interface{}{"1", "<login>"},
+				[]interface{}{"2", "<login>"},
+			),
+		},
+		{
+			name:  "commons-pass",
+			query: "SELECT (i+1)*2 AS x, (i+1)*3 AS y FROM rangetable(rows->5, i->'Int32') WHERE (i+1) > 2 AND (i+1) < 5",
+			expect: mocks.NewBlockFromSlice(
+				[]*columns.Column{
+					{Name: "x", DataType: datatypes.NewInt32DataType()},
+					{Name: "y", DataType: datatypes.NewInt32DataType()},
+				},
+				[]interface{}{6, 9},
+				[]interface{}{8, 12},
+			),
+		},
+		{
+			name:  "commons-groupby-pass",
+			query: "SELECT server, SUM(response_time*2) AS s, MAX(response_time*2) AS m FROM logmock(rows -> 15) GROUP BY server ORDER BY server ASC",
+			expect: mocks.NewBlockFromSlice(
+				[]*columns.Column{
+					{Name: "server", DataType: datatypes.NewStringDataType()},
+					{Name: "s", DataType: datatypes.NewInt64DataType()},
+					{Name: "m", DataType: datatypes.NewInt64DataType()},
+				},
+				[]interface{}{"192.168.0.1", 206, 26},
+				[]interface{}{"192.168.0.2", 134, 28},
+			),
+		},
 		{
 			name: "aggregate-pass",
 			query: `SELECT 
@@ -161,3 +242,67 @@ ORDER BY
 		})
 	}
 }
+
+// TestSelectExecutorStreaming reads many more rows than the live heap grows by,
+// the blocks must flow to the output format as they are produced.
+func TestSelectExecutorStreaming(t *testing.T) {
+	const (
+		rows   = 2000000
+		growth = 32 << 20
+	)
+	mock, cleanup := mocks.NewMock()
+	defer cleanup()
+
+	query := fmt.Sprintf("SELECT number, (number+1) FROM system.numbers limit %d settings max_threads = 2, max_block_size = 8192", rows)
+	statement, err := parsers.Parse(query)
+	assert.Nil(t, err)
+
+	plan := planners.NewSelectPlan(statement.(*sqlparser.Select))
+	err = plan.Build()
+	assert.Nil(t, err)
+
+	// Twice, the pooled values of the other tests are freed by the second.
+	runtime.GC()
+	runtime.GC()
+	var stats runtime.MemStats
+	runtime.ReadMemStats(&stats)
+	allocs := stats.TotalAlloc
+
+	ctx := NewExecutorContext(mock.Ctx, mock.Log, mock.Conf, mock.Session)
+	executor := NewSelectExecutor(ctx, plan)
+	result, err := executor.Execute()
+	assert.Nil(t, err)
+
+	var stream datastreams.IDataBlockOutputStream
+	var read, blocks int
+	var low, high uint64
+	for x := range result.Read() {
+		block, ok := x.(*datablocks.DataBlock)
+		assert.True(t, ok, "%v", x)
+		if stream == nil {
+			stream = datastreams.NewCustomFormatBlockOutputStream(block.Clone(), ioutil.Discard, "TSV")
+		}
+		assert.Nil(t, stream.Write(block))
+		read += block.NumRows()
+
+		// The live heap after a GC, the garbage depends on the GC timing
+		// and the other tests may free theirs meanwhile, only the growth counts.
+		if blocks++; blocks%16 == 0 {
+			runtime.GC()
+			runtime.ReadMemStats(&stats)
+			if low == 0 || stats.HeapAlloc < low {
+				low = stats.HeapAlloc
+			}
+			if stats.HeapAlloc > high {
+				high = stats.HeapAlloc
+			}
+		}
+	}
+	assert.Nil(t, stream.Finalize())
+	runtime.ReadMemStats(&stats)
+
+	assert.Equal(t, rows, read)
+	// The query allocates far beyond the growth allowed, a materialized result would keep it all.
+	assert.True(t, stats.TotalAlloc-allocs > 4*growth, "total allocated:%v", stats.TotalAlloc-allocs)
+	assert.True(t, high-low < growth, "live heap growth:%v", high-low)
+}
@@ -5,8 +5,6 @@
 package executors
 
 import (
-	"fmt"
-
 	"base/errors"
 	"planners"
 	"processors"
@@ -14,9 +12,9 @@ import (
 )
 
 type SelectionExecutor struct {
-	ctx         *ExecutorContext
-	plan        *planners.SelectionPlan
-	transformer processors.IProcessor
+	ctx          *ExecutorContext
+	plan         *planners.SelectionPlan
+	transformers []processors.IProcessor
 }
 
 func NewSelectionExecutor(ctx *ExecutorContext, plan *planners.SelectionPlan) IExecutor {
@@ -44,22 +42,18 @@ func (executor *SelectionExecutor) Execute() (*Result, error) {
 	default:
 		return nil, errors.Errorf("Unsupported filler mode:%v", plan.SelectionMode)
 	}
-	executor.transformer = transform
+	executor.transformers = append(executor.transformers, transform)
 
 	result := NewResult()
 	result.SetInput(transform)
 	return result, nil
 }
 
+// PerLane is true for the normal selection, the aggregations merge all the lanes.
+func (executor *SelectionExecutor) PerLane() bool {
+	return executor.plan.SelectionMode == planners.NormalSelection
+}
+
 func (executor *SelectionExecutor) String() string {
-	transformer := executor.transformer
-	switch transformer := transformer.(type) {
-	case *transforms.NormalSelectionTransform:
-		return fmt.Sprintf("(%v, stats:%+v)", transformer.Name(), transformer.Stats())
-	case *transforms.AggregateSelectionTransform:
-		return fmt.Sprintf("(%v, stats:%+v)", transformer.Name(), transformer.Stats())
-	case *transforms.GroupBySelectionTransform:
-		return fmt.Sprintf("(%v, stats:%+v)", transformer.Name(), transformer.Stats())
-	}
-	return ""
+	return lanesString(executor.transformers)
 }
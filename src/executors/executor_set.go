@@ -0,0 +1,42 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package executors
+
+import (
+	"planners"
+)
+
+type SetExecutor struct {
+	ctx  *ExecutorContext
+	plan *planners.SetPlan
+}
+
+func NewSetExecutor(ctx *ExecutorContext, plan planners.IPlan) IExecutor {
+	return &SetExecutor{
+		ctx:  ctx,
+		plan: plan.(*planners.SetPlan),
+	}
+}
+
+// Execute keeps the settings in the session, the following queries of the session take them.
+func (executor *SetExecutor) Execute() (*Result, error) {
+	ectx := executor.ctx
+	plan := executor.plan
+
+	settings, err := planners.ParseSettings(plan.Ast.Exprs)
+	if err != nil {
+		return nil, err
+	}
+	for name, value := range settings {
+		ectx.session.SetSetting(name, value)
+	}
+
+	result := NewResult()
+	return result, nil
+}
+
+func (executor *SetExecutor) String() string {
+	return ""
+}
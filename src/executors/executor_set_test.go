@@ -0,0 +1,85 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package executors
+
+import (
+	"testing"
+
+	"mocks"
+	"optimizers"
+	"planners"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetExecutor(t *testing.T) {
+	tests := []struct {
+		name   string
+		query  string
+		expect map[string]string
+	}{
+		{
+			name:   "set-int",
+			query:  "set max_threads = 4",
+			expect: map[string]string{"max_threads": "4"},
+		},
+		{
+			name:   "set-string",
+			query:  "set default_format = 'CSV', readonly = 1",
+			expect: map[string]string{"default_format": "CSV", "readonly": "1"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mock, cleanup := mocks.NewMock()
+			defer cleanup()
+
+			plan, err := planners.PlanFactory(test.query)
+			assert.Nil(t, err)
+
+			ctx := NewExecutorContext(mock.Ctx, mock.Log, mock.Conf, mock.Session)
+			executor, err := ExecutorFactory(ctx, plan)
+			assert.Nil(t, err)
+
+			_, err = executor.Execute()
+			assert.Nil(t, err)
+			assert.Equal(t, test.expect, mock.Session.Settings())
+		})
+	}
+}
+
+func TestSetExecutorReorderConjuncts(t *testing.T) {
+	mock, cleanup := mocks.NewMock()
+	defer cleanup()
+
+	plan, err := planners.PlanFactory("set optimize_reorder_conjuncts = 0")
+	assert.Nil(t, err)
+	ctx := NewExecutorContext(mock.Ctx, mock.Log, mock.Conf, mock.Session)
+	executor, err := ExecutorFactory(ctx, plan)
+	assert.Nil(t, err)
+	_, err = executor.Execute()
+	assert.Nil(t, err)
+
+	// The following queries of the session keep the conjuncts as written, as the servers optimize them.
+	conf, unknown, err := mock.Conf.WithSettings(mock.Session.Settings())
+	assert.Nil(t, err)
+	assert.Empty(t, unknown)
+	plan, err = planners.PlanFactory("SELECT a FROM t WHERE url LIKE '%x%' AND status = 404")
+	assert.Nil(t, err)
+	plan = optimizers.Optimize(conf, plan, optimizers.DefaultOptimizers)
+
+	var filter *planners.FilterPlan
+	err = planners.Walk(func(plan planners.IPlan) (bool, error) {
+		if plan, ok := plan.(*planners.ScanPlan); ok {
+			filter = plan.Filter
+		}
+		return true, nil
+	}, plan)
+	assert.Nil(t, err)
+	conjuncts := planners.Conjuncts(filter.SubPlan)
+	assert.Equal(t, 2, len(conjuncts))
+	assert.Equal(t, "like", conjuncts[0].(*planners.BinaryExpressionPlan).FuncName)
+}
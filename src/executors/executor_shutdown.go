@@ -0,0 +1,106 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package executors
+
+import (
+	"sync"
+	"time"
+
+	"base/errors"
+)
+
+// runningQueries are the ExecutionLimits not cancelled yet, the shutdown waits for them.
+type runningQueries struct {
+	mu       sync.Mutex
+	queries  map[*ExecutionLimits]struct{}
+	shutdown bool
+	// idle is closed once the last query ends after the shutdown began.
+	idle chan struct{}
+}
+
+var globalRunningQueries = &runningQueries{
+	queries: make(map[*ExecutionLimits]struct{}),
+	idle:    make(chan struct{}),
+}
+
+func (r *runningQueries) add(limits *ExecutionLimits) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.queries[limits] = struct{}{}
+}
+
+func (r *runningQueries) remove(limits *ExecutionLimits) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.queries[limits]; !ok {
+		return
+	}
+	delete(r.queries, limits)
+	if r.shutdown && len(r.queries) == 0 {
+		close(r.idle)
+	}
+}
+
+// BeginShutdown makes the new queries fail with ShutdownError, the running ones go on.
+func BeginShutdown() {
+	r := globalRunningQueries
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.shutdown {
+		return
+	}
+	r.shutdown = true
+	if len(r.queries) == 0 {
+		close(r.idle)
+	}
+}
+
+// ShutdownError returns the error of the queries started after BeginShutdown, nil before.
+// The servers check it once the query has its ExecutionLimits, so the shutdown either waits for it or fails it.
+func ShutdownError() error {
+	r := globalRunningQueries
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.shutdown {
+		return errors.ErrorWithCode(errors.ABORTED, "Server is shutting down")
+	}
+	return nil
+}
+
+// RunningQueries returns the number of the queries not finished.
+func RunningQueries() int {
+	r := globalRunningQueries
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.queries)
+}
+
+// WaitQueries waits for the running queries to finish after BeginShutdown, false if they don't within the timeout.
+func WaitQueries(timeout time.Duration) bool {
+	t := time.NewTimer(timeout)
+	defer t.Stop()
+	select {
+	case <-globalRunningQueries.idle:
+		return true
+	case <-t.C:
+		return false
+	}
+}
+
+// CancelQueries cancels the running queries, they fail with ABORTED as the server is shutting down.
+func CancelQueries() int {
+	r := globalRunningQueries
+	r.mu.Lock()
+	queries := make([]*ExecutionLimits, 0, len(r.queries))
+	for limits := range r.queries {
+		queries = append(queries, limits)
+	}
+	r.mu.Unlock()
+
+	for _, limits := range queries {
+		limits.abort(errors.ErrorWithCode(errors.ABORTED, "Query was cancelled: the server is shutting down"))
+	}
+	return len(queries)
+}
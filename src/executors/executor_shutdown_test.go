@@ -0,0 +1,64 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package executors
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"config"
+	"sessions"
+
+	"base/errors"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShutdown(t *testing.T) {
+	saved := globalRunningQueries
+	globalRunningQueries = &runningQueries{
+		queries: make(map[*ExecutionLimits]struct{}),
+		idle:    make(chan struct{}),
+	}
+	defer func() { globalRunningQueries = saved }()
+
+	conf := config.DefaultConfig()
+	pv := &sessions.ProgressValues{}
+	assert.Nil(t, ShutdownError())
+
+	// The finished query is not waited for.
+	_, done := NewExecutionLimits(context.Background(), conf)
+	_, slow := NewExecutionLimits(context.Background(), conf)
+	ctx2, stuck := NewExecutionLimits(context.Background(), conf)
+	done.Cancel()
+	done.Cancel()
+	assert.Equal(t, 2, RunningQueries())
+
+	// The new queries are refused.
+	BeginShutdown()
+	BeginShutdown()
+	err := ShutdownError()
+	assert.Equal(t, errors.ABORTED, errors.Code(err))
+	assert.Equal(t, "Server is shutting down (errno 236)", err.Error())
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		slow.Cancel()
+	}()
+	assert.False(t, WaitQueries(50*time.Millisecond))
+	assert.Equal(t, 1, RunningQueries())
+	assert.Nil(t, ctx2.Err())
+
+	// The one beyond the wait is cancelled with the shutdown error.
+	assert.Equal(t, 1, CancelQueries())
+	assert.Equal(t, context.Canceled, ctx2.Err())
+	err = stuck.Error(ctx2.Err(), pv)
+	assert.Equal(t, errors.ABORTED, errors.Code(err))
+	assert.Equal(t, "Query was cancelled: the server is shutting down (errno 236)", err.Error())
+	stuck.Cancel()
+	assert.True(t, WaitQueries(time.Second))
+	assert.Equal(t, 0, RunningQueries())
+}
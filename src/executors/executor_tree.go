@@ -23,16 +23,45 @@ func (tree *ExecutorTree) Add(executor IExecutor) {
 	tree.subExecutors = append(tree.subExecutors, executor)
 }
 
+// BuildPipeline builds the transforms of the executors in order, the ones running per lane
+// get max_threads lanes until the others merge them, max_threads 1 is one transform per step.
 func (tree *ExecutorTree) BuildPipeline() (*processors.Pipeline, error) {
 	ectx := tree.ctx
 
-	pipeline := processors.NewPipeline(ectx.ctx)
-	for _, executor := range tree.subExecutors {
-		transform, err := executor.Execute()
-		if err != nil {
-			return nil, err
+	width := ectx.conf.Runtime.ParallelWorkerNumber
+	if width < 1 {
+		width = 1
+	}
+
+	// The lanes end at the first merge, the order of the blocks after it is kept, such as the one of ORDER BY.
+	merged := false
+	pipeline := processors.NewPipeline(ectx.ctx).SetEdgeCapacity(ectx.conf.Runtime.MaxBlocksInFlight)
+	for i, executor := range tree.subExecutors {
+		lanes := 1
+		if x, ok := executor.(ILaneExecutor); ok && x.PerLane() && !merged {
+			lanes = width
+		} else if i > 0 {
+			merged = true
+		}
+
+		transforms := make([]processors.IProcessor, lanes)
+		for i := range transforms {
+			transform, err := executor.Execute()
+			if err != nil {
+				return nil, err
+			}
+			transforms[i] = transform.In
+		}
+		pipeline.AddLanes(transforms...)
+
+		if x, ok := executor.(IMergeExecutor); ok && lanes > 1 {
+			transform, err := x.MergeLanes()
+			if err != nil {
+				return nil, err
+			}
+			pipeline.Add(transform.In)
+			merged = true
 		}
-		pipeline.Add(transform.In)
 	}
 	return pipeline, nil
 }
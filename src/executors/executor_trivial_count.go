@@ -0,0 +1,77 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package executors
+
+import (
+	"fmt"
+
+	"columns"
+	"datablocks"
+	"datastreams"
+	"datatypes"
+	"datavalues"
+	"planners"
+	"processors"
+	"storages"
+	"transforms"
+)
+
+type TrivialCountExecutor struct {
+	ctx         *ExecutorContext
+	plan        *planners.TrivialCountPlan
+	rows        int64
+	transformer processors.IProcessor
+}
+
+// NewTrivialCountExecutor creates the executor answering the count by the exact rows of the storage.
+// It's false if the storage can't tell them, the scan and the selection of the plan count the rows then.
+func NewTrivialCountExecutor(ctx *ExecutorContext, plan *planners.TrivialCountPlan) (IExecutor, bool) {
+	storage, err := ctx.getStorage(plan.Scan.Schema, plan.Scan.Table)
+	if err != nil {
+		return nil, false
+	}
+	stats, ok := storage.(storages.IStatisticsStorage)
+	if !ok {
+		return nil, false
+	}
+	rows, ok := stats.ExactRows(ctx.session)
+	if !ok {
+		return nil, false
+	}
+	return &TrivialCountExecutor{
+		ctx:  ctx,
+		plan: plan,
+		rows: rows,
+	}, true
+}
+
+// Execute sends the one row of the count, named as the selection does.
+func (executor *TrivialCountExecutor) Execute() (*Result, error) {
+	ectx := executor.ctx
+
+	expr, err := planners.BuildExpression(executor.plan.Selection.Projects.SubPlans[0])
+	if err != nil {
+		return nil, err
+	}
+	block := datablocks.NewDataBlock([]*columns.Column{
+		columns.NewColumn(expr.String(), datatypes.NewInt64DataType()),
+	})
+	if err := block.WriteRow([]datavalues.IDataValue{datavalues.MakeInt(executor.rows)}); err != nil {
+		return nil, err
+	}
+
+	transformCtx := transforms.NewTransformContext(ectx.ctx, ectx.log, ectx.conf)
+	transform := transforms.NewNamedDataSourceTransform(transformCtx, datastreams.NewOneBlockInputStream(block), "trivial count optimization")
+	executor.transformer = transform
+
+	result := NewResult()
+	result.SetInput(transform)
+	return result, nil
+}
+
+func (executor *TrivialCountExecutor) String() string {
+	transformer := executor.transformer.(*transforms.DataSourceTransform)
+	return fmt.Sprintf("(%v, stats:%+v, cost:%v)", transformer.Name(), transformer.Stats(), transformer.Duration())
+}
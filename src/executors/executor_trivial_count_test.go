@@ -0,0 +1,128 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package executors
+
+import (
+	"testing"
+
+	"datablocks"
+	"datavalues"
+	"mocks"
+	"optimizers"
+	"planners"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrivialCountExecutor(t *testing.T) {
+	mock, cleanup := mocks.NewMock()
+	defer cleanup()
+	mock.Conf.Server.DefaultBlockSize = 10
+
+	// The rows of the query and the executor of its source.
+	run := func(query string, optimize bool) ([][]datavalues.IDataValue, IExecutor) {
+		plan, err := planners.PlanFactory(query)
+		assert.Nil(t, err)
+		if optimize {
+			plan = optimizers.Optimize(mock.Conf, plan, optimizers.DefaultOptimizers)
+		}
+		ctx := NewExecutorContext(mock.Ctx, mock.Log, mock.Conf, mock.Session)
+		executor, err := ExecutorFactory(ctx, plan)
+		assert.Nil(t, err)
+		result, err := executor.Execute()
+		assert.Nil(t, err)
+
+		var rows [][]datavalues.IDataValue
+		if result.In != nil {
+			for x := range result.Read() {
+				switch x := x.(type) {
+				case error:
+					assert.Nil(t, x, query)
+				case *datablocks.DataBlock:
+					iter := x.RowIterator()
+					for iter.Next() {
+						rows = append(rows, iter.Value())
+					}
+				}
+			}
+		}
+		var source IExecutor
+		if selector, ok := executor.(*SelectExecutor); ok {
+			source = selector.tree.subExecutors[0]
+		}
+		return rows, source
+	}
+
+	run("create database db1", false)
+	run("create table db1.t1(a Int32, b String) Engine=Memory", false)
+	run("create table db1.t2(a Int32) Engine=Memory", false)
+	run("insert into db1.t1 select i, s from rangetable(rows->35, i->'Int32', s->'String')", false)
+	defer run("drop database db1", false)
+
+	tests := []struct {
+		name    string
+		query   string
+		trivial bool
+		expect  int64
+	}{
+		{name: "count", query: "select count(1) from db1.t1", trivial: true, expect: 35},
+		{name: "aliased", query: "select count(1) as c from db1.t1", trivial: true, expect: 35},
+		{name: "count-empty", query: "select count() from db1.t1", trivial: true, expect: 35},
+		{name: "count-star", query: "select count(*) from db1.t1", trivial: true, expect: 35},
+		{name: "count-star-where", query: "select count(*) from db1.t1 where a >= 30", expect: 5},
+		{name: "where-true", query: "select count(1) from db1.t1 where 1 = 1", trivial: true, expect: 35},
+		{name: "where", query: "select count(1) from db1.t1 where a >= 30", expect: 5},
+		{name: "column", query: "select count(a) from db1.t1", expect: 35},
+		{name: "system", query: "select count(1) from system.databases", expect: 3},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			expect, _ := run(test.query, false)
+			actual, source := run(test.query, true)
+			_, trivial := source.(*TrivialCountExecutor)
+			assert.Equal(t, test.trivial, trivial)
+			assert.Equal(t, expect, actual)
+			if test.name != "system" {
+				assert.Equal(t, [][]datavalues.IDataValue{{datavalues.MakeInt(test.expect)}}, actual)
+			}
+		})
+	}
+
+	// The empty table is of the count 0, which the scan of no blocks has no row of.
+	actual, source := run("select count(1) from db1.t2", true)
+	assert.IsType(t, &TrivialCountExecutor{}, source)
+	assert.Equal(t, [][]datavalues.IDataValue{{datavalues.MakeInt(0)}}, actual)
+
+	explain := func(query string) []string {
+		rows, _ := run(query, true)
+		var res []string
+		for _, row := range rows {
+			res = append(res, datavalues.AsString(row[0]))
+		}
+		return res
+	}
+	assert.Equal(t, []string{
+		"transform_datasource (trivial count optimization)",
+		"transform_projection",
+		"transforms_sink",
+	}, explain("explain pipeline select count(1) from db1.t1 settings max_threads = 1"))
+	for _, query := range []string{
+		"explain pipeline select count() from db1.t1 settings max_threads = 1",
+		"explain pipeline select count(*) from db1.t1 settings max_threads = 1",
+	} {
+		assert.Equal(t, []string{
+			"transform_datasource (trivial count optimization)",
+			"transform_projection",
+			"transforms_sink",
+		}, explain(query), query)
+	}
+	// The system tables can't tell their rows exactly.
+	assert.Equal(t, []string{
+		"transform_datasource",
+		"transform_aggregate_selection",
+		"transform_projection",
+		"transforms_sink",
+	}, explain("explain pipeline select count(1) from system.databases settings max_threads = 1"))
+}
@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"base/errors"
 	"columns"
 	"datablocks"
 	"datastreams"
@@ -60,6 +61,8 @@ func (executor *TableValuedFunctionExecutor) Execute() (*Result, error) {
 
 	var cols []*columns.Column
 	switch strings.ToUpper(plan.FuncName) {
+	case "GENERATERANDOM":
+		return executor.executeGenerateRandom(constants)
 	case "RANGETABLE", "RANDTABLE":
 		for i := 1; i < len(variables); i++ {
 			datatype, err := datatypes.DataTypeFactory(datavalues.AsString(constants[i].(datavalues.IDataValue)))
@@ -141,6 +144,90 @@ func (executor *TableValuedFunctionExecutor) Execute() (*Result, error) {
 	return result, nil
 }
 
+// executeGenerateRandom streams the random blocks endlessly until the query is done,
+// the blocks are generated in order so the result is reproducible for the seed.
+func (executor *TableValuedFunctionExecutor) executeGenerateRandom(constants []interface{}) (*Result, error) {
+	ctx := executor.ctx
+	log := ctx.log
+	conf := ctx.conf
+	queue := make(chan interface{}, 64)
+
+	if len(constants) < 1 || len(constants) > 3 {
+		return nil, errors.Errorf("generateRandom expected 1 to 3 arguments, but got %d", len(constants))
+	}
+	structure := constants[0].(datavalues.IDataValue)
+	cols, err := columns.ParseStructure(datavalues.AsString(structure))
+	if err != nil {
+		return nil, err
+	}
+	seed := datavalues.MakeInt(time.Now().UnixNano())
+	if len(constants) > 1 {
+		seed = constants[1].(datavalues.IDataValue)
+	}
+	maxStringLength := datavalues.ToValue(10)
+	if len(constants) > 2 {
+		maxStringLength = constants[2].(datavalues.IDataValue)
+	}
+	blocksize := conf.Server.DefaultBlockSize
+
+	go func() {
+		defer close(queue)
+
+		start := time.Now()
+		defer func() {
+			executor.duration = time.Since(start)
+		}()
+		for begin := int64(0); ; begin += int64(blocksize) {
+			var x interface{}
+
+			block := datablocks.NewDataBlock(cols)
+			expr, err := expressions.ExpressionFactory(executor.plan.FuncName, []interface{}{
+				datavalues.MakeInt(begin),
+				datavalues.MakeInt(begin + int64(blocksize)),
+				structure,
+				seed,
+				maxStringLength,
+			})
+			if err == nil {
+				err = expr.Eval()
+			}
+			if err == nil {
+				for _, row := range datavalues.AsSlice(expr.Result()) {
+					if err = block.WriteRow(datavalues.AsSlice(row)); err != nil {
+						break
+					}
+				}
+			}
+			if err != nil {
+				log.Error("%+v", err)
+				x = err
+			} else {
+				x = block
+			}
+
+			select {
+			case queue <- x:
+			case <-ctx.ctx.Done():
+				return
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	// Stream.
+	stream := datastreams.NewChannelBlockInputStream(queue)
+	transformCtx := transforms.NewTransformContext(ctx.ctx, ctx.log, ctx.conf)
+	transformCtx.SetProgressCallback(ctx.progressCallback)
+	transform := transforms.NewDataSourceTransform(transformCtx, stream)
+	executor.transformer = transform
+
+	result := NewResult()
+	result.SetInput(transform)
+	return result, nil
+}
+
 func (executor *TableValuedFunctionExecutor) String() string {
 	transformer := executor.transformer.(*transforms.DataSourceTransform)
 	return fmt.Sprintf("(%v, stats:%+v, cost:%v)", transformer.Name(), transformer.Stats(), executor.duration)
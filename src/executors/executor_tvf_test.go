@@ -88,3 +88,53 @@ func TestTVFExecutor(t *testing.T) {
 		})
 	}
 }
+
+func TestTVFExecutorGenerateRandom(t *testing.T) {
+	plan := planners.NewTableValuedFunctionPlan("generateRandom",
+		planners.NewMapPlan(
+			planners.NewTableValuedFunctionExpressionPlan("", planners.NewConstantPlan("a Int32, b String, c DateTime")),
+			planners.NewTableValuedFunctionExpressionPlan("", planners.NewConstantPlan(7)),
+			planners.NewTableValuedFunctionExpressionPlan("", planners.NewConstantPlan(5)),
+		),
+	)
+
+	run := func() []*datablocks.DataBlock {
+		mock, cleanup := mocks.NewMock()
+		defer cleanup()
+
+		ctx := NewExecutorContext(mock.Ctx, mock.Log, mock.Conf, mock.Session)
+		tree := NewExecutorTree(ctx)
+		tree.Add(NewTableValuedFunctionExecutor(ctx, plan))
+		tree.Add(NewSinkExecutor(ctx, nil))
+
+		pipeline, err := tree.BuildPipeline()
+		assert.Nil(t, err)
+		pipeline.Run()
+
+		// The stream is endless, take two blocks.
+		var blocks []*datablocks.DataBlock
+		for x := range pipeline.Out() {
+			blocks = append(blocks, x.(*datablocks.DataBlock))
+			if len(blocks) == 2 {
+				mock.Cancel()
+				break
+			}
+		}
+		return blocks
+	}
+
+	first := run()
+	second := run()
+	assert.Equal(t, 2, len(first))
+	assert.Equal(t, 2, len(second))
+	for i := range first {
+		assert.Equal(t, 65536, first[i].NumRows())
+		assert.True(t, mocks.DataBlockEqual(first[i], second[i]))
+	}
+	assert.False(t, mocks.DataBlockEqual(first[0], first[1]))
+	assert.Equal(t, []*columns.Column{
+		{Name: "a", DataType: datatypes.NewInt32DataType()},
+		{Name: "b", DataType: datatypes.NewStringDataType()},
+		{Name: "c", DataType: datatypes.NewDateTimeDataType()},
+	}, first[0].Columns())
+}
@@ -22,7 +22,7 @@ func TestUseExecutor(t *testing.T) {
 		{
 			name:  "use-db",
 			query: "use dbxx1",
-			err:   "database:dbxx1 doesn't exists",
+			err:   "database:dbxx1 doesn't exists (errno 81)",
 		},
 		{
 			name:  "create-db",
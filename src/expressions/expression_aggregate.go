@@ -22,6 +22,7 @@ type AggregateExpression struct {
 	updateFn      aggregateUpdateFunc
 	mergeFn       aggregateMergeFunc
 	saved         datavalues.IDataValue
+	accumulator   datavalues.IAccumulator
 	validate      IValidator
 	argumentNames [][]string
 	description   docs.Documentation
@@ -43,6 +44,12 @@ func (e *AggregateExpression) Update(params IParams) (datavalues.IDataValue, err
 			return nil, err
 		}
 	}
+	if e.accumulator != nil {
+		if err := e.accumulator.Add(updated); err != nil {
+			return nil, err
+		}
+		return e.accumulator.Result(), nil
+	}
 	if e.saved, err = e.updateFn(e.saved, updated); err != nil {
 		return nil, err
 	}
@@ -53,6 +60,12 @@ func (e *AggregateExpression) Merge(arg IExpression) (datavalues.IDataValue, err
 	var err error
 
 	other := arg.(*AggregateExpression)
+	if e.accumulator != nil {
+		if err := e.accumulator.Merge(other.accumulator); err != nil {
+			return nil, err
+		}
+		return e.accumulator.Result(), nil
+	}
 	if e.saved, err = e.mergeFn(e.saved, other.saved); err != nil {
 		return nil, err
 	}
@@ -60,6 +73,9 @@ func (e *AggregateExpression) Merge(arg IExpression) (datavalues.IDataValue, err
 }
 
 func (e *AggregateExpression) Result() datavalues.IDataValue {
+	if e.accumulator != nil {
+		return e.accumulator.Result()
+	}
 	return e.saved
 }
 
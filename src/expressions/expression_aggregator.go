@@ -99,6 +99,21 @@ func MAX(arg interface{}) IExpression {
 	}
 }
 
+func AVG(arg interface{}) IExpression {
+	return &AggregateExpression{
+		name:          "AVG",
+		argumentNames: [][]string{},
+		description:   docs.Text("Averages Floats or Ints in the group by the compensated sum, NULLs are skipped."),
+		validate: OneOf(
+			SameFamily(datavalues.FamilyInt),
+			SameFamily(datavalues.FamilyFloat),
+			SameFamily(datavalues.FamilyNull),
+		),
+		expr:        expressionsFor(arg)[0],
+		accumulator: &datavalues.AvgAccumulator{},
+	}
+}
+
 func COUNT(arg interface{}) IExpression {
 	return &AggregateExpression{
 		name:          "COUNT",
@@ -90,6 +90,20 @@ func TestAggregatorsExpression(t *testing.T) {
 			expect1: datavalues.ToValue(4),
 			expect2: datavalues.ToValue(7),
 		},
+		{
+			name:    "avg(a)",
+			expr1:   AVG("a"),
+			expr2:   AVG("a"),
+			expect1: datavalues.MakeFloat(2),
+			expect2: datavalues.MakeFloat(10.0 / 3),
+		},
+		{
+			name:    "avg(b)+1",
+			expr1:   ADD(AVG("b"), 1.0),
+			expr2:   ADD(AVG("b"), 1.0),
+			expect1: datavalues.MakeFloat(4.5),
+			expect2: datavalues.MakeFloat(6),
+		},
 		{
 			name:    "count(b)",
 			expr1:   COUNT("b"),
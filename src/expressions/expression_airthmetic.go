@@ -26,6 +26,7 @@ func ADD(left interface{}, right interface{}) IExpression {
 		updateFn: func(left datavalues.IDataValue, right datavalues.IDataValue) (datavalues.IDataValue, error) {
 			return datavalues.Add(left, right)
 		},
+		arenaFn: (*datavalues.Arena).Add,
 	}
 }
 
@@ -46,6 +47,7 @@ func SUB(left interface{}, right interface{}) IExpression {
 		updateFn: func(left datavalues.IDataValue, right datavalues.IDataValue) (datavalues.IDataValue, error) {
 			return datavalues.Sub(left, right)
 		},
+		arenaFn: (*datavalues.Arena).Sub,
 	}
 }
 
@@ -66,6 +68,7 @@ func MUL(left interface{}, right interface{}) IExpression {
 		updateFn: func(left datavalues.IDataValue, right datavalues.IDataValue) (datavalues.IDataValue, error) {
 			return datavalues.Mul(left, right)
 		},
+		arenaFn: (*datavalues.Arena).Mul,
 	}
 }
 
@@ -86,5 +89,6 @@ func DIV(left interface{}, right interface{}) IExpression {
 		updateFn: func(left datavalues.IDataValue, right datavalues.IDataValue) (datavalues.IDataValue, error) {
 			return datavalues.Div(left, right)
 		},
+		arenaFn: (*datavalues.Arena).Div,
 	}
 }
@@ -122,3 +122,20 @@ func TestAirthmeticsParamsExpression(t *testing.T) {
 		})
 	}
 }
+
+func TestAirthmeticsArenaExpression(t *testing.T) {
+	arena := datavalues.NewArena()
+	expr := ALIASED("a", MUL(ADD("x", 1.0), 2.0))
+	SetArena(expr, arena)
+
+	actual, err := expr.Update(Map{"x": datavalues.MakeFloat(1.5)})
+	assert.Nil(t, err)
+	assert.Equal(t, datavalues.MakeFloat(5), actual)
+
+	// The result is made by the arena, the next batch reuses it.
+	arena.Reset()
+	actual2, err := expr.Update(Map{"x": datavalues.MakeFloat(2)})
+	assert.Nil(t, err)
+	assert.True(t, actual == actual2)
+	assert.Equal(t, datavalues.MakeFloat(6), actual2)
+}
@@ -14,12 +14,17 @@ import (
 
 type binaryUpdateFunc func(left, right datavalues.IDataValue) (datavalues.IDataValue, error)
 
+// binaryArenaFunc is the binaryUpdateFunc making the result by the arena.
+type binaryArenaFunc func(arena *datavalues.Arena, left, right datavalues.IDataValue) (datavalues.IDataValue, error)
+
 type BinaryExpression struct {
 	name          string
 	left          IExpression
 	right         IExpression
 	saved         datavalues.IDataValue
 	updateFn      binaryUpdateFunc
+	arenaFn       binaryArenaFunc
+	arena         *datavalues.Arena
 	validate      IValidator
 	argumentNames [][]string
 	description   docs.Documentation
@@ -35,12 +40,7 @@ func (e *BinaryExpression) Eval() error {
 		if err = e.right.Eval(); err != nil {
 			return err
 		}
-		if e.validate != nil {
-			if err := e.validate.Validate(e.left.Result(), e.right.Result()); err != nil {
-				return err
-			}
-		}
-		if e.saved, err = e.updateFn(e.left.Result(), e.right.Result()); err != nil {
+		if e.saved, err = e.evaluate(e.left.Result(), e.right.Result()); err != nil {
 			return err
 		}
 	}
@@ -57,12 +57,7 @@ func (e *BinaryExpression) Update(params IParams) (datavalues.IDataValue, error)
 	if right, err = e.right.Update(params); err != nil {
 		return nil, err
 	}
-	if e.validate != nil {
-		if err := e.validate.Validate(left, right); err != nil {
-			return nil, err
-		}
-	}
-	if e.saved, err = e.updateFn(left, right); err != nil {
+	if e.saved, err = e.evaluate(left, right); err != nil {
 		return nil, err
 	}
 	return e.saved, nil
@@ -82,12 +77,33 @@ func (e *BinaryExpression) Merge(arg IExpression) (datavalues.IDataValue, error)
 		return nil, err
 	}
 
-	if e.saved, err = e.updateFn(left, right); err != nil {
+	if e.saved, err = e.update(left, right); err != nil {
 		return nil, err
 	}
 	return e.saved, nil
 }
 
+// evaluate validates the arguments and updates by them.
+// The error value of an argument is the result, as the arithmetic and the comparisons propagate it.
+func (e *BinaryExpression) evaluate(left, right datavalues.IDataValue) (datavalues.IDataValue, error) {
+	if errv := datavalues.FirstError(left, right); errv != nil {
+		return errv, nil
+	}
+	if e.validate != nil {
+		if err := e.validate.Validate(left, right); err != nil {
+			return nil, err
+		}
+	}
+	return e.update(left, right)
+}
+
+func (e *BinaryExpression) update(left, right datavalues.IDataValue) (datavalues.IDataValue, error) {
+	if e.arena != nil && e.arenaFn != nil {
+		return e.arenaFn(e.arena, left, right)
+	}
+	return e.updateFn(left, right)
+}
+
 func (e *BinaryExpression) Result() datavalues.IDataValue {
 	return e.saved
 }
@@ -114,3 +130,21 @@ func (e *BinaryExpression) Document() docs.Documentation {
 		),
 	)
 }
+
+// SetArena makes the arithmetic and the string functions of the expression allocate their results by the arena,
+// such as for a column of a block. The results are only valid until the arena is Reset.
+func SetArena(expr IExpression, arena *datavalues.Arena) {
+	Walk(func(e IExpression) (bool, error) {
+		switch e := e.(type) {
+		case *BinaryExpression:
+			if e.arenaFn != nil {
+				e.arena = arena
+			}
+		case *ScalarExpression:
+			if e.arenaFn != nil {
+				e.arena = arena
+			}
+		}
+		return true, nil
+	}, expr)
+}
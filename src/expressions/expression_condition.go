@@ -174,37 +174,50 @@ func OR(left interface{}, right interface{}) IExpression {
 }
 
 func LIKE(left interface{}, right interface{}) IExpression {
-	exprs := expressionsFor(left, right)
-	return &BinaryExpression{
-		name: "LIKE",
-		argumentNames: [][]string{
-			{"left", "right"},
-		},
-		description: docs.Text("LIKE."),
-		validate:    All(),
-		left:        exprs[0],
-		right:       exprs[1],
-		updateFn: func(left datavalues.IDataValue, right datavalues.IDataValue) (datavalues.IDataValue, error) {
-			r := datavalues.AsString(right)
-			return datavalues.ToValue(datavalues.Like(r, left)), nil
-		},
-	}
+	return likeExpression("LIKE", "Matches the string by the pattern, the % is any string, the _ is any one character and the backslash escapes.",
+		false, false, left, right)
 }
 
 func NOT_LIKE(left interface{}, right interface{}) IExpression {
+	return likeExpression("NOT LIKE", "Negates the LIKE.", false, true, left, right)
+}
+
+func ILIKE(left interface{}, right interface{}) IExpression {
+	return likeExpression("ILIKE", "The LIKE ignoring the case.", true, false, left, right)
+}
+
+func NOT_ILIKE(left interface{}, right interface{}) IExpression {
+	return likeExpression("NOT ILIKE", "Negates the ILIKE.", true, true, left, right)
+}
+
+// likeExpression matches the left by the pattern of the right, NULL for the NULL.
+// The pattern is compiled once while it stays the same, as the constant ones do row by row.
+func likeExpression(name string, description string, caseInsensitive bool, not bool, left interface{}, right interface{}) IExpression {
+	var pattern string
+	var matcher *datavalues.LikeMatcher
+
 	exprs := expressionsFor(left, right)
 	return &BinaryExpression{
-		name: "NOT LIKE",
+		name: name,
 		argumentNames: [][]string{
-			{"left", "right"},
+			{"s", "pattern"},
 		},
-		description: docs.Text("NOT LIKE."),
+		description: docs.Text(description),
 		validate:    All(),
 		left:        exprs[0],
 		right:       exprs[1],
 		updateFn: func(left datavalues.IDataValue, right datavalues.IDataValue) (datavalues.IDataValue, error) {
-			r := datavalues.AsString(right)
-			return datavalues.ToValue(!datavalues.Like(r, left)), nil
+			if datavalues.IsNull(left) || datavalues.IsNull(right) {
+				return datavalues.MakeNull(), nil
+			}
+			if p := datavalues.AsString(right); matcher == nil || p != pattern {
+				pattern, matcher = p, datavalues.CompileLike(p, caseInsensitive)
+			}
+			s := datavalues.AsString(left)
+			if left.Type() != datavalues.TypeString {
+				s = left.String()
+			}
+			return datavalues.MakeBool(matcher.Match(s) != not), nil
 		},
 	}
 }
@@ -7,6 +7,7 @@ package expressions
 import (
 	"testing"
 
+	"base/errors"
 	"datavalues"
 
 	"github.com/stretchr/testify/assert"
@@ -119,3 +120,70 @@ func TestConditionsExpression(t *testing.T) {
 		})
 	}
 }
+
+func TestLikeExpression(t *testing.T) {
+	tests := []struct {
+		name   string
+		expr   IExpression
+		expect datavalues.IDataValue
+	}{
+		{name: "like", expr: LIKE("url", datavalues.MakeString("%/checkout%")), expect: datavalues.MakeBool(true)},
+		{name: "like-prefix", expr: LIKE("url", datavalues.MakeString("/shop%")), expect: datavalues.MakeBool(false)},
+		{name: "like-single", expr: LIKE("url", datavalues.MakeString("https://shop/_heckout%")), expect: datavalues.MakeBool(true)},
+		{name: "like-case", expr: LIKE("url", datavalues.MakeString("%/CHECKOUT%")), expect: datavalues.MakeBool(false)},
+		{name: "like-column", expr: LIKE("url", "pattern"), expect: datavalues.MakeBool(true)},
+		{name: "like-int", expr: LIKE("i", datavalues.MakeString("4_4")), expect: datavalues.MakeBool(true)},
+		{name: "not-like", expr: NOT_LIKE("url", datavalues.MakeString("%/checkout%")), expect: datavalues.MakeBool(false)},
+		{name: "ilike", expr: ILIKE("url", datavalues.MakeString("%/CHECKOUT%")), expect: datavalues.MakeBool(true)},
+		{name: "not-ilike", expr: NOT_ILIKE("url", datavalues.MakeString("%/CHECKOUT%")), expect: datavalues.MakeBool(false)},
+		{name: "like-null", expr: LIKE("n", datavalues.MakeString("%")), expect: datavalues.MakeNull()},
+		{name: "not-like-null", expr: NOT_LIKE("url", "n"), expect: datavalues.MakeNull()},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			params := Map{
+				"url":     datavalues.MakeString("https://shop/checkout?id=1"),
+				"pattern": datavalues.MakeString("https:%?id=_"),
+				"i":       datavalues.MakeInt32(404),
+				"n":       datavalues.MakeNull(),
+			}
+			actual, err := test.expr.Update(params)
+			assert.Nil(t, err)
+			assert.Equal(t, test.expect, actual)
+		})
+	}
+
+	// The pattern changing row by row is compiled again.
+	expr := LIKE("url", "pattern")
+	for _, pattern := range []string{"%shop%", "%cart%"} {
+		actual, err := expr.Update(Map{"url": datavalues.MakeString("https://shop/"), "pattern": datavalues.MakeString(pattern)})
+		assert.Nil(t, err)
+		assert.Equal(t, datavalues.MakeBool(pattern == "%shop%"), actual)
+	}
+}
+
+func TestErrorValueExpression(t *testing.T) {
+	cell := datavalues.MakeError(errors.New("bad cell"))
+	params := Map{
+		"a": datavalues.MakeInt(1),
+		"e": cell,
+		"s": datavalues.MakeString("s"),
+	}
+	for _, expr := range []IExpression{
+		ADD("a", "e"),
+		DIV("e", "a"),
+		LT("a", "e"),
+		EQ("e", "a"),
+		AND(EQ("a", "a"), "e"),
+		LIKE("e", datavalues.MakeString("%")),
+		// The error is the result before the arguments are validated.
+		ADD("s", "e"),
+		ADD(MUL("e", "a"), "a"),
+	} {
+		actual, err := expr.Update(params)
+		assert.Nil(t, err, "%v", expr)
+		assert.True(t, actual == cell, "%v", expr)
+		assert.Equal(t, "<error: bad cell>", datavalues.Show(actual))
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package expressions
+
+import (
+	"base/docs"
+	"datavalues"
+	"dictionaries"
+)
+
+func DICTGET(args ...interface{}) IExpression {
+	exprs := expressionsFor(args...)
+	return &ScalarExpression{
+		name: "DICTGET",
+		argumentNames: [][]string{
+			{"dict_name", "attr_name", "key"},
+		},
+		description: docs.Text("Returns the attribute value of the key from the external dictionary, or the attribute default if the key is absent."),
+		validate: All(
+			ExactlyNArgs(3),
+			Arg(0, TypeOf(datavalues.ZeroString())),
+			Arg(1, TypeOf(datavalues.ZeroString())),
+		),
+		exprs: exprs,
+		updateFn: func(args ...datavalues.IDataValue) (datavalues.IDataValue, error) {
+			dict, err := dictionaries.GetDictionary(datavalues.AsString(args[0]))
+			if err != nil {
+				return nil, err
+			}
+			return dict.Get(datavalues.AsString(args[1]), args[2])
+		},
+	}
+}
+
+func DICTHAS(args ...interface{}) IExpression {
+	exprs := expressionsFor(args...)
+	return &ScalarExpression{
+		name: "DICTHAS",
+		argumentNames: [][]string{
+			{"dict_name", "key"},
+		},
+		description: docs.Text("Checks whether the key is present in the external dictionary."),
+		validate: All(
+			ExactlyNArgs(2),
+			Arg(0, TypeOf(datavalues.ZeroString())),
+		),
+		exprs: exprs,
+		updateFn: func(args ...datavalues.IDataValue) (datavalues.IDataValue, error) {
+			dict, err := dictionaries.GetDictionary(datavalues.AsString(args[0]))
+			if err != nil {
+				return nil, err
+			}
+			has, err := dict.Has(args[1])
+			if err != nil {
+				return nil, err
+			}
+			return datavalues.MakeBool(has), nil
+		},
+	}
+}
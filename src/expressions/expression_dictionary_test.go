@@ -0,0 +1,92 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package expressions
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"base/xlog"
+	"config"
+	"datavalues"
+	"dictionaries"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDictionaryExpression(t *testing.T) {
+	dir, err := ioutil.TempDir("", "vectorsql-dict")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "regions.tsv")
+	err = ioutil.WriteFile(path, []byte("1\tbeijing\n2\tshanghai\n"), 0644)
+	assert.Nil(t, err)
+
+	conf := config.DefaultConfig()
+	conf.Dictionaries = []config.Dictionary{
+		{
+			Name:       "regions",
+			Source:     "file",
+			Path:       path,
+			Key:        "id",
+			Attributes: []config.DictionaryAttribute{{Name: "name", Type: "String"}},
+		},
+	}
+	err = dictionaries.Load(xlog.NewStdLog(xlog.Level(xlog.PANIC)), conf)
+	assert.Nil(t, err)
+	defer dictionaries.Close()
+
+	tests := []struct {
+		name      string
+		expr      IExpression
+		expect    datavalues.IDataValue
+		errstring string
+	}{
+		{
+			name:   "dictGet('regions', 'name', a)",
+			expr:   DICTGET(CONST("regions"), CONST("name"), VAR("a")),
+			expect: datavalues.MakeString("shanghai"),
+		},
+		{
+			name:   "dictHas('regions', a)",
+			expr:   DICTHAS(CONST("regions"), VAR("a")),
+			expect: datavalues.MakeBool(true),
+		},
+		{
+			name:   "dictHas('regions', 3)",
+			expr:   DICTHAS(CONST("regions"), CONST(3)),
+			expect: datavalues.MakeBool(false),
+		},
+		{
+			name:      "dictGet-unknown-dict",
+			expr:      DICTGET(CONST("none"), CONST("name"), VAR("a")),
+			errstring: "Dictionary:none doesn't exist",
+		},
+		{
+			name:      "dictGet-args-error",
+			expr:      DICTGET(CONST("regions"), VAR("a")),
+			errstring: "expected exactly 3 arguments, but got 2",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			params := Map{
+				"a": datavalues.ToValue(2),
+			}
+			actual, err := test.expr.Update(params)
+			if test.errstring != "" {
+				assert.NotNil(t, err)
+				assert.Equal(t, test.errstring, err.Error())
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, test.expect, actual)
+			}
+		})
+	}
+}
@@ -21,36 +21,73 @@ var (
 		"SUM":   SUM,
 		"MIN":   MIN,
 		"MAX":   MAX,
+		"AVG":   AVG,
 		"COUNT": COUNT,
 	}
 
 	binaryExprTable = map[string]binaryExprCreator{
-		"+":        ADD,
-		"-":        SUB,
-		"*":        MUL,
-		"/":        DIV,
-		">":        GT,
-		">=":       GTE,
-		"=":        EQ,
-		"<":        LT,
-		"<=":       LTE,
-		"<>":       NEQ,
-		"!=":       NEQ,
-		"AND":      AND,
-		"OR":       OR,
-		"LIKE":     LIKE,
-		"NOT LIKE": NOT_LIKE,
+		"+":         ADD,
+		"-":         SUB,
+		"*":         MUL,
+		"/":         DIV,
+		">":         GT,
+		">=":        GTE,
+		"=":         EQ,
+		"<":         LT,
+		"<=":        LTE,
+		"<>":        NEQ,
+		"!=":        NEQ,
+		"AND":       AND,
+		"OR":        OR,
+		"LIKE":      LIKE,
+		"NOT LIKE":  NOT_LIKE,
+		"NOTLIKE":   NOT_LIKE,
+		"ILIKE":     ILIKE,
+		"NOT ILIKE": NOT_ILIKE,
+		"NOTILIKE":  NOT_ILIKE,
 	}
 
 	scalarExprTable = map[string]scalarExprCreator{
-		"LOGMOCK":    LOGMOCK,
-		"RANGETABLE": RANGETABLE,
-		"RANDTABLE":  RANDTABLE,
-		"ZIP":        ZIP,
-		"IF":         IF,
+		"LOGMOCK":          LOGMOCK,
+		"RANGETABLE":       RANGETABLE,
+		"RANDTABLE":        RANDTABLE,
+		"GENERATERANDOM":   GENERATERANDOM,
+		"ZIP":              ZIP,
+		"IF":               IF,
+		"DICTGET":          DICTGET,
+		"DICTHAS":          DICTHAS,
+		"LOWER":            LOWER,
+		"UPPER":            UPPER,
+		"LOWERUTF8":        LOWERUTF8,
+		"UPPERUTF8":        UPPERUTF8,
+		"LENGTH":           LENGTH,
+		"LENGTHUTF8":       LENGTHUTF8,
+		"SUBSTRING":        SUBSTRING,
+		"SUBSTR":           SUBSTR,
+		"MATCH":            MATCH,
+		"EXTRACT":          EXTRACT,
+		"EXTRACTALL":       EXTRACTALL,
+		"REPLACEREGEXPONE": REPLACEREGEXPONE,
+		"REPLACEREGEXPALL": REPLACEREGEXPALL,
+	}
+
+	// nonDeterministicTable are the functions whose results differ between the calls with the same arguments,
+	// such as the random ones and the dictionaries which are reloaded.
+	nonDeterministicTable = map[string]struct{}{
+		"LOGMOCK":        {},
+		"RANDTABLE":      {},
+		"GENERATERANDOM": {},
+		"DICTGET":        {},
+		"DICTHAS":        {},
 	}
 )
 
+// IsDeterministic checks if the function always returns the same result for the same arguments.
+func IsDeterministic(name string) bool {
+	_, ok := nonDeterministicTable[strings.ToUpper(name)]
+	return !ok
+}
+
 func ExpressionFactory(name string, args []interface{}) (IExpression, error) {
 	name = strings.ToUpper(name)
 	switch len(args) {
@@ -64,7 +101,11 @@ func ExpressionFactory(name string, args []interface{}) (IExpression, error) {
 		}
 	}
 	if creator, ok := scalarExprTable[name]; ok {
-		return creator(args...), nil
+		expr := creator(args...)
+		if scalar, ok := expr.(*ScalarExpression); ok && scalar.err != nil {
+			return nil, scalar.err
+		}
+		return expr, nil
 	}
-	return nil, errors.Errorf("Unsupported Expression:%v", name)
+	return nil, errors.ErrorWithCode(errors.UNKNOWN_FUNCTION, "Unsupported Expression:%v", name)
 }
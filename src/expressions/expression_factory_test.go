@@ -36,7 +36,7 @@ func TestExpressionFactory(t *testing.T) {
 		{
 			name:      "notfound-fail",
 			exprName:  "notfound",
-			errstring: "Unsupported Expression:NOTFOUND",
+			errstring: "Unsupported Expression:NOTFOUND (errno 46)",
 		},
 	}
 
@@ -52,3 +52,10 @@ func TestExpressionFactory(t *testing.T) {
 		})
 	}
 }
+
+func TestIsDeterministic(t *testing.T) {
+	assert.True(t, IsDeterministic("rangetable"))
+	assert.True(t, IsDeterministic("SUM"))
+	assert.False(t, IsDeterministic("randtable"))
+	assert.False(t, IsDeterministic("dictGet"))
+}
@@ -0,0 +1,137 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package expressions
+
+import (
+	"regexp"
+
+	"base/docs"
+	"base/errors"
+	"datavalues"
+)
+
+// regexpCache is the regular expression of the last pattern, compiled again only if the pattern changes row by row.
+type regexpCache struct {
+	pattern string
+	re      *regexp.Regexp
+}
+
+func (c *regexpCache) compile(pattern string) (*regexp.Regexp, error) {
+	if c.re == nil || c.pattern != pattern {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, errors.ErrorWithCode(errors.CANNOT_COMPILE_REGEXP, "Cannot compile the regular expression %q: %v", pattern, err)
+		}
+		c.pattern, c.re = pattern, re
+	}
+	return c.re, nil
+}
+
+// regexpFunction is the function of the string and the RE2 regular expression of the second argument, NULL for the NULLs.
+// The constant pattern is compiled once the function is made, so the invalid one fails the plan rather than the rows.
+// The results are made by the arena of the column if it's set.
+func regexpFunction(name string, description string, argumentNames [][]string, validate IValidator,
+	fn func(arena *datavalues.Arena, re *regexp.Regexp, args []datavalues.IDataValue) datavalues.IDataValue, args ...interface{}) IExpression {
+	var cache regexpCache
+
+	exprs := expressionsFor(args...)
+	update := func(arena *datavalues.Arena, args ...datavalues.IDataValue) (datavalues.IDataValue, error) {
+		for _, arg := range args {
+			if datavalues.IsNull(arg) {
+				return datavalues.MakeNull(), nil
+			}
+		}
+		re, err := cache.compile(datavalues.AsString(args[1]))
+		if err != nil {
+			return nil, err
+		}
+		return fn(arena, re, args), nil
+	}
+	expr := &ScalarExpression{
+		name:          name,
+		argumentNames: argumentNames,
+		description:   docs.Text(description),
+		validate:      validate,
+		exprs:         exprs,
+		updateFn: func(args ...datavalues.IDataValue) (datavalues.IDataValue, error) {
+			return update(nil, args...)
+		},
+		arenaFn: update,
+	}
+	if len(exprs) > 1 {
+		if constant, ok := exprs[1].(*ConstantExpression); ok && constant.value.Type() == datavalues.TypeString {
+			_, expr.err = cache.compile(datavalues.AsString(constant.value))
+		}
+	}
+	return expr
+}
+
+func MATCH(args ...interface{}) IExpression {
+	return regexpFunction("MATCH", "Checks if the string matches the regular expression of the RE2 syntax.",
+		[][]string{{"s", "regexp"}},
+		All(
+			ExactlyNArgs(2),
+			Arg(0, NullOr(TypeOf(datavalues.ZeroString()))),
+			Arg(1, NullOr(TypeOf(datavalues.ZeroString()))),
+		),
+		func(arena *datavalues.Arena, re *regexp.Regexp, args []datavalues.IDataValue) datavalues.IDataValue {
+			return datavalues.MakeBool(re.MatchString(datavalues.AsString(args[0])))
+		}, args...)
+}
+
+func EXTRACT(args ...interface{}) IExpression {
+	return regexpFunction("EXTRACT", "Returns the first capture group of the first match of the regular expression, "+
+		"the whole match if it has no groups, empty if nothing matches.",
+		[][]string{{"s", "regexp"}},
+		All(
+			ExactlyNArgs(2),
+			Arg(0, NullOr(TypeOf(datavalues.ZeroString()))),
+			Arg(1, NullOr(TypeOf(datavalues.ZeroString()))),
+		),
+		func(arena *datavalues.Arena, re *regexp.Regexp, args []datavalues.IDataValue) datavalues.IDataValue {
+			return makeString(arena, datavalues.Extract(re, datavalues.AsString(args[0])))
+		}, args...)
+}
+
+func EXTRACTALL(args ...interface{}) IExpression {
+	return regexpFunction("EXTRACTALL", "Returns the tuple of the EXTRACT of all the matches of the regular expression.",
+		[][]string{{"s", "regexp"}},
+		All(
+			ExactlyNArgs(2),
+			Arg(0, NullOr(TypeOf(datavalues.ZeroString()))),
+			Arg(1, NullOr(TypeOf(datavalues.ZeroString()))),
+		),
+		func(arena *datavalues.Arena, re *regexp.Regexp, args []datavalues.IDataValue) datavalues.IDataValue {
+			matches := datavalues.ExtractAll(re, datavalues.AsString(args[0]))
+			values := make([]datavalues.IDataValue, len(matches))
+			for i, m := range matches {
+				values[i] = datavalues.MakeString(m)
+			}
+			return datavalues.MakeTuple(values...)
+		}, args...)
+}
+
+func REPLACEREGEXPONE(args ...interface{}) IExpression {
+	return replaceRegexp("REPLACEREGEXPONE", "Replaces the first match of the regular expression.", datavalues.ReplaceRegexpOne, args...)
+}
+
+func REPLACEREGEXPALL(args ...interface{}) IExpression {
+	return replaceRegexp("REPLACEREGEXPALL", "Replaces all the matches of the regular expression.", datavalues.ReplaceRegexpAll, args...)
+}
+
+func replaceRegexp(name string, description string, replace func(re *regexp.Regexp, s string, template string) string, args ...interface{}) IExpression {
+	return regexpFunction(name, description+` The replacement takes \0 for the whole match and \1 to \9 for the groups.`,
+		[][]string{{"s", "regexp", "replacement"}},
+		All(
+			ExactlyNArgs(3),
+			Arg(0, NullOr(TypeOf(datavalues.ZeroString()))),
+			Arg(1, NullOr(TypeOf(datavalues.ZeroString()))),
+			Arg(2, NullOr(TypeOf(datavalues.ZeroString()))),
+		),
+		func(arena *datavalues.Arena, re *regexp.Regexp, args []datavalues.IDataValue) datavalues.IDataValue {
+			template := datavalues.RegexpTemplate(datavalues.AsString(args[2]))
+			return makeString(arena, replace(re, datavalues.AsString(args[0]), template))
+		}, args...)
+}
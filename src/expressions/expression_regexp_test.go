@@ -0,0 +1,80 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package expressions
+
+import (
+	"testing"
+
+	"base/errors"
+	"datavalues"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegexpExpressions(t *testing.T) {
+	tests := []struct {
+		name      string
+		expr      IExpression
+		expect    datavalues.IDataValue
+		errstring string
+	}{
+		{name: "match", expr: MATCH("s", datavalues.MakeString(`^/user/\d+`)), expect: datavalues.MakeBool(true)},
+		{name: "match-not", expr: MATCH("s", datavalues.MakeString(`^/admin`)), expect: datavalues.MakeBool(false)},
+		{name: "match-column", expr: MATCH("s", "re"), expect: datavalues.MakeBool(true)},
+		{name: "extract", expr: EXTRACT("s", datavalues.MakeString(`id=(\d+)`)), expect: datavalues.MakeString("7")},
+		{name: "extract-whole", expr: EXTRACT("s", datavalues.MakeString(`\d+`)), expect: datavalues.MakeString("42")},
+		{name: "extractAll", expr: EXTRACTALL("s", datavalues.MakeString(`\d+`)), expect: datavalues.MakeTuple(datavalues.MakeString("42"), datavalues.MakeString("7"))},
+		{name: "replaceRegexpOne", expr: REPLACEREGEXPONE("s", datavalues.MakeString(`\d+`), datavalues.MakeString(`<\0>`)), expect: datavalues.MakeString("/user/<42>?id=7")},
+		{name: "replaceRegexpAll", expr: REPLACEREGEXPALL("s", datavalues.MakeString(`(\d+)`), datavalues.MakeString(`N\1`)), expect: datavalues.MakeString("/user/N42?id=N7")},
+		{name: "match-null", expr: MATCH("n", datavalues.MakeString(`x`)), expect: datavalues.MakeNull()},
+		{name: "replace-null", expr: REPLACEREGEXPALL("s", datavalues.MakeString(`x`), "n"), expect: datavalues.MakeNull()},
+		{name: "match-args", expr: MATCH("s"), errstring: "expected exactly 2 arguments, but got 1"},
+		{name: "match-int", expr: MATCH("s", "i"), errstring: "bad argument at index 1: expected type &{String} but got &{Int32}"},
+		{name: "match-invalid", expr: MATCH("s", "bad"), errstring: "Cannot compile the regular expression \"(\": error parsing regexp: missing closing ): `(` (errno 427)"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			params := Map{
+				"s":   datavalues.MakeString("/user/42?id=7"),
+				"re":  datavalues.MakeString(`id=\d$`),
+				"bad": datavalues.MakeString("("),
+				"i":   datavalues.MakeInt32(1),
+				"n":   datavalues.MakeNull(),
+			}
+			actual, err := test.expr.Update(params)
+			if test.errstring != "" {
+				assert.NotNil(t, err)
+				assert.Equal(t, test.errstring, err.Error())
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, test.expect, actual)
+
+			// The arena makes the same values.
+			SetArena(test.expr, datavalues.NewArena())
+			actual, err = test.expr.Update(params)
+			assert.Nil(t, err)
+			assert.Equal(t, test.expect, actual)
+		})
+	}
+}
+
+func TestRegexpExpressionConstant(t *testing.T) {
+	// The constant pattern is compiled once the function is made.
+	expr, err := ExpressionFactory("match", []interface{}{"s", datavalues.MakeString(`\d`)})
+	assert.Nil(t, err)
+	assert.Nil(t, expr.(*ScalarExpression).err)
+	for _, s := range []string{"a1", "b"} {
+		actual, err := expr.Update(Map{"s": datavalues.MakeString(s)})
+		assert.Nil(t, err)
+		assert.Equal(t, datavalues.MakeBool(s == "a1"), actual)
+	}
+
+	_, err = ExpressionFactory("extract", []interface{}{"s", datavalues.MakeString(`[a-`)})
+	assert.Equal(t, errors.CANNOT_COMPILE_REGEXP, errors.Code(err))
+	_, err = ExpressionFactory("replaceRegexpAll", []interface{}{"s", datavalues.MakeString(`(?P<`), datavalues.MakeString("")})
+	assert.Equal(t, errors.CANNOT_COMPILE_REGEXP, errors.Code(err))
+}
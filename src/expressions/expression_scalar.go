@@ -13,19 +13,32 @@ import (
 )
 
 type scalarUpdateFunc func(args ...datavalues.IDataValue) (datavalues.IDataValue, error)
+
+// scalarArenaFunc is the scalarUpdateFunc making the result by the arena, it doesn't keep the args.
+type scalarArenaFunc func(arena *datavalues.Arena, args ...datavalues.IDataValue) (datavalues.IDataValue, error)
+
 type ScalarExpression struct {
 	name          string
 	exprs         []IExpression
 	saved         datavalues.IDataValue
 	updateFn      scalarUpdateFunc
+	arenaFn       scalarArenaFunc
+	arena         *datavalues.Arena
+	args          []datavalues.IDataValue
 	validate      IValidator
 	argumentNames [][]string
 	description   docs.Documentation
+	// err is the failure found once the expression is made, such as of its constant regular expression,
+	// the factory returns it rather than the expression failing row by row.
+	err error
 }
 
 func (e *ScalarExpression) Eval() error {
 	var err error
 
+	if e.err != nil {
+		return e.err
+	}
 	if e.saved == nil {
 		values := make([]datavalues.IDataValue, len(e.exprs))
 
@@ -40,7 +53,7 @@ func (e *ScalarExpression) Eval() error {
 				return err
 			}
 		}
-		if e.saved, err = e.updateFn(values...); err != nil {
+		if e.saved, err = e.update(values); err != nil {
 			return err
 		}
 	}
@@ -49,8 +62,20 @@ func (e *ScalarExpression) Eval() error {
 
 func (e *ScalarExpression) Update(params IParams) (datavalues.IDataValue, error) {
 	var err error
-	values := make([]datavalues.IDataValue, len(e.exprs))
+	var values []datavalues.IDataValue
 
+	if e.err != nil {
+		return nil, e.err
+	}
+	// The arena functions don't keep the args, they are reused row by row.
+	if e.arena != nil && e.arenaFn != nil {
+		if e.args == nil {
+			e.args = make([]datavalues.IDataValue, len(e.exprs))
+		}
+		values = e.args
+	} else {
+		values = make([]datavalues.IDataValue, len(e.exprs))
+	}
 	for i, expr := range e.exprs {
 		val, err := expr.Update(params)
 		if err != nil {
@@ -63,12 +88,19 @@ func (e *ScalarExpression) Update(params IParams) (datavalues.IDataValue, error)
 			return nil, err
 		}
 	}
-	if e.saved, err = e.updateFn(values...); err != nil {
+	if e.saved, err = e.update(values); err != nil {
 		return nil, err
 	}
 	return e.saved, nil
 }
 
+func (e *ScalarExpression) update(values []datavalues.IDataValue) (datavalues.IDataValue, error) {
+	if e.arena != nil && e.arenaFn != nil {
+		return e.arenaFn(e.arena, values...)
+	}
+	return e.updateFn(values...)
+}
+
 func (e *ScalarExpression) Merge(arg IExpression) (datavalues.IDataValue, error) {
 	return e.saved, nil
 }
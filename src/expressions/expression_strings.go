@@ -0,0 +1,141 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package expressions
+
+import (
+	"base/docs"
+	"datavalues"
+)
+
+// stringFunction is the function of one string, NULL for the NULL.
+// The results are made by the arena of the column if it's set.
+func stringFunction(name string, description string, fn func(arena *datavalues.Arena, s string) datavalues.IDataValue, args ...interface{}) IExpression {
+	exprs := expressionsFor(args...)
+	update := func(arena *datavalues.Arena, args ...datavalues.IDataValue) (datavalues.IDataValue, error) {
+		if datavalues.IsNull(args[0]) {
+			return datavalues.MakeNull(), nil
+		}
+		return fn(arena, datavalues.AsString(args[0])), nil
+	}
+	return &ScalarExpression{
+		name: name,
+		argumentNames: [][]string{
+			{"s"},
+		},
+		description: docs.Text(description),
+		validate: All(
+			ExactlyNArgs(1),
+			Arg(0, NullOr(TypeOf(datavalues.ZeroString()))),
+		),
+		exprs: exprs,
+		updateFn: func(args ...datavalues.IDataValue) (datavalues.IDataValue, error) {
+			return update(nil, args...)
+		},
+		arenaFn: update,
+	}
+}
+
+func makeString(arena *datavalues.Arena, s string) datavalues.IDataValue {
+	if arena != nil {
+		return arena.MakeString(s)
+	}
+	return datavalues.MakeString(s)
+}
+
+func makeInt(arena *datavalues.Arena, v int64) datavalues.IDataValue {
+	if arena != nil {
+		return arena.MakeInt(v)
+	}
+	return datavalues.MakeInt(v)
+}
+
+func LOWER(args ...interface{}) IExpression {
+	return stringFunction("LOWER", "Converts the ASCII letters of the string to the lower case.",
+		func(arena *datavalues.Arena, s string) datavalues.IDataValue {
+			return makeString(arena, datavalues.Lower(s))
+		}, args...)
+}
+
+func UPPER(args ...interface{}) IExpression {
+	return stringFunction("UPPER", "Converts the ASCII letters of the string to the upper case.",
+		func(arena *datavalues.Arena, s string) datavalues.IDataValue {
+			return makeString(arena, datavalues.Upper(s))
+		}, args...)
+}
+
+func LOWERUTF8(args ...interface{}) IExpression {
+	return stringFunction("LOWERUTF8", "Converts the string to the lower case by the Unicode case mapping, the string is UTF-8.",
+		func(arena *datavalues.Arena, s string) datavalues.IDataValue {
+			return makeString(arena, datavalues.LowerUTF8(s))
+		}, args...)
+}
+
+func UPPERUTF8(args ...interface{}) IExpression {
+	return stringFunction("UPPERUTF8", "Converts the string to the upper case by the Unicode case mapping, the string is UTF-8.",
+		func(arena *datavalues.Arena, s string) datavalues.IDataValue {
+			return makeString(arena, datavalues.UpperUTF8(s))
+		}, args...)
+}
+
+func LENGTH(args ...interface{}) IExpression {
+	return stringFunction("LENGTH", "Returns the length of the string in bytes.",
+		func(arena *datavalues.Arena, s string) datavalues.IDataValue {
+			return makeInt(arena, int64(len(s)))
+		}, args...)
+}
+
+func LENGTHUTF8(args ...interface{}) IExpression {
+	return stringFunction("LENGTHUTF8", "Returns the length of the string in Unicode code points, the string is UTF-8.",
+		func(arena *datavalues.Arena, s string) datavalues.IDataValue {
+			return makeInt(arena, int64(datavalues.LengthUTF8(s)))
+		}, args...)
+}
+
+func SUBSTRING(args ...interface{}) IExpression {
+	return substring("SUBSTRING", args...)
+}
+
+// SUBSTR is the SUBSTRING, the parser names the both SUBSTR.
+func SUBSTR(args ...interface{}) IExpression {
+	return substring("SUBSTR", args...)
+}
+
+func substring(name string, args ...interface{}) IExpression {
+	exprs := expressionsFor(args...)
+	update := func(arena *datavalues.Arena, args ...datavalues.IDataValue) (datavalues.IDataValue, error) {
+		for _, arg := range args {
+			if datavalues.IsNull(arg) {
+				return datavalues.MakeNull(), nil
+			}
+		}
+		s := datavalues.AsString(args[0])
+		length := int64(len(s))
+		if len(args) > 2 {
+			length = datavalues.AsInt(args[2])
+		}
+		return makeString(arena, datavalues.Substring(s, datavalues.AsInt(args[1]), length)), nil
+	}
+	return &ScalarExpression{
+		name: name,
+		argumentNames: [][]string{
+			{"s", "offset"},
+			{"s", "offset", "length"},
+		},
+		description: docs.Text("Returns the substring of the length bytes from the offset, to the end if no length. " +
+			"The offset is 1-based, the negative one counts from the end. It's empty if out of the string."),
+		validate: All(
+			AtLeastNArgs(2),
+			AtMostNArgs(3),
+			Arg(0, NullOr(TypeOf(datavalues.ZeroString()))),
+			Arg(1, NullOr(FamilyOf(datavalues.FamilyInt))),
+			IfArgPresent(2, Arg(2, NullOr(FamilyOf(datavalues.FamilyInt)))),
+		),
+		exprs: exprs,
+		updateFn: func(args ...datavalues.IDataValue) (datavalues.IDataValue, error) {
+			return update(nil, args...)
+		},
+		arenaFn: update,
+	}
+}
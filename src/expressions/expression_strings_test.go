@@ -0,0 +1,65 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package expressions
+
+import (
+	"testing"
+
+	"datavalues"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStringExpressions(t *testing.T) {
+	tests := []struct {
+		name      string
+		expr      IExpression
+		expect    datavalues.IDataValue
+		errstring string
+	}{
+		{name: "lower", expr: LOWER("s"), expect: datavalues.MakeString("héllo wörld")},
+		{name: "upper", expr: UPPER("s"), expect: datavalues.MakeString("HéLLO WöRLD")},
+		{name: "lowerUTF8", expr: LOWERUTF8("s"), expect: datavalues.MakeString("héllo wörld")},
+		{name: "upperUTF8", expr: UPPERUTF8("s"), expect: datavalues.MakeString("HÉLLO WÖRLD")},
+		{name: "length", expr: LENGTH("s"), expect: datavalues.MakeInt(13)},
+		{name: "lengthUTF8", expr: LENGTHUTF8("s"), expect: datavalues.MakeInt(11)},
+		{name: "substring", expr: SUBSTRING("s", datavalues.MakeInt32(1), datavalues.MakeInt32(3)), expect: datavalues.MakeString("Hé")},
+		{name: "substring-negative", expr: SUBSTRING("s", datavalues.MakeInt32(-5)), expect: datavalues.MakeString("öRLD")},
+		{name: "substring-out", expr: SUBSTR("s", datavalues.MakeInt(20), datavalues.MakeInt(2)), expect: datavalues.MakeString("")},
+		{name: "substring-column", expr: SUBSTRING("s", "i"), expect: datavalues.MakeString("RLD")},
+		{name: "lower-null", expr: LOWER("n"), expect: datavalues.MakeNull()},
+		{name: "length-null", expr: LENGTH("n"), expect: datavalues.MakeNull()},
+		{name: "substring-null", expr: SUBSTRING("s", "n"), expect: datavalues.MakeNull()},
+		{name: "lower-int", expr: LOWER("i"), errstring: "bad argument at index 0: expected type &{String} but got &{Int32}"},
+		{name: "length-args", expr: LENGTH("s", "s"), errstring: "expected exactly 1 argument, but got 2"},
+		{name: "substring-args", expr: SUBSTRING("s"), errstring: "expected at least 2 arguments, but got 1"},
+		{name: "substring-offset", expr: SUBSTRING("s", "s"), errstring: "bad argument at index 1: expected family 1 but got &{String}"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			params := Map{
+				"s": datavalues.MakeString("HéLLO WöRLD"),
+				"i": datavalues.MakeInt32(-3),
+				"n": datavalues.MakeNull(),
+			}
+			actual, err := test.expr.Update(params)
+			if test.errstring != "" {
+				assert.NotNil(t, err)
+				assert.Equal(t, test.errstring, err.Error())
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, test.expect, actual)
+
+			// The arena makes the same values.
+			arena := datavalues.NewArena()
+			SetArena(test.expr, arena)
+			actual, err = test.expr.Update(params)
+			assert.Nil(t, err)
+			assert.Equal(t, test.expect, actual)
+		})
+	}
+}
@@ -6,9 +6,14 @@ package expressions
 
 import (
 	"fmt"
+	"math"
+	"math/rand"
+	"time"
 
 	"base/docs"
 	"base/errors"
+	"columns"
+	"datatypes"
 	"datavalues"
 
 	"github.com/valyala/fastrand"
@@ -100,6 +105,62 @@ func RANDTABLE(args ...interface{}) IExpression {
 	}
 }
 
+func GENERATERANDOM(args ...interface{}) IExpression {
+	exprs := expressionsFor(args...)
+	return &ScalarExpression{
+		name: "GENERATERANDOM",
+		argumentNames: [][]string{
+			{"begin", "end", "structure", "seed", "max_string_length"},
+		},
+		description: docs.Text("Returns a list of random tuples matching the structure, the rows are deterministic for the seed and begin."),
+		validate: All(
+			ExactlyNArgs(5),
+			Arg(2, TypeOf(datavalues.ZeroString())),
+		),
+		exprs: exprs,
+		updateFn: func(args ...datavalues.IDataValue) (datavalues.IDataValue, error) {
+			begin := datavalues.AsInt(args[0])
+			end := datavalues.AsInt(args[1])
+			cols, err := columns.ParseStructure(datavalues.AsString(args[2]))
+			if err != nil {
+				return nil, err
+			}
+			seed := uint64(datavalues.AsInt(args[3]))
+			maxStringLength := int(datavalues.AsInt(args[4]))
+
+			rng := rand.New(rand.NewSource(int64(seed*0x9E3779B97F4A7C15 ^ uint64(begin))))
+			values := make([]datavalues.IDataValue, end-begin)
+			for i := range values {
+				row := make([]datavalues.IDataValue, len(cols))
+				for j, col := range cols {
+					switch col.DataType.Name() {
+					case datatypes.DataTypeInt32Name:
+						row[j] = datavalues.MakeInt32(int32(rng.Uint32()))
+					case datatypes.DataTypeUInt32Name:
+						row[j] = datavalues.ToValue(rng.Uint32())
+					case datatypes.DataTypeInt64Name, datatypes.DataTypeUInt64Name:
+						row[j] = datavalues.MakeInt(int64(rng.Uint64()))
+					case datatypes.DataTypeFloat64Name:
+						row[j] = datavalues.MakeFloat((rng.Float64()*2 - 1) * math.MaxInt32)
+					case datatypes.DataTypeStringName:
+						str := make([]byte, rng.Intn(maxStringLength+1))
+						for k := range str {
+							str[k] = byte(' ' + rng.Intn('~'-' '+1))
+						}
+						row[j] = datavalues.MakeString(string(str))
+					case datatypes.DataTypeDateTimeName:
+						row[j] = datavalues.MakeTime(time.Unix(int64(rng.Uint32()), 0).UTC())
+					default:
+						return nil, errors.Errorf("Unsupported type:%v", col.DataType.Name())
+					}
+				}
+				values[i] = datavalues.MakeTuple(row...)
+			}
+			return datavalues.MakeTuple(values...), nil
+		},
+	}
+}
+
 func ZIP(args ...interface{}) IExpression {
 	exprs := expressionsFor(args...)
 	return &ScalarExpression{
@@ -91,3 +91,30 @@ func TestTVFExpression(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateRandomExpression(t *testing.T) {
+	eval := func(begin int, seed int) datavalues.IDataValue {
+		expr := GENERATERANDOM(begin, begin+5, CONST("a Int32, b UInt64, c Float64, d String, e DateTime"), seed, 8)
+		err := expr.Eval()
+		assert.Nil(t, err)
+		return expr.Result()
+	}
+
+	first := eval(0, 1)
+	assert.Equal(t, 5, len(datavalues.AsSlice(first)))
+	assert.Equal(t, first, eval(0, 1))
+	assert.NotEqual(t, first, eval(0, 2))
+	assert.NotEqual(t, first, eval(5, 1))
+	for _, row := range datavalues.AsSlice(first) {
+		fields := datavalues.AsSlice(row)
+		assert.Equal(t, datavalues.TypeInt32, fields[0].Type())
+		assert.Equal(t, datavalues.TypeInt, fields[1].Type())
+		assert.Equal(t, datavalues.TypeFloat, fields[2].Type())
+		assert.True(t, len(datavalues.AsString(fields[3])) <= 8)
+		assert.Equal(t, datavalues.TypeTime, fields[4].Type())
+	}
+
+	expr := GENERATERANDOM(0, 1, CONST("a Int8"), 1, 8)
+	err := expr.Eval()
+	assert.NotNil(t, err)
+}
@@ -143,6 +143,45 @@ func (v *typeOf) Document() docs.Documentation {
 	return docs.Paragraph(docs.Text("must be of type"), v.wantedType.Document())
 }
 
+type familyOf struct {
+	family datavalues.Family
+}
+
+func FamilyOf(family datavalues.Family) *familyOf {
+	return &familyOf{family: family}
+}
+
+func (v *familyOf) Validate(arg datavalues.IDataValue) error {
+	if arg.Family() != v.family {
+		return errors.Errorf("expected family %v but got %v", v.family, arg.Document())
+	}
+	return nil
+}
+
+func (v *familyOf) Document() docs.Documentation {
+	return docs.Text(fmt.Sprintf("must be of family %v", v.family))
+}
+
+// nullOr passes the NULL, the functions of it return NULL.
+type nullOr struct {
+	validator ISingleArgumentValidator
+}
+
+func NullOr(validator ISingleArgumentValidator) *nullOr {
+	return &nullOr{validator: validator}
+}
+
+func (v *nullOr) Validate(arg datavalues.IDataValue) error {
+	if datavalues.IsNull(arg) {
+		return nil
+	}
+	return v.validator.Validate(arg)
+}
+
+func (v *nullOr) Document() docs.Documentation {
+	return docs.Paragraph(docs.Text("may be NULL, or"), v.validator.Document())
+}
+
 type ifArgPresent struct {
 	i         int
 	validator IValidator
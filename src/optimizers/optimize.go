@@ -1,11 +1,24 @@
 package optimizers
 
 import (
+	"config"
+
 	. "planners"
 )
 
-func Optimize(plan IPlan, optimizers []Optimizer) IPlan {
+// Optimize runs the optimizers the config enables on the plan, the config is of the session and the protocol settings.
+// The SETTINGS of the SELECT win over them, as they do for the executors.
+func Optimize(conf *config.Config, plan IPlan, optimizers []Optimizer) IPlan {
+	if sel, ok := plan.(*SelectPlan); ok && len(sel.Settings) > 0 {
+		// The invalid ones fail the query in the executor.
+		if c, _, err := conf.WithSettings(sel.Settings); err == nil {
+			conf = c
+		}
+	}
 	for _, opt := range optimizers {
+		if opt.Enabled != nil && !opt.Enabled(conf) {
+			continue
+		}
 		opt.Reassembler(plan)
 	}
 	return plan
@@ -0,0 +1,75 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package optimizers
+
+import (
+	"sort"
+
+	"planners"
+)
+
+var ColumnPruningOptimizer = Optimizer{
+	Name:        "ColumnPruningOptimizer",
+	Description: "Read only the columns referenced by the plan",
+	Reassembler: func(plan planners.IPlan) {
+		visit := func(plan planners.IPlan) (kontinue bool, err error) {
+			switch plan := plan.(type) {
+			case *planners.MapPlan:
+				if len(plan.SubPlans) == 0 {
+					break
+				}
+				scan, ok := plan.SubPlans[0].(*planners.ScanPlan)
+				if !ok {
+					break
+				}
+				if columns, ok := referencedColumns(plan); ok {
+					scan.Columns = columns
+				}
+				return false, nil
+			}
+			return true, nil
+		}
+		if err := planners.Walk(visit, plan); err != nil {
+			return
+		}
+	},
+}
+
+// referencedColumns takes the names of the variables in the plans of the select and the filter pushed down,
+// sorted. The names of the aliases are in, they are not the columns of the table and the scan skips them.
+// It's false for the SELECT *, which takes all the columns.
+func referencedColumns(plan *planners.MapPlan) ([]string, bool) {
+	all := false
+	names := make(map[string]struct{})
+	var visit planners.Visit
+	visit = func(plan planners.IPlan) (bool, error) {
+		switch plan := plan.(type) {
+		case *planners.ScanPlan:
+			// The filter pushed down is not walked by the scan.
+			if plan.Filter != nil {
+				if err := planners.Walk(visit, plan.Filter); err != nil {
+					return false, err
+				}
+			}
+		case *planners.SelectionPlan:
+			if plan.Projects == nil || plan.Projects.Length() == 0 {
+				all = true
+			}
+		case *planners.VariablePlan:
+			names[plan.Value] = struct{}{}
+		}
+		return !all, nil
+	}
+	if err := planners.Walk(visit, plan.SubPlans...); err != nil || all {
+		return nil, false
+	}
+
+	columns := make([]string, 0, len(names))
+	for name := range names {
+		columns = append(columns, name)
+	}
+	sort.Strings(columns)
+	return columns, true
+}
@@ -0,0 +1,65 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package optimizers
+
+import (
+	"testing"
+
+	"config"
+	"planners"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOptimizeColumnPruning(t *testing.T) {
+	tests := []struct {
+		name   string
+		query  string
+		expect []string
+	}{
+		{
+			name:   "where",
+			query:  "SELECT a FROM t WHERE b > 0",
+			expect: []string{"a", "b"},
+		},
+		{
+			name:   "aggregate",
+			query:  "SELECT count(a), c FROM t GROUP BY c ORDER BY c",
+			expect: []string{"a", "c"},
+		},
+		{
+			name:   "none",
+			query:  "SELECT count(1) FROM t",
+			expect: []string{},
+		},
+		{
+			name:   "all",
+			query:  "SELECT * FROM t WHERE b > 0",
+			expect: nil,
+		},
+		{
+			name:   "pushed-kept",
+			query:  "SELECT a FROM t WHERE b > 0 AND rand() > c",
+			expect: []string{"a", "b", "c"},
+		},
+	}
+
+	for _, test := range tests {
+		plan, err := planners.PlanFactory(test.query)
+		assert.Nil(t, err)
+		plan = Optimize(config.DefaultConfig(), plan, DefaultOptimizers)
+
+		var scan *planners.ScanPlan
+		err = planners.Walk(func(plan planners.IPlan) (bool, error) {
+			if x, ok := plan.(*planners.ScanPlan); ok {
+				scan = x
+			}
+			return true, nil
+		}, plan)
+		assert.Nil(t, err)
+		assert.NotNil(t, scan, test.name)
+		assert.Equal(t, test.expect, scan.Columns, test.name)
+	}
+}
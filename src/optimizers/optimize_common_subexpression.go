@@ -0,0 +1,211 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package optimizers
+
+import (
+	"sort"
+
+	"expressions"
+	"planners"
+)
+
+// CommonSubexpressionOptimizer computes the subexpressions repeated in a selection or in a filter once per row:
+// they are the commons of the plan, computed into the columns of their names, and the repeats are the variables of them.
+// The projects keep their names, the projection drops the columns of the commons.
+// The subexpressions of the non-deterministic functions and of the aggregates are not shared,
+// neither are those without a variable, which are for the constant folding.
+var CommonSubexpressionOptimizer = Optimizer{
+	Name:        "CommonSubexpressionOptimizer",
+	Description: "Compute the repeated subexpressions once",
+	Reassembler: func(plan planners.IPlan) {
+		visit := func(plan planners.IPlan) (kontinue bool, err error) {
+			switch plan := plan.(type) {
+			case *planners.ScanPlan:
+				// The filter pushed down is not walked by the scan.
+				if plan.Filter != nil {
+					eliminateFilter(plan.Filter)
+				}
+			case *planners.FilterPlan:
+				eliminateFilter(plan)
+				return false, nil
+			case *planners.SelectionPlan:
+				eliminateSelection(plan)
+				return false, nil
+			}
+			return true, nil
+		}
+		if err := planners.Walk(visit, plan); err != nil {
+			return
+		}
+	},
+}
+
+func eliminateFilter(plan *planners.FilterPlan) {
+	if plan.Commons != nil {
+		return
+	}
+	c := newCommons(plan.SubPlan)
+	if len(c.selected) == 0 {
+		return
+	}
+	plan.SubPlan = c.rewrite(plan.SubPlan)
+	plan.Commons = c.plans
+}
+
+func eliminateSelection(plan *planners.SelectionPlan) {
+	if plan.Commons != nil || plan.Projects == nil {
+		return
+	}
+	var plans []planners.IPlan
+	plans = append(plans, plan.Projects.SubPlans...)
+	if plan.GroupBys != nil {
+		plans = append(plans, plan.GroupBys.SubPlans...)
+	}
+	c := newCommons(plans...)
+	if len(c.selected) == 0 {
+		return
+	}
+
+	// The projects are shared with the projection, they are rewritten into the new ones.
+	projects := planners.NewMapPlan()
+	for _, project := range plan.Projects.SubPlans {
+		projects.Add(c.rewrite(project))
+	}
+	plan.Projects = projects
+	if plan.GroupBys != nil {
+		groupbys := planners.NewMapPlan()
+		for _, groupby := range plan.GroupBys.SubPlans {
+			groupbys.Add(c.rewrite(groupby))
+		}
+		plan.GroupBys = groupbys
+	}
+	plan.Commons = c.plans
+}
+
+// commons are the subexpressions of the plans repeated, by the JSON of their plans.
+type commons struct {
+	// selected are the names of the subexpressions shared, the names of their columns.
+	selected map[string]string
+	added    map[string]struct{}
+	// plans are the commons in the order they are computed, each after those it has the variables of.
+	plans *planners.MapPlan
+}
+
+func newCommons(plans ...planners.IPlan) *commons {
+	counts := make(map[string]int)
+	candidates := make(map[string]planners.IPlan)
+	for _, plan := range plans {
+		_ = planners.Walk(func(plan planners.IPlan) (bool, error) {
+			if isShareable(plan) {
+				key := plan.String()
+				counts[key]++
+				candidates[key] = plan
+			}
+			return true, nil
+		}, plan)
+	}
+
+	// The larger first, the repeats inside a shared one are computed once with it.
+	keys := make([]string, 0, len(candidates))
+	for key := range candidates {
+		if counts[key] > 1 {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if len(keys[i]) != len(keys[j]) {
+			return len(keys[i]) > len(keys[j])
+		}
+		return keys[i] < keys[j]
+	})
+
+	c := &commons{
+		selected: make(map[string]string),
+		added:    make(map[string]struct{}),
+		plans:    planners.NewMapPlan(),
+	}
+	names := make(map[string]struct{})
+	for _, key := range keys {
+		repeats := counts[key] - 1
+		if repeats < 1 {
+			continue
+		}
+		expr, err := planners.BuildExpression(candidates[key])
+		if err != nil {
+			continue
+		}
+		// The different plans of the same string, such as of a variable and of a constant string, are not shared.
+		name := expr.String()
+		if _, ok := names[name]; ok {
+			continue
+		}
+		names[name] = struct{}{}
+		c.selected[key] = name
+
+		_ = planners.Walk(func(plan planners.IPlan) (bool, error) {
+			if plan != candidates[key] && isShareable(plan) {
+				counts[plan.String()] -= repeats
+			}
+			return true, nil
+		}, candidates[key])
+	}
+	return c
+}
+
+// rewrite returns the plan with the commons as the variables, it adds the commons to the plans as they are met.
+// The plans are copied, the ones given are not changed.
+func (c *commons) rewrite(plan planners.IPlan) planners.IPlan {
+	key := plan.String()
+	if name, ok := c.selected[key]; ok {
+		if _, ok := c.added[key]; !ok {
+			c.added[key] = struct{}{}
+			c.plans.Add(planners.NewAliasedExpressionPlan(name, c.rewriteArgs(plan)))
+		}
+		return planners.NewVariablePlan(name)
+	}
+	return c.rewriteArgs(plan)
+}
+
+func (c *commons) rewriteArgs(plan planners.IPlan) planners.IPlan {
+	switch plan := plan.(type) {
+	case *planners.AliasedExpressionPlan:
+		return planners.NewAliasedExpressionPlan(plan.As, c.rewrite(plan.Expr))
+	case *planners.UnaryExpressionPlan:
+		return planners.NewUnaryExpressionPlan(plan.FuncName, c.rewrite(plan.Expr))
+	case *planners.BinaryExpressionPlan:
+		return planners.NewBinaryExpressionPlan(plan.FuncName, c.rewrite(plan.Left), c.rewrite(plan.Right))
+	case *planners.FunctionExpressionPlan:
+		args := make([]planners.IPlan, len(plan.Args))
+		for i, arg := range plan.Args {
+			args[i] = c.rewrite(arg)
+		}
+		return planners.NewFunctionExpressionPlan(plan.FuncName, args...)
+	}
+	return plan
+}
+
+// isShareable checks if the plan is an expression which may be computed once for its repeats:
+// deterministic, without an aggregate and with a variable.
+func isShareable(plan planners.IPlan) bool {
+	switch plan.(type) {
+	case *planners.UnaryExpressionPlan, *planners.BinaryExpressionPlan, *planners.FunctionExpressionPlan:
+	default:
+		return false
+	}
+	if hasAggregate, err := planners.CheckAggregateExpressions(plan); err != nil || hasAggregate {
+		return false
+	}
+	deterministic, variables := true, false
+	_ = planners.Walk(func(plan planners.IPlan) (bool, error) {
+		switch plan := plan.(type) {
+		case *planners.FunctionExpressionPlan:
+			deterministic = deterministic && expressions.IsDeterministic(plan.FuncName)
+		case *planners.VariablePlan:
+			variables = true
+		}
+		return true, nil
+	}, plan)
+	return deterministic && variables
+}
@@ -0,0 +1,123 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package optimizers
+
+import (
+	"testing"
+
+	"config"
+	"planners"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOptimizeCommonSubexpression(t *testing.T) {
+	tests := []struct {
+		name      string
+		query     string
+		selection []string
+		filter    []string
+	}{
+		{
+			name:      "projects",
+			query:     "SELECT (a+1)*2, (a+1)*3 FROM t",
+			selection: []string{"(a+1)"},
+		},
+		{
+			name:      "nested",
+			query:     "SELECT ((a+1)*2)+b, ((a+1)*2)-b, a+1 FROM t",
+			selection: []string{"(a+1)", "((a+1)*2)"},
+		},
+		{
+			// The repeats inside the shared one are computed once with it.
+			name:      "inside",
+			query:     "SELECT ((a+1)*2)+b, ((a+1)*2)-b FROM t",
+			selection: []string{"((a+1)*2)"},
+		},
+		{
+			name:      "groupby",
+			query:     "SELECT (a+1)*2 AS x, count(a) FROM t GROUP BY a+1",
+			selection: []string{"(a+1)"},
+		},
+		{
+			name:      "aggregate-args",
+			query:     "SELECT sum(a*b), max(a*b) FROM t",
+			selection: []string{"(a*b)"},
+		},
+		{
+			name:  "aggregate",
+			query: "SELECT sum(a)+1, sum(a)+2 FROM t",
+		},
+		{
+			name:  "non-deterministic",
+			query: "SELECT dictGet('d', 'x', a)+1, dictGet('d', 'x', a)+2 FROM t",
+		},
+		{
+			name:  "constant",
+			query: "SELECT a+(1+2), b+(1+2) FROM t",
+		},
+		{
+			name:  "no-repeat",
+			query: "SELECT a+1, b+1 FROM t",
+		},
+		{
+			name:   "where",
+			query:  "SELECT a FROM t WHERE (a+1) > 2 AND (a+1) < 5",
+			filter: []string{"(a+1)"},
+		},
+		{
+			name:   "where-kept",
+			query:  "SELECT a FROM t WHERE dictHas('d', a+1) AND dictHas('e', a+1)",
+			filter: []string{"(a+1)"},
+		},
+	}
+
+	for _, test := range tests {
+		plan, err := planners.PlanFactory(test.query)
+		assert.Nil(t, err)
+		plan = Optimize(config.DefaultConfig(), plan, DefaultOptimizers)
+
+		var selection []string
+		var filter []string
+		var projects, projections []string
+		names := func(plan *planners.MapPlan) []string {
+			if plan == nil {
+				return nil
+			}
+			exprs, err := planners.BuildExpressions(plan)
+			assert.Nil(t, err, test.name)
+			var res []string
+			for _, expr := range exprs {
+				res = append(res, expr.String())
+			}
+			return res
+		}
+		var visit planners.Visit
+		visit = func(plan planners.IPlan) (bool, error) {
+			switch plan := plan.(type) {
+			case *planners.ScanPlan:
+				if plan.Filter != nil {
+					if err := planners.Walk(visit, plan.Filter); err != nil {
+						return false, err
+					}
+				}
+			case *planners.FilterPlan:
+				filter = append(filter, names(plan.Commons)...)
+			case *planners.SelectionPlan:
+				selection = append(selection, names(plan.Commons)...)
+				projects = names(plan.Projects)
+			case *planners.ProjectionPlan:
+				projections = names(plan.Projections)
+			}
+			return true, nil
+		}
+		err = planners.Walk(visit, plan)
+		assert.Nil(t, err)
+		assert.Equal(t, test.selection, selection, test.name)
+		assert.Equal(t, test.filter, filter, test.name)
+		// The projects keep their names for the projection.
+		assert.Equal(t, projections, projects, test.name)
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package optimizers
+
+import (
+	"sort"
+	"strings"
+
+	"config"
+	"planners"
+)
+
+// ReorderConjunctsSetting is the query setting to keep the conjuncts as written, SETTINGS optimize_reorder_conjuncts = 0,
+// also of the SET of the session and the settings of the protocols.
+const ReorderConjunctsSetting = "optimize_reorder_conjuncts"
+
+// ConjunctOrderOptimizer orders the conjuncts of the filters by their estimated cost, the cheap and selective ones first:
+// the comparisons of the columns and the constants, the equalities before the ranges,
+// then the function calls, then the LIKE and the regular expressions.
+// The filter evaluates each conjunct only for the rows the ones before keep, the expensive ones see fewer rows.
+// The conjuncts of the same cost keep their order.
+var ConjunctOrderOptimizer = Optimizer{
+	Name:        "ConjunctOrderOptimizer",
+	Description: "Order the conjuncts of the filters by their cost",
+	Enabled: func(conf *config.Config) bool {
+		return conf.Runtime.OptimizeReorderConjuncts != 0
+	},
+	Reassembler: func(plan planners.IPlan) {
+		visit := func(plan planners.IPlan) (kontinue bool, err error) {
+			switch plan := plan.(type) {
+			case *planners.SelectPlan:
+				// The subqueries have their own SETTINGS.
+				if plan.Settings[ReorderConjunctsSetting] == "0" {
+					return false, nil
+				}
+			case *planners.ScanPlan:
+				// The filter pushed down is not walked by the scan.
+				if plan.Filter != nil {
+					reorderConjuncts(plan.Filter)
+				}
+			case *planners.FilterPlan:
+				reorderConjuncts(plan)
+				return false, nil
+			}
+			return true, nil
+		}
+		if err := planners.Walk(visit, plan); err != nil {
+			return
+		}
+	},
+}
+
+func reorderConjuncts(plan *planners.FilterPlan) {
+	conjuncts := planners.Conjuncts(plan.SubPlan)
+	costs := make([]int, len(conjuncts))
+	for i, conjunct := range conjuncts {
+		costs[i] = conjunctCost(conjunct)
+	}
+	if sort.IntsAreSorted(costs) {
+		return
+	}
+
+	order := make([]int, len(conjuncts))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return costs[order[i]] < costs[order[j]]
+	})
+	sorted := make([]planners.IPlan, len(conjuncts))
+	for i, k := range order {
+		sorted[i] = conjuncts[k]
+	}
+	plan.SubPlan = planners.NewConjunction(sorted...)
+}
+
+// The costs of the operations, the conjunct is of the sum of its operations.
+const (
+	equalityCost   = 1
+	arithmeticCost = 1
+	rangeCost      = 2
+	inequalityCost = 3
+	functionCost   = 10
+	patternCost    = 50
+)
+
+// patternFunctions are the matches of the patterns, as costly as the regular expressions.
+var patternFunctions = map[string]struct{}{
+	"LIKE":      {},
+	"NOT LIKE":  {},
+	"NOTLIKE":   {},
+	"ILIKE":     {},
+	"NOT ILIKE": {},
+	"NOTILIKE":  {},
+	"MATCH":     {},
+}
+
+func conjunctCost(plan planners.IPlan) int {
+	switch plan := plan.(type) {
+	case *planners.VariablePlan, *planners.ConstantPlan:
+		return 0
+	case *planners.AliasedExpressionPlan:
+		return conjunctCost(plan.Expr)
+	case *planners.BinaryExpressionPlan:
+		cost := conjunctCost(plan.Left) + conjunctCost(plan.Right)
+		name := strings.ToUpper(plan.FuncName)
+		if _, ok := patternFunctions[name]; ok {
+			return cost + patternCost
+		}
+		switch name {
+		case "AND", "OR":
+			return cost
+		case "=":
+			return cost + equalityCost
+		case "+", "-", "*", "/":
+			return cost + arithmeticCost
+		case "<", "<=", ">", ">=":
+			return cost + rangeCost
+		case "<>", "!=":
+			return cost + inequalityCost
+		}
+		return cost + functionCost
+	case *planners.UnaryExpressionPlan:
+		return conjunctCost(plan.Expr) + functionCost
+	case *planners.FunctionExpressionPlan:
+		cost := functionCost
+		if _, ok := patternFunctions[strings.ToUpper(plan.FuncName)]; ok {
+			cost = patternCost
+		}
+		for _, arg := range plan.Args {
+			cost += conjunctCost(arg)
+		}
+		return cost
+	}
+	return functionCost
+}
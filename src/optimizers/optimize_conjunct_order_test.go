@@ -0,0 +1,118 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package optimizers
+
+import (
+	"testing"
+
+	"config"
+	"planners"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOptimizeConjunctOrder(t *testing.T) {
+	tests := []struct {
+		name   string
+		query  string
+		expect []string
+	}{
+		{
+			name:   "like-last",
+			query:  "SELECT a FROM t WHERE url LIKE '%x%' AND status = 404",
+			expect: []string{"(status=404)", "(urlLIKE%x%)"},
+		},
+		{
+			name:   "function-before-like",
+			query:  "SELECT a FROM t WHERE url LIKE '%x%' AND dictHas('d', a) AND b > 1",
+			expect: []string{"(b>1)", "DICTHAS([d a])", "(urlLIKE%x%)"},
+		},
+		{
+			name:   "equality-before-range",
+			query:  "SELECT a FROM t WHERE a <> 1 AND b < 2 AND c = 3",
+			expect: []string{"(c=3)", "(b<2)", "(a!=1)"},
+		},
+		{
+			name:   "arithmetic",
+			query:  "SELECT a FROM t WHERE a + b * 2 = 3 AND c > 1",
+			expect: []string{"(c>1)", "((a+(b*2))=3)"},
+		},
+		{
+			name:   "same-cost-kept",
+			query:  "SELECT a FROM t WHERE b = 1 AND a = 2",
+			expect: []string{"(b=1)", "(a=2)"},
+		},
+		{
+			name:   "or",
+			query:  "SELECT a FROM t WHERE (a = 1 OR url LIKE '%x%') AND b = 2",
+			expect: []string{"(b=2)", "((a=1)OR(urlLIKE%x%))"},
+		},
+		{
+			name:   "disabled",
+			query:  "SELECT a FROM t WHERE url LIKE '%x%' AND status = 404 SETTINGS optimize_reorder_conjuncts = 0",
+			expect: []string{"(urlLIKE%x%)", "(status=404)"},
+		},
+	}
+
+	for _, test := range tests {
+		plan, err := planners.PlanFactory(test.query)
+		assert.Nil(t, err, test.name)
+		plan = Optimize(config.DefaultConfig(), plan, []Optimizer{ConjunctOrderOptimizer})
+
+		var actual []string
+		err = planners.Walk(func(plan planners.IPlan) (bool, error) {
+			if filter, ok := plan.(*planners.FilterPlan); ok {
+				for _, conjunct := range planners.Conjuncts(filter.SubPlan) {
+					expr, err := planners.BuildExpression(conjunct)
+					assert.Nil(t, err)
+					actual = append(actual, expr.String())
+				}
+			}
+			return true, nil
+		}, plan)
+		assert.Nil(t, err)
+		assert.Equal(t, test.expect, actual, test.name)
+	}
+
+	// The filter pushed down to the scan is ordered too.
+	plan, err := planners.PlanFactory("SELECT a FROM t WHERE url LIKE '%x%' AND status = 404")
+	assert.Nil(t, err)
+	plan = Optimize(config.DefaultConfig(), plan, DefaultOptimizers)
+	var scan *planners.ScanPlan
+	err = planners.Walk(func(plan planners.IPlan) (bool, error) {
+		if plan, ok := plan.(*planners.ScanPlan); ok {
+			scan = plan
+		}
+		return true, nil
+	}, plan)
+	assert.Nil(t, err)
+	conjuncts := planners.Conjuncts(scan.Filter.SubPlan)
+	assert.Equal(t, 2, len(conjuncts))
+	assert.Equal(t, "=", conjuncts[0].(*planners.BinaryExpressionPlan).FuncName)
+
+	// Off by the config of the session settings, the SETTINGS of the query win over it.
+	conf, _, err := config.DefaultConfig().WithSettings(map[string]string{ReorderConjunctsSetting: "0"})
+	assert.Nil(t, err)
+	for _, test := range []struct {
+		query  string
+		expect string
+	}{
+		{query: "SELECT a FROM t WHERE url LIKE '%x%' AND status = 404", expect: "like"},
+		{query: "SELECT a FROM t WHERE url LIKE '%x%' AND status = 404 SETTINGS optimize_reorder_conjuncts = 1", expect: "="},
+	} {
+		plan, err := planners.PlanFactory(test.query)
+		assert.Nil(t, err)
+		plan = Optimize(conf, plan, []Optimizer{ConjunctOrderOptimizer})
+		var filter *planners.FilterPlan
+		err = planners.Walk(func(plan planners.IPlan) (bool, error) {
+			if plan, ok := plan.(*planners.FilterPlan); ok {
+				filter = plan
+			}
+			return true, nil
+		}, plan)
+		assert.Nil(t, err)
+		assert.Equal(t, test.expect, planners.Conjuncts(filter.SubPlan)[0].(*planners.BinaryExpressionPlan).FuncName, test.query)
+	}
+}
@@ -0,0 +1,180 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package optimizers
+
+import (
+	"math"
+
+	"datavalues"
+	"expressions"
+	"planners"
+)
+
+// ConstantFoldingOptimizer evaluates the constant subtrees of the filters once at the plan time,
+// the filter always true is removed, the one always false is the constant false which the scan reads nothing for.
+// The non-deterministic functions and the aggregates are not folded, the subtrees failing to evaluate
+// are left as they are for the error at the runtime.
+var ConstantFoldingOptimizer = Optimizer{
+	Name:        "ConstantFoldingOptimizer",
+	Description: "Fold the constant expressions of the filters",
+	Reassembler: func(plan planners.IPlan) {
+		visit := func(plan planners.IPlan) (kontinue bool, err error) {
+			switch plan := plan.(type) {
+			case *planners.MapPlan:
+				for i := 0; i < len(plan.SubPlans); i++ {
+					filter, ok := plan.SubPlans[i].(*planners.FilterPlan)
+					if !ok {
+						continue
+					}
+					filter.SubPlan, _ = fold(filter.SubPlan)
+					if isConstantBool(filter.SubPlan, true) {
+						plan.SubPlans = append(plan.SubPlans[:i], plan.SubPlans[i+1:]...)
+						i--
+					}
+				}
+			}
+			return true, nil
+		}
+		if err := planners.Walk(visit, plan); err != nil {
+			return
+		}
+	},
+}
+
+// predicates are the functions of the bool results, the AND and the OR are simplified only over them.
+var predicates = map[string]struct{}{
+	"=":        {},
+	"<>":       {},
+	"!=":       {},
+	"<":        {},
+	"<=":       {},
+	">":        {},
+	">=":       {},
+	"AND":      {},
+	"OR":       {},
+	"LIKE":     {},
+	"NOT LIKE": {},
+}
+
+// fold returns the plan with its constant subtrees evaluated,
+// and whether a subtree of it failed to evaluate, such a subtree is never dropped by the simplifications.
+func fold(plan planners.IPlan) (planners.IPlan, bool) {
+	switch plan := plan.(type) {
+	case *planners.BinaryExpressionPlan:
+		left, leftFailed := fold(plan.Left)
+		right, rightFailed := fold(plan.Right)
+		plan.Left, plan.Right = left, right
+		if !leftFailed && !rightFailed {
+			if simplified, ok := simplify(plan); ok {
+				return simplified, false
+			}
+		}
+		if isConstant(left) && isConstant(right) {
+			return evaluate(plan)
+		}
+		return plan, leftFailed || rightFailed
+	case *planners.FunctionExpressionPlan:
+		if !expressions.IsDeterministic(plan.FuncName) {
+			return plan, false
+		}
+		failed, constant := false, true
+		for i, arg := range plan.Args {
+			var argFailed bool
+			plan.Args[i], argFailed = fold(arg)
+			failed = failed || argFailed
+			constant = constant && isConstant(plan.Args[i])
+		}
+		if constant {
+			return evaluate(plan)
+		}
+		return plan, failed
+	case *planners.AliasedExpressionPlan:
+		var failed bool
+		plan.Expr, failed = fold(plan.Expr)
+		return plan, failed
+	}
+	// The variables, the constants and the aggregates, whose arguments are by the rows.
+	return plan, false
+}
+
+// simplify takes the AND and the OR of the constant bool with a predicate as the predicate or the constant.
+func simplify(plan *planners.BinaryExpressionPlan) (planners.IPlan, bool) {
+	var absorbing bool
+	switch plan.FuncName {
+	case "AND":
+		absorbing = false
+	case "OR":
+		absorbing = true
+	default:
+		return nil, false
+	}
+
+	for _, side := range [][2]planners.IPlan{{plan.Left, plan.Right}, {plan.Right, plan.Left}} {
+		constant, other := side[0], side[1]
+		if !isPredicate(other) && !isConstantBool(other, true) && !isConstantBool(other, false) {
+			continue
+		}
+		switch {
+		case isConstantBool(constant, absorbing):
+			return planners.NewConstantPlan(absorbing), true
+		case isConstantBool(constant, !absorbing):
+			return other, true
+		}
+	}
+	return nil, false
+}
+
+// evaluate returns the constant of the plan result, or the plan if it fails
+// or its result is not a constant of the plans, such as the NaN.
+func evaluate(plan planners.IPlan) (planners.IPlan, bool) {
+	expr, err := planners.BuildExpression(plan)
+	if err != nil {
+		return plan, true
+	}
+	v, err := expr.Update(expressions.Map{})
+	if err != nil {
+		return plan, true
+	}
+
+	switch v.Type() {
+	case datavalues.TypeBool:
+		return planners.NewConstantPlan(datavalues.AsBool(v)), false
+	case datavalues.TypeInt32:
+		return planners.NewConstantPlan(int(datavalues.AsInt(v))), false
+	case datavalues.TypeInt:
+		return planners.NewConstantPlan(datavalues.AsInt(v)), false
+	case datavalues.TypeFloat:
+		// The division by zero is for the runtime.
+		if f := datavalues.AsFloat(v); !math.IsNaN(f) && !math.IsInf(f, 0) {
+			return planners.NewConstantPlan(f), false
+		}
+	case datavalues.TypeString:
+		return planners.NewConstantPlan(datavalues.AsString(v)), false
+	}
+	return plan, false
+}
+
+func isConstant(plan planners.IPlan) bool {
+	_, ok := plan.(*planners.ConstantPlan)
+	return ok
+}
+
+func isConstantBool(plan planners.IPlan, value bool) bool {
+	constant, ok := plan.(*planners.ConstantPlan)
+	if !ok {
+		return false
+	}
+	b, ok := constant.Value.(bool)
+	return ok && b == value
+}
+
+func isPredicate(plan planners.IPlan) bool {
+	binary, ok := plan.(*planners.BinaryExpressionPlan)
+	if !ok {
+		return false
+	}
+	_, ok = predicates[binary.FuncName]
+	return ok
+}
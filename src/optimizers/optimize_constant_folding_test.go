@@ -0,0 +1,87 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package optimizers
+
+import (
+	"testing"
+
+	"config"
+	"planners"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOptimizeConstantFolding(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		filters []string
+	}{
+		{
+			name:    "fold",
+			query:   "SELECT a FROM t WHERE 1 = 1 AND a > 10/2",
+			filters: []string{"(a>5E+00)"},
+		},
+		{
+			name:  "true",
+			query: "SELECT a FROM t WHERE 1 = 1",
+		},
+		{
+			name:  "or-true",
+			query: "SELECT a FROM t WHERE a > 1 OR 2 > 1",
+		},
+		{
+			name:    "false",
+			query:   "SELECT a FROM t WHERE a > 1 AND 1 = 0",
+			filters: []string{"false"},
+		},
+		{
+			name:    "or-false",
+			query:   "SELECT a FROM t WHERE a > 1 OR 1 = 0",
+			filters: []string{"(a>1)"},
+		},
+		{
+			name:    "division-by-zero",
+			query:   "SELECT a FROM t WHERE a > 1/0",
+			filters: []string{"(a>(1/0))"},
+		},
+		{
+			// The error of the left is kept for the runtime.
+			name:    "error",
+			query:   "SELECT a FROM t WHERE 'x' + 1 > a AND 1 = 0",
+			filters: []string{"(((x+1)>a)ANDfalse)"},
+		},
+		{
+			name:    "non-deterministic",
+			query:   "SELECT a FROM t WHERE dictHas('d', 1) AND a > 1 + 1",
+			filters: []string{"(DICTHAS([d 1])AND(a>2))"},
+		},
+		{
+			name:    "having",
+			query:   "SELECT a, count(a) AS c FROM t GROUP BY a HAVING c > 1 + 1",
+			filters: []string{"(c>2)"},
+		},
+	}
+
+	for _, test := range tests {
+		plan, err := planners.PlanFactory(test.query)
+		assert.Nil(t, err)
+		plan = Optimize(config.DefaultConfig(), plan, []Optimizer{ConstantFoldingOptimizer})
+
+		var filters []string
+		err = planners.Walk(func(plan planners.IPlan) (bool, error) {
+			if filter, ok := plan.(*planners.FilterPlan); ok {
+				expr, err := planners.BuildExpression(filter.SubPlan)
+				if err != nil {
+					return false, err
+				}
+				filters = append(filters, expr.String())
+			}
+			return true, nil
+		}, plan)
+		assert.Nil(t, err, test.name)
+		assert.Equal(t, test.filters, filters, test.name)
+	}
+}
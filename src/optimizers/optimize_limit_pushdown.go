@@ -0,0 +1,59 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package optimizers
+
+import (
+	"planners"
+)
+
+// LimitPushDownOptimizer pushes the offset and the rowcount of the LIMIT to the scan under it
+// if the plans between keep the rows as they are, the normal selection and the projection:
+// the scan stops as it has sent them. The limit plan stays for the offset and the rows of the lanes.
+var LimitPushDownOptimizer = Optimizer{
+	Name:        "LimitPushDownOptimizer",
+	Description: "Push the limit to the scan plan",
+	Reassembler: func(plan planners.IPlan) {
+		visit := func(plan planners.IPlan) (kontinue bool, err error) {
+			switch plan := plan.(type) {
+			case *planners.MapPlan:
+				if len(plan.SubPlans) == 0 {
+					break
+				}
+				scan, ok := plan.SubPlans[0].(*planners.ScanPlan)
+				if !ok {
+					break
+				}
+			subPlans:
+				for _, subPlan := range plan.SubPlans[1:] {
+					switch subPlan := subPlan.(type) {
+					case *planners.SelectionPlan:
+						if subPlan.SelectionMode != planners.NormalSelection {
+							break subPlans
+						}
+					case *planners.ProjectionPlan:
+					case *planners.LimitPlan:
+						offset, ok := constantInt(subPlan.OffsetPlan)
+						if !ok {
+							break subPlans
+						}
+						rowcount, ok := constantInt(subPlan.RowcountPlan)
+						if !ok || rowcount <= 0 {
+							break subPlans
+						}
+						scan.Limit = offset + rowcount
+						break subPlans
+					default:
+						break subPlans
+					}
+				}
+				return false, nil
+			}
+			return true, nil
+		}
+		if err := planners.Walk(visit, plan); err != nil {
+			return
+		}
+	},
+}
@@ -0,0 +1,86 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package optimizers
+
+import (
+	"testing"
+
+	"config"
+	"planners"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOptimizeLimitPushDown(t *testing.T) {
+	tests := []struct {
+		name   string
+		query  string
+		expect int
+	}{
+		{
+			name:   "limit",
+			query:  "SELECT a, b FROM t LIMIT 10",
+			expect: 10,
+		},
+		{
+			name:   "offset",
+			query:  "SELECT a, b FROM t LIMIT 5, 10",
+			expect: 15,
+		},
+		{
+			name:   "expression",
+			query:  "SELECT a + 1 FROM t LIMIT 10",
+			expect: 10,
+		},
+		{
+			// The filter is pushed down to the scan first.
+			name:   "where-pushed",
+			query:  "SELECT a FROM t WHERE a > 1 LIMIT 10",
+			expect: 10,
+		},
+		{
+			name:   "where-kept",
+			query:  "SELECT a FROM t WHERE dictHas('d', a) LIMIT 10",
+			expect: 0,
+		},
+		{
+			name:   "orderby",
+			query:  "SELECT a FROM t ORDER BY a LIMIT 10",
+			expect: 0,
+		},
+		{
+			name:   "aggregate",
+			query:  "SELECT count(a) FROM t LIMIT 10",
+			expect: 0,
+		},
+		{
+			name:   "groupby",
+			query:  "SELECT a, count(a) FROM t GROUP BY a LIMIT 10",
+			expect: 0,
+		},
+		{
+			name:   "no-limit",
+			query:  "SELECT a FROM t",
+			expect: 0,
+		},
+	}
+
+	for _, test := range tests {
+		plan, err := planners.PlanFactory(test.query)
+		assert.Nil(t, err)
+		plan = Optimize(config.DefaultConfig(), plan, DefaultOptimizers)
+
+		var scan *planners.ScanPlan
+		err = planners.Walk(func(plan planners.IPlan) (bool, error) {
+			if x, ok := plan.(*planners.ScanPlan); ok {
+				scan = x
+			}
+			return true, nil
+		}, plan)
+		assert.Nil(t, err)
+		assert.NotNil(t, scan, test.name)
+		assert.Equal(t, test.expect, scan.Limit, test.name)
+	}
+}
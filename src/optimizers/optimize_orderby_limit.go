@@ -0,0 +1,53 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package optimizers
+
+import (
+	"planners"
+)
+
+var OrderByLimitOptimizer = Optimizer{
+	Name:        "OrderByLimitOptimizer",
+	Description: "Push the limit to the orderby plan followed by it",
+	Reassembler: func(plan planners.IPlan) {
+		visit := func(plan planners.IPlan) (kontinue bool, err error) {
+			switch plan := plan.(type) {
+			case *planners.MapPlan:
+				for i := 1; i < len(plan.SubPlans); i++ {
+					orderBy, ok := plan.SubPlans[i-1].(*planners.OrderByPlan)
+					if !ok {
+						continue
+					}
+					limit, ok := plan.SubPlans[i].(*planners.LimitPlan)
+					if !ok {
+						continue
+					}
+					offset, ok := constantInt(limit.OffsetPlan)
+					if !ok {
+						continue
+					}
+					rowcount, ok := constantInt(limit.RowcountPlan)
+					if !ok || rowcount <= 0 {
+						continue
+					}
+					orderBy.Limit = offset + rowcount
+				}
+			}
+			return true, nil
+		}
+		if err := planners.Walk(visit, plan); err != nil {
+			return
+		}
+	},
+}
+
+func constantInt(plan planners.IPlan) (int, bool) {
+	constant, ok := plan.(*planners.ConstantPlan)
+	if !ok {
+		return 0, false
+	}
+	v, ok := constant.Value.(int)
+	return v, ok && v >= 0
+}
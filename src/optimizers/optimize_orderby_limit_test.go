@@ -0,0 +1,60 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package optimizers
+
+import (
+	"testing"
+
+	"config"
+	"planners"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOptimizeOrderByLimit(t *testing.T) {
+	tests := []struct {
+		name   string
+		query  string
+		expect int
+	}{
+		{
+			name:   "limit",
+			query:  "SELECT number FROM system.numbers ORDER BY number DESC LIMIT 100",
+			expect: 100,
+		},
+		{
+			name:   "offset",
+			query:  "SELECT number FROM system.numbers ORDER BY number LIMIT 10, 5",
+			expect: 15,
+		},
+		{
+			name:   "no-limit",
+			query:  "SELECT number FROM system.numbers ORDER BY number",
+			expect: 0,
+		},
+		{
+			name:   "explain",
+			query:  "EXPLAIN PIPELINE SELECT number FROM system.numbers ORDER BY number LIMIT 3",
+			expect: 3,
+		},
+	}
+
+	for _, test := range tests {
+		plan, err := planners.PlanFactory(test.query)
+		assert.Nil(t, err)
+		plan = Optimize(config.DefaultConfig(), plan, DefaultOptimizers)
+
+		var orderBy *planners.OrderByPlan
+		err = planners.Walk(func(plan planners.IPlan) (bool, error) {
+			if x, ok := plan.(*planners.OrderByPlan); ok {
+				orderBy = x
+			}
+			return true, nil
+		}, plan)
+		assert.Nil(t, err)
+		assert.NotNil(t, orderBy, test.name)
+		assert.Equal(t, test.expect, orderBy.Limit, test.name)
+	}
+}
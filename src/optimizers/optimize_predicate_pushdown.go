@@ -5,31 +5,82 @@
 package optimizers
 
 import (
+	"expressions"
 	"planners"
 )
 
+// PredicatePushDownOptimizer moves the conjuncts of the WHERE right over the scan to the scan plan,
+// the storage skips the blocks by them and the scan filters the rows before sending the blocks.
+// The filters after the aggregation, such as the HAVING, are over the outputs of the selection and stay.
 var PredicatePushDownOptimizer = Optimizer{
 	Name:        "PredicatePushDownOptimizer",
 	Description: "Push predicates to scan plan",
 	Reassembler: func(plan planners.IPlan) {
-		var scan *planners.ScanPlan
-		var filter *planners.FilterPlan
-
 		visit := func(plan planners.IPlan) (kontinue bool, err error) {
 			switch plan := plan.(type) {
-			case *planners.ScanPlan:
-				scan = plan
-			case *planners.FilterPlan:
-				filter = plan
+			case *planners.MapPlan:
+				for i := 1; i < len(plan.SubPlans); i++ {
+					scan, ok := plan.SubPlans[i-1].(*planners.ScanPlan)
+					if !ok || scan.Filter != nil {
+						continue
+					}
+					filter, ok := plan.SubPlans[i].(*planners.FilterPlan)
+					if !ok {
+						continue
+					}
+
+					var pushed, kept []planners.IPlan
+					for _, conjunct := range planners.Conjuncts(filter.SubPlan) {
+						if pushable(conjunct) {
+							pushed = append(pushed, conjunct)
+						} else {
+							kept = append(kept, conjunct)
+						}
+					}
+					if len(pushed) == 0 {
+						continue
+					}
+					scan.Filter = planners.NewFilterPlan(planners.NewConjunction(pushed...))
+					if len(kept) > 0 {
+						filter.SubPlan = planners.NewConjunction(kept...)
+					} else {
+						plan.SubPlans = append(plan.SubPlans[:i], plan.SubPlans[i+1:]...)
+					}
+				}
 			}
 			return true, nil
 		}
 		if err := planners.Walk(visit, plan); err != nil {
 			return
 		}
+	},
+}
 
-		if scan != nil && filter != nil {
-			scan.Filter = filter
+// pushable is whether the predicate gives the same rows evaluated by the scan,
+// it's over the columns of the rows only: no aggregate nor non-deterministic function.
+func pushable(plan planners.IPlan) bool {
+	hasAggregate, err := planners.CheckAggregateExpressions(plan)
+	if err != nil || hasAggregate {
+		return false
+	}
+
+	deterministic := true
+	if err := planners.Walk(func(plan planners.IPlan) (bool, error) {
+		var name string
+		switch plan := plan.(type) {
+		case *planners.UnaryExpressionPlan:
+			name = plan.FuncName
+		case *planners.BinaryExpressionPlan:
+			name = plan.FuncName
+		case *planners.FunctionExpressionPlan:
+			name = plan.FuncName
 		}
-	},
+		if name != "" && !expressions.IsDeterministic(name) {
+			deterministic = false
+		}
+		return deterministic, nil
+	}, plan); err != nil {
+		return false
+	}
+	return deterministic
 }
@@ -7,31 +7,104 @@ package optimizers
 import (
 	"testing"
 
+	"config"
 	"planners"
 
 	"github.com/stretchr/testify/assert"
 )
 
 func TestOptimizePredicatePushDown(t *testing.T) {
+	filter := planners.NewFilterPlan(
+		planners.NewBinaryExpressionPlan(
+			"=",
+			planners.NewVariablePlan("name"),
+			planners.NewConstantPlan("db2"),
+		),
+	)
 	plan := planners.NewMapPlan(
 		planners.NewScanPlan("tables", "system"),
+		filter,
 		planners.NewProjectPlan(
 			planners.NewMapPlan(
 				planners.NewVariablePlan("name"),
 			),
 		),
-		planners.NewFilterPlan(
-			planners.NewBinaryExpressionPlan(
-				"=",
-				planners.NewVariablePlan("name"),
-				planners.NewConstantPlan("db2"),
-			),
-		),
 	)
 
-	plan = Optimize(plan, DefaultOptimizers).(*planners.MapPlan)
+	plan = Optimize(config.DefaultConfig(), plan, DefaultOptimizers).(*planners.MapPlan)
+
+	// All the conjuncts are pushed, the filter is removed.
+	assert.Equal(t, filter.SubPlan, plan.SubPlans[0].(*planners.ScanPlan).Filter.SubPlan)
+	assert.Equal(t, 2, len(plan.SubPlans))
+}
+
+func TestOptimizePredicatePushDownConjuncts(t *testing.T) {
+	tests := []struct {
+		name   string
+		query  string
+		pushed []string
+		kept   [][]string
+	}{
+		{
+			name:   "all",
+			query:  "SELECT a FROM t WHERE a > 1 AND (b = 'x' OR c < 2)",
+			pushed: []string{"(a>1)", "((b=x)OR(c<2))"},
+		},
+		{
+			name:   "non-deterministic",
+			query:  "SELECT a FROM t WHERE a > 1 AND dictHas('d', a) AND b = 2",
+			pushed: []string{"(b=2)", "(a>1)"},
+			kept:   [][]string{{"DICTHAS([d a])"}},
+		},
+		{
+			name:  "nothing",
+			query: "SELECT a FROM t WHERE dictHas('d', a)",
+			kept:  [][]string{{"DICTHAS([d a])"}},
+		},
+		{
+			// The HAVING is over the aggregates, after the selection.
+			name:   "having",
+			query:  "SELECT a, count(b) AS c FROM t WHERE a > 1 GROUP BY a HAVING a < 10",
+			pushed: []string{"(a>1)"},
+			kept:   [][]string{{"(a<10)"}},
+		},
+		{
+			name:  "table-valued-function",
+			query: "SELECT number FROM numbers(10) WHERE number > 1",
+			kept:  [][]string{{"(number>1)"}},
+		},
+	}
+
+	conjuncts := func(plan planners.IPlan) []string {
+		var res []string
+		for _, conjunct := range planners.Conjuncts(plan) {
+			expr, err := planners.BuildExpression(conjunct)
+			assert.Nil(t, err)
+			res = append(res, expr.String())
+		}
+		return res
+	}
+
+	for _, test := range tests {
+		plan, err := planners.PlanFactory(test.query)
+		assert.Nil(t, err, test.name)
+		plan = Optimize(config.DefaultConfig(), plan, DefaultOptimizers)
 
-	expect := plan.SubPlans[2]
-	actual := plan.SubPlans[0].(*planners.ScanPlan).Filter
-	assert.Equal(t, expect, actual)
+		var pushed []string
+		var kept [][]string
+		err = planners.Walk(func(plan planners.IPlan) (bool, error) {
+			switch plan := plan.(type) {
+			case *planners.ScanPlan:
+				if plan.Filter != nil {
+					pushed = conjuncts(plan.Filter.SubPlan)
+				}
+			case *planners.FilterPlan:
+				kept = append(kept, conjuncts(plan.SubPlan))
+			}
+			return true, nil
+		}, plan)
+		assert.Nil(t, err)
+		assert.Equal(t, test.pushed, pushed, test.name)
+		assert.Equal(t, test.kept, kept, test.name)
+	}
 }
@@ -7,6 +7,7 @@ package optimizers
 import (
 	"testing"
 
+	"config"
 	"planners"
 
 	"github.com/stretchr/testify/assert"
@@ -22,7 +23,7 @@ func TestOptimizeProjectPushDown(t *testing.T) {
 		),
 	)
 
-	plan = Optimize(plan, DefaultOptimizers).(*planners.MapPlan)
+	plan = Optimize(config.DefaultConfig(), plan, DefaultOptimizers).(*planners.MapPlan)
 
 	expect := plan.SubPlans[1]
 	actual := plan.SubPlans[0].(*planners.ScanPlan).Project
@@ -0,0 +1,66 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package optimizers
+
+import (
+	"strings"
+
+	"planners"
+)
+
+// TrivialCountOptimizer takes the COUNT of a constant over a table, with no filter and no group, as the TrivialCountPlan
+// which the storage knowing its exact rows answers without the scan.
+// The COUNT of a column is left, it's of the rows where the column is not NULL.
+var TrivialCountOptimizer = Optimizer{
+	Name:        "TrivialCountOptimizer",
+	Description: "Answer the count of all the rows by the storage",
+	Reassembler: func(plan planners.IPlan) {
+		visit := func(plan planners.IPlan) (kontinue bool, err error) {
+			switch plan := plan.(type) {
+			case *planners.MapPlan:
+				if len(plan.SubPlans) < 2 {
+					break
+				}
+				scan, ok := plan.SubPlans[0].(*planners.ScanPlan)
+				if !ok || scan.Filter != nil || scan.Limit > 0 {
+					break
+				}
+				selection, ok := plan.SubPlans[1].(*planners.SelectionPlan)
+				if !ok || !isTrivialCount(selection) {
+					break
+				}
+				// The HAVING too.
+				for _, sub := range plan.SubPlans {
+					if _, ok := sub.(*planners.FilterPlan); ok {
+						return false, nil
+					}
+				}
+				plan.SubPlans = append([]planners.IPlan{planners.NewTrivialCountPlan(scan, selection)}, plan.SubPlans[2:]...)
+				return false, nil
+			}
+			return true, nil
+		}
+		if err := planners.Walk(visit, plan); err != nil {
+			return
+		}
+	},
+}
+
+func isTrivialCount(selection *planners.SelectionPlan) bool {
+	if selection.SelectionMode != planners.AggregateSelection || selection.Commons != nil ||
+		selection.Projects.Length() != 1 || selection.GroupBys.Length() > 0 {
+		return false
+	}
+	project := selection.Projects.SubPlans[0]
+	if aliased, ok := project.(*planners.AliasedExpressionPlan); ok {
+		project = aliased.Expr
+	}
+	count, ok := project.(*planners.UnaryExpressionPlan)
+	if !ok || strings.ToUpper(count.FuncName) != "COUNT" {
+		return false
+	}
+	_, ok = count.Expr.(*planners.ConstantPlan)
+	return ok
+}
@@ -0,0 +1,77 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package optimizers
+
+import (
+	"testing"
+
+	"config"
+	"planners"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOptimizeTrivialCount(t *testing.T) {
+	tests := []struct {
+		name   string
+		query  string
+		expect bool
+	}{
+		{name: "count", query: "SELECT count(1) FROM t", expect: true},
+		{name: "count-empty", query: "SELECT count() FROM t", expect: true},
+		{name: "count-star", query: "SELECT count(*) FROM t", expect: true},
+		{name: "count-star-aliased", query: "SELECT count(*) AS c FROM t", expect: true},
+		{name: "aliased", query: "SELECT count(1) AS c FROM t", expect: true},
+		{name: "orderby-limit", query: "SELECT count(1) FROM t ORDER BY count(1) LIMIT 1", expect: true},
+		{name: "where-folded", query: "SELECT count(1) FROM t WHERE 1 = 1", expect: true},
+		{name: "where", query: "SELECT count(1) FROM t WHERE a > 1"},
+		{name: "where-kept", query: "SELECT count(1) FROM t WHERE dictHas('d', a)"},
+		{name: "having", query: "SELECT count(1) AS c FROM t HAVING c > 1"},
+		{name: "column", query: "SELECT count(a) FROM t"},
+		{name: "groupby", query: "SELECT count(1) FROM t GROUP BY a"},
+		{name: "groupby-star", query: "SELECT count(*) FROM t GROUP BY a"},
+		{name: "more", query: "SELECT count(1), sum(a) FROM t"},
+		{name: "expression", query: "SELECT count(1) + 1 FROM t"},
+		{name: "tvf", query: "SELECT count(1) FROM rangetable(rows->5, i->'Int32')"},
+	}
+
+	for _, test := range tests {
+		plan, err := planners.PlanFactory(test.query)
+		assert.Nil(t, err)
+		plan = Optimize(config.DefaultConfig(), plan, DefaultOptimizers)
+
+		var trivial *planners.TrivialCountPlan
+		var scans int
+		err = planners.Walk(func(plan planners.IPlan) (bool, error) {
+			switch plan := plan.(type) {
+			case *planners.TrivialCountPlan:
+				trivial = plan
+			case *planners.ScanPlan:
+				scans++
+			}
+			return true, nil
+		}, plan)
+		assert.Nil(t, err)
+		assert.Equal(t, test.expect, trivial != nil, test.name)
+		if trivial != nil {
+			// The scan is kept in it for the storages which can't tell their rows.
+			assert.Equal(t, 1, scans, test.name)
+			assert.Nil(t, trivial.Scan.Filter, test.name)
+		}
+	}
+}
+
+func TestParseCountAll(t *testing.T) {
+	for _, query := range []string{"SELECT count() FROM t", "SELECT count(*) FROM t"} {
+		plan, err := planners.PlanFactory(query)
+		assert.Nil(t, err, query)
+		assert.Contains(t, plan.String(), `"FuncName": "COUNT"`, query)
+	}
+
+	// The star is only of the count.
+	_, err := planners.PlanFactory("SELECT sum(*) FROM t")
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Unsupported argument *")
+}
@@ -5,6 +5,7 @@
 package optimizers
 
 import (
+	"config"
 	"planners"
 )
 
@@ -12,9 +13,18 @@ type Optimizer struct {
 	Name        string
 	Description string
 	Reassembler func(planners.IPlan)
+	// Enabled reports whether the optimizer runs with the settings of the config, nil is always.
+	Enabled func(conf *config.Config) bool
 }
 
 var DefaultOptimizers = []Optimizer{
+	ConstantFoldingOptimizer,
 	ProjectPushDownOptimizer,
 	PredicatePushDownOptimizer,
+	ConjunctOrderOptimizer,
+	OrderByLimitOptimizer,
+	LimitPushDownOptimizer,
+	ColumnPruningOptimizer,
+	CommonSubexpressionOptimizer,
+	TrivialCountOptimizer,
 }
@@ -7,6 +7,7 @@ package parsers
 import (
 	"strings"
 
+	"base/errors"
 	"parsers/sqlparser"
 )
 
@@ -18,7 +19,10 @@ func Parse(sql string) (sqlparser.Statement, error) {
 		} else {
 			sql += " values('fill up')"
 		}
-		return sqlparser.ParseStrictDDL(sql)
+		node, err = sqlparser.ParseStrictDDL(sql)
 	}
-	return node, err
+	if err != nil {
+		return nil, errors.ErrorWithCode(errors.SYNTAX_ERROR, "%s", err)
+	}
+	return node, nil
 }
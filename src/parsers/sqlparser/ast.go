@@ -334,6 +334,7 @@ type Select struct {
 	Having      *Where
 	OrderBy     OrderBy
 	Limit       *Limit
+	Settings    SetExprs
 	Formats     *Formats
 	Lock        string
 	StatementBase
@@ -385,11 +386,15 @@ func (node *Select) SetFormats(formats *Formats) {
 
 // Format formats the node.
 func (node *Select) Format(buf *TrackedBuffer) {
-	buf.Myprintf("select %v%s%s%s%v from %v%v%v%v%v%v%s%v",
+	buf.Myprintf("select %v%s%s%s%v from %v%v%v%v%v%v%s",
 		node.Comments, node.Cache, node.Distinct, node.Hints, node.SelectExprs,
 		node.From, node.Where,
 		node.GroupBy, node.Having, node.OrderBy,
-		node.Limit, node.Lock, node.Formats)
+		node.Limit, node.Lock)
+	if len(node.Settings) > 0 {
+		buf.Myprintf(" settings %v", node.Settings)
+	}
+	buf.Myprintf("%v", node.Formats)
 }
 
 func (node *Select) walkSubtree(visit Visit) error {
@@ -710,6 +715,14 @@ const (
 	ImplicitStr       = ""
 )
 
+const (
+	NodeNameSet = "SET"
+)
+
+func (node *Set) Name() string {
+	return NodeNameSet
+}
+
 // Format formats the node.
 func (node *Set) Format(buf *TrackedBuffer) {
 	if node.Scope == "" {
@@ -794,6 +807,7 @@ type DDL struct {
 
 	// The following fields are set if a DDL was fully analyzed.
 	IfExists      bool
+	Temporary     bool
 	TableSpec     *TableSpec
 	OptLike       *OptLike
 	PartitionSpec *PartitionSpec
@@ -849,12 +863,16 @@ const (
 func (node *DDL) Format(buf *TrackedBuffer) {
 	switch node.Action {
 	case CreateStr:
+		action := node.Action
+		if node.Temporary {
+			action += " temporary"
+		}
 		if node.OptLike != nil {
-			buf.Myprintf("%s table %v %v", node.Action, node.Table, node.OptLike)
+			buf.Myprintf("%s table %v %v", action, node.Table, node.OptLike)
 		} else if node.TableSpec != nil {
-			buf.Myprintf("%s table %v %v", node.Action, node.Table, node.TableSpec)
+			buf.Myprintf("%s table %v %v", action, node.Table, node.TableSpec)
 		} else {
-			buf.Myprintf("%s table %v", node.Action, node.Table)
+			buf.Myprintf("%s table %v", action, node.Table)
 		}
 	case DropStr:
 		exists := ""
@@ -2672,6 +2690,8 @@ const (
 	NotInStr             = "not in"
 	LikeStr              = "like"
 	NotLikeStr           = "not like"
+	ILikeStr             = "ilike"
+	NotILikeStr          = "not ilike"
 	RegexpStr            = "regexp"
 	NotRegexpStr         = "not regexp"
 	JSONExtractOp        = "->"
@@ -141,6 +141,8 @@ var (
 		input: "select /* for update */ 1 from t for update",
 	}, {
 		input: "select /* lock in share mode */ 1 from t lock in share mode",
+	}, {
+		input: "select /* settings */ 1 from t limit 1 settings max_threads = 1, max_block_size = 10 format TSV",
 	}, {
 		input: "select /* select list */ 1, 2 from t",
 	}, {
@@ -326,6 +328,10 @@ var (
 		input: "select /* not like */ 1 from t where a not like b",
 	}, {
 		input: "select /* not like escape */ 1 from t where a not like b escape '$'",
+	}, {
+		input: "select /* ilike */ 1 from t where a ilike b",
+	}, {
+		input: "select /* not ilike */ 1 from t where a not ilike b",
 	}, {
 		input: "select /* regexp */ 1 from t where a regexp b",
 	}, {
@@ -1411,6 +1417,8 @@ var (
 		input: "select match(a) against ('foo') from t",
 	}, {
 		input: "select match(a1, a2) against ('foo' in natural language mode with query expansion) from t",
+	}, {
+		input: "select match(a, '^x') from t where match(b, 'y')",
 	}, {
 		input: "select title from video as v where match(v.title, v.tag) against ('DEMO' in boolean mode)",
 	}, {
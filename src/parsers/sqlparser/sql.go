@@ -1,9 +1,12 @@
+// Code generated by goyacc -o sql.go sql.y. DO NOT EDIT.
+
 //line sql.y:19
 package sqlparser
 
 import __yyfmt__ "fmt"
 
 //line sql.y:19
+
 func setParseTree(yylex interface{}, stmt Statement) {
 	yylex.(*Tokenizer).ParseTree = stmt
 }
@@ -127,332 +130,335 @@ const LIMIT = 57359
 const OFFSET = 57360
 const FOR = 57361
 const FORMAT = 57362
-const ALL = 57363
-const DISTINCT = 57364
-const AS = 57365
-const EXISTS = 57366
-const ASC = 57367
-const DESC = 57368
-const INTO = 57369
-const DUPLICATE = 57370
-const KEY = 57371
-const DEFAULT = 57372
-const SET = 57373
-const LOCK = 57374
-const UNLOCK = 57375
-const KEYS = 57376
-const VALUES = 57377
-const LAST_INSERT_ID = 57378
-const NEXT = 57379
-const VALUE = 57380
-const SHARE = 57381
-const MODE = 57382
-const SQL_NO_CACHE = 57383
-const SQL_CACHE = 57384
-const JOIN = 57385
-const STRAIGHT_JOIN = 57386
-const LEFT = 57387
-const RIGHT = 57388
-const INNER = 57389
-const OUTER = 57390
-const CROSS = 57391
-const NATURAL = 57392
-const USE = 57393
-const FORCE = 57394
-const ON = 57395
-const USING = 57396
-const ID = 57397
-const HEX = 57398
-const STRING = 57399
-const INTEGRAL = 57400
-const FLOAT = 57401
-const HEXNUM = 57402
-const VALUE_ARG = 57403
-const LIST_ARG = 57404
-const COMMENT = 57405
-const COMMENT_KEYWORD = 57406
-const BIT_LITERAL = 57407
-const NULL = 57408
-const TRUE = 57409
-const FALSE = 57410
-const OFF = 57411
-const OR = 57412
-const AND = 57413
-const NOT = 57414
-const BETWEEN = 57415
-const CASE = 57416
-const WHEN = 57417
-const THEN = 57418
-const ELSE = 57419
-const END = 57420
-const LE = 57421
-const GE = 57422
-const NE = 57423
-const NULL_SAFE_EQUAL = 57424
-const IS = 57425
-const LIKE = 57426
-const REGEXP = 57427
-const IN = 57428
-const SHIFT_LEFT = 57429
-const SHIFT_RIGHT = 57430
-const DIV = 57431
-const MOD = 57432
-const UNARY = 57433
-const COLLATE = 57434
-const BINARY = 57435
-const UNDERSCORE_BINARY = 57436
-const UNDERSCORE_UTF8MB4 = 57437
-const INTERVAL = 57438
-const JSON_EXTRACT_OP = 57439
-const JSON_UNQUOTE_EXTRACT_OP = 57440
-const CREATE = 57441
-const ALTER = 57442
-const DROP = 57443
-const RENAME = 57444
-const ANALYZE = 57445
-const ADD = 57446
-const FLUSH = 57447
-const SCHEMA = 57448
-const TABLE = 57449
-const DESCRIPTOR = 57450
-const INDEX = 57451
-const VIEW = 57452
-const TO = 57453
-const IGNORE = 57454
-const IF = 57455
-const UNIQUE = 57456
-const PRIMARY = 57457
-const COLUMN = 57458
-const SPATIAL = 57459
-const FULLTEXT = 57460
-const KEY_BLOCK_SIZE = 57461
-const CHECK = 57462
-const ACTION = 57463
-const CASCADE = 57464
-const CONSTRAINT = 57465
-const FOREIGN = 57466
-const NO = 57467
-const REFERENCES = 57468
-const RESTRICT = 57469
-const SHOW = 57470
-const DESCRIBE = 57471
-const EXPLAIN = 57472
-const DATE = 57473
-const ESCAPE = 57474
-const REPAIR = 57475
-const OPTIMIZE = 57476
-const TRUNCATE = 57477
-const MAXVALUE = 57478
-const PARTITION = 57479
-const REORGANIZE = 57480
-const LESS = 57481
-const THAN = 57482
-const PROCEDURE = 57483
-const TRIGGER = 57484
-const VINDEX = 57485
-const VINDEXES = 57486
-const STATUS = 57487
-const VARIABLES = 57488
-const WARNINGS = 57489
-const SEQUENCE = 57490
-const BEGIN = 57491
-const START = 57492
-const TRANSACTION = 57493
-const COMMIT = 57494
-const ROLLBACK = 57495
-const BIT = 57496
-const TINYINT = 57497
-const SMALLINT = 57498
-const MEDIUMINT = 57499
-const INT = 57500
-const INTEGER = 57501
-const BIGINT = 57502
-const INTNUM = 57503
-const REAL = 57504
-const DOUBLE = 57505
-const FLOAT_TYPE = 57506
-const DECIMAL = 57507
-const NUMERIC = 57508
-const TIME = 57509
-const TIMESTAMP = 57510
-const DATETIME = 57511
-const YEAR = 57512
-const CHAR = 57513
-const VARCHAR = 57514
-const BOOL = 57515
-const CHARACTER = 57516
-const VARBINARY = 57517
-const NCHAR = 57518
-const TEXT = 57519
-const TINYTEXT = 57520
-const MEDIUMTEXT = 57521
-const LONGTEXT = 57522
-const BLOB = 57523
-const TINYBLOB = 57524
-const MEDIUMBLOB = 57525
-const LONGBLOB = 57526
-const JSON = 57527
-const ENUM = 57528
-const GEOMETRY = 57529
-const POINT = 57530
-const LINESTRING = 57531
-const POLYGON = 57532
-const GEOMETRYCOLLECTION = 57533
-const MULTIPOINT = 57534
-const MULTILINESTRING = 57535
-const MULTIPOLYGON = 57536
-const INT8 = 57537
-const INT16 = 57538
-const INT32 = 57539
-const INT64 = 57540
-const UINT8 = 57541
-const UINT16 = 57542
-const UINT32 = 57543
-const UINT64 = 57544
-const FLOAT32 = 57545
-const FLOAT64 = 57546
-const ENUM8 = 57547
-const ENUM16 = 57548
-const NULLABLE = 57549
-const UUID = 57550
-const NULLX = 57551
-const AUTO_INCREMENT = 57552
-const APPROXNUM = 57553
-const SIGNED = 57554
-const UNSIGNED = 57555
-const ZEROFILL = 57556
-const COLLATION = 57557
-const DATABASES = 57558
-const TABLES = 57559
-const VITESS_METADATA = 57560
-const VSCHEMA = 57561
-const FULL = 57562
-const PROCESSLIST = 57563
-const COLUMNS = 57564
-const FIELDS = 57565
-const ENGINES = 57566
-const ENGINE = 57567
-const PLUGINS = 57568
-const NAMES = 57569
-const CHARSET = 57570
-const GLOBAL = 57571
-const SESSION = 57572
-const ISOLATION = 57573
-const LEVEL = 57574
-const READ = 57575
-const WRITE = 57576
-const ONLY = 57577
-const REPEATABLE = 57578
-const COMMITTED = 57579
-const UNCOMMITTED = 57580
-const SERIALIZABLE = 57581
-const CURRENT_TIMESTAMP = 57582
-const DATABASE = 57583
-const CURRENT_DATE = 57584
-const CURRENT_TIME = 57585
-const LOCALTIME = 57586
-const LOCALTIMESTAMP = 57587
-const UTC_DATE = 57588
-const UTC_TIME = 57589
-const UTC_TIMESTAMP = 57590
-const REPLACE = 57591
-const CONVERT = 57592
-const CAST = 57593
-const SUBSTR = 57594
-const SUBSTRING = 57595
-const GROUP_CONCAT = 57596
-const SEPARATOR = 57597
-const TIMESTAMPADD = 57598
-const TIMESTAMPDIFF = 57599
-const MATCH = 57600
-const AGAINST = 57601
-const BOOLEAN = 57602
-const LANGUAGE = 57603
-const WITH = 57604
-const QUERY = 57605
-const EXPANSION = 57606
-const UNUSED = 57607
-const ARRAY = 57608
-const CUME_DIST = 57609
-const DESCRIPTION = 57610
-const DENSE_RANK = 57611
-const EMPTY = 57612
-const EXCEPT = 57613
-const FIRST_VALUE = 57614
-const GROUPING = 57615
-const GROUPS = 57616
-const JSON_TABLE = 57617
-const LAG = 57618
-const LAST_VALUE = 57619
-const LATERAL = 57620
-const LEAD = 57621
-const MEMBER = 57622
-const NTH_VALUE = 57623
-const NTILE = 57624
-const OF = 57625
-const OVER = 57626
-const PERCENT_RANK = 57627
-const RANK = 57628
-const RECURSIVE = 57629
-const ROW_NUMBER = 57630
-const SYSTEM = 57631
-const WINDOW = 57632
-const ACTIVE = 57633
-const ADMIN = 57634
-const BUCKETS = 57635
-const CLONE = 57636
-const COMPONENT = 57637
-const DEFINITION = 57638
-const ENFORCED = 57639
-const EXCLUDE = 57640
-const FOLLOWING = 57641
-const GEOMCOLLECTION = 57642
-const GET_MASTER_PUBLIC_KEY = 57643
-const HISTOGRAM = 57644
-const HISTORY = 57645
-const INACTIVE = 57646
-const INVISIBLE = 57647
-const LOCKED = 57648
-const MASTER_COMPRESSION_ALGORITHMS = 57649
-const MASTER_PUBLIC_KEY_PATH = 57650
-const MASTER_TLS_CIPHERSUITES = 57651
-const MASTER_ZSTD_COMPRESSION_LEVEL = 57652
-const NESTED = 57653
-const NETWORK_NAMESPACE = 57654
-const NOWAIT = 57655
-const NULLS = 57656
-const OJ = 57657
-const OLD = 57658
-const OPTIONAL = 57659
-const ORDINALITY = 57660
-const ORGANIZATION = 57661
-const OTHERS = 57662
-const PATH = 57663
-const PERSIST = 57664
-const PERSIST_ONLY = 57665
-const PRECEDING = 57666
-const PRIVILEGE_CHECKS_USER = 57667
-const PROCESS = 57668
-const RANDOM = 57669
-const REFERENCE = 57670
-const REQUIRE_ROW_FORMAT = 57671
-const RESOURCE = 57672
-const RESPECT = 57673
-const RESTART = 57674
-const RETAIN = 57675
-const REUSE = 57676
-const ROLE = 57677
-const SECONDARY = 57678
-const SECONDARY_ENGINE = 57679
-const SECONDARY_LOAD = 57680
-const SECONDARY_UNLOAD = 57681
-const SKIP = 57682
-const SRID = 57683
-const THREAD_PRIORITY = 57684
-const TIES = 57685
-const UNBOUNDED = 57686
-const VCPU = 57687
-const VISIBLE = 57688
+const SETTINGS = 57363
+const ALL = 57364
+const DISTINCT = 57365
+const AS = 57366
+const EXISTS = 57367
+const ASC = 57368
+const DESC = 57369
+const INTO = 57370
+const DUPLICATE = 57371
+const KEY = 57372
+const DEFAULT = 57373
+const SET = 57374
+const LOCK = 57375
+const UNLOCK = 57376
+const KEYS = 57377
+const VALUES = 57378
+const LAST_INSERT_ID = 57379
+const NEXT = 57380
+const VALUE = 57381
+const SHARE = 57382
+const MODE = 57383
+const SQL_NO_CACHE = 57384
+const SQL_CACHE = 57385
+const JOIN = 57386
+const STRAIGHT_JOIN = 57387
+const LEFT = 57388
+const RIGHT = 57389
+const INNER = 57390
+const OUTER = 57391
+const CROSS = 57392
+const NATURAL = 57393
+const USE = 57394
+const FORCE = 57395
+const ON = 57396
+const USING = 57397
+const AGAINST = 57398
+const ID = 57399
+const HEX = 57400
+const STRING = 57401
+const INTEGRAL = 57402
+const FLOAT = 57403
+const HEXNUM = 57404
+const VALUE_ARG = 57405
+const LIST_ARG = 57406
+const COMMENT = 57407
+const COMMENT_KEYWORD = 57408
+const BIT_LITERAL = 57409
+const NULL = 57410
+const TRUE = 57411
+const FALSE = 57412
+const OFF = 57413
+const OR = 57414
+const AND = 57415
+const NOT = 57416
+const BETWEEN = 57417
+const CASE = 57418
+const WHEN = 57419
+const THEN = 57420
+const ELSE = 57421
+const END = 57422
+const LE = 57423
+const GE = 57424
+const NE = 57425
+const NULL_SAFE_EQUAL = 57426
+const IS = 57427
+const LIKE = 57428
+const ILIKE = 57429
+const REGEXP = 57430
+const IN = 57431
+const SHIFT_LEFT = 57432
+const SHIFT_RIGHT = 57433
+const DIV = 57434
+const MOD = 57435
+const UNARY = 57436
+const COLLATE = 57437
+const BINARY = 57438
+const UNDERSCORE_BINARY = 57439
+const UNDERSCORE_UTF8MB4 = 57440
+const INTERVAL = 57441
+const JSON_EXTRACT_OP = 57442
+const JSON_UNQUOTE_EXTRACT_OP = 57443
+const CREATE = 57444
+const ALTER = 57445
+const DROP = 57446
+const RENAME = 57447
+const ANALYZE = 57448
+const ADD = 57449
+const FLUSH = 57450
+const SCHEMA = 57451
+const TABLE = 57452
+const TEMPORARY = 57453
+const DESCRIPTOR = 57454
+const INDEX = 57455
+const VIEW = 57456
+const TO = 57457
+const IGNORE = 57458
+const IF = 57459
+const UNIQUE = 57460
+const PRIMARY = 57461
+const COLUMN = 57462
+const SPATIAL = 57463
+const FULLTEXT = 57464
+const KEY_BLOCK_SIZE = 57465
+const CHECK = 57466
+const ACTION = 57467
+const CASCADE = 57468
+const CONSTRAINT = 57469
+const FOREIGN = 57470
+const NO = 57471
+const REFERENCES = 57472
+const RESTRICT = 57473
+const SHOW = 57474
+const DESCRIBE = 57475
+const EXPLAIN = 57476
+const DATE = 57477
+const ESCAPE = 57478
+const REPAIR = 57479
+const OPTIMIZE = 57480
+const TRUNCATE = 57481
+const MAXVALUE = 57482
+const PARTITION = 57483
+const REORGANIZE = 57484
+const LESS = 57485
+const THAN = 57486
+const PROCEDURE = 57487
+const TRIGGER = 57488
+const VINDEX = 57489
+const VINDEXES = 57490
+const STATUS = 57491
+const VARIABLES = 57492
+const WARNINGS = 57493
+const SEQUENCE = 57494
+const BEGIN = 57495
+const START = 57496
+const TRANSACTION = 57497
+const COMMIT = 57498
+const ROLLBACK = 57499
+const BIT = 57500
+const TINYINT = 57501
+const SMALLINT = 57502
+const MEDIUMINT = 57503
+const INT = 57504
+const INTEGER = 57505
+const BIGINT = 57506
+const INTNUM = 57507
+const REAL = 57508
+const DOUBLE = 57509
+const FLOAT_TYPE = 57510
+const DECIMAL = 57511
+const NUMERIC = 57512
+const TIME = 57513
+const TIMESTAMP = 57514
+const DATETIME = 57515
+const YEAR = 57516
+const CHAR = 57517
+const VARCHAR = 57518
+const BOOL = 57519
+const CHARACTER = 57520
+const VARBINARY = 57521
+const NCHAR = 57522
+const TEXT = 57523
+const TINYTEXT = 57524
+const MEDIUMTEXT = 57525
+const LONGTEXT = 57526
+const BLOB = 57527
+const TINYBLOB = 57528
+const MEDIUMBLOB = 57529
+const LONGBLOB = 57530
+const JSON = 57531
+const ENUM = 57532
+const GEOMETRY = 57533
+const POINT = 57534
+const LINESTRING = 57535
+const POLYGON = 57536
+const GEOMETRYCOLLECTION = 57537
+const MULTIPOINT = 57538
+const MULTILINESTRING = 57539
+const MULTIPOLYGON = 57540
+const INT8 = 57541
+const INT16 = 57542
+const INT32 = 57543
+const INT64 = 57544
+const UINT8 = 57545
+const UINT16 = 57546
+const UINT32 = 57547
+const UINT64 = 57548
+const FLOAT32 = 57549
+const FLOAT64 = 57550
+const ENUM8 = 57551
+const ENUM16 = 57552
+const NULLABLE = 57553
+const UUID = 57554
+const NULLX = 57555
+const AUTO_INCREMENT = 57556
+const APPROXNUM = 57557
+const SIGNED = 57558
+const UNSIGNED = 57559
+const ZEROFILL = 57560
+const COLLATION = 57561
+const DATABASES = 57562
+const TABLES = 57563
+const VITESS_METADATA = 57564
+const VSCHEMA = 57565
+const FULL = 57566
+const PROCESSLIST = 57567
+const COLUMNS = 57568
+const FIELDS = 57569
+const ENGINES = 57570
+const ENGINE = 57571
+const PLUGINS = 57572
+const NAMES = 57573
+const CHARSET = 57574
+const GLOBAL = 57575
+const SESSION = 57576
+const ISOLATION = 57577
+const LEVEL = 57578
+const READ = 57579
+const WRITE = 57580
+const ONLY = 57581
+const REPEATABLE = 57582
+const COMMITTED = 57583
+const UNCOMMITTED = 57584
+const SERIALIZABLE = 57585
+const CURRENT_TIMESTAMP = 57586
+const DATABASE = 57587
+const CURRENT_DATE = 57588
+const CURRENT_TIME = 57589
+const LOCALTIME = 57590
+const LOCALTIMESTAMP = 57591
+const UTC_DATE = 57592
+const UTC_TIME = 57593
+const UTC_TIMESTAMP = 57594
+const REPLACE = 57595
+const CONVERT = 57596
+const CAST = 57597
+const SUBSTR = 57598
+const SUBSTRING = 57599
+const GROUP_CONCAT = 57600
+const SEPARATOR = 57601
+const TIMESTAMPADD = 57602
+const TIMESTAMPDIFF = 57603
+const MATCH = 57604
+const BOOLEAN = 57605
+const LANGUAGE = 57606
+const WITH = 57607
+const QUERY = 57608
+const EXPANSION = 57609
+const UNUSED = 57610
+const ARRAY = 57611
+const CUME_DIST = 57612
+const DESCRIPTION = 57613
+const DENSE_RANK = 57614
+const EMPTY = 57615
+const EXCEPT = 57616
+const FIRST_VALUE = 57617
+const GROUPING = 57618
+const GROUPS = 57619
+const JSON_TABLE = 57620
+const LAG = 57621
+const LAST_VALUE = 57622
+const LATERAL = 57623
+const LEAD = 57624
+const MEMBER = 57625
+const NTH_VALUE = 57626
+const NTILE = 57627
+const OF = 57628
+const OVER = 57629
+const PERCENT_RANK = 57630
+const RANK = 57631
+const RECURSIVE = 57632
+const ROW_NUMBER = 57633
+const SYSTEM = 57634
+const WINDOW = 57635
+const ACTIVE = 57636
+const ADMIN = 57637
+const BUCKETS = 57638
+const CLONE = 57639
+const COMPONENT = 57640
+const DEFINITION = 57641
+const ENFORCED = 57642
+const EXCLUDE = 57643
+const FOLLOWING = 57644
+const GEOMCOLLECTION = 57645
+const GET_MASTER_PUBLIC_KEY = 57646
+const HISTOGRAM = 57647
+const HISTORY = 57648
+const INACTIVE = 57649
+const INVISIBLE = 57650
+const LOCKED = 57651
+const MASTER_COMPRESSION_ALGORITHMS = 57652
+const MASTER_PUBLIC_KEY_PATH = 57653
+const MASTER_TLS_CIPHERSUITES = 57654
+const MASTER_ZSTD_COMPRESSION_LEVEL = 57655
+const NESTED = 57656
+const NETWORK_NAMESPACE = 57657
+const NOWAIT = 57658
+const NULLS = 57659
+const OJ = 57660
+const OLD = 57661
+const OPTIONAL = 57662
+const ORDINALITY = 57663
+const ORGANIZATION = 57664
+const OTHERS = 57665
+const PATH = 57666
+const PERSIST = 57667
+const PERSIST_ONLY = 57668
+const PRECEDING = 57669
+const PRIVILEGE_CHECKS_USER = 57670
+const PROCESS = 57671
+const RANDOM = 57672
+const REFERENCE = 57673
+const REQUIRE_ROW_FORMAT = 57674
+const RESOURCE = 57675
+const RESPECT = 57676
+const RESTART = 57677
+const RETAIN = 57678
+const REUSE = 57679
+const ROLE = 57680
+const SECONDARY = 57681
+const SECONDARY_ENGINE = 57682
+const SECONDARY_LOAD = 57683
+const SECONDARY_UNLOAD = 57684
+const SKIP = 57685
+const SRID = 57686
+const THREAD_PRIORITY = 57687
+const TIES = 57688
+const UNBOUNDED = 57689
+const VCPU = 57690
+const VISIBLE = 57691
 
 var yyToknames = [...]string{
 	"$end",
@@ -475,6 +481,7 @@ var yyToknames = [...]string{
 	"OFFSET",
 	"FOR",
 	"FORMAT",
+	"SETTINGS",
 	"ALL",
 	"DISTINCT",
 	"AS",
@@ -509,6 +516,7 @@ var yyToknames = [...]string{
 	"FORCE",
 	"ON",
 	"USING",
+	"AGAINST",
 	"'('",
 	"','",
 	"')'",
@@ -546,6 +554,7 @@ var yyToknames = [...]string{
 	"NULL_SAFE_EQUAL",
 	"IS",
 	"LIKE",
+	"ILIKE",
 	"REGEXP",
 	"IN",
 	"'|'",
@@ -579,6 +588,7 @@ var yyToknames = [...]string{
 	"FLUSH",
 	"SCHEMA",
 	"TABLE",
+	"TEMPORARY",
 	"DESCRIPTOR",
 	"INDEX",
 	"VIEW",
@@ -730,7 +740,6 @@ var yyToknames = [...]string{
 	"TIMESTAMPADD",
 	"TIMESTAMPDIFF",
 	"MATCH",
-	"AGAINST",
 	"BOOLEAN",
 	"LANGUAGE",
 	"WITH",
@@ -820,16 +829,17 @@ var yyToknames = [...]string{
 	"VISIBLE",
 	"';'",
 }
+
 var yyStatenames = [...]string{}
 
 const yyEofCode = 1
 const yyErrCode = 2
 const yyInitialStackSize = 16
 
-//line sql.y:4498
+//line sql.y:4535
 
 //line yacctab:1
-var yyExca = [...]int{
+var yyExca = [...]int16{
 	-1, 1,
 	1, -1,
 	-2, 0,
@@ -837,1835 +847,1831 @@ var yyExca = [...]int{
 	5, 29,
 	-2, 4,
 	-1, 37,
-	162, 316,
-	163, 316,
-	-2, 302,
-	-1, 320,
-	113, 668,
-	-2, 664,
-	-1, 321,
-	113, 669,
-	-2, 665,
-	-1, 389,
-	83, 917,
+	166, 317,
+	167, 317,
+	-2, 303,
+	-1, 322,
+	116, 674,
+	-2, 670,
+	-1, 323,
+	116, 675,
+	-2, 671,
+	-1, 391,
+	85, 925,
 	-2, 63,
-	-1, 390,
-	83, 835,
+	-1, 392,
+	85, 843,
 	-2, 64,
-	-1, 395,
-	83, 804,
-	-2, 630,
 	-1, 397,
-	83, 865,
-	-2, 632,
-	-1, 690,
-	1, 368,
-	5, 368,
-	12, 368,
-	13, 368,
-	14, 368,
-	15, 368,
-	17, 368,
-	19, 368,
-	20, 368,
-	31, 368,
-	32, 368,
-	43, 368,
-	44, 368,
-	45, 368,
-	46, 368,
-	47, 368,
-	49, 368,
-	50, 368,
-	53, 368,
-	54, 368,
-	56, 368,
-	57, 368,
-	364, 368,
-	-2, 396,
-	-1, 694,
-	54, 44,
-	56, 44,
+	85, 812,
+	-2, 636,
+	-1, 399,
+	85, 873,
+	-2, 638,
+	-1, 696,
+	1, 369,
+	5, 369,
+	12, 369,
+	13, 369,
+	14, 369,
+	15, 369,
+	17, 369,
+	19, 369,
+	20, 369,
+	21, 369,
+	32, 369,
+	33, 369,
+	44, 369,
+	45, 369,
+	46, 369,
+	47, 369,
+	48, 369,
+	50, 369,
+	51, 369,
+	54, 369,
+	55, 369,
+	58, 369,
+	59, 369,
+	367, 369,
+	-2, 397,
+	-1, 700,
+	55, 44,
+	58, 44,
 	-2, 48,
-	-1, 859,
-	113, 671,
-	-2, 667,
-	-1, 1094,
+	-1, 866,
+	116, 677,
+	-2, 673,
+	-1, 1108,
 	5, 30,
-	-2, 463,
-	-1, 1278,
+	-2, 466,
+	-1, 1294,
 	5, 29,
-	-2, 604,
-	-1, 1444,
+	-2, 610,
+	-1, 1460,
 	5, 30,
-	-2, 605,
-	-1, 1498,
+	-2, 611,
+	-1, 1514,
 	5, 29,
-	-2, 607,
-	-1, 1546,
+	-2, 613,
+	-1, 1562,
 	5, 30,
-	-2, 608,
+	-2, 614,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 17414
-
-var yyAct = [...]int{
+const yyLast = 17351
 
-	321, 1570, 1560, 1343, 1124, 1224, 351, 1520, 325, 1380,
-	1409, 1424, 1309, 1460, 646, 969, 1381, 645, 3, 1149,
-	946, 338, 1144, 1314, 299, 686, 941, 1125, 999, 57,
-	1378, 1055, 81, 1015, 978, 1281, 264, 1174, 1155, 264,
-	1287, 1251, 1086, 394, 1203, 805, 352, 51, 290, 884,
-	891, 894, 819, 982, 687, 932, 1191, 943, 827, 948,
-	912, 861, 549, 707, 578, 584, 1011, 264, 81, 298,
-	706, 518, 264, 388, 264, 590, 383, 993, 323, 598,
-	925, 308, 380, 385, 56, 696, 1037, 1563, 693, 660,
-	61, 1544, 1558, 291, 292, 293, 294, 1530, 51, 297,
-	1555, 1036, 1344, 1543, 1268, 1374, 304, 661, 1529, 523,
-	551, 1306, 536, 1307, 1308, 312, 63, 64, 65, 66,
-	67, 963, 259, 255, 261, 256, 257, 1024, 1164, 1041,
-	391, 1163, 964, 965, 1165, 708, 972, 709, 1035, 572,
-	296, 1491, 611, 610, 620, 621, 613, 614, 615, 616,
-	617, 618, 619, 612, 295, 382, 622, 251, 1182, 253,
-	520, 363, 522, 369, 370, 367, 368, 366, 365, 364,
-	1252, 992, 1226, 1431, 1412, 988, 553, 371, 372, 555,
-	567, 989, 893, 1000, 568, 565, 566, 1365, 1032, 1029,
-	1030, 1363, 1028, 289, 794, 560, 561, 570, 793, 1228,
-	791, 1557, 1554, 1521, 1223, 926, 1574, 1513, 983, 1254,
-	552, 554, 571, 1578, 1461, 1150, 1152, 537, 547, 525,
-	253, 1229, 798, 1220, 784, 1039, 1042, 1463, 1301, 1222,
-	1300, 1469, 985, 1299, 792, 795, 521, 528, 266, 1227,
-	985, 254, 1534, 1256, 533, 1260, 1447, 1255, 1049, 1253,
-	1238, 1048, 264, 1160, 1258, 264, 1103, 258, 1100, 634,
-	635, 264, 1034, 1257, 1113, 1080, 252, 264, 833, 1175,
-	81, 985, 81, 1326, 81, 81, 702, 81, 602, 81,
-	543, 612, 970, 959, 622, 81, 622, 868, 830, 825,
-	1259, 1261, 1234, 1151, 597, 820, 1462, 595, 1033, 1062,
-	519, 866, 867, 865, 1511, 824, 1000, 530, 1480, 531,
-	550, 1285, 532, 597, 1572, 81, 548, 1573, 548, 1571,
-	548, 548, 587, 548, 1327, 548, 1221, 1492, 1219, 984,
-	990, 548, 586, 1470, 1468, 1528, 1211, 984, 1038, 1201,
-	529, 596, 595, 535, 574, 575, 596, 595, 1272, 542,
-	70, 51, 519, 1040, 1168, 544, 913, 710, 597, 634,
-	635, 634, 635, 597, 1270, 1209, 631, 786, 984, 633,
-	539, 540, 541, 981, 979, 1180, 980, 821, 264, 264,
-	264, 1516, 977, 983, 54, 517, 71, 81, 592, 913,
-	1058, 1110, 1535, 81, 864, 1420, 588, 644, 1419, 648,
-	649, 650, 651, 652, 653, 654, 655, 656, 1197, 659,
-	662, 662, 662, 668, 662, 662, 668, 662, 676, 677,
-	678, 679, 680, 681, 1196, 691, 615, 616, 617, 618,
-	619, 612, 1579, 1210, 622, 1183, 685, 1099, 1215, 1212,
-	1205, 1213, 1208, 885, 1204, 886, 1537, 1206, 1207, 391,
-	1512, 1438, 350, 1057, 663, 665, 667, 669, 671, 673,
-	674, 1214, 695, 1098, 1415, 1097, 684, 700, 694, 1056,
-	704, 1580, 664, 666, 1509, 670, 672, 1352, 675, 851,
-	853, 854, 596, 595, 79, 852, 832, 596, 595, 524,
-	556, 1236, 557, 558, 1166, 559, 1167, 562, 250, 597,
-	836, 837, 1233, 573, 597, 611, 610, 620, 621, 613,
-	614, 615, 616, 617, 618, 619, 612, 1192, 264, 622,
-	393, 1061, 1346, 81, 831, 1077, 1078, 1079, 264, 264,
-	81, 1175, 1466, 1556, 264, 22, 1170, 264, 1539, 577,
-	264, 596, 595, 1064, 264, 887, 81, 81, 596, 595,
-	804, 81, 81, 81, 264, 81, 81, 803, 597, 787,
-	1087, 81, 81, 377, 378, 597, 526, 527, 785, 548,
-	1466, 1524, 1466, 577, 1466, 1502, 548, 620, 621, 613,
-	614, 615, 616, 617, 618, 619, 612, 807, 782, 622,
-	81, 1377, 548, 548, 264, 303, 545, 548, 548, 548,
-	81, 548, 548, 1466, 1465, 577, 718, 548, 548, 1446,
-	577, 838, 862, 577, 1407, 1406, 788, 789, 538, 799,
-	1389, 577, 796, 1335, 1334, 382, 1329, 1332, 802, 611,
-	610, 620, 621, 613, 614, 615, 616, 617, 618, 619,
-	612, 1507, 813, 622, 81, 1477, 859, 1476, 857, 1323,
-	611, 610, 620, 621, 613, 614, 615, 616, 617, 618,
-	619, 612, 903, 906, 622, 858, 898, 24, 914, 986,
-	840, 1329, 1331, 1329, 1330, 1329, 1328, 81, 81, 855,
-	51, 1156, 847, 1241, 264, 1092, 577, 929, 577, 896,
-	577, 698, 264, 1284, 264, 648, 1497, 264, 264, 717,
-	716, 264, 264, 264, 81, 613, 614, 615, 616, 617,
-	618, 619, 612, 888, 889, 622, 54, 1379, 58, 953,
-	1284, 697, 393, 1442, 393, 929, 393, 393, 1092, 393,
-	922, 393, 910, 896, 699, 1479, 701, 393, 944, 945,
-	1156, 783, 24, 691, 929, 1333, 807, 691, 790, 934,
-	937, 938, 939, 935, 954, 936, 940, 928, 956, 1092,
-	1001, 1002, 1003, 577, 808, 809, 391, 600, 1296, 810,
-	811, 812, 927, 814, 815, 952, 961, 960, 957, 816,
-	817, 24, 929, 962, 1284, 264, 955, 1116, 81, 973,
-	1115, 54, 264, 264, 264, 264, 264, 698, 264, 264,
-	1092, 697, 264, 81, 995, 996, 997, 998, 703, 834,
-	1277, 917, 797, 1017, 1018, 1019, 305, 54, 1548, 264,
-	1426, 264, 264, 994, 1008, 1009, 1010, 264, 1405, 1394,
-	54, 1016, 899, 900, 548, 1319, 905, 908, 909, 393,
-	699, 1225, 697, 1013, 1014, 712, 1288, 1289, 1427, 548,
-	341, 340, 343, 344, 345, 346, 1169, 1012, 1007, 342,
-	347, 921, 1006, 923, 924, 54, 1005, 1004, 1021, 1565,
-	1561, 1379, 862, 1022, 846, 1321, 1291, 859, 1198, 1068,
-	1043, 1044, 1045, 1046, 1047, 826, 1050, 1051, 801, 1294,
-	1052, 934, 937, 938, 939, 935, 858, 936, 940, 1136,
-	1069, 1288, 1289, 1070, 1137, 1081, 1134, 1054, 1293, 1133,
-	1138, 1135, 938, 939, 1132, 1063, 610, 620, 621, 613,
-	614, 615, 616, 617, 618, 619, 612, 1082, 1552, 622,
-	264, 264, 264, 264, 264, 309, 310, 1126, 1542, 1237,
-	1065, 591, 264, 1550, 1075, 264, 1074, 579, 1187, 715,
-	264, 1518, 546, 1179, 264, 1127, 589, 828, 1130, 1517,
-	580, 1495, 898, 934, 937, 938, 939, 935, 1122, 936,
-	940, 1177, 1109, 1123, 1171, 393, 691, 691, 691, 691,
-	691, 1441, 393, 1422, 1121, 1128, 1129, 1025, 1131, 800,
-	942, 944, 591, 1158, 1153, 1159, 1139, 828, 393, 393,
-	691, 306, 307, 393, 393, 393, 1026, 393, 393, 1154,
-	300, 1157, 1485, 393, 393, 1186, 301, 1188, 1189, 1190,
-	1176, 1053, 81, 81, 1184, 1185, 1161, 58, 1076, 1073,
-	1484, 1104, 1429, 1156, 1172, 1173, 569, 1072, 1567, 1566,
-	1567, 1101, 842, 818, 593, 1531, 1413, 829, 60, 62,
-	55, 1, 600, 81, 1559, 393, 1345, 1423, 1031, 1519,
-	1459, 327, 1193, 1194, 1195, 1313, 976, 69, 516, 548,
-	68, 1510, 264, 975, 1216, 1091, 1202, 974, 1467, 1411,
-	1232, 81, 987, 1181, 991, 1320, 1178, 1515, 723, 721,
-	722, 720, 725, 1107, 724, 719, 890, 277, 386, 548,
-	711, 1020, 594, 72, 1218, 1217, 1027, 1231, 823, 563,
-	576, 564, 915, 279, 630, 1071, 1162, 392, 1385, 582,
-	835, 583, 1483, 1428, 81, 1108, 1245, 1280, 657, 919,
-	920, 1126, 1244, 911, 326, 850, 339, 1269, 336, 1278,
-	337, 1263, 841, 1243, 1250, 1276, 604, 324, 1262, 316,
-	689, 682, 933, 931, 81, 262, 393, 859, 288, 1068,
-	1239, 930, 381, 1283, 1145, 1142, 1143, 1290, 1279, 81,
-	81, 1292, 1286, 1023, 971, 688, 1273, 1240, 1373, 1490,
-	845, 26, 59, 315, 311, 1303, 384, 19, 18, 1302,
-	17, 262, 20, 262, 1297, 1298, 1305, 16, 15, 264,
-	1324, 1325, 81, 14, 534, 1316, 30, 21, 13, 12,
-	1317, 1318, 11, 10, 1337, 9, 8, 7, 264, 6,
-	5, 4, 302, 23, 81, 2, 0, 81, 81, 81,
-	264, 1310, 0, 0, 0, 0, 0, 0, 0, 81,
-	393, 1200, 264, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1338, 0, 0, 393, 0, 0, 0, 0,
-	0, 0, 0, 1351, 1310, 1339, 0, 1341, 0, 0,
-	0, 1230, 0, 0, 0, 0, 0, 0, 0, 1353,
-	81, 0, 0, 0, 1382, 0, 393, 1336, 691, 0,
-	1361, 0, 0, 1126, 0, 0, 0, 1384, 264, 1354,
-	0, 1243, 0, 0, 0, 0, 1340, 1399, 0, 0,
-	0, 1387, 1391, 1390, 0, 1372, 0, 0, 1350, 1397,
-	81, 0, 1396, 1398, 1383, 0, 51, 0, 1404, 0,
-	1358, 1359, 0, 1360, 0, 0, 1362, 81, 1364, 0,
-	0, 0, 0, 0, 691, 81, 1400, 1401, 1402, 0,
-	0, 0, 0, 0, 0, 0, 0, 1414, 0, 1416,
-	1417, 1418, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 262, 0, 0, 262, 0, 0, 0, 0, 0,
-	262, 915, 0, 548, 632, 0, 262, 0, 1430, 0,
-	81, 0, 0, 1408, 0, 81, 274, 264, 0, 839,
-	0, 81, 81, 81, 264, 1450, 81, 1425, 81, 0,
-	0, 1454, 1455, 1456, 0, 1449, 0, 0, 1458, 0,
-	284, 0, 0, 0, 1457, 0, 0, 81, 264, 0,
-	1472, 1464, 1473, 1474, 1475, 1471, 0, 1481, 0, 0,
-	690, 0, 0, 0, 0, 0, 0, 81, 81, 1382,
-	0, 0, 0, 0, 0, 0, 0, 895, 897, 1496,
-	0, 0, 0, 1498, 0, 0, 0, 81, 1310, 1478,
-	0, 267, 0, 1508, 1199, 393, 0, 1506, 270, 0,
-	81, 81, 0, 0, 0, 0, 278, 0, 273, 1383,
-	0, 1522, 1499, 1523, 0, 0, 0, 262, 262, 262,
-	1526, 0, 0, 0, 1382, 393, 0, 1532, 0, 0,
-	0, 0, 0, 0, 264, 0, 1482, 1533, 0, 0,
-	276, 0, 81, 0, 0, 0, 283, 0, 0, 0,
-	1541, 0, 0, 393, 0, 81, 1545, 0, 0, 318,
-	1126, 1376, 1425, 1310, 1383, 1551, 51, 1549, 0, 81,
-	1371, 0, 0, 268, 0, 1421, 0, 0, 0, 1553,
-	0, 0, 1564, 0, 0, 0, 393, 0, 0, 1575,
-	0, 0, 0, 0, 0, 915, 1282, 0, 0, 611,
-	610, 620, 621, 613, 614, 615, 616, 617, 618, 619,
-	612, 0, 0, 622, 280, 271, 0, 281, 282, 287,
-	0, 0, 1536, 272, 1562, 275, 1282, 269, 286, 285,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 393, 1315, 0, 0, 611, 610, 620, 621, 613,
-	614, 615, 616, 617, 618, 619, 612, 262, 0, 622,
-	0, 0, 0, 0, 0, 0, 0, 262, 262, 0,
-	0, 0, 0, 262, 393, 0, 262, 314, 0, 262,
-	0, 0, 0, 806, 0, 0, 0, 863, 0, 0,
-	0, 0, 0, 262, 0, 0, 1342, 0, 0, 1347,
-	1348, 1349, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 393, 0, 0, 0, 0, 0, 0, 0, 1089,
-	0, 0, 0, 1090, 0, 0, 0, 0, 0, 0,
-	1094, 1095, 1096, 262, 0, 0, 0, 1102, 0, 0,
-	1105, 1106, 806, 0, 0, 0, 1112, 0, 0, 0,
-	1114, 0, 1386, 1117, 1118, 1119, 1120, 915, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1370, 0,
-	0, 915, 0, 690, 0, 1141, 0, 0, 690, 0,
-	0, 0, 690, 0, 315, 0, 0, 0, 0, 315,
-	315, 0, 1410, 315, 315, 315, 0, 0, 0, 916,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 393,
-	0, 0, 0, 0, 0, 0, 0, 393, 315, 315,
-	315, 315, 0, 262, 0, 1369, 0, 0, 0, 0,
-	0, 262, 0, 950, 0, 0, 262, 262, 0, 0,
-	262, 958, 806, 611, 610, 620, 621, 613, 614, 615,
-	616, 617, 618, 619, 612, 0, 0, 622, 0, 0,
-	0, 0, 1448, 0, 0, 0, 0, 1410, 0, 0,
-	0, 0, 0, 1410, 1410, 1410, 0, 0, 393, 0,
-	1315, 0, 0, 0, 0, 0, 0, 0, 636, 637,
-	638, 639, 640, 641, 642, 643, 0, 0, 0, 1410,
-	611, 610, 620, 621, 613, 614, 615, 616, 617, 618,
-	619, 612, 0, 0, 622, 0, 0, 0, 0, 1500,
-	1501, 0, 0, 1249, 262, 0, 0, 0, 0, 0,
-	0, 262, 262, 262, 262, 262, 0, 262, 262, 1514,
-	0, 262, 0, 0, 0, 0, 0, 863, 0, 0,
-	0, 0, 393, 393, 0, 0, 0, 0, 262, 0,
-	1059, 1060, 0, 0, 0, 0, 262, 0, 0, 0,
-	0, 0, 0, 806, 1295, 0, 1368, 0, 581, 585,
-	0, 0, 0, 0, 0, 315, 0, 0, 0, 0,
-	0, 0, 0, 0, 1540, 603, 0, 0, 24, 25,
-	52, 27, 28, 0, 915, 0, 0, 1547, 0, 0,
-	0, 690, 690, 690, 690, 690, 0, 0, 43, 0,
-	0, 1410, 0, 29, 48, 49, 690, 0, 0, 0,
-	647, 0, 315, 0, 0, 690, 0, 0, 0, 658,
-	0, 0, 0, 38, 0, 0, 0, 54, 0, 0,
-	315, 611, 610, 620, 621, 613, 614, 615, 616, 617,
-	618, 619, 612, 0, 0, 622, 0, 0, 916, 262,
-	262, 262, 262, 262, 0, 0, 1355, 0, 0, 0,
-	0, 1140, 0, 1357, 262, 0, 0, 0, 0, 950,
-	0, 0, 0, 262, 1366, 1367, 1246, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 31, 32,
-	34, 33, 36, 1388, 50, 0, 611, 610, 620, 621,
-	613, 614, 615, 616, 617, 618, 619, 612, 0, 0,
-	622, 0, 0, 0, 1403, 0, 0, 37, 44, 45,
-	0, 0, 46, 47, 35, 0, 611, 610, 620, 621,
-	613, 614, 615, 616, 617, 618, 619, 612, 39, 40,
-	622, 41, 42, 1088, 860, 0, 0, 869, 870, 871,
-	872, 873, 874, 875, 876, 877, 878, 879, 880, 881,
-	882, 883, 0, 611, 610, 620, 621, 613, 614, 615,
-	616, 617, 618, 619, 612, 0, 0, 622, 0, 0,
-	0, 0, 0, 0, 0, 1437, 0, 0, 0, 0,
-	0, 262, 0, 0, 0, 1443, 1444, 1445, 0, 0,
-	0, 315, 918, 0, 0, 0, 0, 0, 0, 0,
-	1452, 1453, 315, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 822, 0, 0,
-	0, 0, 0, 806, 0, 0, 0, 0, 53, 0,
-	0, 0, 916, 0, 1486, 1487, 1488, 1489, 0, 0,
-	0, 1493, 1494, 848, 849, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1503, 1504, 1505, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 742,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1527, 690, 0, 0, 647, 0, 744, 901,
-	902, 0, 0, 0, 0, 0, 0, 0, 262, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1538, 0, 0, 0, 0, 0, 262, 0, 0,
-	0, 0, 0, 0, 0, 1546, 0, 0, 0, 262,
-	0, 0, 0, 0, 0, 0, 0, 728, 0, 690,
-	0, 262, 0, 0, 0, 0, 0, 0, 968, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1576, 1577, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 745, 0, 0, 0,
-	0, 0, 0, 0, 916, 0, 1083, 1084, 1085, 0,
-	0, 0, 0, 0, 0, 0, 0, 262, 916, 758,
-	761, 762, 763, 764, 765, 766, 0, 775, 776, 777,
-	778, 779, 746, 747, 748, 749, 726, 727, 759, 0,
-	729, 0, 730, 731, 732, 733, 734, 735, 736, 737,
-	738, 739, 750, 751, 752, 753, 754, 755, 756, 757,
-	767, 768, 769, 770, 771, 772, 773, 774, 780, 781,
-	740, 741, 0, 743, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 606, 0, 609, 0, 1066,
-	1067, 0, 585, 623, 624, 625, 626, 627, 628, 629,
-	0, 607, 608, 605, 611, 610, 620, 621, 613, 614,
-	615, 616, 617, 618, 619, 612, 1451, 0, 622, 0,
-	0, 0, 0, 950, 0, 760, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 262, 0, 0,
-	0, 0, 0, 0, 1093, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1111, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1146, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1247, 1248, 0,
-	0, 0, 0, 262, 0, 0, 0, 0, 0, 0,
-	1264, 1265, 0, 1266, 1267, 0, 0, 0, 0, 0,
-	0, 916, 0, 0, 0, 1274, 1275, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1235, 0, 0, 1322,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1271, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1356, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1304, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1432, 1433,
-	1434, 1435, 1436, 0, 0, 0, 1439, 1440, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1375, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1392, 0, 0, 1393, 0, 0, 1395,
-	0, 0, 0, 0, 1146, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 647, 502, 490, 0, 447, 505, 421, 437, 513,
-	438, 441, 478, 406, 460, 165, 435, 515, 0, 425,
-	401, 431, 402, 423, 449, 111, 453, 420, 492, 463,
-	504, 137, 511, 139, 469, 0, 211, 153, 0, 0,
-	451, 494, 458, 487, 446, 479, 411, 468, 506, 436,
-	476, 507, 0, 0, 0, 80, 0, 1311, 1312, 0,
-	0, 0, 1568, 0, 101, 0, 473, 501, 433, 475,
-	477, 400, 470, 0, 404, 407, 512, 497, 428, 429,
-	0, 0, 0, 0, 0, 0, 0, 450, 459, 484,
-	444, 0, 0, 0, 0, 0, 0, 0, 0, 426,
-	0, 467, 1525, 647, 0, 408, 405, 0, 0, 448,
-	0, 0, 0, 410, 0, 427, 485, 0, 398, 119,
-	489, 496, 0, 445, 265, 500, 443, 442, 503, 184,
-	0, 215, 122, 136, 97, 83, 93, 0, 121, 162,
-	191, 195, 493, 424, 432, 105, 430, 193, 172, 231,
-	466, 174, 192, 140, 221, 185, 230, 240, 241, 218,
-	238, 245, 208, 86, 217, 229, 102, 203, 88, 227,
-	214, 151, 131, 132, 87, 0, 189, 110, 117, 107,
-	164, 224, 225, 106, 248, 94, 237, 90, 95, 236,
-	158, 220, 228, 152, 145, 89, 226, 150, 144, 135,
-	114, 124, 182, 142, 183, 125, 155, 154, 156, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 403, 0, 212, 234, 249, 99,
-	419, 219, 243, 244, 0, 0, 100, 118, 113, 0,
-	181, 157, 96, 127, 209, 134, 141, 188, 247, 171,
-	194, 103, 233, 210, 415, 418, 413, 414, 461, 462,
-	508, 509, 510, 486, 409, 0, 416, 417, 0, 491,
-	498, 499, 465, 82, 91, 138, 246, 186, 116, 235,
-	399, 412, 109, 422, 0, 0, 434, 439, 440, 452,
-	454, 455, 456, 457, 464, 471, 472, 474, 480, 481,
-	482, 483, 488, 495, 514, 84, 85, 92, 98, 104,
-	108, 112, 115, 120, 123, 126, 128, 129, 130, 133,
-	143, 146, 147, 148, 149, 159, 160, 161, 163, 166,
-	167, 168, 169, 170, 173, 175, 176, 177, 178, 179,
-	180, 187, 190, 196, 197, 198, 199, 200, 201, 202,
-	204, 205, 206, 207, 213, 216, 222, 223, 232, 239,
-	242, 502, 490, 0, 447, 505, 421, 437, 513, 438,
-	441, 478, 406, 460, 165, 435, 515, 0, 425, 401,
-	431, 402, 423, 449, 111, 453, 420, 492, 463, 504,
-	137, 511, 139, 469, 0, 211, 153, 0, 0, 451,
-	494, 458, 487, 446, 479, 411, 468, 506, 436, 476,
-	507, 54, 0, 0, 80, 0, 0, 0, 0, 0,
-	0, 0, 0, 101, 0, 473, 501, 433, 475, 477,
-	400, 470, 0, 404, 407, 512, 497, 428, 429, 0,
-	0, 0, 0, 0, 0, 0, 450, 459, 484, 444,
-	0, 0, 0, 0, 0, 0, 0, 0, 426, 0,
-	467, 0, 0, 0, 408, 405, 0, 0, 448, 0,
-	0, 0, 410, 0, 427, 485, 0, 398, 119, 489,
-	496, 0, 445, 265, 500, 443, 442, 503, 184, 0,
-	215, 122, 136, 97, 83, 93, 0, 121, 162, 191,
-	195, 493, 424, 432, 105, 430, 193, 172, 231, 466,
-	174, 192, 140, 221, 185, 230, 240, 241, 218, 238,
-	245, 208, 86, 217, 229, 102, 203, 88, 227, 214,
-	151, 131, 132, 87, 0, 189, 110, 117, 107, 164,
-	224, 225, 106, 248, 94, 237, 90, 95, 236, 158,
-	220, 228, 152, 145, 89, 226, 150, 144, 135, 114,
-	124, 182, 142, 183, 125, 155, 154, 156, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 403, 0, 212, 234, 249, 99, 419,
-	219, 243, 244, 0, 0, 100, 118, 113, 0, 181,
-	157, 96, 127, 209, 134, 141, 188, 247, 171, 194,
-	103, 233, 210, 415, 418, 413, 414, 461, 462, 508,
-	509, 510, 486, 409, 0, 416, 417, 0, 491, 498,
-	499, 465, 82, 91, 138, 246, 186, 116, 235, 399,
-	412, 109, 422, 0, 0, 434, 439, 440, 452, 454,
-	455, 456, 457, 464, 471, 472, 474, 480, 481, 482,
-	483, 488, 495, 514, 84, 85, 92, 98, 104, 108,
-	112, 115, 120, 123, 126, 128, 129, 130, 133, 143,
-	146, 147, 148, 149, 159, 160, 161, 163, 166, 167,
-	168, 169, 170, 173, 175, 176, 177, 178, 179, 180,
-	187, 190, 196, 197, 198, 199, 200, 201, 202, 204,
-	205, 206, 207, 213, 216, 222, 223, 232, 239, 242,
-	502, 490, 0, 447, 505, 421, 437, 513, 438, 441,
-	478, 406, 460, 165, 435, 515, 0, 425, 401, 431,
-	402, 423, 449, 111, 453, 420, 492, 463, 504, 137,
-	511, 139, 469, 0, 211, 153, 0, 0, 451, 494,
-	458, 487, 446, 479, 411, 468, 506, 436, 476, 507,
-	0, 0, 0, 80, 0, 0, 0, 0, 0, 0,
-	0, 0, 101, 0, 473, 501, 433, 475, 477, 400,
-	470, 0, 404, 407, 512, 497, 428, 429, 0, 0,
-	0, 0, 0, 0, 0, 450, 459, 484, 444, 0,
-	0, 0, 0, 0, 0, 1242, 0, 426, 0, 467,
-	0, 0, 0, 408, 405, 0, 0, 448, 0, 0,
-	0, 410, 0, 427, 485, 0, 398, 119, 489, 496,
-	0, 445, 265, 500, 443, 442, 503, 184, 0, 215,
-	122, 136, 97, 83, 93, 0, 121, 162, 191, 195,
-	493, 424, 432, 105, 430, 193, 172, 231, 466, 174,
-	192, 140, 221, 185, 230, 240, 241, 218, 238, 245,
-	208, 86, 217, 229, 102, 203, 88, 227, 214, 151,
-	131, 132, 87, 0, 189, 110, 117, 107, 164, 224,
-	225, 106, 248, 94, 237, 90, 95, 236, 158, 220,
-	228, 152, 145, 89, 226, 150, 144, 135, 114, 124,
-	182, 142, 183, 125, 155, 154, 156, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 403, 0, 212, 234, 249, 99, 419, 219,
-	243, 244, 0, 0, 100, 118, 113, 0, 181, 157,
-	96, 127, 209, 134, 141, 188, 247, 171, 194, 103,
-	233, 210, 415, 418, 413, 414, 461, 462, 508, 509,
-	510, 486, 409, 0, 416, 417, 0, 491, 498, 499,
-	465, 82, 91, 138, 246, 186, 116, 235, 399, 412,
-	109, 422, 0, 0, 434, 439, 440, 452, 454, 455,
-	456, 457, 464, 471, 472, 474, 480, 481, 482, 483,
-	488, 495, 514, 84, 85, 92, 98, 104, 108, 112,
-	115, 120, 123, 126, 128, 129, 130, 133, 143, 146,
-	147, 148, 149, 159, 160, 161, 163, 166, 167, 168,
-	169, 170, 173, 175, 176, 177, 178, 179, 180, 187,
-	190, 196, 197, 198, 199, 200, 201, 202, 204, 205,
-	206, 207, 213, 216, 222, 223, 232, 239, 242, 502,
-	490, 0, 447, 505, 421, 437, 513, 438, 441, 478,
-	406, 460, 165, 435, 515, 0, 425, 401, 431, 402,
-	423, 449, 111, 453, 420, 492, 463, 504, 137, 511,
-	139, 469, 0, 211, 153, 0, 0, 451, 494, 458,
-	487, 446, 479, 411, 468, 506, 436, 476, 507, 0,
-	0, 0, 263, 0, 0, 0, 0, 0, 0, 0,
-	0, 101, 0, 473, 501, 433, 475, 477, 400, 470,
-	0, 404, 407, 512, 497, 428, 429, 0, 0, 0,
-	0, 0, 0, 0, 450, 459, 484, 444, 0, 0,
-	0, 0, 0, 0, 959, 0, 426, 0, 467, 0,
-	0, 0, 408, 405, 0, 0, 448, 0, 0, 0,
-	410, 0, 427, 485, 0, 398, 119, 489, 496, 0,
-	445, 265, 500, 443, 442, 503, 184, 0, 215, 122,
-	136, 97, 83, 93, 0, 121, 162, 191, 195, 493,
-	424, 432, 105, 430, 193, 172, 231, 466, 174, 192,
-	140, 221, 185, 230, 240, 241, 218, 238, 245, 208,
-	86, 217, 229, 102, 203, 88, 227, 214, 151, 131,
-	132, 87, 0, 189, 110, 117, 107, 164, 224, 225,
-	106, 248, 94, 237, 90, 95, 236, 158, 220, 228,
-	152, 145, 89, 226, 150, 144, 135, 114, 124, 182,
-	142, 183, 125, 155, 154, 156, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 403, 0, 212, 234, 249, 99, 419, 219, 243,
-	244, 0, 0, 100, 118, 113, 0, 181, 157, 96,
-	127, 209, 134, 141, 188, 247, 171, 194, 103, 233,
-	210, 415, 418, 413, 414, 461, 462, 508, 509, 510,
-	486, 409, 0, 416, 417, 0, 491, 498, 499, 465,
-	82, 91, 138, 246, 186, 116, 235, 399, 412, 109,
-	422, 0, 0, 434, 439, 440, 452, 454, 455, 456,
-	457, 464, 471, 472, 474, 480, 481, 482, 483, 488,
-	495, 514, 84, 85, 92, 98, 104, 108, 112, 115,
-	120, 123, 126, 128, 129, 130, 133, 143, 146, 147,
-	148, 149, 159, 160, 161, 163, 166, 167, 168, 169,
-	170, 173, 175, 176, 177, 178, 179, 180, 187, 190,
-	196, 197, 198, 199, 200, 201, 202, 204, 205, 206,
-	207, 213, 216, 222, 223, 232, 239, 242, 502, 490,
-	0, 447, 505, 421, 437, 513, 438, 441, 478, 406,
-	460, 165, 435, 515, 0, 425, 401, 431, 402, 423,
-	449, 111, 453, 420, 492, 463, 504, 137, 511, 139,
-	469, 0, 211, 153, 0, 0, 451, 494, 458, 487,
-	446, 479, 411, 468, 506, 436, 476, 507, 0, 0,
-	0, 320, 0, 0, 0, 0, 0, 0, 0, 0,
-	101, 0, 473, 501, 433, 475, 477, 400, 470, 0,
-	404, 407, 512, 497, 428, 429, 0, 0, 0, 0,
-	0, 0, 0, 450, 459, 484, 444, 0, 0, 0,
-	0, 0, 0, 856, 0, 426, 0, 467, 0, 0,
-	0, 408, 405, 0, 0, 448, 0, 0, 0, 410,
-	0, 427, 485, 0, 398, 119, 489, 496, 0, 445,
-	265, 500, 443, 442, 503, 184, 0, 215, 122, 136,
-	97, 83, 93, 0, 121, 162, 191, 195, 493, 424,
-	432, 105, 430, 193, 172, 231, 466, 174, 192, 140,
-	221, 185, 230, 240, 241, 218, 238, 245, 208, 86,
-	217, 229, 102, 203, 88, 227, 214, 151, 131, 132,
-	87, 0, 189, 110, 117, 107, 164, 224, 225, 106,
-	248, 94, 237, 90, 95, 236, 158, 220, 228, 152,
-	145, 89, 226, 150, 144, 135, 114, 124, 182, 142,
-	183, 125, 155, 154, 156, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	403, 0, 212, 234, 249, 99, 419, 219, 243, 244,
-	0, 0, 100, 118, 113, 0, 181, 157, 96, 127,
-	209, 134, 141, 188, 247, 171, 194, 103, 233, 210,
-	415, 418, 413, 414, 461, 462, 508, 509, 510, 486,
-	409, 0, 416, 417, 0, 491, 498, 499, 465, 82,
-	91, 138, 246, 186, 116, 235, 399, 412, 109, 422,
-	0, 0, 434, 439, 440, 452, 454, 455, 456, 457,
-	464, 471, 472, 474, 480, 481, 482, 483, 488, 495,
-	514, 84, 85, 92, 98, 104, 108, 112, 115, 120,
-	123, 126, 128, 129, 130, 133, 143, 146, 147, 148,
-	149, 159, 160, 161, 163, 166, 167, 168, 169, 170,
-	173, 175, 176, 177, 178, 179, 180, 187, 190, 196,
-	197, 198, 199, 200, 201, 202, 204, 205, 206, 207,
-	213, 216, 222, 223, 232, 239, 242, 502, 490, 0,
-	447, 505, 421, 437, 513, 438, 441, 478, 406, 460,
-	165, 435, 515, 0, 425, 401, 431, 402, 423, 449,
-	111, 453, 420, 492, 463, 504, 137, 511, 139, 469,
-	0, 211, 153, 0, 0, 451, 494, 458, 487, 446,
-	479, 411, 468, 506, 436, 476, 507, 0, 0, 0,
-	80, 0, 0, 0, 0, 0, 0, 0, 0, 101,
-	0, 473, 501, 433, 475, 477, 400, 470, 0, 404,
-	407, 512, 497, 428, 429, 0, 0, 0, 0, 0,
-	0, 0, 450, 459, 484, 444, 0, 0, 0, 0,
-	0, 0, 0, 0, 426, 0, 467, 0, 0, 0,
-	408, 405, 0, 0, 448, 0, 0, 0, 410, 0,
-	427, 485, 0, 398, 119, 489, 496, 0, 445, 265,
-	500, 443, 442, 503, 184, 0, 215, 122, 136, 97,
-	83, 93, 0, 121, 162, 191, 195, 493, 424, 432,
-	105, 430, 193, 172, 231, 466, 174, 192, 140, 221,
-	185, 230, 240, 241, 218, 238, 245, 208, 86, 217,
-	229, 102, 203, 88, 227, 214, 151, 131, 132, 87,
-	0, 189, 110, 117, 107, 164, 224, 225, 106, 248,
-	94, 237, 90, 95, 236, 158, 220, 228, 152, 145,
-	89, 226, 150, 144, 135, 114, 124, 182, 142, 183,
-	125, 155, 154, 156, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 403,
-	0, 212, 234, 249, 99, 419, 219, 243, 244, 0,
-	0, 100, 118, 113, 0, 181, 157, 96, 127, 209,
-	134, 141, 188, 247, 171, 194, 103, 233, 210, 415,
-	418, 413, 414, 461, 462, 508, 509, 510, 486, 409,
-	0, 416, 417, 0, 491, 498, 499, 465, 82, 91,
-	138, 246, 186, 116, 235, 399, 412, 109, 422, 0,
-	0, 434, 439, 440, 452, 454, 455, 456, 457, 464,
-	471, 472, 474, 480, 481, 482, 483, 488, 495, 514,
-	84, 85, 92, 98, 104, 108, 112, 115, 120, 123,
-	126, 128, 129, 130, 133, 143, 146, 147, 148, 149,
-	159, 160, 161, 163, 166, 167, 168, 169, 170, 173,
-	175, 176, 177, 178, 179, 180, 187, 190, 196, 197,
-	198, 199, 200, 201, 202, 204, 205, 206, 207, 213,
-	216, 222, 223, 232, 239, 242, 502, 490, 0, 447,
-	505, 421, 437, 513, 438, 441, 478, 406, 460, 165,
-	435, 515, 0, 425, 401, 431, 402, 423, 449, 111,
-	453, 420, 492, 463, 504, 137, 511, 139, 469, 0,
-	211, 153, 0, 0, 451, 494, 458, 487, 446, 479,
-	411, 468, 506, 436, 476, 507, 0, 0, 0, 320,
-	0, 0, 0, 0, 0, 0, 0, 0, 101, 0,
-	473, 501, 433, 475, 477, 400, 470, 0, 404, 407,
-	512, 497, 428, 429, 0, 0, 0, 0, 0, 0,
-	0, 450, 459, 484, 444, 0, 0, 0, 0, 0,
-	0, 0, 0, 426, 0, 467, 0, 0, 0, 408,
-	405, 0, 0, 448, 0, 0, 0, 410, 0, 427,
-	485, 0, 398, 119, 489, 496, 0, 445, 265, 500,
-	443, 442, 503, 184, 0, 215, 122, 136, 97, 83,
-	93, 0, 121, 162, 191, 195, 493, 424, 432, 105,
-	430, 193, 172, 231, 466, 174, 192, 140, 221, 185,
-	230, 240, 241, 218, 238, 245, 208, 86, 217, 229,
-	102, 203, 88, 227, 214, 151, 131, 132, 87, 0,
-	189, 110, 117, 107, 164, 224, 225, 106, 248, 94,
-	237, 90, 95, 236, 158, 220, 228, 152, 145, 89,
-	226, 150, 144, 135, 114, 124, 182, 142, 183, 125,
-	155, 154, 156, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 403, 0,
-	212, 234, 249, 99, 419, 219, 243, 244, 0, 0,
-	100, 118, 113, 0, 181, 157, 96, 127, 209, 134,
-	141, 188, 247, 171, 194, 103, 233, 210, 415, 418,
-	413, 414, 461, 462, 508, 509, 510, 486, 409, 0,
-	416, 417, 0, 491, 498, 499, 465, 82, 91, 138,
-	246, 186, 116, 235, 399, 412, 109, 422, 0, 0,
-	434, 439, 440, 452, 454, 455, 456, 457, 464, 471,
-	472, 474, 480, 481, 482, 483, 488, 495, 514, 84,
-	85, 92, 98, 104, 108, 112, 115, 120, 123, 126,
-	128, 129, 130, 133, 143, 146, 147, 148, 149, 159,
-	160, 161, 163, 166, 167, 168, 169, 170, 173, 175,
-	176, 177, 178, 179, 180, 187, 190, 196, 197, 198,
-	199, 200, 201, 202, 204, 205, 206, 207, 213, 216,
-	222, 223, 232, 239, 242, 502, 490, 0, 447, 505,
-	421, 437, 513, 438, 441, 478, 406, 460, 165, 435,
-	515, 0, 425, 401, 431, 402, 423, 449, 111, 453,
-	420, 492, 463, 504, 137, 511, 139, 469, 0, 211,
-	153, 0, 0, 451, 494, 458, 487, 446, 479, 411,
-	468, 506, 436, 476, 507, 0, 0, 0, 80, 0,
-	0, 0, 0, 0, 0, 0, 0, 101, 0, 473,
-	501, 433, 475, 477, 400, 470, 0, 404, 407, 512,
-	497, 428, 429, 0, 0, 0, 0, 0, 0, 0,
-	450, 459, 484, 444, 0, 0, 0, 0, 0, 0,
-	0, 0, 426, 0, 467, 0, 0, 0, 408, 405,
-	0, 0, 448, 0, 0, 0, 410, 0, 427, 485,
-	0, 398, 119, 489, 496, 0, 445, 265, 500, 443,
-	442, 503, 184, 0, 215, 122, 136, 97, 83, 93,
-	0, 121, 162, 191, 195, 493, 424, 432, 105, 430,
-	193, 172, 231, 466, 174, 192, 140, 221, 185, 230,
-	240, 241, 218, 238, 245, 208, 86, 217, 229, 102,
-	203, 88, 227, 214, 151, 131, 132, 87, 0, 189,
-	110, 117, 107, 164, 224, 225, 106, 248, 94, 237,
-	90, 396, 236, 158, 220, 228, 152, 145, 89, 226,
-	150, 144, 135, 114, 124, 182, 142, 183, 125, 155,
-	154, 156, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 403, 0, 212,
-	234, 249, 99, 419, 219, 243, 244, 0, 0, 100,
-	118, 113, 0, 181, 397, 395, 127, 209, 134, 141,
-	188, 247, 171, 194, 103, 233, 210, 415, 418, 413,
-	414, 461, 462, 508, 509, 510, 486, 409, 0, 416,
-	417, 0, 491, 498, 499, 465, 82, 91, 138, 246,
-	186, 116, 235, 399, 412, 109, 422, 0, 0, 434,
-	439, 440, 452, 454, 455, 456, 457, 464, 471, 472,
-	474, 480, 481, 482, 483, 488, 495, 514, 84, 85,
-	92, 98, 104, 108, 112, 115, 120, 123, 126, 128,
-	129, 130, 133, 143, 146, 147, 148, 149, 159, 160,
-	161, 163, 166, 167, 168, 169, 170, 173, 175, 176,
-	177, 178, 179, 180, 187, 190, 196, 197, 198, 199,
-	200, 201, 202, 204, 205, 206, 207, 213, 216, 222,
-	223, 232, 239, 242, 502, 490, 0, 447, 505, 421,
-	437, 513, 438, 441, 478, 406, 460, 165, 435, 515,
-	0, 425, 401, 431, 402, 423, 449, 111, 453, 420,
-	492, 463, 504, 137, 511, 139, 469, 0, 211, 153,
-	0, 0, 451, 494, 458, 487, 446, 479, 411, 468,
-	506, 436, 476, 507, 0, 0, 0, 263, 0, 0,
-	0, 0, 0, 0, 0, 0, 101, 0, 473, 501,
-	433, 475, 477, 400, 470, 0, 404, 407, 512, 497,
-	428, 429, 0, 0, 0, 0, 0, 0, 0, 450,
-	459, 484, 444, 0, 0, 0, 0, 0, 0, 0,
-	0, 426, 0, 467, 0, 0, 0, 408, 405, 0,
-	0, 448, 0, 0, 0, 410, 0, 427, 485, 0,
-	398, 119, 489, 496, 0, 445, 265, 500, 443, 442,
-	503, 184, 0, 215, 122, 136, 97, 83, 93, 0,
-	121, 162, 191, 195, 493, 424, 432, 105, 430, 193,
-	172, 231, 466, 174, 192, 140, 221, 185, 230, 240,
-	241, 218, 238, 245, 208, 86, 217, 229, 102, 203,
-	88, 227, 214, 151, 131, 132, 87, 0, 189, 110,
-	117, 107, 164, 224, 225, 106, 248, 94, 237, 90,
-	95, 236, 158, 220, 228, 152, 145, 89, 226, 150,
-	144, 135, 114, 124, 182, 142, 183, 125, 155, 154,
-	156, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 403, 0, 212, 234,
-	249, 99, 419, 219, 243, 244, 0, 0, 100, 118,
-	113, 0, 181, 157, 96, 127, 209, 134, 141, 188,
-	247, 171, 194, 103, 233, 210, 415, 418, 413, 414,
-	461, 462, 508, 509, 510, 486, 409, 0, 416, 417,
-	0, 491, 498, 499, 465, 82, 91, 138, 246, 186,
-	116, 235, 399, 412, 109, 422, 0, 0, 434, 439,
-	440, 452, 454, 455, 456, 457, 464, 471, 472, 474,
-	480, 481, 482, 483, 488, 495, 514, 84, 85, 92,
-	98, 104, 108, 112, 115, 120, 123, 126, 128, 129,
-	130, 133, 143, 146, 147, 148, 149, 159, 160, 161,
-	163, 166, 167, 168, 169, 170, 173, 175, 176, 177,
-	178, 179, 180, 187, 190, 196, 197, 198, 199, 200,
-	201, 202, 204, 205, 206, 207, 213, 216, 222, 223,
-	232, 239, 242, 502, 490, 0, 447, 505, 421, 437,
-	513, 438, 441, 478, 406, 460, 165, 435, 515, 0,
-	425, 401, 431, 402, 423, 449, 111, 453, 420, 492,
-	463, 504, 137, 511, 139, 469, 0, 211, 153, 0,
-	0, 451, 494, 458, 487, 446, 479, 411, 468, 506,
-	436, 476, 507, 0, 0, 0, 80, 0, 0, 0,
-	0, 0, 0, 0, 0, 101, 0, 473, 501, 433,
-	475, 477, 400, 470, 0, 404, 407, 512, 497, 428,
-	429, 0, 0, 0, 0, 0, 0, 0, 450, 459,
-	484, 444, 0, 0, 0, 0, 0, 0, 0, 0,
-	426, 0, 467, 0, 0, 0, 408, 405, 0, 0,
-	448, 0, 0, 0, 410, 0, 427, 485, 0, 398,
-	119, 489, 496, 0, 445, 265, 500, 443, 442, 503,
-	184, 0, 215, 122, 136, 97, 83, 93, 0, 121,
-	162, 191, 195, 493, 424, 432, 105, 430, 193, 172,
-	231, 466, 174, 192, 140, 221, 185, 230, 240, 241,
-	218, 238, 245, 208, 86, 217, 705, 102, 203, 88,
-	227, 214, 151, 131, 132, 87, 0, 189, 110, 117,
-	107, 164, 224, 225, 106, 248, 94, 237, 90, 396,
-	236, 158, 220, 228, 152, 145, 89, 226, 150, 144,
-	135, 114, 124, 182, 142, 183, 125, 155, 154, 156,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 403, 0, 212, 234, 249,
-	99, 419, 219, 243, 244, 0, 0, 100, 118, 113,
-	0, 181, 397, 395, 127, 209, 134, 141, 188, 247,
-	171, 194, 103, 233, 210, 415, 418, 413, 414, 461,
-	462, 508, 509, 510, 486, 409, 0, 416, 417, 0,
-	491, 498, 499, 465, 82, 91, 138, 246, 186, 116,
-	235, 399, 412, 109, 422, 0, 0, 434, 439, 440,
-	452, 454, 455, 456, 457, 464, 471, 472, 474, 480,
-	481, 482, 483, 488, 495, 514, 84, 85, 92, 98,
-	104, 108, 112, 115, 120, 123, 126, 128, 129, 130,
-	133, 143, 146, 147, 148, 149, 159, 160, 161, 163,
-	166, 167, 168, 169, 170, 173, 175, 176, 177, 178,
-	179, 180, 187, 190, 196, 197, 198, 199, 200, 201,
-	202, 204, 205, 206, 207, 213, 216, 222, 223, 232,
-	239, 242, 502, 490, 0, 447, 505, 421, 437, 513,
-	438, 441, 478, 406, 460, 165, 435, 515, 0, 425,
-	401, 431, 402, 423, 449, 111, 453, 420, 492, 463,
-	504, 137, 511, 139, 469, 0, 211, 153, 0, 0,
-	451, 494, 458, 487, 446, 479, 411, 468, 506, 436,
-	476, 507, 0, 0, 0, 80, 0, 0, 0, 0,
-	0, 0, 0, 0, 101, 0, 473, 501, 433, 475,
-	477, 400, 470, 0, 404, 407, 512, 497, 428, 429,
-	0, 0, 0, 0, 0, 0, 0, 450, 459, 484,
-	444, 0, 0, 0, 0, 0, 0, 0, 0, 426,
-	0, 467, 0, 0, 0, 408, 405, 0, 0, 448,
-	0, 0, 0, 410, 0, 427, 485, 0, 398, 119,
-	489, 496, 0, 445, 265, 500, 443, 442, 503, 184,
-	0, 215, 122, 136, 97, 83, 93, 0, 121, 162,
-	191, 195, 493, 424, 432, 105, 430, 193, 172, 231,
-	466, 174, 192, 140, 221, 185, 230, 240, 241, 218,
-	238, 245, 208, 86, 217, 387, 102, 203, 88, 227,
-	214, 151, 131, 132, 87, 0, 189, 110, 117, 107,
-	164, 224, 225, 106, 248, 94, 237, 90, 396, 236,
-	158, 220, 228, 152, 145, 89, 226, 150, 144, 135,
-	114, 124, 182, 142, 183, 125, 155, 154, 156, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 403, 0, 212, 234, 249, 99,
-	419, 219, 243, 244, 0, 0, 100, 118, 113, 0,
-	181, 397, 395, 390, 389, 134, 141, 188, 247, 171,
-	194, 103, 233, 210, 415, 418, 413, 414, 461, 462,
-	508, 509, 510, 486, 409, 0, 416, 417, 0, 491,
-	498, 499, 465, 82, 91, 138, 246, 186, 116, 235,
-	399, 412, 109, 422, 0, 0, 434, 439, 440, 452,
-	454, 455, 456, 457, 464, 471, 472, 474, 480, 481,
-	482, 483, 488, 495, 514, 84, 85, 92, 98, 104,
-	108, 112, 115, 120, 123, 126, 128, 129, 130, 133,
-	143, 146, 147, 148, 149, 159, 160, 161, 163, 166,
-	167, 168, 169, 170, 173, 175, 176, 177, 178, 179,
-	180, 187, 190, 196, 197, 198, 199, 200, 201, 202,
-	204, 205, 206, 207, 213, 216, 222, 223, 232, 239,
-	242, 165, 0, 0, 0, 0, 0, 322, 0, 0,
-	0, 111, 0, 319, 0, 0, 0, 137, 362, 139,
-	0, 0, 211, 153, 0, 0, 0, 0, 353, 354,
-	0, 0, 0, 0, 0, 0, 0, 0, 54, 0,
-	0, 320, 341, 340, 343, 344, 345, 346, 0, 0,
-	101, 342, 347, 348, 349, 0, 0, 0, 317, 334,
-	0, 361, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 331, 332, 0, 0, 0, 0, 375, 0, 333,
-	0, 0, 328, 329, 330, 335, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 119, 0, 1147, 1148, 0,
-	265, 0, 0, 373, 0, 184, 0, 215, 122, 136,
-	97, 83, 93, 0, 121, 162, 191, 195, 0, 0,
-	0, 105, 0, 193, 172, 231, 0, 174, 192, 140,
-	221, 185, 230, 240, 241, 218, 238, 245, 208, 86,
-	217, 229, 102, 203, 88, 227, 214, 151, 131, 132,
-	87, 0, 189, 110, 117, 107, 164, 224, 225, 106,
-	248, 94, 237, 90, 95, 236, 158, 220, 228, 152,
-	145, 89, 226, 150, 144, 135, 114, 124, 182, 142,
-	183, 125, 155, 154, 156, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 212, 234, 249, 99, 0, 219, 243, 244,
-	0, 0, 100, 118, 113, 0, 181, 157, 96, 127,
-	209, 134, 141, 188, 247, 171, 194, 103, 233, 210,
-	363, 374, 369, 370, 367, 368, 366, 365, 364, 376,
-	355, 356, 357, 358, 360, 0, 371, 372, 359, 82,
-	91, 138, 246, 186, 116, 235, 0, 0, 109, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 84, 85, 92, 98, 104, 108, 112, 115, 120,
-	123, 126, 128, 129, 130, 133, 143, 146, 147, 148,
-	149, 159, 160, 161, 163, 166, 167, 168, 169, 170,
-	173, 175, 176, 177, 178, 179, 180, 187, 190, 196,
-	197, 198, 199, 200, 201, 202, 204, 205, 206, 207,
-	213, 216, 222, 223, 232, 239, 242, 165, 0, 0,
-	0, 0, 0, 322, 0, 0, 0, 111, 0, 319,
-	0, 0, 0, 137, 362, 139, 0, 0, 211, 153,
-	0, 0, 0, 0, 353, 354, 0, 0, 0, 0,
-	0, 0, 966, 0, 54, 0, 0, 320, 341, 340,
-	343, 344, 345, 346, 0, 0, 101, 342, 347, 348,
-	349, 967, 0, 0, 317, 334, 0, 361, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 331, 332, 0,
-	0, 0, 0, 375, 0, 333, 0, 0, 328, 329,
-	330, 335, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 119, 0, 0, 0, 0, 265, 0, 0, 373,
-	0, 184, 0, 215, 122, 136, 97, 83, 93, 0,
-	121, 162, 191, 195, 0, 0, 0, 105, 0, 193,
-	172, 231, 0, 174, 192, 140, 221, 185, 230, 240,
-	241, 218, 238, 245, 208, 86, 217, 229, 102, 203,
-	88, 227, 214, 151, 131, 132, 87, 0, 189, 110,
-	117, 107, 164, 224, 225, 106, 248, 94, 237, 90,
-	95, 236, 158, 220, 228, 152, 145, 89, 226, 150,
-	144, 135, 114, 124, 182, 142, 183, 125, 155, 154,
-	156, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 212, 234,
-	249, 99, 0, 219, 243, 244, 0, 0, 100, 118,
-	113, 0, 181, 157, 96, 127, 209, 134, 141, 188,
-	247, 171, 194, 103, 233, 210, 363, 374, 369, 370,
-	367, 368, 366, 365, 364, 376, 355, 356, 357, 358,
-	360, 0, 371, 372, 359, 82, 91, 138, 246, 186,
-	116, 235, 0, 0, 109, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 84, 85, 92,
-	98, 104, 108, 112, 115, 120, 123, 126, 128, 129,
-	130, 133, 143, 146, 147, 148, 149, 159, 160, 161,
-	163, 166, 167, 168, 169, 170, 173, 175, 176, 177,
-	178, 179, 180, 187, 190, 196, 197, 198, 199, 200,
-	201, 202, 204, 205, 206, 207, 213, 216, 222, 223,
-	232, 239, 242, 165, 0, 0, 0, 892, 0, 322,
-	0, 0, 0, 111, 0, 319, 0, 0, 0, 137,
-	362, 139, 0, 0, 211, 153, 0, 0, 0, 0,
-	353, 354, 0, 0, 0, 0, 0, 0, 0, 0,
-	54, 0, 0, 320, 341, 340, 343, 344, 345, 346,
-	0, 0, 101, 342, 347, 348, 349, 0, 0, 0,
-	317, 334, 0, 361, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 331, 332, 313, 0, 0, 0, 375,
-	0, 333, 0, 0, 328, 329, 330, 335, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 119, 0, 0,
-	0, 0, 265, 0, 0, 373, 0, 184, 0, 215,
-	122, 136, 97, 83, 93, 0, 121, 162, 191, 195,
-	0, 0, 0, 105, 0, 193, 172, 231, 0, 174,
-	192, 140, 221, 185, 230, 240, 241, 218, 238, 245,
-	208, 86, 217, 229, 102, 203, 88, 227, 214, 151,
-	131, 132, 87, 0, 189, 110, 117, 107, 164, 224,
-	225, 106, 248, 94, 237, 90, 95, 236, 158, 220,
-	228, 152, 145, 89, 226, 150, 144, 135, 114, 124,
-	182, 142, 183, 125, 155, 154, 156, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 212, 234, 249, 99, 0, 219,
-	243, 244, 0, 0, 100, 118, 113, 0, 181, 157,
-	96, 127, 209, 134, 141, 188, 247, 171, 194, 103,
-	233, 210, 363, 374, 369, 370, 367, 368, 366, 365,
-	364, 376, 355, 356, 357, 358, 360, 0, 371, 372,
-	359, 82, 91, 138, 246, 186, 116, 235, 0, 0,
-	109, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 84, 85, 92, 98, 104, 108, 112,
-	115, 120, 123, 126, 128, 129, 130, 133, 143, 146,
-	147, 148, 149, 159, 160, 161, 163, 166, 167, 168,
-	169, 170, 173, 175, 176, 177, 178, 179, 180, 187,
-	190, 196, 197, 198, 199, 200, 201, 202, 204, 205,
-	206, 207, 213, 216, 222, 223, 232, 239, 242, 165,
-	0, 0, 0, 0, 0, 322, 0, 0, 0, 111,
-	0, 319, 0, 0, 0, 137, 362, 139, 0, 0,
-	211, 153, 0, 0, 0, 0, 353, 354, 0, 0,
-	0, 0, 0, 0, 0, 0, 54, 0, 577, 320,
-	341, 340, 343, 344, 345, 346, 0, 0, 101, 342,
-	347, 348, 349, 0, 0, 0, 317, 334, 0, 361,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 331,
-	332, 0, 0, 0, 0, 375, 0, 333, 0, 0,
-	328, 329, 330, 335, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 119, 0, 0, 0, 0, 265, 0,
-	0, 373, 0, 184, 0, 215, 122, 136, 97, 83,
-	93, 0, 121, 162, 191, 195, 0, 0, 0, 105,
-	0, 193, 172, 231, 0, 174, 192, 140, 221, 185,
-	230, 240, 241, 218, 238, 245, 208, 86, 217, 229,
-	102, 203, 88, 227, 214, 151, 131, 132, 87, 0,
-	189, 110, 117, 107, 164, 224, 225, 106, 248, 94,
-	237, 90, 95, 236, 158, 220, 228, 152, 145, 89,
-	226, 150, 144, 135, 114, 124, 182, 142, 183, 125,
-	155, 154, 156, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	212, 234, 249, 99, 0, 219, 243, 244, 0, 0,
-	100, 118, 113, 0, 181, 157, 96, 127, 209, 134,
-	141, 188, 247, 171, 194, 103, 233, 210, 363, 374,
-	369, 370, 367, 368, 366, 365, 364, 376, 355, 356,
-	357, 358, 360, 0, 371, 372, 359, 82, 91, 138,
-	246, 186, 116, 235, 0, 0, 109, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 84,
-	85, 92, 98, 104, 108, 112, 115, 120, 123, 126,
-	128, 129, 130, 133, 143, 146, 147, 148, 149, 159,
-	160, 161, 163, 166, 167, 168, 169, 170, 173, 175,
-	176, 177, 178, 179, 180, 187, 190, 196, 197, 198,
-	199, 200, 201, 202, 204, 205, 206, 207, 213, 216,
-	222, 223, 232, 239, 242, 165, 0, 0, 0, 0,
-	0, 322, 0, 0, 0, 111, 0, 319, 0, 0,
-	0, 137, 362, 139, 0, 0, 211, 153, 0, 0,
-	0, 0, 353, 354, 0, 0, 0, 0, 0, 0,
-	0, 0, 54, 0, 0, 320, 341, 340, 343, 344,
-	345, 346, 0, 0, 101, 342, 347, 348, 349, 0,
-	0, 0, 317, 334, 0, 361, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 331, 332, 313, 0, 0,
-	0, 375, 0, 333, 0, 0, 328, 329, 330, 335,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 119,
-	0, 0, 0, 0, 265, 0, 0, 373, 0, 184,
-	0, 215, 122, 136, 97, 83, 93, 0, 121, 162,
-	191, 195, 0, 0, 0, 105, 0, 193, 172, 231,
-	0, 174, 192, 140, 221, 185, 230, 240, 241, 218,
-	238, 245, 208, 86, 217, 229, 102, 203, 88, 227,
-	214, 151, 131, 132, 87, 0, 189, 110, 117, 107,
-	164, 224, 225, 106, 248, 94, 237, 90, 95, 236,
-	158, 220, 228, 152, 145, 89, 226, 150, 144, 135,
-	114, 124, 182, 142, 183, 125, 155, 154, 156, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 212, 234, 249, 99,
-	0, 219, 243, 244, 0, 0, 100, 118, 113, 0,
-	181, 157, 96, 127, 209, 134, 141, 188, 247, 171,
-	194, 103, 233, 210, 363, 374, 369, 370, 367, 368,
-	366, 365, 364, 376, 355, 356, 357, 358, 360, 0,
-	371, 372, 359, 82, 91, 138, 246, 186, 116, 235,
-	0, 0, 109, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 84, 85, 92, 98, 104,
-	108, 112, 115, 120, 123, 126, 128, 129, 130, 133,
-	143, 146, 147, 148, 149, 159, 160, 161, 163, 166,
-	167, 168, 169, 170, 173, 175, 176, 177, 178, 179,
-	180, 187, 190, 196, 197, 198, 199, 200, 201, 202,
-	204, 205, 206, 207, 213, 216, 222, 223, 232, 239,
-	242, 165, 0, 0, 0, 0, 0, 322, 0, 0,
-	0, 111, 0, 319, 0, 0, 0, 137, 362, 139,
-	0, 0, 211, 153, 0, 0, 0, 0, 353, 354,
-	0, 0, 0, 0, 0, 0, 0, 0, 54, 0,
-	0, 320, 341, 907, 343, 344, 345, 346, 0, 0,
-	101, 342, 347, 348, 349, 0, 0, 0, 317, 334,
-	0, 361, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 331, 332, 313, 0, 0, 0, 375, 0, 333,
-	0, 0, 328, 329, 330, 335, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 119, 0, 0, 0, 0,
-	265, 0, 0, 373, 0, 184, 0, 215, 122, 136,
-	97, 83, 93, 0, 121, 162, 191, 195, 0, 0,
-	0, 105, 0, 193, 172, 231, 0, 174, 192, 140,
-	221, 185, 230, 240, 241, 218, 238, 245, 208, 86,
-	217, 229, 102, 203, 88, 227, 214, 151, 131, 132,
-	87, 0, 189, 110, 117, 107, 164, 224, 225, 106,
-	248, 94, 237, 90, 95, 236, 158, 220, 228, 152,
-	145, 89, 226, 150, 144, 135, 114, 124, 182, 142,
-	183, 125, 155, 154, 156, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 212, 234, 249, 99, 0, 219, 243, 244,
-	0, 0, 100, 118, 113, 0, 181, 157, 96, 127,
-	209, 134, 141, 188, 247, 171, 194, 103, 233, 210,
-	363, 374, 369, 370, 367, 368, 366, 365, 364, 376,
-	355, 356, 357, 358, 360, 0, 371, 372, 359, 82,
-	91, 138, 246, 186, 116, 235, 0, 0, 109, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 84, 85, 92, 98, 104, 108, 112, 115, 120,
-	123, 126, 128, 129, 130, 133, 143, 146, 147, 148,
-	149, 159, 160, 161, 163, 166, 167, 168, 169, 170,
-	173, 175, 176, 177, 178, 179, 180, 187, 190, 196,
-	197, 198, 199, 200, 201, 202, 204, 205, 206, 207,
-	213, 216, 222, 223, 232, 239, 242, 165, 0, 0,
-	0, 0, 0, 322, 0, 0, 0, 111, 0, 319,
-	0, 0, 0, 137, 362, 139, 0, 0, 211, 153,
-	0, 0, 0, 0, 353, 354, 0, 0, 0, 0,
-	0, 0, 0, 0, 54, 0, 0, 320, 341, 904,
-	343, 344, 345, 346, 0, 0, 101, 342, 347, 348,
-	349, 0, 0, 0, 317, 334, 0, 361, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 331, 332, 313,
-	0, 0, 0, 375, 0, 333, 0, 0, 328, 329,
-	330, 335, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 119, 0, 0, 0, 0, 265, 0, 0, 373,
-	0, 184, 0, 215, 122, 136, 97, 83, 93, 0,
-	121, 162, 191, 195, 0, 0, 0, 105, 0, 193,
-	172, 231, 0, 174, 192, 140, 221, 185, 230, 240,
-	241, 218, 238, 245, 208, 86, 217, 229, 102, 203,
-	88, 227, 214, 151, 131, 132, 87, 0, 189, 110,
-	117, 107, 164, 224, 225, 106, 248, 94, 237, 90,
-	95, 236, 158, 220, 228, 152, 145, 89, 226, 150,
-	144, 135, 114, 124, 182, 142, 183, 125, 155, 154,
-	156, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 212, 234,
-	249, 99, 0, 219, 243, 244, 0, 0, 100, 118,
-	113, 0, 181, 157, 96, 127, 209, 134, 141, 188,
-	247, 171, 194, 103, 233, 210, 363, 374, 369, 370,
-	367, 368, 366, 365, 364, 376, 355, 356, 357, 358,
-	360, 0, 371, 372, 359, 82, 91, 138, 246, 186,
-	116, 235, 0, 0, 109, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 84, 85, 92,
-	98, 104, 108, 112, 115, 120, 123, 126, 128, 129,
-	130, 133, 143, 146, 147, 148, 149, 159, 160, 161,
-	163, 166, 167, 168, 169, 170, 173, 175, 176, 177,
-	178, 179, 180, 187, 190, 196, 197, 198, 199, 200,
-	201, 202, 204, 205, 206, 207, 213, 216, 222, 223,
-	232, 239, 242, 24, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 165, 0, 0, 0, 0,
-	0, 322, 0, 0, 0, 111, 0, 319, 0, 0,
-	0, 137, 362, 139, 0, 0, 211, 153, 0, 0,
-	0, 0, 353, 354, 0, 0, 0, 0, 0, 0,
-	0, 0, 54, 0, 0, 320, 341, 340, 343, 344,
-	345, 346, 0, 0, 101, 342, 347, 348, 349, 0,
-	0, 0, 317, 334, 0, 361, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 331, 332, 0, 0, 0,
-	0, 375, 0, 333, 0, 0, 328, 329, 330, 335,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 119,
-	0, 0, 0, 0, 265, 0, 0, 373, 0, 184,
-	0, 215, 122, 136, 97, 83, 93, 0, 121, 162,
-	191, 195, 0, 0, 0, 105, 0, 193, 172, 231,
-	0, 174, 192, 140, 221, 185, 230, 240, 241, 218,
-	238, 245, 208, 86, 217, 229, 102, 203, 88, 227,
-	214, 151, 131, 132, 87, 0, 189, 110, 117, 107,
-	164, 224, 225, 106, 248, 94, 237, 90, 95, 236,
-	158, 220, 228, 152, 145, 89, 226, 150, 144, 135,
-	114, 124, 182, 142, 183, 125, 155, 154, 156, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 212, 234, 249, 99,
-	0, 219, 243, 244, 0, 0, 100, 118, 113, 0,
-	181, 157, 96, 127, 209, 134, 141, 188, 247, 171,
-	194, 103, 233, 210, 363, 374, 369, 370, 367, 368,
-	366, 365, 364, 376, 355, 356, 357, 358, 360, 0,
-	371, 372, 359, 82, 91, 138, 246, 186, 116, 235,
-	0, 0, 109, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 84, 85, 92, 98, 104,
-	108, 112, 115, 120, 123, 126, 128, 129, 130, 133,
-	143, 146, 147, 148, 149, 159, 160, 161, 163, 166,
-	167, 168, 169, 170, 173, 175, 176, 177, 178, 179,
-	180, 187, 190, 196, 197, 198, 199, 200, 201, 202,
-	204, 205, 206, 207, 213, 216, 222, 223, 232, 239,
-	242, 165, 0, 0, 0, 0, 0, 322, 0, 0,
-	0, 111, 0, 319, 0, 0, 0, 137, 362, 139,
-	0, 0, 211, 153, 0, 0, 0, 0, 353, 354,
-	0, 0, 0, 0, 0, 0, 0, 0, 54, 0,
-	0, 320, 341, 340, 343, 344, 345, 346, 0, 0,
-	101, 342, 347, 348, 349, 0, 0, 0, 317, 334,
-	0, 361, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 331, 332, 0, 0, 0, 0, 375, 0, 333,
-	0, 0, 328, 329, 330, 335, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 119, 0, 0, 0, 0,
-	265, 0, 0, 373, 0, 184, 0, 215, 122, 136,
-	97, 83, 93, 0, 121, 162, 191, 195, 0, 0,
-	0, 105, 0, 193, 172, 231, 0, 174, 192, 140,
-	221, 185, 230, 240, 241, 218, 238, 245, 208, 86,
-	217, 229, 102, 203, 88, 227, 214, 151, 131, 132,
-	87, 0, 189, 110, 117, 107, 164, 224, 225, 106,
-	248, 94, 237, 90, 95, 236, 158, 220, 228, 152,
-	145, 89, 226, 150, 144, 135, 114, 124, 182, 142,
-	183, 125, 155, 154, 156, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 212, 234, 249, 99, 0, 219, 243, 244,
-	0, 0, 100, 118, 113, 0, 181, 157, 96, 127,
-	209, 134, 141, 188, 247, 171, 194, 103, 233, 210,
-	363, 374, 369, 370, 367, 368, 366, 365, 364, 376,
-	355, 356, 357, 358, 360, 0, 371, 372, 359, 82,
-	91, 138, 246, 186, 116, 235, 0, 0, 109, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 84, 85, 92, 98, 104, 108, 112, 115, 120,
-	123, 126, 128, 129, 130, 133, 143, 146, 147, 148,
-	149, 159, 160, 161, 163, 166, 167, 168, 169, 170,
-	173, 175, 176, 177, 178, 179, 180, 187, 190, 196,
-	197, 198, 199, 200, 201, 202, 204, 205, 206, 207,
-	213, 216, 222, 223, 232, 239, 242, 165, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 111, 0, 0,
-	0, 0, 0, 137, 362, 139, 0, 0, 211, 153,
-	0, 0, 0, 0, 353, 354, 0, 0, 0, 0,
-	0, 0, 0, 0, 54, 0, 0, 320, 341, 340,
-	343, 344, 345, 346, 0, 0, 101, 342, 347, 348,
-	349, 0, 0, 0, 0, 334, 0, 361, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 331, 332, 0,
-	0, 0, 0, 375, 0, 333, 0, 0, 328, 329,
-	330, 335, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 119, 0, 0, 0, 0, 265, 0, 0, 373,
-	0, 184, 0, 215, 122, 136, 97, 83, 93, 0,
-	121, 162, 191, 195, 0, 0, 0, 105, 0, 193,
-	172, 231, 1569, 174, 192, 140, 221, 185, 230, 240,
-	241, 218, 238, 245, 208, 86, 217, 229, 102, 203,
-	88, 227, 214, 151, 131, 132, 87, 0, 189, 110,
-	117, 107, 164, 224, 225, 106, 248, 94, 237, 90,
-	95, 236, 158, 220, 228, 152, 145, 89, 226, 150,
-	144, 135, 114, 124, 182, 142, 183, 125, 155, 154,
-	156, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 212, 234,
-	249, 99, 0, 219, 243, 244, 0, 0, 100, 118,
-	113, 0, 181, 157, 96, 127, 209, 134, 141, 188,
-	247, 171, 194, 103, 233, 210, 363, 374, 369, 370,
-	367, 368, 366, 365, 364, 376, 355, 356, 357, 358,
-	360, 0, 371, 372, 359, 82, 91, 138, 246, 186,
-	116, 235, 0, 0, 109, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 84, 85, 92,
-	98, 104, 108, 112, 115, 120, 123, 126, 128, 129,
-	130, 133, 143, 146, 147, 148, 149, 159, 160, 161,
-	163, 166, 167, 168, 169, 170, 173, 175, 176, 177,
-	178, 179, 180, 187, 190, 196, 197, 198, 199, 200,
-	201, 202, 204, 205, 206, 207, 213, 216, 222, 223,
-	232, 239, 242, 165, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 111, 0, 0, 0, 0, 0, 137,
-	362, 139, 0, 0, 211, 153, 0, 0, 0, 0,
-	353, 354, 0, 0, 0, 0, 0, 0, 0, 0,
-	54, 0, 577, 320, 341, 340, 343, 344, 345, 346,
-	0, 0, 101, 342, 347, 348, 349, 0, 0, 0,
-	0, 334, 0, 361, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 331, 332, 0, 0, 0, 0, 375,
-	0, 333, 0, 0, 328, 329, 330, 335, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 119, 0, 0,
-	0, 0, 265, 0, 0, 373, 0, 184, 0, 215,
-	122, 136, 97, 83, 93, 0, 121, 162, 191, 195,
-	0, 0, 0, 105, 0, 193, 172, 231, 0, 174,
-	192, 140, 221, 185, 230, 240, 241, 218, 238, 245,
-	208, 86, 217, 229, 102, 203, 88, 227, 214, 151,
-	131, 132, 87, 0, 189, 110, 117, 107, 164, 224,
-	225, 106, 248, 94, 237, 90, 95, 236, 158, 220,
-	228, 152, 145, 89, 226, 150, 144, 135, 114, 124,
-	182, 142, 183, 125, 155, 154, 156, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 212, 234, 249, 99, 0, 219,
-	243, 244, 0, 0, 100, 118, 113, 0, 181, 157,
-	96, 127, 209, 134, 141, 188, 247, 171, 194, 103,
-	233, 210, 363, 374, 369, 370, 367, 368, 366, 365,
-	364, 376, 355, 356, 357, 358, 360, 0, 371, 372,
-	359, 82, 91, 138, 246, 186, 116, 235, 0, 0,
-	109, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 84, 85, 92, 98, 104, 108, 112,
-	115, 120, 123, 126, 128, 129, 130, 133, 143, 146,
-	147, 148, 149, 159, 160, 161, 163, 166, 167, 168,
-	169, 170, 173, 175, 176, 177, 178, 179, 180, 187,
-	190, 196, 197, 198, 199, 200, 201, 202, 204, 205,
-	206, 207, 213, 216, 222, 223, 232, 239, 242, 165,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 111,
-	0, 0, 0, 0, 0, 137, 362, 139, 0, 0,
-	211, 153, 0, 0, 0, 0, 353, 354, 0, 0,
-	0, 0, 0, 0, 0, 0, 54, 0, 0, 320,
-	341, 340, 343, 344, 345, 346, 0, 0, 101, 342,
-	347, 348, 349, 0, 0, 0, 0, 334, 0, 361,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 331,
-	332, 0, 0, 0, 0, 375, 0, 333, 0, 0,
-	328, 329, 330, 335, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 119, 0, 0, 0, 0, 265, 0,
-	0, 373, 0, 184, 0, 215, 122, 136, 97, 83,
-	93, 0, 121, 162, 191, 195, 0, 0, 0, 105,
-	0, 193, 172, 231, 0, 174, 192, 140, 221, 185,
-	230, 240, 241, 218, 238, 245, 208, 86, 217, 229,
-	102, 203, 88, 227, 214, 151, 131, 132, 87, 0,
-	189, 110, 117, 107, 164, 224, 225, 106, 248, 94,
-	237, 90, 95, 236, 158, 220, 228, 152, 145, 89,
-	226, 150, 144, 135, 114, 124, 182, 142, 183, 125,
-	155, 154, 156, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	212, 234, 249, 99, 0, 219, 243, 244, 0, 0,
-	100, 118, 113, 0, 181, 157, 96, 127, 209, 134,
-	141, 188, 247, 171, 194, 103, 233, 210, 363, 374,
-	369, 370, 367, 368, 366, 365, 364, 376, 355, 356,
-	357, 358, 360, 0, 371, 372, 359, 82, 91, 138,
-	246, 186, 116, 235, 0, 0, 109, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 84,
-	85, 92, 98, 104, 108, 112, 115, 120, 123, 126,
-	128, 129, 130, 133, 143, 146, 147, 148, 149, 159,
-	160, 161, 163, 166, 167, 168, 169, 170, 173, 175,
-	176, 177, 178, 179, 180, 187, 190, 196, 197, 198,
-	199, 200, 201, 202, 204, 205, 206, 207, 213, 216,
-	222, 223, 232, 239, 242, 165, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 111, 0, 0, 0, 0,
-	0, 137, 0, 139, 0, 0, 211, 153, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 80, 0, 0, 0, 0,
-	0, 0, 0, 0, 101, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 611, 610, 620, 621, 613, 614, 615, 616, 617,
-	618, 619, 612, 0, 0, 622, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 119,
-	0, 0, 0, 0, 265, 0, 0, 0, 0, 184,
-	0, 215, 122, 136, 97, 83, 93, 0, 121, 162,
-	191, 195, 0, 0, 0, 105, 0, 193, 172, 231,
-	0, 174, 192, 140, 221, 185, 230, 240, 241, 218,
-	238, 245, 208, 86, 217, 229, 102, 203, 88, 227,
-	214, 151, 131, 132, 87, 0, 189, 110, 117, 107,
-	164, 224, 225, 106, 248, 94, 237, 90, 95, 236,
-	158, 220, 228, 152, 145, 89, 226, 150, 144, 135,
-	114, 124, 182, 142, 183, 125, 155, 154, 156, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 212, 234, 249, 99,
-	0, 219, 243, 244, 0, 0, 100, 118, 113, 0,
-	181, 157, 96, 127, 209, 134, 141, 188, 247, 171,
-	194, 103, 233, 210, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 82, 91, 138, 246, 186, 116, 235,
-	0, 0, 109, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 84, 85, 92, 98, 104,
-	108, 112, 115, 120, 123, 126, 128, 129, 130, 133,
-	143, 146, 147, 148, 149, 159, 160, 161, 163, 166,
-	167, 168, 169, 170, 173, 175, 176, 177, 178, 179,
-	180, 187, 190, 196, 197, 198, 199, 200, 201, 202,
-	204, 205, 206, 207, 213, 216, 222, 223, 232, 239,
-	242, 165, 0, 0, 0, 0, 599, 0, 0, 0,
-	0, 111, 0, 0, 0, 0, 0, 137, 0, 139,
-	0, 0, 211, 153, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 80, 0, 601, 0, 0, 0, 0, 0, 0,
-	101, 0, 0, 0, 0, 0, 596, 595, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 597, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 119, 0, 0, 0, 0,
-	265, 0, 0, 0, 0, 184, 0, 215, 122, 136,
-	97, 83, 93, 0, 121, 162, 191, 195, 0, 0,
-	0, 105, 0, 193, 172, 231, 0, 174, 192, 140,
-	221, 185, 230, 240, 241, 218, 238, 245, 208, 86,
-	217, 229, 102, 203, 88, 227, 214, 151, 131, 132,
-	87, 0, 189, 110, 117, 107, 164, 224, 225, 106,
-	248, 94, 237, 90, 95, 236, 158, 220, 228, 152,
-	145, 89, 226, 150, 144, 135, 114, 124, 182, 142,
-	183, 125, 155, 154, 156, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 212, 234, 249, 99, 0, 219, 243, 244,
-	0, 0, 100, 118, 113, 0, 181, 157, 96, 127,
-	209, 134, 141, 188, 247, 171, 194, 103, 233, 210,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 82,
-	91, 138, 246, 186, 116, 235, 0, 0, 109, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 84, 85, 92, 98, 104, 108, 112, 115, 120,
-	123, 126, 128, 129, 130, 133, 143, 146, 147, 148,
-	149, 159, 160, 161, 163, 166, 167, 168, 169, 170,
-	173, 175, 176, 177, 178, 179, 180, 187, 190, 196,
-	197, 198, 199, 200, 201, 202, 204, 205, 206, 207,
-	213, 216, 222, 223, 232, 239, 242, 165, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 111, 0, 0,
-	0, 0, 0, 137, 0, 139, 0, 0, 211, 153,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 80, 0, 0,
-	0, 0, 0, 0, 0, 0, 101, 0, 0, 0,
-	0, 0, 74, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 119, 76, 77, 0, 0, 73, 0, 0, 0,
-	78, 184, 0, 215, 122, 136, 97, 83, 93, 0,
-	121, 162, 191, 195, 0, 0, 0, 105, 0, 193,
-	172, 231, 0, 174, 192, 140, 221, 185, 230, 240,
-	241, 218, 238, 245, 208, 86, 217, 229, 102, 203,
-	88, 227, 214, 151, 131, 132, 87, 0, 189, 110,
-	117, 107, 164, 224, 225, 106, 248, 94, 237, 90,
-	95, 236, 158, 220, 228, 152, 145, 89, 226, 150,
-	144, 135, 114, 124, 182, 142, 183, 125, 155, 154,
-	156, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 212, 234,
-	249, 99, 0, 219, 243, 244, 0, 0, 100, 118,
-	113, 0, 181, 157, 96, 127, 209, 134, 141, 188,
-	247, 171, 194, 103, 233, 210, 0, 75, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 82, 91, 138, 246, 186,
-	116, 235, 0, 0, 109, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 84, 85, 92,
-	98, 104, 108, 112, 115, 120, 123, 126, 128, 129,
-	130, 133, 143, 146, 147, 148, 149, 159, 160, 161,
-	163, 166, 167, 168, 169, 170, 173, 175, 176, 177,
-	178, 179, 180, 187, 190, 196, 197, 198, 199, 200,
-	201, 202, 204, 205, 206, 207, 213, 216, 222, 223,
-	232, 239, 242, 165, 0, 0, 0, 0, 949, 0,
-	0, 0, 0, 111, 0, 0, 0, 0, 0, 137,
-	0, 139, 0, 0, 211, 153, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 263, 0, 951, 0, 0, 0, 0,
-	0, 0, 101, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 119, 0, 0,
-	0, 0, 265, 0, 0, 0, 0, 184, 0, 215,
-	122, 136, 97, 83, 93, 0, 121, 162, 191, 195,
-	0, 0, 0, 105, 0, 193, 172, 231, 0, 174,
-	192, 140, 221, 185, 230, 240, 241, 218, 238, 245,
-	208, 86, 217, 229, 102, 203, 88, 227, 214, 151,
-	131, 132, 87, 0, 189, 110, 117, 107, 164, 224,
-	225, 106, 248, 94, 237, 90, 95, 236, 158, 220,
-	228, 152, 145, 89, 226, 150, 144, 135, 114, 124,
-	182, 142, 183, 125, 155, 154, 156, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 212, 234, 249, 99, 0, 219,
-	243, 244, 0, 0, 100, 118, 113, 0, 181, 157,
-	96, 127, 209, 134, 141, 188, 247, 171, 194, 103,
-	233, 210, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 82, 91, 138, 246, 186, 116, 235, 0, 0,
-	109, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 84, 85, 92, 98, 104, 108, 112,
-	115, 120, 123, 126, 128, 129, 130, 133, 143, 146,
-	147, 148, 149, 159, 160, 161, 163, 166, 167, 168,
-	169, 170, 173, 175, 176, 177, 178, 179, 180, 187,
-	190, 196, 197, 198, 199, 200, 201, 202, 204, 205,
-	206, 207, 213, 216, 222, 223, 232, 239, 242, 24,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 165, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 111, 0, 0, 0, 0, 0, 137, 0, 139,
-	0, 0, 211, 153, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 54, 0,
-	0, 80, 0, 0, 0, 0, 0, 0, 0, 0,
-	101, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 119, 0, 0, 0, 0,
-	265, 0, 0, 0, 0, 184, 0, 215, 122, 136,
-	97, 83, 93, 0, 121, 162, 191, 195, 0, 0,
-	0, 105, 0, 193, 172, 231, 0, 174, 192, 140,
-	221, 185, 230, 240, 241, 218, 238, 245, 208, 86,
-	217, 229, 102, 203, 88, 227, 214, 151, 131, 132,
-	87, 0, 189, 110, 117, 107, 164, 224, 225, 106,
-	248, 94, 237, 90, 95, 236, 158, 220, 228, 152,
-	145, 89, 226, 150, 144, 135, 114, 124, 182, 142,
-	183, 125, 155, 154, 156, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 212, 234, 249, 99, 0, 219, 243, 244,
-	0, 0, 100, 118, 113, 0, 181, 157, 96, 127,
-	209, 134, 141, 188, 247, 171, 194, 103, 233, 210,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 82,
-	91, 138, 246, 186, 116, 235, 0, 0, 109, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 84, 85, 92, 98, 104, 108, 112, 115, 120,
-	123, 126, 128, 129, 130, 133, 143, 146, 147, 148,
-	149, 159, 160, 161, 163, 166, 167, 168, 169, 170,
-	173, 175, 176, 177, 178, 179, 180, 187, 190, 196,
-	197, 198, 199, 200, 201, 202, 204, 205, 206, 207,
-	213, 216, 222, 223, 232, 239, 242, 24, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 165,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 111,
-	0, 0, 0, 0, 0, 137, 0, 139, 0, 0,
-	211, 153, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 54, 0, 0, 692,
-	0, 0, 0, 0, 0, 0, 0, 0, 101, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 119, 0, 0, 0, 0, 265, 0,
-	0, 0, 0, 184, 0, 215, 122, 136, 97, 83,
-	93, 0, 121, 162, 191, 195, 0, 0, 0, 105,
-	0, 193, 172, 231, 0, 174, 192, 140, 221, 185,
-	230, 240, 241, 218, 238, 245, 208, 86, 217, 229,
-	102, 203, 88, 227, 214, 151, 131, 132, 87, 0,
-	189, 110, 117, 107, 164, 224, 225, 106, 248, 94,
-	237, 90, 95, 236, 158, 220, 228, 152, 145, 89,
-	226, 150, 144, 135, 114, 124, 182, 142, 183, 125,
-	155, 154, 156, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	212, 234, 249, 99, 0, 219, 243, 244, 0, 0,
-	100, 118, 113, 0, 181, 157, 96, 127, 209, 134,
-	141, 188, 247, 171, 194, 103, 233, 210, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 82, 91, 138,
-	246, 186, 116, 235, 0, 0, 109, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 84,
-	85, 92, 98, 104, 108, 112, 115, 120, 123, 126,
-	128, 129, 130, 133, 143, 146, 147, 148, 149, 159,
-	160, 161, 163, 166, 167, 168, 169, 170, 173, 175,
-	176, 177, 178, 179, 180, 187, 190, 196, 197, 198,
-	199, 200, 201, 202, 204, 205, 206, 207, 213, 216,
-	222, 223, 232, 239, 242, 165, 0, 0, 0, 0,
-	949, 0, 0, 0, 0, 111, 0, 0, 0, 0,
-	0, 137, 0, 139, 0, 0, 211, 153, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 263, 0, 951, 0, 0,
-	0, 0, 0, 0, 101, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 119,
-	0, 0, 0, 0, 265, 0, 0, 0, 0, 184,
-	0, 215, 122, 136, 97, 83, 93, 0, 121, 162,
-	191, 195, 0, 0, 0, 105, 0, 193, 172, 231,
-	0, 947, 192, 140, 221, 185, 230, 240, 241, 218,
-	238, 245, 208, 86, 217, 229, 102, 203, 88, 227,
-	214, 151, 131, 132, 87, 0, 189, 110, 117, 107,
-	164, 224, 225, 106, 248, 94, 237, 90, 95, 236,
-	158, 220, 228, 152, 145, 89, 226, 150, 144, 135,
-	114, 124, 182, 142, 183, 125, 155, 154, 156, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 212, 234, 249, 99,
-	0, 219, 243, 244, 0, 0, 100, 118, 113, 0,
-	181, 157, 96, 127, 209, 134, 141, 188, 247, 171,
-	194, 103, 233, 210, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 82, 91, 138, 246, 186, 116, 235,
-	0, 0, 109, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 84, 85, 92, 98, 104,
-	108, 112, 115, 120, 123, 126, 128, 129, 130, 133,
-	143, 146, 147, 148, 149, 159, 160, 161, 163, 166,
-	167, 168, 169, 170, 173, 175, 176, 177, 178, 179,
-	180, 187, 190, 196, 197, 198, 199, 200, 201, 202,
-	204, 205, 206, 207, 213, 216, 222, 223, 232, 239,
-	242, 165, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 111, 0, 0, 0, 0, 0, 137, 0, 139,
-	0, 0, 211, 153, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 80, 0, 0, 843, 0, 0, 844, 0, 0,
-	101, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 119, 0, 0, 0, 0,
-	265, 0, 0, 0, 0, 184, 0, 215, 122, 136,
-	97, 83, 93, 0, 121, 162, 191, 195, 0, 0,
-	0, 105, 0, 193, 172, 231, 0, 174, 192, 140,
-	221, 185, 230, 240, 241, 218, 238, 245, 208, 86,
-	217, 229, 102, 203, 88, 227, 214, 151, 131, 132,
-	87, 0, 189, 110, 117, 107, 164, 224, 225, 106,
-	248, 94, 237, 90, 95, 236, 158, 220, 228, 152,
-	145, 89, 226, 150, 144, 135, 114, 124, 182, 142,
-	183, 125, 155, 154, 156, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 212, 234, 249, 99, 0, 219, 243, 244,
-	0, 0, 100, 118, 113, 0, 181, 157, 96, 127,
-	209, 134, 141, 188, 247, 171, 194, 103, 233, 210,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 82,
-	91, 138, 246, 186, 116, 235, 0, 0, 109, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 84, 85, 92, 98, 104, 108, 112, 115, 120,
-	123, 126, 128, 129, 130, 133, 143, 146, 147, 148,
-	149, 159, 160, 161, 163, 166, 167, 168, 169, 170,
-	173, 175, 176, 177, 178, 179, 180, 187, 190, 196,
-	197, 198, 199, 200, 201, 202, 204, 205, 206, 207,
-	213, 216, 222, 223, 232, 239, 242, 165, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 111, 0, 714,
-	0, 0, 0, 137, 0, 139, 0, 0, 211, 153,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 80, 0, 713,
-	0, 0, 0, 0, 0, 0, 101, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 119, 0, 0, 0, 0, 265, 0, 0, 0,
-	0, 184, 0, 215, 122, 136, 97, 83, 93, 0,
-	121, 162, 191, 195, 0, 0, 0, 105, 0, 193,
-	172, 231, 0, 174, 192, 140, 221, 185, 230, 240,
-	241, 218, 238, 245, 208, 86, 217, 229, 102, 203,
-	88, 227, 214, 151, 131, 132, 87, 0, 189, 110,
-	117, 107, 164, 224, 225, 106, 248, 94, 237, 90,
-	95, 236, 158, 220, 228, 152, 145, 89, 226, 150,
-	144, 135, 114, 124, 182, 142, 183, 125, 155, 154,
-	156, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 212, 234,
-	249, 99, 0, 219, 243, 244, 0, 0, 100, 118,
-	113, 0, 181, 157, 96, 127, 209, 134, 141, 188,
-	247, 171, 194, 103, 233, 210, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 82, 91, 138, 246, 186,
-	116, 235, 0, 0, 109, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 84, 85, 92,
-	98, 104, 108, 112, 115, 120, 123, 126, 128, 129,
-	130, 133, 143, 146, 147, 148, 149, 159, 160, 161,
-	163, 166, 167, 168, 169, 170, 173, 175, 176, 177,
-	178, 179, 180, 187, 190, 196, 197, 198, 199, 200,
-	201, 202, 204, 205, 206, 207, 213, 216, 222, 223,
-	232, 239, 242, 165, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 111, 0, 0, 0, 0, 0, 137,
-	0, 139, 0, 0, 211, 153, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	54, 0, 0, 692, 0, 0, 0, 0, 0, 0,
-	0, 0, 101, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 119, 0, 0,
-	0, 0, 265, 0, 0, 0, 0, 184, 0, 215,
-	122, 136, 97, 83, 93, 0, 121, 162, 191, 195,
-	0, 0, 0, 105, 0, 193, 172, 231, 0, 174,
-	192, 140, 221, 185, 230, 240, 241, 218, 238, 245,
-	208, 86, 217, 229, 102, 203, 88, 227, 214, 151,
-	131, 132, 87, 0, 189, 110, 117, 107, 164, 224,
-	225, 106, 248, 94, 237, 90, 95, 236, 158, 220,
-	228, 152, 145, 89, 226, 150, 144, 135, 114, 124,
-	182, 142, 183, 125, 155, 154, 156, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 212, 234, 249, 99, 0, 219,
-	243, 244, 0, 0, 100, 118, 113, 0, 181, 157,
-	96, 127, 209, 134, 141, 188, 247, 171, 194, 103,
-	233, 210, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 82, 91, 138, 246, 186, 116, 235, 0, 0,
-	109, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 84, 85, 92, 98, 104, 108, 112,
-	115, 120, 123, 126, 128, 129, 130, 133, 143, 146,
-	147, 148, 149, 159, 160, 161, 163, 166, 167, 168,
-	169, 170, 173, 175, 176, 177, 178, 179, 180, 187,
-	190, 196, 197, 198, 199, 200, 201, 202, 204, 205,
-	206, 207, 213, 216, 222, 223, 232, 239, 242, 165,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 111,
-	0, 0, 0, 0, 0, 137, 0, 139, 0, 0,
-	211, 153, 0, 0, 0, 0, 0, 0, 0, 0,
+var yyAct = [...]int16{
+	323, 1586, 1536, 1359, 1138, 1238, 1576, 327, 1396, 652,
+	1325, 353, 1163, 1440, 1476, 1397, 340, 1330, 1425, 651,
+	3, 1158, 955, 301, 1008, 692, 950, 552, 1394, 57,
+	1065, 1139, 82, 1188, 978, 1297, 265, 292, 987, 265,
+	1303, 893, 1267, 903, 1024, 826, 354, 51, 900, 1099,
+	921, 1217, 952, 693, 713, 1169, 941, 1074, 812, 991,
+	868, 396, 583, 589, 387, 1205, 1020, 265, 82, 957,
+	712, 300, 265, 522, 265, 390, 385, 934, 595, 325,
+	603, 310, 293, 294, 295, 296, 702, 528, 299, 699,
+	382, 56, 61, 667, 554, 1579, 1560, 1574, 51, 1546,
+	1047, 1571, 1360, 1559, 1390, 527, 306, 314, 666, 1545,
+	260, 256, 1322, 556, 257, 258, 1046, 972, 63, 64,
+	65, 66, 67, 1323, 1324, 262, 1507, 617, 616, 626,
+	627, 619, 620, 621, 622, 623, 624, 625, 618, 1033,
+	1178, 628, 714, 1177, 715, 1051, 1179, 973, 974, 981,
+	252, 577, 254, 572, 1045, 298, 384, 573, 570, 571,
+	297, 524, 393, 526, 530, 531, 365, 1196, 371, 372,
+	369, 370, 368, 367, 366, 541, 1001, 1428, 1240, 1381,
+	558, 1379, 373, 374, 560, 1009, 997, 291, 801, 565,
+	566, 575, 998, 1447, 800, 1242, 798, 1573, 1570, 1537,
+	1237, 935, 1529, 529, 992, 1042, 1039, 1040, 1268, 1038,
+	1594, 1477, 542, 1164, 1166, 557, 559, 254, 1243, 805,
+	790, 994, 1317, 1316, 576, 1479, 1485, 1315, 525, 533,
+	802, 799, 532, 1590, 268, 255, 640, 641, 1059, 994,
+	538, 1058, 1550, 1049, 1052, 1241, 259, 1463, 1270, 1234,
+	1253, 1189, 1174, 265, 1117, 1236, 265, 1114, 1127, 253,
+	1092, 840, 265, 621, 622, 623, 624, 625, 618, 265,
+	708, 628, 82, 607, 82, 548, 82, 82, 979, 82,
+	1044, 82, 1272, 628, 1276, 968, 1271, 82, 1269, 1342,
+	827, 837, 618, 1274, 1165, 628, 1478, 1068, 1248, 276,
+	1072, 561, 1273, 562, 563, 535, 564, 536, 567, 602,
+	537, 1527, 1508, 523, 578, 555, 1043, 82, 553, 1496,
+	553, 993, 553, 553, 286, 553, 592, 553, 1009, 1275,
+	1277, 1486, 1484, 553, 591, 579, 580, 1225, 1544, 993,
+	1301, 1343, 534, 999, 1588, 540, 521, 1589, 70, 1587,
+	832, 547, 1215, 51, 1182, 1235, 1048, 1233, 549, 716,
+	640, 641, 1067, 640, 641, 1286, 831, 1223, 637, 600,
+	1050, 639, 792, 601, 600, 828, 269, 922, 1066, 1124,
+	265, 265, 265, 272, 71, 602, 922, 1113, 1595, 82,
+	602, 280, 1194, 1532, 275, 82, 1089, 1090, 1091, 650,
+	593, 654, 655, 656, 657, 658, 659, 660, 661, 662,
+	597, 665, 668, 668, 668, 674, 668, 668, 674, 668,
+	682, 683, 684, 685, 686, 687, 278, 697, 1596, 1551,
+	54, 1436, 285, 691, 544, 545, 546, 1224, 601, 600,
+	871, 1435, 1229, 1226, 1219, 1227, 1222, 1112, 1218, 1211,
+	1111, 1220, 1221, 710, 251, 602, 352, 1002, 1210, 270,
+	670, 672, 329, 676, 678, 1228, 681, 601, 600, 690,
+	706, 700, 701, 1197, 1553, 669, 671, 673, 675, 677,
+	679, 680, 1528, 393, 602, 894, 1454, 895, 80, 616,
+	626, 627, 619, 620, 621, 622, 623, 624, 625, 618,
+	282, 273, 628, 283, 284, 289, 839, 1431, 1180, 274,
+	1181, 277, 1368, 271, 288, 287, 601, 600, 1250, 379,
+	380, 1247, 265, 1288, 395, 843, 844, 82, 1206, 1071,
+	1482, 1572, 265, 602, 265, 82, 1555, 582, 582, 265,
+	1482, 1540, 265, 1525, 1362, 265, 838, 994, 1523, 265,
+	1189, 82, 82, 1184, 789, 876, 82, 82, 82, 265,
+	82, 82, 797, 601, 600, 896, 82, 82, 811, 873,
+	874, 875, 872, 553, 601, 600, 810, 523, 815, 816,
+	602, 553, 793, 817, 818, 819, 791, 821, 822, 1482,
+	582, 602, 788, 823, 824, 82, 550, 553, 553, 265,
+	1482, 1518, 553, 553, 553, 82, 553, 553, 1482, 1481,
+	814, 724, 553, 553, 543, 845, 1462, 582, 1423, 1422,
+	795, 794, 869, 796, 858, 860, 861, 22, 803, 1493,
+	859, 384, 806, 1492, 809, 617, 616, 626, 627, 619,
+	620, 621, 622, 623, 624, 625, 618, 993, 820, 628,
+	82, 1339, 990, 988, 995, 989, 1405, 582, 1351, 1350,
+	1300, 986, 992, 1345, 1348, 1170, 864, 912, 915, 866,
+	1345, 1347, 1458, 923, 907, 847, 1345, 1346, 1345, 1344,
+	1106, 582, 704, 82, 82, 862, 51, 305, 854, 905,
+	265, 1100, 938, 582, 905, 582, 723, 722, 265, 58,
+	265, 654, 865, 265, 265, 1170, 24, 265, 265, 265,
+	82, 938, 619, 620, 621, 622, 623, 624, 625, 618,
+	897, 898, 628, 1395, 24, 24, 705, 1300, 395, 707,
+	395, 1495, 395, 395, 931, 395, 1513, 395, 938, 704,
+	1349, 919, 1106, 395, 953, 954, 1312, 962, 1256, 697,
+	703, 1300, 709, 697, 1293, 963, 971, 54, 54, 965,
+	1130, 1129, 1010, 1011, 1012, 943, 946, 947, 948, 944,
+	814, 945, 949, 605, 1106, 54, 54, 1564, 961, 936,
+	582, 937, 970, 705, 966, 969, 703, 638, 703, 841,
+	804, 265, 1442, 964, 82, 1106, 265, 982, 1003, 265,
+	265, 265, 265, 265, 393, 265, 265, 938, 1421, 265,
+	82, 1410, 1025, 343, 342, 345, 346, 347, 348, 582,
+	1335, 1035, 344, 349, 1183, 1021, 265, 1016, 265, 265,
+	1026, 1027, 1028, 1015, 265, 307, 82, 1063, 1014, 1013,
+	553, 1304, 1305, 696, 1284, 395, 1239, 1443, 1030, 1022,
+	1023, 718, 1581, 1577, 1395, 1337, 553, 617, 616, 626,
+	627, 619, 620, 621, 622, 623, 624, 625, 618, 1307,
+	1212, 628, 943, 946, 947, 948, 944, 833, 945, 949,
+	1031, 808, 1304, 1305, 869, 1036, 54, 853, 1053, 1054,
+	1055, 1056, 1057, 1310, 1060, 1061, 1150, 1309, 1062, 1080,
+	1076, 1151, 866, 1147, 1148, 1081, 1146, 1568, 1082, 1149,
+	1558, 1152, 1093, 947, 948, 1064, 1252, 1077, 943, 946,
+	947, 948, 944, 1073, 945, 949, 311, 312, 1101, 596,
+	393, 1566, 1087, 1094, 1086, 865, 584, 1201, 1193, 265,
+	265, 265, 265, 265, 594, 1140, 902, 721, 1075, 1534,
+	585, 265, 551, 320, 265, 1533, 1511, 1191, 1457, 265,
+	1136, 1185, 1438, 265, 1141, 1034, 807, 1144, 951, 308,
+	309, 1123, 596, 907, 835, 1075, 1085, 302, 1501, 303,
+	1445, 58, 1137, 395, 1084, 697, 697, 697, 697, 697,
+	1500, 395, 1135, 1142, 1143, 1170, 1145, 574, 1583, 1582,
+	953, 1118, 1115, 1167, 825, 587, 1153, 395, 395, 697,
+	598, 1583, 395, 395, 395, 1171, 395, 395, 1547, 1172,
+	1429, 1173, 395, 395, 836, 1190, 1175, 60, 1168, 1198,
+	1199, 82, 82, 62, 55, 1, 1575, 1361, 1439, 1041,
+	1535, 263, 1475, 1200, 290, 1202, 1203, 1204, 1329, 1186,
+	1187, 849, 985, 69, 520, 1393, 68, 1526, 984, 1214,
+	983, 605, 1483, 82, 395, 926, 1427, 996, 1195, 317,
+	1000, 1336, 386, 870, 1192, 1216, 82, 263, 553, 263,
+	1207, 1208, 1209, 1531, 265, 729, 727, 728, 726, 1246,
+	1244, 1230, 731, 82, 617, 616, 626, 627, 619, 620,
+	621, 622, 623, 624, 625, 618, 899, 730, 628, 553,
+	1245, 725, 279, 388, 717, 1029, 599, 72, 1232, 1231,
+	1037, 830, 924, 617, 616, 626, 627, 619, 620, 621,
+	622, 623, 624, 625, 618, 568, 569, 628, 82, 928,
+	929, 1296, 1259, 281, 1140, 1260, 1261, 636, 1083, 1176,
+	1266, 1285, 394, 834, 1278, 1294, 1279, 1401, 842, 588,
+	696, 1499, 1444, 1122, 663, 696, 395, 920, 82, 696,
+	328, 857, 341, 1254, 338, 339, 848, 1292, 609, 326,
+	318, 695, 1295, 82, 82, 1308, 1080, 1258, 688, 866,
+	1004, 1005, 1006, 1007, 1299, 942, 940, 939, 383, 1319,
+	1159, 1156, 1157, 1318, 1306, 1302, 1032, 980, 1313, 1314,
+	1017, 1018, 1019, 265, 694, 1255, 82, 1389, 1506, 852,
+	1333, 1334, 1289, 1332, 26, 59, 1353, 1321, 313, 19,
+	18, 17, 265, 1340, 1341, 20, 16, 15, 82, 14,
+	539, 82, 82, 82, 265, 30, 21, 13, 12, 11,
+	395, 10, 9, 8, 82, 1354, 7, 265, 263, 6,
+	5, 263, 4, 304, 23, 2, 395, 263, 1355, 0,
+	1357, 0, 0, 0, 263, 0, 1367, 1326, 0, 0,
+	0, 0, 0, 0, 642, 643, 644, 645, 646, 647,
+	648, 649, 395, 0, 0, 0, 82, 395, 0, 0,
+	0, 0, 1352, 697, 0, 1398, 0, 0, 1140, 1370,
+	1326, 1369, 0, 0, 265, 1400, 0, 1377, 0, 0,
+	0, 1356, 1415, 0, 0, 1403, 0, 0, 0, 1406,
+	0, 1388, 1407, 1366, 1413, 870, 82, 0, 1412, 1414,
+	1399, 0, 51, 1420, 0, 0, 0, 0, 1258, 0,
+	0, 0, 0, 82, 0, 0, 0, 0, 0, 0,
+	697, 82, 1416, 1417, 1418, 0, 581, 0, 0, 1430,
+	0, 1432, 1433, 1434, 0, 0, 0, 0, 0, 0,
+	1437, 0, 0, 0, 0, 263, 263, 263, 0, 0,
+	0, 0, 0, 0, 924, 0, 0, 0, 0, 553,
+	1446, 696, 696, 696, 696, 696, 82, 0, 0, 0,
+	0, 82, 0, 265, 0, 0, 696, 82, 82, 82,
+	265, 0, 82, 0, 82, 696, 0, 0, 0, 1466,
+	1465, 0, 1474, 0, 0, 1470, 1471, 1472, 0, 0,
+	0, 1480, 1473, 82, 265, 0, 1487, 0, 0, 0,
+	0, 0, 0, 0, 0, 1441, 0, 0, 316, 0,
+	0, 1497, 0, 82, 82, 1488, 0, 1489, 1490, 1491,
+	1398, 0, 0, 0, 1512, 0, 0, 0, 0, 0,
+	0, 1514, 0, 82, 0, 1494, 1522, 1213, 395, 0,
+	1524, 0, 0, 0, 0, 0, 82, 82, 0, 0,
+	0, 0, 0, 0, 0, 1399, 0, 1539, 1515, 1538,
+	0, 1542, 0, 0, 0, 0, 1326, 0, 0, 395,
+	0, 0, 1548, 0, 0, 1398, 0, 263, 0, 0,
+	265, 0, 1251, 1498, 0, 1549, 0, 263, 82, 263,
+	0, 0, 0, 0, 263, 0, 1557, 263, 0, 395,
+	263, 82, 1561, 0, 813, 1140, 0, 0, 1565, 0,
+	1399, 1567, 51, 867, 263, 82, 877, 878, 879, 880,
+	881, 882, 883, 884, 885, 886, 887, 888, 889, 890,
+	891, 892, 1580, 1569, 395, 1591, 0, 0, 0, 0,
+	1441, 1326, 0, 924, 1298, 0, 0, 0, 0, 0,
+	0, 0, 908, 909, 263, 0, 914, 917, 918, 0,
+	0, 0, 0, 813, 0, 0, 0, 0, 0, 1552,
+	1578, 0, 927, 0, 1298, 0, 0, 0, 0, 0,
+	0, 930, 0, 932, 933, 1392, 0, 0, 0, 395,
+	1331, 626, 627, 619, 620, 621, 622, 623, 624, 625,
+	618, 0, 0, 628, 0, 0, 317, 1387, 846, 0,
+	0, 317, 317, 0, 0, 317, 317, 317, 0, 0,
+	0, 925, 395, 0, 617, 616, 626, 627, 619, 620,
+	621, 622, 623, 624, 625, 618, 0, 0, 628, 0,
+	317, 317, 317, 317, 1358, 263, 0, 1363, 1364, 1365,
+	0, 0, 0, 263, 0, 959, 0, 0, 263, 263,
+	395, 0, 263, 967, 813, 0, 0, 904, 906, 696,
+	0, 0, 0, 0, 0, 0, 1374, 1375, 0, 1376,
+	0, 0, 1378, 0, 1380, 617, 616, 626, 627, 619,
+	620, 621, 622, 623, 624, 625, 618, 0, 0, 628,
+	0, 0, 1402, 0, 0, 0, 0, 924, 0, 0,
+	0, 586, 590, 0, 0, 0, 0, 0, 0, 0,
+	0, 924, 0, 0, 0, 0, 696, 0, 608, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1424,
+	0, 0, 1426, 0, 0, 0, 263, 0, 0, 1088,
+	0, 263, 0, 1386, 263, 263, 263, 263, 263, 395,
+	263, 263, 0, 653, 263, 0, 0, 395, 0, 0,
+	0, 0, 664, 0, 0, 0, 0, 1095, 1096, 1097,
+	1098, 263, 0, 1069, 1070, 0, 0, 0, 0, 263,
+	0, 0, 0, 0, 0, 0, 813, 0, 1105, 1385,
+	0, 0, 0, 0, 0, 0, 0, 0, 317, 0,
+	0, 0, 1464, 0, 0, 0, 1121, 1426, 0, 0,
+	0, 0, 0, 1426, 1426, 1426, 0, 0, 395, 0,
+	1331, 617, 616, 626, 627, 619, 620, 621, 622, 623,
+	624, 625, 618, 0, 0, 628, 0, 0, 0, 1426,
+	0, 0, 0, 0, 0, 0, 0, 317, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1516,
+	1517, 0, 0, 0, 0, 317, 0, 617, 616, 626,
+	627, 619, 620, 621, 622, 623, 624, 625, 618, 1530,
+	0, 628, 0, 925, 263, 263, 263, 263, 263, 0,
+	0, 0, 395, 395, 0, 0, 1154, 0, 0, 263,
+	0, 0, 1103, 0, 959, 0, 1104, 0, 263, 0,
+	0, 0, 0, 1108, 1109, 1110, 0, 0, 0, 0,
+	1116, 0, 0, 1119, 1120, 0, 0, 0, 0, 1126,
+	0, 0, 0, 1128, 1556, 0, 1131, 1132, 1133, 1134,
+	0, 0, 0, 0, 924, 0, 0, 1563, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1155, 0,
+	0, 1426, 1384, 0, 24, 25, 52, 27, 28, 0,
+	0, 0, 0, 829, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 43, 0, 0, 0, 0,
+	29, 48, 49, 0, 1263, 0, 1264, 0, 0, 855,
+	856, 0, 0, 0, 0, 0, 0, 0, 1280, 1281,
+	38, 1282, 1283, 0, 0, 54, 0, 0, 0, 0,
+	0, 0, 0, 1290, 1291, 0, 0, 0, 0, 263,
+	0, 0, 0, 0, 0, 0, 0, 1262, 0, 317,
+	617, 616, 626, 627, 619, 620, 621, 622, 623, 624,
+	625, 618, 317, 653, 628, 0, 910, 911, 617, 616,
+	626, 627, 619, 620, 621, 622, 623, 624, 625, 618,
+	1102, 0, 628, 813, 0, 0, 0, 31, 32, 34,
+	33, 36, 925, 50, 0, 0, 0, 1338, 0, 0,
+	0, 617, 616, 626, 627, 619, 620, 621, 622, 623,
+	624, 625, 618, 0, 0, 628, 0, 37, 44, 45,
+	0, 1265, 46, 47, 35, 977, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 39, 40,
+	0, 41, 42, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1372, 0, 263, 0,
+	0, 0, 1311, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 263, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 263,
-	0, 951, 0, 0, 0, 0, 0, 0, 101, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 119, 0, 0, 0, 0, 265, 0,
-	0, 0, 0, 184, 0, 215, 122, 136, 97, 83,
-	93, 0, 121, 162, 191, 195, 0, 0, 0, 105,
-	0, 193, 172, 231, 0, 174, 192, 140, 221, 185,
-	230, 240, 241, 218, 238, 245, 208, 86, 217, 229,
-	102, 203, 88, 227, 214, 151, 131, 132, 87, 0,
-	189, 110, 117, 107, 164, 224, 225, 106, 248, 94,
-	237, 90, 95, 236, 158, 220, 228, 152, 145, 89,
-	226, 150, 144, 135, 114, 124, 182, 142, 183, 125,
-	155, 154, 156, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	212, 234, 249, 99, 0, 219, 243, 244, 0, 0,
-	100, 118, 113, 0, 181, 157, 96, 127, 209, 134,
-	141, 188, 247, 171, 194, 103, 233, 210, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 82, 91, 138,
-	246, 186, 116, 235, 0, 0, 109, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 84,
-	85, 92, 98, 104, 108, 112, 115, 120, 123, 126,
-	128, 129, 130, 133, 143, 146, 147, 148, 149, 159,
-	160, 161, 163, 166, 167, 168, 169, 170, 173, 175,
-	176, 177, 178, 179, 180, 187, 190, 196, 197, 198,
-	199, 200, 201, 202, 204, 205, 206, 207, 213, 216,
-	222, 223, 232, 239, 242, 165, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 111, 0, 0, 0, 0,
-	0, 137, 0, 139, 0, 0, 211, 153, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 80, 0, 601, 0, 0,
-	0, 0, 0, 0, 101, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 119,
-	0, 0, 0, 0, 265, 0, 0, 0, 0, 184,
-	0, 215, 122, 136, 97, 83, 93, 0, 121, 162,
-	191, 195, 0, 0, 0, 105, 0, 193, 172, 231,
-	0, 174, 192, 140, 221, 185, 230, 240, 241, 218,
-	238, 245, 208, 86, 217, 229, 102, 203, 88, 227,
-	214, 151, 131, 132, 87, 0, 189, 110, 117, 107,
-	164, 224, 225, 106, 248, 94, 237, 90, 95, 236,
-	158, 220, 228, 152, 145, 89, 226, 150, 144, 135,
-	114, 124, 182, 142, 183, 125, 155, 154, 156, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 212, 234, 249, 99,
-	0, 219, 243, 244, 0, 0, 100, 118, 113, 0,
-	181, 157, 96, 127, 209, 134, 141, 188, 247, 171,
-	194, 103, 233, 210, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 82, 91, 138, 246, 186, 116, 235,
-	0, 0, 109, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 84, 85, 92, 98, 104,
-	108, 112, 115, 120, 123, 126, 128, 129, 130, 133,
-	143, 146, 147, 148, 149, 159, 160, 161, 163, 166,
-	167, 168, 169, 170, 173, 175, 176, 177, 178, 179,
-	180, 187, 190, 196, 197, 198, 199, 200, 201, 202,
-	204, 205, 206, 207, 213, 216, 222, 223, 232, 239,
-	242, 165, 0, 0, 0, 0, 0, 0, 0, 0,
-	683, 111, 0, 0, 0, 0, 0, 137, 0, 139,
-	0, 0, 211, 153, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 263, 0, 0, 0, 0, 0, 0, 0, 0,
-	101, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 119, 0, 0, 0, 0,
-	265, 0, 0, 0, 0, 184, 0, 215, 122, 136,
-	97, 83, 93, 0, 121, 162, 191, 195, 0, 0,
-	0, 105, 0, 193, 172, 231, 0, 174, 192, 140,
-	221, 185, 230, 240, 241, 218, 238, 245, 208, 86,
-	217, 229, 102, 203, 88, 227, 214, 151, 131, 132,
-	87, 0, 189, 110, 117, 107, 164, 224, 225, 106,
-	248, 94, 237, 90, 95, 236, 158, 220, 228, 152,
-	145, 89, 226, 150, 144, 135, 114, 124, 182, 142,
-	183, 125, 155, 154, 156, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 212, 234, 249, 99, 0, 219, 243, 244,
-	0, 0, 100, 118, 113, 0, 181, 157, 96, 127,
-	209, 134, 141, 188, 247, 171, 194, 103, 233, 210,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 82,
-	91, 138, 246, 186, 116, 235, 0, 0, 109, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 84, 85, 92, 98, 104, 108, 112, 115, 120,
-	123, 126, 128, 129, 130, 133, 143, 146, 147, 148,
-	149, 159, 160, 161, 163, 166, 167, 168, 169, 170,
-	173, 175, 176, 177, 178, 179, 180, 187, 190, 196,
-	197, 198, 199, 200, 201, 202, 204, 205, 206, 207,
-	213, 216, 222, 223, 232, 239, 242, 379, 0, 0,
-	0, 0, 0, 0, 165, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 111, 0, 0, 0, 0, 0,
-	137, 0, 139, 0, 0, 211, 153, 0, 0, 0,
+	0, 0, 0, 0, 0, 611, 0, 615, 0, 0,
+	0, 0, 263, 629, 630, 631, 632, 633, 634, 635,
+	0, 612, 613, 614, 610, 617, 616, 626, 627, 619,
+	620, 621, 622, 623, 624, 625, 618, 0, 53, 628,
+	0, 0, 0, 0, 0, 0, 0, 1078, 1079, 0,
+	590, 0, 0, 0, 0, 0, 925, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 263,
+	925, 0, 0, 0, 0, 1371, 0, 0, 0, 0,
+	0, 0, 0, 1373, 0, 0, 0, 0, 1448, 1449,
+	1450, 1451, 1452, 0, 1382, 1383, 1455, 1456, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 263, 0, 0, 0, 0, 0,
-	0, 0, 0, 101, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 119, 0,
-	0, 0, 0, 265, 0, 0, 0, 0, 184, 0,
-	215, 122, 136, 97, 83, 93, 0, 121, 162, 191,
-	195, 0, 0, 0, 105, 0, 193, 172, 231, 0,
-	174, 192, 140, 221, 185, 230, 240, 241, 218, 238,
-	245, 208, 86, 217, 229, 102, 203, 88, 227, 214,
-	151, 131, 132, 87, 0, 189, 110, 117, 107, 164,
-	224, 225, 106, 248, 94, 237, 90, 95, 236, 158,
-	220, 228, 152, 145, 89, 226, 150, 144, 135, 114,
-	124, 182, 142, 183, 125, 155, 154, 156, 0, 0,
+	0, 0, 0, 1404, 1107, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 212, 234, 249, 99, 0,
-	219, 243, 244, 0, 0, 100, 118, 113, 0, 181,
-	157, 96, 127, 209, 134, 141, 188, 247, 171, 194,
-	103, 233, 210, 0, 0, 0, 0, 0, 0, 0,
+	0, 1125, 0, 0, 1419, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 82, 91, 138, 246, 186, 116, 235, 0,
-	0, 109, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 84, 85, 92, 98, 104, 108,
-	112, 115, 120, 123, 126, 128, 129, 130, 133, 143,
-	146, 147, 148, 149, 159, 160, 161, 163, 166, 167,
-	168, 169, 170, 173, 175, 176, 177, 178, 179, 180,
-	187, 190, 196, 197, 198, 199, 200, 201, 202, 204,
-	205, 206, 207, 213, 216, 222, 223, 232, 239, 242,
-	165, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	111, 0, 0, 0, 0, 0, 137, 0, 139, 0,
-	0, 211, 153, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1160, 0, 0, 0, 0, 1467, 0,
+	0, 0, 0, 0, 0, 959, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	263, 0, 0, 0, 0, 0, 0, 0, 0, 101,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 119, 0, 260, 0, 0, 265,
-	0, 0, 0, 0, 184, 0, 215, 122, 136, 97,
-	83, 93, 0, 121, 162, 191, 195, 0, 0, 0,
-	105, 0, 193, 172, 231, 0, 174, 192, 140, 221,
-	185, 230, 240, 241, 218, 238, 245, 208, 86, 217,
-	229, 102, 203, 88, 227, 214, 151, 131, 132, 87,
-	0, 189, 110, 117, 107, 164, 224, 225, 106, 248,
-	94, 237, 90, 95, 236, 158, 220, 228, 152, 145,
-	89, 226, 150, 144, 135, 114, 124, 182, 142, 183,
-	125, 155, 154, 156, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 263,
+	0, 0, 0, 0, 0, 1453, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1459, 1460, 1461, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1468, 1469, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	748, 0, 0, 0, 1502, 1503, 1504, 1505, 0, 0,
+	0, 1509, 1510, 0, 0, 0, 0, 1249, 0, 0,
+	0, 0, 1584, 0, 0, 263, 1519, 1520, 1521, 0,
+	750, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 925, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1543, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1287, 0, 0, 0, 0, 0, 0,
+	734, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1554, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1562, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1320,
+	751, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1592, 1593, 0, 764, 767, 768, 769, 770, 771, 772,
+	0, 781, 782, 783, 784, 785, 752, 753, 754, 755,
+	732, 733, 765, 0, 735, 0, 736, 737, 738, 739,
+	740, 741, 742, 743, 744, 745, 756, 757, 758, 759,
+	760, 761, 762, 763, 773, 774, 775, 776, 777, 778,
+	779, 780, 786, 787, 746, 747, 0, 749, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1391, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 766, 0,
+	1408, 0, 0, 1409, 0, 0, 1411, 0, 0, 0,
+	0, 1160, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 505, 493, 653, 449,
+	508, 423, 439, 516, 440, 443, 481, 408, 463, 166,
+	437, 518, 519, 0, 427, 403, 433, 404, 425, 451,
+	112, 455, 422, 495, 466, 507, 138, 514, 140, 472,
+	0, 212, 154, 0, 0, 453, 497, 460, 490, 448,
+	482, 413, 471, 509, 438, 479, 510, 83, 0, 0,
+	0, 81, 0, 1327, 1328, 0, 0, 0, 0, 0,
+	102, 0, 476, 504, 435, 478, 480, 402, 473, 0,
+	406, 409, 515, 500, 430, 431, 0, 0, 0, 0,
+	0, 0, 0, 452, 461, 462, 487, 446, 0, 0,
+	0, 0, 0, 0, 0, 0, 428, 0, 470, 1541,
+	653, 0, 410, 407, 0, 0, 450, 0, 0, 0,
+	412, 0, 429, 488, 0, 400, 120, 492, 499, 266,
+	0, 447, 267, 503, 445, 444, 506, 185, 0, 216,
+	123, 137, 98, 84, 94, 0, 122, 163, 192, 196,
+	496, 426, 434, 106, 432, 194, 173, 232, 469, 175,
+	193, 141, 222, 186, 231, 241, 242, 219, 239, 246,
+	209, 87, 218, 230, 103, 204, 89, 228, 215, 152,
+	132, 133, 88, 0, 190, 111, 118, 108, 165, 225,
+	226, 107, 249, 95, 238, 91, 96, 237, 159, 221,
+	229, 153, 146, 90, 227, 151, 145, 136, 115, 125,
+	183, 143, 184, 126, 156, 155, 157, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 405, 0, 213, 235, 250, 100, 421, 220,
+	244, 245, 0, 0, 101, 119, 114, 0, 182, 158,
+	97, 128, 210, 135, 142, 189, 248, 172, 195, 104,
+	234, 211, 417, 420, 415, 416, 464, 465, 511, 512,
+	513, 489, 411, 0, 418, 419, 0, 494, 501, 502,
+	468, 92, 139, 247, 187, 117, 236, 401, 414, 110,
+	424, 0, 0, 436, 441, 442, 454, 456, 457, 458,
+	459, 467, 474, 475, 477, 483, 484, 485, 486, 491,
+	498, 517, 85, 86, 93, 99, 105, 109, 113, 116,
+	121, 124, 127, 129, 130, 131, 134, 144, 147, 148,
+	149, 150, 160, 161, 162, 164, 167, 168, 169, 170,
+	171, 174, 176, 177, 178, 179, 180, 181, 188, 191,
+	197, 198, 199, 200, 201, 202, 203, 205, 206, 207,
+	208, 214, 217, 223, 224, 233, 240, 243, 505, 493,
+	0, 449, 508, 423, 439, 516, 440, 443, 481, 408,
+	463, 166, 437, 518, 519, 0, 427, 403, 433, 404,
+	425, 451, 112, 455, 422, 495, 466, 507, 138, 514,
+	140, 472, 0, 212, 154, 0, 0, 453, 497, 460,
+	490, 448, 482, 413, 471, 509, 438, 479, 510, 83,
+	54, 0, 0, 81, 0, 0, 0, 0, 0, 0,
+	0, 0, 102, 0, 476, 504, 435, 478, 480, 402,
+	473, 0, 406, 409, 515, 500, 430, 431, 0, 0,
+	0, 0, 0, 0, 0, 452, 461, 462, 487, 446,
+	0, 0, 0, 0, 0, 0, 0, 0, 428, 0,
+	470, 0, 0, 0, 410, 407, 0, 0, 450, 0,
+	0, 0, 412, 0, 429, 488, 0, 400, 120, 492,
+	499, 266, 0, 447, 267, 503, 445, 444, 506, 185,
+	0, 216, 123, 137, 98, 84, 94, 0, 122, 163,
+	192, 196, 496, 426, 434, 106, 432, 194, 173, 232,
+	469, 175, 193, 141, 222, 186, 231, 241, 242, 219,
+	239, 246, 209, 87, 218, 230, 103, 204, 89, 228,
+	215, 152, 132, 133, 88, 0, 190, 111, 118, 108,
+	165, 225, 226, 107, 249, 95, 238, 91, 96, 237,
+	159, 221, 229, 153, 146, 90, 227, 151, 145, 136,
+	115, 125, 183, 143, 184, 126, 156, 155, 157, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 405, 0, 213, 235, 250, 100,
+	421, 220, 244, 245, 0, 0, 101, 119, 114, 0,
+	182, 158, 97, 128, 210, 135, 142, 189, 248, 172,
+	195, 104, 234, 211, 417, 420, 415, 416, 464, 465,
+	511, 512, 513, 489, 411, 0, 418, 419, 0, 494,
+	501, 502, 468, 92, 139, 247, 187, 117, 236, 401,
+	414, 110, 424, 0, 0, 436, 441, 442, 454, 456,
+	457, 458, 459, 467, 474, 475, 477, 483, 484, 485,
+	486, 491, 498, 517, 85, 86, 93, 99, 105, 109,
+	113, 116, 121, 124, 127, 129, 130, 131, 134, 144,
+	147, 148, 149, 150, 160, 161, 162, 164, 167, 168,
+	169, 170, 171, 174, 176, 177, 178, 179, 180, 181,
+	188, 191, 197, 198, 199, 200, 201, 202, 203, 205,
+	206, 207, 208, 214, 217, 223, 224, 233, 240, 243,
+	505, 493, 0, 449, 508, 423, 439, 516, 440, 443,
+	481, 408, 463, 166, 437, 518, 519, 0, 427, 403,
+	433, 404, 425, 451, 112, 455, 422, 495, 466, 507,
+	138, 514, 140, 472, 0, 212, 154, 0, 0, 453,
+	497, 460, 490, 448, 482, 413, 471, 509, 438, 479,
+	510, 83, 0, 0, 0, 81, 0, 0, 0, 0,
+	0, 0, 0, 0, 102, 0, 476, 504, 435, 478,
+	480, 402, 473, 0, 406, 409, 515, 500, 430, 431,
+	0, 0, 0, 0, 0, 0, 0, 452, 461, 462,
+	487, 446, 0, 0, 0, 0, 0, 0, 1257, 0,
+	428, 0, 470, 0, 0, 0, 410, 407, 0, 0,
+	450, 0, 0, 0, 412, 0, 429, 488, 0, 400,
+	120, 492, 499, 266, 0, 447, 267, 503, 445, 444,
+	506, 185, 0, 216, 123, 137, 98, 84, 94, 0,
+	122, 163, 192, 196, 496, 426, 434, 106, 432, 194,
+	173, 232, 469, 175, 193, 141, 222, 186, 231, 241,
+	242, 219, 239, 246, 209, 87, 218, 230, 103, 204,
+	89, 228, 215, 152, 132, 133, 88, 0, 190, 111,
+	118, 108, 165, 225, 226, 107, 249, 95, 238, 91,
+	96, 237, 159, 221, 229, 153, 146, 90, 227, 151,
+	145, 136, 115, 125, 183, 143, 184, 126, 156, 155,
+	157, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 405, 0, 213, 235,
+	250, 100, 421, 220, 244, 245, 0, 0, 101, 119,
+	114, 0, 182, 158, 97, 128, 210, 135, 142, 189,
+	248, 172, 195, 104, 234, 211, 417, 420, 415, 416,
+	464, 465, 511, 512, 513, 489, 411, 0, 418, 419,
+	0, 494, 501, 502, 468, 92, 139, 247, 187, 117,
+	236, 401, 414, 110, 424, 0, 0, 436, 441, 442,
+	454, 456, 457, 458, 459, 467, 474, 475, 477, 483,
+	484, 485, 486, 491, 498, 517, 85, 86, 93, 99,
+	105, 109, 113, 116, 121, 124, 127, 129, 130, 131,
+	134, 144, 147, 148, 149, 150, 160, 161, 162, 164,
+	167, 168, 169, 170, 171, 174, 176, 177, 178, 179,
+	180, 181, 188, 191, 197, 198, 199, 200, 201, 202,
+	203, 205, 206, 207, 208, 214, 217, 223, 224, 233,
+	240, 243, 505, 493, 0, 449, 508, 423, 439, 516,
+	440, 443, 481, 408, 463, 166, 437, 518, 519, 0,
+	427, 403, 433, 404, 425, 451, 112, 455, 422, 495,
+	466, 507, 138, 514, 140, 472, 0, 212, 154, 0,
+	0, 453, 497, 460, 490, 448, 482, 413, 471, 509,
+	438, 479, 510, 83, 0, 0, 0, 264, 0, 0,
+	0, 0, 0, 0, 0, 0, 102, 0, 476, 504,
+	435, 478, 480, 402, 473, 0, 406, 409, 515, 500,
+	430, 431, 0, 0, 0, 0, 0, 0, 0, 452,
+	461, 462, 487, 446, 0, 0, 0, 0, 0, 0,
+	968, 0, 428, 0, 470, 0, 0, 0, 410, 407,
+	0, 0, 450, 0, 0, 0, 412, 0, 429, 488,
+	0, 400, 120, 492, 499, 266, 0, 447, 267, 503,
+	445, 444, 506, 185, 0, 216, 123, 137, 98, 84,
+	94, 0, 122, 163, 192, 196, 496, 426, 434, 106,
+	432, 194, 173, 232, 469, 175, 193, 141, 222, 186,
+	231, 241, 242, 219, 239, 246, 209, 87, 218, 230,
+	103, 204, 89, 228, 215, 152, 132, 133, 88, 0,
+	190, 111, 118, 108, 165, 225, 226, 107, 249, 95,
+	238, 91, 96, 237, 159, 221, 229, 153, 146, 90,
+	227, 151, 145, 136, 115, 125, 183, 143, 184, 126,
+	156, 155, 157, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 405, 0,
+	213, 235, 250, 100, 421, 220, 244, 245, 0, 0,
+	101, 119, 114, 0, 182, 158, 97, 128, 210, 135,
+	142, 189, 248, 172, 195, 104, 234, 211, 417, 420,
+	415, 416, 464, 465, 511, 512, 513, 489, 411, 0,
+	418, 419, 0, 494, 501, 502, 468, 92, 139, 247,
+	187, 117, 236, 401, 414, 110, 424, 0, 0, 436,
+	441, 442, 454, 456, 457, 458, 459, 467, 474, 475,
+	477, 483, 484, 485, 486, 491, 498, 517, 85, 86,
+	93, 99, 105, 109, 113, 116, 121, 124, 127, 129,
+	130, 131, 134, 144, 147, 148, 149, 150, 160, 161,
+	162, 164, 167, 168, 169, 170, 171, 174, 176, 177,
+	178, 179, 180, 181, 188, 191, 197, 198, 199, 200,
+	201, 202, 203, 205, 206, 207, 208, 214, 217, 223,
+	224, 233, 240, 243, 505, 493, 0, 449, 508, 423,
+	439, 516, 440, 443, 481, 408, 463, 166, 437, 518,
+	519, 0, 427, 403, 433, 404, 425, 451, 112, 455,
+	422, 495, 466, 507, 138, 514, 140, 472, 0, 212,
+	154, 0, 0, 453, 497, 460, 490, 448, 482, 413,
+	471, 509, 438, 479, 510, 83, 0, 0, 0, 322,
+	0, 0, 0, 0, 0, 0, 0, 0, 102, 0,
+	476, 504, 435, 478, 480, 402, 473, 0, 406, 409,
+	515, 500, 430, 431, 0, 0, 0, 0, 0, 0,
+	0, 452, 461, 462, 487, 446, 0, 0, 0, 0,
+	0, 0, 863, 0, 428, 0, 470, 0, 0, 0,
+	410, 407, 0, 0, 450, 0, 0, 0, 412, 0,
+	429, 488, 0, 400, 120, 492, 499, 266, 0, 447,
+	267, 503, 445, 444, 506, 185, 0, 216, 123, 137,
+	98, 84, 94, 0, 122, 163, 192, 196, 496, 426,
+	434, 106, 432, 194, 173, 232, 469, 175, 193, 141,
+	222, 186, 231, 241, 242, 219, 239, 246, 209, 87,
+	218, 230, 103, 204, 89, 228, 215, 152, 132, 133,
+	88, 0, 190, 111, 118, 108, 165, 225, 226, 107,
+	249, 95, 238, 91, 96, 237, 159, 221, 229, 153,
+	146, 90, 227, 151, 145, 136, 115, 125, 183, 143,
+	184, 126, 156, 155, 157, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	405, 0, 213, 235, 250, 100, 421, 220, 244, 245,
+	0, 0, 101, 119, 114, 0, 182, 158, 97, 128,
+	210, 135, 142, 189, 248, 172, 195, 104, 234, 211,
+	417, 420, 415, 416, 464, 465, 511, 512, 513, 489,
+	411, 0, 418, 419, 0, 494, 501, 502, 468, 92,
+	139, 247, 187, 117, 236, 401, 414, 110, 424, 0,
+	0, 436, 441, 442, 454, 456, 457, 458, 459, 467,
+	474, 475, 477, 483, 484, 485, 486, 491, 498, 517,
+	85, 86, 93, 99, 105, 109, 113, 116, 121, 124,
+	127, 129, 130, 131, 134, 144, 147, 148, 149, 150,
+	160, 161, 162, 164, 167, 168, 169, 170, 171, 174,
+	176, 177, 178, 179, 180, 181, 188, 191, 197, 198,
+	199, 200, 201, 202, 203, 205, 206, 207, 208, 214,
+	217, 223, 224, 233, 240, 243, 505, 493, 0, 449,
+	508, 423, 439, 516, 440, 443, 481, 408, 463, 166,
+	437, 518, 519, 0, 427, 403, 433, 404, 425, 451,
+	112, 455, 422, 495, 466, 507, 138, 514, 140, 472,
+	0, 212, 154, 0, 0, 453, 497, 460, 490, 448,
+	482, 413, 471, 509, 438, 479, 510, 83, 0, 0,
+	0, 81, 0, 0, 0, 0, 0, 0, 0, 0,
+	102, 0, 476, 504, 435, 478, 480, 402, 473, 0,
+	406, 409, 515, 500, 430, 431, 0, 0, 0, 0,
+	0, 0, 0, 452, 461, 462, 487, 446, 0, 0,
+	0, 0, 0, 0, 0, 0, 428, 0, 470, 0,
+	0, 0, 410, 407, 0, 0, 450, 0, 0, 0,
+	412, 0, 429, 488, 0, 400, 120, 492, 499, 266,
+	0, 447, 267, 503, 445, 444, 506, 185, 0, 216,
+	123, 137, 98, 84, 94, 0, 122, 163, 192, 196,
+	496, 426, 434, 106, 432, 194, 173, 232, 469, 175,
+	193, 141, 222, 186, 231, 241, 242, 219, 239, 246,
+	209, 87, 218, 230, 103, 204, 89, 228, 215, 152,
+	132, 133, 88, 0, 190, 111, 118, 108, 165, 225,
+	226, 107, 249, 95, 238, 91, 96, 237, 159, 221,
+	229, 153, 146, 90, 227, 151, 145, 136, 115, 125,
+	183, 143, 184, 126, 156, 155, 157, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 405, 0, 213, 235, 250, 100, 421, 220,
+	244, 245, 0, 0, 101, 119, 114, 0, 182, 158,
+	97, 128, 210, 135, 142, 189, 248, 172, 195, 104,
+	234, 211, 417, 420, 415, 416, 464, 465, 511, 512,
+	513, 489, 411, 0, 418, 419, 0, 494, 501, 502,
+	468, 92, 139, 247, 187, 117, 236, 401, 414, 110,
+	424, 0, 0, 436, 441, 442, 454, 456, 457, 458,
+	459, 467, 474, 475, 477, 483, 484, 485, 486, 491,
+	498, 517, 85, 86, 93, 99, 105, 109, 113, 116,
+	121, 124, 127, 129, 130, 131, 134, 144, 147, 148,
+	149, 150, 160, 161, 162, 164, 167, 168, 169, 170,
+	171, 174, 176, 177, 178, 179, 180, 181, 188, 191,
+	197, 198, 199, 200, 201, 202, 203, 205, 206, 207,
+	208, 214, 217, 223, 224, 233, 240, 243, 505, 493,
+	0, 449, 508, 423, 439, 516, 440, 443, 481, 408,
+	463, 166, 437, 518, 519, 0, 427, 403, 433, 404,
+	425, 451, 112, 455, 422, 495, 466, 507, 138, 514,
+	140, 472, 0, 212, 154, 0, 0, 453, 497, 460,
+	490, 448, 482, 413, 471, 509, 438, 479, 510, 83,
+	0, 0, 0, 322, 0, 0, 0, 0, 0, 0,
+	0, 0, 102, 0, 476, 504, 435, 478, 480, 402,
+	473, 0, 406, 409, 515, 500, 430, 431, 0, 0,
+	0, 0, 0, 0, 0, 452, 461, 462, 487, 446,
+	0, 0, 0, 0, 0, 0, 0, 0, 428, 0,
+	470, 0, 0, 0, 410, 407, 0, 0, 450, 0,
+	0, 0, 412, 0, 429, 488, 0, 400, 120, 492,
+	499, 266, 0, 447, 267, 503, 445, 444, 506, 185,
+	0, 216, 123, 137, 98, 84, 94, 0, 122, 163,
+	192, 196, 496, 426, 434, 106, 432, 194, 173, 232,
+	469, 175, 193, 141, 222, 186, 231, 241, 242, 219,
+	239, 246, 209, 87, 218, 230, 103, 204, 89, 228,
+	215, 152, 132, 133, 88, 0, 190, 111, 118, 108,
+	165, 225, 226, 107, 249, 95, 238, 91, 96, 237,
+	159, 221, 229, 153, 146, 90, 227, 151, 145, 136,
+	115, 125, 183, 143, 184, 126, 156, 155, 157, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 405, 0, 213, 235, 250, 100,
+	421, 220, 244, 245, 0, 0, 101, 119, 114, 0,
+	182, 158, 97, 128, 210, 135, 142, 189, 248, 172,
+	195, 104, 234, 211, 417, 420, 415, 416, 464, 465,
+	511, 512, 513, 489, 411, 0, 418, 419, 0, 494,
+	501, 502, 468, 92, 139, 247, 187, 117, 236, 401,
+	414, 110, 424, 0, 0, 436, 441, 442, 454, 456,
+	457, 458, 459, 467, 474, 475, 477, 483, 484, 485,
+	486, 491, 498, 517, 85, 86, 93, 99, 105, 109,
+	113, 116, 121, 124, 127, 129, 130, 131, 134, 144,
+	147, 148, 149, 150, 160, 161, 162, 164, 167, 168,
+	169, 170, 171, 174, 176, 177, 178, 179, 180, 181,
+	188, 191, 197, 198, 199, 200, 201, 202, 203, 205,
+	206, 207, 208, 214, 217, 223, 224, 233, 240, 243,
+	505, 493, 0, 449, 508, 423, 439, 516, 440, 443,
+	481, 408, 463, 166, 437, 518, 519, 0, 427, 403,
+	433, 404, 425, 451, 112, 455, 422, 495, 466, 507,
+	138, 514, 140, 472, 0, 212, 154, 0, 0, 453,
+	497, 460, 490, 448, 482, 413, 471, 509, 438, 479,
+	510, 83, 0, 0, 0, 81, 0, 0, 0, 0,
+	0, 0, 0, 0, 102, 0, 476, 504, 435, 478,
+	480, 402, 473, 0, 406, 409, 515, 500, 430, 431,
+	0, 0, 0, 0, 0, 0, 0, 452, 461, 462,
+	487, 446, 0, 0, 0, 0, 0, 0, 0, 0,
+	428, 0, 470, 0, 0, 0, 410, 407, 0, 0,
+	450, 0, 0, 0, 412, 0, 429, 488, 0, 400,
+	120, 492, 499, 266, 0, 447, 267, 503, 445, 444,
+	506, 185, 0, 216, 123, 137, 98, 84, 94, 0,
+	122, 163, 192, 196, 496, 426, 434, 106, 432, 194,
+	173, 232, 469, 175, 193, 141, 222, 186, 231, 241,
+	242, 219, 239, 246, 209, 87, 218, 230, 103, 204,
+	89, 228, 215, 152, 132, 133, 88, 0, 190, 111,
+	118, 108, 165, 225, 226, 107, 249, 95, 238, 91,
+	398, 237, 159, 221, 229, 153, 146, 90, 227, 151,
+	145, 136, 115, 125, 183, 143, 184, 126, 156, 155,
+	157, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 405, 0, 213, 235,
+	250, 100, 421, 220, 244, 245, 0, 0, 101, 119,
+	114, 0, 182, 399, 397, 128, 210, 135, 142, 189,
+	248, 172, 195, 104, 234, 211, 417, 420, 415, 416,
+	464, 465, 511, 512, 513, 489, 411, 0, 418, 419,
+	0, 494, 501, 502, 468, 92, 139, 247, 187, 117,
+	236, 401, 414, 110, 424, 0, 0, 436, 441, 442,
+	454, 456, 457, 458, 459, 467, 474, 475, 477, 483,
+	484, 485, 486, 491, 498, 517, 85, 86, 93, 99,
+	105, 109, 113, 116, 121, 124, 127, 129, 130, 131,
+	134, 144, 147, 148, 149, 150, 160, 161, 162, 164,
+	167, 168, 169, 170, 171, 174, 176, 177, 178, 179,
+	180, 181, 188, 191, 197, 198, 199, 200, 201, 202,
+	203, 205, 206, 207, 208, 214, 217, 223, 224, 233,
+	240, 243, 505, 493, 0, 449, 508, 423, 439, 516,
+	440, 443, 481, 408, 463, 166, 437, 518, 519, 0,
+	427, 403, 433, 404, 425, 451, 112, 455, 422, 495,
+	466, 507, 138, 514, 140, 472, 0, 212, 154, 0,
+	0, 453, 497, 460, 490, 448, 482, 413, 471, 509,
+	438, 479, 510, 83, 0, 0, 0, 264, 0, 0,
+	0, 0, 0, 0, 0, 0, 102, 0, 476, 504,
+	435, 478, 480, 402, 473, 0, 406, 409, 515, 500,
+	430, 431, 0, 0, 0, 0, 0, 0, 0, 452,
+	461, 462, 487, 446, 0, 0, 0, 0, 0, 0,
+	0, 0, 428, 0, 470, 0, 0, 0, 410, 407,
+	0, 0, 450, 0, 0, 0, 412, 0, 429, 488,
+	0, 400, 120, 492, 499, 266, 0, 447, 267, 503,
+	445, 444, 506, 185, 0, 216, 123, 137, 98, 84,
+	94, 0, 122, 163, 192, 196, 496, 426, 434, 106,
+	432, 194, 173, 232, 469, 175, 193, 141, 222, 186,
+	231, 241, 242, 219, 239, 246, 209, 87, 218, 230,
+	103, 204, 89, 228, 215, 152, 132, 133, 88, 0,
+	190, 111, 118, 108, 165, 225, 226, 107, 249, 95,
+	238, 91, 96, 237, 159, 221, 229, 153, 146, 90,
+	227, 151, 145, 136, 115, 125, 183, 143, 184, 126,
+	156, 155, 157, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 405, 0,
+	213, 235, 250, 100, 421, 220, 244, 245, 0, 0,
+	101, 119, 114, 0, 182, 158, 97, 128, 210, 135,
+	142, 189, 248, 172, 195, 104, 234, 211, 417, 420,
+	415, 416, 464, 465, 511, 512, 513, 489, 411, 0,
+	418, 419, 0, 494, 501, 502, 468, 92, 139, 247,
+	187, 117, 236, 401, 414, 110, 424, 0, 0, 436,
+	441, 442, 454, 456, 457, 458, 459, 467, 474, 475,
+	477, 483, 484, 485, 486, 491, 498, 517, 85, 86,
+	93, 99, 105, 109, 113, 116, 121, 124, 127, 129,
+	130, 131, 134, 144, 147, 148, 149, 150, 160, 161,
+	162, 164, 167, 168, 169, 170, 171, 174, 176, 177,
+	178, 179, 180, 181, 188, 191, 197, 198, 199, 200,
+	201, 202, 203, 205, 206, 207, 208, 214, 217, 223,
+	224, 233, 240, 243, 505, 493, 0, 449, 508, 423,
+	439, 516, 440, 443, 481, 408, 463, 166, 437, 518,
+	519, 0, 427, 403, 433, 404, 425, 451, 112, 455,
+	422, 495, 466, 507, 138, 514, 140, 472, 0, 212,
+	154, 0, 0, 453, 497, 460, 490, 448, 482, 413,
+	471, 509, 438, 479, 510, 83, 0, 0, 0, 81,
+	0, 0, 0, 0, 0, 0, 0, 0, 102, 0,
+	476, 504, 435, 478, 480, 402, 473, 0, 406, 409,
+	515, 500, 430, 431, 0, 0, 0, 0, 0, 0,
+	0, 452, 461, 462, 487, 446, 0, 0, 0, 0,
+	0, 0, 0, 0, 428, 0, 470, 0, 0, 0,
+	410, 407, 0, 0, 450, 0, 0, 0, 412, 0,
+	429, 488, 0, 400, 120, 492, 499, 266, 0, 447,
+	267, 503, 445, 444, 506, 185, 0, 216, 123, 137,
+	98, 84, 94, 0, 122, 163, 192, 196, 496, 426,
+	434, 106, 432, 194, 173, 232, 469, 175, 193, 141,
+	222, 186, 231, 241, 242, 219, 239, 246, 209, 87,
+	218, 711, 103, 204, 89, 228, 215, 152, 132, 133,
+	88, 0, 190, 111, 118, 108, 165, 225, 226, 107,
+	249, 95, 238, 91, 398, 237, 159, 221, 229, 153,
+	146, 90, 227, 151, 145, 136, 115, 125, 183, 143,
+	184, 126, 156, 155, 157, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	405, 0, 213, 235, 250, 100, 421, 220, 244, 245,
+	0, 0, 101, 119, 114, 0, 182, 399, 397, 128,
+	210, 135, 142, 189, 248, 172, 195, 104, 234, 211,
+	417, 420, 415, 416, 464, 465, 511, 512, 513, 489,
+	411, 0, 418, 419, 0, 494, 501, 502, 468, 92,
+	139, 247, 187, 117, 236, 401, 414, 110, 424, 0,
+	0, 436, 441, 442, 454, 456, 457, 458, 459, 467,
+	474, 475, 477, 483, 484, 485, 486, 491, 498, 517,
+	85, 86, 93, 99, 105, 109, 113, 116, 121, 124,
+	127, 129, 130, 131, 134, 144, 147, 148, 149, 150,
+	160, 161, 162, 164, 167, 168, 169, 170, 171, 174,
+	176, 177, 178, 179, 180, 181, 188, 191, 197, 198,
+	199, 200, 201, 202, 203, 205, 206, 207, 208, 214,
+	217, 223, 224, 233, 240, 243, 505, 493, 0, 449,
+	508, 423, 439, 516, 440, 443, 481, 408, 463, 166,
+	437, 518, 519, 0, 427, 403, 433, 404, 425, 451,
+	112, 455, 422, 495, 466, 507, 138, 514, 140, 472,
+	0, 212, 154, 0, 0, 453, 497, 460, 490, 448,
+	482, 413, 471, 509, 438, 479, 510, 83, 0, 0,
+	0, 81, 0, 0, 0, 0, 0, 0, 0, 0,
+	102, 0, 476, 504, 435, 478, 480, 402, 473, 0,
+	406, 409, 515, 500, 430, 431, 0, 0, 0, 0,
+	0, 0, 0, 452, 461, 462, 487, 446, 0, 0,
+	0, 0, 0, 0, 0, 0, 428, 0, 470, 0,
+	0, 0, 410, 407, 0, 0, 450, 0, 0, 0,
+	412, 0, 429, 488, 0, 400, 120, 492, 499, 266,
+	0, 447, 267, 503, 445, 444, 506, 185, 0, 216,
+	123, 137, 98, 84, 94, 0, 122, 163, 192, 196,
+	496, 426, 434, 106, 432, 194, 173, 232, 469, 175,
+	193, 141, 222, 186, 231, 241, 242, 219, 239, 246,
+	209, 87, 218, 389, 103, 204, 89, 228, 215, 152,
+	132, 133, 88, 0, 190, 111, 118, 108, 165, 225,
+	226, 107, 249, 95, 238, 91, 398, 237, 159, 221,
+	229, 153, 146, 90, 227, 151, 145, 136, 115, 125,
+	183, 143, 184, 126, 156, 155, 157, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 405, 0, 213, 235, 250, 100, 421, 220,
+	244, 245, 0, 0, 101, 119, 114, 0, 182, 399,
+	397, 392, 391, 135, 142, 189, 248, 172, 195, 104,
+	234, 211, 417, 420, 415, 416, 464, 465, 511, 512,
+	513, 489, 411, 0, 418, 419, 0, 494, 501, 502,
+	468, 92, 139, 247, 187, 117, 236, 401, 414, 110,
+	424, 0, 0, 436, 441, 442, 454, 456, 457, 458,
+	459, 467, 474, 475, 477, 483, 484, 485, 486, 491,
+	498, 517, 85, 86, 93, 99, 105, 109, 113, 116,
+	121, 124, 127, 129, 130, 131, 134, 144, 147, 148,
+	149, 150, 160, 161, 162, 164, 167, 168, 169, 170,
+	171, 174, 176, 177, 178, 179, 180, 181, 188, 191,
+	197, 198, 199, 200, 201, 202, 203, 205, 206, 207,
+	208, 214, 217, 223, 224, 233, 240, 243, 166, 0,
+	0, 0, 0, 0, 0, 324, 0, 0, 0, 112,
+	0, 321, 0, 0, 0, 138, 364, 140, 0, 0,
+	212, 154, 0, 0, 0, 0, 355, 356, 0, 0,
+	0, 0, 0, 0, 0, 0, 83, 54, 0, 0,
+	322, 343, 342, 345, 346, 347, 348, 0, 0, 102,
+	344, 349, 350, 351, 0, 0, 0, 319, 336, 0,
+	363, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 333, 334, 0, 0, 0, 0, 377, 0, 335,
+	0, 0, 330, 331, 332, 337, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 120, 0, 1161, 266, 1162,
+	0, 267, 0, 0, 375, 0, 185, 0, 216, 123,
+	137, 98, 84, 94, 0, 122, 163, 192, 196, 0,
+	0, 0, 106, 0, 194, 173, 232, 0, 175, 193,
+	141, 222, 186, 231, 241, 242, 219, 239, 246, 209,
+	87, 218, 230, 103, 204, 89, 228, 215, 152, 132,
+	133, 88, 0, 190, 111, 118, 108, 165, 225, 226,
+	107, 249, 95, 238, 91, 96, 237, 159, 221, 229,
+	153, 146, 90, 227, 151, 145, 136, 115, 125, 183,
+	143, 184, 126, 156, 155, 157, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 213, 235, 250, 100, 0, 220, 244,
+	245, 0, 0, 101, 119, 114, 0, 182, 158, 97,
+	128, 210, 135, 142, 189, 248, 172, 195, 104, 234,
+	211, 365, 376, 371, 372, 369, 370, 368, 367, 366,
+	378, 357, 358, 359, 360, 362, 0, 373, 374, 361,
+	92, 139, 247, 187, 117, 236, 0, 0, 110, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 85, 86, 93, 99, 105, 109, 113, 116, 121,
+	124, 127, 129, 130, 131, 134, 144, 147, 148, 149,
+	150, 160, 161, 162, 164, 167, 168, 169, 170, 171,
+	174, 176, 177, 178, 179, 180, 181, 188, 191, 197,
+	198, 199, 200, 201, 202, 203, 205, 206, 207, 208,
+	214, 217, 223, 224, 233, 240, 243, 166, 0, 0,
+	0, 0, 0, 0, 324, 0, 0, 0, 112, 0,
+	321, 0, 0, 0, 138, 364, 140, 0, 0, 212,
+	154, 0, 0, 0, 0, 355, 356, 0, 0, 0,
+	0, 0, 0, 975, 0, 83, 54, 0, 0, 322,
+	343, 342, 345, 346, 347, 348, 0, 0, 102, 344,
+	349, 350, 351, 976, 0, 0, 319, 336, 0, 363,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	333, 334, 0, 0, 0, 0, 377, 0, 335, 0,
+	0, 330, 331, 332, 337, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 120, 0, 0, 266, 0, 0,
+	267, 0, 0, 375, 0, 185, 0, 216, 123, 137,
+	98, 84, 94, 0, 122, 163, 192, 196, 0, 0,
+	0, 106, 0, 194, 173, 232, 0, 175, 193, 141,
+	222, 186, 231, 241, 242, 219, 239, 246, 209, 87,
+	218, 230, 103, 204, 89, 228, 215, 152, 132, 133,
+	88, 0, 190, 111, 118, 108, 165, 225, 226, 107,
+	249, 95, 238, 91, 96, 237, 159, 221, 229, 153,
+	146, 90, 227, 151, 145, 136, 115, 125, 183, 143,
+	184, 126, 156, 155, 157, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 213, 235, 250, 100, 0, 220, 244, 245,
+	0, 0, 101, 119, 114, 0, 182, 158, 97, 128,
+	210, 135, 142, 189, 248, 172, 195, 104, 234, 211,
+	365, 376, 371, 372, 369, 370, 368, 367, 366, 378,
+	357, 358, 359, 360, 362, 0, 373, 374, 361, 92,
+	139, 247, 187, 117, 236, 0, 0, 110, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	85, 86, 93, 99, 105, 109, 113, 116, 121, 124,
+	127, 129, 130, 131, 134, 144, 147, 148, 149, 150,
+	160, 161, 162, 164, 167, 168, 169, 170, 171, 174,
+	176, 177, 178, 179, 180, 181, 188, 191, 197, 198,
+	199, 200, 201, 202, 203, 205, 206, 207, 208, 214,
+	217, 223, 224, 233, 240, 243, 166, 0, 0, 0,
+	0, 901, 0, 324, 0, 0, 0, 112, 0, 321,
+	0, 0, 0, 138, 364, 140, 0, 0, 212, 154,
+	0, 0, 0, 0, 355, 356, 0, 0, 0, 0,
+	0, 0, 0, 0, 83, 54, 0, 0, 322, 343,
+	342, 345, 346, 347, 348, 0, 0, 102, 344, 349,
+	350, 351, 0, 0, 0, 319, 336, 0, 363, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 333,
+	334, 315, 0, 0, 0, 377, 0, 335, 0, 0,
+	330, 331, 332, 337, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 120, 0, 0, 266, 0, 0, 267,
+	0, 0, 375, 0, 185, 0, 216, 123, 137, 98,
+	84, 94, 0, 122, 163, 192, 196, 0, 0, 0,
+	106, 0, 194, 173, 232, 0, 175, 193, 141, 222,
+	186, 231, 241, 242, 219, 239, 246, 209, 87, 218,
+	230, 103, 204, 89, 228, 215, 152, 132, 133, 88,
+	0, 190, 111, 118, 108, 165, 225, 226, 107, 249,
+	95, 238, 91, 96, 237, 159, 221, 229, 153, 146,
+	90, 227, 151, 145, 136, 115, 125, 183, 143, 184,
+	126, 156, 155, 157, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 213, 235, 250, 100, 0, 220, 244, 245, 0,
+	0, 101, 119, 114, 0, 182, 158, 97, 128, 210,
+	135, 142, 189, 248, 172, 195, 104, 234, 211, 365,
+	376, 371, 372, 369, 370, 368, 367, 366, 378, 357,
+	358, 359, 360, 362, 0, 373, 374, 361, 92, 139,
+	247, 187, 117, 236, 0, 0, 110, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 85,
+	86, 93, 99, 105, 109, 113, 116, 121, 124, 127,
+	129, 130, 131, 134, 144, 147, 148, 149, 150, 160,
+	161, 162, 164, 167, 168, 169, 170, 171, 174, 176,
+	177, 178, 179, 180, 181, 188, 191, 197, 198, 199,
+	200, 201, 202, 203, 205, 206, 207, 208, 214, 217,
+	223, 224, 233, 240, 243, 166, 0, 0, 0, 0,
+	0, 0, 324, 0, 0, 0, 112, 0, 321, 0,
+	0, 0, 138, 364, 140, 0, 0, 212, 154, 0,
+	0, 0, 0, 355, 356, 0, 0, 0, 0, 0,
+	0, 0, 0, 83, 54, 0, 582, 322, 343, 342,
+	345, 346, 347, 348, 0, 0, 102, 344, 349, 350,
+	351, 0, 0, 0, 319, 336, 0, 363, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 333, 334,
+	0, 0, 0, 0, 377, 0, 335, 0, 0, 330,
+	331, 332, 337, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 120, 0, 0, 266, 0, 0, 267, 0,
+	0, 375, 0, 185, 0, 216, 123, 137, 98, 84,
+	94, 0, 122, 163, 192, 196, 0, 0, 0, 106,
+	0, 194, 173, 232, 0, 175, 193, 141, 222, 186,
+	231, 241, 242, 219, 239, 246, 209, 87, 218, 230,
+	103, 204, 89, 228, 215, 152, 132, 133, 88, 0,
+	190, 111, 118, 108, 165, 225, 226, 107, 249, 95,
+	238, 91, 96, 237, 159, 221, 229, 153, 146, 90,
+	227, 151, 145, 136, 115, 125, 183, 143, 184, 126,
+	156, 155, 157, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	213, 235, 250, 100, 0, 220, 244, 245, 0, 0,
+	101, 119, 114, 0, 182, 158, 97, 128, 210, 135,
+	142, 189, 248, 172, 195, 104, 234, 211, 365, 376,
+	371, 372, 369, 370, 368, 367, 366, 378, 357, 358,
+	359, 360, 362, 0, 373, 374, 361, 92, 139, 247,
+	187, 117, 236, 0, 0, 110, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 85, 86,
+	93, 99, 105, 109, 113, 116, 121, 124, 127, 129,
+	130, 131, 134, 144, 147, 148, 149, 150, 160, 161,
+	162, 164, 167, 168, 169, 170, 171, 174, 176, 177,
+	178, 179, 180, 181, 188, 191, 197, 198, 199, 200,
+	201, 202, 203, 205, 206, 207, 208, 214, 217, 223,
+	224, 233, 240, 243, 166, 0, 0, 0, 0, 0,
+	0, 324, 0, 0, 0, 112, 0, 321, 0, 0,
+	0, 138, 364, 140, 0, 0, 212, 154, 0, 0,
+	0, 0, 355, 356, 0, 0, 0, 0, 0, 0,
+	0, 0, 83, 54, 0, 0, 322, 343, 342, 345,
+	346, 347, 348, 0, 0, 102, 344, 349, 350, 351,
+	0, 0, 0, 319, 336, 0, 363, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 333, 334, 315,
+	0, 0, 0, 377, 0, 335, 0, 0, 330, 331,
+	332, 337, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 120, 0, 0, 266, 0, 0, 267, 0, 0,
+	375, 0, 185, 0, 216, 123, 137, 98, 84, 94,
+	0, 122, 163, 192, 196, 0, 0, 0, 106, 0,
+	194, 173, 232, 0, 175, 193, 141, 222, 186, 231,
+	241, 242, 219, 239, 246, 209, 87, 218, 230, 103,
+	204, 89, 228, 215, 152, 132, 133, 88, 0, 190,
+	111, 118, 108, 165, 225, 226, 107, 249, 95, 238,
+	91, 96, 237, 159, 221, 229, 153, 146, 90, 227,
+	151, 145, 136, 115, 125, 183, 143, 184, 126, 156,
+	155, 157, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 213,
+	235, 250, 100, 0, 220, 244, 245, 0, 0, 101,
+	119, 114, 0, 182, 158, 97, 128, 210, 135, 142,
+	189, 248, 172, 195, 104, 234, 211, 365, 376, 371,
+	372, 369, 370, 368, 367, 366, 378, 357, 358, 359,
+	360, 362, 0, 373, 374, 361, 92, 139, 247, 187,
+	117, 236, 0, 0, 110, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 85, 86, 93,
+	99, 105, 109, 113, 116, 121, 124, 127, 129, 130,
+	131, 134, 144, 147, 148, 149, 150, 160, 161, 162,
+	164, 167, 168, 169, 170, 171, 174, 176, 177, 178,
+	179, 180, 181, 188, 191, 197, 198, 199, 200, 201,
+	202, 203, 205, 206, 207, 208, 214, 217, 223, 224,
+	233, 240, 243, 166, 0, 0, 0, 0, 0, 0,
+	324, 0, 0, 0, 112, 0, 321, 0, 0, 0,
+	138, 364, 140, 0, 0, 212, 154, 0, 0, 0,
+	0, 355, 356, 0, 0, 0, 0, 0, 0, 0,
+	0, 83, 54, 0, 0, 322, 343, 916, 345, 346,
+	347, 348, 0, 0, 102, 344, 349, 350, 351, 0,
+	0, 0, 319, 336, 0, 363, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 333, 334, 315, 0,
+	0, 0, 377, 0, 335, 0, 0, 330, 331, 332,
+	337, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	120, 0, 0, 266, 0, 0, 267, 0, 0, 375,
+	0, 185, 0, 216, 123, 137, 98, 84, 94, 0,
+	122, 163, 192, 196, 0, 0, 0, 106, 0, 194,
+	173, 232, 0, 175, 193, 141, 222, 186, 231, 241,
+	242, 219, 239, 246, 209, 87, 218, 230, 103, 204,
+	89, 228, 215, 152, 132, 133, 88, 0, 190, 111,
+	118, 108, 165, 225, 226, 107, 249, 95, 238, 91,
+	96, 237, 159, 221, 229, 153, 146, 90, 227, 151,
+	145, 136, 115, 125, 183, 143, 184, 126, 156, 155,
+	157, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 213, 235,
+	250, 100, 0, 220, 244, 245, 0, 0, 101, 119,
+	114, 0, 182, 158, 97, 128, 210, 135, 142, 189,
+	248, 172, 195, 104, 234, 211, 365, 376, 371, 372,
+	369, 370, 368, 367, 366, 378, 357, 358, 359, 360,
+	362, 0, 373, 374, 361, 92, 139, 247, 187, 117,
+	236, 0, 0, 110, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 85, 86, 93, 99,
+	105, 109, 113, 116, 121, 124, 127, 129, 130, 131,
+	134, 144, 147, 148, 149, 150, 160, 161, 162, 164,
+	167, 168, 169, 170, 171, 174, 176, 177, 178, 179,
+	180, 181, 188, 191, 197, 198, 199, 200, 201, 202,
+	203, 205, 206, 207, 208, 214, 217, 223, 224, 233,
+	240, 243, 166, 0, 0, 0, 0, 0, 0, 324,
+	0, 0, 0, 112, 0, 321, 0, 0, 0, 138,
+	364, 140, 0, 0, 212, 154, 0, 0, 0, 0,
+	355, 356, 0, 0, 0, 0, 0, 0, 0, 0,
+	83, 54, 0, 0, 322, 343, 913, 345, 346, 347,
+	348, 0, 0, 102, 344, 349, 350, 351, 0, 0,
+	0, 319, 336, 0, 363, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 333, 334, 315, 0, 0,
+	0, 377, 0, 335, 0, 0, 330, 331, 332, 337,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 120,
+	0, 0, 266, 0, 0, 267, 0, 0, 375, 0,
+	185, 0, 216, 123, 137, 98, 84, 94, 0, 122,
+	163, 192, 196, 0, 0, 0, 106, 0, 194, 173,
+	232, 0, 175, 193, 141, 222, 186, 231, 241, 242,
+	219, 239, 246, 209, 87, 218, 230, 103, 204, 89,
+	228, 215, 152, 132, 133, 88, 0, 190, 111, 118,
+	108, 165, 225, 226, 107, 249, 95, 238, 91, 96,
+	237, 159, 221, 229, 153, 146, 90, 227, 151, 145,
+	136, 115, 125, 183, 143, 184, 126, 156, 155, 157,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 213, 235, 250,
+	100, 0, 220, 244, 245, 0, 0, 101, 119, 114,
+	0, 182, 158, 97, 128, 210, 135, 142, 189, 248,
+	172, 195, 104, 234, 211, 365, 376, 371, 372, 369,
+	370, 368, 367, 366, 378, 357, 358, 359, 360, 362,
+	0, 373, 374, 361, 92, 139, 247, 187, 117, 236,
+	0, 0, 110, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 85, 86, 93, 99, 105,
+	109, 113, 116, 121, 124, 127, 129, 130, 131, 134,
+	144, 147, 148, 149, 150, 160, 161, 162, 164, 167,
+	168, 169, 170, 171, 174, 176, 177, 178, 179, 180,
+	181, 188, 191, 197, 198, 199, 200, 201, 202, 203,
+	205, 206, 207, 208, 214, 217, 223, 224, 233, 240,
+	243, 24, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 166, 0, 0, 0, 0, 0, 0,
+	324, 0, 0, 0, 112, 0, 321, 0, 0, 0,
+	138, 364, 140, 0, 0, 212, 154, 0, 0, 0,
+	0, 355, 356, 0, 0, 0, 0, 0, 0, 0,
+	0, 83, 54, 0, 0, 322, 343, 342, 345, 346,
+	347, 348, 0, 0, 102, 344, 349, 350, 351, 0,
+	0, 0, 319, 336, 0, 363, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 333, 334, 0, 0,
+	0, 0, 377, 0, 335, 0, 0, 330, 331, 332,
+	337, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	120, 0, 0, 266, 0, 0, 267, 0, 0, 375,
+	0, 185, 0, 216, 123, 137, 98, 84, 94, 0,
+	122, 163, 192, 196, 0, 0, 0, 106, 0, 194,
+	173, 232, 0, 175, 193, 141, 222, 186, 231, 241,
+	242, 219, 239, 246, 209, 87, 218, 230, 103, 204,
+	89, 228, 215, 152, 132, 133, 88, 0, 190, 111,
+	118, 108, 165, 225, 226, 107, 249, 95, 238, 91,
+	96, 237, 159, 221, 229, 153, 146, 90, 227, 151,
+	145, 136, 115, 125, 183, 143, 184, 126, 156, 155,
+	157, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 213, 235,
+	250, 100, 0, 220, 244, 245, 0, 0, 101, 119,
+	114, 0, 182, 158, 97, 128, 210, 135, 142, 189,
+	248, 172, 195, 104, 234, 211, 365, 376, 371, 372,
+	369, 370, 368, 367, 366, 378, 357, 358, 359, 360,
+	362, 0, 373, 374, 361, 92, 139, 247, 187, 117,
+	236, 0, 0, 110, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 85, 86, 93, 99,
+	105, 109, 113, 116, 121, 124, 127, 129, 130, 131,
+	134, 144, 147, 148, 149, 150, 160, 161, 162, 164,
+	167, 168, 169, 170, 171, 174, 176, 177, 178, 179,
+	180, 181, 188, 191, 197, 198, 199, 200, 201, 202,
+	203, 205, 206, 207, 208, 214, 217, 223, 224, 233,
+	240, 243, 166, 0, 0, 0, 0, 0, 0, 324,
+	0, 0, 0, 112, 0, 321, 0, 0, 0, 138,
+	364, 140, 0, 0, 212, 154, 0, 0, 0, 0,
+	355, 356, 0, 0, 0, 0, 0, 0, 0, 0,
+	83, 54, 0, 0, 322, 343, 342, 345, 346, 347,
+	348, 0, 0, 102, 344, 349, 350, 351, 0, 0,
+	0, 319, 336, 0, 363, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 333, 334, 0, 0, 0,
+	0, 377, 0, 335, 0, 0, 330, 331, 332, 337,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 120,
+	0, 0, 266, 0, 0, 267, 0, 0, 375, 0,
+	185, 0, 216, 123, 137, 98, 84, 94, 0, 122,
+	163, 192, 196, 0, 0, 0, 106, 0, 194, 173,
+	232, 0, 175, 193, 141, 222, 186, 231, 241, 242,
+	219, 239, 246, 209, 87, 218, 230, 103, 204, 89,
+	228, 215, 152, 132, 133, 88, 0, 190, 111, 118,
+	108, 165, 225, 226, 107, 249, 95, 238, 91, 96,
+	237, 159, 221, 229, 153, 146, 90, 227, 151, 145,
+	136, 115, 125, 183, 143, 184, 126, 156, 155, 157,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 213, 235, 250,
+	100, 0, 220, 244, 245, 0, 0, 101, 119, 114,
+	0, 182, 158, 97, 128, 210, 135, 142, 189, 248,
+	172, 195, 104, 234, 211, 365, 376, 371, 372, 369,
+	370, 368, 367, 366, 378, 357, 358, 359, 360, 362,
+	0, 373, 374, 361, 92, 139, 247, 187, 117, 236,
+	0, 0, 110, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 85, 86, 93, 99, 105,
+	109, 113, 116, 121, 124, 127, 129, 130, 131, 134,
+	144, 147, 148, 149, 150, 160, 161, 162, 164, 167,
+	168, 169, 170, 171, 174, 176, 177, 178, 179, 180,
+	181, 188, 191, 197, 198, 199, 200, 201, 202, 203,
+	205, 206, 207, 208, 214, 217, 223, 224, 233, 240,
+	243, 166, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 112, 0, 0, 0, 0, 0, 138, 364,
+	140, 0, 0, 212, 154, 0, 0, 0, 0, 355,
+	356, 0, 0, 0, 0, 0, 0, 0, 0, 83,
+	54, 0, 0, 322, 343, 342, 345, 346, 347, 348,
+	0, 0, 102, 344, 349, 350, 351, 0, 0, 0,
+	0, 336, 0, 363, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 333, 334, 0, 0, 0, 0,
+	377, 0, 335, 0, 0, 330, 331, 332, 337, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 120, 0,
+	0, 266, 0, 0, 267, 0, 0, 375, 0, 185,
+	0, 216, 123, 137, 98, 84, 94, 0, 122, 163,
+	192, 196, 0, 0, 0, 106, 0, 194, 173, 232,
+	1585, 175, 193, 141, 222, 186, 231, 241, 242, 219,
+	239, 246, 209, 87, 218, 230, 103, 204, 89, 228,
+	215, 152, 132, 133, 88, 0, 190, 111, 118, 108,
+	165, 225, 226, 107, 249, 95, 238, 91, 96, 237,
+	159, 221, 229, 153, 146, 90, 227, 151, 145, 136,
+	115, 125, 183, 143, 184, 126, 156, 155, 157, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 213, 235, 250, 100,
+	0, 220, 244, 245, 0, 0, 101, 119, 114, 0,
+	182, 158, 97, 128, 210, 135, 142, 189, 248, 172,
+	195, 104, 234, 211, 365, 376, 371, 372, 369, 370,
+	368, 367, 366, 378, 357, 358, 359, 360, 362, 0,
+	373, 374, 361, 92, 139, 247, 187, 117, 236, 0,
+	0, 110, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 85, 86, 93, 99, 105, 109,
+	113, 116, 121, 124, 127, 129, 130, 131, 134, 144,
+	147, 148, 149, 150, 160, 161, 162, 164, 167, 168,
+	169, 170, 171, 174, 176, 177, 178, 179, 180, 181,
+	188, 191, 197, 198, 199, 200, 201, 202, 203, 205,
+	206, 207, 208, 214, 217, 223, 224, 233, 240, 243,
+	166, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 112, 0, 0, 0, 0, 0, 138, 364, 140,
+	0, 0, 212, 154, 0, 0, 0, 0, 355, 356,
+	0, 0, 0, 0, 0, 0, 0, 0, 83, 54,
+	0, 582, 322, 343, 342, 345, 346, 347, 348, 0,
+	0, 102, 344, 349, 350, 351, 0, 0, 0, 0,
+	336, 0, 363, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 333, 334, 0, 0, 0, 0, 377,
+	0, 335, 0, 0, 330, 331, 332, 337, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 120, 0, 0,
+	266, 0, 0, 267, 0, 0, 375, 0, 185, 0,
+	216, 123, 137, 98, 84, 94, 0, 122, 163, 192,
+	196, 0, 0, 0, 106, 0, 194, 173, 232, 0,
+	175, 193, 141, 222, 186, 231, 241, 242, 219, 239,
+	246, 209, 87, 218, 230, 103, 204, 89, 228, 215,
+	152, 132, 133, 88, 0, 190, 111, 118, 108, 165,
+	225, 226, 107, 249, 95, 238, 91, 96, 237, 159,
+	221, 229, 153, 146, 90, 227, 151, 145, 136, 115,
+	125, 183, 143, 184, 126, 156, 155, 157, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 213, 235, 250, 100, 0,
+	220, 244, 245, 0, 0, 101, 119, 114, 0, 182,
+	158, 97, 128, 210, 135, 142, 189, 248, 172, 195,
+	104, 234, 211, 365, 376, 371, 372, 369, 370, 368,
+	367, 366, 378, 357, 358, 359, 360, 362, 0, 373,
+	374, 361, 92, 139, 247, 187, 117, 236, 0, 0,
+	110, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 85, 86, 93, 99, 105, 109, 113,
+	116, 121, 124, 127, 129, 130, 131, 134, 144, 147,
+	148, 149, 150, 160, 161, 162, 164, 167, 168, 169,
+	170, 171, 174, 176, 177, 178, 179, 180, 181, 188,
+	191, 197, 198, 199, 200, 201, 202, 203, 205, 206,
+	207, 208, 214, 217, 223, 224, 233, 240, 243, 166,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	112, 0, 0, 0, 0, 0, 138, 364, 140, 0,
+	0, 212, 154, 0, 0, 0, 0, 355, 356, 0,
+	0, 0, 0, 0, 0, 0, 0, 83, 54, 0,
+	0, 322, 343, 342, 345, 346, 347, 348, 0, 0,
+	102, 344, 349, 350, 351, 0, 0, 0, 0, 336,
+	0, 363, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 333, 334, 0, 0, 0, 0, 377, 0,
+	335, 0, 0, 330, 331, 332, 337, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 120, 0, 0, 266,
+	0, 0, 267, 0, 0, 375, 0, 185, 0, 216,
+	123, 137, 98, 84, 94, 0, 122, 163, 192, 196,
+	0, 0, 0, 106, 0, 194, 173, 232, 0, 175,
+	193, 141, 222, 186, 231, 241, 242, 219, 239, 246,
+	209, 87, 218, 230, 103, 204, 89, 228, 215, 152,
+	132, 133, 88, 0, 190, 111, 118, 108, 165, 225,
+	226, 107, 249, 95, 238, 91, 96, 237, 159, 221,
+	229, 153, 146, 90, 227, 151, 145, 136, 115, 125,
+	183, 143, 184, 126, 156, 155, 157, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 213, 235, 250, 100, 0, 220,
+	244, 245, 0, 0, 101, 119, 114, 0, 182, 158,
+	97, 128, 210, 135, 142, 189, 248, 172, 195, 104,
+	234, 211, 365, 376, 371, 372, 369, 370, 368, 367,
+	366, 378, 357, 358, 359, 360, 362, 0, 373, 374,
+	361, 92, 139, 247, 187, 117, 236, 0, 0, 110,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 85, 86, 93, 99, 105, 109, 113, 116,
+	121, 124, 127, 129, 130, 131, 134, 144, 147, 148,
+	149, 150, 160, 161, 162, 164, 167, 168, 169, 170,
+	171, 174, 176, 177, 178, 179, 180, 181, 188, 191,
+	197, 198, 199, 200, 201, 202, 203, 205, 206, 207,
+	208, 214, 217, 223, 224, 233, 240, 243, 166, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 112,
+	0, 0, 0, 0, 0, 138, 0, 140, 0, 0,
+	212, 154, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 83, 0, 0, 0,
+	81, 0, 0, 0, 0, 0, 0, 0, 0, 102,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 617, 616, 626,
+	627, 619, 620, 621, 622, 623, 624, 625, 618, 0,
+	0, 628, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 120, 0, 0, 266, 0,
+	0, 267, 0, 0, 0, 0, 185, 0, 216, 123,
+	137, 98, 84, 94, 0, 122, 163, 192, 196, 0,
+	0, 0, 106, 0, 194, 173, 232, 0, 175, 193,
+	141, 222, 186, 231, 241, 242, 219, 239, 246, 209,
+	87, 218, 230, 103, 204, 89, 228, 215, 152, 132,
+	133, 88, 0, 190, 111, 118, 108, 165, 225, 226,
+	107, 249, 95, 238, 91, 96, 237, 159, 221, 229,
+	153, 146, 90, 227, 151, 145, 136, 115, 125, 183,
+	143, 184, 126, 156, 155, 157, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 213, 235, 250, 100, 0, 220, 244,
+	245, 0, 0, 101, 119, 114, 0, 182, 158, 97,
+	128, 210, 135, 142, 189, 248, 172, 195, 104, 234,
+	211, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	92, 139, 247, 187, 117, 236, 0, 0, 110, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 85, 86, 93, 99, 105, 109, 113, 116, 121,
+	124, 127, 129, 130, 131, 134, 144, 147, 148, 149,
+	150, 160, 161, 162, 164, 167, 168, 169, 170, 171,
+	174, 176, 177, 178, 179, 180, 181, 188, 191, 197,
+	198, 199, 200, 201, 202, 203, 205, 206, 207, 208,
+	214, 217, 223, 224, 233, 240, 243, 166, 0, 0,
+	0, 0, 0, 604, 0, 0, 0, 0, 112, 0,
+	0, 0, 0, 0, 138, 0, 140, 0, 0, 212,
+	154, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 83, 0, 0, 0, 81,
+	0, 606, 0, 0, 0, 0, 0, 0, 102, 0,
+	0, 0, 0, 0, 601, 600, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 602, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 120, 0, 0, 266, 0, 0,
+	267, 0, 0, 0, 0, 185, 0, 216, 123, 137,
+	98, 84, 94, 0, 122, 163, 192, 196, 0, 0,
+	0, 106, 0, 194, 173, 232, 0, 175, 193, 141,
+	222, 186, 231, 241, 242, 219, 239, 246, 209, 87,
+	218, 230, 103, 204, 89, 228, 215, 152, 132, 133,
+	88, 0, 190, 111, 118, 108, 165, 225, 226, 107,
+	249, 95, 238, 91, 96, 237, 159, 221, 229, 153,
+	146, 90, 227, 151, 145, 136, 115, 125, 183, 143,
+	184, 126, 156, 155, 157, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 213, 235, 250, 100, 0, 220, 244, 245,
+	0, 0, 101, 119, 114, 0, 182, 158, 97, 128,
+	210, 135, 142, 189, 248, 172, 195, 104, 234, 211,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 92,
+	139, 247, 187, 117, 236, 0, 0, 110, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	85, 86, 93, 99, 105, 109, 113, 116, 121, 124,
+	127, 129, 130, 131, 134, 144, 147, 148, 149, 150,
+	160, 161, 162, 164, 167, 168, 169, 170, 171, 174,
+	176, 177, 178, 179, 180, 181, 188, 191, 197, 198,
+	199, 200, 201, 202, 203, 205, 206, 207, 208, 214,
+	217, 223, 224, 233, 240, 243, 166, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 112, 0, 0,
+	0, 0, 0, 138, 0, 140, 0, 0, 212, 154,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 83, 0, 0, 0, 81, 0,
+	0, 0, 0, 0, 0, 0, 0, 102, 0, 0,
+	0, 0, 0, 74, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 120, 76, 77, 78, 0, 0, 73,
+	0, 0, 0, 79, 185, 0, 216, 123, 137, 98,
+	84, 94, 0, 122, 163, 192, 196, 0, 0, 0,
+	106, 0, 194, 173, 232, 0, 175, 193, 141, 222,
+	186, 231, 241, 242, 219, 239, 246, 209, 87, 218,
+	230, 103, 204, 89, 228, 215, 152, 132, 133, 88,
+	0, 190, 111, 118, 108, 165, 225, 226, 107, 249,
+	95, 238, 91, 96, 237, 159, 221, 229, 153, 146,
+	90, 227, 151, 145, 136, 115, 125, 183, 143, 184,
+	126, 156, 155, 157, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 213, 235, 250, 100, 0, 220, 244, 245, 0,
+	0, 101, 119, 114, 0, 182, 158, 97, 128, 210,
+	135, 142, 189, 248, 172, 195, 104, 234, 211, 0,
+	75, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 92, 139,
+	247, 187, 117, 236, 0, 0, 110, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 85,
+	86, 93, 99, 105, 109, 113, 116, 121, 124, 127,
+	129, 130, 131, 134, 144, 147, 148, 149, 150, 160,
+	161, 162, 164, 167, 168, 169, 170, 171, 174, 176,
+	177, 178, 179, 180, 181, 188, 191, 197, 198, 199,
+	200, 201, 202, 203, 205, 206, 207, 208, 214, 217,
+	223, 224, 233, 240, 243, 166, 0, 0, 0, 0,
+	0, 958, 0, 0, 0, 0, 112, 0, 0, 0,
+	0, 0, 138, 0, 140, 0, 0, 212, 154, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 83, 0, 0, 0, 264, 0, 960,
+	0, 0, 0, 0, 0, 0, 102, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 120, 0, 0, 266, 0, 0, 267, 0,
+	0, 0, 0, 185, 0, 216, 123, 137, 98, 84,
+	94, 0, 122, 163, 192, 196, 0, 0, 0, 106,
+	0, 194, 173, 232, 0, 175, 193, 141, 222, 186,
+	231, 241, 242, 219, 239, 246, 209, 87, 218, 230,
+	103, 204, 89, 228, 215, 152, 132, 133, 88, 0,
+	190, 111, 118, 108, 165, 225, 226, 107, 249, 95,
+	238, 91, 96, 237, 159, 221, 229, 153, 146, 90,
+	227, 151, 145, 136, 115, 125, 183, 143, 184, 126,
+	156, 155, 157, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 212, 234, 249, 99, 0, 219, 243, 244, 0,
-	0, 100, 118, 113, 0, 181, 157, 96, 127, 209,
-	134, 141, 188, 247, 171, 194, 103, 233, 210, 0,
+	213, 235, 250, 100, 0, 220, 244, 245, 0, 0,
+	101, 119, 114, 0, 182, 158, 97, 128, 210, 135,
+	142, 189, 248, 172, 195, 104, 234, 211, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 92, 139, 247,
+	187, 117, 236, 0, 0, 110, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 85, 86,
+	93, 99, 105, 109, 113, 116, 121, 124, 127, 129,
+	130, 131, 134, 144, 147, 148, 149, 150, 160, 161,
+	162, 164, 167, 168, 169, 170, 171, 174, 176, 177,
+	178, 179, 180, 181, 188, 191, 197, 198, 199, 200,
+	201, 202, 203, 205, 206, 207, 208, 214, 217, 223,
+	224, 233, 240, 243, 24, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 166, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 112, 0, 0,
+	0, 0, 0, 138, 0, 140, 0, 0, 212, 154,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 83, 54, 0, 0, 81, 0,
+	0, 0, 0, 0, 0, 0, 0, 102, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 120, 0, 0, 266, 0, 0, 267,
+	0, 0, 0, 0, 185, 0, 216, 123, 137, 98,
+	84, 94, 0, 122, 163, 192, 196, 0, 0, 0,
+	106, 0, 194, 173, 232, 0, 175, 193, 141, 222,
+	186, 231, 241, 242, 219, 239, 246, 209, 87, 218,
+	230, 103, 204, 89, 228, 215, 152, 132, 133, 88,
+	0, 190, 111, 118, 108, 165, 225, 226, 107, 249,
+	95, 238, 91, 96, 237, 159, 221, 229, 153, 146,
+	90, 227, 151, 145, 136, 115, 125, 183, 143, 184,
+	126, 156, 155, 157, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 82, 91,
-	138, 246, 186, 116, 235, 0, 0, 109, 0, 0,
+	0, 213, 235, 250, 100, 0, 220, 244, 245, 0,
+	0, 101, 119, 114, 0, 182, 158, 97, 128, 210,
+	135, 142, 189, 248, 172, 195, 104, 234, 211, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 92, 139,
+	247, 187, 117, 236, 0, 0, 110, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 85,
+	86, 93, 99, 105, 109, 113, 116, 121, 124, 127,
+	129, 130, 131, 134, 144, 147, 148, 149, 150, 160,
+	161, 162, 164, 167, 168, 169, 170, 171, 174, 176,
+	177, 178, 179, 180, 181, 188, 191, 197, 198, 199,
+	200, 201, 202, 203, 205, 206, 207, 208, 214, 217,
+	223, 224, 233, 240, 243, 24, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 166, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 112, 0,
+	0, 0, 0, 0, 138, 0, 140, 0, 0, 212,
+	154, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 83, 54, 0, 0, 698,
+	0, 0, 0, 0, 0, 0, 0, 0, 102, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 120, 0, 0, 266, 0, 0,
+	267, 0, 0, 0, 0, 185, 0, 216, 123, 137,
+	98, 84, 94, 0, 122, 163, 192, 196, 0, 0,
+	0, 106, 0, 194, 173, 232, 0, 175, 193, 141,
+	222, 186, 231, 241, 242, 219, 239, 246, 209, 87,
+	218, 230, 103, 204, 89, 228, 215, 152, 132, 133,
+	88, 0, 190, 111, 118, 108, 165, 225, 226, 107,
+	249, 95, 238, 91, 96, 237, 159, 221, 229, 153,
+	146, 90, 227, 151, 145, 136, 115, 125, 183, 143,
+	184, 126, 156, 155, 157, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	84, 85, 92, 98, 104, 108, 112, 115, 120, 123,
-	126, 128, 129, 130, 133, 143, 146, 147, 148, 149,
-	159, 160, 161, 163, 166, 167, 168, 169, 170, 173,
-	175, 176, 177, 178, 179, 180, 187, 190, 196, 197,
-	198, 199, 200, 201, 202, 204, 205, 206, 207, 213,
-	216, 222, 223, 232, 239, 242, 165, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 111, 0, 0, 0,
-	0, 0, 137, 0, 139, 0, 0, 211, 153, 0,
+	0, 0, 213, 235, 250, 100, 0, 220, 244, 245,
+	0, 0, 101, 119, 114, 0, 182, 158, 97, 128,
+	210, 135, 142, 189, 248, 172, 195, 104, 234, 211,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 80, 0, 0, 0,
-	0, 0, 0, 0, 0, 101, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	119, 0, 0, 0, 0, 265, 0, 0, 0, 0,
-	184, 0, 215, 122, 136, 97, 83, 93, 0, 121,
-	162, 191, 195, 0, 0, 0, 105, 0, 193, 172,
-	231, 0, 174, 192, 140, 221, 185, 230, 240, 241,
-	218, 238, 245, 208, 86, 217, 229, 102, 203, 88,
-	227, 214, 151, 131, 132, 87, 0, 189, 110, 117,
-	107, 164, 224, 225, 106, 248, 94, 237, 90, 95,
-	236, 158, 220, 228, 152, 145, 89, 226, 150, 144,
-	135, 114, 124, 182, 142, 183, 125, 155, 154, 156,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 92,
+	139, 247, 187, 117, 236, 0, 0, 110, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 212, 234, 249,
-	99, 0, 219, 243, 244, 0, 0, 100, 118, 113,
-	0, 181, 157, 96, 127, 209, 134, 141, 188, 247,
-	171, 194, 103, 233, 210, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 82, 91, 138, 246, 186, 116,
-	235, 0, 0, 109, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 84, 85, 92, 98,
-	104, 108, 112, 115, 120, 123, 126, 128, 129, 130,
-	133, 143, 146, 147, 148, 149, 159, 160, 161, 163,
-	166, 167, 168, 169, 170, 173, 175, 176, 177, 178,
-	179, 180, 187, 190, 196, 197, 198, 199, 200, 201,
-	202, 204, 205, 206, 207, 213, 216, 222, 223, 232,
-	239, 242, 165, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 111, 0, 0, 0, 0, 0, 137, 0,
-	139, 0, 0, 211, 153, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 320, 0, 0, 0, 0, 0, 0, 0,
-	0, 101, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 119, 0, 0, 0,
-	0, 265, 0, 0, 0, 0, 184, 0, 215, 122,
-	136, 97, 83, 93, 0, 121, 162, 191, 195, 0,
-	0, 0, 105, 0, 193, 172, 231, 0, 174, 192,
-	140, 221, 185, 230, 240, 241, 218, 238, 245, 208,
-	86, 217, 229, 102, 203, 88, 227, 214, 151, 131,
-	132, 87, 0, 189, 110, 117, 107, 164, 224, 225,
-	106, 248, 94, 237, 90, 95, 236, 158, 220, 228,
-	152, 145, 89, 226, 150, 144, 135, 114, 124, 182,
-	142, 183, 125, 155, 154, 156, 0, 0, 0, 0,
+	85, 86, 93, 99, 105, 109, 113, 116, 121, 124,
+	127, 129, 130, 131, 134, 144, 147, 148, 149, 150,
+	160, 161, 162, 164, 167, 168, 169, 170, 171, 174,
+	176, 177, 178, 179, 180, 181, 188, 191, 197, 198,
+	199, 200, 201, 202, 203, 205, 206, 207, 208, 214,
+	217, 223, 224, 233, 240, 243, 166, 0, 0, 0,
+	0, 0, 958, 0, 0, 0, 0, 112, 0, 0,
+	0, 0, 0, 138, 0, 140, 0, 0, 212, 154,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 83, 0, 0, 0, 264, 0,
+	960, 0, 0, 0, 0, 0, 0, 102, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 120, 0, 0, 266, 0, 0, 267,
+	0, 0, 0, 0, 185, 0, 216, 123, 137, 98,
+	84, 94, 0, 122, 163, 192, 196, 0, 0, 0,
+	106, 0, 194, 173, 232, 0, 956, 193, 141, 222,
+	186, 231, 241, 242, 219, 239, 246, 209, 87, 218,
+	230, 103, 204, 89, 228, 215, 152, 132, 133, 88,
+	0, 190, 111, 118, 108, 165, 225, 226, 107, 249,
+	95, 238, 91, 96, 237, 159, 221, 229, 153, 146,
+	90, 227, 151, 145, 136, 115, 125, 183, 143, 184,
+	126, 156, 155, 157, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 212, 234, 249, 99, 0, 219, 243,
-	244, 0, 0, 100, 118, 113, 0, 181, 157, 96,
-	127, 209, 134, 141, 188, 247, 171, 194, 103, 233,
-	210, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	82, 91, 138, 246, 186, 116, 235, 0, 0, 109,
+	0, 213, 235, 250, 100, 0, 220, 244, 245, 0,
+	0, 101, 119, 114, 0, 182, 158, 97, 128, 210,
+	135, 142, 189, 248, 172, 195, 104, 234, 211, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 92, 139,
+	247, 187, 117, 236, 0, 0, 110, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 84, 85, 92, 98, 104, 108, 112, 115,
-	120, 123, 126, 128, 129, 130, 133, 143, 146, 147,
-	148, 149, 159, 160, 161, 163, 166, 167, 168, 169,
-	170, 173, 175, 176, 177, 178, 179, 180, 187, 190,
-	196, 197, 198, 199, 200, 201, 202, 204, 205, 206,
-	207, 213, 216, 222, 223, 232, 239, 242, 165, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 111, 0,
-	0, 0, 0, 0, 137, 0, 139, 0, 0, 211,
-	153, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
-	0, 0, 0, 0, 0, 0, 0, 101, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 119, 0, 0, 0, 0, 265, 0, 0,
-	0, 0, 184, 0, 215, 122, 136, 97, 83, 93,
-	0, 121, 162, 191, 195, 0, 0, 0, 105, 0,
-	193, 172, 231, 0, 174, 192, 140, 221, 185, 230,
-	240, 241, 218, 238, 245, 208, 86, 217, 229, 102,
-	203, 88, 227, 214, 151, 131, 132, 87, 0, 189,
-	110, 117, 107, 164, 224, 225, 106, 248, 94, 237,
-	90, 95, 236, 158, 220, 228, 152, 145, 89, 226,
-	150, 144, 135, 114, 124, 182, 142, 183, 125, 155,
-	154, 156, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 212,
-	234, 249, 99, 0, 219, 243, 244, 0, 0, 100,
-	118, 113, 0, 181, 157, 96, 127, 209, 134, 141,
-	188, 247, 171, 194, 103, 233, 210, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 82, 91, 138, 246,
-	186, 116, 235, 0, 0, 109, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 84, 85,
-	92, 98, 104, 108, 112, 115, 120, 123, 126, 128,
-	129, 130, 133, 143, 146, 147, 148, 149, 159, 160,
-	161, 163, 166, 167, 168, 169, 170, 173, 175, 176,
-	177, 178, 179, 180, 187, 190, 196, 197, 198, 199,
-	200, 201, 202, 204, 205, 206, 207, 213, 216, 222,
-	223, 232, 239, 242,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 85,
+	86, 93, 99, 105, 109, 113, 116, 121, 124, 127,
+	129, 130, 131, 134, 144, 147, 148, 149, 150, 160,
+	161, 162, 164, 167, 168, 169, 170, 171, 174, 176,
+	177, 178, 179, 180, 181, 188, 191, 197, 198, 199,
+	200, 201, 202, 203, 205, 206, 207, 208, 214, 217,
+	223, 224, 233, 240, 243, 166, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 112, 0, 0, 0,
+	0, 0, 138, 0, 140, 0, 0, 212, 154, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 83, 0, 0, 0, 81, 0, 0,
+	850, 0, 0, 851, 0, 0, 102, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 120, 0, 0, 266, 0, 0, 267, 0,
+	0, 0, 0, 185, 0, 216, 123, 137, 98, 84,
+	94, 0, 122, 163, 192, 196, 0, 0, 0, 106,
+	0, 194, 173, 232, 0, 175, 193, 141, 222, 186,
+	231, 241, 242, 219, 239, 246, 209, 87, 218, 230,
+	103, 204, 89, 228, 215, 152, 132, 133, 88, 0,
+	190, 111, 118, 108, 165, 225, 226, 107, 249, 95,
+	238, 91, 96, 237, 159, 221, 229, 153, 146, 90,
+	227, 151, 145, 136, 115, 125, 183, 143, 184, 126,
+	156, 155, 157, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	213, 235, 250, 100, 0, 220, 244, 245, 0, 0,
+	101, 119, 114, 0, 182, 158, 97, 128, 210, 135,
+	142, 189, 248, 172, 195, 104, 234, 211, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 92, 139, 247,
+	187, 117, 236, 0, 0, 110, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 85, 86,
+	93, 99, 105, 109, 113, 116, 121, 124, 127, 129,
+	130, 131, 134, 144, 147, 148, 149, 150, 160, 161,
+	162, 164, 167, 168, 169, 170, 171, 174, 176, 177,
+	178, 179, 180, 181, 188, 191, 197, 198, 199, 200,
+	201, 202, 203, 205, 206, 207, 208, 214, 217, 223,
+	224, 233, 240, 243, 166, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 112, 0, 720, 0, 0,
+	0, 138, 0, 140, 0, 0, 212, 154, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 83, 0, 0, 0, 81, 0, 719, 0,
+	0, 0, 0, 0, 0, 102, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 120, 0, 0, 266, 0, 0, 267, 0, 0,
+	0, 0, 185, 0, 216, 123, 137, 98, 84, 94,
+	0, 122, 163, 192, 196, 0, 0, 0, 106, 0,
+	194, 173, 232, 0, 175, 193, 141, 222, 186, 231,
+	241, 242, 219, 239, 246, 209, 87, 218, 230, 103,
+	204, 89, 228, 215, 152, 132, 133, 88, 0, 190,
+	111, 118, 108, 165, 225, 226, 107, 249, 95, 238,
+	91, 96, 237, 159, 221, 229, 153, 146, 90, 227,
+	151, 145, 136, 115, 125, 183, 143, 184, 126, 156,
+	155, 157, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 213,
+	235, 250, 100, 0, 220, 244, 245, 0, 0, 101,
+	119, 114, 0, 182, 158, 97, 128, 210, 135, 142,
+	189, 248, 172, 195, 104, 234, 211, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 92, 139, 247, 187,
+	117, 236, 0, 0, 110, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 85, 86, 93,
+	99, 105, 109, 113, 116, 121, 124, 127, 129, 130,
+	131, 134, 144, 147, 148, 149, 150, 160, 161, 162,
+	164, 167, 168, 169, 170, 171, 174, 176, 177, 178,
+	179, 180, 181, 188, 191, 197, 198, 199, 200, 201,
+	202, 203, 205, 206, 207, 208, 214, 217, 223, 224,
+	233, 240, 243, 166, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 112, 0, 0, 0, 0, 0,
+	138, 0, 140, 0, 0, 212, 154, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 83, 54, 0, 0, 698, 0, 0, 0, 0,
+	0, 0, 0, 0, 102, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	120, 0, 0, 266, 0, 0, 267, 0, 0, 0,
+	0, 185, 0, 216, 123, 137, 98, 84, 94, 0,
+	122, 163, 192, 196, 0, 0, 0, 106, 0, 194,
+	173, 232, 0, 175, 193, 141, 222, 186, 231, 241,
+	242, 219, 239, 246, 209, 87, 218, 230, 103, 204,
+	89, 228, 215, 152, 132, 133, 88, 0, 190, 111,
+	118, 108, 165, 225, 226, 107, 249, 95, 238, 91,
+	96, 237, 159, 221, 229, 153, 146, 90, 227, 151,
+	145, 136, 115, 125, 183, 143, 184, 126, 156, 155,
+	157, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 213, 235,
+	250, 100, 0, 220, 244, 245, 0, 0, 101, 119,
+	114, 0, 182, 158, 97, 128, 210, 135, 142, 189,
+	248, 172, 195, 104, 234, 211, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 92, 139, 247, 187, 117,
+	236, 0, 0, 110, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 85, 86, 93, 99,
+	105, 109, 113, 116, 121, 124, 127, 129, 130, 131,
+	134, 144, 147, 148, 149, 150, 160, 161, 162, 164,
+	167, 168, 169, 170, 171, 174, 176, 177, 178, 179,
+	180, 181, 188, 191, 197, 198, 199, 200, 201, 202,
+	203, 205, 206, 207, 208, 214, 217, 223, 224, 233,
+	240, 243, 166, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 112, 0, 0, 0, 0, 0, 138,
+	0, 140, 0, 0, 212, 154, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	83, 0, 0, 0, 264, 0, 960, 0, 0, 0,
+	0, 0, 0, 102, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 120,
+	0, 0, 266, 0, 0, 267, 0, 0, 0, 0,
+	185, 0, 216, 123, 137, 98, 84, 94, 0, 122,
+	163, 192, 196, 0, 0, 0, 106, 0, 194, 173,
+	232, 0, 175, 193, 141, 222, 186, 231, 241, 242,
+	219, 239, 246, 209, 87, 218, 230, 103, 204, 89,
+	228, 215, 152, 132, 133, 88, 0, 190, 111, 118,
+	108, 165, 225, 226, 107, 249, 95, 238, 91, 96,
+	237, 159, 221, 229, 153, 146, 90, 227, 151, 145,
+	136, 115, 125, 183, 143, 184, 126, 156, 155, 157,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 213, 235, 250,
+	100, 0, 220, 244, 245, 0, 0, 101, 119, 114,
+	0, 182, 158, 97, 128, 210, 135, 142, 189, 248,
+	172, 195, 104, 234, 211, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 92, 139, 247, 187, 117, 236,
+	0, 0, 110, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 85, 86, 93, 99, 105,
+	109, 113, 116, 121, 124, 127, 129, 130, 131, 134,
+	144, 147, 148, 149, 150, 160, 161, 162, 164, 167,
+	168, 169, 170, 171, 174, 176, 177, 178, 179, 180,
+	181, 188, 191, 197, 198, 199, 200, 201, 202, 203,
+	205, 206, 207, 208, 214, 217, 223, 224, 233, 240,
+	243, 166, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 112, 0, 0, 0, 0, 0, 138, 0,
+	140, 0, 0, 212, 154, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 83,
+	0, 0, 0, 81, 0, 606, 0, 0, 0, 0,
+	0, 0, 102, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 120, 0,
+	0, 266, 0, 0, 267, 0, 0, 0, 0, 185,
+	0, 216, 123, 137, 98, 84, 94, 0, 122, 163,
+	192, 196, 0, 0, 0, 106, 0, 194, 173, 232,
+	0, 175, 193, 141, 222, 186, 231, 241, 242, 219,
+	239, 246, 209, 87, 218, 230, 103, 204, 89, 228,
+	215, 152, 132, 133, 88, 0, 190, 111, 118, 108,
+	165, 225, 226, 107, 249, 95, 238, 91, 96, 237,
+	159, 221, 229, 153, 146, 90, 227, 151, 145, 136,
+	115, 125, 183, 143, 184, 126, 156, 155, 157, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 213, 235, 250, 100,
+	0, 220, 244, 245, 0, 0, 101, 119, 114, 0,
+	182, 158, 97, 128, 210, 135, 142, 189, 248, 172,
+	195, 104, 234, 211, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 92, 139, 247, 187, 117, 236, 0,
+	0, 110, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 85, 86, 93, 99, 105, 109,
+	113, 116, 121, 124, 127, 129, 130, 131, 134, 144,
+	147, 148, 149, 150, 160, 161, 162, 164, 167, 168,
+	169, 170, 171, 174, 176, 177, 178, 179, 180, 181,
+	188, 191, 197, 198, 199, 200, 201, 202, 203, 205,
+	206, 207, 208, 214, 217, 223, 224, 233, 240, 243,
+	166, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	689, 112, 0, 0, 0, 0, 0, 138, 0, 140,
+	0, 0, 212, 154, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 83, 0,
+	0, 0, 264, 0, 0, 0, 0, 0, 0, 0,
+	0, 102, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 120, 0, 0,
+	266, 0, 0, 267, 0, 0, 0, 0, 185, 0,
+	216, 123, 137, 98, 84, 94, 0, 122, 163, 192,
+	196, 0, 0, 0, 106, 0, 194, 173, 232, 0,
+	175, 193, 141, 222, 186, 231, 241, 242, 219, 239,
+	246, 209, 87, 218, 230, 103, 204, 89, 228, 215,
+	152, 132, 133, 88, 0, 190, 111, 118, 108, 165,
+	225, 226, 107, 249, 95, 238, 91, 96, 237, 159,
+	221, 229, 153, 146, 90, 227, 151, 145, 136, 115,
+	125, 183, 143, 184, 126, 156, 155, 157, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 213, 235, 250, 100, 0,
+	220, 244, 245, 0, 0, 101, 119, 114, 0, 182,
+	158, 97, 128, 210, 135, 142, 189, 248, 172, 195,
+	104, 234, 211, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 92, 139, 247, 187, 117, 236, 0, 0,
+	110, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 85, 86, 93, 99, 105, 109, 113,
+	116, 121, 124, 127, 129, 130, 131, 134, 144, 147,
+	148, 149, 150, 160, 161, 162, 164, 167, 168, 169,
+	170, 171, 174, 176, 177, 178, 179, 180, 181, 188,
+	191, 197, 198, 199, 200, 201, 202, 203, 205, 206,
+	207, 208, 214, 217, 223, 224, 233, 240, 243, 381,
+	0, 0, 0, 0, 0, 0, 166, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 112, 0, 0,
+	0, 0, 0, 138, 0, 140, 0, 0, 212, 154,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 83, 0, 0, 0, 264, 0,
+	0, 0, 0, 0, 0, 0, 0, 102, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 120, 0, 0, 266, 0, 0, 267,
+	0, 0, 0, 0, 185, 0, 216, 123, 137, 98,
+	84, 94, 0, 122, 163, 192, 196, 0, 0, 0,
+	106, 0, 194, 173, 232, 0, 175, 193, 141, 222,
+	186, 231, 241, 242, 219, 239, 246, 209, 87, 218,
+	230, 103, 204, 89, 228, 215, 152, 132, 133, 88,
+	0, 190, 111, 118, 108, 165, 225, 226, 107, 249,
+	95, 238, 91, 96, 237, 159, 221, 229, 153, 146,
+	90, 227, 151, 145, 136, 115, 125, 183, 143, 184,
+	126, 156, 155, 157, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 213, 235, 250, 100, 0, 220, 244, 245, 0,
+	0, 101, 119, 114, 0, 182, 158, 97, 128, 210,
+	135, 142, 189, 248, 172, 195, 104, 234, 211, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 92, 139,
+	247, 187, 117, 236, 0, 0, 110, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 85,
+	86, 93, 99, 105, 109, 113, 116, 121, 124, 127,
+	129, 130, 131, 134, 144, 147, 148, 149, 150, 160,
+	161, 162, 164, 167, 168, 169, 170, 171, 174, 176,
+	177, 178, 179, 180, 181, 188, 191, 197, 198, 199,
+	200, 201, 202, 203, 205, 206, 207, 208, 214, 217,
+	223, 224, 233, 240, 243, 166, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 112, 0, 0, 0,
+	0, 0, 138, 0, 140, 0, 0, 212, 154, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 83, 0, 0, 0, 264, 0, 0,
+	0, 0, 0, 0, 0, 0, 102, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 120, 0, 261, 266, 0, 0, 267, 0,
+	0, 0, 0, 185, 0, 216, 123, 137, 98, 84,
+	94, 0, 122, 163, 192, 196, 0, 0, 0, 106,
+	0, 194, 173, 232, 0, 175, 193, 141, 222, 186,
+	231, 241, 242, 219, 239, 246, 209, 87, 218, 230,
+	103, 204, 89, 228, 215, 152, 132, 133, 88, 0,
+	190, 111, 118, 108, 165, 225, 226, 107, 249, 95,
+	238, 91, 96, 237, 159, 221, 229, 153, 146, 90,
+	227, 151, 145, 136, 115, 125, 183, 143, 184, 126,
+	156, 155, 157, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	213, 235, 250, 100, 0, 220, 244, 245, 0, 0,
+	101, 119, 114, 0, 182, 158, 97, 128, 210, 135,
+	142, 189, 248, 172, 195, 104, 234, 211, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 92, 139, 247,
+	187, 117, 236, 0, 0, 110, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 85, 86,
+	93, 99, 105, 109, 113, 116, 121, 124, 127, 129,
+	130, 131, 134, 144, 147, 148, 149, 150, 160, 161,
+	162, 164, 167, 168, 169, 170, 171, 174, 176, 177,
+	178, 179, 180, 181, 188, 191, 197, 198, 199, 200,
+	201, 202, 203, 205, 206, 207, 208, 214, 217, 223,
+	224, 233, 240, 243, 166, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 112, 0, 0, 0, 0,
+	0, 138, 0, 140, 0, 0, 212, 154, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 83, 0, 0, 0, 81, 0, 0, 0,
+	0, 0, 0, 0, 0, 102, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 120, 0, 0, 266, 0, 0, 267, 0, 0,
+	0, 0, 185, 0, 216, 123, 137, 98, 84, 94,
+	0, 122, 163, 192, 196, 0, 0, 0, 106, 0,
+	194, 173, 232, 0, 175, 193, 141, 222, 186, 231,
+	241, 242, 219, 239, 246, 209, 87, 218, 230, 103,
+	204, 89, 228, 215, 152, 132, 133, 88, 0, 190,
+	111, 118, 108, 165, 225, 226, 107, 249, 95, 238,
+	91, 96, 237, 159, 221, 229, 153, 146, 90, 227,
+	151, 145, 136, 115, 125, 183, 143, 184, 126, 156,
+	155, 157, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 213,
+	235, 250, 100, 0, 220, 244, 245, 0, 0, 101,
+	119, 114, 0, 182, 158, 97, 128, 210, 135, 142,
+	189, 248, 172, 195, 104, 234, 211, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 92, 139, 247, 187,
+	117, 236, 0, 0, 110, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 85, 86, 93,
+	99, 105, 109, 113, 116, 121, 124, 127, 129, 130,
+	131, 134, 144, 147, 148, 149, 150, 160, 161, 162,
+	164, 167, 168, 169, 170, 171, 174, 176, 177, 178,
+	179, 180, 181, 188, 191, 197, 198, 199, 200, 201,
+	202, 203, 205, 206, 207, 208, 214, 217, 223, 224,
+	233, 240, 243, 166, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 112, 0, 0, 0, 0, 0,
+	138, 0, 140, 0, 0, 212, 154, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 83, 0, 0, 0, 322, 0, 0, 0, 0,
+	0, 0, 0, 0, 102, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	120, 0, 0, 266, 0, 0, 267, 0, 0, 0,
+	0, 185, 0, 216, 123, 137, 98, 84, 94, 0,
+	122, 163, 192, 196, 0, 0, 0, 106, 0, 194,
+	173, 232, 0, 175, 193, 141, 222, 186, 231, 241,
+	242, 219, 239, 246, 209, 87, 218, 230, 103, 204,
+	89, 228, 215, 152, 132, 133, 88, 0, 190, 111,
+	118, 108, 165, 225, 226, 107, 249, 95, 238, 91,
+	96, 237, 159, 221, 229, 153, 146, 90, 227, 151,
+	145, 136, 115, 125, 183, 143, 184, 126, 156, 155,
+	157, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 213, 235,
+	250, 100, 0, 220, 244, 245, 0, 0, 101, 119,
+	114, 0, 182, 158, 97, 128, 210, 135, 142, 189,
+	248, 172, 195, 104, 234, 211, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 92, 139, 247, 187, 117,
+	236, 0, 0, 110, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 85, 86, 93, 99,
+	105, 109, 113, 116, 121, 124, 127, 129, 130, 131,
+	134, 144, 147, 148, 149, 150, 160, 161, 162, 164,
+	167, 168, 169, 170, 171, 174, 176, 177, 178, 179,
+	180, 181, 188, 191, 197, 198, 199, 200, 201, 202,
+	203, 205, 206, 207, 208, 214, 217, 223, 224, 233,
+	240, 243, 166, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 112, 0, 0, 0, 0, 0, 138,
+	0, 140, 0, 0, 212, 154, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	83, 0, 0, 0, 264, 0, 0, 0, 0, 0,
+	0, 0, 0, 102, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 120,
+	0, 0, 266, 0, 0, 267, 0, 0, 0, 0,
+	185, 0, 216, 123, 137, 98, 84, 94, 0, 122,
+	163, 192, 196, 0, 0, 0, 106, 0, 194, 173,
+	232, 0, 175, 193, 141, 222, 186, 231, 241, 242,
+	219, 239, 246, 209, 87, 218, 230, 103, 204, 89,
+	228, 215, 152, 132, 133, 88, 0, 190, 111, 118,
+	108, 165, 225, 226, 107, 249, 95, 238, 91, 96,
+	237, 159, 221, 229, 153, 146, 90, 227, 151, 145,
+	136, 115, 125, 183, 143, 184, 126, 156, 155, 157,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 213, 235, 250,
+	100, 0, 220, 244, 245, 0, 0, 101, 119, 114,
+	0, 182, 158, 97, 128, 210, 135, 142, 189, 248,
+	172, 195, 104, 234, 211, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 92, 139, 247, 187, 117, 236,
+	0, 0, 110, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 85, 86, 93, 99, 105,
+	109, 113, 116, 121, 124, 127, 129, 130, 131, 134,
+	144, 147, 148, 149, 150, 160, 161, 162, 164, 167,
+	168, 169, 170, 171, 174, 176, 177, 178, 179, 180,
+	181, 188, 191, 197, 198, 199, 200, 201, 202, 203,
+	205, 206, 207, 208, 214, 217, 223, 224, 233, 240,
+	243,
 }
-var yyPact = [...]int{
 
-	1972, -1000, -280, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+var yyPact = [...]int16{
+	2018, -1000, -276, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 966, 1022, -1000, -1000, -1000, -1000, -1000, -1000,
+	291, 11718, 19, 108, -16, 15937, 107, 264, 16984, -1000,
+	15, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -78, -83,
+	-1000, 719, -1000, -1000, -1000, -1000, -1000, 960, 963, 829,
+	947, 884, -1000, 8216, 84, 84, 15588, 6471, -1000, -1000,
+	253, 16984, 98, 16984, -165, 69, 69, 69, 105, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 1012, 1043, -1000, -1000, -1000, -1000, -1000, -1000,
-	295, 11829, 30, 117, -1, 16012, 114, 1362, 17050, -1000,
-	25, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -80, -94,
-	-1000, 736, -1000, -1000, -1000, -1000, -1000, 993, 1000, 810,
-	980, 894, -1000, 8357, 91, 91, 15666, 6627, -1000, -1000,
-	294, 17050, 110, 17050, -157, 89, 89, 89, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -2681,23 +2687,22 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 102, 16984, 186, -1000, 16984, 78, 554, 78, 78,
+	78, 16984, -1000, 159, -1000, -1000, -1000, -1000, 16984, 536,
+	920, 3213, 53, 3213, -1000, 3213, 3213, -1000, 3213, 23,
+	3213, -85, 985, 24, -14, -1000, 3213, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	479, 917, 9624, 9624, 966, -1000, 719, -1000, -1000, -1000,
+	906, -1000, -1000, 342, 999, -1000, 11369, 157, -1000, 9624,
+	2178, 701, -1000, -1000, 701, -1000, -1000, 119, -1000, -1000,
+	10671, 10671, 10671, 10671, 10671, 10671, 10671, 10671, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	113, 17050, 191, -1000, 17050, 87, 560, 87, 87, 87,
-	17050, -1000, 167, -1000, -1000, -1000, 17050, 538, 921, 3396,
-	52, 3396, -1000, 3396, 3396, -1000, 3396, 33, 3396, -54,
-	1024, 34, -22, -1000, 3396, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 548, 928,
-	9753, 9753, 1012, -1000, 736, -1000, -1000, -1000, 919, -1000,
-	-1000, 322, 1033, -1000, 11483, 165, -1000, 9753, 2410, 762,
-	-1000, -1000, 762, -1000, -1000, 145, -1000, -1000, 10791, 10791,
-	10791, 10791, 10791, 10791, 10791, 10791, -1000, -1000, -1000, -1000,
+	-1000, -1000, 701, -1000, 9275, 701, 701, 701, 701, 701,
+	701, 701, 701, 9624, 701, 701, 701, 701, 701, 701,
+	701, 701, 701, 701, 701, 701, 701, 701, 701, 15232,
+	14185, 16984, 728, 671, -1000, -1000, 154, 694, 6109, -110,
+	-1000, -1000, -1000, 274, 13836, -1000, -1000, -1000, 915, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	762, -1000, 9407, 762, 762, 762, 762, 762, 762, 762,
-	762, 9753, 762, 762, 762, 762, 762, 762, 762, 762,
-	762, 762, 762, 762, 762, 762, 762, 15313, 14275, 17050,
-	786, 680, -1000, -1000, 163, 752, 6268, -113, -1000, -1000,
-	-1000, 274, 13929, -1000, -1000, -1000, 918, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -2709,225 +2714,209 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 643, 17050, -1000, 2248,
-	-1000, 530, 3396, 97, 510, 292, 501, 17050, 17050, 3396,
-	40, 74, 70, 17050, 756, 94, 17050, 965, 835, 17050,
-	499, 492, -1000, 5909, -1000, 3396, 3396, -1000, -1000, -1000,
-	3396, 3396, 3396, 17050, 3396, 3396, -1000, -1000, -1000, -1000,
-	3396, 3396, -1000, 1032, 284, -1000, -1000, -1000, -1000, 9753,
-	214, -1000, 832, -1000, -1000, -1000, -1000, -1000, 977, 1038,
-	195, 468, 155, 753, -1000, 475, 993, 548, 894, 13583,
-	830, -1000, -1000, 17050, -1000, 9753, 9753, 410, -1000, 14967,
-	-1000, -1000, 4473, 204, 10791, 329, 210, 10791, 10791, 10791,
-	10791, 10791, 10791, 10791, 10791, 10791, 10791, 10791, 10791, 10791,
-	10791, 10791, 385, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 487, -1000, 736, 791, 791, 178, 178, 178, 178,
-	178, 178, 178, 11137, 7665, 548, 633, 273, 9407, 8357,
-	8357, 9753, 9753, 9049, 8703, 8357, 970, 277, 273, 16704,
-	-1000, -1000, 10445, -1000, -1000, -1000, -1000, -1000, 548, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 16358, 16358, 8357, 8357,
-	8357, 8357, 51, 17050, -1000, 726, 920, -1000, -1000, -1000,
-	967, 12891, 762, 13237, 51, 665, 14275, 17050, -1000, -1000,
-	14275, 17050, 4114, 5550, 752, -113, 727, -1000, -128, -119,
-	7319, 174, -1000, -1000, -1000, -1000, -106, 242, 612, 106,
-	-59, -1000, -1000, -1000, 768, -1000, 768, 768, 768, 768,
-	-8, -8, -8, -8, -1000, -1000, -1000, -1000, -1000, 812,
-	811, 807, 803, -1000, -1000, -1000, 768, 768, 768, -1000,
+	638, 16984, -1000, 2478, -1000, 532, 3213, 89, 526, 295,
+	522, 16984, 69, 16984, 3213, 32, 67, 61, 16984, 732,
+	87, 16984, 941, 827, 16984, 516, 508, -1000, 5747, -1000,
+	3213, 3213, -1000, -1000, -1000, 3213, 3213, 3213, 16984, 3213,
+	3213, -1000, -1000, -1000, -1000, 3213, 3213, -1000, 993, 279,
+	-1000, -1000, -1000, -1000, 9624, 273, -1000, 823, -1000, -1000,
+	-1000, -1000, -1000, 953, 1015, 195, 488, 145, 731, -1000,
+	499, 960, 479, 884, 13487, 842, -1000, -1000, 16984, -1000,
+	9624, 9624, 553, -1000, 14883, -1000, -1000, 4299, 217, 10671,
+	373, 476, 10671, 10671, 10671, 10671, 10671, 10671, 10671, 10671,
+	10671, 10671, 10671, 10671, 10671, 10671, 10671, 10671, 425, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 505, -1000, 719,
+	752, 752, 172, 172, 172, 172, 172, 172, 172, 11020,
+	7518, 479, 636, 298, 9275, 8216, 8216, 9624, 9624, 8914,
+	8565, 8216, 949, 305, 298, 16635, -1000, -1000, 10322, -1000,
+	-1000, -1000, -1000, -1000, 479, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 16286, 16286, 8216, 8216, 8216, 8216, 43, 16984,
+	-1000, 749, 874, -1000, -1000, -1000, 944, 12789, 701, 13138,
+	43, 692, 14185, 16984, -1000, -1000, 14185, 16984, 3937, 5385,
+	694, -110, 698, -1000, -136, -108, 7169, 167, -1000, -1000,
+	-1000, -1000, -97, 517, 595, 115, -58, -1000, -1000, -1000,
+	741, -1000, 741, 741, 741, 741, -10, -10, -10, -10,
+	-1000, -1000, -1000, -1000, -1000, 782, 781, 776, 770, -1000,
+	-1000, -1000, 741, 741, 741, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 802, 802, 802, 776, 776,
-	776, 776, 814, -1000, 17050, -115, 963, 3396, -1000, 71,
-	-1000, 17050, 17050, 17050, 17050, 17050, 130, 17050, 17050, 745,
-	-1000, 17050, 3396, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 17050, 378,
-	17050, 17050, 273, -1000, 461, 208, 17050, -1000, 485, -1000,
-	901, 9753, 9753, 5191, 9753, -1000, -1000, -1000, 928, -1000,
-	970, 1018, -1000, 911, 909, 8357, -1000, -1000, 204, 223,
-	-1000, -1000, 456, -1000, -1000, -1000, -1000, 152, 762, -1000,
-	2032, -1000, -1000, -1000, -1000, 329, 10791, 10791, 10791, 411,
-	2032, 2069, 481, 821, 178, 326, 326, 176, 176, 176,
-	176, 176, 607, 607, -1000, -1000, -1000, 548, -1000, -1000,
-	-1000, 548, 8357, 744, -1000, -1000, 9753, -1000, 548, 629,
-	629, 409, 414, 247, 1030, 629, 245, 1020, 629, 629,
-	8357, 310, -1000, 9753, 548, -1000, 151, -1000, 556, 734,
-	731, 629, 548, 629, 629, 937, 762, -1000, 16704, 14275,
-	14275, 14275, 14275, 14275, -1000, 871, 866, -1000, 863, 856,
-	867, 17050, -1000, 631, 12891, 6973, 164, 762, -1000, 14621,
-	-1000, -1000, 1021, 14275, 669, -1000, 669, -1000, 140, -1000,
-	-1000, 727, -113, -122, -1000, -1000, -1000, -1000, 273, -1000,
-	436, -1000, 271, -1000, -1000, -1000, 801, 478, -1000, 945,
-	203, 211, 473, 942, -1000, -1000, -1000, 923, -1000, 306,
-	-1000, -73, -1000, -1000, 374, -8, -8, -1000, -1000, 174,
-	917, 174, 174, 174, 457, 457, 457, 457, -1000, -1000,
-	-1000, -1000, 363, -1000, -1000, -1000, 347, -1000, -1000, -1000,
-	825, 16358, 3396, -1000, 256, -1000, -1000, -1000, 307, 307,
-	200, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 50, 787, -1000, -1000, -1000, -1000, 12, 39,
-	93, -1000, 3396, -1000, 284, 993, 442, 201, 9753, -1000,
-	-1000, -1000, 431, -1000, -1000, 899, 273, 273, 137, -1000,
-	-1000, 17050, -1000, -1000, -1000, -1000, 672, -1000, -1000, -1000,
-	3755, 8357, -1000, 411, 2032, 2002, -1000, 10791, 10791, -1000,
-	-1000, 629, 8357, 273, -1000, -1000, -1000, 61, 385, 61,
-	10791, 10791, -1000, 10791, 10791, -1000, -172, 703, 282, -1000,
-	9753, 268, -1000, 5191, -1000, 10791, 10791, -1000, -1000, -1000,
-	-1000, 775, 16704, 16358, 728, -1000, 228, 920, 793, 823,
-	848, -1000, -1000, -1000, -1000, 865, -1000, 846, -1000, -1000,
-	-1000, -1000, 548, 712, -1000, -1000, 273, 762, 762, -1000,
-	107, 104, 102, 16358, -1000, 1012, 9753, 669, -1000, -1000,
-	183, -1000, -1000, -139, -141, -1000, -1000, -1000, 3037, 16358,
-	67, -1000, 473, 473, -1000, -1000, -1000, 780, 822, 10791,
-	-1000, -1000, -1000, 592, 174, 174, -1000, 215, -1000, -1000,
-	-1000, 619, -1000, 617, 615, 570, 689, 567, 17050, -1000,
-	-1000, 3037, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 17050, -1000, -1000,
-	-1000, -1000, -1000, 16358, -177, 464, 16358, 16358, 16358, 17050,
-	-1000, 378, -1000, -1000, 417, 273, -1000, -1000, 4832, -1000,
-	1021, 14275, -1000, -1000, 548, -1000, 10791, 2032, 2032, -1000,
-	-1000, 548, 768, 768, -1000, 768, 776, -1000, 768, 15,
-	768, 11, 548, 548, 1937, 1786, 1729, 1531, 762, -167,
-	-1000, 273, 9753, -1000, 1485, 535, 818, 762, -1000, 12533,
-	664, 564, -1000, 1012, 16704, 9753, -1000, -1000, 9753, 774,
-	-1000, 9753, -1000, -1000, -1000, 967, 6973, 14275, 16704, 762,
-	762, 762, 564, 993, 273, -1000, -1000, -1000, -1000, 773,
-	-1000, -1000, -1000, 558, -1000, 768, -1000, -1000, -1000, 16358,
-	-53, 1037, 2032, -1000, -1000, -1000, -1000, -1000, -8, 404,
-	-8, -8, -8, 337, -1000, 334, 3396, -1000, -1000, -1000,
-	-1000, -1000, 956, -1000, 4832, -1000, -1000, 765, 794, -1000,
-	-1000, -1000, -1000, 1019, 688, -1000, 2032, -1000, -1000, 115,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 10791, 10791,
-	10791, 10791, 10791, 548, 391, 273, 10791, 10791, -1000, 953,
-	667, -1000, -1000, 8011, 548, 553, 133, -1000, -1000, 16358,
-	993, -1000, 273, 273, 16358, 273, 17050, -1000, 706, 548,
-	16358, 16358, 16358, 12175, -1000, 3037, 160, 16358, -1000, 547,
-	-1000, 202, -1000, -96, 174, -1000, 174, 174, 174, 590,
-	588, -1000, 762, 679, -1000, 225, 16358, 17050, 1016, 996,
-	-1000, -1000, 556, 556, 556, 556, 48, -1000, -1000, 556,
-	556, 932, 762, -1000, -1000, 661, 16358, 16358, -1000, -1000,
-	518, -1000, -1000, -1000, 516, 516, 516, 164, 584, 160,
-	-1000, 416, 221, 390, -1000, 64, 16358, 314, 930, -1000,
-	922, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 49, 4832,
-	3037, 514, -1000, -1000, 9753, 9753, -1000, -1000, -1000, -1000,
-	548, 58, -183, -1000, -1000, 1036, -1000, 762, -1000, 736,
-	129, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	331, -1000, -1000, 17050, -1000, -1000, 386, -1000, -1000, 482,
-	-1000, 16358, -1000, -1000, 787, 273, 677, -1000, 898, -175,
-	-190, 16704, 667, 548, 16358, -1000, 763, -1000, -1000, 49,
-	908, -177, -1000, 888, -1000, 637, -1000, -1000, 16358, -1000,
-	46, -1000, -179, 476, 44, -188, 817, 762, -194, 816,
-	-1000, 1029, 10099, -1000, -1000, 1031, 175, 175, 556, 548,
-	-1000, -1000, -1000, 75, 402, -1000, -1000, -1000, -1000, -1000,
-	-1000,
+	-1000, 768, 768, 768, 755, 755, 755, 755, 793, -1000,
+	16984, -107, 940, 3213, -1000, 16984, 85, -1000, 16984, 16984,
+	16984, 16984, 16984, 117, 16984, 16984, 730, -1000, 16984, 3213,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 16984, 285, 16984, 16984, 298,
+	-1000, 467, 207, 16984, 955, 5385, -1000, 877, 9624, 9624,
+	5023, 9624, -1000, -1000, -1000, 917, -1000, 949, 965, -1000,
+	898, 896, 8216, -1000, -1000, 217, 293, -1000, -1000, 325,
+	-1000, -1000, -1000, -1000, 144, 701, -1000, 1026, -1000, -1000,
+	-1000, -1000, 373, 10671, 10671, 10671, 10671, 538, 538, 1026,
+	2054, 1542, 391, 172, 160, 160, 184, 184, 184, 184,
+	184, 611, 611, -1000, -1000, -1000, 479, -1000, -1000, -1000,
+	479, 8216, 716, -1000, -1000, 9624, -1000, 479, 622, 622,
+	392, 363, 246, 991, 622, 243, 990, 622, 622, 8216,
+	296, -1000, 9624, 479, -1000, 142, -1000, 760, 703, 702,
+	622, 479, 622, 622, 928, 701, -1000, 16635, 14185, 14185,
+	14185, 14185, 14185, -1000, 862, 859, -1000, 860, 852, 867,
+	16984, -1000, 634, 12789, 6820, 161, 701, -1000, 14534, -1000,
+	-1000, 983, 14185, 653, -1000, 653, -1000, 136, -1000, -1000,
+	698, -110, -114, -1000, -1000, -1000, -1000, 298, -1000, 448,
+	-1000, 269, -1000, -1000, -1000, 767, 493, -1000, 931, 209,
+	191, 490, 927, -1000, -1000, -1000, 907, -1000, 321, -1000,
+	-68, -1000, -1000, 410, -10, -10, -1000, -1000, 167, 905,
+	167, 167, 167, 466, 466, 466, 466, -1000, -1000, -1000,
+	-1000, 395, -1000, -1000, -1000, 386, -1000, -1000, -1000, 816,
+	16286, 3213, -1000, 267, -1000, -1000, -1000, -1000, 307, 307,
+	225, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 42, 791, -1000, -1000, -1000, -1000, 14, 31,
+	86, -1000, 3213, -1000, 279, 960, 459, 205, 9624, -1000,
+	-1000, -1000, 456, -1000, -1000, 16286, 694, 875, 298, 298,
+	134, -1000, -1000, 16984, -1000, -1000, -1000, -1000, 737, -1000,
+	-1000, -1000, 3575, 8216, -1000, 538, 538, 1026, 2021, -1000,
+	10671, -1000, 10671, -1000, -1000, 622, 8216, 298, -1000, -1000,
+	-1000, 96, 425, 96, 10671, 10671, -1000, 10671, 10671, -1000,
+	788, 684, 281, -1000, 9624, 441, -1000, 5023, -1000, 10671,
+	10671, -1000, -1000, -1000, -1000, 718, 16635, 16286, 693, -1000,
+	255, 874, 787, 815, 828, -1000, -1000, -1000, -1000, 853,
+	-1000, 849, -1000, -1000, -1000, -1000, 479, 688, -1000, -1000,
+	298, 701, 701, -1000, 97, 93, 92, 16286, -1000, 966,
+	9624, 653, -1000, -1000, 182, -1000, -1000, -142, -135, -1000,
+	-1000, -1000, 2851, 16286, 59, -1000, 490, 490, -1000, -1000,
+	-1000, 763, 801, 10671, -1000, -1000, -1000, 592, 167, 167,
+	-1000, 229, -1000, -1000, -1000, 620, -1000, 618, 612, 605,
+	682, 600, 16984, -1000, -1000, 2851, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 16984, -1000, -1000, -1000, -1000, -1000, 16286, -180, 484,
+	16286, 16286, 16286, 16984, -1000, 285, -1000, -1000, 450, 298,
+	-1000, -1000, -1000, 4661, -1000, 983, 14185, -1000, -1000, 479,
+	-1000, -1000, 10671, 1026, 1026, -1000, -1000, 479, 741, 741,
+	-1000, 741, 755, -1000, 741, 1, 741, -1, 479, 479,
+	2003, 1830, 1784, 1638, 701, -172, -1000, 298, 9624, -1000,
+	1577, 997, 800, 701, -1000, 12428, 669, 598, -1000, 966,
+	16635, 9624, -1000, -1000, 9624, 754, -1000, 9624, -1000, -1000,
+	-1000, 944, 6820, 14185, 16635, 701, 701, 701, 598, 960,
+	298, -1000, -1000, -1000, -1000, 751, -1000, -1000, -1000, 560,
+	-1000, 741, -1000, -1000, -1000, 16286, -54, 1011, 1026, -1000,
+	-1000, -1000, -1000, -1000, -10, 445, -10, -10, -10, 378,
+	-1000, 368, 3213, -1000, -1000, -1000, -1000, -1000, 934, -1000,
+	4661, -1000, -1000, 735, 792, -1000, -1000, -1000, -1000, 967,
+	680, -1000, 1026, -1000, -1000, 133, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 10671, 10671, 10671, 10671, 10671, 479,
+	424, 298, 10671, 10671, -1000, 929, 614, -1000, -1000, 7867,
+	479, 558, 131, -1000, -1000, 16286, 960, -1000, 298, 298,
+	16286, 298, 16984, -1000, 721, 479, 16286, 16286, 16286, 12067,
+	-1000, 2851, 156, 16286, -1000, 550, -1000, 196, -1000, -95,
+	167, -1000, 167, 167, 167, 574, 570, -1000, 701, 673,
+	-1000, 234, 16286, 16984, 976, 962, -1000, -1000, 760, 760,
+	760, 760, 30, -1000, -1000, 760, 760, 926, 701, -1000,
+	-1000, 700, 16286, 16286, -1000, -1000, 542, -1000, -1000, -1000,
+	531, 531, 531, 161, 489, 156, -1000, 483, 226, 420,
+	-1000, 55, 16286, 324, 925, -1000, 919, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 41, 4661, 2851, 482, -1000, -1000,
+	9624, 9624, -1000, -1000, -1000, -1000, 479, 58, -184, -1000,
+	-1000, 1009, -1000, 701, -1000, 719, 126, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 366, -1000, -1000, 16984,
+	-1000, -1000, 412, -1000, -1000, 478, -1000, 16286, -1000, -1000,
+	791, 298, 631, -1000, 869, -178, -188, 16635, 614, 479,
+	16286, -1000, 720, -1000, -1000, 41, 895, -180, -1000, 866,
+	-1000, 602, -1000, -1000, 16286, -1000, 38, -1000, -181, 472,
+	36, -186, 799, 701, -189, 798, -1000, 989, 9973, -1000,
+	-1000, 1002, 201, 201, 760, 479, -1000, -1000, -1000, 68,
+	357, -1000, -1000, -1000, -1000, -1000, -1000,
 }
-var yyPgo = [...]int{
 
-	0, 1225, 17, 535, 1223, 1222, 1221, 1220, 1219, 1217,
-	1216, 1215, 1213, 1212, 1209, 1208, 1207, 1206, 1204, 1203,
-	1198, 1197, 1192, 1190, 1188, 1187, 90, 1184, 1182, 1181,
-	75, 1180, 81, 1179, 1178, 42, 182, 50, 51, 1657,
-	1177, 57, 25, 54, 1175, 1174, 1173, 40, 1172, 1167,
-	22, 1166, 1165, 1164, 82, 1162, 1161, 55, 1153, 1152,
-	88, 1151, 76, 1150, 19, 38, 1149, 1147, 1146, 1145,
-	78, 1539, 1142, 1140, 21, 1138, 1136, 107, 1135, 61,
-	14, 9, 6, 16, 1134, 1061, 8, 1133, 60, 1128,
-	1125, 1123, 1122, 29, 1121, 65, 1120, 24, 64, 58,
-	1118, 10, 80, 35, 30, 4, 83, 70, 1117, 27,
-	73, 63, 1116, 1115, 498, 1114, 1113, 52, 1111, 1109,
-	31, 1108, 112, 489, 1106, 1105, 1104, 1103, 43, 0,
-	452, 62, 79, 1102, 1101, 1100, 1119, 45, 59, 20,
-	26, 48, 218, 49, 1098, 1097, 41, 1095, 1094, 1092,
-	1091, 1090, 1089, 1088, 77, 1087, 1086, 1085, 28, 15,
-	1084, 1083, 66, 33, 1082, 1079, 1078, 56, 71, 1077,
-	1073, 53, 37, 1071, 1070, 1068, 1067, 12, 1066, 23,
-	1065, 13, 1060, 34, 1059, 7, 1058, 11, 1057, 3,
-	1056, 5, 44, 1, 1054, 2, 1051, 1050, 46, 811,
-	85, 1049, 89,
+var yyPgo = [...]int16{
+	0, 1265, 19, 627, 1264, 1263, 1262, 1260, 1259, 1256,
+	1253, 1252, 1251, 1249, 1248, 1247, 1246, 1245, 1240, 1239,
+	1237, 1236, 1235, 1231, 1230, 1229, 92, 1228, 1225, 1224,
+	78, 1219, 81, 1218, 1217, 49, 946, 48, 43, 1458,
+	1215, 52, 25, 53, 1214, 1207, 1206, 40, 1205, 1204,
+	21, 1202, 1201, 1200, 90, 1198, 1197, 56, 1196, 1195,
+	89, 1188, 76, 1181, 12, 55, 1180, 1179, 1178, 1177,
+	79, 953, 1176, 1175, 16, 1174, 1172, 93, 1171, 60,
+	9, 8, 11, 15, 1170, 462, 7, 1167, 50, 1164,
+	1163, 1162, 1161, 29, 1159, 63, 1158, 23, 62, 57,
+	1157, 18, 77, 35, 28, 4, 64, 1153, 70, 1152,
+	31, 75, 54, 1149, 1148, 454, 1147, 1143, 45, 1136,
+	1135, 30, 1121, 175, 87, 1120, 1119, 1118, 1117, 61,
+	0, 456, 94, 80, 1116, 1115, 1114, 1005, 58, 69,
+	22, 26, 37, 27, 41, 1113, 1112, 42, 1111, 1107,
+	1092, 1088, 1087, 1086, 1085, 457, 1083, 1074, 1071, 24,
+	34, 1070, 1068, 66, 44, 1067, 1066, 1062, 65, 73,
+	1060, 1058, 59, 33, 1057, 1056, 1054, 1053, 10, 1052,
+	17, 1048, 14, 1042, 38, 1040, 2, 1039, 13, 1038,
+	3, 1037, 5, 51, 1, 1036, 6, 1035, 1034, 46,
+	1065, 86, 1033, 108,
 }
-var yyR1 = [...]int{
 
-	0, 196, 197, 197, 1, 1, 1, 1, 1, 1,
+var yyR1 = [...]uint8{
+	0, 197, 198, 198, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 2, 2, 2, 6, 3, 4,
 	4, 5, 5, 7, 7, 29, 29, 8, 9, 9,
-	9, 9, 200, 200, 54, 54, 55, 55, 102, 102,
-	10, 10, 10, 10, 107, 107, 111, 111, 111, 112,
-	112, 112, 112, 144, 144, 11, 11, 11, 11, 11,
-	11, 11, 191, 191, 190, 189, 189, 188, 188, 187,
-	17, 174, 176, 176, 175, 175, 175, 175, 168, 147,
-	147, 147, 147, 150, 150, 148, 148, 148, 148, 148,
-	148, 148, 148, 148, 148, 148, 148, 148, 148, 148,
-	148, 148, 149, 149, 149, 149, 149, 149, 149, 151,
-	151, 151, 151, 151, 152, 152, 152, 152, 152, 152,
-	152, 152, 152, 152, 152, 152, 152, 152, 152, 152,
-	152, 152, 152, 153, 153, 153, 153, 153, 153, 153,
-	153, 167, 167, 154, 154, 162, 162, 163, 163, 163,
-	160, 160, 161, 161, 164, 164, 164, 164, 156, 156,
-	157, 157, 165, 165, 158, 158, 158, 159, 159, 159,
-	166, 166, 166, 166, 166, 155, 155, 169, 169, 182,
-	182, 181, 181, 181, 173, 173, 178, 178, 178, 178,
-	178, 171, 171, 172, 172, 180, 180, 179, 170, 170,
-	183, 183, 183, 183, 194, 195, 193, 193, 193, 193,
-	193, 45, 45, 45, 46, 46, 177, 177, 177, 12,
+	9, 9, 201, 201, 54, 54, 55, 55, 102, 102,
+	10, 10, 10, 10, 108, 108, 112, 112, 112, 113,
+	113, 113, 113, 145, 145, 11, 11, 11, 11, 11,
+	11, 11, 192, 192, 191, 190, 190, 189, 189, 188,
+	17, 17, 175, 177, 177, 176, 176, 176, 176, 169,
+	148, 148, 148, 148, 151, 151, 149, 149, 149, 149,
+	149, 149, 149, 149, 149, 149, 149, 149, 149, 149,
+	149, 149, 149, 150, 150, 150, 150, 150, 150, 150,
+	152, 152, 152, 152, 152, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 154, 154, 154, 154, 154, 154,
+	154, 154, 168, 168, 155, 155, 163, 163, 164, 164,
+	164, 161, 161, 162, 162, 165, 165, 165, 165, 157,
+	157, 158, 158, 166, 166, 159, 159, 159, 160, 160,
+	160, 167, 167, 167, 167, 167, 156, 156, 170, 170,
+	183, 183, 182, 182, 182, 174, 174, 179, 179, 179,
+	179, 179, 172, 172, 173, 173, 181, 181, 180, 171,
+	171, 184, 184, 184, 184, 195, 196, 194, 194, 194,
+	194, 194, 45, 45, 45, 46, 46, 178, 178, 178,
 	12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
-	12, 12, 12, 12, 192, 192, 192, 192, 192, 192,
-	192, 192, 192, 192, 192, 192, 186, 184, 184, 185,
-	185, 13, 18, 18, 14, 14, 14, 14, 14, 15,
-	15, 19, 20, 20, 20, 20, 20, 20, 20, 20,
+	12, 12, 12, 12, 12, 193, 193, 193, 193, 193,
+	193, 193, 193, 193, 193, 193, 193, 187, 185, 185,
+	186, 186, 13, 18, 18, 14, 14, 14, 14, 14,
+	15, 15, 19, 20, 20, 20, 20, 20, 20, 20,
 	20, 20, 20, 20, 20, 20, 20, 20, 20, 20,
 	20, 20, 20, 20, 20, 20, 20, 20, 20, 20,
-	118, 118, 116, 116, 119, 119, 117, 117, 117, 120,
-	120, 120, 120, 121, 121, 121, 145, 145, 145, 21,
-	21, 23, 23, 24, 25, 22, 22, 22, 22, 22,
-	22, 22, 16, 201, 26, 27, 27, 28, 28, 28,
-	32, 32, 32, 30, 30, 31, 31, 37, 37, 36,
-	36, 38, 38, 38, 38, 133, 133, 133, 132, 132,
-	40, 40, 41, 41, 42, 42, 43, 43, 43, 43,
-	43, 43, 63, 63, 52, 52, 51, 51, 50, 53,
-	53, 53, 101, 101, 103, 103, 44, 44, 44, 44,
-	47, 47, 48, 48, 49, 49, 140, 140, 139, 139,
-	139, 138, 138, 56, 56, 56, 58, 57, 57, 57,
-	57, 59, 59, 61, 61, 60, 60, 62, 64, 64,
-	64, 64, 65, 65, 39, 39, 39, 39, 39, 39,
-	39, 115, 115, 67, 67, 66, 66, 66, 66, 66,
-	66, 66, 66, 66, 66, 78, 78, 78, 78, 78,
-	78, 68, 68, 68, 68, 68, 68, 68, 35, 35,
-	79, 79, 79, 85, 80, 80, 71, 71, 71, 71,
+	20, 119, 119, 117, 117, 120, 120, 118, 118, 118,
+	121, 121, 121, 121, 122, 122, 122, 146, 146, 146,
+	21, 21, 23, 23, 24, 25, 22, 22, 22, 22,
+	22, 22, 22, 16, 202, 26, 27, 27, 28, 28,
+	28, 32, 32, 32, 30, 30, 31, 31, 37, 37,
+	36, 36, 38, 38, 38, 38, 134, 134, 134, 133,
+	133, 40, 40, 41, 41, 42, 42, 43, 43, 43,
+	43, 43, 43, 63, 63, 52, 52, 51, 51, 50,
+	53, 53, 53, 101, 101, 103, 103, 44, 44, 44,
+	44, 47, 47, 48, 48, 49, 49, 141, 141, 140,
+	140, 140, 139, 139, 56, 56, 56, 58, 57, 57,
+	57, 57, 59, 59, 61, 61, 60, 60, 62, 64,
+	64, 64, 64, 65, 65, 39, 39, 39, 39, 39,
+	39, 39, 116, 116, 67, 67, 66, 66, 66, 66,
+	66, 66, 66, 66, 66, 66, 66, 66, 78, 78,
+	78, 78, 78, 78, 68, 68, 68, 68, 68, 68,
+	68, 35, 35, 79, 79, 79, 85, 80, 80, 71,
 	71, 71, 71, 71, 71, 71, 71, 71, 71, 71,
 	71, 71, 71, 71, 71, 71, 71, 71, 71, 71,
-	71, 71, 71, 71, 71, 71, 71, 71, 75, 75,
-	75, 73, 73, 73, 73, 73, 73, 73, 73, 73,
-	73, 73, 73, 73, 74, 74, 74, 74, 74, 74,
+	71, 71, 71, 71, 71, 71, 71, 71, 71, 71,
+	71, 75, 75, 75, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 74, 74,
 	74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
-	202, 202, 77, 76, 76, 76, 76, 76, 76, 33,
-	33, 33, 33, 33, 143, 143, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 89,
-	89, 34, 34, 87, 87, 88, 90, 90, 86, 86,
-	86, 70, 70, 70, 70, 70, 70, 70, 70, 72,
-	72, 72, 91, 91, 92, 92, 93, 93, 94, 94,
-	95, 96, 96, 96, 97, 97, 97, 97, 98, 98,
-	98, 99, 99, 69, 69, 69, 69, 69, 69, 100,
-	100, 100, 100, 104, 104, 81, 81, 83, 83, 82,
-	84, 105, 105, 109, 106, 106, 110, 110, 110, 110,
-	108, 108, 108, 135, 135, 135, 113, 113, 122, 122,
-	123, 123, 114, 114, 124, 124, 124, 124, 124, 124,
-	124, 124, 124, 124, 125, 125, 125, 126, 126, 127,
-	127, 127, 134, 134, 130, 130, 131, 131, 136, 136,
-	137, 137, 128, 128, 128, 128, 128, 128, 128, 128,
-	128, 128, 128, 128, 128, 128, 128, 128, 128, 128,
-	128, 128, 128, 128, 128, 128, 128, 128, 128, 128,
-	128, 128, 128, 128, 128, 128, 128, 128, 128, 128,
-	128, 128, 128, 128, 128, 128, 128, 128, 128, 128,
-	128, 128, 128, 128, 128, 128, 128, 128, 128, 128,
-	128, 128, 128, 128, 128, 128, 128, 128, 128, 128,
-	128, 128, 128, 128, 128, 128, 128, 128, 128, 128,
-	128, 128, 128, 128, 128, 128, 128, 128, 128, 128,
-	128, 128, 128, 128, 128, 128, 128, 128, 128, 128,
-	128, 128, 128, 128, 128, 128, 128, 128, 128, 128,
-	128, 128, 128, 128, 128, 128, 128, 128, 128, 128,
-	129, 129, 129, 129, 129, 129, 129, 129, 129, 129,
-	129, 129, 129, 129, 129, 129, 129, 129, 129, 129,
-	129, 129, 129, 129, 129, 129, 129, 129, 129, 129,
-	129, 129, 129, 129, 129, 129, 129, 129, 129, 129,
-	129, 129, 129, 129, 129, 129, 129, 129, 129, 129,
+	74, 74, 74, 74, 203, 203, 77, 76, 76, 76,
+	76, 76, 76, 33, 33, 33, 33, 33, 144, 144,
+	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
+	147, 147, 147, 89, 89, 34, 34, 87, 87, 88,
+	90, 90, 86, 86, 86, 70, 70, 70, 70, 70,
+	70, 70, 70, 72, 72, 72, 91, 91, 92, 92,
+	93, 93, 94, 94, 95, 96, 96, 96, 97, 97,
+	97, 97, 98, 98, 98, 107, 107, 99, 99, 69,
+	69, 69, 69, 69, 69, 100, 100, 100, 100, 104,
+	104, 81, 81, 83, 83, 82, 84, 105, 105, 110,
+	106, 106, 111, 111, 111, 111, 109, 109, 109, 136,
+	136, 136, 114, 114, 123, 123, 124, 124, 115, 115,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	126, 126, 126, 127, 127, 128, 128, 128, 135, 135,
+	131, 131, 132, 132, 137, 137, 138, 138, 129, 129,
 	129, 129, 129, 129, 129, 129, 129, 129, 129, 129,
 	129, 129, 129, 129, 129, 129, 129, 129, 129, 129,
 	129, 129, 129, 129, 129, 129, 129, 129, 129, 129,
@@ -2939,78 +2928,95 @@ var yyR1 = [...]int{
 	129, 129, 129, 129, 129, 129, 129, 129, 129, 129,
 	129, 129, 129, 129, 129, 129, 129, 129, 129, 129,
 	129, 129, 129, 129, 129, 129, 129, 129, 129, 129,
-	129, 129, 129, 129, 129, 129, 129, 129, 129, 198,
-	199, 141, 142, 142, 142,
+	129, 129, 129, 129, 129, 129, 129, 129, 130, 130,
+	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
+	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
+	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
+	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
+	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
+	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
+	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
+	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
+	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
+	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
+	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
+	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
+	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
+	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
+	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
+	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
+	130, 130, 130, 130, 130, 130, 130, 130, 199, 200,
+	142, 143, 143, 143,
 }
-var yyR2 = [...]int{
 
+var yyR2 = [...]int8{
 	0, 2, 0, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 0, 5, 6, 7, 5, 10, 1,
+	1, 1, 1, 0, 6, 6, 7, 5, 10, 1,
 	3, 1, 3, 8, 8, 1, 1, 9, 8, 7,
 	6, 6, 1, 1, 1, 3, 1, 3, 0, 4,
 	3, 4, 5, 4, 1, 3, 3, 2, 2, 2,
 	2, 2, 1, 1, 1, 2, 2, 8, 4, 6,
 	5, 5, 0, 2, 1, 0, 2, 1, 3, 3,
-	4, 4, 2, 4, 1, 3, 3, 3, 8, 3,
-	1, 1, 1, 2, 1, 1, 1, 1, 1, 1,
+	4, 5, 4, 2, 4, 1, 3, 3, 3, 8,
+	3, 1, 1, 1, 2, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 2, 2, 2, 2, 2, 2, 2, 1,
-	2, 2, 2, 1, 4, 4, 2, 2, 3, 3,
-	3, 3, 1, 1, 1, 1, 1, 6, 6, 6,
-	6, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 3, 0, 3, 0, 5, 0, 3, 5,
-	0, 1, 0, 1, 0, 1, 2, 1, 0, 2,
-	0, 3, 0, 1, 0, 3, 3, 0, 2, 2,
-	0, 2, 1, 2, 1, 0, 2, 5, 4, 1,
-	2, 2, 3, 2, 0, 1, 2, 3, 3, 2,
-	2, 1, 1, 0, 1, 1, 3, 2, 3, 1,
-	10, 11, 11, 12, 3, 3, 1, 1, 2, 2,
-	2, 0, 3, 6, 0, 3, 1, 1, 1, 6,
-	7, 7, 7, 7, 4, 5, 7, 5, 5, 5,
-	12, 7, 5, 9, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 7, 1, 3, 8,
-	8, 3, 3, 5, 4, 6, 5, 4, 4, 3,
-	2, 3, 4, 4, 3, 4, 4, 4, 4, 4,
-	4, 3, 2, 3, 3, 2, 3, 4, 3, 7,
-	6, 4, 2, 4, 4, 3, 3, 5, 2, 3,
-	1, 1, 0, 1, 1, 1, 0, 2, 2, 0,
-	2, 3, 2, 0, 2, 3, 0, 1, 1, 2,
-	1, 1, 2, 1, 1, 2, 2, 2, 2, 2,
-	3, 3, 2, 0, 2, 0, 2, 1, 2, 2,
-	0, 1, 1, 0, 1, 0, 1, 0, 1, 1,
-	3, 1, 2, 3, 5, 0, 1, 2, 1, 1,
-	0, 2, 1, 3, 1, 1, 1, 3, 1, 3,
-	5, 6, 3, 7, 0, 1, 1, 3, 1, 1,
-	4, 4, 1, 3, 1, 3, 4, 4, 4, 3,
-	2, 4, 0, 1, 0, 2, 0, 1, 0, 1,
-	2, 1, 1, 1, 2, 2, 1, 2, 3, 2,
-	3, 2, 2, 2, 1, 1, 3, 3, 0, 5,
-	5, 5, 0, 2, 1, 3, 3, 2, 3, 1,
-	2, 0, 3, 1, 1, 3, 3, 4, 4, 5,
-	3, 4, 5, 6, 2, 1, 2, 1, 2, 1,
-	2, 1, 1, 1, 1, 1, 1, 1, 0, 2,
-	1, 1, 1, 3, 1, 3, 1, 1, 1, 1,
-	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 2, 2, 2, 2,
-	2, 2, 2, 3, 1, 1, 1, 1, 4, 5,
-	6, 4, 4, 6, 6, 6, 8, 8, 8, 8,
-	9, 7, 5, 4, 2, 2, 2, 2, 2, 2,
-	2, 2, 2, 2, 2, 2, 2, 2, 8, 8,
-	0, 2, 3, 4, 4, 4, 4, 4, 4, 0,
-	3, 4, 7, 3, 1, 1, 2, 3, 3, 1,
-	2, 2, 1, 2, 1, 2, 2, 1, 2, 0,
-	1, 0, 2, 1, 2, 4, 0, 2, 1, 3,
-	5, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	2, 2, 0, 3, 0, 2, 0, 3, 1, 3,
-	2, 0, 1, 1, 0, 2, 4, 4, 0, 2,
-	4, 0, 2, 2, 1, 3, 5, 4, 6, 1,
-	3, 3, 5, 0, 5, 1, 3, 1, 2, 3,
-	1, 1, 3, 3, 1, 3, 3, 3, 3, 3,
-	1, 2, 1, 1, 1, 1, 1, 1, 0, 2,
-	0, 3, 0, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 0, 1, 1, 1, 1, 0,
-	1, 1, 0, 2, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 2, 2, 2, 2, 2, 2, 2,
+	1, 2, 2, 2, 1, 4, 4, 2, 2, 3,
+	3, 3, 3, 1, 1, 1, 1, 1, 6, 6,
+	6, 6, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 3, 0, 3, 0, 5, 0, 3,
+	5, 0, 1, 0, 1, 0, 1, 2, 1, 0,
+	2, 0, 3, 0, 1, 0, 3, 3, 0, 2,
+	2, 0, 2, 1, 2, 1, 0, 2, 5, 4,
+	1, 2, 2, 3, 2, 0, 1, 2, 3, 3,
+	2, 2, 1, 1, 0, 1, 1, 3, 2, 3,
+	1, 10, 11, 11, 12, 3, 3, 1, 1, 2,
+	2, 2, 0, 3, 6, 0, 3, 1, 1, 1,
+	6, 7, 7, 7, 7, 4, 5, 7, 5, 5,
+	5, 12, 7, 5, 9, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 7, 1, 3,
+	8, 8, 3, 3, 5, 4, 6, 5, 4, 4,
+	3, 2, 3, 4, 4, 3, 4, 4, 4, 4,
+	4, 4, 3, 2, 3, 3, 2, 3, 4, 3,
+	7, 6, 4, 2, 4, 4, 3, 3, 5, 2,
+	3, 1, 1, 0, 1, 1, 1, 0, 2, 2,
+	0, 2, 3, 2, 0, 2, 3, 0, 1, 1,
+	2, 1, 1, 2, 1, 1, 2, 2, 2, 2,
+	2, 3, 3, 2, 0, 2, 0, 2, 1, 2,
+	2, 0, 1, 1, 0, 1, 0, 1, 0, 1,
+	1, 3, 1, 2, 3, 5, 0, 1, 2, 1,
+	1, 0, 2, 1, 3, 1, 1, 1, 3, 1,
+	3, 5, 6, 3, 7, 0, 1, 1, 3, 1,
+	1, 4, 4, 1, 3, 1, 3, 4, 4, 4,
+	3, 2, 4, 0, 1, 0, 2, 0, 1, 0,
+	1, 2, 1, 1, 1, 2, 2, 1, 2, 3,
+	2, 3, 2, 2, 2, 1, 1, 3, 3, 0,
+	5, 5, 5, 0, 2, 1, 3, 3, 2, 3,
+	1, 2, 0, 3, 1, 1, 3, 3, 4, 4,
+	5, 4, 5, 3, 4, 5, 6, 2, 1, 2,
+	1, 2, 1, 2, 1, 1, 1, 1, 1, 1,
+	1, 0, 2, 1, 1, 1, 3, 1, 3, 1,
+	1, 1, 1, 1, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 2,
+	2, 2, 2, 2, 2, 2, 3, 1, 1, 1,
+	1, 4, 5, 6, 4, 4, 6, 6, 6, 8,
+	8, 8, 8, 9, 4, 7, 5, 4, 2, 2,
+	2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+	2, 2, 8, 8, 0, 2, 3, 4, 4, 4,
+	4, 4, 4, 0, 3, 4, 7, 3, 1, 1,
+	2, 3, 3, 1, 2, 2, 1, 2, 1, 2,
+	2, 1, 2, 0, 1, 0, 2, 1, 2, 4,
+	0, 2, 1, 3, 5, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 2, 2, 0, 3, 0, 2,
+	0, 3, 1, 3, 2, 0, 1, 1, 0, 2,
+	4, 4, 0, 2, 4, 0, 2, 0, 2, 2,
+	1, 3, 5, 4, 6, 1, 3, 3, 5, 0,
+	5, 1, 3, 1, 2, 3, 1, 1, 3, 3,
+	1, 3, 3, 3, 3, 3, 1, 2, 1, 1,
+	1, 1, 1, 1, 0, 2, 0, 3, 0, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	0, 1, 1, 1, 1, 0, 1, 1, 0, 2,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -3040,360 +3046,363 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 0, 0, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	0, 0, 1, 1,
 }
-var yyChk = [...]int{
 
-	-1000, -196, -1, -2, -6, -7, -8, -9, -10, -11,
+var yyChk = [...]int16{
+	-1000, -197, -1, -2, -6, -7, -8, -9, -10, -11,
 	-12, -13, -14, -15, -19, -20, -21, -23, -24, -25,
-	-22, -16, -3, -4, 6, 7, -29, 9, 10, 31,
-	-17, 116, 117, 119, 118, 152, 120, 145, 51, 166,
-	167, 169, 170, 26, 146, 147, 150, 151, 32, 33,
-	122, -198, 8, 266, 55, -197, 364, -93, 15, -28,
-	5, -26, -201, -26, -26, -26, -26, -26, -174, -176,
-	55, 91, -127, 127, 73, 258, 123, 124, 131, -130,
-	58, -129, 276, 138, 308, 309, 166, 177, 171, 198,
-	190, 277, 310, 139, 188, 191, 245, 137, 311, 232,
-	239, 67, 169, 254, 312, 148, 186, 182, 313, 285,
-	180, 28, 314, 241, 203, 315, 281, 181, 240, 122,
-	316, 141, 135, 317, 204, 208, 318, 246, 319, 320,
-	321, 175, 176, 322, 248, 202, 136, 34, 278, 36,
-	156, 249, 206, 323, 201, 197, 324, 325, 326, 327,
-	200, 174, 196, 40, 210, 209, 211, 244, 193, 328,
-	329, 330, 142, 331, 183, 18, 332, 333, 334, 335,
-	336, 252, 151, 337, 154, 338, 339, 340, 341, 342,
-	343, 243, 205, 207, 132, 158, 280, 344, 250, 179,
-	345, 143, 155, 150, 253, 144, 346, 347, 348, 349,
-	350, 351, 352, 170, 353, 354, 355, 356, 165, 247,
-	256, 39, 229, 357, 173, 134, 358, 167, 162, 234,
-	194, 157, 359, 360, 184, 185, 199, 172, 195, 168,
-	159, 152, 361, 255, 230, 282, 192, 189, 163, 362,
-	160, 161, 363, 235, 236, 164, 279, 251, 187, 231,
-	-114, 127, 236, 129, 124, 124, 126, 127, 258, 123,
-	124, -60, -136, 58, -129, 127, 124, 109, 191, 245,
-	116, 233, 241, 126, 34, 243, 158, -145, 124, -116,
-	232, 235, 236, 164, 58, 247, 246, 237, -136, 168,
-	-141, -141, -141, -141, -141, 234, 234, -141, -2, -97,
-	17, 16, -5, -3, -198, 6, 21, 22, -32, 41,
-	42, -27, -38, 100, -39, -136, -66, 75, -71, 30,
-	58, -129, 24, -70, -67, -86, -84, -85, 109, 110,
-	111, 98, 99, 106, 76, 112, -75, -73, -74, -76,
-	60, 59, 68, 61, 62, 63, 64, 69, 70, 71,
-	-130, -82, -198, 45, 46, 267, 268, 269, 270, 275,
-	271, 78, 35, 257, 265, 264, 263, 261, 262, 259,
-	260, 273, 274, 130, 258, 104, 266, -114, -114, 11,
-	-54, -55, -60, -62, -136, -106, -144, 168, -110, 247,
-	246, -131, -108, -130, -128, 245, 191, 244, 121, 283,
-	74, 23, 25, 227, 77, 109, 16, 78, 108, 267,
-	116, 49, 284, 259, 260, 257, 269, 270, 258, 233,
-	30, 10, 286, 26, 146, 22, 102, 118, 81, 82,
-	149, 24, 147, 71, 289, 19, 52, 11, 13, 290,
-	291, 14, 130, 129, 93, 126, 47, 8, 112, 27,
-	90, 43, 292, 29, 293, 294, 295, 296, 45, 91,
-	17, 261, 262, 32, 297, 275, 153, 104, 50, 37,
-	75, 298, 299, 69, 300, 72, 53, 73, 15, 48,
-	301, 302, 303, 304, 92, 119, 266, 46, 305, 123,
-	6, 272, 31, 145, 44, 306, 124, 80, 273, 274,
-	128, 70, 5, 131, 33, 9, 51, 54, 263, 264,
-	265, 35, 79, 12, 307, 20, -175, 91, -168, 58,
-	-60, 126, -60, 266, -123, 130, -123, -123, 124, -60,
-	116, 118, 121, 53, -18, -60, -122, 130, 58, -122,
-	-122, -122, -60, 113, -60, 58, 31, -142, -198, -131,
-	258, 58, 158, 124, 159, 127, -142, -142, -142, -142,
-	162, 163, -142, -119, -118, 239, 240, 234, 238, 12,
-	163, 234, 161, -142, -141, -141, -199, 57, -98, 19,
-	32, -39, -136, -94, -95, -39, -93, -2, -26, 37,
-	-30, 22, 66, 11, -133, 74, 73, 90, -132, 23,
-	-130, 60, 113, -39, -68, 93, 75, 91, 92, 77,
-	95, 94, 105, 98, 99, 100, 101, 102, 103, 104,
-	96, 97, 108, 83, 84, 85, 86, 87, 88, 89,
-	-115, -198, -85, -198, 114, 115, -71, -71, -71, -71,
-	-71, -71, -71, -71, -198, -2, -80, -39, -198, -198,
-	-198, -198, -198, -198, -198, -198, -198, -89, -39, -198,
-	-202, -77, -198, -202, -77, -202, -77, -202, -198, -202,
-	-77, -202, -77, -202, -202, -77, -198, -198, -198, -198,
-	-198, -198, -61, 27, -60, -41, -42, -43, -44, -63,
-	-85, -198, 58, -60, -60, -54, -200, 56, 11, 54,
-	-200, 56, 113, 56, -106, 168, -107, -111, 248, 250,
-	83, -135, -130, 60, 30, 31, 57, 56, -60, -147,
-	-150, -152, -151, -153, -148, -149, 188, 189, 109, 192,
-	194, 195, 196, 197, 198, 199, 200, 201, 202, 203,
-	222, 223, 31, 225, 60, 148, 184, 185, 186, 187,
-	204, 205, 206, 207, 208, 209, 210, 211, 171, 190,
-	277, 172, 173, 174, 175, 176, 177, 212, 213, 214,
-	215, 216, 217, 218, 219, 179, 180, 181, 182, 183,
-	220, 221, 58, -142, 127, 58, 75, 58, -60, -60,
-	-142, 160, 160, 124, 124, 165, -60, 56, 128, -54,
-	24, 53, -60, 58, 58, -137, -136, -128, -142, -142,
-	-142, -142, -142, -60, -142, -142, -142, -142, 11, -117,
-	11, 93, -39, -121, 91, 75, 53, -99, 20, 9,
-	93, 56, 18, 113, 56, -96, 25, 26, -97, -199,
-	-32, -72, -130, 61, 64, -31, 44, -60, -39, -39,
-	-78, 69, 75, 70, 71, -132, 100, -137, -131, -128,
-	-71, -79, -82, -85, 65, 93, 91, 92, 77, -71,
+	-22, -16, -3, -4, 6, 7, -29, 9, 10, 32,
+	-17, 119, 120, 122, 121, 156, 123, 149, 52, 170,
+	171, 173, 174, 27, 150, 151, 154, 155, 33, 34,
+	125, -199, 8, 270, 57, -198, 367, -93, 15, -28,
+	5, -26, -202, -26, -26, -26, -26, -26, -175, -177,
+	57, 93, -128, 131, 75, 262, 126, 127, 128, 135,
+	-131, 60, -130, 56, 142, 311, 312, 170, 181, 175,
+	202, 194, 280, 313, 143, 192, 195, 249, 141, 314,
+	236, 243, 69, 173, 258, 315, 152, 190, 186, 316,
+	288, 184, 29, 317, 245, 207, 318, 284, 185, 244,
+	125, 319, 145, 139, 320, 208, 212, 321, 250, 322,
+	323, 324, 179, 180, 325, 252, 206, 140, 35, 281,
+	37, 160, 253, 210, 326, 205, 201, 327, 328, 329,
+	330, 204, 178, 200, 41, 214, 213, 215, 248, 197,
+	331, 332, 333, 146, 334, 187, 18, 335, 336, 337,
+	338, 339, 256, 155, 340, 158, 341, 342, 343, 344,
+	345, 346, 247, 209, 211, 136, 162, 283, 347, 254,
+	183, 348, 147, 159, 154, 257, 148, 349, 350, 351,
+	352, 353, 354, 355, 174, 356, 357, 358, 359, 169,
+	251, 260, 40, 233, 360, 177, 138, 361, 171, 166,
+	238, 198, 161, 362, 363, 188, 189, 203, 176, 199,
+	172, 163, 156, 364, 259, 234, 285, 196, 193, 167,
+	365, 164, 165, 366, 239, 240, 168, 282, 255, 191,
+	235, -115, 131, 240, 133, 127, 127, 130, 131, 262,
+	126, 127, -60, -137, 60, -130, 128, 131, 127, 112,
+	195, 249, 119, 237, 245, 130, 35, 247, 162, -146,
+	127, -117, 236, 239, 240, 168, 60, 251, 250, 241,
+	-137, 172, -142, -142, -142, -142, -142, 238, 238, -142,
+	-2, -97, 17, 16, -5, -3, -199, 6, 22, 23,
+	-32, 42, 43, -27, -38, 103, -39, -137, -66, 77,
+	-71, 31, 60, -130, 25, -70, -67, -86, -84, -85,
+	112, 113, 114, 101, 102, 109, 78, 115, -75, -73,
+	-74, -76, 62, 61, 70, 63, 64, 65, 66, 71,
+	72, 73, -131, -82, -199, 46, 47, 271, 272, 273,
+	274, 279, 275, 80, 36, 261, 269, 268, 267, 265,
+	266, 263, 264, 277, 278, 134, 262, 107, 270, -115,
+	-115, 11, -54, -55, -60, -62, -137, -106, -145, 172,
+	-111, 251, 250, -132, -109, -131, -129, 249, 195, 248,
+	124, 286, 76, 24, 26, 231, 79, 112, 16, 80,
+	111, 271, 119, 50, 287, 263, 264, 261, 273, 274,
+	262, 237, 31, 10, 289, 27, 150, 23, 105, 121,
+	83, 84, 153, 25, 151, 73, 292, 19, 53, 11,
+	13, 293, 294, 14, 134, 133, 96, 130, 48, 8,
+	115, 28, 92, 44, 295, 30, 296, 297, 298, 299,
+	46, 93, 94, 17, 265, 266, 33, 300, 279, 157,
+	107, 51, 38, 77, 301, 302, 71, 303, 74, 54,
+	75, 15, 49, 304, 305, 306, 307, 95, 122, 270,
+	47, 308, 126, 6, 276, 32, 149, 45, 309, 127,
+	82, 277, 278, 132, 72, 5, 135, 34, 9, 52,
+	55, 267, 268, 269, 36, 81, 12, 310, 20, 21,
+	-176, 93, -169, 60, -60, 130, -60, 270, -124, 134,
+	-124, -124, 127, 127, -60, 119, 121, 124, 54, -18,
+	-60, -123, 134, 60, -123, -123, -123, -60, 116, -60,
+	60, 32, -143, -199, -132, 262, 60, 162, 127, 163,
+	131, -143, -143, -143, -143, 166, 167, -143, -120, -119,
+	243, 244, 238, 242, 12, 167, 238, 165, -143, -142,
+	-142, -200, 59, -98, 19, 33, -39, -137, -94, -95,
+	-39, -93, -2, -26, 38, -30, 23, 68, 11, -134,
+	76, 75, 92, -133, 24, -131, 62, 116, -39, -68,
+	96, 77, 93, 94, 95, 79, 98, 97, 108, 101,
+	102, 103, 104, 105, 106, 107, 99, 100, 111, 85,
+	86, 87, 88, 89, 90, 91, -116, -199, -85, -199,
+	117, 118, -71, -71, -71, -71, -71, -71, -71, -71,
+	-199, -2, -80, -39, -199, -199, -199, -199, -199, -199,
+	-199, -199, -199, -89, -39, -199, -203, -77, -199, -203,
+	-77, -203, -77, -203, -199, -203, -77, -203, -77, -203,
+	-203, -77, -199, -199, -199, -199, -199, -199, -61, 28,
+	-60, -41, -42, -43, -44, -63, -85, -199, 60, -60,
+	-60, -54, -201, 58, 11, 55, -201, 58, 116, 58,
+	-106, 172, -108, -112, 252, 254, 85, -136, -131, 62,
+	31, 32, 59, 58, -60, -148, -151, -153, -152, -154,
+	-149, -150, 192, 193, 112, 196, 198, 199, 200, 201,
+	202, 203, 204, 205, 206, 207, 226, 227, 32, 229,
+	62, 152, 188, 189, 190, 191, 208, 209, 210, 211,
+	212, 213, 214, 215, 175, 194, 280, 176, 177, 178,
+	179, 180, 181, 216, 217, 218, 219, 220, 221, 222,
+	223, 183, 184, 185, 186, 187, 224, 225, 60, -143,
+	131, 60, 77, 60, -60, -124, -60, -143, 164, 164,
+	127, 127, 169, -60, 58, 132, -54, 25, 54, -60,
+	60, 60, -138, -137, -129, -143, -143, -143, -143, -143,
+	-60, -143, -143, -143, -143, 11, -118, 11, 96, -39,
+	-122, 93, 77, 54, -107, 21, 9, 96, 58, 18,
+	116, 58, -96, 26, 27, -97, -200, -32, -72, -131,
+	63, 66, -31, 45, -60, -39, -39, -78, 71, 77,
+	72, 73, -133, 103, -138, -132, -129, -71, -79, -82,
+	-85, 67, 96, 93, 94, 95, 79, -71, -71, -71,
 	-71, -71, -71, -71, -71, -71, -71, -71, -71, -71,
-	-71, -71, -71, -71, -143, 58, 60, 58, -70, -70,
-	-130, -37, 22, -36, -38, -199, 56, -199, -2, -36,
-	-36, -39, -39, -86, 60, -36, -86, 60, -36, -36,
-	-30, -87, -88, 79, -86, -130, -136, -199, -71, -130,
-	-130, -36, -37, -36, -36, -102, 154, -60, 31, 56,
-	-56, -58, -57, -59, 43, 47, 49, 44, 45, 46,
-	50, -140, 23, -41, -198, -198, -139, 154, -138, 23,
-	-136, 60, -102, 54, -41, -60, -41, -62, -136, 100,
-	-110, -107, 56, 249, 251, 252, 53, 72, -39, -159,
-	108, -45, 242, -168, -169, -170, -178, 140, -183, 132,
-	134, 131, -171, 141, 126, 29, 57, -164, 69, 75,
-	224, -160, 230, -154, 55, -154, -154, -154, -154, -158,
-	191, -158, -158, -158, 55, 55, 55, 55, -154, -154,
-	-154, -162, 55, -162, -162, -163, 55, -163, -163, -163,
-	-134, 54, -60, -46, 242, 24, -142, -124, 121, 118,
-	119, -186, 117, 227, 191, 67, 30, 15, 267, 154,
-	282, 58, 155, -60, -60, -60, -60, -60, 121, 118,
-	-60, -60, -60, -142, -60, -120, 91, 75, 12, -136,
-	-136, 60, 91, -60, 58, 39, -39, -39, -137, -95,
-	-98, -113, 19, 11, 35, 35, -36, 69, 70, 71,
-	113, -198, -79, -71, -71, -71, -35, 149, 74, -199,
-	-199, -36, 56, -39, -199, -199, -199, 56, 54, 23,
-	11, 11, -199, 11, 11, -199, -199, -36, -90, -88,
-	81, -39, -199, 113, -199, 56, 56, -199, -199, -199,
-	-199, -99, 31, -198, -105, -109, -86, -42, -43, -43,
-	-42, -43, 43, 43, 43, 48, 43, 48, 43, -57,
-	-136, -199, -52, -51, -50, -53, -39, 124, 125, -64,
-	51, 129, 52, -198, -138, -65, 12, -41, -65, -65,
-	113, -111, -112, 253, 250, 256, 58, 60, 83, 55,
-	58, 29, -171, -171, -172, 58, -172, 29, -156, 30,
-	69, -161, 231, 61, -158, -158, -159, 31, -159, -159,
-	-159, -167, 60, -167, -167, -167, 61, 61, 53, -130,
-	-142, 83, -141, -192, 137, 133, 140, 141, 135, 58,
-	126, 29, 132, 134, 154, 131, -192, -125, -126, 128,
-	23, 126, 29, 154, -191, 54, 160, 227, 160, 128,
-	-142, -117, -97, 60, 91, -39, 60, 40, 113, -60,
-	-40, 11, 100, -131, -37, -35, 74, -71, -71, -199,
-	-38, -146, 109, 188, 148, 186, 182, 202, 193, 229,
-	184, 230, -143, -146, -71, -71, -71, -71, 276, -93,
-	82, -39, 80, -131, -71, -71, -69, 35, -2, -198,
-	-105, -103, -130, -65, 56, 83, -48, -47, 53, 54,
-	-49, 53, -47, 43, 43, -199, 56, -198, -198, 126,
-	126, 126, -103, -93, -39, -65, 250, 254, 255, -177,
-	-131, 60, 61, -180, -179, -130, -183, -172, -172, 55,
-	-157, 53, -71, 57, -159, -159, 58, 109, 57, 56,
-	57, 57, 57, 56, 57, 56, -60, -177, -141, -141,
-	-60, -141, -130, -189, 279, -190, 58, -130, -130, -130,
-	-60, -120, 60, -65, -41, -199, -71, -199, -154, -154,
-	-154, -163, -154, 176, -154, 176, -199, -199, 19, 19,
-	19, 19, -198, -34, 272, -39, 56, 56, -104, 53,
-	-81, -83, -82, -198, -2, -100, -130, -104, -199, 56,
-	-93, -109, -39, -39, 55, -39, -140, -50, -42, -86,
-	-198, -198, -198, -199, -97, 55, 57, 56, -154, -101,
-	-130, -165, 227, 9, -158, 60, -158, -158, -158, 61,
-	61, -142, 27, -188, -187, -131, 55, 54, -91, 13,
-	-158, 58, -71, -71, -71, -71, -71, -199, 60, -71,
-	-71, 28, 56, -199, -199, -199, 56, 113, -130, -97,
-	-101, -136, -199, -199, -101, -101, -101, -139, -177, -182,
-	-181, 54, 136, 67, -179, 57, 56, -166, 132, 29,
-	131, -74, -159, -159, -159, -159, 57, 57, -198, 56,
-	83, -101, -60, -92, 14, 16, -199, -199, -199, -199,
-	-33, 93, 279, -199, -199, 29, -83, 35, -2, -198,
-	-130, -130, 57, -199, -199, -199, -64, 57, -181, 58,
-	-173, 83, 60, 143, -130, -155, 67, 29, 29, -184,
-	-185, 154, -187, -177, 57, -39, -80, -199, 277, 50,
-	280, 9, -81, -2, 113, 61, -60, 60, -199, 56,
-	-130, -191, 40, 278, 281, -105, -199, -130, 55, -185,
-	35, -189, 40, -101, 156, 279, 57, 157, 280, -194,
-	-195, 53, -198, 281, -195, 53, 10, 9, -71, 153,
-	-193, 144, 139, 142, 31, -193, -199, -199, 138, 30,
-	69,
+	-71, -71, -71, -144, 60, 62, 60, -70, -70, -131,
+	-37, 23, -36, -38, -200, 58, -200, -2, -36, -36,
+	-39, -39, -86, 62, -36, -86, 62, -36, -36, -30,
+	-87, -88, 81, -86, -131, -137, -200, -71, -131, -131,
+	-36, -37, -36, -36, -102, 158, -60, 32, 58, -56,
+	-58, -57, -59, 44, 48, 50, 45, 46, 47, 51,
+	-141, 24, -41, -199, -199, -140, 158, -139, 24, -137,
+	62, -102, 55, -41, -60, -41, -62, -137, 103, -111,
+	-108, 58, 253, 255, 256, 54, 74, -39, -160, 111,
+	-45, 246, -169, -170, -171, -179, 144, -184, 136, 138,
+	135, -172, 145, 130, 30, 59, -165, 71, 77, 228,
+	-161, 234, -155, 57, -155, -155, -155, -155, -159, 195,
+	-159, -159, -159, 57, 57, 57, 57, -155, -155, -155,
+	-163, 57, -163, -163, -164, 57, -164, -164, -164, -135,
+	55, -60, -46, 246, 25, -143, -60, -125, 124, 121,
+	122, -187, 120, 231, 195, 69, 31, 15, 271, 158,
+	285, 60, 159, -60, -60, -60, -60, -60, 124, 121,
+	-60, -60, -60, -143, -60, -121, 93, 77, 12, -137,
+	-137, 62, 93, -60, -99, 20, -106, 40, -39, -39,
+	-138, -95, -98, -114, 19, 11, 36, 36, -36, 71,
+	72, 73, 116, -199, -79, -71, -71, -71, -71, -35,
+	153, -35, 76, -200, -200, -36, 58, -39, -200, -200,
+	-200, 58, 55, 24, 11, 11, -200, 11, 11, -200,
+	-200, -36, -90, -88, 83, -39, -200, 116, -200, 58,
+	58, -200, -200, -200, -200, -99, 32, -199, -105, -110,
+	-86, -42, -43, -43, -42, -43, 44, 44, 44, 49,
+	44, 49, 44, -57, -137, -200, -52, -51, -50, -53,
+	-39, 127, 129, -64, 52, 133, 53, -199, -139, -65,
+	12, -41, -65, -65, 116, -112, -113, 257, 254, 260,
+	60, 62, 85, 57, 60, 30, -172, -172, -173, 60,
+	-173, 30, -157, 31, 71, -162, 235, 63, -159, -159,
+	-160, 32, -160, -160, -160, -168, 62, -168, -168, -168,
+	63, 63, 54, -131, -143, 85, -142, -193, 141, 137,
+	144, 145, 139, 60, 130, 30, 136, 138, 158, 135,
+	-193, -126, -127, 132, 24, 130, 30, 158, -192, 55,
+	164, 231, 164, 132, -143, -118, -97, 62, 93, -39,
+	62, -131, 41, 116, -60, -40, 11, 103, -132, -37,
+	-35, -35, 76, -71, -71, -200, -38, -147, 112, 192,
+	152, 190, 186, 206, 197, 233, 188, 234, -144, -147,
+	-71, -71, -71, -71, 56, -93, 84, -39, 82, -132,
+	-71, -71, -69, 36, -2, -199, -105, -103, -131, -65,
+	58, 85, -48, -47, 54, 55, -49, 54, -47, 44,
+	44, -200, 58, -199, -199, 130, 130, 130, -103, -93,
+	-39, -65, 254, 258, 259, -178, -132, 62, 63, -181,
+	-180, -131, -184, -173, -173, 57, -158, 54, -71, 59,
+	-160, -160, 60, 112, 59, 58, 59, 59, 59, 58,
+	59, 58, -60, -178, -142, -142, -60, -142, -131, -190,
+	282, -191, 60, -131, -131, -131, -60, -121, 62, -65,
+	-41, -200, -71, -200, -155, -155, -155, -164, -155, 180,
+	-155, 180, -200, -200, 19, 19, 19, 19, -199, -34,
+	276, -39, 58, 58, -104, 54, -81, -83, -82, -199,
+	-2, -100, -131, -104, -200, 58, -93, -110, -39, -39,
+	57, -39, -141, -50, -42, -86, -199, -199, -199, -200,
+	-97, 57, 59, 58, -155, -101, -131, -166, 231, 9,
+	-159, 62, -159, -159, -159, 63, 63, -143, 28, -189,
+	-188, -132, 57, 55, -91, 13, -159, 60, -71, -71,
+	-71, -71, -71, -200, 62, -71, -71, 29, 58, -200,
+	-200, -200, 58, 116, -131, -97, -101, -137, -200, -200,
+	-101, -101, -101, -140, -178, -183, -182, 55, 140, 69,
+	-180, 59, 58, -167, 136, 30, 135, -74, -160, -160,
+	-160, -160, 59, 59, -199, 58, 85, -101, -60, -92,
+	14, 16, -200, -200, -200, -200, -33, 96, 282, -200,
+	-200, 30, -83, 36, -2, -199, -131, -131, 59, -200,
+	-200, -200, -64, 59, -182, 60, -174, 85, 62, 147,
+	-131, -156, 69, 30, 30, -185, -186, 158, -188, -178,
+	59, -39, -80, -200, 280, 51, 283, 9, -81, -2,
+	116, 63, -60, 62, -200, 58, -131, -192, 41, 281,
+	284, -105, -200, -131, 57, -186, 36, -190, 41, -101,
+	160, 282, 59, 161, 283, -195, -196, 54, -199, 284,
+	-196, 54, 10, 9, -71, 157, -194, 148, 143, 146,
+	32, -194, -200, -200, 142, 31, 71,
 }
-var yyDef = [...]int{
 
+var yyDef = [...]int16{
 	23, -2, 2, -2, 5, 6, 7, 8, 9, 10,
 	11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
-	21, 22, 586, 0, 333, 333, 333, 333, 333, 333,
-	0, 659, 642, 0, 0, 0, 0, -2, 320, 321,
-	0, 323, 324, 961, 961, 961, 961, 961, 0, 0,
-	961, 0, 35, 36, 959, 1, 3, 594, 0, 0,
-	337, 340, 335, 0, 642, 642, 0, 0, 65, 66,
-	0, 0, 0, 948, 0, 640, 640, 640, 660, 661,
-	664, 665, 790, 791, 792, 793, 794, 795, 796, 797,
-	798, 799, 800, 801, 802, 803, 804, 805, 806, 807,
-	808, 809, 810, 811, 812, 813, 814, 815, 816, 817,
-	818, 819, 820, 821, 822, 823, 824, 825, 826, 827,
-	828, 829, 830, 831, 832, 833, 834, 835, 836, 837,
-	838, 839, 840, 841, 842, 843, 844, 845, 846, 847,
-	848, 849, 850, 851, 852, 853, 854, 855, 856, 857,
-	858, 859, 860, 861, 862, 863, 864, 865, 866, 867,
-	868, 869, 870, 871, 872, 873, 874, 875, 876, 877,
-	878, 879, 880, 881, 882, 883, 884, 885, 886, 887,
-	888, 889, 890, 891, 892, 893, 894, 895, 896, 897,
-	898, 899, 900, 901, 902, 903, 904, 905, 906, 907,
-	908, 909, 910, 911, 912, 913, 914, 915, 916, 917,
-	918, 919, 920, 921, 922, 923, 924, 925, 926, 927,
-	928, 929, 930, 931, 932, 933, 934, 935, 936, 937,
-	938, 939, 940, 941, 942, 943, 944, 945, 946, 947,
-	949, 950, 951, 952, 953, 954, 955, 956, 957, 958,
-	0, 0, 0, 643, 0, 638, 0, 638, 638, 638,
-	0, 270, 415, 668, 669, 948, 0, 0, 0, 962,
-	0, 962, 282, 962, 962, 285, 962, 0, 962, 0,
-	292, 0, 0, 298, 962, 317, 318, 303, 319, 322,
-	325, 326, 327, 328, 329, 961, 961, 332, 29, 598,
-	0, 0, 586, 31, 0, 333, 338, 339, 343, 341,
-	342, 334, 0, 351, 355, 0, 424, 0, 429, 431,
-	-2, -2, 0, 466, 467, 468, 469, 470, 0, 0,
-	0, 0, 0, 0, 0, 0, 494, 495, 496, 497,
-	571, 572, 573, 574, 575, 576, 577, 578, 433, 434,
-	568, 620, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 559, 0, 530, 530, 530, 530, 530, 530, 530,
-	530, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 44, 46, 415, 50, 0, 937, 624, -2,
-	-2, 0, 0, 666, 667, -2, 803, -2, 672, 673,
-	674, 675, 676, 677, 678, 679, 680, 681, 682, 683,
-	684, 685, 686, 687, 688, 689, 690, 691, 692, 693,
-	694, 695, 696, 697, 698, 699, 700, 701, 702, 703,
-	704, 705, 706, 707, 708, 709, 710, 711, 712, 713,
-	714, 715, 716, 717, 718, 719, 720, 721, 722, 723,
-	724, 725, 726, 727, 728, 729, 730, 731, 732, 733,
-	734, 735, 736, 737, 738, 739, 740, 741, 742, 743,
-	744, 745, 746, 747, 748, 749, 750, 751, 752, 753,
-	754, 755, 756, 757, 758, 759, 760, 761, 762, 763,
-	764, 765, 766, 767, 768, 769, 770, 771, 772, 773,
-	774, 775, 776, 777, 778, 779, 780, 781, 782, 783,
-	784, 785, 786, 787, 788, 789, 0, 0, 84, 0,
-	82, 0, 962, 0, 0, 0, 0, 0, 0, 962,
-	0, 0, 0, 0, 261, 0, 0, 0, 0, 0,
-	0, 0, 269, 0, 271, 962, 962, 274, 963, 964,
-	962, 962, 962, 0, 962, 962, 281, 283, 284, 286,
-	962, 962, 288, 0, 306, 304, 305, 300, 301, 0,
-	313, 295, 296, 299, 330, 331, 30, 960, 601, 0,
-	0, 595, 0, 587, 588, 591, 594, 29, 340, 0,
-	345, 344, 336, 0, 352, 0, 0, 0, 356, 0,
-	358, 359, 0, 427, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 451, 452, 453, 454, 455, 456, 457,
-	430, 0, 444, 0, 0, 0, 486, 487, 488, 489,
-	490, 491, 492, 0, 347, 29, 0, 464, 0, 0,
-	0, 0, 0, 0, 0, 0, 343, 0, 560, 0,
-	514, 522, 0, 515, 523, 516, 524, 517, 0, 518,
-	525, 519, 526, 520, 521, 527, 0, 0, 0, 347,
-	0, 0, 48, 0, 414, 0, 362, 364, 365, 366,
-	-2, 0, 668, 398, -2, 0, 0, 0, 42, 43,
-	0, 0, 0, 0, 51, 937, 53, 54, 0, 0,
-	0, 177, 633, 634, 635, 631, 221, 0, 0, 164,
-	160, 90, 91, 92, 153, 94, 153, 153, 153, 153,
-	174, 174, 174, 174, 132, 133, 134, 135, 136, 0,
-	0, 0, 0, 141, 142, 119, 153, 153, 153, 123,
-	143, 144, 145, 146, 147, 148, 149, 150, 95, 96,
-	97, 98, 99, 100, 101, 102, 103, 104, 105, 106,
-	107, 108, 109, 110, 111, 155, 155, 155, 157, 157,
-	157, 157, 662, 68, 0, 224, 0, 962, 80, 0,
-	234, 0, 0, 0, 0, 0, 0, 0, 0, 264,
-	639, 0, 962, 267, 268, 416, 670, 671, 272, 273,
-	275, 276, 277, 278, 279, 280, 287, 291, 0, 309,
-	0, 0, 293, 294, 0, 0, 0, 24, 0, 599,
-	0, 0, 0, 0, 0, 590, 592, 593, 598, 32,
-	343, 0, 579, 0, 0, 0, 346, 27, 425, 426,
-	428, 445, 0, 447, 449, 357, 353, 0, 569, -2,
-	435, 436, 460, 461, 462, 0, 0, 0, 0, 458,
-	440, 0, 471, 472, 473, 474, 475, 476, 477, 478,
-	479, 480, 481, 482, 485, 544, 545, 0, 483, 484,
-	493, 0, 0, 348, 349, 463, 0, 619, 29, 0,
-	0, 0, 0, 468, 571, 0, 468, 571, 0, 0,
-	0, 566, 563, 0, 0, 568, 0, 531, 0, 0,
-	0, 0, 0, 0, 0, 601, 0, 413, 0, 0,
-	0, 0, 0, 0, 403, 0, 0, 406, 0, 0,
-	0, 0, 397, 0, 0, 374, 418, 882, 399, 0,
-	401, 402, 422, 0, 422, 45, 422, 47, 0, 417,
-	625, 52, 0, 0, 57, 58, 626, 627, 628, 629,
-	0, 81, 0, 85, 86, 87, 0, 0, 209, 0,
-	0, 203, 203, 0, 201, 202, 83, 168, 165, 0,
-	167, 162, 161, 93, 0, 174, 174, 126, 127, 177,
-	0, 177, 177, 177, 0, 0, 0, 0, 120, 121,
-	122, 112, 0, 113, 114, 115, 0, 116, 117, 118,
-	0, 0, 962, 70, 0, 641, 71, 961, 0, 0,
-	654, 235, 644, 645, 646, 647, 648, 649, 650, 651,
-	652, 653, 0, 72, 237, 239, 238, 242, 0, 0,
-	0, 262, 962, 266, 306, 594, 0, 0, 0, 307,
-	308, 314, 0, 297, 602, 0, 596, 597, 0, 589,
-	25, 0, 636, 637, 580, 581, 360, 446, 448, 450,
-	0, 347, 437, 458, 441, 0, 438, 0, 0, 432,
-	498, 0, 0, 465, -2, 501, 502, 0, 0, 0,
-	0, 0, 537, 0, 0, 538, 0, 586, 0, 564,
-	0, 0, 513, 0, 532, 0, 0, 533, 534, 535,
-	536, 0, 0, 0, 422, 621, 0, 363, 392, 394,
-	0, 389, 404, 405, 407, 0, 409, 0, 411, 412,
-	367, 369, 0, 375, 376, 378, 379, 0, 0, 372,
-	0, 0, 0, 0, 400, 586, 0, 422, 40, 41,
-	0, 55, 56, 0, 0, 62, 178, 179, 0, 0,
-	0, 196, 203, 203, 199, 204, 200, 0, 170, 0,
-	166, 89, 163, 0, 177, 177, 128, 0, 129, 130,
-	131, 0, 151, 0, 0, 0, 0, 0, 0, 663,
-	69, 0, 229, 961, 244, 245, 246, 247, 248, 249,
-	250, 251, 252, 253, 254, 255, 961, 0, 961, 655,
-	656, 657, 658, 0, 75, 0, 0, 0, 0, 0,
-	265, 309, 290, 310, 0, 312, 315, 600, 0, 26,
-	422, 0, 354, 570, 0, 439, 0, 459, 442, 499,
-	350, 0, 153, 153, 549, 153, 157, 552, 153, 554,
-	153, 557, 0, 0, 0, 0, 0, 0, 0, 561,
-	512, 567, 0, 569, 0, 0, 613, 0, -2, 0,
-	613, 0, 384, 586, 0, 0, 386, 393, 0, 0,
-	387, 0, 388, 408, 410, 396, 0, 0, 0, 0,
-	0, 0, 0, 594, 423, 39, 59, 60, 61, 222,
-	226, 227, 228, 0, 205, 153, 208, 197, 198, 0,
-	172, 0, 169, 154, 124, 125, 175, 176, 174, 0,
-	174, 174, 174, 0, 158, 0, 962, 225, 230, 231,
-	232, 233, 0, 236, 0, 73, 74, 0, 0, 241,
-	263, 289, 311, 582, 361, 500, 443, 503, 546, 174,
-	550, 551, 553, 555, 556, 558, 505, 504, 0, 0,
-	0, 0, 0, 0, 0, 565, 0, 0, 33, 0,
-	603, 615, 617, 0, 29, 0, 609, 34, 49, 0,
-	594, 622, 623, 390, 0, 395, 370, 377, 0, 0,
-	0, 0, 0, 398, 38, 0, 188, 0, 207, 0,
-	382, 180, 173, 0, 177, 152, 177, 177, 177, 0,
-	0, 67, 0, 76, 77, 0, 0, 0, 584, 0,
-	547, 548, 0, 0, 0, 0, 539, 511, 562, 0,
-	0, 0, 0, 618, -2, 0, 0, 0, 385, 37,
-	0, 371, 380, 381, 0, 0, 0, 418, 0, 187,
-	189, 0, 194, 0, 206, 0, 0, 185, 0, 182,
-	184, 171, 137, 138, 139, 140, 156, 159, 0, 0,
-	0, 0, 243, 28, 0, 0, 506, 508, 507, 509,
-	0, 0, 0, 528, 529, 0, 616, 0, -2, 0,
-	611, 610, 391, 419, 420, 421, 373, 223, 190, 191,
-	0, 195, 193, 0, 383, 88, 0, 181, 183, 0,
-	257, 0, 78, 79, 72, 585, 583, 510, 0, 0,
-	0, 0, 606, 29, 0, 192, 0, 186, 256, 0,
-	0, 75, 540, 0, 543, 614, -2, 612, 0, 258,
-	0, 240, 541, 0, 0, 0, 210, 0, 0, 211,
-	212, 0, 0, 542, 213, 0, 0, 0, 0, 0,
-	214, 216, 217, 0, 0, 215, 259, 260, 218, 219,
-	220,
+	21, 22, 590, 0, 334, 334, 334, 334, 334, 334,
+	0, 665, 648, 0, 0, 0, 0, -2, 321, 322,
+	0, 324, 325, 970, 970, 970, 970, 970, 0, 0,
+	970, 0, 35, 36, 968, 1, 3, 598, 0, 0,
+	338, 341, 336, 0, 648, 648, 0, 0, 65, 66,
+	0, 0, 0, 957, 0, 646, 646, 646, 936, 666,
+	667, 670, 671, 798, 799, 800, 801, 802, 803, 804,
+	805, 806, 807, 808, 809, 810, 811, 812, 813, 814,
+	815, 816, 817, 818, 819, 820, 821, 822, 823, 824,
+	825, 826, 827, 828, 829, 830, 831, 832, 833, 834,
+	835, 836, 837, 838, 839, 840, 841, 842, 843, 844,
+	845, 846, 847, 848, 849, 850, 851, 852, 853, 854,
+	855, 856, 857, 858, 859, 860, 861, 862, 863, 864,
+	865, 866, 867, 868, 869, 870, 871, 872, 873, 874,
+	875, 876, 877, 878, 879, 880, 881, 882, 883, 884,
+	885, 886, 887, 888, 889, 890, 891, 892, 893, 894,
+	895, 896, 897, 898, 899, 900, 901, 902, 903, 904,
+	905, 906, 907, 908, 909, 910, 911, 912, 913, 914,
+	915, 916, 917, 918, 919, 920, 921, 922, 923, 924,
+	925, 926, 927, 928, 929, 930, 931, 932, 933, 934,
+	935, 937, 938, 939, 940, 941, 942, 943, 944, 945,
+	946, 947, 948, 949, 950, 951, 952, 953, 954, 955,
+	956, 958, 959, 960, 961, 962, 963, 964, 965, 966,
+	967, 0, 0, 0, 649, 0, 644, 0, 644, 644,
+	644, 0, 271, 416, 674, 675, 936, 957, 0, 0,
+	0, 971, 0, 971, 283, 971, 971, 286, 971, 0,
+	971, 0, 293, 0, 0, 299, 971, 318, 319, 304,
+	320, 323, 326, 327, 328, 329, 330, 970, 970, 333,
+	29, 602, 0, 0, 590, 31, 0, 334, 339, 340,
+	344, 342, 343, 335, 0, 352, 356, 0, 425, 0,
+	430, 432, -2, -2, 0, 469, 470, 471, 472, 473,
+	0, 0, 0, 0, 0, 0, 0, 0, 497, 498,
+	499, 500, 575, 576, 577, 578, 579, 580, 581, 582,
+	434, 435, 572, 626, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 563, 0, 534, 534, 534, 534, 534,
+	534, 534, 534, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 44, 46, 416, 50, 0, 946,
+	630, -2, -2, 0, 0, 672, 673, -2, 811, -2,
+	678, 679, 680, 681, 682, 683, 684, 685, 686, 687,
+	688, 689, 690, 691, 692, 693, 694, 695, 696, 697,
+	698, 699, 700, 701, 702, 703, 704, 705, 706, 707,
+	708, 709, 710, 711, 712, 713, 714, 715, 716, 717,
+	718, 719, 720, 721, 722, 723, 724, 725, 726, 727,
+	728, 729, 730, 731, 732, 733, 734, 735, 736, 737,
+	738, 739, 740, 741, 742, 743, 744, 745, 746, 747,
+	748, 749, 750, 751, 752, 753, 754, 755, 756, 757,
+	758, 759, 760, 761, 762, 763, 764, 765, 766, 767,
+	768, 769, 770, 771, 772, 773, 774, 775, 776, 777,
+	778, 779, 780, 781, 782, 783, 784, 785, 786, 787,
+	788, 789, 790, 791, 792, 793, 794, 795, 796, 797,
+	0, 0, 85, 0, 83, 0, 971, 0, 0, 0,
+	0, 0, 646, 0, 971, 0, 0, 0, 0, 262,
+	0, 0, 0, 0, 0, 0, 0, 270, 0, 272,
+	971, 971, 275, 972, 973, 971, 971, 971, 0, 971,
+	971, 282, 284, 285, 287, 971, 971, 289, 0, 307,
+	305, 306, 301, 302, 0, 314, 296, 297, 300, 331,
+	332, 30, 969, 605, 0, 0, 599, 0, 591, 592,
+	595, 598, 29, 341, 0, 346, 345, 337, 0, 353,
+	0, 0, 0, 357, 0, 359, 360, 0, 428, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 454,
+	455, 456, 457, 458, 459, 460, 431, 0, 447, 0,
+	0, 0, 489, 490, 491, 492, 493, 494, 495, 0,
+	348, 29, 0, 467, 0, 0, 0, 0, 0, 0,
+	0, 0, 344, 0, 564, 0, 518, 526, 0, 519,
+	527, 520, 528, 521, 0, 522, 529, 523, 530, 524,
+	525, 531, 0, 0, 0, 348, 0, 0, 48, 0,
+	415, 0, 363, 365, 366, 367, -2, 0, 674, 399,
+	-2, 0, 0, 0, 42, 43, 0, 0, 0, 0,
+	51, 946, 53, 54, 0, 0, 0, 178, 639, 640,
+	641, 637, 222, 0, 0, 165, 161, 91, 92, 93,
+	154, 95, 154, 154, 154, 154, 175, 175, 175, 175,
+	133, 134, 135, 136, 137, 0, 0, 0, 0, 142,
+	143, 120, 154, 154, 154, 124, 144, 145, 146, 147,
+	148, 149, 150, 151, 96, 97, 98, 99, 100, 101,
+	102, 103, 104, 105, 106, 107, 108, 109, 110, 111,
+	112, 156, 156, 156, 158, 158, 158, 158, 668, 68,
+	0, 225, 0, 971, 80, 0, 0, 235, 0, 0,
+	0, 0, 0, 0, 0, 0, 265, 645, 0, 971,
+	268, 269, 417, 676, 677, 273, 274, 276, 277, 278,
+	279, 280, 281, 288, 292, 0, 310, 0, 0, 294,
+	295, 0, 0, 0, 607, 0, 603, 0, 0, 0,
+	0, 0, 594, 596, 597, 602, 32, 344, 0, 583,
+	0, 0, 0, 347, 27, 426, 427, 429, 448, 0,
+	450, 452, 358, 354, 0, 573, -2, 436, 437, 463,
+	464, 465, 0, 0, 0, 0, 0, 461, 461, 443,
+	0, 474, 475, 476, 477, 478, 479, 480, 481, 482,
+	483, 484, 485, 488, 548, 549, 0, 486, 487, 496,
+	0, 0, 349, 350, 466, 0, 625, 29, 0, 0,
+	0, 0, 471, 575, 0, 471, 575, 0, 0, 0,
+	570, 567, 0, 0, 572, 0, 535, 0, 0, 0,
+	0, 0, 0, 0, 607, 0, 414, 0, 0, 0,
+	0, 0, 0, 404, 0, 0, 407, 0, 0, 0,
+	0, 398, 0, 0, 375, 419, 890, 400, 0, 402,
+	403, 423, 0, 423, 45, 423, 47, 0, 418, 631,
+	52, 0, 0, 57, 58, 632, 633, 634, 635, 0,
+	82, 0, 86, 87, 88, 0, 0, 210, 0, 0,
+	204, 204, 0, 202, 203, 84, 169, 166, 0, 168,
+	163, 162, 94, 0, 175, 175, 127, 128, 178, 0,
+	178, 178, 178, 0, 0, 0, 0, 121, 122, 123,
+	113, 0, 114, 115, 116, 0, 117, 118, 119, 0,
+	0, 971, 70, 0, 647, 71, 81, 970, 0, 0,
+	660, 236, 650, 651, 652, 653, 654, 655, 656, 657,
+	658, 659, 0, 72, 238, 240, 239, 243, 0, 0,
+	0, 263, 971, 267, 307, 598, 0, 0, 0, 308,
+	309, 315, 0, 298, 24, 0, 606, 0, 600, 601,
+	0, 593, 25, 0, 642, 643, 584, 585, 361, 449,
+	451, 453, 0, 348, 438, 461, 461, 444, 0, 439,
+	0, 441, 0, 433, 501, 0, 0, 468, -2, 504,
+	505, 0, 0, 0, 0, 0, 541, 0, 0, 542,
+	514, 590, 0, 568, 0, 0, 517, 0, 536, 0,
+	0, 537, 538, 539, 540, 0, 0, 0, 423, 627,
+	0, 364, 393, 395, 0, 390, 405, 406, 408, 0,
+	410, 0, 412, 413, 368, 370, 0, 376, 377, 379,
+	380, 0, 0, 373, 0, 0, 0, 0, 401, 590,
+	0, 423, 40, 41, 0, 55, 56, 0, 0, 62,
+	179, 180, 0, 0, 0, 197, 204, 204, 200, 205,
+	201, 0, 171, 0, 167, 90, 164, 0, 178, 178,
+	129, 0, 130, 131, 132, 0, 152, 0, 0, 0,
+	0, 0, 0, 669, 69, 0, 230, 970, 245, 246,
+	247, 248, 249, 250, 251, 252, 253, 254, 255, 256,
+	970, 0, 970, 661, 662, 663, 664, 0, 75, 0,
+	0, 0, 0, 0, 266, 310, 291, 311, 0, 313,
+	316, 608, 604, 0, 26, 423, 0, 355, 574, 0,
+	440, 442, 0, 462, 445, 502, 351, 0, 154, 154,
+	553, 154, 158, 556, 154, 558, 154, 561, 0, 0,
+	0, 0, 0, 0, 0, 565, 516, 571, 0, 573,
+	0, 0, 619, 0, -2, 0, 619, 0, 385, 590,
+	0, 0, 387, 394, 0, 0, 388, 0, 389, 409,
+	411, 397, 0, 0, 0, 0, 0, 0, 0, 598,
+	424, 39, 59, 60, 61, 223, 227, 228, 229, 0,
+	206, 154, 209, 198, 199, 0, 173, 0, 170, 155,
+	125, 126, 176, 177, 175, 0, 175, 175, 175, 0,
+	159, 0, 971, 226, 231, 232, 233, 234, 0, 237,
+	0, 73, 74, 0, 0, 242, 264, 290, 312, 586,
+	362, 503, 446, 506, 550, 175, 554, 555, 557, 559,
+	560, 562, 508, 507, 0, 0, 0, 0, 0, 0,
+	0, 569, 0, 0, 33, 0, 609, 621, 623, 0,
+	29, 0, 615, 34, 49, 0, 598, 628, 629, 391,
+	0, 396, 371, 378, 0, 0, 0, 0, 0, 399,
+	38, 0, 189, 0, 208, 0, 383, 181, 174, 0,
+	178, 153, 178, 178, 178, 0, 0, 67, 0, 76,
+	77, 0, 0, 0, 588, 0, 551, 552, 0, 0,
+	0, 0, 543, 515, 566, 0, 0, 0, 0, 624,
+	-2, 0, 0, 0, 386, 37, 0, 372, 381, 382,
+	0, 0, 0, 419, 0, 188, 190, 0, 195, 0,
+	207, 0, 0, 186, 0, 183, 185, 172, 138, 139,
+	140, 141, 157, 160, 0, 0, 0, 0, 244, 28,
+	0, 0, 509, 511, 510, 512, 0, 0, 0, 532,
+	533, 0, 622, 0, -2, 0, 617, 616, 392, 420,
+	421, 422, 374, 224, 191, 192, 0, 196, 194, 0,
+	384, 89, 0, 182, 184, 0, 258, 0, 78, 79,
+	72, 589, 587, 513, 0, 0, 0, 0, 612, 29,
+	0, 193, 0, 187, 257, 0, 0, 75, 544, 0,
+	547, 620, -2, 618, 0, 259, 0, 241, 545, 0,
+	0, 0, 211, 0, 0, 212, 213, 0, 0, 546,
+	214, 0, 0, 0, 0, 0, 215, 217, 218, 0,
+	0, 216, 260, 261, 219, 220, 221,
 }
-var yyTok1 = [...]int{
 
+var yyTok1 = [...]int16{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 76, 3, 3, 3, 103, 95, 3,
-	55, 57, 100, 98, 56, 99, 113, 101, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 364,
-	84, 83, 85, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 78, 3, 3, 3, 106, 98, 3,
+	57, 59, 103, 101, 58, 102, 116, 104, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 367,
+	86, 85, 87, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 105, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 108, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 94, 3, 106,
+	3, 3, 3, 3, 97, 3, 109,
 }
-var yyTok2 = [...]int{
 
+var yyTok2 = [...]int16{
 	2, 3, 4, 5, 6, 7, 8, 9, 10, 11,
 	12, 13, 14, 15, 16, 17, 18, 19, 20, 21,
 	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
 	32, 33, 34, 35, 36, 37, 38, 39, 40, 41,
 	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
-	52, 53, 54, 58, 59, 60, 61, 62, 63, 64,
+	52, 53, 54, 55, 56, 60, 61, 62, 63, 64,
 	65, 66, 67, 68, 69, 70, 71, 72, 73, 74,
-	75, 77, 78, 79, 80, 81, 82, 86, 87, 88,
-	89, 90, 91, 92, 93, 96, 97, 102, 104, 107,
-	108, 109, 110, 111, 112, 114, 115, 116, 117, 118,
+	75, 76, 77, 79, 80, 81, 82, 83, 84, 88,
+	89, 90, 91, 92, 93, 94, 95, 96, 99, 100,
+	105, 107, 110, 111, 112, 113, 114, 115, 117, 118,
 	119, 120, 121, 122, 123, 124, 125, 126, 127, 128,
 	129, 130, 131, 132, 133, 134, 135, 136, 137, 138,
 	139, 140, 141, 142, 143, 144, 145, 146, 147, 148,
@@ -3411,7 +3420,8 @@ var yyTok2 = [...]int{
 	259, 260, 261, 262, 263, 264, 265, 266, 267, 268,
 	269, 270, 271, 272, 273, 274,
 }
-var yyTok3 = [...]int{
+
+var yyTok3 = [...]uint16{
 	57600, 275, 57601, 276, 57602, 277, 57603, 278, 57604, 279,
 	57605, 280, 57606, 281, 57607, 282, 57608, 283, 57609, 284,
 	57610, 285, 57611, 286, 57612, 287, 57613, 288, 57614, 289,
@@ -3429,7 +3439,8 @@ var yyTok3 = [...]int{
 	57670, 345, 57671, 346, 57672, 347, 57673, 348, 57674, 349,
 	57675, 350, 57676, 351, 57677, 352, 57678, 353, 57679, 354,
 	57680, 355, 57681, 356, 57682, 357, 57683, 358, 57684, 359,
-	57685, 360, 57686, 361, 57687, 362, 57688, 363, 0,
+	57685, 360, 57686, 361, 57687, 362, 57688, 363, 57689, 364,
+	57690, 365, 57691, 366, 0,
 }
 
 var yyErrorMessages = [...]struct {
@@ -3510,9 +3521,9 @@ func yyErrorMessage(state, lookAhead int) string {
 	expected := make([]int, 0, 4)
 
 	// Look for shiftable tokens.
-	base := yyPact[state]
+	base := int(yyPact[state])
 	for tok := TOKSTART; tok-1 < len(yyToknames); tok++ {
-		if n := base + tok; n >= 0 && n < yyLast && yyChk[yyAct[n]] == tok {
+		if n := base + tok; n >= 0 && n < yyLast && int(yyChk[int(yyAct[n])]) == tok {
 			if len(expected) == cap(expected) {
 				return res
 			}
@@ -3522,13 +3533,13 @@ func yyErrorMessage(state, lookAhead int) string {
 
 	if yyDef[state] == -2 {
 		i := 0
-		for yyExca[i] != -1 || yyExca[i+1] != state {
+		for yyExca[i] != -1 || int(yyExca[i+1]) != state {
 			i += 2
 		}
 
 		// Look for tokens that we accept or reduce.
 		for i += 2; yyExca[i] >= 0; i += 2 {
-			tok := yyExca[i]
+			tok := int(yyExca[i])
 			if tok < TOKSTART || yyExca[i+1] == 0 {
 				continue
 			}
@@ -3559,30 +3570,30 @@ func yylex1(lex yyLexer, lval *yySymType) (char, token int) {
 	token = 0
 	char = lex.Lex(lval)
 	if char <= 0 {
-		token = yyTok1[0]
+		token = int(yyTok1[0])
 		goto out
 	}
 	if char < len(yyTok1) {
-		token = yyTok1[char]
+		token = int(yyTok1[char])
 		goto out
 	}
 	if char >= yyPrivate {
 		if char < yyPrivate+len(yyTok2) {
-			token = yyTok2[char-yyPrivate]
+			token = int(yyTok2[char-yyPrivate])
 			goto out
 		}
 	}
 	for i := 0; i < len(yyTok3); i += 2 {
-		token = yyTok3[i+0]
+		token = int(yyTok3[i+0])
 		if token == char {
-			token = yyTok3[i+1]
+			token = int(yyTok3[i+1])
 			goto out
 		}
 	}
 
 out:
 	if token == 0 {
-		token = yyTok2[1] /* unknown char */
+		token = int(yyTok2[1]) /* unknown char */
 	}
 	if yyDebug >= 3 {
 		__yyfmt__.Printf("lex %s(%d)\n", yyTokname(token), uint(char))
@@ -3637,7 +3648,7 @@ yystack:
 	yyS[yyp].yys = yystate
 
 yynewstate:
-	yyn = yyPact[yystate]
+	yyn = int(yyPact[yystate])
 	if yyn <= yyFlag {
 		goto yydefault /* simple state */
 	}
@@ -3648,8 +3659,8 @@ yynewstate:
 	if yyn < 0 || yyn >= yyLast {
 		goto yydefault
 	}
-	yyn = yyAct[yyn]
-	if yyChk[yyn] == yytoken { /* valid shift */
+	yyn = int(yyAct[yyn])
+	if int(yyChk[yyn]) == yytoken { /* valid shift */
 		yyrcvr.char = -1
 		yytoken = -1
 		yyVAL = yyrcvr.lval
@@ -3662,7 +3673,7 @@ yynewstate:
 
 yydefault:
 	/* default state action */
-	yyn = yyDef[yystate]
+	yyn = int(yyDef[yystate])
 	if yyn == -2 {
 		if yyrcvr.char < 0 {
 			yyrcvr.char, yytoken = yylex1(yylex, &yyrcvr.lval)
@@ -3671,18 +3682,18 @@ yydefault:
 		/* look through exception table */
 		xi := 0
 		for {
-			if yyExca[xi+0] == -1 && yyExca[xi+1] == yystate {
+			if yyExca[xi+0] == -1 && int(yyExca[xi+1]) == yystate {
 				break
 			}
 			xi += 2
 		}
 		for xi += 2; ; xi += 2 {
-			yyn = yyExca[xi+0]
+			yyn = int(yyExca[xi+0])
 			if yyn < 0 || yyn == yytoken {
 				break
 			}
 		}
-		yyn = yyExca[xi+1]
+		yyn = int(yyExca[xi+1])
 		if yyn < 0 {
 			goto ret0
 		}
@@ -3704,10 +3715,10 @@ yydefault:
 
 			/* find a state where "error" is a legal shift action */
 			for yyp >= 0 {
-				yyn = yyPact[yyS[yyp].yys] + yyErrCode
+				yyn = int(yyPact[yyS[yyp].yys]) + yyErrCode
 				if yyn >= 0 && yyn < yyLast {
-					yystate = yyAct[yyn] /* simulate a shift of "error" */
-					if yyChk[yystate] == yyErrCode {
+					yystate = int(yyAct[yyn]) /* simulate a shift of "error" */
+					if int(yyChk[yystate]) == yyErrCode {
 						goto yystack
 					}
 				}
@@ -3743,7 +3754,7 @@ yydefault:
 	yypt := yyp
 	_ = yypt // guard against "declared and not used"
 
-	yyp -= yyR2[yyn]
+	yyp -= int(yyR2[yyn])
 	// yyp is now the index of $0. Perform the default action. Iff the
 	// reduced production is ε, $1 is possibly out of range.
 	if yyp+1 >= len(yyS) {
@@ -3754,16 +3765,16 @@ yydefault:
 	yyVAL = yyS[yyp+1]
 
 	/* consult goto table to find next state */
-	yyn = yyR1[yyn]
-	yyg := yyPgo[yyn]
+	yyn = int(yyR1[yyn])
+	yyg := int(yyPgo[yyn])
 	yyj := yyg + yyS[yyp].yys + 1
 
 	if yyj >= yyLast {
-		yystate = yyAct[yyg]
+		yystate = int(yyAct[yyg])
 	} else {
-		yystate = yyAct[yyj]
-		if yyChk[yystate] != -yyn {
-			yystate = yyAct[yyg]
+		yystate = int(yyAct[yyj])
+		if int(yyChk[yystate]) != -yyn {
+			yystate = int(yyAct[yyg])
 		}
 	}
 	// dummy call; replaced with literal code
@@ -3771,94 +3782,95 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1076
+//line sql.y:1082
 		{
 			setParseTree(yylex, yyDollar[1].statement)
 		}
 	case 2:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1082
+//line sql.y:1088
 		{
 		}
 	case 3:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1084
+//line sql.y:1090
 		{
 		}
 	case 4:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1088
+//line sql.y:1094
 		{
 			yyVAL.statement = yyDollar[1].selStmt
 		}
 	case 23:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1110
+//line sql.y:1116
 		{
 			setParseTree(yylex, nil)
 		}
 	case 24:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:1116
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:1122
 		{
 			sel := yyDollar[1].selStmt.(*Select)
 			sel.OrderBy = yyDollar[2].orderBy
 			sel.Limit = yyDollar[3].limit
 			sel.Lock = yyDollar[4].str
-			sel.Formats = yyDollar[5].formats
+			sel.Settings = yyDollar[5].setExprs
+			sel.Formats = yyDollar[6].formats
 			yyVAL.selStmt = sel
 		}
 	case 25:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:1125
+//line sql.y:1132
 		{
 			yyVAL.selStmt = &Union{Type: yyDollar[2].str, Left: yyDollar[1].selStmt, Right: yyDollar[3].selStmt, OrderBy: yyDollar[4].orderBy, Limit: yyDollar[5].limit, Lock: yyDollar[6].str}
 		}
 	case 26:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line sql.y:1129
+//line sql.y:1136
 		{
 			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, SelectExprs: SelectExprs{Nextval{Expr: yyDollar[5].expr}}, From: TableExprs{&AliasedTableExpr{Expr: yyDollar[7].tableName}}}
 		}
 	case 27:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:1135
+//line sql.y:1142
 		{
 			yyVAL.statement = &Stream{Comments: Comments(yyDollar[2].bytes2), SelectExpr: yyDollar[3].selectExpr, Table: yyDollar[5].tableName}
 		}
 	case 28:
 		yyDollar = yyS[yypt-10 : yypt+1]
-		//line sql.y:1142
+//line sql.y:1149
 		{
 			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, Distinct: yyDollar[4].str, Hints: yyDollar[5].str, SelectExprs: yyDollar[6].selectExprs, From: yyDollar[7].tableExprs, Where: NewWhere(WhereStr, yyDollar[8].expr), GroupBy: GroupBy(yyDollar[9].exprs), Having: NewWhere(HavingStr, yyDollar[10].expr)}
 		}
 	case 29:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1148
+//line sql.y:1155
 		{
 			yyVAL.selStmt = yyDollar[1].selStmt
 		}
 	case 30:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1152
+//line sql.y:1159
 		{
 			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
 		}
 	case 31:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1158
+//line sql.y:1165
 		{
 			yyVAL.selStmt = yyDollar[1].selStmt
 		}
 	case 32:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1162
+//line sql.y:1169
 		{
 			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
 		}
 	case 33:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line sql.y:1168
+//line sql.y:1175
 		{
 			// insert_data returns a *Insert pre-filled with Columns & Values
 			ins := yyDollar[7].ins
@@ -3873,7 +3885,7 @@ yydefault:
 		}
 	case 34:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line sql.y:1181
+//line sql.y:1188
 		{
 			cols := make(Columns, 0, len(yyDollar[7].updateExprs))
 			vals := make(ValTuple, 0, len(yyDollar[8].updateExprs))
@@ -3885,192 +3897,192 @@ yydefault:
 		}
 	case 35:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1193
+//line sql.y:1200
 		{
 			yyVAL.str = InsertStr
 		}
 	case 36:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1197
+//line sql.y:1204
 		{
 			yyVAL.str = ReplaceStr
 		}
 	case 37:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line sql.y:1203
+//line sql.y:1210
 		{
 			yyVAL.statement = &Update{Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].str, TableExprs: yyDollar[4].tableExprs, Exprs: yyDollar[6].updateExprs, Where: NewWhere(WhereStr, yyDollar[7].expr), OrderBy: yyDollar[8].orderBy, Limit: yyDollar[9].limit}
 		}
 	case 38:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line sql.y:1209
+//line sql.y:1216
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), TableExprs: TableExprs{&AliasedTableExpr{Expr: yyDollar[4].tableName}}, Partitions: yyDollar[5].partitions, Where: NewWhere(WhereStr, yyDollar[6].expr), OrderBy: yyDollar[7].orderBy, Limit: yyDollar[8].limit}
 		}
 	case 39:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line sql.y:1213
+//line sql.y:1220
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[4].tableNames, TableExprs: yyDollar[6].tableExprs, Where: NewWhere(WhereStr, yyDollar[7].expr)}
 		}
 	case 40:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:1217
+//line sql.y:1224
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[3].tableNames, TableExprs: yyDollar[5].tableExprs, Where: NewWhere(WhereStr, yyDollar[6].expr)}
 		}
 	case 41:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:1221
+//line sql.y:1228
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[3].tableNames, TableExprs: yyDollar[5].tableExprs, Where: NewWhere(WhereStr, yyDollar[6].expr)}
 		}
 	case 42:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1227
+//line sql.y:1234
 		{
 		}
 	case 43:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1229
+//line sql.y:1236
 		{
 		}
 	case 44:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1233
+//line sql.y:1240
 		{
 			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
 		}
 	case 45:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1237
+//line sql.y:1244
 		{
 			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
 		}
 	case 46:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1243
+//line sql.y:1250
 		{
 			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
 		}
 	case 47:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1247
+//line sql.y:1254
 		{
 			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
 		}
 	case 48:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1252
+//line sql.y:1259
 		{
 			yyVAL.partitions = nil
 		}
 	case 49:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1256
+//line sql.y:1263
 		{
 			yyVAL.partitions = yyDollar[3].partitions
 		}
 	case 50:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1262
+//line sql.y:1269
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[3].setExprs}
 		}
 	case 51:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1266
+//line sql.y:1273
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Scope: yyDollar[3].str, Exprs: yyDollar[4].setExprs}
 		}
 	case 52:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:1270
+//line sql.y:1277
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Scope: yyDollar[3].str, Exprs: yyDollar[5].setExprs}
 		}
 	case 53:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1274
+//line sql.y:1281
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[4].setExprs}
 		}
 	case 54:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1280
+//line sql.y:1287
 		{
 			yyVAL.setExprs = SetExprs{yyDollar[1].setExpr}
 		}
 	case 55:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1284
+//line sql.y:1291
 		{
 			yyVAL.setExprs = append(yyVAL.setExprs, yyDollar[3].setExpr)
 		}
 	case 56:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1290
+//line sql.y:1297
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(TransactionStr), Expr: NewStrVal([]byte(yyDollar[3].str))}
 		}
 	case 57:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1294
+//line sql.y:1301
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(TransactionStr), Expr: NewStrVal([]byte(TxReadWrite))}
 		}
 	case 58:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1298
+//line sql.y:1305
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(TransactionStr), Expr: NewStrVal([]byte(TxReadOnly))}
 		}
 	case 59:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1304
+//line sql.y:1311
 		{
 			yyVAL.str = IsolationLevelRepeatableRead
 		}
 	case 60:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1308
+//line sql.y:1315
 		{
 			yyVAL.str = IsolationLevelReadCommitted
 		}
 	case 61:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1312
+//line sql.y:1319
 		{
 			yyVAL.str = IsolationLevelReadUncommitted
 		}
 	case 62:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1316
+//line sql.y:1323
 		{
 			yyVAL.str = IsolationLevelSerializable
 		}
 	case 63:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1322
+//line sql.y:1329
 		{
 			yyVAL.str = SessionStr
 		}
 	case 64:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1326
+//line sql.y:1333
 		{
 			yyVAL.str = GlobalStr
 		}
 	case 65:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1332
+//line sql.y:1339
 		{
 			yyDollar[1].ddl.TableSpec = yyDollar[2].TableSpec
 			yyVAL.statement = yyDollar[1].ddl
 		}
 	case 66:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1337
+//line sql.y:1344
 		{
 			// Create table [name] like [name]
 			yyDollar[1].ddl.OptLike = yyDollar[2].optLike
@@ -4078,139 +4090,146 @@ yydefault:
 		}
 	case 67:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line sql.y:1343
+//line sql.y:1350
 		{
 			// Change this to an alter statement
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[7].tableName}
 		}
 	case 68:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1348
+//line sql.y:1355
 		{
 			yyVAL.statement = &DDL{Action: CreateStr, Table: yyDollar[3].tableName.ToViewName()}
 		}
 	case 69:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:1352
+//line sql.y:1359
 		{
 			yyVAL.statement = &DDL{Action: CreateStr, Table: yyDollar[5].tableName.ToViewName()}
 		}
 	case 70:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:1356
+//line sql.y:1363
 		{
 			yyVAL.statement = &DBDDL{Action: CreateStr, DBName: string(yyDollar[4].bytes), Options: yyDollar[5].databaseOption}
 		}
 	case 71:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:1360
+//line sql.y:1367
 		{
 			yyVAL.statement = &DBDDL{Action: CreateStr, DBName: string(yyDollar[4].bytes)}
 		}
 	case 72:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1365
+//line sql.y:1372
 		{
 			yyVAL.colIdent = NewColIdent("")
 		}
 	case 73:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1369
+//line sql.y:1376
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
 	case 74:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1375
+//line sql.y:1382
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
 	case 75:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1380
+//line sql.y:1387
 		{
 			var v []VindexParam
 			yyVAL.vindexParams = v
 		}
 	case 76:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1385
+//line sql.y:1392
 		{
 			yyVAL.vindexParams = yyDollar[2].vindexParams
 		}
 	case 77:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1391
+//line sql.y:1398
 		{
 			yyVAL.vindexParams = make([]VindexParam, 0, 4)
 			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[1].vindexParam)
 		}
 	case 78:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1396
+//line sql.y:1403
 		{
 			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[3].vindexParam)
 		}
 	case 79:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1402
+//line sql.y:1409
 		{
 			yyVAL.vindexParam = VindexParam{Key: yyDollar[1].colIdent, Val: yyDollar[3].str}
 		}
 	case 80:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1408
+//line sql.y:1415
 		{
 			yyVAL.ddl = &DDL{Action: CreateStr, Table: yyDollar[4].tableName}
 			setDDL(yylex, yyVAL.ddl)
 		}
 	case 81:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1420
+		{
+			yyVAL.ddl = &DDL{Action: CreateStr, Table: yyDollar[5].tableName, Temporary: true}
+			setDDL(yylex, yyVAL.ddl)
+		}
+	case 82:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1415
+//line sql.y:1427
 		{
 			yyVAL.TableSpec = yyDollar[2].TableSpec
 			yyVAL.TableSpec.Options = yyDollar[4].tableOption
 		}
-	case 82:
+	case 83:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1422
+//line sql.y:1434
 		{
 			yyVAL.optLike = &OptLike{LikeTable: yyDollar[2].tableName}
 		}
-	case 83:
+	case 84:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1426
+//line sql.y:1438
 		{
 			yyVAL.optLike = &OptLike{LikeTable: yyDollar[3].tableName}
 		}
-	case 84:
+	case 85:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1432
+//line sql.y:1444
 		{
 			yyVAL.TableSpec = &TableSpec{}
 			yyVAL.TableSpec.AddColumn(yyDollar[1].columnDefinition)
 		}
-	case 85:
+	case 86:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1437
+//line sql.y:1449
 		{
 			yyVAL.TableSpec.AddColumn(yyDollar[3].columnDefinition)
 		}
-	case 86:
+	case 87:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1441
+//line sql.y:1453
 		{
 			yyVAL.TableSpec.AddIndex(yyDollar[3].indexDefinition)
 		}
-	case 87:
+	case 88:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1445
+//line sql.y:1457
 		{
 			yyVAL.TableSpec.AddConstraint(yyDollar[3].constraintDefinition)
 		}
-	case 88:
+	case 89:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line sql.y:1451
+//line sql.y:1463
 		{
 			yyDollar[2].columnType.NotNull = yyDollar[3].boolVal
 			yyDollar[2].columnType.Default = yyDollar[4].optVal
@@ -4220,140 +4239,132 @@ yydefault:
 			yyDollar[2].columnType.Comment = yyDollar[8].sqlVal
 			yyVAL.columnDefinition = &ColumnDefinition{Name: NewColIdent(string(yyDollar[1].bytes)), Type: yyDollar[2].columnType}
 		}
-	case 89:
+	case 90:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1463
+//line sql.y:1475
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Unsigned = yyDollar[2].boolVal
 			yyVAL.columnType.Zerofill = yyDollar[3].boolVal
 		}
-	case 93:
+	case 94:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1474
+//line sql.y:1486
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Length = yyDollar[2].sqlVal
 		}
-	case 94:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1479
-		{
-			yyVAL.columnType = yyDollar[1].columnType
-		}
 	case 95:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1485
+//line sql.y:1491
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType = yyDollar[1].columnType
 		}
 	case 96:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1489
+//line sql.y:1497
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 97:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1493
+//line sql.y:1501
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 98:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1497
+//line sql.y:1505
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 99:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1501
+//line sql.y:1509
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 100:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1505
+//line sql.y:1513
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 101:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1509
+//line sql.y:1517
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 102:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1513
+//line sql.y:1521
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 103:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1517
+//line sql.y:1525
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 104:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1521
+//line sql.y:1529
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 105:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1525
+//line sql.y:1533
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 106:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1529
+//line sql.y:1537
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 107:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1533
+//line sql.y:1541
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 108:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1537
+//line sql.y:1545
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 109:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1541
+//line sql.y:1549
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 110:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1545
+//line sql.y:1553
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 111:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1549
+//line sql.y:1557
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 112:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1555
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1561
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
-			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
-			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
 	case 113:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1561
+//line sql.y:1567
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
@@ -4361,7 +4372,7 @@ yydefault:
 		}
 	case 114:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1567
+//line sql.y:1573
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
@@ -4369,7 +4380,7 @@ yydefault:
 		}
 	case 115:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1573
+//line sql.y:1579
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
@@ -4377,7 +4388,7 @@ yydefault:
 		}
 	case 116:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1579
+//line sql.y:1585
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
@@ -4385,7 +4396,7 @@ yydefault:
 		}
 	case 117:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1585
+//line sql.y:1591
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
@@ -4393,728 +4404,736 @@ yydefault:
 		}
 	case 118:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1591
+//line sql.y:1597
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
 	case 119:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1599
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1603
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
 	case 120:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1603
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1611
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 121:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1607
+//line sql.y:1615
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
 	case 122:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1611
+//line sql.y:1619
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
 	case 123:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1615
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1623
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
 	case 124:
-		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1621
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1627
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 125:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1625
+//line sql.y:1633
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
 	case 126:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1629
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1637
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
 	case 127:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1633
+//line sql.y:1641
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
 	case 128:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1637
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1645
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
 	case 129:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1641
+//line sql.y:1649
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
 	case 130:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1645
+//line sql.y:1653
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
 	case 131:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1649
+//line sql.y:1657
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
 	case 132:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1653
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1661
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
 	case 133:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1657
+//line sql.y:1665
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 134:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1661
+//line sql.y:1669
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 135:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1665
+//line sql.y:1673
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 136:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1669
+//line sql.y:1677
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 137:
-		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:1673
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1681
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 138:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:1677
+//line sql.y:1685
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
 	case 139:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:1681
+//line sql.y:1689
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
 	case 140:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:1686
+//line sql.y:1693
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
 	case 141:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1690
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:1698
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
 	case 142:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1694
+//line sql.y:1702
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 143:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1700
+//line sql.y:1706
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 144:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1704
+//line sql.y:1712
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 145:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1708
+//line sql.y:1716
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 146:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1712
+//line sql.y:1720
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 147:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1716
+//line sql.y:1724
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 148:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1720
+//line sql.y:1728
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 149:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1724
+//line sql.y:1732
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 150:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1728
+//line sql.y:1736
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 151:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1734
+//line sql.y:1740
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 152:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1746
 		{
 			yyVAL.strs = make([]string, 0, 4)
 			yyVAL.strs = append(yyVAL.strs, "'"+string(yyDollar[1].bytes)+"'")
 		}
-	case 152:
+	case 153:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1739
+//line sql.y:1751
 		{
 			yyVAL.strs = append(yyDollar[1].strs, "'"+string(yyDollar[3].bytes)+"'")
 		}
-	case 153:
+	case 154:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1744
+//line sql.y:1756
 		{
 			yyVAL.sqlVal = nil
 		}
-	case 154:
+	case 155:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1748
+//line sql.y:1760
 		{
 			yyVAL.sqlVal = NewIntVal(yyDollar[2].bytes)
 		}
-	case 155:
+	case 156:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1753
+//line sql.y:1765
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 156:
+	case 157:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:1757
+//line sql.y:1769
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 				Scale:  NewIntVal(yyDollar[4].bytes),
 			}
 		}
-	case 157:
+	case 158:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1765
+//line sql.y:1777
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 158:
+	case 159:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1769
+//line sql.y:1781
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 			}
 		}
-	case 159:
+	case 160:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:1775
+//line sql.y:1787
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 				Scale:  NewIntVal(yyDollar[4].bytes),
 			}
 		}
-	case 160:
+	case 161:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1783
+//line sql.y:1795
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 161:
+	case 162:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1787
+//line sql.y:1799
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 162:
+	case 163:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1792
+//line sql.y:1804
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 163:
+	case 164:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1796
+//line sql.y:1808
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 164:
+	case 165:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1802
+//line sql.y:1814
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 165:
+	case 166:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1806
+//line sql.y:1818
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 166:
+	case 167:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1810
+//line sql.y:1822
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 167:
+	case 168:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1814
+//line sql.y:1826
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 168:
+	case 169:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1819
+//line sql.y:1831
 		{
 			yyVAL.optVal = nil
 		}
-	case 169:
+	case 170:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1823
+//line sql.y:1835
 		{
 			yyVAL.optVal = yyDollar[2].expr
 		}
-	case 170:
+	case 171:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1828
+//line sql.y:1840
 		{
 			yyVAL.optVal = nil
 		}
-	case 171:
+	case 172:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1832
+//line sql.y:1844
 		{
 			yyVAL.optVal = yyDollar[3].expr
 		}
-	case 172:
+	case 173:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1837
+//line sql.y:1849
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 173:
+	case 174:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1841
+//line sql.y:1853
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 174:
+	case 175:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1846
+//line sql.y:1858
 		{
 			yyVAL.str = ""
 		}
-	case 175:
+	case 176:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1850
+//line sql.y:1862
 		{
 			yyVAL.str = string(yyDollar[3].bytes)
 		}
-	case 176:
+	case 177:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1854
+//line sql.y:1866
 		{
 			yyVAL.str = string(yyDollar[3].bytes)
 		}
-	case 177:
+	case 178:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1859
+//line sql.y:1871
 		{
 			yyVAL.str = ""
 		}
-	case 178:
+	case 179:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1863
+//line sql.y:1875
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 179:
+	case 180:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1867
+//line sql.y:1879
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 180:
+	case 181:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1872
+//line sql.y:1884
 		{
 			yyVAL.colKeyOpt = colKeyNone
 		}
-	case 181:
+	case 182:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1876
+//line sql.y:1888
 		{
 			yyVAL.colKeyOpt = colKeyPrimary
 		}
-	case 182:
+	case 183:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1880
+//line sql.y:1892
 		{
 			yyVAL.colKeyOpt = colKey
 		}
-	case 183:
+	case 184:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1884
+//line sql.y:1896
 		{
 			yyVAL.colKeyOpt = colKeyUniqueKey
 		}
-	case 184:
+	case 185:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1888
+//line sql.y:1900
 		{
 			yyVAL.colKeyOpt = colKeyUnique
 		}
-	case 185:
+	case 186:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1893
+//line sql.y:1905
 		{
 			yyVAL.sqlVal = nil
 		}
-	case 186:
+	case 187:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1897
+//line sql.y:1909
 		{
 			yyVAL.sqlVal = NewStrVal(yyDollar[2].bytes)
 		}
-	case 187:
+	case 188:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:1903
+//line sql.y:1915
 		{
 			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns, Options: yyDollar[5].indexOptions}
 		}
-	case 188:
+	case 189:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1907
+//line sql.y:1919
 		{
 			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns}
 		}
-	case 189:
+	case 190:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1913
+//line sql.y:1925
 		{
 			yyVAL.indexOptions = []*IndexOption{yyDollar[1].indexOption}
 		}
-	case 190:
+	case 191:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1917
+//line sql.y:1929
 		{
 			yyVAL.indexOptions = append(yyVAL.indexOptions, yyDollar[2].indexOption)
 		}
-	case 191:
+	case 192:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1923
+//line sql.y:1935
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Using: string(yyDollar[2].bytes)}
 		}
-	case 192:
+	case 193:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1927
+//line sql.y:1939
 		{
 			// should not be string
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewIntVal(yyDollar[3].bytes)}
 		}
-	case 193:
+	case 194:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1932
+//line sql.y:1944
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewStrVal(yyDollar[2].bytes)}
 		}
-	case 194:
+	case 195:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1938
+//line sql.y:1950
 		{
 			yyVAL.str = ""
 		}
-	case 195:
+	case 196:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1942
+//line sql.y:1954
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 196:
+	case 197:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1948
+//line sql.y:1960
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
 		}
-	case 197:
+	case 198:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1952
+//line sql.y:1964
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Spatial: true, Unique: false}
 		}
-	case 198:
+	case 199:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1956
+//line sql.y:1968
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Unique: true}
 		}
-	case 199:
+	case 200:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1960
+//line sql.y:1972
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes), Name: NewColIdent(yyDollar[2].str), Unique: true}
 		}
-	case 200:
+	case 201:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1964
+//line sql.y:1976
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].str), Name: NewColIdent(yyDollar[2].str), Unique: false}
 		}
-	case 201:
+	case 202:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1970
+//line sql.y:1982
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 202:
+	case 203:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1974
+//line sql.y:1986
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 203:
+	case 204:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1979
+//line sql.y:1991
 		{
 			yyVAL.str = ""
 		}
-	case 204:
+	case 205:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1983
+//line sql.y:1995
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 205:
+	case 206:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1989
+//line sql.y:2001
 		{
 			yyVAL.indexColumns = []*IndexColumn{yyDollar[1].indexColumn}
 		}
-	case 206:
+	case 207:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1993
+//line sql.y:2005
 		{
 			yyVAL.indexColumns = append(yyVAL.indexColumns, yyDollar[3].indexColumn)
 		}
-	case 207:
+	case 208:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1999
+//line sql.y:2011
 		{
 			yyVAL.indexColumn = &IndexColumn{Column: yyDollar[1].colIdent, Length: yyDollar[2].sqlVal}
 		}
-	case 208:
+	case 209:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2005
+//line sql.y:2017
 		{
 			yyVAL.constraintDefinition = &ConstraintDefinition{Name: string(yyDollar[2].bytes), Details: yyDollar[3].constraintInfo}
 		}
-	case 209:
+	case 210:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2009
+//line sql.y:2021
 		{
 			yyVAL.constraintDefinition = &ConstraintDefinition{Details: yyDollar[1].constraintInfo}
 		}
-	case 210:
+	case 211:
 		yyDollar = yyS[yypt-10 : yypt+1]
-		//line sql.y:2015
+//line sql.y:2027
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns}
 		}
-	case 211:
+	case 212:
 		yyDollar = yyS[yypt-11 : yypt+1]
-		//line sql.y:2019
+//line sql.y:2031
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnDelete: yyDollar[11].ReferenceAction}
 		}
-	case 212:
+	case 213:
 		yyDollar = yyS[yypt-11 : yypt+1]
-		//line sql.y:2023
+//line sql.y:2035
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnUpdate: yyDollar[11].ReferenceAction}
 		}
-	case 213:
+	case 214:
 		yyDollar = yyS[yypt-12 : yypt+1]
-		//line sql.y:2027
+//line sql.y:2039
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnDelete: yyDollar[11].ReferenceAction, OnUpdate: yyDollar[12].ReferenceAction}
 		}
-	case 214:
+	case 215:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2033
+//line sql.y:2045
 		{
 			yyVAL.ReferenceAction = yyDollar[3].ReferenceAction
 		}
-	case 215:
+	case 216:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2039
+//line sql.y:2051
 		{
 			yyVAL.ReferenceAction = yyDollar[3].ReferenceAction
 		}
-	case 216:
+	case 217:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2045
+//line sql.y:2057
 		{
 			yyVAL.ReferenceAction = Restrict
 		}
-	case 217:
+	case 218:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2049
+//line sql.y:2061
 		{
 			yyVAL.ReferenceAction = Cascade
 		}
-	case 218:
+	case 219:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2053
+//line sql.y:2065
 		{
 			yyVAL.ReferenceAction = NoAction
 		}
-	case 219:
+	case 220:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2057
+//line sql.y:2069
 		{
 			yyVAL.ReferenceAction = SetDefault
 		}
-	case 220:
+	case 221:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2061
+//line sql.y:2073
 		{
 			yyVAL.ReferenceAction = SetNull
 		}
-	case 221:
+	case 222:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2068
+//line sql.y:2080
 		{
 			yyVAL.tableOption = &TableOption{}
 		}
-	case 222:
+	case 223:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2072
+//line sql.y:2084
 		{
 			yyVAL.tableOption = &TableOption{Engine: yyDollar[3].str}
 		}
-	case 223:
+	case 224:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:2076
+//line sql.y:2088
 		{
 			yyVAL.tableOption = &TableOption{Engine: yyDollar[3].str, Formatter: yyDollar[5].str}
 		}
-	case 224:
+	case 225:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2081
+//line sql.y:2093
 		{
 		}
-	case 225:
+	case 226:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2083
+//line sql.y:2095
 		{
 			yyVAL.databaseOption = &DatabaseOption{Engine: yyDollar[3].str}
 		}
-	case 226:
+	case 227:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2089
+//line sql.y:2101
 		{
 			yyVAL.str = yyDollar[1].colIdent.String()
 		}
-	case 227:
+	case 228:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2093
+//line sql.y:2105
 		{
 			yyVAL.str = "'" + string(yyDollar[1].bytes) + "'"
 		}
-	case 228:
+	case 229:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2097
+//line sql.y:2109
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 229:
+	case 230:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:2103
+//line sql.y:2115
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName}
 		}
-	case 230:
+	case 231:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line sql.y:2107
+//line sql.y:2119
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName}
 		}
-	case 231:
+	case 232:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line sql.y:2111
+//line sql.y:2123
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName}
 		}
-	case 232:
+	case 233:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line sql.y:2115
+//line sql.y:2127
 		{
 			// Change this to a rename statement
 			yyVAL.statement = &DDL{Action: RenameStr, FromTables: TableNames{yyDollar[4].tableName}, ToTables: TableNames{yyDollar[7].tableName}}
 		}
-	case 233:
+	case 234:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line sql.y:2120
+//line sql.y:2132
 		{
 			// Rename an index can just be an alter
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName}
 		}
-	case 234:
+	case 235:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2125
+//line sql.y:2137
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[3].tableName.ToViewName()}
 		}
-	case 235:
+	case 236:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:2129
+//line sql.y:2141
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, PartitionSpec: yyDollar[5].partSpec}
 		}
-	case 236:
+	case 237:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line sql.y:2133
+//line sql.y:2145
 		{
 			yyVAL.statement = &DDL{
 				Action: CreateVindexStr,
@@ -5126,9 +5145,9 @@ yydefault:
 				},
 			}
 		}
-	case 237:
+	case 238:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:2145
+//line sql.y:2157
 		{
 			yyVAL.statement = &DDL{
 				Action: DropVindexStr,
@@ -5138,21 +5157,21 @@ yydefault:
 				},
 			}
 		}
-	case 238:
+	case 239:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:2155
+//line sql.y:2167
 		{
 			yyVAL.statement = &DDL{Action: AddVschemaTableStr, Table: yyDollar[5].tableName}
 		}
-	case 239:
+	case 240:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:2159
+//line sql.y:2171
 		{
 			yyVAL.statement = &DDL{Action: DropVschemaTableStr, Table: yyDollar[5].tableName}
 		}
-	case 240:
+	case 241:
 		yyDollar = yyS[yypt-12 : yypt+1]
-		//line sql.y:2163
+//line sql.y:2175
 		{
 			yyVAL.statement = &DDL{
 				Action: AddColVindexStr,
@@ -5165,9 +5184,9 @@ yydefault:
 				VindexCols: yyDollar[9].columns,
 			}
 		}
-	case 241:
+	case 242:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line sql.y:2176
+//line sql.y:2188
 		{
 			yyVAL.statement = &DDL{
 				Action: DropColVindexStr,
@@ -5177,15 +5196,15 @@ yydefault:
 				},
 			}
 		}
-	case 242:
+	case 243:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:2186
+//line sql.y:2198
 		{
 			yyVAL.statement = &DDL{Action: AddSequenceStr, Table: yyDollar[5].tableName}
 		}
-	case 243:
+	case 244:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line sql.y:2190
+//line sql.y:2202
 		{
 			yyVAL.statement = &DDL{
 				Action: AddAutoIncStr,
@@ -5196,59 +5215,59 @@ yydefault:
 				},
 			}
 		}
-	case 256:
+	case 257:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line sql.y:2217
+//line sql.y:2229
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: ReorganizeStr, Name: yyDollar[3].colIdent, Definitions: yyDollar[6].partDefs}
 		}
-	case 257:
+	case 258:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2223
+//line sql.y:2235
 		{
 			yyVAL.partDefs = []*PartitionDefinition{yyDollar[1].partDef}
 		}
-	case 258:
+	case 259:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2227
+//line sql.y:2239
 		{
 			yyVAL.partDefs = append(yyDollar[1].partDefs, yyDollar[3].partDef)
 		}
-	case 259:
+	case 260:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line sql.y:2233
+//line sql.y:2245
 		{
 			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Limit: yyDollar[7].expr}
 		}
-	case 260:
+	case 261:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line sql.y:2237
+//line sql.y:2249
 		{
 			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Maxvalue: true}
 		}
-	case 261:
+	case 262:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2243
+//line sql.y:2255
 		{
 			yyVAL.statement = yyDollar[3].ddl
 		}
-	case 262:
+	case 263:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2249
+//line sql.y:2261
 		{
 			yyVAL.ddl = &DDL{Action: RenameStr, FromTables: TableNames{yyDollar[1].tableName}, ToTables: TableNames{yyDollar[3].tableName}}
 		}
-	case 263:
+	case 264:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:2253
+//line sql.y:2265
 		{
 			yyVAL.ddl = yyDollar[1].ddl
 			yyVAL.ddl.FromTables = append(yyVAL.ddl.FromTables, yyDollar[3].tableName)
 			yyVAL.ddl.ToTables = append(yyVAL.ddl.ToTables, yyDollar[5].tableName)
 		}
-	case 264:
+	case 265:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2261
+//line sql.y:2273
 		{
 			var exists bool
 			if yyDollar[3].byt != 0 {
@@ -5256,16 +5275,16 @@ yydefault:
 			}
 			yyVAL.statement = &DDL{Action: DropStr, FromTables: yyDollar[4].tableNames, IfExists: exists}
 		}
-	case 265:
+	case 266:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:2269
+//line sql.y:2281
 		{
 			// Change this to an alter statement
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[5].tableName}
 		}
-	case 266:
+	case 267:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:2274
+//line sql.y:2286
 		{
 			var exists bool
 			if yyDollar[3].byt != 0 {
@@ -5273,148 +5292,148 @@ yydefault:
 			}
 			yyVAL.statement = &DDL{Action: DropStr, FromTables: TableNames{yyDollar[4].tableName.ToViewName()}, IfExists: exists}
 		}
-	case 267:
+	case 268:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2282
+//line sql.y:2294
 		{
 			yyVAL.statement = &DBDDL{Action: DropStr, DBName: string(yyDollar[4].bytes)}
 		}
-	case 268:
+	case 269:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2286
+//line sql.y:2298
 		{
 			yyVAL.statement = &DBDDL{Action: DropStr, DBName: string(yyDollar[4].bytes)}
 		}
-	case 269:
+	case 270:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2292
+//line sql.y:2304
 		{
 			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[3].tableName}
 		}
-	case 270:
+	case 271:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2296
+//line sql.y:2308
 		{
 			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[2].tableName}
 		}
-	case 271:
+	case 272:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2302
+//line sql.y:2314
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[3].tableName}
 		}
-	case 272:
+	case 273:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2309
+//line sql.y:2321
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 273:
+	case 274:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2314
+//line sql.y:2326
 		{
 			yyVAL.statement = &Show{Type: CharsetStr}
 		}
-	case 274:
+	case 275:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2318
+//line sql.y:2330
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 275:
-		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2322
-		{
-			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
-		}
 	case 276:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2327
+//line sql.y:2334
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
 	case 277:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2331
+//line sql.y:2339
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
 	case 278:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2335
+//line sql.y:2343
 		{
-			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Table: yyDollar[4].tableName}
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
 	case 279:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2339
+//line sql.y:2347
 		{
-			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Table: yyDollar[4].tableName}
 		}
 	case 280:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2343
+//line sql.y:2351
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
 	case 281:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2347
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2355
 		{
-			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
 	case 282:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2351
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2359
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
 	case 283:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2355
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2363
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
 	case 284:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2359
+//line sql.y:2367
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
 	case 285:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2363
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2371
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
 	case 286:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2367
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2375
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
 	case 287:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2379
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 288:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2371
+//line sql.y:2383
 		{
 			yyVAL.statement = &Show{Scope: yyDollar[2].str, Type: string(yyDollar[3].bytes)}
 		}
-	case 288:
+	case 289:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2375
+//line sql.y:2387
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 289:
+	case 290:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line sql.y:2379
+//line sql.y:2391
 		{
 			showTablesOpt := &ShowTablesOpt{Full: yyDollar[2].str, DbName: yyDollar[6].str, Filter: yyDollar[7].showFilter}
 			yyVAL.statement = &Show{Type: string(yyDollar[3].str), ShowTablesOpt: showTablesOpt, OnTable: yyDollar[5].tableName}
 		}
-	case 290:
+	case 291:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:2384
+//line sql.y:2396
 		{
 			// this is ugly, but I couldn't find a better way for now
 			if yyDollar[3].str == "processlist" {
@@ -5424,717 +5443,717 @@ yydefault:
 				yyVAL.statement = &Show{Type: yyDollar[3].str, ShowTablesOpt: showTablesOpt, Limit: yyDollar[6].limit}
 			}
 		}
-	case 291:
+	case 292:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2394
+//line sql.y:2406
 		{
 			yyVAL.statement = &Show{Scope: yyDollar[2].str, Type: string(yyDollar[3].bytes)}
 		}
-	case 292:
+	case 293:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2398
+//line sql.y:2410
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 293:
+	case 294:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2402
+//line sql.y:2414
 		{
 			// Cannot dereference $4 directly, or else the parser stackcannot be pooled. See yyParsePooled
 			showCollationFilterOpt := yyDollar[4].expr
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes), ShowCollationFilterOpt: &showCollationFilterOpt}
 		}
-	case 294:
+	case 295:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2408
+//line sql.y:2420
 		{
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[4].showFilter}
 			yyVAL.statement = &Show{Scope: string(yyDollar[2].bytes), Type: string(yyDollar[3].bytes), ShowTablesOpt: showTablesOpt}
 		}
-	case 295:
+	case 296:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2413
+//line sql.y:2425
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 296:
+	case 297:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2417
+//line sql.y:2429
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 297:
+	case 298:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:2421
+//line sql.y:2433
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), OnTable: yyDollar[5].tableName}
 		}
-	case 298:
+	case 299:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2425
+//line sql.y:2437
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 299:
+	case 300:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2439
+//line sql.y:2451
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 300:
+	case 301:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2445
+//line sql.y:2457
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 301:
+	case 302:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2449
+//line sql.y:2461
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 302:
+	case 303:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2455
+//line sql.y:2467
 		{
 			yyVAL.str = ""
 		}
-	case 303:
+	case 304:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2459
+//line sql.y:2471
 		{
 			yyVAL.str = "full "
 		}
-	case 304:
+	case 305:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2465
+//line sql.y:2477
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 305:
+	case 306:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2469
+//line sql.y:2481
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 306:
+	case 307:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2475
+//line sql.y:2487
 		{
 			yyVAL.str = ""
 		}
-	case 307:
+	case 308:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2479
+//line sql.y:2491
 		{
 			yyVAL.str = yyDollar[2].tableIdent.v
 		}
-	case 308:
+	case 309:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2483
+//line sql.y:2495
 		{
 			yyVAL.str = yyDollar[2].tableIdent.v
 		}
-	case 309:
+	case 310:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2489
+//line sql.y:2501
 		{
 			yyVAL.showFilter = nil
 		}
-	case 310:
+	case 311:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2493
+//line sql.y:2505
 		{
 			yyVAL.showFilter = &ShowFilter{Like: string(yyDollar[2].bytes)}
 		}
-	case 311:
+	case 312:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2497
+//line sql.y:2509
 		{
 			yyVAL.showFilter = &ShowFilter{Like: string(yyDollar[2].bytes), Not: true}
 		}
-	case 312:
+	case 313:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2501
+//line sql.y:2513
 		{
 			yyVAL.showFilter = &ShowFilter{Filter: yyDollar[2].expr}
 		}
-	case 313:
+	case 314:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2507
+//line sql.y:2519
 		{
 			yyVAL.showFilter = nil
 		}
-	case 314:
+	case 315:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2511
+//line sql.y:2523
 		{
 			yyVAL.showFilter = &ShowFilter{Like: string(yyDollar[2].bytes)}
 		}
-	case 315:
+	case 316:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2515
+//line sql.y:2527
 		{
 			yyVAL.showFilter = &ShowFilter{Like: string(yyDollar[2].bytes), Not: true}
 		}
-	case 316:
+	case 317:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2521
+//line sql.y:2533
 		{
 			yyVAL.str = ""
 		}
-	case 317:
+	case 318:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2525
+//line sql.y:2537
 		{
 			yyVAL.str = SessionStr
 		}
-	case 318:
+	case 319:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2529
+//line sql.y:2541
 		{
 			yyVAL.str = GlobalStr
 		}
-	case 319:
+	case 320:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2535
+//line sql.y:2547
 		{
 			yyVAL.statement = &Use{DBName: yyDollar[2].tableIdent}
 		}
-	case 320:
+	case 321:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2539
+//line sql.y:2551
 		{
 			yyVAL.statement = &Use{DBName: TableIdent{v: ""}}
 		}
-	case 321:
+	case 322:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2545
+//line sql.y:2557
 		{
 			yyVAL.statement = &Begin{}
 		}
-	case 322:
+	case 323:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2549
+//line sql.y:2561
 		{
 			yyVAL.statement = &Begin{}
 		}
-	case 323:
+	case 324:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2555
+//line sql.y:2567
 		{
 			yyVAL.statement = &Commit{}
 		}
-	case 324:
+	case 325:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2561
+//line sql.y:2573
 		{
 			yyVAL.statement = &Rollback{}
 		}
-	case 325:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2567
-		{
-			yyVAL.statement = &OtherRead{}
-		}
 	case 326:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2571
+//line sql.y:2579
 		{
 			yyVAL.statement = &OtherRead{}
 		}
 	case 327:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2575
+//line sql.y:2583
 		{
 			yyVAL.statement = &OtherRead{}
 		}
 	case 328:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2579
+//line sql.y:2587
 		{
-			yyVAL.statement = &OtherAdmin{}
+			yyVAL.statement = &OtherRead{}
 		}
 	case 329:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2583
+//line sql.y:2591
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
 	case 330:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2587
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2595
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
 	case 331:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2591
+//line sql.y:2599
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
 	case 332:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2603
+		{
+			yyVAL.statement = &OtherAdmin{}
+		}
+	case 333:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2597
+//line sql.y:2609
 		{
 			yyVAL.statement = &DDL{Action: FlushStr}
 		}
-	case 333:
+	case 334:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2602
+//line sql.y:2614
 		{
 			setAllowComments(yylex, true)
 		}
-	case 334:
+	case 335:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2605
+//line sql.y:2617
 		{
 			yyVAL.bytes2 = yyDollar[2].bytes2
 			setAllowComments(yylex, false)
 		}
-	case 335:
+	case 336:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2611
+//line sql.y:2623
 		{
 			yyVAL.bytes2 = nil
 		}
-	case 336:
+	case 337:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2615
+//line sql.y:2627
 		{
 			yyVAL.bytes2 = append(yyDollar[1].bytes2, yyDollar[2].bytes)
 		}
-	case 337:
+	case 338:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2621
+//line sql.y:2633
 		{
 			yyVAL.str = UnionStr
 		}
-	case 338:
+	case 339:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2625
+//line sql.y:2637
 		{
 			yyVAL.str = UnionAllStr
 		}
-	case 339:
+	case 340:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2629
+//line sql.y:2641
 		{
 			yyVAL.str = UnionDistinctStr
 		}
-	case 340:
+	case 341:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2634
+//line sql.y:2646
 		{
 			yyVAL.str = ""
 		}
-	case 341:
+	case 342:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2638
+//line sql.y:2650
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 342:
+	case 343:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2642
+//line sql.y:2654
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 343:
+	case 344:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2647
+//line sql.y:2659
 		{
 			yyVAL.str = ""
 		}
-	case 344:
+	case 345:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2651
+//line sql.y:2663
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 345:
+	case 346:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2656
+//line sql.y:2668
 		{
 			yyVAL.str = ""
 		}
-	case 346:
+	case 347:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2660
+//line sql.y:2672
 		{
 			yyVAL.str = StraightJoinHint
 		}
-	case 347:
+	case 348:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2665
+//line sql.y:2677
 		{
 			yyVAL.selectExprs = nil
 		}
-	case 348:
+	case 349:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2669
+//line sql.y:2681
 		{
 			yyVAL.selectExprs = yyDollar[1].selectExprs
 		}
-	case 349:
+	case 350:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2675
+//line sql.y:2687
 		{
 			yyVAL.selectExprs = SelectExprs{yyDollar[1].selectExpr}
 		}
-	case 350:
+	case 351:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2679
+//line sql.y:2691
 		{
 			yyVAL.selectExprs = append(yyVAL.selectExprs, yyDollar[3].selectExpr)
 		}
-	case 351:
+	case 352:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2685
+//line sql.y:2697
 		{
 			yyVAL.selectExpr = &StarExpr{}
 		}
-	case 352:
+	case 353:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2689
+//line sql.y:2701
 		{
 			yyVAL.selectExpr = &AliasedExpr{Expr: yyDollar[1].expr, As: yyDollar[2].colIdent}
 		}
-	case 353:
+	case 354:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2693
+//line sql.y:2705
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: TableName{Name: yyDollar[1].tableIdent}}
 		}
-	case 354:
+	case 355:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:2697
+//line sql.y:2709
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}}
 		}
-	case 355:
+	case 356:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2702
+//line sql.y:2714
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 356:
+	case 357:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2706
+//line sql.y:2718
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 357:
+	case 358:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2710
+//line sql.y:2722
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 359:
+	case 360:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2717
+//line sql.y:2729
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 360:
+	case 361:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2722
+//line sql.y:2734
 		{
 			yyVAL.tableExprs = TableExprs{&AliasedTableExpr{Expr: TableName{Name: NewTableIdent("dual")}}}
 		}
-	case 361:
+	case 362:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2726
+//line sql.y:2738
 		{
 			yyVAL.tableExprs = yyDollar[2].tableExprs
 		}
-	case 362:
+	case 363:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2732
+//line sql.y:2744
 		{
 			yyVAL.tableExprs = TableExprs{yyDollar[1].tableExpr}
 		}
-	case 363:
+	case 364:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2736
+//line sql.y:2748
 		{
 			yyVAL.tableExprs = append(yyVAL.tableExprs, yyDollar[3].tableExpr)
 		}
-	case 366:
+	case 367:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2746
+//line sql.y:2758
 		{
 			yyVAL.tableExpr = yyDollar[1].aliasedTableName
 		}
-	case 367:
+	case 368:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2750
+//line sql.y:2762
 		{
 			yyVAL.tableExpr = &AliasedTableExpr{Expr: yyDollar[1].subquery, As: yyDollar[3].tableIdent}
 		}
-	case 368:
+	case 369:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2754
+//line sql.y:2766
 		{
 			// missed alias for subquery
 			yylex.Error("Every derived table must have its own alias")
 			return 1
 		}
-	case 369:
+	case 370:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2760
+//line sql.y:2772
 		{
 			yyVAL.tableExpr = &ParenTableExpr{Exprs: yyDollar[2].tableExprs}
 		}
-	case 370:
+	case 371:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:2764
+//line sql.y:2776
 		{
 			yyVAL.tableExpr = &TableValuedFunction{Name: NewColIdent(string(yyDollar[1].bytes)), Args: yyDollar[3].tableValuedFunctionArguments}
 		}
-	case 371:
+	case 372:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:2768
+//line sql.y:2780
 		{
 			yyVAL.tableExpr = &TableValuedFunction{Name: NewColIdent(string(yyDollar[1].bytes)), Args: yyDollar[3].tableValuedFunctionArguments, As: yyDollar[6].tableIdent}
 		}
-	case 372:
+	case 373:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2774
+//line sql.y:2786
 		{
 			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, As: yyDollar[2].tableIdent, Hints: yyDollar[3].indexHints}
 		}
-	case 373:
+	case 374:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line sql.y:2778
+//line sql.y:2790
 		{
 			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, Partitions: yyDollar[4].partitions, As: yyDollar[6].tableIdent, Hints: yyDollar[7].indexHints}
 		}
-	case 374:
+	case 375:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2783
+//line sql.y:2795
 		{
 			yyVAL.tableValuedFunctionArguments = nil
 		}
-	case 375:
+	case 376:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2787
+//line sql.y:2799
 		{
 			yyVAL.tableValuedFunctionArguments = yyDollar[1].tableValuedFunctionArguments
 		}
-	case 376:
+	case 377:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2793
+//line sql.y:2805
 		{
 			yyVAL.tableValuedFunctionArguments = TableValuedFunctionArguments{yyDollar[1].tableValuedFunctionArgument}
 		}
-	case 377:
+	case 378:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2797
+//line sql.y:2809
 		{
 			yyVAL.tableValuedFunctionArguments = append(yyVAL.tableValuedFunctionArguments, yyDollar[3].tableValuedFunctionArgument)
 		}
-	case 378:
+	case 379:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2803
+//line sql.y:2815
 		{
 			yyVAL.tableValuedFunctionArgument = &TableValuedFunctionArgument{Value: yyDollar[1].tableValuedFunctionArgumentValue}
 		}
-	case 379:
+	case 380:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2809
+//line sql.y:2821
 		{
 			yyVAL.tableValuedFunctionArgumentValue = &ExprTableValuedFunctionArgumentValue{Expr: yyDollar[1].expr}
 		}
-	case 380:
+	case 381:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2813
+//line sql.y:2825
 		{
 			yyVAL.tableValuedFunctionArgumentValue = &TableDescriptorTableValuedFunctionArgumentValue{Table: yyDollar[3].tableExpr}
 		}
-	case 381:
+	case 382:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2817
+//line sql.y:2829
 		{
 			yyVAL.tableValuedFunctionArgumentValue = &FieldDescriptorTableValuedFunctionArgumentValue{Field: yyDollar[3].colName}
 		}
-	case 382:
+	case 383:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2823
+//line sql.y:2835
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 383:
+	case 384:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2827
+//line sql.y:2839
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 384:
+	case 385:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2833
+//line sql.y:2845
 		{
 			yyVAL.partitions = Partitions{yyDollar[1].colIdent}
 		}
-	case 385:
+	case 386:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2837
+//line sql.y:2849
 		{
 			yyVAL.partitions = append(yyVAL.partitions, yyDollar[3].colIdent)
 		}
-	case 386:
+	case 387:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2850
+//line sql.y:2862
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 387:
+	case 388:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2854
+//line sql.y:2866
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 388:
+	case 389:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2858
+//line sql.y:2870
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 389:
+	case 390:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2862
+//line sql.y:2874
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr}
 		}
-	case 390:
+	case 391:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2868
+//line sql.y:2880
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
-	case 391:
+	case 392:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2872
+//line sql.y:2884
 		{
 			yyVAL.joinCondition = JoinCondition{Using: yyDollar[3].columns}
 		}
-	case 392:
+	case 393:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2878
+//line sql.y:2890
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 393:
+	case 394:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2882
+//line sql.y:2894
 		{
 			yyVAL.joinCondition = yyDollar[1].joinCondition
 		}
-	case 394:
+	case 395:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2888
+//line sql.y:2900
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 395:
+	case 396:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2892
+//line sql.y:2904
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
-	case 396:
+	case 397:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2897
+//line sql.y:2909
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 397:
+	case 398:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2901
+//line sql.y:2913
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 398:
+	case 399:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2906
+//line sql.y:2918
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 399:
+	case 400:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2910
+//line sql.y:2922
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
-	case 400:
+	case 401:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2914
+//line sql.y:2926
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 402:
+	case 403:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2921
+//line sql.y:2933
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 403:
+	case 404:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2927
+//line sql.y:2939
 		{
 			yyVAL.str = JoinStr
 		}
-	case 404:
+	case 405:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2931
+//line sql.y:2943
 		{
 			yyVAL.str = JoinStr
 		}
-	case 405:
+	case 406:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2935
+//line sql.y:2947
 		{
 			yyVAL.str = JoinStr
 		}
-	case 406:
+	case 407:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2941
+//line sql.y:2953
 		{
 			yyVAL.str = StraightJoinStr
 		}
-	case 407:
+	case 408:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2947
+//line sql.y:2959
 		{
 			yyVAL.str = LeftJoinStr
 		}
-	case 408:
+	case 409:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2951
+//line sql.y:2963
 		{
 			yyVAL.str = LeftJoinStr
 		}
-	case 409:
+	case 410:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2955
+//line sql.y:2967
 		{
 			yyVAL.str = RightJoinStr
 		}
-	case 410:
+	case 411:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2959
+//line sql.y:2971
 		{
 			yyVAL.str = RightJoinStr
 		}
-	case 411:
+	case 412:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2965
+//line sql.y:2977
 		{
 			yyVAL.str = NaturalJoinStr
 		}
-	case 412:
+	case 413:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2969
+//line sql.y:2981
 		{
 			if yyDollar[2].str == LeftJoinStr {
 				yyVAL.str = NaturalLeftJoinStr
@@ -6142,465 +6161,477 @@ yydefault:
 				yyVAL.str = NaturalRightJoinStr
 			}
 		}
-	case 413:
+	case 414:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2979
+//line sql.y:2991
 		{
 			yyVAL.tableName = yyDollar[2].tableName
 		}
-	case 414:
+	case 415:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2983
+//line sql.y:2995
 		{
 			yyVAL.tableName = yyDollar[1].tableName
 		}
-	case 415:
+	case 416:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2989
+//line sql.y:3001
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 416:
+	case 417:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2993
+//line sql.y:3005
 		{
 			yyVAL.tableName = TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}
 		}
-	case 417:
+	case 418:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2999
+//line sql.y:3011
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 418:
+	case 419:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:3004
+//line sql.y:3016
 		{
 			yyVAL.indexHints = nil
 		}
-	case 419:
+	case 420:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:3008
+//line sql.y:3020
 		{
 			yyVAL.indexHints = &IndexHints{Type: UseStr, Indexes: yyDollar[4].columns}
 		}
-	case 420:
+	case 421:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:3012
+//line sql.y:3024
 		{
 			yyVAL.indexHints = &IndexHints{Type: IgnoreStr, Indexes: yyDollar[4].columns}
 		}
-	case 421:
+	case 422:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:3016
+//line sql.y:3028
 		{
 			yyVAL.indexHints = &IndexHints{Type: ForceStr, Indexes: yyDollar[4].columns}
 		}
-	case 422:
+	case 423:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:3021
+//line sql.y:3033
 		{
 			yyVAL.expr = nil
 		}
-	case 423:
+	case 424:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:3025
+//line sql.y:3037
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 424:
+	case 425:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:3031
+//line sql.y:3043
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 425:
+	case 426:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:3035
+//line sql.y:3047
 		{
 			yyVAL.expr = &AndExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 426:
+	case 427:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:3039
+//line sql.y:3051
 		{
 			yyVAL.expr = &OrExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 427:
+	case 428:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:3043
+//line sql.y:3055
 		{
 			yyVAL.expr = &NotExpr{Expr: yyDollar[2].expr}
 		}
-	case 428:
+	case 429:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:3047
+//line sql.y:3059
 		{
 			yyVAL.expr = &IsExpr{Operator: yyDollar[3].str, Expr: yyDollar[1].expr}
 		}
-	case 429:
+	case 430:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:3051
+//line sql.y:3063
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 430:
+	case 431:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:3055
+//line sql.y:3067
 		{
 			yyVAL.expr = &Default{ColName: yyDollar[2].str}
 		}
-	case 431:
+	case 432:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:3061
+//line sql.y:3073
 		{
 			yyVAL.str = ""
 		}
-	case 432:
+	case 433:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:3065
+//line sql.y:3077
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 433:
+	case 434:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:3071
+//line sql.y:3083
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 434:
+	case 435:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:3075
+//line sql.y:3087
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 435:
+	case 436:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:3081
+//line sql.y:3093
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: yyDollar[2].str, Right: yyDollar[3].expr}
 		}
-	case 436:
+	case 437:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:3085
+//line sql.y:3097
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: InStr, Right: yyDollar[3].colTuple}
 		}
-	case 437:
+	case 438:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:3089
+//line sql.y:3101
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotInStr, Right: yyDollar[4].colTuple}
 		}
-	case 438:
+	case 439:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:3093
+//line sql.y:3105
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: LikeStr, Right: yyDollar[3].expr, Escape: yyDollar[4].expr}
 		}
-	case 439:
+	case 440:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:3097
+//line sql.y:3109
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotLikeStr, Right: yyDollar[4].expr, Escape: yyDollar[5].expr}
 		}
-	case 440:
+	case 441:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3113
+		{
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: ILikeStr, Right: yyDollar[3].expr, Escape: yyDollar[4].expr}
+		}
+	case 442:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3117
+		{
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotILikeStr, Right: yyDollar[4].expr, Escape: yyDollar[5].expr}
+		}
+	case 443:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:3101
+//line sql.y:3121
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: RegexpStr, Right: yyDollar[3].expr}
 		}
-	case 441:
+	case 444:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:3105
+//line sql.y:3125
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotRegexpStr, Right: yyDollar[4].expr}
 		}
-	case 442:
+	case 445:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:3109
+//line sql.y:3129
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: BetweenStr, From: yyDollar[3].expr, To: yyDollar[5].expr}
 		}
-	case 443:
+	case 446:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:3113
+//line sql.y:3133
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: NotBetweenStr, From: yyDollar[4].expr, To: yyDollar[6].expr}
 		}
-	case 444:
+	case 447:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:3117
+//line sql.y:3137
 		{
 			yyVAL.expr = &ExistsExpr{Subquery: yyDollar[2].subquery}
 		}
-	case 445:
+	case 448:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:3123
+//line sql.y:3143
 		{
 			yyVAL.str = IsNullStr
 		}
-	case 446:
+	case 449:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:3127
+//line sql.y:3147
 		{
 			yyVAL.str = IsNotNullStr
 		}
-	case 447:
+	case 450:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:3131
+//line sql.y:3151
 		{
 			yyVAL.str = IsTrueStr
 		}
-	case 448:
+	case 451:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:3135
+//line sql.y:3155
 		{
 			yyVAL.str = IsNotTrueStr
 		}
-	case 449:
+	case 452:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:3139
+//line sql.y:3159
 		{
 			yyVAL.str = IsFalseStr
 		}
-	case 450:
+	case 453:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:3143
+//line sql.y:3163
 		{
 			yyVAL.str = IsNotFalseStr
 		}
-	case 451:
+	case 454:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:3149
+//line sql.y:3169
 		{
 			yyVAL.str = EqualStr
 		}
-	case 452:
+	case 455:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:3153
+//line sql.y:3173
 		{
 			yyVAL.str = LessThanStr
 		}
-	case 453:
+	case 456:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:3157
+//line sql.y:3177
 		{
 			yyVAL.str = GreaterThanStr
 		}
-	case 454:
+	case 457:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:3161
+//line sql.y:3181
 		{
 			yyVAL.str = LessEqualStr
 		}
-	case 455:
+	case 458:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:3165
+//line sql.y:3185
 		{
 			yyVAL.str = GreaterEqualStr
 		}
-	case 456:
+	case 459:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:3169
+//line sql.y:3189
 		{
 			yyVAL.str = NotEqualStr
 		}
-	case 457:
+	case 460:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:3173
+//line sql.y:3193
 		{
 			yyVAL.str = NullSafeEqualStr
 		}
-	case 458:
+	case 461:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:3178
+//line sql.y:3198
 		{
 			yyVAL.expr = nil
 		}
-	case 459:
+	case 462:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:3182
+//line sql.y:3202
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 460:
+	case 463:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:3188
+//line sql.y:3208
 		{
 			yyVAL.colTuple = yyDollar[1].valTuple
 		}
-	case 461:
+	case 464:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:3192
+//line sql.y:3212
 		{
 			yyVAL.colTuple = yyDollar[1].subquery
 		}
-	case 462:
+	case 465:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:3196
+//line sql.y:3216
 		{
 			yyVAL.colTuple = ListArg(yyDollar[1].bytes)
 		}
-	case 463:
+	case 466:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:3202
+//line sql.y:3222
 		{
 			yyVAL.subquery = &Subquery{yyDollar[2].selStmt}
 		}
-	case 464:
+	case 467:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:3208
+//line sql.y:3228
 		{
 			yyVAL.exprs = Exprs{yyDollar[1].expr}
 		}
-	case 465:
+	case 468:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:3212
+//line sql.y:3232
 		{
 			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[3].expr)
 		}
-	case 466:
+	case 469:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:3218
+//line sql.y:3238
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 467:
+	case 470:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:3222
+//line sql.y:3242
 		{
 			yyVAL.expr = yyDollar[1].boolVal
 		}
-	case 468:
+	case 471:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:3226
+//line sql.y:3246
 		{
 			yyVAL.expr = yyDollar[1].colName
 		}
-	case 469:
+	case 472:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:3230
+//line sql.y:3250
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 470:
+	case 473:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:3234
+//line sql.y:3254
 		{
 			yyVAL.expr = yyDollar[1].subquery
 		}
-	case 471:
+	case 474:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:3238
+//line sql.y:3258
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitAndStr, Right: yyDollar[3].expr}
 		}
-	case 472:
+	case 475:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:3242
+//line sql.y:3262
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitOrStr, Right: yyDollar[3].expr}
 		}
-	case 473:
+	case 476:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:3246
+//line sql.y:3266
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitXorStr, Right: yyDollar[3].expr}
 		}
-	case 474:
+	case 477:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:3250
+//line sql.y:3270
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: PlusStr, Right: yyDollar[3].expr}
 		}
-	case 475:
+	case 478:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:3254
+//line sql.y:3274
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MinusStr, Right: yyDollar[3].expr}
 		}
-	case 476:
+	case 479:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:3258
+//line sql.y:3278
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MultStr, Right: yyDollar[3].expr}
 		}
-	case 477:
+	case 480:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:3262
+//line sql.y:3282
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: DivStr, Right: yyDollar[3].expr}
 		}
-	case 478:
+	case 481:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:3266
+//line sql.y:3286
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: IntDivStr, Right: yyDollar[3].expr}
 		}
-	case 479:
+	case 482:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:3270
+//line sql.y:3290
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
 		}
-	case 480:
+	case 483:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:3274
+//line sql.y:3294
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
 		}
-	case 481:
+	case 484:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:3278
+//line sql.y:3298
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftLeftStr, Right: yyDollar[3].expr}
 		}
-	case 482:
+	case 485:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:3282
+//line sql.y:3302
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftRightStr, Right: yyDollar[3].expr}
 		}
-	case 483:
+	case 486:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:3286
+//line sql.y:3306
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONExtractOp, Right: yyDollar[3].expr}
 		}
-	case 484:
+	case 487:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:3290
+//line sql.y:3310
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONUnquoteExtractOp, Right: yyDollar[3].expr}
 		}
-	case 485:
+	case 488:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:3294
+//line sql.y:3314
 		{
 			yyVAL.expr = &CollateExpr{Expr: yyDollar[1].expr, Charset: yyDollar[3].str}
 		}
-	case 486:
+	case 489:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:3298
+//line sql.y:3318
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BinaryStr, Expr: yyDollar[2].expr}
 		}
-	case 487:
+	case 490:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:3302
+//line sql.y:3322
 		{
 			yyVAL.expr = &UnaryExpr{Operator: UBinaryStr, Expr: yyDollar[2].expr}
 		}
-	case 488:
+	case 491:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:3306
+//line sql.y:3326
 		{
 			yyVAL.expr = &UnaryExpr{Operator: Utf8mb4Str, Expr: yyDollar[2].expr}
 		}
-	case 489:
+	case 492:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:3310
+//line sql.y:3330
 		{
 			if num, ok := yyDollar[2].expr.(*SQLVal); ok && num.Type == IntVal {
 				yyVAL.expr = num
@@ -6608,9 +6639,9 @@ yydefault:
 				yyVAL.expr = &UnaryExpr{Operator: UPlusStr, Expr: yyDollar[2].expr}
 			}
 		}
-	case 490:
+	case 493:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:3318
+//line sql.y:3338
 		{
 			if num, ok := yyDollar[2].expr.(*SQLVal); ok && num.Type == IntVal {
 				// Handle double negative
@@ -6624,21 +6655,21 @@ yydefault:
 				yyVAL.expr = &UnaryExpr{Operator: UMinusStr, Expr: yyDollar[2].expr}
 			}
 		}
-	case 491:
+	case 494:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:3332
+//line sql.y:3352
 		{
 			yyVAL.expr = &UnaryExpr{Operator: TildaStr, Expr: yyDollar[2].expr}
 		}
-	case 492:
+	case 495:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:3336
+//line sql.y:3356
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BangStr, Expr: yyDollar[2].expr}
 		}
-	case 493:
+	case 496:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:3340
+//line sql.y:3360
 		{
 			// This rule prevents the usage of INTERVAL
 			// as a function. If support is needed for that,
@@ -6646,485 +6677,491 @@ yydefault:
 			// will be non-trivial because of grammar conflicts.
 			yyVAL.expr = &IntervalExpr{Expr: yyDollar[2].expr, Unit: yyDollar[3].colIdent.String()}
 		}
-	case 498:
+	case 501:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:3358
+//line sql.y:3378
 		{
 			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs}
 		}
-	case 499:
+	case 502:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:3362
+//line sql.y:3382
 		{
 			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs}
 		}
-	case 500:
+	case 503:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:3366
+//line sql.y:3386
 		{
 			yyVAL.expr = &FuncExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprs}
 		}
-	case 501:
+	case 504:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:3376
+//line sql.y:3396
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("left"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 502:
+	case 505:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:3380
+//line sql.y:3400
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("right"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 503:
+	case 506:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:3384
+//line sql.y:3404
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
 		}
-	case 504:
+	case 507:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:3388
+//line sql.y:3408
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
 		}
-	case 505:
+	case 508:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:3392
+//line sql.y:3412
 		{
 			yyVAL.expr = &ConvertUsingExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].str}
 		}
-	case 506:
+	case 509:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line sql.y:3396
+//line sql.y:3416
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 507:
+	case 510:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line sql.y:3400
+//line sql.y:3420
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 508:
+	case 511:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line sql.y:3404
+//line sql.y:3424
 		{
 			yyVAL.expr = &SubstrExpr{StrVal: NewStrVal(yyDollar[3].bytes), From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 509:
+	case 512:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line sql.y:3408
+//line sql.y:3428
 		{
 			yyVAL.expr = &SubstrExpr{StrVal: NewStrVal(yyDollar[3].bytes), From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 510:
+	case 513:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line sql.y:3412
+//line sql.y:3432
 		{
 			yyVAL.expr = &MatchExpr{Columns: yyDollar[3].selectExprs, Expr: yyDollar[7].expr, Option: yyDollar[8].str}
 		}
-	case 511:
+	case 514:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3437
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("match"), Exprs: yyDollar[3].selectExprs}
+		}
+	case 515:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line sql.y:3416
+//line sql.y:3441
 		{
 			yyVAL.expr = &GroupConcatExpr{Distinct: yyDollar[3].str, Exprs: yyDollar[4].selectExprs, OrderBy: yyDollar[5].orderBy, Separator: yyDollar[6].str}
 		}
-	case 512:
+	case 516:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:3420
+//line sql.y:3445
 		{
 			yyVAL.expr = &CaseExpr{Expr: yyDollar[2].expr, Whens: yyDollar[3].whens, Else: yyDollar[4].expr}
 		}
-	case 513:
+	case 517:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:3424
+//line sql.y:3449
 		{
 			yyVAL.expr = &ValuesFuncExpr{Name: yyDollar[3].colName}
 		}
-	case 514:
+	case 518:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:3434
+//line sql.y:3459
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_timestamp")}
 		}
-	case 515:
+	case 519:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:3438
+//line sql.y:3463
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_timestamp")}
 		}
-	case 516:
+	case 520:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:3442
+//line sql.y:3467
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_time")}
 		}
-	case 517:
+	case 521:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:3447
+//line sql.y:3472
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_date")}
 		}
-	case 518:
+	case 522:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:3452
+//line sql.y:3477
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtime")}
 		}
-	case 519:
+	case 523:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:3457
+//line sql.y:3482
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtimestamp")}
 		}
-	case 520:
+	case 524:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:3463
+//line sql.y:3488
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_date")}
 		}
-	case 521:
+	case 525:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:3468
+//line sql.y:3493
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_time")}
 		}
-	case 522:
+	case 526:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:3473
+//line sql.y:3498
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("current_timestamp"), Fsp: yyDollar[2].expr}
 		}
-	case 523:
+	case 527:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:3477
+//line sql.y:3502
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("utc_timestamp"), Fsp: yyDollar[2].expr}
 		}
-	case 524:
+	case 528:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:3481
+//line sql.y:3506
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("utc_time"), Fsp: yyDollar[2].expr}
 		}
-	case 525:
+	case 529:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:3486
+//line sql.y:3511
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("localtime"), Fsp: yyDollar[2].expr}
 		}
-	case 526:
+	case 530:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:3491
+//line sql.y:3516
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("localtimestamp"), Fsp: yyDollar[2].expr}
 		}
-	case 527:
+	case 531:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:3496
+//line sql.y:3521
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("current_time"), Fsp: yyDollar[2].expr}
 		}
-	case 528:
+	case 532:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line sql.y:3500
+//line sql.y:3525
 		{
 			yyVAL.expr = &TimestampFuncExpr{Name: string("timestampadd"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].expr, Expr2: yyDollar[7].expr}
 		}
-	case 529:
+	case 533:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line sql.y:3504
+//line sql.y:3529
 		{
 			yyVAL.expr = &TimestampFuncExpr{Name: string("timestampdiff"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].expr, Expr2: yyDollar[7].expr}
 		}
-	case 532:
+	case 536:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:3515
+//line sql.y:3540
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 533:
+	case 537:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:3525
+//line sql.y:3550
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("if"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 534:
+	case 538:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:3529
+//line sql.y:3554
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("database"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 535:
+	case 539:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:3533
+//line sql.y:3558
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("mod"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 536:
+	case 540:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:3537
+//line sql.y:3562
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("replace"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 537:
+	case 541:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:3541
+//line sql.y:3566
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 538:
+	case 542:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:3545
+//line sql.y:3570
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 539:
+	case 543:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:3551
+//line sql.y:3576
 		{
 			yyVAL.str = ""
 		}
-	case 540:
+	case 544:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:3555
+//line sql.y:3580
 		{
 			yyVAL.str = BooleanModeStr
 		}
-	case 541:
+	case 545:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:3559
+//line sql.y:3584
 		{
 			yyVAL.str = NaturalLanguageModeStr
 		}
-	case 542:
+	case 546:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line sql.y:3563
+//line sql.y:3588
 		{
 			yyVAL.str = NaturalLanguageModeWithQueryExpansionStr
 		}
-	case 543:
+	case 547:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:3567
+//line sql.y:3592
 		{
 			yyVAL.str = QueryExpansionStr
 		}
-	case 544:
+	case 548:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:3573
+//line sql.y:3598
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 545:
+	case 549:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:3577
+//line sql.y:3602
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 546:
+	case 550:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:3583
+//line sql.y:3608
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 547:
+	case 551:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:3587
+//line sql.y:3612
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: yyDollar[3].str, Operator: CharacterSetStr}
 		}
-	case 548:
+	case 552:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:3591
+//line sql.y:3616
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: string(yyDollar[3].bytes)}
 		}
-	case 549:
+	case 553:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:3595
+//line sql.y:3620
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 550:
+	case 554:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:3599
+//line sql.y:3624
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 551:
+	case 555:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:3603
+//line sql.y:3628
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 			yyVAL.convertType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.convertType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 552:
+	case 556:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:3609
+//line sql.y:3634
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 553:
+	case 557:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:3613
+//line sql.y:3638
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 554:
+	case 558:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:3617
+//line sql.y:3642
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 555:
+	case 559:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:3621
+//line sql.y:3646
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 556:
+	case 560:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:3625
+//line sql.y:3650
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 557:
+	case 561:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:3629
+//line sql.y:3654
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 558:
+	case 562:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:3633
+//line sql.y:3658
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 559:
+	case 563:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:3638
+//line sql.y:3663
 		{
 			yyVAL.expr = nil
 		}
-	case 560:
+	case 564:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:3642
+//line sql.y:3667
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 561:
+	case 565:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:3647
+//line sql.y:3672
 		{
 			yyVAL.str = string("")
 		}
-	case 562:
+	case 566:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:3651
+//line sql.y:3676
 		{
 			yyVAL.str = " separator '" + string(yyDollar[2].bytes) + "'"
 		}
-	case 563:
+	case 567:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:3657
+//line sql.y:3682
 		{
 			yyVAL.whens = []*When{yyDollar[1].when}
 		}
-	case 564:
+	case 568:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:3661
+//line sql.y:3686
 		{
 			yyVAL.whens = append(yyDollar[1].whens, yyDollar[2].when)
 		}
-	case 565:
+	case 569:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:3667
+//line sql.y:3692
 		{
 			yyVAL.when = &When{Cond: yyDollar[2].expr, Val: yyDollar[4].expr}
 		}
-	case 566:
+	case 570:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:3672
+//line sql.y:3697
 		{
 			yyVAL.expr = nil
 		}
-	case 567:
+	case 571:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:3676
+//line sql.y:3701
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 568:
+	case 572:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:3682
+//line sql.y:3707
 		{
 			yyVAL.colName = &ColName{Name: yyDollar[1].colIdent}
 		}
-	case 569:
+	case 573:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:3686
+//line sql.y:3711
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Name: yyDollar[1].tableIdent}, Name: yyDollar[3].colIdent}
 		}
-	case 570:
+	case 574:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:3690
+//line sql.y:3715
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}, Name: yyDollar[5].colIdent}
 		}
-	case 571:
+	case 575:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:3696
+//line sql.y:3721
 		{
 			yyVAL.expr = NewStrVal(yyDollar[1].bytes)
 		}
-	case 572:
+	case 576:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:3700
+//line sql.y:3725
 		{
 			yyVAL.expr = NewHexVal(yyDollar[1].bytes)
 		}
-	case 573:
+	case 577:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:3704
+//line sql.y:3729
 		{
 			yyVAL.expr = NewBitVal(yyDollar[1].bytes)
 		}
-	case 574:
+	case 578:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:3708
+//line sql.y:3733
 		{
 			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
 		}
-	case 575:
+	case 579:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:3712
+//line sql.y:3737
 		{
 			yyVAL.expr = NewFloatVal(yyDollar[1].bytes)
 		}
-	case 576:
+	case 580:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:3716
+//line sql.y:3741
 		{
 			yyVAL.expr = NewHexNum(yyDollar[1].bytes)
 		}
-	case 577:
+	case 581:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:3720
+//line sql.y:3745
 		{
 			yyVAL.expr = NewValArg(yyDollar[1].bytes)
 		}
-	case 578:
+	case 582:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:3724
+//line sql.y:3749
 		{
 			yyVAL.expr = &NullVal{}
 		}
-	case 579:
+	case 583:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:3730
+//line sql.y:3755
 		{
 			// TODO(sougou): Deprecate this construct.
 			if yyDollar[1].colIdent.Lowered() != "value" {
@@ -7133,251 +7170,263 @@ yydefault:
 			}
 			yyVAL.expr = NewIntVal([]byte("1"))
 		}
-	case 580:
+	case 584:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:3739
+//line sql.y:3764
 		{
 			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
 		}
-	case 581:
+	case 585:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:3743
+//line sql.y:3768
 		{
 			yyVAL.expr = NewValArg(yyDollar[1].bytes)
 		}
-	case 582:
+	case 586:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:3748
+//line sql.y:3773
 		{
 			yyVAL.exprs = nil
 		}
-	case 583:
+	case 587:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:3752
+//line sql.y:3777
 		{
 			yyVAL.exprs = yyDollar[3].exprs
 		}
-	case 584:
+	case 588:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:3757
+//line sql.y:3782
 		{
 			yyVAL.expr = nil
 		}
-	case 585:
+	case 589:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:3761
+//line sql.y:3786
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 586:
+	case 590:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:3766
+//line sql.y:3791
 		{
 			yyVAL.orderBy = nil
 		}
-	case 587:
+	case 591:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:3770
+//line sql.y:3795
 		{
 			yyVAL.orderBy = yyDollar[3].orderBy
 		}
-	case 588:
+	case 592:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:3776
+//line sql.y:3801
 		{
 			yyVAL.orderBy = OrderBy{yyDollar[1].order}
 		}
-	case 589:
+	case 593:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:3780
+//line sql.y:3805
 		{
 			yyVAL.orderBy = append(yyDollar[1].orderBy, yyDollar[3].order)
 		}
-	case 590:
+	case 594:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:3786
+//line sql.y:3811
 		{
 			yyVAL.order = &Order{Expr: yyDollar[1].expr, Direction: yyDollar[2].str}
 		}
-	case 591:
+	case 595:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:3791
+//line sql.y:3816
 		{
 			yyVAL.str = AscScr
 		}
-	case 592:
+	case 596:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:3795
+//line sql.y:3820
 		{
 			yyVAL.str = AscScr
 		}
-	case 593:
+	case 597:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:3799
+//line sql.y:3824
 		{
 			yyVAL.str = DescScr
 		}
-	case 594:
+	case 598:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:3804
+//line sql.y:3829
 		{
 			yyVAL.limit = nil
 		}
-	case 595:
+	case 599:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:3808
+//line sql.y:3833
 		{
 			yyVAL.limit = &Limit{Rowcount: yyDollar[2].expr}
 		}
-	case 596:
+	case 600:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:3812
+//line sql.y:3837
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[2].expr, Rowcount: yyDollar[4].expr}
 		}
-	case 597:
+	case 601:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:3816
+//line sql.y:3841
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[4].expr, Rowcount: yyDollar[2].expr}
 		}
-	case 598:
+	case 602:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:3821
+//line sql.y:3846
 		{
 			yyVAL.str = ""
 		}
-	case 599:
+	case 603:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:3825
+//line sql.y:3850
 		{
 			yyVAL.str = ForUpdateStr
 		}
-	case 600:
+	case 604:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:3829
+//line sql.y:3854
 		{
 			yyVAL.str = ShareModeStr
 		}
-	case 601:
+	case 605:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:3834
+//line sql.y:3859
+		{
+			yyVAL.setExprs = nil
+		}
+	case 606:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3863
+		{
+			yyVAL.setExprs = yyDollar[2].setExprs
+		}
+	case 607:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3868
 		{
 			yyVAL.formats = nil
 		}
-	case 602:
+	case 608:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:3838
+//line sql.y:3872
 		{
-			yyVAL.formats = &Formats{FormatName: string(yyDollar[2].bytes)}
+			yyVAL.formats = &Formats{FormatName: yyDollar[2].colIdent.String()}
 		}
-	case 603:
+	case 609:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:3851
+//line sql.y:3885
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[2].values}
 		}
-	case 604:
+	case 610:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:3855
+//line sql.y:3889
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[1].selStmt}
 		}
-	case 605:
+	case 611:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:3859
+//line sql.y:3893
 		{
 			// Drop the redundant parenthesis.
 			yyVAL.ins = &Insert{Rows: yyDollar[2].selStmt}
 		}
-	case 606:
+	case 612:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:3864
+//line sql.y:3898
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].values}
 		}
-	case 607:
+	case 613:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:3868
+//line sql.y:3902
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[4].selStmt}
 		}
-	case 608:
+	case 614:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:3872
+//line sql.y:3906
 		{
 			// Drop the redundant parenthesis.
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].selStmt}
 		}
-	case 609:
+	case 615:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:3879
+//line sql.y:3913
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 610:
+	case 616:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:3883
+//line sql.y:3917
 		{
 			yyVAL.columns = Columns{yyDollar[3].colIdent}
 		}
-	case 611:
+	case 617:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:3887
+//line sql.y:3921
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 612:
+	case 618:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:3891
+//line sql.y:3925
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[5].colIdent)
 		}
-	case 613:
+	case 619:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:3896
+//line sql.y:3930
 		{
 			yyVAL.updateExprs = nil
 		}
-	case 614:
+	case 620:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:3900
+//line sql.y:3934
 		{
 			yyVAL.updateExprs = yyDollar[5].updateExprs
 		}
-	case 615:
+	case 621:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:3906
+//line sql.y:3940
 		{
 			yyVAL.values = Values{yyDollar[1].valTuple}
 		}
-	case 616:
+	case 622:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:3910
+//line sql.y:3944
 		{
 			yyVAL.values = append(yyDollar[1].values, yyDollar[3].valTuple)
 		}
-	case 617:
+	case 623:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:3916
+//line sql.y:3950
 		{
 			yyVAL.valTuple = yyDollar[1].valTuple
 		}
-	case 618:
+	case 624:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:3920
+//line sql.y:3954
 		{
 			yyVAL.valTuple = ValTuple{}
 		}
-	case 619:
+	case 625:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:3926
+//line sql.y:3960
 		{
 			yyVAL.valTuple = ValTuple(yyDollar[2].exprs)
 		}
-	case 620:
+	case 626:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:3932
+//line sql.y:3966
 		{
 			if len(yyDollar[1].valTuple) == 1 {
 				yyVAL.expr = &ParenExpr{yyDollar[1].valTuple[0]}
@@ -7385,312 +7434,312 @@ yydefault:
 				yyVAL.expr = yyDollar[1].valTuple
 			}
 		}
-	case 621:
+	case 627:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:3942
+//line sql.y:3976
 		{
 			yyVAL.updateExprs = UpdateExprs{yyDollar[1].updateExpr}
 		}
-	case 622:
+	case 628:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:3946
+//line sql.y:3980
 		{
 			yyVAL.updateExprs = append(yyDollar[1].updateExprs, yyDollar[3].updateExpr)
 		}
-	case 623:
+	case 629:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:3952
+//line sql.y:3986
 		{
 			yyVAL.updateExpr = &UpdateExpr{Name: yyDollar[1].colName, Expr: yyDollar[3].expr}
 		}
-	case 624:
+	case 630:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:3958
+//line sql.y:3992
 		{
 			yyVAL.setExprs = SetExprs{yyDollar[1].setExpr}
 		}
-	case 625:
+	case 631:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:3962
+//line sql.y:3996
 		{
 			yyVAL.setExprs = append(yyDollar[1].setExprs, yyDollar[3].setExpr)
 		}
-	case 626:
+	case 632:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:3968
+//line sql.y:4002
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: NewStrVal([]byte("on"))}
 		}
-	case 627:
+	case 633:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:3972
+//line sql.y:4006
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: NewStrVal([]byte("off"))}
 		}
-	case 628:
+	case 634:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:3976
+//line sql.y:4010
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: yyDollar[3].expr}
 		}
-	case 629:
+	case 635:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:3980
+//line sql.y:4014
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Expr: yyDollar[2].expr}
 		}
-	case 631:
+	case 637:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:3987
+//line sql.y:4021
 		{
 			yyVAL.bytes = []byte("charset")
 		}
-	case 633:
+	case 639:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:3994
+//line sql.y:4028
 		{
 			yyVAL.expr = NewStrVal([]byte(yyDollar[1].colIdent.String()))
 		}
-	case 634:
+	case 640:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:3998
+//line sql.y:4032
 		{
 			yyVAL.expr = NewStrVal(yyDollar[1].bytes)
 		}
-	case 635:
+	case 641:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:4002
+//line sql.y:4036
 		{
 			yyVAL.expr = &Default{}
 		}
-	case 638:
+	case 644:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:4011
+//line sql.y:4045
 		{
 			yyVAL.byt = 0
 		}
-	case 639:
+	case 645:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:4015
+//line sql.y:4049
 		{
 			yyVAL.byt = 1
 		}
-	case 640:
+	case 646:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:4020
+//line sql.y:4054
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 641:
+	case 647:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:4024
+//line sql.y:4058
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 642:
+	case 648:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:4029
+//line sql.y:4063
 		{
 			yyVAL.str = ""
 		}
-	case 643:
+	case 649:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:4033
+//line sql.y:4067
 		{
 			yyVAL.str = IgnoreStr
 		}
-	case 644:
+	case 650:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:4039
+//line sql.y:4073
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 645:
+	case 651:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:4043
+//line sql.y:4077
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 646:
+	case 652:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:4047
+//line sql.y:4081
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 647:
+	case 653:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:4051
+//line sql.y:4085
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 648:
+	case 654:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:4055
+//line sql.y:4089
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 649:
+	case 655:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:4059
+//line sql.y:4093
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 650:
+	case 656:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:4063
+//line sql.y:4097
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 651:
+	case 657:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:4067
+//line sql.y:4101
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 652:
+	case 658:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:4071
+//line sql.y:4105
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 653:
+	case 659:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:4075
+//line sql.y:4109
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 654:
+	case 660:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:4080
+//line sql.y:4114
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 655:
+	case 661:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:4084
+//line sql.y:4118
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 656:
+	case 662:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:4088
+//line sql.y:4122
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 657:
+	case 663:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:4094
+//line sql.y:4128
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 658:
+	case 664:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:4098
+//line sql.y:4132
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 659:
+	case 665:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:4103
+//line sql.y:4137
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 660:
+	case 666:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:4107
+//line sql.y:4141
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 661:
+	case 667:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:4111
+//line sql.y:4145
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 662:
+	case 668:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:4116
+//line sql.y:4150
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 663:
+	case 669:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:4120
+//line sql.y:4154
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 664:
+	case 670:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:4126
+//line sql.y:4160
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 665:
+	case 671:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:4130
+//line sql.y:4164
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 667:
+	case 673:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:4137
+//line sql.y:4171
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 668:
+	case 674:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:4143
+//line sql.y:4177
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 669:
+	case 675:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:4147
+//line sql.y:4181
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 671:
+	case 677:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:4154
+//line sql.y:4188
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 959:
+	case 968:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:4468
+//line sql.y:4505
 		{
 			if incNesting(yylex) {
 				yylex.Error("max nesting level reached")
 				return 1
 			}
 		}
-	case 960:
+	case 969:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:4477
+//line sql.y:4514
 		{
 			decNesting(yylex)
 		}
-	case 961:
+	case 970:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:4482
+//line sql.y:4519
 		{
 			skipToEnd(yylex)
 		}
-	case 962:
+	case 971:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:4487
+//line sql.y:4524
 		{
 			skipToEnd(yylex)
 		}
-	case 963:
+	case 972:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:4491
+//line sql.y:4528
 		{
 			skipToEnd(yylex)
 		}
-	case 964:
+	case 973:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:4495
+//line sql.y:4532
 		{
 			skipToEnd(yylex)
 		}
@@ -144,6 +144,7 @@ func skipToEnd(yylex interface{}) {
 	OFFSET
 	FOR
 	FORMAT
+	SETTINGS
 
 %token	<bytes>
 	ALL
@@ -191,6 +192,10 @@ func skipToEnd(yylex interface{}) {
 	ON
 	USING
 
+// The MATCH AGAINST is taken over the match function, see function_call_keyword.
+%nonassoc	<bytes>
+	AGAINST
+
 %token	<empty>
 	'('
 	','
@@ -248,6 +253,7 @@ func skipToEnd(yylex interface{}) {
 	NULL_SAFE_EQUAL
 	IS
 	LIKE
+	ILIKE
 	REGEXP
 	IN
 
@@ -315,6 +321,7 @@ func skipToEnd(yylex interface{}) {
 %token	<bytes>
 	SCHEMA
 	TABLE
+	TEMPORARY
 	DESCRIPTOR
 	INDEX
 	VIEW
@@ -546,7 +553,6 @@ func skipToEnd(yylex interface{}) {
 // Match
 %token	<bytes>
 	MATCH
-	AGAINST
 	BOOLEAN
 	LANGUAGE
 	WITH
@@ -871,7 +877,7 @@ func skipToEnd(yylex interface{}) {
 	update_list
 
 %type	<setExprs>
-	set_list
+	set_list settings_opt
 	transaction_chars
 
 %type	<bytes>
@@ -1112,13 +1118,14 @@ command:
 	}
 
 select_statement:
-	base_select order_by_opt limit_opt lock_opt formats_opt
+	base_select order_by_opt limit_opt lock_opt settings_opt formats_opt
 	{
 		sel := $1.(*Select)
 		sel.OrderBy = $2
 		sel.Limit = $3
 		sel.Lock = $4
-		sel.Formats = $5
+		sel.Settings = $5
+		sel.Formats = $6
 		$$ = sel
 	}
 |	union_lhs union_op union_rhs order_by_opt limit_opt lock_opt
@@ -1409,6 +1416,11 @@ create_table_prefix:
 		$$ = &DDL{Action: CreateStr, Table: $4}
 		setDDL(yylex, $$)
 	}
+|	CREATE TEMPORARY TABLE not_exists_opt table_name
+	{
+		$$ = &DDL{Action: CreateStr, Table: $5, Temporary: true}
+		setDDL(yylex, $$)
+	}
 
 table_spec:
 	'(' table_column_list ')' table_option
@@ -3097,6 +3109,14 @@ condition:
 	{
 		$$ = &ComparisonExpr{Left: $1, Operator: NotLikeStr, Right: $4, Escape: $5}
 	}
+|	value_expression ILIKE value_expression like_escape_opt
+	{
+		$$ = &ComparisonExpr{Left: $1, Operator: ILikeStr, Right: $3, Escape: $4}
+	}
+|	value_expression NOT ILIKE value_expression like_escape_opt
+	{
+		$$ = &ComparisonExpr{Left: $1, Operator: NotILikeStr, Right: $4, Escape: $5}
+	}
 |	value_expression REGEXP value_expression
 	{
 		$$ = &ComparisonExpr{Left: $1, Operator: RegexpStr, Right: $3}
@@ -3412,6 +3432,11 @@ function_call_keyword:
 	{
 		$$ = &MatchExpr{Columns: $3, Expr: $7, Option: $8}
 	}
+|	MATCH openb select_expression_list closeb
+	%prec JOIN
+	{
+		$$ = &FuncExpr{Name: NewColIdent("match"), Exprs: $3}
+	}
 |	GROUP_CONCAT openb distinct_opt select_expression_list order_by_opt separator_opt closeb
 	{
 		$$ = &GroupConcatExpr{Distinct: $3, Exprs: $4, OrderBy: $5, Separator: $6}
@@ -3830,13 +3855,22 @@ lock_opt:
 		$$ = ShareModeStr
 	}
 
+settings_opt:
+	{
+		$$ = nil
+	}
+|	SETTINGS set_list
+	{
+		$$ = $2
+	}
+
 formats_opt:
 	{
 		$$ = nil
 	}
-|	FORMAT ID
+|	FORMAT sql_id
 	{
-		$$ = &Formats{FormatName: string($2)}
+		$$ = &Formats{FormatName: $2.String()}
 	}
 
 // insert_data expands all combinations into a single rule.
@@ -4227,6 +4261,7 @@ reserved_keyword:
 |	LEAD
 |	LEFT
 |	LIKE
+|	ILIKE
 |	LIMIT
 |	LOCALTIME
 |	LOCALTIMESTAMP
@@ -4284,6 +4319,7 @@ reserved_keyword:
 |	WHERE
 |	WINDOW
 |	FORMAT
+|	SETTINGS
 
 /*
   These are non-reserved Vitess, because they don't cause conflicts in the grammar.
@@ -4431,6 +4467,7 @@ non_reserved_keyword:
 |	START
 |	STATUS
 |	TABLES
+|	TEMPORARY
 |	TEXT
 |	THAN
 |	THREAD_PRIORITY
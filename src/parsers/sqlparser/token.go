@@ -187,6 +187,7 @@ var keywords = map[string]int{
 	"for":                 FOR,
 	"force":               FORCE,
 	"foreign":             FOREIGN,
+	"format":              FORMAT,
 	"from":                FROM,
 	"full":                FULL,
 	"fulltext":            FULLTEXT,
@@ -205,6 +206,7 @@ var keywords = map[string]int{
 	"hour_second":         UNUSED,
 	"if":                  IF,
 	"ignore":              IGNORE,
+	"ilike":               ILIKE,
 	"in":                  IN,
 	"index":               INDEX,
 	"infile":              UNUSED,
@@ -327,6 +329,7 @@ var keywords = map[string]int{
 	"serializable":        SERIALIZABLE,
 	"session":             SESSION,
 	"set":                 SET,
+	"settings":            SETTINGS,
 	"share":               SHARE,
 	"show":                SHOW,
 	"signal":              UNUSED,
@@ -352,6 +355,7 @@ var keywords = map[string]int{
 	"stream":              STREAM,
 	"table":               TABLE,
 	"tables":              TABLES,
+	"temporary":           TEMPORARY,
 	"terminated":          UNUSED,
 	"text":                TEXT,
 	"than":                THAN,
@@ -409,6 +413,7 @@ var keywords = map[string]int{
 var keywordsExtend = map[string]int{
 	"Date":     DATE,
 	"Datetime": DATETIME,
+	"DateTime": DATETIME,
 	"Enum8":    ENUM8,
 	"Enum16":   ENUM16,
 	"Float32":  FLOAT32,
@@ -424,7 +429,6 @@ var keywordsExtend = map[string]int{
 	"UInt32":   UINT32,
 	"UInt64":   UINT64,
 	"UUID":     UUID,
-	"FORMAT":   FORMAT,
 }
 
 // keywordStrings contains the reverse mapping of token to keyword strings
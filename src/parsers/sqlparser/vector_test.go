@@ -43,6 +43,15 @@ func TestVectors(t *testing.T) {
 	}, {
 		input:  "create table t1(a int) engine=File(JSON)",
 		output: "create table t1 (\n\ta int\n)ENGINE = File(JSON)",
+	}, {
+		input:  "create temporary table t1(a int)",
+		output: "create temporary table t1 (\n\ta int\n)",
+	}, {
+		input:  "create temporary table if not exists t1(a int) engine=Memory",
+		output: "create temporary table t1 (\n\ta int\n)ENGINE = Memory",
+	}, {
+		input:  "select temporary from t1",
+		output: "select `temporary` from t1",
 	}}
 	for _, tcase := range validSQL {
 		if tcase.output == "" {
@@ -11,6 +11,7 @@ import (
 	"strings"
 
 	"base/errors"
+	"datavalues"
 	"parsers/sqlparser"
 )
 
@@ -46,36 +47,28 @@ func parseExpression(aliases map[string]IPlan, expr sqlparser.Expr) (IPlan, erro
 		return NewConstantPlan(val), nil
 	case *sqlparser.FuncExpr:
 		funcName := strings.ToUpper(expr.Name.String())
-		switch len(expr.Exprs) {
-		case 1:
-			expr, err := parseFunctionArgument(aliases, expr.Exprs[0].(*sqlparser.AliasedExpr))
-			if err != nil {
-				return nil, err
-			}
-			return NewUnaryExpressionPlan(funcName, expr), nil
-		case 2:
-			left, err := parseFunctionArgument(aliases, expr.Exprs[0].(*sqlparser.AliasedExpr))
-			if err != nil {
-				return nil, err
+		// count() and count(*) are the count of all the rows, as count(1).
+		if funcName == "COUNT" && isCountAll(expr.Exprs) {
+			return NewUnaryExpressionPlan(funcName, NewConstantPlan(1)), nil
+		}
+		args := make([]IPlan, len(expr.Exprs))
+		for i, expr := range expr.Exprs {
+			aliased, ok := expr.(*sqlparser.AliasedExpr)
+			if !ok {
+				return nil, errors.ErrorWithCode(errors.NOT_IMPLEMENTED, "Unsupported argument %v of type %v", sqlparser.String(expr), reflect.TypeOf(expr))
 			}
-			right, err := parseFunctionArgument(aliases, expr.Exprs[1].(*sqlparser.AliasedExpr))
+			arg, err := parseFunctionArgument(aliases, aliased)
 			if err != nil {
 				return nil, err
 			}
-			return NewBinaryExpressionPlan(funcName, left, right), nil
+			args[i] = arg
+		}
+		switch len(args) {
+		case 1:
+			return NewUnaryExpressionPlan(funcName, args[0]), nil
+		case 2:
+			return NewBinaryExpressionPlan(funcName, args[0], args[1]), nil
 		default:
-			args := make([]IPlan, len(expr.Exprs))
-			for i, expr := range expr.Exprs {
-				aliased, ok := expr.(*sqlparser.AliasedExpr)
-				if !ok {
-					return nil, errors.Errorf("Unsupported argument %v of type %v", expr, reflect.TypeOf(expr))
-				}
-				arg, err := parseFunctionArgument(aliases, aliased)
-				if err != nil {
-					return nil, err
-				}
-				args[i] = arg
-			}
 			return NewFunctionExpressionPlan(funcName, args...), nil
 		}
 	case *sqlparser.BinaryExpr:
@@ -89,6 +82,10 @@ func parseExpression(aliases map[string]IPlan, expr sqlparser.Expr) (IPlan, erro
 		}
 		return NewBinaryExpressionPlan(expr.Operator, left, right), nil
 	case *sqlparser.ComparisonExpr:
+		// The backslash escapes the wildcards of the LIKE, no other escape character.
+		if expr.Escape != nil {
+			return nil, errors.ErrorWithCode(errors.NOT_IMPLEMENTED, "Unsupported ESCAPE %v of %v", sqlparser.String(expr.Escape), strings.ToUpper(expr.Operator))
+		}
 		left, err := parseExpression(aliases, expr.Left)
 		if err != nil {
 			return nil, err
@@ -121,7 +118,7 @@ func parseExpression(aliases map[string]IPlan, expr sqlparser.Expr) (IPlan, erro
 	case *sqlparser.ParenExpr:
 		return parseExpression(aliases, expr.Expr)
 	}
-	return nil, errors.Errorf("Unsupported expression %+v %+v", expr, reflect.TypeOf(expr))
+	return nil, errors.ErrorWithCode(errors.NOT_IMPLEMENTED, "Unsupported expression %+v %+v", expr, reflect.TypeOf(expr))
 }
 
 func parseFunctionArgument(aliases map[string]IPlan, expr *sqlparser.AliasedExpr) (IPlan, error) {
@@ -132,12 +129,24 @@ func parseFunctionArgument(aliases map[string]IPlan, expr *sqlparser.AliasedExpr
 	return subExpr, nil
 }
 
+// isCountAll reports whether the arguments of the count are none or the star.
+func isCountAll(exprs sqlparser.SelectExprs) bool {
+	switch len(exprs) {
+	case 0:
+		return true
+	case 1:
+		_, ok := exprs[0].(*sqlparser.StarExpr)
+		return ok
+	}
+	return false
+}
+
 func parseAliasedTableExpression(expr *sqlparser.AliasedTableExpr) (IPlan, error) {
 	switch subExpr := expr.Expr.(type) {
 	case sqlparser.TableName:
 		return NewScanPlan(subExpr.Name.String(), subExpr.Qualifier.String()), nil
 	default:
-		return nil, errors.Errorf("Unsupported aliased table expression:%+v", expr.Expr)
+		return nil, errors.ErrorWithCode(errors.NOT_IMPLEMENTED, "Unsupported aliased table expression:%+v", expr.Expr)
 	}
 }
 
@@ -179,7 +188,7 @@ func parseFrom(expr sqlparser.TableExpr) (IPlan, error) {
 	case *sqlparser.TableValuedFunction:
 		return parseTableValuedFunction(nil, expr)
 	default:
-		return nil, errors.Errorf("Unsupported table expression:%+v", expr)
+		return nil, errors.ErrorWithCode(errors.NOT_IMPLEMENTED, "Unsupported table expression:%+v", expr)
 	}
 }
 
@@ -239,7 +248,16 @@ func parseOrderBy(orderBy sqlparser.OrderBy) ([]Order, error) {
 	orders := make([]Order, len(orderBy))
 
 	for i, field := range orderBy {
-		expr, err := parseExpression(nil, field.Expr)
+		node := field.Expr
+		if collate, ok := node.(*sqlparser.CollateExpr); ok {
+			collation, err := datavalues.ParseCollation(collate.Charset)
+			if err != nil {
+				return nil, err
+			}
+			orders[i].Collation = collation
+			node = collate.Expr
+		}
+		expr, err := parseExpression(nil, node)
 		if err != nil {
 			return nil, errors.Errorf("couldn't parse order by expression with index %v", i)
 		}
@@ -263,3 +281,26 @@ func parseLimit(limit *sqlparser.Limit) (IPlan, error) {
 	}
 	return NewLimitPlan(offsetPlan, rowcountPlan), nil
 }
+
+// ParseSettings takes the values of the settings as strings, the booleans are 0 and 1.
+func ParseSettings(exprs sqlparser.SetExprs) (map[string]string, error) {
+	settings := make(map[string]string, len(exprs))
+	for _, expr := range exprs {
+		var value string
+		switch e := expr.Expr.(type) {
+		case *sqlparser.SQLVal:
+			value = string(e.Val)
+		case sqlparser.BoolVal:
+			value = "0"
+			if e {
+				value = "1"
+			}
+		case *sqlparser.ColName:
+			value = e.Name.String()
+		default:
+			return nil, errors.Errorf("Unsupported setting value:%v", sqlparser.String(expr.Expr))
+		}
+		settings[expr.Name.Lowered()] = value
+	}
+	return settings, nil
+}
@@ -103,10 +103,28 @@ func BuildExpression(plan IPlan) (expressions.IExpression, error) {
 		}
 		return expressions.ExpressionFactory(t.FuncName, exprArgs)
 	default:
-		return nil, errors.Errorf("Unsupported expression plan:%T", t)
+		return nil, errors.ErrorWithCode(errors.NOT_IMPLEMENTED, "Unsupported expression plan:%T", t)
 	}
 }
 
+// CheckExpressions builds the expressions of the plan, so the invalid constant arguments,
+// such as the regular expressions, fail the plan rather than the rows.
+// The unknown functions are left to the execution as before, the arguments of the table valued functions are not expressions.
+func CheckExpressions(plan IPlan) error {
+	return Walk(func(p IPlan) (bool, error) {
+		switch p.(type) {
+		case *TableValuedFunctionPlan:
+			return false, nil
+		case *UnaryExpressionPlan, *BinaryExpressionPlan, *FunctionExpressionPlan:
+			if _, err := BuildExpression(p); err != nil && errors.Code(err) != errors.UNKNOWN_FUNCTION {
+				return false, err
+			}
+			return false, nil
+		}
+		return true, nil
+	}, plan)
+}
+
 func CheckAggregateExpressions(plan IPlan) (bool, error) {
 	hasAggregate := false
 	if err := Walk(func(p IPlan) (bool, error) {
@@ -36,6 +36,7 @@ func TestCreateTablePlan(t *testing.T) {
             "Qualifier": ""
         },
         "IfExists": false,
+        "Temporary": false,
         "TableSpec": {
             "Columns": [
                 {
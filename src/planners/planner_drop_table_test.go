@@ -41,6 +41,7 @@ func TestDropTablePlan(t *testing.T) {
             "Qualifier": ""
         },
         "IfExists": false,
+        "Temporary": false,
         "TableSpec": null,
         "OptLike": null,
         "PartitionSpec": null,
@@ -0,0 +1,57 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package planners
+
+import (
+	"encoding/json"
+
+	"base/errors"
+	"parsers"
+	"parsers/sqlparser"
+)
+
+const (
+	ExplainPipeline = "PIPELINE"
+)
+
+// ExplainPlan explains the SELECT, the PIPELINE shows the stages of its pipeline.
+type ExplainPlan struct {
+	Name    string
+	Kind    string
+	SubPlan *SelectPlan
+	query   string
+}
+
+func NewExplainPlan(kind string, query string) *ExplainPlan {
+	return &ExplainPlan{
+		Name:  "ExplainPlan",
+		Kind:  kind,
+		query: query,
+	}
+}
+
+func (plan *ExplainPlan) Build() error {
+	ast, err := parsers.Parse(plan.query)
+	if err != nil {
+		return err
+	}
+	if _, ok := ast.(*sqlparser.Select); !ok {
+		return errors.ErrorWithCode(errors.NOT_IMPLEMENTED, "EXPLAIN %s supports SELECT only", plan.Kind)
+	}
+	plan.SubPlan = NewSelectPlan(ast).(*SelectPlan)
+	return plan.SubPlan.Build()
+}
+
+func (plan *ExplainPlan) Walk(visit Visit) error {
+	return Walk(visit, plan.SubPlan)
+}
+
+func (plan *ExplainPlan) String() string {
+	out, err := json.MarshalIndent(plan, "", "    ")
+	if err != nil {
+		return err.Error()
+	}
+	return string(out)
+}
@@ -0,0 +1,33 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package planners
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExplainPlan(t *testing.T) {
+	plan, err := PlanFactory("EXPLAIN PIPELINE select a from t1 where a > 1 settings max_threads = 2")
+	assert.Nil(t, err)
+
+	explain := plan.(*ExplainPlan)
+	assert.Equal(t, ExplainPipeline, explain.Kind)
+	assert.Equal(t, map[string]string{"max_threads": "2"}, explain.SubPlan.Settings)
+
+	var scans []string
+	err = Walk(func(plan IPlan) (bool, error) {
+		if scan, ok := plan.(*ScanPlan); ok {
+			scans = append(scans, scan.Table)
+		}
+		return true, nil
+	}, plan)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"t1"}, scans)
+
+	_, err = PlanFactory("explain pipeline use db1")
+	assert.Equal(t, "EXPLAIN PIPELINE supports SELECT only (errno 48)", err.Error())
+}
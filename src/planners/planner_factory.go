@@ -5,12 +5,17 @@
 package planners
 
 import (
+	"regexp"
+
 	"parsers"
 
 	"base/errors"
 	"parsers/sqlparser"
 )
 
+// The EXPLAIN is parsed without the statement, the one explained is taken from the query.
+var explainPipeline = regexp.MustCompile(`(?is)^\s*explain\s+pipeline\s+(.*)$`)
+
 type planCreator func(ast sqlparser.Statement) IPlan
 
 var table = map[string]planCreator{
@@ -23,6 +28,7 @@ var table = map[string]planCreator{
 	sqlparser.NodeNameShowDatabases:  NewShowDatabasesPlan,
 	sqlparser.NodeNameShowTables:     NewShowTablesPlan,
 	sqlparser.NodeNameInsert:         NewInsertPlan,
+	sqlparser.NodeNameSet:            NewSetPlan,
 }
 
 func PlanFactory(query string) (IPlan, error) {
@@ -31,9 +37,16 @@ func PlanFactory(query string) (IPlan, error) {
 		return nil, err
 	}
 
+	if _, ok := statement.(*sqlparser.OtherRead); ok {
+		if m := explainPipeline.FindStringSubmatch(query); m != nil {
+			plan := NewExplainPlan(ExplainPipeline, m[1])
+			return plan, plan.Build()
+		}
+	}
+
 	creator, ok := table[statement.Name()]
 	if !ok {
-		return nil, errors.Errorf("Couldn't get the planner:%T", statement)
+		return nil, errors.ErrorWithCode(errors.NOT_IMPLEMENTED, "Couldn't get the planner:%T", statement)
 	}
 	plan := creator(statement)
 	return plan, plan.Build()
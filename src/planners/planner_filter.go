@@ -12,6 +12,9 @@ import (
 type FilterPlan struct {
 	Name    string
 	SubPlan IPlan
+	// Commons are the subexpressions repeated in the predicate, computed once per row
+	// into the columns of their names before it, which the repeats are the variables of.
+	Commons *MapPlan `json:",omitempty"`
 }
 
 func NewFilterPlan(plan IPlan) *FilterPlan {
@@ -34,9 +37,35 @@ func (plan *FilterPlan) Build() error {
 }
 
 func (plan *FilterPlan) Walk(visit Visit) error {
+	if plan.Commons != nil {
+		if err := Walk(visit, plan.Commons); err != nil {
+			return err
+		}
+	}
 	return Walk(visit, plan.SubPlan)
 }
 
+// Conjuncts splits the predicate by its ANDs, in order.
+func Conjuncts(plan IPlan) []IPlan {
+	if and, ok := plan.(*BinaryExpressionPlan); ok && and.FuncName == "AND" {
+		return append(Conjuncts(and.Left), Conjuncts(and.Right)...)
+	}
+	return []IPlan{plan}
+}
+
+// NewConjunction joins the predicates by AND, nil if there is none.
+func NewConjunction(plans ...IPlan) IPlan {
+	var res IPlan
+	for _, plan := range plans {
+		if res == nil {
+			res = plan
+		} else {
+			res = NewBinaryExpressionPlan("AND", res, plan)
+		}
+	}
+	return res
+}
+
 func (plan *FilterPlan) String() string {
 	out, err := json.MarshalIndent(plan, "", "    ")
 	if err != nil {
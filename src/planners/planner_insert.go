@@ -11,32 +11,46 @@ import (
 )
 
 type InsertPlan struct {
-	Name   string
-	Schema string
-	Table  string
-	Format string
+	Name    string
+	Schema  string
+	Table   string
+	Format  string
+	SubPlan *SelectPlan `json:",omitempty"`
 }
 
 func NewInsertPlan(ast sqlparser.Statement) IPlan {
+	var subPlan *SelectPlan
+
 	format := ""
 	node := ast.(*sqlparser.Insert)
 
 	if node.Formats != nil {
 		format = node.Formats.FormatName
 	}
+	// INSERT INTO ... SELECT.
+	if sel, ok := node.Rows.(*sqlparser.Select); ok {
+		subPlan = NewSelectPlan(sel).(*SelectPlan)
+	}
 	return &InsertPlan{
-		Name:   "InsertPlan",
-		Schema: node.Table.Qualifier.String(),
-		Table:  node.Table.Name.String(),
-		Format: format,
+		Name:    "InsertPlan",
+		Schema:  node.Table.Qualifier.String(),
+		Table:   node.Table.Name.String(),
+		Format:  format,
+		SubPlan: subPlan,
 	}
 }
 
 func (plan *InsertPlan) Build() error {
+	if plan.SubPlan != nil {
+		return plan.SubPlan.Build()
+	}
 	return nil
 }
 
 func (plan *InsertPlan) Walk(visit Visit) error {
+	if plan.SubPlan != nil {
+		return Walk(visit, plan.SubPlan)
+	}
 	return nil
 }
 
@@ -6,16 +6,23 @@ package planners
 
 import (
 	"encoding/json"
+
+	"datavalues"
 )
 
 type Order struct {
 	Expression IPlan
 	Direction  string
+	// Collation is the order of the strings by the COLLATE, the bytes by default.
+	Collation datavalues.Collation `json:",omitempty"`
 }
 
 type OrderByPlan struct {
 	Name   string
 	Orders []Order
+	// Limit is the rows of the LIMIT after the sort with its offset, set by the optimizer.
+	// The sort keeps only the first ones, 0 sorts all the rows.
+	Limit int `json:",omitempty"`
 }
 
 func NewOrderByPlan(orders ...Order) *OrderByPlan {
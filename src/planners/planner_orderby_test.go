@@ -7,6 +7,8 @@ package planners
 import (
 	"testing"
 
+	"datavalues"
+
 	"github.com/stretchr/testify/assert"
 )
 
@@ -77,3 +79,25 @@ func TestOrderByPlan(t *testing.T) {
 		})
 	}
 }
+
+func TestOrderByCollate(t *testing.T) {
+	plan, err := PlanFactory("SELECT name FROM t ORDER BY name COLLATE NOCASE DESC, id")
+	assert.Nil(t, err)
+
+	var orderBy *OrderByPlan
+	err = Walk(func(plan IPlan) (bool, error) {
+		if x, ok := plan.(*OrderByPlan); ok {
+			orderBy = x
+		}
+		return true, nil
+	}, plan)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(orderBy.Orders))
+	assert.Equal(t, NewVariablePlan("name"), orderBy.Orders[0].Expression)
+	assert.Equal(t, "desc", orderBy.Orders[0].Direction)
+	assert.Equal(t, datavalues.CollationNoCase, orderBy.Orders[0].Collation)
+	assert.Equal(t, datavalues.CollationBinary, orderBy.Orders[1].Collation)
+
+	_, err = PlanFactory("SELECT name FROM t ORDER BY name COLLATE klingon")
+	assert.Equal(t, "Unsupported collation:klingon (errno 48)", err.Error())
+}
@@ -14,6 +14,10 @@ type ScanPlan struct {
 	Schema  string
 	Filter  *FilterPlan     `json:",omitempty"`
 	Project *ProjectionPlan `json:",omitempty"`
+	// Columns are the columns the plan references, the scan reads only them. Nil is all the columns.
+	Columns []string `json:",omitempty"`
+	// Limit is the rows the scan sends at most, the offset and the rowcount of the LIMIT over it. 0 is all the rows.
+	Limit int `json:",omitempty"`
 }
 
 func NewScanPlan(table string, schema string) *ScanPlan {
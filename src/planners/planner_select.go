@@ -11,9 +11,11 @@ import (
 )
 
 type SelectPlan struct {
-	Name    string
-	SubPlan *MapPlan `json:",omitempty"`
-	ast     *sqlparser.Select
+	Name     string
+	SubPlan  *MapPlan          `json:",omitempty"`
+	Format   string            `json:",omitempty"`
+	Settings map[string]string `json:",omitempty"`
+	ast      *sqlparser.Select
 }
 
 func NewSelectPlan(ast sqlparser.Statement) IPlan {
@@ -28,6 +30,17 @@ func (plan *SelectPlan) Build() error {
 	ast := plan.ast
 	tree := plan.SubPlan
 
+	if ast.Formats != nil {
+		plan.Format = ast.Formats.FormatName
+	}
+	if len(ast.Settings) > 0 {
+		settings, err := ParseSettings(ast.Settings)
+		if err != nil {
+			return err
+		}
+		plan.Settings = settings
+	}
+
 	// Source.
 	source, err := parseFrom(ast.From[0])
 	if err != nil {
@@ -110,7 +123,10 @@ func (plan *SelectPlan) Build() error {
 
 	// Sink.
 	tree.Add(NewSinkPlan())
-	return tree.Build()
+	if err := tree.Build(); err != nil {
+		return err
+	}
+	return CheckExpressions(tree)
 }
 
 func (plan *SelectPlan) Walk(visit Visit) error {
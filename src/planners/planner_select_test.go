@@ -7,6 +7,7 @@ package planners
 import (
 	"testing"
 
+	"base/errors"
 	"parsers"
 
 	"parsers/sqlparser"
@@ -626,3 +627,21 @@ LIMIT 10`,
 		})
 	}
 }
+
+func TestSelectPlanLikeEscape(t *testing.T) {
+	statement, err := parsers.Parse("SELECT a FROM t WHERE a LIKE 'x!%' ESCAPE '!'")
+	assert.Nil(t, err)
+
+	plan := NewSelectPlan(statement.(*sqlparser.Select))
+	err = plan.Build()
+	assert.Equal(t, "Unsupported ESCAPE '!' of LIKE (errno 48)", err.Error())
+}
+
+func TestSelectPlanInvalidRegexp(t *testing.T) {
+	statement, err := parsers.Parse("SELECT a FROM t WHERE b = 1 AND match(a, '(')")
+	assert.Nil(t, err)
+
+	plan := NewSelectPlan(statement.(*sqlparser.Select))
+	err = plan.Build()
+	assert.Equal(t, errors.CANNOT_COMPILE_REGEXP, errors.Code(err))
+}
@@ -22,6 +22,9 @@ type SelectionPlan struct {
 	Projects      *MapPlan `json:",omitempty"`
 	GroupBys      *MapPlan `json:",omitempty"`
 	SelectionMode SelectionMode
+	// Commons are the subexpressions repeated in the projects and the groupbys, computed once per row
+	// into the columns of their names before them, which the repeats are the variables of.
+	Commons *MapPlan `json:",omitempty"`
 }
 
 func NewSelectionPlan(projects *MapPlan, groupbys *MapPlan) *SelectionPlan {
@@ -60,6 +63,11 @@ func (plan *SelectionPlan) Build() error {
 }
 
 func (plan *SelectionPlan) Walk(visit Visit) error {
+	if plan.Commons != nil {
+		if err := Walk(visit, plan.Commons); err != nil {
+			return err
+		}
+	}
 	return Walk(visit, plan.Projects, plan.GroupBys)
 }
 
@@ -0,0 +1,39 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package planners
+
+import (
+	"encoding/json"
+
+	"parsers/sqlparser"
+)
+
+type SetPlan struct {
+	Name string
+	Ast  *sqlparser.Set
+}
+
+func NewSetPlan(ast sqlparser.Statement) IPlan {
+	return &SetPlan{
+		Name: "SetPlan",
+		Ast:  ast.(*sqlparser.Set),
+	}
+}
+
+func (plan *SetPlan) Build() error {
+	return nil
+}
+
+func (plan *SetPlan) Walk(visit Visit) error {
+	return nil
+}
+
+func (plan *SetPlan) String() string {
+	out, err := json.MarshalIndent(plan, "", "    ")
+	if err != nil {
+		return err.Error()
+	}
+	return string(out)
+}
@@ -0,0 +1,46 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package planners
+
+import (
+	"testing"
+
+	"parsers"
+	"parsers/sqlparser"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetPlan(t *testing.T) {
+	query := "set max_threads = 4"
+	statement, err := parsers.Parse(query)
+	assert.Nil(t, err)
+
+	plan := NewSetPlan(statement.(*sqlparser.Set))
+	err = plan.Build()
+	assert.Nil(t, err)
+
+	err = plan.Walk(nil)
+	assert.Nil(t, err)
+
+	expect := `{
+    "Name": "SetPlan",
+    "Ast": {
+        "Comments": null,
+        "Exprs": [
+            {
+                "Name": "max_threads",
+                "Expr": {
+                    "Type": 1,
+                    "Val": "NA=="
+                }
+            }
+        ],
+        "Scope": ""
+    }
+}`
+	actual := plan.String()
+	assert.Equal(t, expect, actual)
+}
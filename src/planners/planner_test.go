@@ -56,7 +56,7 @@ func TestPlanner(t *testing.T) {
 			plan: NewMapPlan(
 				NewVariablePlan("a"),
 			),
-			errString: "Unsupported expression plan:*planners.MapPlan",
+			errString: "Unsupported expression plan:*planners.MapPlan (errno 48)",
 		},
 	}
 
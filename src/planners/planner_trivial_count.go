@@ -0,0 +1,41 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package planners
+
+import (
+	"encoding/json"
+)
+
+// TrivialCountPlan is the COUNT of all the rows of a table, with no filter and no group.
+// The storage answers it by its rows if it knows them exactly, else the scan and the selection do.
+type TrivialCountPlan struct {
+	Name      string
+	Scan      *ScanPlan
+	Selection *SelectionPlan
+}
+
+func NewTrivialCountPlan(scan *ScanPlan, selection *SelectionPlan) *TrivialCountPlan {
+	return &TrivialCountPlan{
+		Name:      "TrivialCountPlan",
+		Scan:      scan,
+		Selection: selection,
+	}
+}
+
+func (plan *TrivialCountPlan) Build() error {
+	return nil
+}
+
+func (plan *TrivialCountPlan) Walk(visit Visit) error {
+	return Walk(visit, plan.Scan, plan.Selection)
+}
+
+func (plan *TrivialCountPlan) String() string {
+	out, err := json.MarshalIndent(plan, "", "    ")
+	if err != nil {
+		return err.Error()
+	}
+	return string(out)
+}
@@ -0,0 +1,34 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package planners
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrivialCountPlan(t *testing.T) {
+	scan := NewScanPlan("t", "db")
+	selection := NewSelectionPlan(NewMapPlan(NewUnaryExpressionPlan("count", NewConstantPlan(1))), NewMapPlan())
+	plan := NewTrivialCountPlan(scan, selection)
+	err := plan.Build()
+	assert.Nil(t, err)
+
+	var scans, selections int
+	err = plan.Walk(func(plan IPlan) (bool, error) {
+		switch plan.(type) {
+		case *ScanPlan:
+			scans++
+		case *SelectionPlan:
+			selections++
+		}
+		return true, nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, scans)
+	assert.Equal(t, 1, selections)
+	assert.Contains(t, plan.String(), `"Name": "TrivialCountPlan"`)
+}
@@ -117,6 +117,8 @@ func (p *BaseProcessor) Subscribe(eventHandlers ...EventHandler) {
 			if p.nextHandler != nil {
 				p.nextHandler(ctx.Err())
 			}
+			// Unblock the upstream that is sending, it stops at its next select.
+			in.Stop()
 			return
 		case x, ok := <-in.Recv():
 			if !ok {
@@ -132,6 +134,11 @@ func (p *BaseProcessor) Subscribe(eventHandlers ...EventHandler) {
 				p.nextHandler(x)
 				atomic.AddInt64((*int64)(&p.duration), int64(time.Since(start)))
 			}
+			// Nothing reads the output any more, such as after the LIMIT, the upstream stops too.
+			if out.IsClose() {
+				in.Stop()
+				return
+			}
 		}
 	}
 }
@@ -6,6 +6,7 @@ package processors
 
 import (
 	"sync"
+	"sync/atomic"
 )
 
 type InPort struct {
@@ -15,6 +16,9 @@ type InPort struct {
 	edges       []*OutPort
 	closed      bool
 	closeCounts int
+	// The receiver is gone, the values are drained until the senders close.
+	stopped  int32
+	stopOnce sync.Once
 }
 
 func NewInPort(name string) *InPort {
@@ -43,12 +47,25 @@ func (pt *InPort) Send(v interface{}) {
 	pt.mu.Lock()
 	defer pt.mu.Unlock()
 
-	if pt.closed {
+	if pt.closed || pt.IsStopped() {
 		return
 	}
 	pt.ch <- v
 }
 
+// SetCapacity buffers the values of the senders, they wait only beyond it.
+// It's set before the processors run.
+func (pt *InPort) SetCapacity(n int) {
+	pt.mu.Lock()
+	defer pt.mu.Unlock()
+	pt.ch = make(chan interface{}, n)
+}
+
+// Len returns the values buffered.
+func (pt *InPort) Len() int {
+	return len(pt.ch)
+}
+
 func (pt *InPort) Recv() <-chan interface{} {
 	return pt.ch
 }
@@ -64,3 +81,19 @@ func (pt *InPort) Close() {
 		}
 	}
 }
+
+// Stop tells the senders the receiver reads no more,
+// the values already on the way are drained until the port is closed.
+func (pt *InPort) Stop() {
+	pt.stopOnce.Do(func() {
+		atomic.StoreInt32(&pt.stopped, 1)
+		go func() {
+			for range pt.ch {
+			}
+		}()
+	})
+}
+
+func (pt *InPort) IsStopped() bool {
+	return atomic.LoadInt32(&pt.stopped) == 1
+}
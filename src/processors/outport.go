@@ -41,7 +41,9 @@ func (pt *OutPort) Send(v interface{}) {
 		return
 	}
 	for _, rpt := range pt.edges {
-		rpt.Send(v)
+		if !rpt.IsStopped() {
+			rpt.Send(v)
+		}
 	}
 }
 
@@ -56,8 +58,17 @@ func (pt *OutPort) Close() {
 	}
 }
 
+// IsClose also checks if all the receivers are stopped, the sender can stop producing.
 func (pt *OutPort) IsClose() bool {
 	pt.mu.Lock()
 	defer pt.mu.Unlock()
-	return pt.closed
+	if pt.closed {
+		return true
+	}
+	for _, rpt := range pt.edges {
+		if !rpt.IsStopped() {
+			return false
+		}
+	}
+	return len(pt.edges) > 0
 }
@@ -6,37 +6,106 @@ package processors
 
 import (
 	"context"
+	"fmt"
 	"sync"
 )
 
 type Pipeline struct {
 	mu         sync.Mutex
 	ctx        context.Context
-	index      int64
 	processors []IProcessor
+	// The processors of the last stage, the next stage reads from all of them.
+	lanes  []IProcessor
+	stages []string
+	// The values buffered on each input, 0 is unbuffered.
+	capacity int
 }
 
 func NewPipeline(ctx context.Context) *Pipeline {
 	return &Pipeline{ctx: ctx}
 }
 
+// SetEdgeCapacity bounds the values in flight on the inputs of the processors added after it.
+// The inputs merge all their edges into one buffer, a stage with several senders waits on none of them
+// in particular, so the resize and the merges of the lanes don't deadlock.
+func (pipeline *Pipeline) SetEdgeCapacity(n int) *Pipeline {
+	pipeline.mu.Lock()
+	defer pipeline.mu.Unlock()
+	pipeline.capacity = n
+	return pipeline
+}
+
+// Add adds the stage of one processor, it merges the lanes of the previous stage.
 func (pipeline *Pipeline) Add(proc IProcessor) *Pipeline {
+	return pipeline.AddLanes(proc)
+}
+
+// AddLanes adds the stage of the processors running side by side, one per lane.
+// The lanes of the previous stage are connected one to one if the width is the same,
+// merged if it's one processor, otherwise a Resize spreads them over the new lanes.
+func (pipeline *Pipeline) AddLanes(procs ...IProcessor) *Pipeline {
 	ctx := pipeline.ctx
 
 	pipeline.mu.Lock()
 	defer pipeline.mu.Unlock()
 
-	proc.SetContext(ctx)
-	if len(pipeline.processors) > 0 {
-		source := pipeline.processors[pipeline.index-1]
-		proc.From(source)
+	prev := pipeline.lanes
+	if len(prev) > 0 && len(procs) > 1 && len(prev) != len(procs) {
+		resize := NewResize(len(procs))
+		resize.SetContext(ctx)
+		pipeline.setCapacity(resize)
+		resize.From(prev...)
+		pipeline.processors = append(pipeline.processors, resize)
+		pipeline.stages = append(pipeline.stages, fmt.Sprintf("%s %d -> %d", resize.Name(), len(prev), len(procs)))
+		prev = resize.Lanes()
 	}
 
-	pipeline.index++
-	pipeline.processors = append(pipeline.processors, proc)
+	for i, proc := range procs {
+		proc.SetContext(ctx)
+		pipeline.setCapacity(proc)
+		switch {
+		case len(prev) == len(procs):
+			proc.From(prev[i])
+		case len(prev) > 0:
+			proc.From(prev...)
+		}
+	}
+	pipeline.processors = append(pipeline.processors, procs...)
+	pipeline.lanes = procs
+
+	stage := procs[0].Name()
+	if len(procs) > 1 {
+		stage = fmt.Sprintf("%s x %d", stage, len(procs))
+	}
+	pipeline.stages = append(pipeline.stages, stage)
 	return pipeline
 }
 
+func (pipeline *Pipeline) setCapacity(proc IProcessor) {
+	if pipeline.capacity > 0 {
+		proc.In().SetCapacity(pipeline.capacity)
+	}
+}
+
+// Buffered returns the values waiting on the inputs of all the processors.
+func (pipeline *Pipeline) Buffered() int {
+	pipeline.mu.Lock()
+	defer pipeline.mu.Unlock()
+
+	n := 0
+	for _, proc := range pipeline.processors {
+		n += proc.In().Len()
+	}
+	return n
+}
+
+// Width returns the lanes of the last stage.
+func (pipeline *Pipeline) Width() int {
+	pipeline.mu.Lock()
+	defer pipeline.mu.Unlock()
+	return len(pipeline.lanes)
+}
+
 func (pipeline *Pipeline) Run() {
 	pipeline.mu.Lock()
 	defer pipeline.mu.Unlock()
@@ -85,12 +154,21 @@ func (pipeline *Pipeline) Wait(f func(v interface{}) error) error {
 	return nil
 }
 
+// Out returns the input of the last processor, the pipeline ends with a stage of one processor.
 func (pipeline *Pipeline) Out() <-chan interface{} {
-	return pipeline.processors[pipeline.index-1].In().Recv()
+	return pipeline.Last().In().Recv()
 }
 
 func (pipeline *Pipeline) Last() IProcessor {
-	return pipeline.processors[pipeline.index-1]
+	return pipeline.lanes[0]
+}
+
+// Explain describes the stages in order, one line each with the width of the parallel ones.
+func (pipeline *Pipeline) Explain() []string {
+	pipeline.mu.Lock()
+	defer pipeline.mu.Unlock()
+
+	return append([]string(nil), pipeline.stages...)
 }
 
 func (pipeline *Pipeline) String() string {
@@ -6,6 +6,8 @@ package processors
 
 import (
 	"context"
+	"fmt"
+	"sync"
 	"testing"
 	"time"
 
@@ -109,6 +111,68 @@ func TestPipelineSequentialDAG(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestPipelineLanes(t *testing.T) {
+	tests := []struct {
+		name    string
+		widths  []int
+		explain []string
+	}{
+		{
+			name:    "single",
+			widths:  []int{1, 1},
+			explain: []string{"source1", "t1", "t2", "sink1"},
+		},
+		{
+			name:    "resize-and-merge",
+			widths:  []int{4, 4},
+			explain: []string{"source1", "resize 1 -> 4", "t1 x 4", "t2 x 4", "sink1"},
+		},
+		{
+			name:    "resize-lanes",
+			widths:  []int{4, 2},
+			explain: []string{"source1", "resize 1 -> 4", "t1 x 4", "resize 4 -> 2", "t2 x 2", "sink1"},
+		},
+	}
+
+	for _, test := range tests {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		source1 := NewSource("source1")
+		pipeline1 := NewPipeline(ctx).Add(source1)
+		for i, width := range test.widths {
+			var lanes []IProcessor
+			for j := 0; j < width; j++ {
+				lanes = append(lanes, NewMockAddTransform(fmt.Sprintf("t%d", i+1)))
+			}
+			pipeline1.AddLanes(lanes...)
+		}
+		pipeline1.Add(NewSink("sink1"))
+		assert.Equal(t, test.explain, pipeline1.Explain(), test.name)
+		assert.Equal(t, 1, pipeline1.Width(), test.name)
+
+		pipeline1.Run()
+		go func() {
+			out := source1.Out()
+			defer out.Close()
+			for i := 0; i < 100; i++ {
+				out.Send(i)
+			}
+		}()
+
+		var count, sum int
+		err := pipeline1.Wait(func(x interface{}) error {
+			count++
+			sum += x.(int)
+			return nil
+		})
+		assert.Nil(t, err, test.name)
+		// Every value passes each stage once.
+		assert.Equal(t, 100, count, test.name)
+		assert.Equal(t, 4950+200, sum, test.name)
+		cancel()
+	}
+}
+
 func TestPipelinePauseAndResume(t *testing.T) {
 	numbers := 100
 
@@ -214,3 +278,102 @@ func BenchmarkPipeline(b *testing.B) {
 		return nil
 	})
 }
+
+func TestPipelineStopUpstream(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	source := NewSource("source")
+	sink := NewSink("sink")
+	pipeline := NewPipeline(ctx).
+		Add(source).
+		AddLanes(NewMockAddTransform("t1"), NewMockAddTransform("t1")).
+		Add(NewMockSleepTransform("t2", 0)).
+		Add(sink)
+	pipeline.Run()
+
+	// The source is endless, it stops only if nothing reads it.
+	stopped := make(chan int)
+	go func() {
+		out := source.Out()
+		defer out.Close()
+		i := 0
+		for ; !out.IsClose(); i++ {
+			out.Send(i)
+		}
+		stopped <- i
+	}()
+
+	var got int
+	for range sink.In().Recv() {
+		got++
+		if got == 3 {
+			sink.In().Stop()
+			break
+		}
+	}
+
+	select {
+	case sent := <-stopped:
+		assert.True(t, sent >= got)
+	case <-time.After(5 * time.Second):
+		assert.Fail(t, "the source doesn't stop")
+	}
+}
+
+func TestPipelineBackpressure(t *testing.T) {
+	const (
+		numbers  = 200
+		capacity = 2
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// The source is spread over 3 lanes and merged again, with the capacity on the 6 inputs:
+	// resize, 3 lanes, t2 and the sink.
+	source := NewSource("source")
+	sink := NewSink("sink")
+	pipeline := NewPipeline(ctx).
+		SetEdgeCapacity(capacity).
+		Add(source).
+		AddLanes(NewMockAddTransform("t1"), NewMockAddTransform("t1"), NewMockAddTransform("t1")).
+		Add(NewMockSleepTransform("t2", 0)).
+		Add(sink)
+	pipeline.Run()
+
+	var mu sync.Mutex
+	sent := 0
+	go func() {
+		out := source.Out()
+		defer out.Close()
+		for i := 0; i < numbers; i++ {
+			out.Send(i)
+			mu.Lock()
+			sent++
+			mu.Unlock()
+		}
+	}()
+
+	// Each stage holds one value besides its buffer.
+	maxInFlight := 6*capacity + 5
+	received := 0
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for range sink.In().Recv() {
+			received++
+			time.Sleep(time.Millisecond)
+			assert.True(t, pipeline.Buffered() <= 6*capacity, "buffered:%v", pipeline.Buffered())
+			mu.Lock()
+			assert.True(t, sent-received <= maxInFlight, "in flight:%v", sent-received)
+			mu.Unlock()
+		}
+	}()
+
+	select {
+	case <-done:
+		assert.Equal(t, numbers, received)
+	case <-time.After(10 * time.Second):
+		assert.Fail(t, "the pipeline is stuck")
+	}
+}
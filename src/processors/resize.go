@@ -0,0 +1,64 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package processors
+
+// Resize spreads the values of its inputs over the lanes in turn,
+// the processors downstream are connected to one lane each.
+type Resize struct {
+	next  int
+	lanes []*resizeLane
+	BaseProcessor
+}
+
+// resizeLane is the output of one lane, it's run by the Resize.
+type resizeLane struct {
+	BaseProcessor
+}
+
+func NewResize(lanes int) *Resize {
+	p := &Resize{
+		BaseProcessor: NewBaseProcessor("resize"),
+	}
+	for i := 0; i < lanes; i++ {
+		p.lanes = append(p.lanes, &resizeLane{BaseProcessor: NewBaseProcessor("resize")})
+	}
+	return p
+}
+
+// Lanes returns the outputs to connect the downstream processors to.
+func (p *Resize) Lanes() []IProcessor {
+	lanes := make([]IProcessor, len(p.lanes))
+	for i, lane := range p.lanes {
+		lanes[i] = lane
+	}
+	return lanes
+}
+
+func (p *Resize) Execute() {
+	defer func() {
+		for _, lane := range p.lanes {
+			lane.Out().Close()
+		}
+	}()
+
+	onNext := func(x interface{}) {
+		lane := p.lanes[p.next]
+		p.next = (p.next + 1) % len(p.lanes)
+		lane.Out().Send(x)
+		if p.lanesClosed() {
+			p.Out().Close()
+		}
+	}
+	p.Subscribe(onNext)
+}
+
+func (p *Resize) lanesClosed() bool {
+	for _, lane := range p.lanes {
+		if !lane.Out().IsClose() {
+			return false
+		}
+	}
+	return true
+}
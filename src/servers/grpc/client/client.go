@@ -0,0 +1,154 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+// Package client is the Go client of the VectorSQL gRPC service.
+package client
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strconv"
+
+	"datablocks"
+	"servers/grpc/vectorsqlpb"
+
+	"base/binary"
+	"base/errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// insertChunkSize is the bytes of the data in each message of the Insert stream.
+const insertChunkSize = 64 << 10
+
+// exceptionCodeKey is the trailer of the server with the code of the exception.
+const exceptionCodeKey = "exception-code"
+
+type Client struct {
+	conn *grpc.ClientConn
+	rpc  vectorsqlpb.VectorSQLClient
+}
+
+// Dial connects to the grpc_port of the server, without TLS unless the options have the credentials.
+func Dial(ctx context.Context, address string, opts ...grpc.DialOption) (*Client, error) {
+	if len(opts) == 0 {
+		opts = []grpc.DialOption{grpc.WithInsecure()}
+	}
+	conn, err := grpc.DialContext(ctx, address, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	return &Client{
+		conn: conn,
+		rpc:  vectorsqlpb.NewVectorSQLClient(conn),
+	}, nil
+}
+
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// Query runs the query and calls the fn for each response as it comes, the result blocks and the progress last.
+// The errors of the server have their exception codes.
+func (c *Client) Query(ctx context.Context, req *vectorsqlpb.QueryRequest, fn func(*vectorsqlpb.QueryResponse) error) error {
+	var trailer metadata.MD
+	stream, err := c.rpc.ExecuteQuery(ctx, req, grpc.Trailer(&trailer))
+	if err != nil {
+		return exception(err, trailer)
+	}
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return exception(err, trailer)
+		}
+		if err := fn(resp); err != nil {
+			return err
+		}
+	}
+}
+
+// QueryBlocks runs the query in the Native format and returns the blocks of the result and the progress.
+func (c *Client) QueryBlocks(ctx context.Context, req *vectorsqlpb.QueryRequest) ([]*datablocks.DataBlock, *vectorsqlpb.Progress, error) {
+	var blocks []*datablocks.DataBlock
+	var progress *vectorsqlpb.Progress
+
+	native := *req
+	native.OutputFormat = vectorsqlpb.Format_NATIVE
+	err := c.Query(ctx, &native, func(resp *vectorsqlpb.QueryResponse) error {
+		if resp.Progress != nil {
+			progress = resp.Progress
+		}
+		if len(resp.Output) == 0 {
+			return nil
+		}
+		block, err := datablocks.ReadNative(binary.NewReader(bytes.NewReader(resp.Output)))
+		if err != nil {
+			return err
+		}
+		blocks = append(blocks, block)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return blocks, progress, nil
+}
+
+// Insert streams the data to the INSERT query of the req, in the chunks of insertChunkSize bytes.
+// The data of the req is sent first.
+func (c *Client) Insert(ctx context.Context, req *vectorsqlpb.InsertRequest, data io.Reader) (*vectorsqlpb.InsertResponse, error) {
+	var trailer metadata.MD
+	stream, err := c.rpc.Insert(ctx, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, exception(err, trailer)
+	}
+	if err := stream.Send(req); err != nil && err != io.EOF {
+		return nil, exception(err, trailer)
+	}
+	if data != nil {
+		buf := make([]byte, insertChunkSize)
+		for {
+			n, rerr := io.ReadFull(data, buf)
+			if n > 0 {
+				// The server failed, its error comes with the CloseAndRecv.
+				if err := stream.Send(&vectorsqlpb.InsertRequest{Data: append([]byte(nil), buf[:n]...)}); err == io.EOF {
+					break
+				} else if err != nil {
+					return nil, exception(err, trailer)
+				}
+			}
+			if rerr == io.EOF || rerr == io.ErrUnexpectedEOF {
+				break
+			}
+			if rerr != nil {
+				return nil, errors.Wrap(rerr)
+			}
+		}
+	}
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		return nil, exception(err, trailer)
+	}
+	return resp, nil
+}
+
+// exception is the error of the status with the exception code of the trailer.
+func exception(err error, trailer metadata.MD) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return errors.Wrap(err)
+	}
+	if values := trailer.Get(exceptionCodeKey); len(values) > 0 {
+		if code, err := strconv.Atoi(values[0]); err == nil {
+			return errors.ErrorWithCode(code, "%s", st.Message())
+		}
+	}
+	return errors.New(st.Message())
+}
@@ -0,0 +1,154 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package grpc
+
+import (
+	"fmt"
+	"net"
+
+	"config"
+	"databases"
+	"servers/grpc/vectorsqlpb"
+	"sessions"
+	"users"
+
+	"base/errors"
+	"base/xlog"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+)
+
+// ExceptionCodeKey is the trailer of the failed calls with the code of the exception.
+const ExceptionCodeKey = "exception-code"
+
+// GRPCHandler serves the VectorSQL service of vectorsqlpb on the grpc_port, with the server reflection
+// so grpcurl and the other tools can list and call it.
+type GRPCHandler struct {
+	log      *xlog.Log
+	conf     *config.Config
+	server   *grpc.Server
+	listener net.Listener
+}
+
+func NewGRPCHandler(log *xlog.Log, conf *config.Config) *GRPCHandler {
+	s := &GRPCHandler{
+		log:    log,
+		conf:   conf,
+		server: grpc.NewServer(),
+	}
+	vectorsqlpb.RegisterVectorSQLServer(s.server, s)
+	reflection.Register(s.server)
+	return s
+}
+
+func (s *GRPCHandler) Start() {
+	log := s.log
+
+	if s.conf.Server.GRPCPort <= 0 {
+		return
+	}
+	listener, err := net.Listen("tcp", fmt.Sprintf("%v:%v", s.conf.Server.ListenHost, s.conf.Server.GRPCPort))
+	if err != nil {
+		log.Panic("Couldn't listen: %+v", err)
+	}
+	s.listener = listener
+	go s.serve(listener)
+}
+
+func (s *GRPCHandler) serve(listener net.Listener) {
+	log := s.log
+
+	if err := s.server.Serve(listener); err != nil {
+		log.Error("gRPC serve error:%+v", err)
+	}
+}
+
+// Stop refuses the new calls, the calls running go on until they finish or their queries are cancelled.
+func (s *GRPCHandler) Stop() {
+	go s.server.GracefulStop()
+}
+
+// Address returns the gRPC address, empty if not enabled.
+func (s *GRPCHandler) Address() string {
+	if s.listener == nil {
+		return ""
+	}
+	return fmt.Sprintf(":%v", s.conf.Server.GRPCPort)
+}
+
+// newSession authenticates the user of the call and sets the database, the closing is of the caller.
+func (s *GRPCHandler) newSession(name string, password string, database string) (*sessions.Session, error) {
+	user, err := users.Authenticate(s.conf, name, password)
+	if err != nil {
+		return nil, err
+	}
+	if database != "" {
+		if err := user.CheckDatabase(database); err != nil {
+			return nil, err
+		}
+		if _, err := databases.GetDatabase(database); err != nil {
+			return nil, err
+		}
+	}
+
+	session := sessions.NewSession()
+	session.SetUser(user)
+	if database != "" {
+		session.SetDatabase(database)
+	}
+	return session, nil
+}
+
+// querySettings are the settings of the call over the ones of the user profile.
+func querySettings(user *users.User, settings map[string]string) map[string]string {
+	out := make(map[string]string, len(user.Settings)+len(settings))
+	for k, v := range user.Settings {
+		out[k] = v
+	}
+	for k, v := range settings {
+		out[k] = v
+	}
+	return out
+}
+
+// statusError is the gRPC status of the error with the ClickHouse style message,
+// the code of the exception is in the trailer.
+func statusError(stream grpc.ServerStream, err error) error {
+	if err == nil {
+		return nil
+	}
+	code := errors.Code(err)
+	stream.SetTrailer(metadata.Pairs(ExceptionCodeKey, fmt.Sprintf("%d", code)))
+	return status.Errorf(statusCode(code), "Code: %d. DB::Exception: %v", code, err)
+}
+
+func statusCode(code int) codes.Code {
+	switch code {
+	case errors.CANNOT_PARSE_TEXT, errors.SYNTAX_ERROR, errors.CANNOT_COMPILE_REGEXP, errors.UNKNOWN_SETTING:
+		return codes.InvalidArgument
+	case errors.AUTHENTICATION_FAILED:
+		return codes.Unauthenticated
+	case errors.READONLY, errors.ACCESS_DENIED:
+		return codes.PermissionDenied
+	case errors.UNKNOWN_TABLE, errors.UNKNOWN_DATABASE:
+		return codes.NotFound
+	case errors.QUERY_WITH_SAME_ID_IS_ALREADY_RUNNING:
+		return codes.AlreadyExists
+	case errors.LIMIT_EXCEEDED, errors.TOO_MANY_SIMULTANEOUS_QUERIES, errors.MEMORY_LIMIT_EXCEEDED,
+		errors.TOO_MANY_ROWS, errors.TOO_MANY_BYTES:
+		return codes.ResourceExhausted
+	case errors.TIMEOUT_EXCEEDED:
+		return codes.DeadlineExceeded
+	case errors.ABORTED:
+		return codes.Unavailable
+	case errors.NOT_IMPLEMENTED:
+		return codes.Unimplemented
+	}
+	return codes.Internal
+}
@@ -0,0 +1,167 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+
+	"config"
+	"mocks"
+	"servers/grpc/client"
+	"servers/grpc/vectorsqlpb"
+
+	"base/errors"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+)
+
+// newTestServer serves the handler on a port of the loopback and dials it by the client.
+func newTestServer(t *testing.T, conf *config.Config) (*client.Client, *grpc.ClientConn, func()) {
+	mock, cleanup := mocks.NewMock()
+	handler := NewGRPCHandler(mock.Log, conf)
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	go handler.serve(listener)
+
+	ctx := context.Background()
+	c, err := client.Dial(ctx, listener.Addr().String())
+	assert.Nil(t, err)
+	conn, err := grpc.DialContext(ctx, listener.Addr().String(), grpc.WithInsecure())
+	assert.Nil(t, err)
+	return c, conn, func() {
+		conn.Close()
+		c.Close()
+		handler.server.Stop()
+		cleanup()
+	}
+}
+
+func TestGRPCHandlerQuery(t *testing.T) {
+	mock, cleanup := mocks.NewMock()
+	defer cleanup()
+	c, _, stop := newTestServer(t, mock.Conf)
+	defer stop()
+	ctx := context.Background()
+
+	query := func(q string) error {
+		return c.Query(ctx, &vectorsqlpb.QueryRequest{Query: q}, func(*vectorsqlpb.QueryResponse) error { return nil })
+	}
+	assert.Nil(t, query("create database grpcdb"))
+	defer query("drop database grpcdb")
+	assert.Nil(t, query("create table grpcdb.t1(a Int32, b String) Engine=Memory"))
+
+	// The bulk load in the messages of the stream, the FORMAT of the query wins.
+	var data strings.Builder
+	for i := 0; i < 1000; i++ {
+		fmt.Fprintf(&data, "{\"a\":%d,\"b\":\"s%d\"}\n", i, i)
+	}
+	resp, err := c.Insert(ctx, &vectorsqlpb.InsertRequest{
+		Query:       "insert into grpcdb.t1",
+		InputFormat: vectorsqlpb.Format_JSON_EACH_ROW,
+		Data:        []byte(data.String()[:10]),
+	}, strings.NewReader(data.String()[10:]))
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1000), resp.WrittenRows)
+	assert.Len(t, resp.QueryId, 36)
+	resp, err = c.Insert(ctx, &vectorsqlpb.InsertRequest{Query: "insert into grpcdb.t1 format TSV"}, strings.NewReader("1000\ts1000\n"))
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), resp.WrittenRows)
+
+	// The Native blocks.
+	blocks, progress, err := c.QueryBlocks(ctx, &vectorsqlpb.QueryRequest{
+		Query:    "select a, b from t1 where a >= 998 order by a",
+		Database: "grpcdb",
+		Settings: map[string]string{"max_threads": "2"},
+		QueryId:  "grpc-query",
+	})
+	assert.Nil(t, err)
+	var rows []string
+	for _, block := range blocks {
+		it := block.RowIterator()
+		for it.Next() {
+			row := it.Value()
+			rows = append(rows, fmt.Sprintf("%v %v", row[0], row[1]))
+		}
+	}
+	assert.Equal(t, []string{"998 s998", "999 s999", "1000 s1000"}, rows)
+	assert.Equal(t, uint64(1001), progress.ReadRows)
+
+	// The JSON rows.
+	var output strings.Builder
+	var ids []string
+	err = c.Query(ctx, &vectorsqlpb.QueryRequest{
+		Query:        "select a from grpcdb.t1 where a < 2 order by a",
+		OutputFormat: vectorsqlpb.Format_JSON_EACH_ROW,
+	}, func(resp *vectorsqlpb.QueryResponse) error {
+		output.Write(resp.Output)
+		ids = append(ids, resp.QueryId)
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"a\":0}\n{\"a\":1}\n", output.String())
+	assert.Equal(t, ids[0], ids[len(ids)-1])
+}
+
+func TestGRPCHandlerErrors(t *testing.T) {
+	mock, cleanup := mocks.NewMock()
+	defer cleanup()
+	conf := *mock.Conf
+	conf.Users = []config.User{{Name: "default", Password: "secret"}}
+	c, _, stop := newTestServer(t, &conf)
+	defer stop()
+	ctx := context.Background()
+	noop := func(*vectorsqlpb.QueryResponse) error { return nil }
+
+	err := c.Query(ctx, &vectorsqlpb.QueryRequest{Query: "select 1"}, noop)
+	assert.Equal(t, errors.AUTHENTICATION_FAILED, errors.Code(err))
+
+	err = c.Query(ctx, &vectorsqlpb.QueryRequest{Query: "selec 1", Password: "secret"}, noop)
+	assert.Equal(t, errors.SYNTAX_ERROR, errors.Code(err))
+	assert.Contains(t, err.Error(), "Code: 62. DB::Exception:")
+
+	err = c.Query(ctx, &vectorsqlpb.QueryRequest{Query: "select * from system.tables", Password: "secret", Database: "nodb"}, noop)
+	assert.Equal(t, errors.UNKNOWN_DATABASE, errors.Code(err))
+
+	err = c.Query(ctx, &vectorsqlpb.QueryRequest{Query: "insert into system.tables format TSV", Password: "secret"}, noop)
+	assert.NotNil(t, err)
+	_, err = c.Insert(ctx, &vectorsqlpb.InsertRequest{Query: "select 1", Password: "secret"}, nil)
+	assert.NotNil(t, err)
+	_, err = c.Insert(ctx, &vectorsqlpb.InsertRequest{Query: "insert into nodb.t1", Password: "secret"}, strings.NewReader("1\n"))
+	assert.NotNil(t, err)
+}
+
+func TestGRPCHandlerReflection(t *testing.T) {
+	mock, cleanup := mocks.NewMock()
+	defer cleanup()
+	_, conn, stop := newTestServer(t, mock.Conf)
+	defer stop()
+
+	rc := grpc_reflection_v1alpha.NewServerReflectionClient(conn)
+	stream, err := rc.ServerReflectionInfo(context.Background())
+	assert.Nil(t, err)
+	assert.Nil(t, stream.Send(&grpc_reflection_v1alpha.ServerReflectionRequest{
+		MessageRequest: &grpc_reflection_v1alpha.ServerReflectionRequest_ListServices{},
+	}))
+	resp, err := stream.Recv()
+	assert.Nil(t, err)
+	var names []string
+	for _, service := range resp.GetListServicesResponse().Service {
+		names = append(names, service.Name)
+	}
+	assert.Contains(t, names, "vectorsql.VectorSQL")
+
+	assert.Nil(t, stream.Send(&grpc_reflection_v1alpha.ServerReflectionRequest{
+		MessageRequest: &grpc_reflection_v1alpha.ServerReflectionRequest_FileContainingSymbol{FileContainingSymbol: "vectorsql.VectorSQL"},
+	}))
+	resp, err = stream.Recv()
+	assert.Nil(t, err)
+	assert.Len(t, resp.GetFileDescriptorResponse().FileDescriptorProto, 1)
+}
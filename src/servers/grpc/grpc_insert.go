@@ -0,0 +1,141 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package grpc
+
+import (
+	"io"
+
+	"dataformats"
+	"datastreams"
+	"executors"
+	"optimizers"
+	"planners"
+	"servers/grpc/vectorsqlpb"
+
+	"base/errors"
+)
+
+// Insert parses the data of the stream block by block and writes them to the table as they are parsed,
+// the table output is finalized only after the last message.
+func (s *GRPCHandler) Insert(stream vectorsqlpb.VectorSQL_InsertServer) error {
+	resp, err := s.insert(stream)
+	if err != nil {
+		return statusError(stream, err)
+	}
+	return stream.SendAndClose(resp)
+}
+
+func (s *GRPCHandler) insert(stream vectorsqlpb.VectorSQL_InsertServer) (*vectorsqlpb.InsertResponse, error) {
+	log := s.log
+
+	req, err := stream.Recv()
+	if err == io.EOF {
+		return nil, errors.New("Empty insert, the first message has the INSERT query")
+	}
+	if err != nil {
+		return nil, err
+	}
+	session, err := s.newSession(req.User, req.Password, req.Database)
+	if err != nil {
+		log.Warning("GRPCHandler->Authentication: %v", err)
+		return nil, err
+	}
+	defer session.Close()
+	log.Debug("GRPCHandler-Insert->Enter:%+v, user:%s", req.Query, session.GetUser().Name)
+
+	conf, _, err := s.conf.WithSettings(querySettings(session.GetUser(), req.Settings))
+	if err != nil {
+		return nil, err
+	}
+	ctx, limits := executors.NewExecutionLimits(stream.Context(), conf)
+	defer limits.Cancel()
+	if err := executors.ShutdownError(); err != nil {
+		return nil, err
+	}
+	id, err := limits.StartQuery(req.QueryId, req.Query, session)
+	if err != nil {
+		return nil, err
+	}
+	log = log.With("query_id", id)
+
+	plan, err := planners.PlanFactory(req.Query)
+	if err != nil {
+		log.Error("%+v", err)
+		return nil, err
+	}
+	plan = optimizers.Optimize(conf, plan, optimizers.DefaultOptimizers)
+	insertPlan, ok := plan.(*planners.InsertPlan)
+	if !ok || insertPlan.SubPlan != nil {
+		return nil, errors.New("Insert expects the INSERT query with the data, use ExecuteQuery for the others")
+	}
+
+	// The FORMAT clause wins over the format of the message.
+	format := insertPlan.Format
+	if format == "" {
+		if format, err = formatName(req.InputFormat); err != nil {
+			return nil, err
+		}
+	}
+	if dataformats.FactoryGetInput(format) == nil {
+		return nil, errors.Errorf("Unknown input format:%s", format)
+	}
+
+	ectx := executors.NewExecutorContext(ctx, log, conf, session)
+	executor, err := executors.ExecutorFactory(ectx, insertPlan)
+	if err != nil {
+		log.Error("%+v", err)
+		return nil, err
+	}
+	result, err := executor.Execute()
+	if err != nil {
+		log.Error("%+v", err)
+		return nil, err
+	}
+
+	output := result.Out
+	data := &insertReader{stream: stream, data: req.Data}
+	input := datastreams.NewCustomFormatBlockInputStream(output.SampleBlock(), data, format, dataformats.DefaultFormatSettings())
+	defer input.Close()
+	var rows int
+	for {
+		block, err := input.Read()
+		if err != nil {
+			log.Error("%+v", err)
+			return nil, err
+		}
+		if block == nil {
+			break
+		}
+		log.Debug("GRPCHandler->Insert->DataBlock: rows:%+v", block.NumRows())
+		rows += block.NumRows()
+		if err := output.Write(block); err != nil {
+			return nil, err
+		}
+	}
+	if err := output.Finalize(); err != nil {
+		return nil, err
+	}
+	log.Debug("GRPCHandler->Insert->Return")
+	return &vectorsqlpb.InsertResponse{QueryId: id, WrittenRows: uint64(rows)}, nil
+}
+
+// insertReader reads the data of the messages of the stream in order, until the client closes it.
+type insertReader struct {
+	stream vectorsqlpb.VectorSQL_InsertServer
+	data   []byte
+}
+
+func (r *insertReader) Read(p []byte) (int, error) {
+	for len(r.data) == 0 {
+		req, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		r.data = req.Data
+	}
+	n := copy(p, r.data)
+	r.data = r.data[n:]
+	return n, nil
+}
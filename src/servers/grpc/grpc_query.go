@@ -0,0 +1,155 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package grpc
+
+import (
+	"bytes"
+	"time"
+
+	"datablocks"
+	"dataformats"
+	"executors"
+	"optimizers"
+	"planners"
+	"servers/grpc/vectorsqlpb"
+	"sessions"
+
+	"base/errors"
+)
+
+// formatNames are the dataformats of the Format enum.
+var formatNames = map[vectorsqlpb.Format]string{
+	vectorsqlpb.Format_NATIVE:        "Native",
+	vectorsqlpb.Format_JSON_EACH_ROW: "JSONEachRow",
+}
+
+func formatName(format vectorsqlpb.Format) (string, error) {
+	name, ok := formatNames[format]
+	if !ok {
+		return "", errors.Errorf("Unknown format:%v", format)
+	}
+	return name, nil
+}
+
+// ExecuteQuery runs the query and sends each result block as it comes, in the output format,
+// then the progress. The query is cancelled with the call.
+func (s *GRPCHandler) ExecuteQuery(req *vectorsqlpb.QueryRequest, stream vectorsqlpb.VectorSQL_ExecuteQueryServer) error {
+	return statusError(stream, s.executeQuery(req, stream))
+}
+
+func (s *GRPCHandler) executeQuery(req *vectorsqlpb.QueryRequest, stream vectorsqlpb.VectorSQL_ExecuteQueryServer) error {
+	log := s.log
+	start := time.Now()
+
+	format, err := formatName(req.OutputFormat)
+	if err != nil {
+		return err
+	}
+	session, err := s.newSession(req.User, req.Password, req.Database)
+	if err != nil {
+		log.Warning("GRPCHandler->Authentication: %v", err)
+		return err
+	}
+	defer session.Close()
+	log.Debug("GRPCHandler-Query->Enter:%+v, user:%s", req.Query, session.GetUser().Name)
+
+	conf, _, err := s.conf.WithSettings(querySettings(session.GetUser(), req.Settings))
+	if err != nil {
+		return err
+	}
+	ctx, limits := executors.NewExecutionLimits(stream.Context(), conf)
+	defer limits.Cancel()
+	if err := executors.ShutdownError(); err != nil {
+		return err
+	}
+	id, err := limits.StartQuery(req.QueryId, req.Query, session)
+	if err != nil {
+		return err
+	}
+	log = log.With("query_id", id)
+
+	// Logical plans.
+	plan, err := planners.PlanFactory(req.Query)
+	if err != nil {
+		log.Error("%+v", err)
+		return err
+	}
+	plan = optimizers.Optimize(conf, plan, optimizers.DefaultOptimizers)
+	if insertPlan, ok := plan.(*planners.InsertPlan); ok && insertPlan.SubPlan == nil {
+		return errors.New("INSERT with the data is not supported by ExecuteQuery, use the Insert call")
+	}
+
+	// Executors.
+	ectx := executors.NewExecutorContext(ctx, log, conf, session)
+	ectx.SetProgressCallback(func(pv *sessions.ProgressValues) {
+		session.UpdateProgress(pv)
+		limits.CheckSpeed(pv)
+	})
+	executor, err := executors.ExecutorFactory(ectx, plan)
+	if err != nil {
+		log.Error("%+v", err)
+		return err
+	}
+	result, err := executor.Execute()
+	if err != nil {
+		log.Error("%+v", err)
+		return err
+	}
+
+	if result.In != nil {
+		settings := dataformats.DefaultFormatSettings()
+		sink := result.In
+		for x := range sink.In().Recv() {
+			switch x := x.(type) {
+			case error:
+				x = limits.Error(x, session.GetProgress())
+				log.Error("%+v", x)
+				limits.Cancel()
+				// The rest is drained, the pipeline stops at the cancellation.
+				sink.In().Stop()
+				return x
+			case *datablocks.DataBlock:
+				// The message is kept by the stream after Send, each block has its own buffer.
+				var buf bytes.Buffer
+				if err := writeBlock(&buf, format, settings, x); err != nil {
+					return err
+				}
+				rows := x.NumRows()
+				x.Release()
+				if err := stream.Send(&vectorsqlpb.QueryResponse{
+					QueryId: id,
+					Output:  buf.Bytes(),
+					Rows:    uint64(rows),
+				}); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	progress := session.GetProgress()
+	log.Debug("%v", executor.String())
+	log.Debug("Query memory peak:%d bytes", limits.MemoryTracker().Peak())
+	return stream.Send(&vectorsqlpb.QueryResponse{
+		QueryId: id,
+		Progress: &vectorsqlpb.Progress{
+			ReadRows:  uint64(progress.ReadRows.Get()),
+			ReadBytes: uint64(progress.ReadBytes.Get()),
+			ElapsedNs: uint64(time.Since(start)),
+		},
+	})
+}
+
+// writeBlock writes the block alone in the format, the client decodes each response by itself.
+func writeBlock(buf *bytes.Buffer, format string, settings *dataformats.FormatSettings, block *datablocks.DataBlock) error {
+	output := dataformats.FactoryGetOutput(format)(block, buf, settings)
+	if err := output.WritePrefix(); err != nil {
+		return err
+	}
+	if err := output.Write(block); err != nil {
+		return err
+	}
+	return output.WriteSuffix()
+}
@@ -0,0 +1,641 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: vectorsql.proto
+
+package vectorsqlpb
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// Format is the encoding of the result blocks and of the inserted data.
+type Format int32
+
+const (
+	// The Native format of the HTTP interface, the columns encoding of the native protocol blocks.
+	Format_NATIVE Format = 0
+	// One JSON object per row.
+	Format_JSON_EACH_ROW Format = 1
+)
+
+var Format_name = map[int32]string{
+	0: "NATIVE",
+	1: "JSON_EACH_ROW",
+}
+
+var Format_value = map[string]int32{
+	"NATIVE":        0,
+	"JSON_EACH_ROW": 1,
+}
+
+func (x Format) String() string {
+	return proto.EnumName(Format_name, int32(x))
+}
+
+func (Format) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fbd24441087dad53, []int{0}
+}
+
+type QueryRequest struct {
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// The id of the query, a UUID is made if empty.
+	QueryId string `protobuf:"bytes,2,opt,name=query_id,json=queryId,proto3" json:"query_id,omitempty"`
+	// The settings of the query, such as max_threads and max_execution_time.
+	Settings map[string]string `protobuf:"bytes,3,rep,name=settings,proto3" json:"settings,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The current database, the default one if empty.
+	Database     string `protobuf:"bytes,4,opt,name=database,proto3" json:"database,omitempty"`
+	OutputFormat Format `protobuf:"varint,5,opt,name=output_format,json=outputFormat,proto3,enum=vectorsql.Format" json:"output_format,omitempty"`
+	// The credentials, the default user if empty.
+	User                 string   `protobuf:"bytes,6,opt,name=user,proto3" json:"user,omitempty"`
+	Password             string   `protobuf:"bytes,7,opt,name=password,proto3" json:"password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryRequest) Reset()         { *m = QueryRequest{} }
+func (m *QueryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRequest) ProtoMessage()    {}
+func (*QueryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbd24441087dad53, []int{0}
+}
+
+func (m *QueryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryRequest.Unmarshal(m, b)
+}
+func (m *QueryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRequest.Merge(m, src)
+}
+func (m *QueryRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryRequest.Size(m)
+}
+func (m *QueryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRequest proto.InternalMessageInfo
+
+func (m *QueryRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *QueryRequest) GetQueryId() string {
+	if m != nil {
+		return m.QueryId
+	}
+	return ""
+}
+
+func (m *QueryRequest) GetSettings() map[string]string {
+	if m != nil {
+		return m.Settings
+	}
+	return nil
+}
+
+func (m *QueryRequest) GetDatabase() string {
+	if m != nil {
+		return m.Database
+	}
+	return ""
+}
+
+func (m *QueryRequest) GetOutputFormat() Format {
+	if m != nil {
+		return m.OutputFormat
+	}
+	return Format_NATIVE
+}
+
+func (m *QueryRequest) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *QueryRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+// QueryResponse is one result block in the output format,
+// the last response has the progress of the query and no output.
+type QueryResponse struct {
+	QueryId              string    `protobuf:"bytes,1,opt,name=query_id,json=queryId,proto3" json:"query_id,omitempty"`
+	Output               []byte    `protobuf:"bytes,2,opt,name=output,proto3" json:"output,omitempty"`
+	Rows                 uint64    `protobuf:"varint,3,opt,name=rows,proto3" json:"rows,omitempty"`
+	Progress             *Progress `protobuf:"bytes,4,opt,name=progress,proto3" json:"progress,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *QueryResponse) Reset()         { *m = QueryResponse{} }
+func (m *QueryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryResponse) ProtoMessage()    {}
+func (*QueryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbd24441087dad53, []int{1}
+}
+
+func (m *QueryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryResponse.Unmarshal(m, b)
+}
+func (m *QueryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResponse.Merge(m, src)
+}
+func (m *QueryResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryResponse.Size(m)
+}
+func (m *QueryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryResponse proto.InternalMessageInfo
+
+func (m *QueryResponse) GetQueryId() string {
+	if m != nil {
+		return m.QueryId
+	}
+	return ""
+}
+
+func (m *QueryResponse) GetOutput() []byte {
+	if m != nil {
+		return m.Output
+	}
+	return nil
+}
+
+func (m *QueryResponse) GetRows() uint64 {
+	if m != nil {
+		return m.Rows
+	}
+	return 0
+}
+
+func (m *QueryResponse) GetProgress() *Progress {
+	if m != nil {
+		return m.Progress
+	}
+	return nil
+}
+
+type Progress struct {
+	ReadRows             uint64   `protobuf:"varint,1,opt,name=read_rows,json=readRows,proto3" json:"read_rows,omitempty"`
+	ReadBytes            uint64   `protobuf:"varint,2,opt,name=read_bytes,json=readBytes,proto3" json:"read_bytes,omitempty"`
+	ElapsedNs            uint64   `protobuf:"varint,3,opt,name=elapsed_ns,json=elapsedNs,proto3" json:"elapsed_ns,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Progress) Reset()         { *m = Progress{} }
+func (m *Progress) String() string { return proto.CompactTextString(m) }
+func (*Progress) ProtoMessage()    {}
+func (*Progress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbd24441087dad53, []int{2}
+}
+
+func (m *Progress) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Progress.Unmarshal(m, b)
+}
+func (m *Progress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Progress.Marshal(b, m, deterministic)
+}
+func (m *Progress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Progress.Merge(m, src)
+}
+func (m *Progress) XXX_Size() int {
+	return xxx_messageInfo_Progress.Size(m)
+}
+func (m *Progress) XXX_DiscardUnknown() {
+	xxx_messageInfo_Progress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Progress proto.InternalMessageInfo
+
+func (m *Progress) GetReadRows() uint64 {
+	if m != nil {
+		return m.ReadRows
+	}
+	return 0
+}
+
+func (m *Progress) GetReadBytes() uint64 {
+	if m != nil {
+		return m.ReadBytes
+	}
+	return 0
+}
+
+func (m *Progress) GetElapsedNs() uint64 {
+	if m != nil {
+		return m.ElapsedNs
+	}
+	return 0
+}
+
+// InsertRequest is one message of the Insert stream,
+// the first one has the INSERT query and the settings, all of them may have the data.
+type InsertRequest struct {
+	Query    string            `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	QueryId  string            `protobuf:"bytes,2,opt,name=query_id,json=queryId,proto3" json:"query_id,omitempty"`
+	Settings map[string]string `protobuf:"bytes,3,rep,name=settings,proto3" json:"settings,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Database string            `protobuf:"bytes,4,opt,name=database,proto3" json:"database,omitempty"`
+	// The format of the data, the FORMAT clause of the query wins.
+	InputFormat          Format   `protobuf:"varint,5,opt,name=input_format,json=inputFormat,proto3,enum=vectorsql.Format" json:"input_format,omitempty"`
+	User                 string   `protobuf:"bytes,6,opt,name=user,proto3" json:"user,omitempty"`
+	Password             string   `protobuf:"bytes,7,opt,name=password,proto3" json:"password,omitempty"`
+	Data                 []byte   `protobuf:"bytes,8,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InsertRequest) Reset()         { *m = InsertRequest{} }
+func (m *InsertRequest) String() string { return proto.CompactTextString(m) }
+func (*InsertRequest) ProtoMessage()    {}
+func (*InsertRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbd24441087dad53, []int{3}
+}
+
+func (m *InsertRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InsertRequest.Unmarshal(m, b)
+}
+func (m *InsertRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InsertRequest.Marshal(b, m, deterministic)
+}
+func (m *InsertRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsertRequest.Merge(m, src)
+}
+func (m *InsertRequest) XXX_Size() int {
+	return xxx_messageInfo_InsertRequest.Size(m)
+}
+func (m *InsertRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsertRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InsertRequest proto.InternalMessageInfo
+
+func (m *InsertRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *InsertRequest) GetQueryId() string {
+	if m != nil {
+		return m.QueryId
+	}
+	return ""
+}
+
+func (m *InsertRequest) GetSettings() map[string]string {
+	if m != nil {
+		return m.Settings
+	}
+	return nil
+}
+
+func (m *InsertRequest) GetDatabase() string {
+	if m != nil {
+		return m.Database
+	}
+	return ""
+}
+
+func (m *InsertRequest) GetInputFormat() Format {
+	if m != nil {
+		return m.InputFormat
+	}
+	return Format_NATIVE
+}
+
+func (m *InsertRequest) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *InsertRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+func (m *InsertRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type InsertResponse struct {
+	QueryId              string   `protobuf:"bytes,1,opt,name=query_id,json=queryId,proto3" json:"query_id,omitempty"`
+	WrittenRows          uint64   `protobuf:"varint,2,opt,name=written_rows,json=writtenRows,proto3" json:"written_rows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InsertResponse) Reset()         { *m = InsertResponse{} }
+func (m *InsertResponse) String() string { return proto.CompactTextString(m) }
+func (*InsertResponse) ProtoMessage()    {}
+func (*InsertResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbd24441087dad53, []int{4}
+}
+
+func (m *InsertResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InsertResponse.Unmarshal(m, b)
+}
+func (m *InsertResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InsertResponse.Marshal(b, m, deterministic)
+}
+func (m *InsertResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsertResponse.Merge(m, src)
+}
+func (m *InsertResponse) XXX_Size() int {
+	return xxx_messageInfo_InsertResponse.Size(m)
+}
+func (m *InsertResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsertResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InsertResponse proto.InternalMessageInfo
+
+func (m *InsertResponse) GetQueryId() string {
+	if m != nil {
+		return m.QueryId
+	}
+	return ""
+}
+
+func (m *InsertResponse) GetWrittenRows() uint64 {
+	if m != nil {
+		return m.WrittenRows
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("vectorsql.Format", Format_name, Format_value)
+	proto.RegisterType((*QueryRequest)(nil), "vectorsql.QueryRequest")
+	proto.RegisterMapType((map[string]string)(nil), "vectorsql.QueryRequest.SettingsEntry")
+	proto.RegisterType((*QueryResponse)(nil), "vectorsql.QueryResponse")
+	proto.RegisterType((*Progress)(nil), "vectorsql.Progress")
+	proto.RegisterType((*InsertRequest)(nil), "vectorsql.InsertRequest")
+	proto.RegisterMapType((map[string]string)(nil), "vectorsql.InsertRequest.SettingsEntry")
+	proto.RegisterType((*InsertResponse)(nil), "vectorsql.InsertResponse")
+}
+
+func init() { proto.RegisterFile("vectorsql.proto", fileDescriptor_fbd24441087dad53) }
+
+var fileDescriptor_fbd24441087dad53 = []byte{
+	// 529 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x51, 0x8f, 0xd2, 0x40,
+	0x10, 0x76, 0x81, 0xeb, 0x95, 0xa1, 0x3d, 0xb9, 0xd5, 0x68, 0x0f, 0x63, 0x82, 0x24, 0x2a, 0xf1,
+	0x01, 0x0d, 0x1a, 0x63, 0xf4, 0xc1, 0xc0, 0x05, 0x23, 0xc6, 0x70, 0x5e, 0x31, 0x67, 0xe2, 0x4b,
+	0x53, 0xae, 0xe3, 0x85, 0x88, 0x6d, 0xd9, 0xdd, 0x1e, 0xf2, 0x0b, 0x7c, 0xf6, 0x37, 0xf9, 0x23,
+	0xfc, 0x3b, 0x66, 0x67, 0x4b, 0x85, 0x9c, 0x17, 0x0d, 0xbe, 0xed, 0xcc, 0x37, 0x7c, 0x33, 0xf3,
+	0xcd, 0x47, 0xe1, 0xea, 0x39, 0x9e, 0xaa, 0x44, 0xc8, 0xf9, 0xac, 0x93, 0x8a, 0x44, 0x25, 0xbc,
+	0x5a, 0x24, 0x5a, 0x3f, 0x4a, 0xe0, 0x1c, 0x67, 0x28, 0x96, 0x3e, 0xce, 0x33, 0x94, 0x8a, 0x5f,
+	0x87, 0x9d, 0xb9, 0x8e, 0x3d, 0xd6, 0x64, 0xed, 0xaa, 0x6f, 0x02, 0x7e, 0x00, 0x36, 0x3d, 0x82,
+	0x69, 0xe4, 0x95, 0x08, 0xd8, 0xa5, 0x78, 0x18, 0xf1, 0x1e, 0xd8, 0x12, 0x95, 0x9a, 0xc6, 0x67,
+	0xd2, 0x2b, 0x37, 0xcb, 0xed, 0x5a, 0xf7, 0x6e, 0xe7, 0x77, 0xc3, 0x75, 0xee, 0xce, 0x38, 0xaf,
+	0x1b, 0xc4, 0x4a, 0x2c, 0xfd, 0xe2, 0x67, 0xbc, 0x01, 0x76, 0x14, 0xaa, 0x70, 0x12, 0x4a, 0xf4,
+	0x2a, 0xc4, 0x5e, 0xc4, 0xfc, 0x29, 0xb8, 0x49, 0xa6, 0xd2, 0x4c, 0x05, 0x9f, 0x12, 0xf1, 0x25,
+	0x54, 0xde, 0x4e, 0x93, 0xb5, 0xf7, 0xba, 0xfb, 0x6b, 0x3d, 0x5e, 0x11, 0xe0, 0x3b, 0xa6, 0xce,
+	0x44, 0x9c, 0x43, 0x25, 0x93, 0x28, 0x3c, 0x8b, 0xf8, 0xe8, 0xad, 0xfb, 0xa4, 0xa1, 0x94, 0x8b,
+	0x44, 0x44, 0xde, 0xae, 0xe9, 0xb3, 0x8a, 0x1b, 0x2f, 0xc0, 0xdd, 0x18, 0x8f, 0xd7, 0xa1, 0xfc,
+	0x19, 0x57, 0x32, 0xe8, 0xa7, 0x96, 0xe6, 0x3c, 0x9c, 0x65, 0x98, 0x2b, 0x60, 0x82, 0xe7, 0xa5,
+	0x67, 0xac, 0xf5, 0x8d, 0x81, 0x9b, 0x6f, 0x2a, 0xd3, 0x24, 0x96, 0xb8, 0x21, 0x18, 0xdb, 0x14,
+	0xec, 0x06, 0x58, 0x66, 0x52, 0xe2, 0x71, 0xfc, 0x3c, 0xd2, 0x13, 0x8b, 0x64, 0xa1, 0x45, 0x64,
+	0xed, 0x8a, 0x4f, 0x6f, 0xfe, 0x10, 0xec, 0x54, 0x24, 0x67, 0x02, 0xa5, 0x24, 0x65, 0x6a, 0xdd,
+	0x6b, 0x6b, 0x8b, 0xbf, 0xcb, 0x21, 0xbf, 0x28, 0x6a, 0x21, 0xd8, 0xab, 0x2c, 0xbf, 0x05, 0x55,
+	0x81, 0x61, 0x14, 0x10, 0x2b, 0x23, 0x56, 0x5b, 0x27, 0x7c, 0xcd, 0x7c, 0x1b, 0x80, 0xc0, 0xc9,
+	0x52, 0xa1, 0xa4, 0x49, 0x2a, 0x3e, 0x95, 0xf7, 0x75, 0x42, 0xc3, 0x38, 0x0b, 0x53, 0x89, 0x51,
+	0x10, 0xaf, 0x46, 0xaa, 0xe6, 0x99, 0x91, 0x6c, 0xfd, 0x2c, 0x81, 0x3b, 0x8c, 0x25, 0x0a, 0xb5,
+	0xb5, 0x6f, 0xfa, 0x17, 0x7c, 0x73, 0x6f, 0x6d, 0xb5, 0x0d, 0xf2, 0xad, 0x8c, 0xf3, 0x04, 0x9c,
+	0x69, 0xfc, 0x2f, 0xbe, 0xa9, 0x51, 0xd9, 0x76, 0xb6, 0xd1, 0xf5, 0xba, 0xa3, 0x67, 0xd3, 0x29,
+	0xe9, 0xfd, 0x7f, 0x56, 0x1a, 0xc1, 0xde, 0x6a, 0xf7, 0xbf, 0x5b, 0xe9, 0x0e, 0x38, 0x0b, 0x31,
+	0x55, 0x0a, 0x63, 0x73, 0x64, 0x73, 0xc6, 0x5a, 0x9e, 0xd3, 0x77, 0x7e, 0x70, 0x1f, 0xac, 0x7c,
+	0x35, 0x00, 0x6b, 0xd4, 0x7b, 0x3f, 0x3c, 0x19, 0xd4, 0xaf, 0xf0, 0x7d, 0x70, 0xdf, 0x8c, 0x8f,
+	0x46, 0xc1, 0xa0, 0x77, 0xf8, 0x3a, 0xf0, 0x8f, 0x3e, 0xd4, 0x59, 0xf7, 0x3b, 0x83, 0xea, 0x09,
+	0x69, 0x33, 0x3e, 0x7e, 0xcb, 0x0f, 0xc1, 0x19, 0x7c, 0xc5, 0xd3, 0x4c, 0x21, 0xf9, 0x9a, 0xdf,
+	0xbc, 0xe4, 0x3f, 0xdd, 0xf0, 0x2e, 0x02, 0x66, 0xee, 0x47, 0x8c, 0xbf, 0x04, 0xcb, 0xec, 0xc2,
+	0xbd, 0xcb, 0x4e, 0xdb, 0x38, 0xf8, 0x03, 0x62, 0x08, 0xda, 0xac, 0xef, 0x7e, 0xac, 0x15, 0x68,
+	0x3a, 0x99, 0x58, 0xf4, 0xf9, 0x7a, 0xfc, 0x6b, 0x00, 0xd4, 0xce, 0xb0, 0xbb, 0xd1, 0x04, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// VectorSQLClient is the client API for VectorSQL service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type VectorSQLClient interface {
+	// ExecuteQuery runs the query and streams the result blocks as they come.
+	ExecuteQuery(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (VectorSQL_ExecuteQueryClient, error)
+	// Insert streams the data into the table of the INSERT query of the first message.
+	Insert(ctx context.Context, opts ...grpc.CallOption) (VectorSQL_InsertClient, error)
+}
+
+type vectorSQLClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewVectorSQLClient(cc grpc.ClientConnInterface) VectorSQLClient {
+	return &vectorSQLClient{cc}
+}
+
+func (c *vectorSQLClient) ExecuteQuery(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (VectorSQL_ExecuteQueryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_VectorSQL_serviceDesc.Streams[0], "/vectorsql.VectorSQL/ExecuteQuery", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vectorSQLExecuteQueryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type VectorSQL_ExecuteQueryClient interface {
+	Recv() (*QueryResponse, error)
+	grpc.ClientStream
+}
+
+type vectorSQLExecuteQueryClient struct {
+	grpc.ClientStream
+}
+
+func (x *vectorSQLExecuteQueryClient) Recv() (*QueryResponse, error) {
+	m := new(QueryResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vectorSQLClient) Insert(ctx context.Context, opts ...grpc.CallOption) (VectorSQL_InsertClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_VectorSQL_serviceDesc.Streams[1], "/vectorsql.VectorSQL/Insert", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vectorSQLInsertClient{stream}
+	return x, nil
+}
+
+type VectorSQL_InsertClient interface {
+	Send(*InsertRequest) error
+	CloseAndRecv() (*InsertResponse, error)
+	grpc.ClientStream
+}
+
+type vectorSQLInsertClient struct {
+	grpc.ClientStream
+}
+
+func (x *vectorSQLInsertClient) Send(m *InsertRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *vectorSQLInsertClient) CloseAndRecv() (*InsertResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(InsertResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// VectorSQLServer is the server API for VectorSQL service.
+type VectorSQLServer interface {
+	// ExecuteQuery runs the query and streams the result blocks as they come.
+	ExecuteQuery(*QueryRequest, VectorSQL_ExecuteQueryServer) error
+	// Insert streams the data into the table of the INSERT query of the first message.
+	Insert(VectorSQL_InsertServer) error
+}
+
+// UnimplementedVectorSQLServer can be embedded to have forward compatible implementations.
+type UnimplementedVectorSQLServer struct {
+}
+
+func (*UnimplementedVectorSQLServer) ExecuteQuery(req *QueryRequest, srv VectorSQL_ExecuteQueryServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExecuteQuery not implemented")
+}
+func (*UnimplementedVectorSQLServer) Insert(srv VectorSQL_InsertServer) error {
+	return status.Errorf(codes.Unimplemented, "method Insert not implemented")
+}
+
+func RegisterVectorSQLServer(s *grpc.Server, srv VectorSQLServer) {
+	s.RegisterService(&_VectorSQL_serviceDesc, srv)
+}
+
+func _VectorSQL_ExecuteQuery_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(QueryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VectorSQLServer).ExecuteQuery(m, &vectorSQLExecuteQueryServer{stream})
+}
+
+type VectorSQL_ExecuteQueryServer interface {
+	Send(*QueryResponse) error
+	grpc.ServerStream
+}
+
+type vectorSQLExecuteQueryServer struct {
+	grpc.ServerStream
+}
+
+func (x *vectorSQLExecuteQueryServer) Send(m *QueryResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _VectorSQL_Insert_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(VectorSQLServer).Insert(&vectorSQLInsertServer{stream})
+}
+
+type VectorSQL_InsertServer interface {
+	SendAndClose(*InsertResponse) error
+	Recv() (*InsertRequest, error)
+	grpc.ServerStream
+}
+
+type vectorSQLInsertServer struct {
+	grpc.ServerStream
+}
+
+func (x *vectorSQLInsertServer) SendAndClose(m *InsertResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *vectorSQLInsertServer) Recv() (*InsertRequest, error) {
+	m := new(InsertRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+var _VectorSQL_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "vectorsql.VectorSQL",
+	HandlerType: (*VectorSQLServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExecuteQuery",
+			Handler:       _VectorSQL_ExecuteQuery_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Insert",
+			Handler:       _VectorSQL_Insert_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "vectorsql.proto",
+}
@@ -0,0 +1,36 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package http
+
+import (
+	"net/http"
+	"net/url"
+
+	"users"
+)
+
+// authenticate checks the credentials of the request,
+// from the X-ClickHouse-User/X-ClickHouse-Key headers, the basic auth, or the user/password parameters.
+func (s *HTTPHandler) authenticate(req *http.Request, params url.Values) (*users.User, error) {
+	name := req.Header.Get("X-ClickHouse-User")
+	password := req.Header.Get("X-ClickHouse-Key")
+	if name == "" && password == "" {
+		if basicName, basicPassword, ok := req.BasicAuth(); ok {
+			name, password = basicName, basicPassword
+		} else {
+			name, password = params.Get("user"), params.Get("password")
+		}
+	}
+	return users.Authenticate(s.conf, name, password)
+}
+
+// applyProfile sets the settings of the user profile which are not given as the parameters.
+func applyProfile(user *users.User, params url.Values) {
+	for k, v := range user.Settings {
+		if params.Get(k) == "" {
+			params.Set(k, v)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package http
+
+import (
+	"compress/flate"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"base/errors"
+)
+
+const (
+	defaultCompressionLevel = 3
+)
+
+// responseEncoding returns the encoding of the response body accepted by the client,
+// it's empty unless the enable_http_compression setting is on.
+func responseEncoding(params url.Values, req *http.Request) (string, int, error) {
+	switch v := params.Get("enable_http_compression"); v {
+	case "", "0", "false":
+		return "", 0, nil
+	case "1", "true":
+	default:
+		return "", 0, errors.Errorf("Invalid setting enable_http_compression:%s", v)
+	}
+
+	level := defaultCompressionLevel
+	if v := params.Get("http_zlib_compression_level"); v != "" {
+		l, err := strconv.Atoi(v)
+		if err != nil || l < flate.HuffmanOnly || l > flate.BestCompression {
+			return "", 0, errors.Errorf("Invalid setting http_zlib_compression_level:%s", v)
+		}
+		level = l
+	}
+
+	accepts := map[string]bool{}
+	for _, token := range strings.Split(req.Header.Get("Accept-Encoding"), ",") {
+		parts := strings.Split(token, ";")
+		name := strings.ToLower(strings.TrimSpace(parts[0]))
+		if len(parts) > 1 && strings.TrimSpace(parts[1]) == "q=0" {
+			continue
+		}
+		accepts[name] = true
+	}
+	for _, encoding := range []string{"gzip", "deflate"} {
+		if accepts[encoding] {
+			return encoding, level, nil
+		}
+	}
+	return "", 0, nil
+}
+
+// decompressBody replaces the request body with the decompressed reader by the Content-Encoding,
+// so the format parsers read the plain data in streaming fashion.
+func decompressBody(req *http.Request) error {
+	var body io.ReadCloser
+
+	switch encoding := strings.ToLower(req.Header.Get("Content-Encoding")); encoding {
+	case "", "identity":
+		return nil
+	case "gzip":
+		reader, err := gzip.NewReader(req.Body)
+		if err != nil {
+			return errors.Wrap(err)
+		}
+		body = reader
+	case "deflate":
+		body = flate.NewReader(req.Body)
+	default:
+		return errors.Errorf("Unknown Content-Encoding:%s", encoding)
+	}
+	req.Body = &decompressReader{ReadCloser: body, raw: req.Body}
+	return nil
+}
+
+type decompressReader struct {
+	io.ReadCloser
+	raw io.Closer
+}
+
+func (r *decompressReader) Close() error {
+	err := r.ReadCloser.Close()
+	if rerr := r.raw.Close(); err == nil {
+		err = rerr
+	}
+	return err
+}
@@ -0,0 +1,90 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package http
+
+import (
+	"mime"
+	"net/http"
+	"net/url"
+	"sort"
+
+	"columns"
+	"datablocks"
+	"dataformats"
+	"datastreams"
+	"executors"
+
+	"base/errors"
+)
+
+const (
+	// The bytes of the multipart files kept in memory, the rest goes to the temporary files.
+	maxExternalMemory = 32 << 20
+)
+
+// receiveExternalTables reads the files of the multipart/form-data body into the external tables.
+// The table is named by the file field, its columns are by the '<name>_structure' or '<name>_types'
+// parameter and its format by the '<name>_format', TabSeparated by default.
+func (s *HTTPHandler) receiveExternalTables(req *http.Request, params url.Values, external *executors.ExternalTables) error {
+	if req.Method != http.MethodPost {
+		return nil
+	}
+	if mediaType, _, err := mime.ParseMediaType(req.Header.Get("Content-Type")); err != nil || mediaType != "multipart/form-data" {
+		return nil
+	}
+	if err := req.ParseMultipartForm(maxExternalMemory); err != nil {
+		return errors.Wrap(err)
+	}
+	defer req.MultipartForm.RemoveAll()
+
+	settings, err := formatSettings(params)
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(req.MultipartForm.File))
+	for name := range req.MultipartForm.File {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		cols, err := externalColumns(name, params)
+		if err != nil {
+			return err
+		}
+		format := params.Get(name + "_format")
+		if format == "" {
+			format = defaultInputFormat
+		}
+		if dataformats.FactoryGetInput(format) == nil {
+			return errors.Errorf("Unknown input format:%s", format)
+		}
+
+		for _, header := range req.MultipartForm.File[name] {
+			file, err := header.Open()
+			if err != nil {
+				return errors.Wrap(err)
+			}
+			input := datastreams.NewCustomFormatBlockInputStream(datablocks.NewDataBlock(cols), file, format, settings)
+			err = external.Write(name, cols, input)
+			input.Close()
+			file.Close()
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func externalColumns(name string, params url.Values) ([]*columns.Column, error) {
+	if structure := params.Get(name + "_structure"); structure != "" {
+		return executors.ParseStructure(structure)
+	}
+	if types := params.Get(name + "_types"); types != "" {
+		return executors.ParseTypes(types)
+	}
+	return nil, errors.Errorf("Neither structure nor types have been provided for external table %s, use the parameter %s_structure or %s_types", name, name, name)
+}
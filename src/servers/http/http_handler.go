@@ -5,20 +5,29 @@
 package http
 
 import (
+	"context"
+	"crypto/tls"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
+	"strings"
 
 	"config"
+	"executors"
+	"planners"
 
 	"base/errors"
+	"base/sync2"
 	"base/xlog"
 )
 
 type HTTPHandler struct {
-	httpServer *http.Server
-	log        *xlog.Log
-	conf       *config.Config
+	httpServer  *http.Server
+	httpsServer *http.Server
+	log         *xlog.Log
+	conf        *config.Config
+	draining    sync2.AtomicBool
 }
 
 func NewHTTPHandler(log *xlog.Log, conf *config.Config) *HTTPHandler {
@@ -31,44 +40,173 @@ func NewHTTPHandler(log *xlog.Log, conf *config.Config) *HTTPHandler {
 	return s
 }
 
+// SetTLSConfig enables the HTTPS listener on the https_port, before Start.
+func (s *HTTPHandler) SetTLSConfig(tlsConf *tls.Config) {
+	if s.conf.Server.HTTPSPort <= 0 {
+		return
+	}
+	s.httpsServer = &http.Server{
+		Addr:      fmt.Sprintf("%v:%v", s.conf.Server.ListenHost, s.conf.Server.HTTPSPort),
+		Handler:   s,
+		TLSConfig: tlsConf,
+	}
+}
+
 func (s *HTTPHandler) Start() {
 	log := s.log
 	go func() {
-		log.Fatal("%v", s.httpServer.ListenAndServe())
+		if err := s.httpServer.ListenAndServe(); err != http.ErrServerClosed {
+			log.Fatal("%v", err)
+		}
 	}()
+	if s.httpsServer != nil {
+		go func() {
+			// The certificate comes from the TLSConfig.
+			if err := s.httpsServer.ListenAndServeTLS("", ""); err != http.ErrServerClosed {
+				log.Fatal("%v", err)
+			}
+		}()
+	}
 }
 
-func (s *HTTPHandler) Stop() {
+// Stop closes the listeners and waits for the requests in flight until the context is done,
+// then closes their connections.
+func (s *HTTPHandler) Stop(ctx context.Context) {
+	s.Drain()
+	for _, server := range []*http.Server{s.httpServer, s.httpsServer} {
+		if server == nil {
+			continue
+		}
+		if err := server.Shutdown(ctx); err != nil {
+			server.Close()
+		}
+	}
+}
+
+// Drain makes the health checks fail, the load balancers move the traffic away before the shutdown.
+func (s *HTTPHandler) Drain() {
+	s.draining.Set(true)
 }
 
 func (s *HTTPHandler) Address() string {
 	return fmt.Sprintf(":%v", s.conf.Server.HTTPPort)
 }
 
-func (s *HTTPHandler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+// SecureAddress returns the HTTPS address, empty if not enabled.
+func (s *HTTPHandler) SecureAddress() string {
+	if s.httpsServer == nil {
+		return ""
+	}
+	return fmt.Sprintf(":%v", s.conf.Server.HTTPSPort)
+}
+
+func (s *HTTPHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 	log := s.log
 
+	// Before the authentication.
+	if isHealthCheck(req) {
+		s.serveHealth(w)
+		return
+	}
+
+	rw := newResponseWriter(w)
+	// Ends the compressed body after the exceptions.
+	defer rw.Close()
+
 	// Catch panics, and close the connection in any case.
 	defer func() {
 		if x := recover(); x != nil {
-			log.Error("%+v", errors.Errorf("%+v", x))
+			err := errors.Errorf("%+v", x)
+			log.Error("%+v", err)
+			rw.writeException(err)
 		}
 	}()
 
-	// extract the query SQL
-	var query = req.URL.Query().Get("query")
-	if query == "" && req.Method == http.MethodPost {
-		bs, err := ioutil.ReadAll(req.Body)
-		if err != nil {
-			fmt.Fprintf(rw, "%v", err.Error())
+	params := req.URL.Query()
+	user, err := s.authenticate(req, params)
+	if err != nil {
+		log.Warning("HTTPHandler->Authentication from %s: %v", req.RemoteAddr, err)
+		rw.writeException(err)
+		return
+	}
+	session, release, err := s.acquireSession(user, params)
+	if err != nil {
+		rw.writeException(err)
+		return
+	}
+	defer release()
+	// The URL parameters win over the session settings, then the profile.
+	applySession(session, params)
+	applyProfile(user, params)
+
+	encoding, level, err := responseEncoding(params, req)
+	if err != nil {
+		rw.writeException(err)
+		return
+	}
+	rw.setCompression(encoding, level)
+
+	if req.Method == http.MethodPost {
+		if err := decompressBody(req); err != nil {
+			rw.writeException(err)
 			return
 		}
-		defer req.Body.Close()
-		query = string(bs)
 	}
 
-	if err := s.processQuery(query, rw); err != nil {
-		fmt.Fprintf(rw, "%v", err.Error())
+	// The external tables of the multipart body, dropped when the query ends.
+	external := executors.NewExternalTables(log, s.conf, session)
+	defer external.Close()
+	if err := s.receiveExternalTables(req, params, external); err != nil {
+		rw.writeException(err)
 		return
 	}
+
+	query, data, err := readQuery(req)
+	if err != nil {
+		rw.writeException(err)
+		return
+	}
+
+	if err := s.processQuery(session, query, data, params, rw); err != nil {
+		rw.writeException(err)
+		return
+	}
+}
+
+// readQuery extracts the query SQL from the 'query' parameter and the POST body.
+// If both are given, the body follows the parameter,
+// except for the INSERT whose body is the data, returned unread to be streamed into the table.
+func readQuery(req *http.Request) (string, io.Reader, error) {
+	query := req.URL.Query().Get("query")
+	if req.Method == http.MethodPost {
+		if query != "" && isInsertData(query) {
+			return query, req.Body, nil
+		}
+
+		defer req.Body.Close()
+		bs, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			return "", nil, errors.Wrap(err)
+		}
+		if len(bs) > 0 {
+			if query != "" {
+				query += "\n"
+			}
+			query += string(bs)
+		}
+	}
+	if strings.TrimSpace(query) == "" {
+		return "", nil, errors.New("Empty query")
+	}
+	return query, nil, nil
+}
+
+// isInsertData checks if the query is an INSERT waiting for the data, such as 'INSERT INTO t FORMAT CSV'.
+func isInsertData(query string) bool {
+	plan, err := planners.PlanFactory(query)
+	if err != nil {
+		return false
+	}
+	insertPlan, ok := plan.(*planners.InsertPlan)
+	return ok && insertPlan.SubPlan == nil
 }
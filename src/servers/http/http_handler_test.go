@@ -0,0 +1,783 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package http
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"base/errors"
+	"config"
+	"mocks"
+	"sessions"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHTTPHandlerQuery(t *testing.T) {
+	tests := []struct {
+		name        string
+		method      string
+		target      string
+		body        string
+		code        int
+		contentType string
+		expect      string
+	}{
+		{
+			name:        "get-query",
+			method:      http.MethodGet,
+			target:      "/?query=select+name+from+system.databases",
+			code:        http.StatusOK,
+			contentType: "text/tab-separated-values; charset=UTF-8",
+			expect:      "system\n",
+		},
+		{
+			name:        "post-body",
+			method:      http.MethodPost,
+			target:      "/",
+			body:        "select name from system.databases",
+			code:        http.StatusOK,
+			contentType: "text/tab-separated-values; charset=UTF-8",
+			expect:      "system\n",
+		},
+		{
+			name:        "post-query-and-body",
+			method:      http.MethodPost,
+			target:      "/?query=select+name",
+			body:        "from system.databases FORMAT CSV",
+			code:        http.StatusOK,
+			contentType: "text/csv; charset=UTF-8; header=absent",
+			expect:      "\"system\"\n",
+		},
+		{
+			name:        "empty-query",
+			method:      http.MethodPost,
+			target:      "/",
+			code:        http.StatusInternalServerError,
+			contentType: "text/plain; charset=UTF-8",
+			expect:      "Code: 1002. DB::Exception: Empty query\n",
+		},
+		{
+			name:        "syntax-error",
+			method:      http.MethodGet,
+			target:      "/?query=selectx",
+			code:        http.StatusBadRequest,
+			contentType: "text/plain; charset=UTF-8",
+			expect:      "Code: 62. DB::Exception: syntax error at position 8 near 'selectx' (errno 62)\n",
+		},
+		{
+			name:        "unknown-format",
+			method:      http.MethodGet,
+			target:      "/?query=select+name+from+system.databases&default_format=XX",
+			code:        http.StatusInternalServerError,
+			contentType: "text/plain; charset=UTF-8",
+			expect:      "Code: 1002. DB::Exception: Unknown format:XX\n",
+		},
+	}
+
+	mock, cleanup := mocks.NewMock()
+	defer cleanup()
+	handler := NewHTTPHandler(mock.Log, mock.Conf)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rw := httptest.NewRecorder()
+			req := httptest.NewRequest(test.method, test.target, strings.NewReader(test.body))
+			handler.ServeHTTP(rw, req)
+			assert.Equal(t, test.code, rw.Code)
+			assert.Equal(t, test.contentType, rw.Header().Get("Content-Type"))
+			assert.Equal(t, test.expect, rw.Body.String())
+		})
+	}
+}
+
+func TestHTTPExceptionTrailer(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	rw := newResponseWriter(recorder)
+	rw.Header().Set("Content-Type", "text/tab-separated-values; charset=UTF-8")
+	_, err := rw.Write([]byte("1\n"))
+	assert.Nil(t, err)
+	rw.Flush()
+
+	rw.writeException(errors.New("broken"))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.True(t, recorder.Flushed)
+	assert.Equal(t, "1\n\nCode: 1002. DB::Exception: broken\n", recorder.Body.String())
+}
+
+func TestHTTPHandlerInsert(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		target string
+		body   string
+		code   int
+		expect string
+	}{
+		{
+			name:   "create-db",
+			method: http.MethodGet,
+			target: "/?query=create+database+db1",
+			code:   http.StatusOK,
+		},
+		{
+			name:   "create-table",
+			method: http.MethodGet,
+			target: "/?query=create+table+db1.t1(a+UInt32,b+String)+Engine=Memory",
+			code:   http.StatusOK,
+		},
+		{
+			name:   "insert-csv",
+			method: http.MethodPost,
+			target: "/?query=insert+into+db1.t1+FORMAT+CSV&max_insert_block_size=2",
+			body:   "1,\"a\"\n2,\"b,b\"\n3,c\n",
+			code:   http.StatusOK,
+		},
+		{
+			name:   "insert-tsv",
+			method: http.MethodPost,
+			target: "/?query=insert+into+db1.t1",
+			body:   "4\td\n",
+			code:   http.StatusOK,
+		},
+		{
+			name:   "insert-jsoneachrow",
+			method: http.MethodPost,
+			target: "/?query=insert+into+db1.t1+FORMAT+JSONEachRow",
+			body:   "{\"a\":5,\"b\":\"e\"}\n{\"a\":6}\n",
+			code:   http.StatusOK,
+		},
+		{
+			name:   "insert-parse-error",
+			method: http.MethodPost,
+			target: "/?query=insert+into+db1.t1+FORMAT+CSV",
+			body:   "7,g\nx,h\n",
+			code:   http.StatusBadRequest,
+			expect: "Code: 6. DB::Exception: Cannot parse input at line 2: column:a strconv.ParseUint: parsing \"x\": invalid syntax, row: \"x,h\" (errno 6)\n",
+		},
+		{
+			name:   "insert-unknown-format",
+			method: http.MethodPost,
+			target: "/?query=insert+into+db1.t1+FORMAT+XX",
+			code:   http.StatusInternalServerError,
+			expect: "Code: 1002. DB::Exception: Unknown input format:XX\n",
+		},
+		{
+			name:   "select",
+			method: http.MethodGet,
+			target: "/?query=select+a,b+from+db1.t1+order+by+a",
+			code:   http.StatusOK,
+			expect: "1\ta\n2\tb,b\n3\tc\n4\td\n5\te\n6\t\n",
+		},
+		{
+			name:   "drop-db",
+			method: http.MethodGet,
+			target: "/?query=drop+database+db1",
+			code:   http.StatusOK,
+		},
+	}
+
+	mock, cleanup := mocks.NewMock()
+	defer cleanup()
+	handler := NewHTTPHandler(mock.Log, mock.Conf)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rw := httptest.NewRecorder()
+			req := httptest.NewRequest(test.method, test.target, strings.NewReader(test.body))
+			handler.ServeHTTP(rw, req)
+			assert.Equal(t, test.code, rw.Code)
+			assert.Equal(t, test.expect, rw.Body.String())
+		})
+	}
+}
+
+func TestHTTPHandlerCompression(t *testing.T) {
+	tests := []struct {
+		name           string
+		target         string
+		acceptEncoding string
+		encoding       string
+		code           int
+		expect         string
+	}{
+		{
+			name:           "gzip",
+			target:         "/?query=select+name+from+system.databases&enable_http_compression=1",
+			acceptEncoding: "gzip, deflate",
+			encoding:       "gzip",
+			code:           http.StatusOK,
+			expect:         "system\n",
+		},
+		{
+			name:           "deflate",
+			target:         "/?query=select+name+from+system.databases&enable_http_compression=1&http_zlib_compression_level=9",
+			acceptEncoding: "deflate",
+			encoding:       "deflate",
+			code:           http.StatusOK,
+			expect:         "system\n",
+		},
+		{
+			name:           "disabled",
+			target:         "/?query=select+name+from+system.databases",
+			acceptEncoding: "gzip",
+			code:           http.StatusOK,
+			expect:         "system\n",
+		},
+		{
+			name:           "not-accepted",
+			target:         "/?query=select+name+from+system.databases&enable_http_compression=1",
+			acceptEncoding: "br, gzip;q=0",
+			code:           http.StatusOK,
+			expect:         "system\n",
+		},
+		{
+			name:           "exception-uncompressed",
+			target:         "/?query=selectx&enable_http_compression=1",
+			acceptEncoding: "gzip",
+			code:           http.StatusBadRequest,
+			expect:         "Code: 62. DB::Exception: syntax error at position 8 near 'selectx' (errno 62)\n",
+		},
+		{
+			name:           "invalid-level",
+			target:         "/?query=select+1&enable_http_compression=1&http_zlib_compression_level=10",
+			acceptEncoding: "gzip",
+			code:           http.StatusInternalServerError,
+			expect:         "Code: 1002. DB::Exception: Invalid setting http_zlib_compression_level:10\n",
+		},
+	}
+
+	mock, cleanup := mocks.NewMock()
+	defer cleanup()
+	handler := NewHTTPHandler(mock.Log, mock.Conf)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rw := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, test.target, nil)
+			req.Header.Set("Accept-Encoding", test.acceptEncoding)
+			handler.ServeHTTP(rw, req)
+			assert.Equal(t, test.code, rw.Code)
+			assert.Equal(t, test.encoding, rw.Header().Get("Content-Encoding"))
+
+			var reader io.Reader = rw.Body
+			switch test.encoding {
+			case "gzip":
+				gz, err := gzip.NewReader(rw.Body)
+				assert.Nil(t, err)
+				reader = gz
+			case "deflate":
+				reader = flate.NewReader(rw.Body)
+			}
+			body, err := ioutil.ReadAll(reader)
+			assert.Nil(t, err)
+			assert.Equal(t, test.expect, string(body))
+		})
+	}
+}
+
+func TestHTTPHandlerInsertCompressed(t *testing.T) {
+	mock, cleanup := mocks.NewMock()
+	defer cleanup()
+	handler := NewHTTPHandler(mock.Log, mock.Conf)
+
+	serve := func(method string, target string, encoding string, body io.Reader) *httptest.ResponseRecorder {
+		rw := httptest.NewRecorder()
+		req := httptest.NewRequest(method, target, body)
+		req.Header.Set("Content-Encoding", encoding)
+		handler.ServeHTTP(rw, req)
+		return rw
+	}
+
+	rw := serve(http.MethodGet, "/?query=create+database+db1", "", nil)
+	assert.Equal(t, http.StatusOK, rw.Code)
+	rw = serve(http.MethodGet, "/?query=create+table+db1.t1(a+UInt32,b+String)+Engine=Memory", "", nil)
+	assert.Equal(t, http.StatusOK, rw.Code)
+
+	buf := new(bytes.Buffer)
+	gz := gzip.NewWriter(buf)
+	_, err := gz.Write([]byte("1,a\n2,b\n"))
+	assert.Nil(t, err)
+	assert.Nil(t, gz.Close())
+	rw = serve(http.MethodPost, "/?query=insert+into+db1.t1+FORMAT+CSV", "gzip", buf)
+	assert.Equal(t, http.StatusOK, rw.Code, rw.Body.String())
+
+	rw = serve(http.MethodPost, "/?query=insert+into+db1.t1+FORMAT+CSV", "br", strings.NewReader("3,c\n"))
+	assert.Equal(t, "Code: 1002. DB::Exception: Unknown Content-Encoding:br\n", rw.Body.String())
+
+	rw = serve(http.MethodGet, "/?query=select+a,b+from+db1.t1", "", nil)
+	assert.Equal(t, "1\ta\n2\tb\n", rw.Body.String())
+	rw = serve(http.MethodGet, "/?query=drop+database+db1", "", nil)
+	assert.Equal(t, http.StatusOK, rw.Code)
+}
+
+func TestHTTPHandlerSession(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		target string
+		body   string
+		code   int
+		expect string
+	}{
+		{
+			name:   "create-db",
+			method: http.MethodGet,
+			target: "/?query=create+database+db1",
+			code:   http.StatusOK,
+		},
+		{
+			name:   "use",
+			method: http.MethodGet,
+			target: "/?query=use+db1&session_id=s1",
+			code:   http.StatusOK,
+		},
+		{
+			name:   "set",
+			method: http.MethodGet,
+			target: "/?query=set+default_format='CSV'&session_id=s1",
+			code:   http.StatusOK,
+		},
+		{
+			name:   "create-temporary",
+			method: http.MethodGet,
+			target: "/?query=create+temporary+table+t1(a+UInt32,b+String)&session_id=s1",
+			code:   http.StatusOK,
+		},
+		{
+			name:   "insert-temporary",
+			method: http.MethodPost,
+			target: "/?query=insert+into+t1&session_id=s1",
+			body:   "1\ta\n2\tb\n",
+			code:   http.StatusOK,
+		},
+		{
+			name:   "select-temporary",
+			method: http.MethodGet,
+			target: "/?query=select+a,b+from+t1&session_id=s1",
+			code:   http.StatusOK,
+			expect: "1,\"a\"\n2,\"b\"\n",
+		},
+		{
+			name:   "select-param-wins",
+			method: http.MethodGet,
+			target: "/?query=select+a,b+from+t1&session_id=s1&default_format=TSV",
+			code:   http.StatusOK,
+			expect: "1\ta\n2\tb\n",
+		},
+		{
+			name:   "select-other-session",
+			method: http.MethodGet,
+			target: "/?query=select+a,b+from+t1&session_id=s2",
+			code:   http.StatusNotFound,
+			expect: "Code: 60. DB::Exception: Couldn't find table:t1 storage (errno 60)\n",
+		},
+		{
+			name:   "select-no-session",
+			method: http.MethodGet,
+			target: "/?query=select+a,b+from+t1",
+			code:   http.StatusNotFound,
+			expect: "Code: 60. DB::Exception: Couldn't find table:t1 storage (errno 60)\n",
+		},
+		{
+			name:   "drop-temporary",
+			method: http.MethodGet,
+			target: "/?query=drop+table+t1&session_id=s1",
+			code:   http.StatusOK,
+		},
+		{
+			name:   "session-timeout",
+			method: http.MethodGet,
+			target: "/?query=select+1&session_id=s1&session_timeout=100000",
+			code:   http.StatusInternalServerError,
+			expect: "Code: 1002. DB::Exception: Session timeout 100000 is larger than max_session_timeout:3600\n",
+		},
+		{
+			name:   "drop-db",
+			method: http.MethodGet,
+			target: "/?query=drop+database+db1",
+			code:   http.StatusOK,
+		},
+	}
+
+	mock, cleanup := mocks.NewMock()
+	defer cleanup()
+	handler := NewHTTPHandler(mock.Log, mock.Conf)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rw := httptest.NewRecorder()
+			req := httptest.NewRequest(test.method, test.target, strings.NewReader(test.body))
+			handler.ServeHTTP(rw, req)
+			assert.Equal(t, test.code, rw.Code)
+			assert.Equal(t, test.expect, rw.Body.String())
+		})
+	}
+}
+
+func TestHTTPHandlerAuth(t *testing.T) {
+	tests := []struct {
+		name    string
+		method  string
+		target  string
+		headers map[string]string
+		basic   []string
+		code    int
+		expect  string
+	}{
+		{
+			name:    "header",
+			method:  http.MethodGet,
+			target:  "/?query=select+name+from+system.databases",
+			headers: map[string]string{"X-ClickHouse-User": "default", "X-ClickHouse-Key": "pass"},
+			code:    http.StatusOK,
+			expect:  "system\n",
+		},
+		{
+			name:   "basic",
+			method: http.MethodGet,
+			target: "/?query=select+name+from+system.databases",
+			basic:  []string{"reader", "secret"},
+			code:   http.StatusOK,
+			expect: "system\n",
+		},
+		{
+			name:   "params",
+			method: http.MethodGet,
+			target: "/?query=select+name+from+system.databases&user=reader&password=secret",
+			code:   http.StatusOK,
+			expect: "system\n",
+		},
+		{
+			name:   "no-credentials",
+			method: http.MethodGet,
+			target: "/?query=select+1",
+			code:   http.StatusUnauthorized,
+			expect: "Code: 516. DB::Exception: default: Authentication failed: password is incorrect or there is no user with such name (errno 516)\n",
+		},
+		{
+			name:   "unknown-user",
+			method: http.MethodGet,
+			target: "/?query=select+1",
+			basic:  []string{"nobody", "secret"},
+			code:   http.StatusUnauthorized,
+			expect: "Code: 516. DB::Exception: nobody: Authentication failed: password is incorrect or there is no user with such name (errno 516)\n",
+		},
+		{
+			name:   "readonly",
+			method: http.MethodPost,
+			target: "/?query=create+database+db1",
+			basic:  []string{"reader", "secret"},
+			code:   http.StatusForbidden,
+			expect: "Code: 164. DB::Exception: reader: Cannot execute query in readonly mode (errno 164)\n",
+		},
+		{
+			name:   "database-denied",
+			method: http.MethodGet,
+			target: "/?query=select+*+from+db2.t1",
+			basic:  []string{"reader", "secret"},
+			code:   http.StatusForbidden,
+			expect: "Code: 497. DB::Exception: reader: Not enough privileges to access database db2 (errno 497)\n",
+		},
+		{
+			name:   "profile-settings",
+			method: http.MethodGet,
+			target: "/?query=select+name+from+system.databases",
+			basic:  []string{"broken", ""},
+			code:   http.StatusInternalServerError,
+			expect: "Code: 1002. DB::Exception: Invalid setting max_insert_block_size:x\n",
+		},
+	}
+
+	mock, cleanup := mocks.NewMock()
+	defer cleanup()
+	conf := *mock.Conf
+	conf.Users = []config.User{
+		{Name: "default", Password: "pass"},
+		{Name: "reader", Password: "secret", Databases: []string{"db1"}, Readonly: true},
+		{Name: "broken", Profile: "broken"},
+	}
+	conf.Profiles = []config.Profile{
+		{Name: "broken", Settings: map[string]string{"max_insert_block_size": "x"}},
+	}
+	handler := NewHTTPHandler(mock.Log, &conf)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rw := httptest.NewRecorder()
+			req := httptest.NewRequest(test.method, test.target, nil)
+			for k, v := range test.headers {
+				req.Header.Set(k, v)
+			}
+			if test.basic != nil {
+				req.SetBasicAuth(test.basic[0], test.basic[1])
+			}
+			handler.ServeHTTP(rw, req)
+			assert.Equal(t, test.code, rw.Code)
+			assert.Equal(t, test.expect, rw.Body.String())
+		})
+	}
+}
+
+func TestHTTPHandlerHealth(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		target string
+		code   int
+		expect string
+	}{
+		{
+			name:   "ping",
+			method: http.MethodGet,
+			target: "/ping",
+			code:   http.StatusOK,
+			expect: "Ok.\n",
+		},
+		{
+			name:   "replicas-status",
+			method: http.MethodGet,
+			target: "/replicas_status",
+			code:   http.StatusOK,
+			expect: "Ok.\n",
+		},
+		{
+			name:   "root",
+			method: http.MethodGet,
+			target: "/",
+			code:   http.StatusOK,
+			expect: "Ok.\n",
+		},
+		{
+			name:   "root-query",
+			method: http.MethodGet,
+			target: "/?query=select+name+from+system.databases",
+			code:   http.StatusUnauthorized,
+			expect: "Code: 516. DB::Exception: default: Authentication failed: password is incorrect or there is no user with such name (errno 516)\n",
+		},
+	}
+
+	mock, cleanup := mocks.NewMock()
+	defer cleanup()
+	// The probes skip the authentication.
+	conf := *mock.Conf
+	conf.Users = []config.User{{Name: "default", Password: "pass"}}
+	handler := NewHTTPHandler(mock.Log, &conf)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rw := httptest.NewRecorder()
+			req := httptest.NewRequest(test.method, test.target, nil)
+			handler.ServeHTTP(rw, req)
+			assert.Equal(t, test.code, rw.Code)
+			assert.Equal(t, test.expect, rw.Body.String())
+		})
+	}
+
+	// Draining.
+	handler.Drain()
+	for _, target := range []string{"/ping", "/replicas_status", "/"} {
+		rw := httptest.NewRecorder()
+		handler.ServeHTTP(rw, httptest.NewRequest(http.MethodGet, target, nil))
+		assert.Equal(t, http.StatusServiceUnavailable, rw.Code)
+		assert.Equal(t, "Server is shutting down.\n", rw.Body.String())
+	}
+}
+
+func TestHTTPHandlerExecutionLimits(t *testing.T) {
+	tests := []struct {
+		name   string
+		target string
+		code   int
+		prefix string
+	}{
+		{
+			name:   "max-execution-time",
+			target: "/?query=select+number+from+system.numbers+where+number+%3C+0&max_execution_time=1",
+			code:   http.StatusInternalServerError,
+			prefix: "Code: 159. DB::Exception: Timeout exceeded: elapsed 1.",
+		},
+		{
+			name:   "min-execution-speed",
+			target: "/?query=select+number+from+system.numbers+where+number+%3C+0&min_execution_speed=1000000000000&timeout_before_checking_execution_speed=0",
+			code:   http.StatusInternalServerError,
+			prefix: "Code: 160. DB::Exception: Query is executing too slow: ",
+		},
+		{
+			name:   "invalid-setting",
+			target: "/?query=select+1&max_execution_time=x",
+			code:   http.StatusInternalServerError,
+			prefix: "Code: 1002. DB::Exception: Invalid setting max_execution_time:x",
+		},
+	}
+
+	mock, cleanup := mocks.NewMock()
+	defer cleanup()
+	handler := NewHTTPHandler(mock.Log, mock.Conf)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rw := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, test.target, nil)
+			handler.ServeHTTP(rw, req)
+			assert.Equal(t, test.code, rw.Code)
+			assert.True(t, strings.HasPrefix(rw.Body.String(), test.prefix), rw.Body.String())
+		})
+	}
+}
+
+func TestHTTPHandlerNative(t *testing.T) {
+	mock, cleanup := mocks.NewMock()
+	defer cleanup()
+	handler := NewHTTPHandler(mock.Log, mock.Conf)
+	serve := func(method string, target string, body io.Reader) *httptest.ResponseRecorder {
+		rw := httptest.NewRecorder()
+		handler.ServeHTTP(rw, httptest.NewRequest(method, target, body))
+		return rw
+	}
+
+	for _, query := range []string{
+		"create+database+db1",
+		"create+table+db1.t1(a+UInt32,b+String)+Engine=Memory",
+		"create+table+db1.t2(b+String,a+UInt32)+Engine=Memory",
+	} {
+		rw := serve(http.MethodGet, "/?query="+query, nil)
+		assert.Equal(t, http.StatusOK, rw.Code, rw.Body.String())
+	}
+	defer serve(http.MethodGet, "/?query=drop+database+db1", nil)
+
+	rw := serve(http.MethodPost, "/?query=insert+into+db1.t1", strings.NewReader("1\ta\n2\tb\n"))
+	assert.Equal(t, http.StatusOK, rw.Code, rw.Body.String())
+
+	// The Native result, inserted into the table of the other column order.
+	rw = serve(http.MethodGet, "/?query=select+a,b+from+db1.t1+order+by+a+FORMAT+Native", nil)
+	assert.Equal(t, http.StatusOK, rw.Code, rw.Body.String())
+	assert.Equal(t, "application/octet-stream", rw.Header().Get("Content-Type"))
+	native := rw.Body.Bytes()
+
+	rw = serve(http.MethodPost, "/?query=insert+into+db1.t2+FORMAT+Native", bytes.NewReader(native))
+	assert.Equal(t, http.StatusOK, rw.Code, rw.Body.String())
+	rw = serve(http.MethodGet, "/?query=select+b,a+from+db1.t2+order+by+a", nil)
+	assert.Equal(t, "a\t1\nb\t2\n", rw.Body.String())
+
+	rw = serve(http.MethodPost, "/?query=insert+into+db1.t2+FORMAT+Native", bytes.NewReader(native[:len(native)-1]))
+	assert.Equal(t, http.StatusInternalServerError, rw.Code, rw.Body.String())
+}
+
+func TestHTTPHandlerExternalTables(t *testing.T) {
+	tests := []struct {
+		name   string
+		target string
+		files  map[string]string
+		code   int
+		expect string
+	}{
+		{
+			name:   "structure",
+			target: "/?query=select+id,name+from+ids+order+by+id&ids_structure=id+UInt32,name+String",
+			files:  map[string]string{"ids": "2\tb\n1\ta\n"},
+			code:   http.StatusOK,
+			expect: "1\ta\n2\tb\n",
+		},
+		{
+			name:   "types-and-format",
+			target: "/?query=select+_1+from+ids&ids_types=UInt32&ids_format=CSV",
+			files:  map[string]string{"ids": "3\n"},
+			code:   http.StatusOK,
+			expect: "3\n",
+		},
+		{
+			name:   "dropped-after-query",
+			target: "/?query=select+id+from+ids",
+			code:   http.StatusNotFound,
+		},
+		{
+			name:   "no-structure",
+			target: "/?query=select+1",
+			files:  map[string]string{"ids": "1\n"},
+			code:   http.StatusInternalServerError,
+			expect: "Code: 1002. DB::Exception: Neither structure nor types have been provided for external table ids, use the parameter ids_structure or ids_types\n",
+		},
+	}
+
+	mock, cleanup := mocks.NewMock()
+	defer cleanup()
+	handler := NewHTTPHandler(mock.Log, mock.Conf)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			body := new(bytes.Buffer)
+			mw := multipart.NewWriter(body)
+			for name, data := range test.files {
+				fw, err := mw.CreateFormFile(name, name+".tsv")
+				assert.Nil(t, err)
+				_, err = fw.Write([]byte(data))
+				assert.Nil(t, err)
+			}
+			assert.Nil(t, mw.Close())
+
+			rw := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, test.target, body)
+			req.Header.Set("Content-Type", mw.FormDataContentType())
+			handler.ServeHTTP(rw, req)
+			assert.Equal(t, test.code, rw.Code, rw.Body.String())
+			if test.expect != "" {
+				assert.Equal(t, test.expect, rw.Body.String())
+			}
+		})
+	}
+}
+
+func TestHTTPHandlerQueryID(t *testing.T) {
+	mock, cleanup := mocks.NewMock()
+	defer cleanup()
+	handler := NewHTTPHandler(mock.Log, mock.Conf)
+
+	// The query sees itself in system.processes by the query_id of the client.
+	rw := httptest.NewRecorder()
+	handler.ServeHTTP(rw, httptest.NewRequest(http.MethodGet, "/?query=select+query_id+from+system.processes&query_id=q1", nil))
+	assert.Equal(t, http.StatusOK, rw.Code)
+	assert.Equal(t, "q1", rw.Header().Get("X-ClickHouse-Query-Id"))
+	assert.Equal(t, "q1\n", rw.Body.String())
+
+	// A new one without it.
+	rw = httptest.NewRecorder()
+	handler.ServeHTTP(rw, httptest.NewRequest(http.MethodGet, "/?query=select+query_id+from+system.processes", nil))
+	assert.Equal(t, http.StatusOK, rw.Code)
+	assert.Len(t, rw.Header().Get("X-ClickHouse-Query-Id"), 36)
+
+	// The query_id of a running query is rejected.
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/?query=select+number+from+system.numbers+where+number+%3C+0&max_execution_time=1&query_id=q2", nil))
+	}()
+	for running := false; !running; {
+		for _, process := range sessions.Processes() {
+			running = running || process.QueryID() == "q2"
+		}
+		time.Sleep(time.Millisecond)
+	}
+	rw = httptest.NewRecorder()
+	handler.ServeHTTP(rw, httptest.NewRequest(http.MethodGet, "/?query=select+query_id+from+system.processes&query_id=q2", nil))
+	assert.Equal(t, http.StatusInternalServerError, rw.Code)
+	assert.Equal(t, "Code: 216. DB::Exception: Query with id = q2 is already running (errno 216)\n", rw.Body.String())
+	<-done
+
+	// The id is free once the query finished.
+	rw = httptest.NewRecorder()
+	handler.ServeHTTP(rw, httptest.NewRequest(http.MethodGet, "/?query=select+query_id+from+system.processes&query_id=q2", nil))
+	assert.Equal(t, http.StatusOK, rw.Code)
+}
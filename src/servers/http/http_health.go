@@ -0,0 +1,33 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package http
+
+import (
+	"net/http"
+)
+
+// isHealthCheck checks if the request is a probe of the load balancers or the clients:
+// '/ping', '/replicas_status', and '/' without a query.
+func isHealthCheck(req *http.Request) bool {
+	switch req.URL.Path {
+	case "/ping", "/replicas_status":
+		return true
+	case "/":
+		return req.Method == http.MethodGet && req.URL.Query().Get("query") == ""
+	}
+	return false
+}
+
+// serveHealth answers the probes without authentication,
+// with 503 once the server is draining so the load balancers stop sending the queries.
+func (s *HTTPHandler) serveHealth(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "text/plain; charset=UTF-8")
+	if s.draining.Get() {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte("Server is shutting down.\n"))
+		return
+	}
+	_, _ = w.Write([]byte("Ok.\n"))
+}
@@ -7,26 +7,64 @@ package http
 import (
 	"context"
 	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
 
+	"config"
 	"datablocks"
+	"dataformats"
 	"datastreams"
 	"executors"
 	"optimizers"
 	"planners"
 	"processors"
 	"sessions"
+
+	"base/errors"
+	"base/xlog"
+)
+
+const (
+	defaultFormat      = "TSV"
+	defaultInputFormat = "TabSeparated"
 )
 
-func (s *HTTPHandler) processQuery(query string, rw io.Writer) (err error) {
+type queryOutput struct {
+	format   string
+	settings *dataformats.FormatSettings
+	start    time.Time
+	session  *sessions.Session
+	ectx     *executors.ExecutorContext
+	log      *xlog.Log
+	limits   *executors.ExecutionLimits
+}
+
+func (s *HTTPHandler) processQuery(session *sessions.Session, query string, data io.Reader, params url.Values, rw http.ResponseWriter) (err error) {
 	log := s.log
-	conf := s.conf
-	session := sessions.NewSession()
-	defer session.Close()
+	user := session.GetUser()
 
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
+	log.Debug("HTTPHandler-Query->Enter:%+v, user:%s", query, user.Name)
+	start := time.Now()
 
-	log.Debug("HTTPHandler-Query->Enter:%+v", query)
+	// The parameters have the session and the profile settings already.
+	conf, _, err := s.conf.WithSettings(querySettings(params))
+	if err != nil {
+		return err
+	}
+	ctx, limits := executors.NewExecutionLimits(context.Background(), conf)
+	defer limits.Cancel()
+	if err := executors.ShutdownError(); err != nil {
+		return err
+	}
+	id, err := limits.StartQuery(params.Get("query_id"), query, session)
+	if err != nil {
+		return err
+	}
+	rw.Header().Set("X-ClickHouse-Query-Id", id)
+	log = log.With("query_id", id)
 
 	// Logical plans.
 	plan, err := planners.PlanFactory(query)
@@ -34,10 +72,38 @@ func (s *HTTPHandler) processQuery(query string, rw io.Writer) (err error) {
 		log.Error("%+v", err)
 		return err
 	}
-	plan = optimizers.Optimize(plan, optimizers.DefaultOptimizers)
+	plan = optimizers.Optimize(conf, plan, optimizers.DefaultOptimizers)
+
+	settings, err := formatSettings(params)
+	if err != nil {
+		return err
+	}
+
+	// INSERT with the data.
+	if insertPlan, ok := plan.(*planners.InsertPlan); ok && insertPlan.SubPlan == nil {
+		return s.processInsertQuery(ctx, log, conf, session, insertPlan, data, settings)
+	}
+
+	// Output format, the FORMAT clause wins over the default_format parameter.
+	format := params.Get("default_format")
+	if selectPlan, ok := plan.(*planners.SelectPlan); ok && selectPlan.Format != "" {
+		format = selectPlan.Format
+	}
+	if format == "" {
+		format = defaultFormat
+	}
+	if dataformats.FactoryGetOutput(format) == nil {
+		return errors.Errorf("Unknown format:%s", format)
+	}
+	rw.Header().Set("Content-Type", dataformats.FactoryGetContentType(format))
+	rw.Header().Set("X-ClickHouse-Format", format)
 
 	// Executors.
 	ectx := executors.NewExecutorContext(ctx, log, conf, session)
+	ectx.SetProgressCallback(func(pv *sessions.ProgressValues) {
+		session.UpdateProgress(pv)
+		limits.CheckSpeed(pv)
+	})
 	executor, err := executors.ExecutorFactory(ectx, plan)
 	if err != nil {
 		log.Error("%+v", err)
@@ -50,44 +116,188 @@ func (s *HTTPHandler) processQuery(query string, rw io.Writer) (err error) {
 		return err
 	}
 
-	if err = s.processOrdinaryQuery(rw, session, result.In); err != nil {
+	output := &queryOutput{
+		format:   format,
+		settings: settings,
+		start:    start,
+		session:  session,
+		ectx:     ectx,
+		log:      log,
+		limits:   limits,
+	}
+	if err = s.processOrdinaryQuery(rw, output, result.In); err != nil {
 		return
 	}
 	log.Debug("%v", executor.String())
+	log.Debug("Query memory peak:%d bytes", limits.MemoryTracker().Peak())
 	return nil
 }
 
-func (s *HTTPHandler) processOrdinaryQuery(rw io.Writer, session *sessions.Session, sink processors.IProcessor) error {
-	log := s.log
+func (s *HTTPHandler) processOrdinaryQuery(rw io.Writer, output *queryOutput, sink processors.IProcessor) error {
+	log := output.log
 
 	log.Debug("HTTPHandler->OrdinaryQuery->Enter")
-	if sink != nil {
-		for x := range sink.In().Recv() {
-			switch x := x.(type) {
-			case error:
-				log.Error("%+v", x)
-				return x
-			case *datablocks.DataBlock:
-				log.Debug("HTTPHandler->OrdinaryQuery->DataBlock: rows:%+v", x.NumRows())
-				if err := s.sendData(rw, x); err != nil {
-					return err
-				}
+	if sink == nil {
+		return nil
+	}
+
+	var stream datastreams.IDataBlockOutputStream
+	for x := range sink.In().Recv() {
+		switch x := x.(type) {
+		case error:
+			x = output.limits.Error(x, output.session.GetProgress())
+			log.Error("%+v", x)
+			output.limits.Cancel()
+			// The rest is drained, the pipeline stops at the cancellation.
+			sink.In().Stop()
+			return x
+		case *datablocks.DataBlock:
+			log.Debug("HTTPHandler->OrdinaryQuery->DataBlock: rows:%+v", x.NumRows())
+			if stream == nil {
+				stream = datastreams.NewCustomFormatBlockOutputStreamWithSettings(x.Clone(), rw, output.format, output.settings)
+			}
+			if err := stream.Write(x); err != nil {
+				return err
+			}
+			// The block is written out, its buffers go back to the pool.
+			x.Release()
+			// Flush per block, the client sees the rows before the query finishes.
+			if flusher, ok := rw.(http.Flusher); ok {
+				flusher.Flush()
 			}
 		}
 	}
+	if stream == nil {
+		stream = datastreams.NewCustomFormatBlockOutputStreamWithSettings(nil, rw, output.format, output.settings)
+	}
+	if cf, ok := stream.(*datastreams.CustomFormatBlockOutputStream); ok {
+		cf.SetStatistics(output.statistics())
+	}
+	if err := stream.Finalize(); err != nil {
+		return err
+	}
 	log.Debug("HTTPHandler->OrdinaryQuery->Return")
 	return nil
 }
 
-func (s *HTTPHandler) sendData(writer io.Writer, block *datablocks.DataBlock) error {
-	// TODO Get the format from AST
-	output := datastreams.NewCustomFormatBlockOutputStream(block, writer, "TSV")
+// processInsertQuery parses the data block by block and writes them to the table as they are parsed,
+// the table output is finalized only after the last block.
+func (s *HTTPHandler) processInsertQuery(ctx context.Context, log *xlog.Log, conf *config.Config, session *sessions.Session, plan *planners.InsertPlan, data io.Reader, settings *dataformats.FormatSettings) error {
+
+	log.Debug("HTTPHandler->InsertQuery->Enter")
+	format := plan.Format
+	if format == "" {
+		format = defaultInputFormat
+	}
+	if dataformats.FactoryGetInput(format) == nil {
+		return errors.Errorf("Unknown input format:%s", format)
+	}
+	if data == nil {
+		data = strings.NewReader("")
+	}
 
-	if err := output.Write(block); err != nil {
+	ectx := executors.NewExecutorContext(ctx, log, conf, session)
+	executor, err := executors.ExecutorFactory(ectx, plan)
+	if err != nil {
+		log.Error("%+v", err)
+		return err
+	}
+	result, err := executor.Execute()
+	if err != nil {
+		log.Error("%+v", err)
 		return err
 	}
+
+	output := result.Out
+	input := datastreams.NewCustomFormatBlockInputStream(output.SampleBlock(), data, format, settings)
+	defer input.Close()
+	for {
+		block, err := input.Read()
+		if err != nil {
+			log.Error("%+v", err)
+			return err
+		}
+		if block == nil {
+			break
+		}
+		log.Debug("HTTPHandler->InsertQuery->DataBlock: rows:%+v", block.NumRows())
+		if err := output.Write(block); err != nil {
+			return err
+		}
+	}
 	if err := output.Finalize(); err != nil {
 		return err
 	}
+	log.Debug("HTTPHandler->InsertQuery->Return")
 	return nil
 }
+
+func (output *queryOutput) statistics() *dataformats.Statistics {
+	progress := output.session.GetProgress()
+	profile := output.ectx.ProfileValues()
+	return &dataformats.Statistics{
+		Elapsed:         time.Since(output.start),
+		RowsRead:        progress.ReadRows.Get(),
+		BytesRead:       progress.ReadBytes.Get(),
+		AppliedLimit:    profile.AppliedLimit.Get(),
+		RowsBeforeLimit: profile.RowsBeforeLimit.Get(),
+	}
+}
+
+// formatSettings reads the format settings passed as the URL parameters.
+func formatSettings(params url.Values) (*dataformats.FormatSettings, error) {
+	settings := dataformats.DefaultFormatSettings()
+	if v := params.Get("output_format_json_quote_64bit_integers"); v != "" {
+		switch v {
+		case "1", "true":
+			settings.JSONQuote64bitIntegers = true
+		case "0", "false":
+			settings.JSONQuote64bitIntegers = false
+		default:
+			return nil, errors.Errorf("Invalid setting output_format_json_quote_64bit_integers:%s", v)
+		}
+	}
+	if v := params.Get("input_format_skip_unknown_fields"); v != "" {
+		switch v {
+		case "1", "true":
+			settings.InputSkipUnknownFields = true
+		case "0", "false":
+			settings.InputSkipUnknownFields = false
+		default:
+			return nil, errors.Errorf("Invalid setting input_format_skip_unknown_fields:%s", v)
+		}
+	}
+	if v := params.Get("max_insert_block_size"); v != "" {
+		size, err := strconv.Atoi(v)
+		if err != nil || size <= 0 {
+			return nil, errors.Errorf("Invalid setting max_insert_block_size:%s", v)
+		}
+		settings.MaxInsertBlockSize = size
+	}
+	if v := params.Get("output_format_pretty_max_rows"); v != "" {
+		rows, err := strconv.Atoi(v)
+		if err != nil || rows <= 0 {
+			return nil, errors.Errorf("Invalid setting output_format_pretty_max_rows:%s", v)
+		}
+		settings.PrettyMaxRows = rows
+	}
+	if v := params.Get("output_format_pretty_max_column_pad_width"); v != "" {
+		width, err := strconv.Atoi(v)
+		if err != nil || width <= 0 {
+			return nil, errors.Errorf("Invalid setting output_format_pretty_max_column_pad_width:%s", v)
+		}
+		settings.PrettyMaxColumnPadWidth = width
+	}
+	return settings, nil
+}
+
+// querySettings takes the first value of the parameters, WithSettings picks the settings of them.
+func querySettings(params url.Values) map[string]string {
+	settings := make(map[string]string, len(params))
+	for k, v := range params {
+		if len(v) > 0 {
+			settings[k] = v[0]
+		}
+	}
+	return settings
+}
@@ -0,0 +1,137 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package http
+
+import (
+	"compress/flate"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/http"
+
+	"base/errors"
+)
+
+// compressWriter is the gzip or deflate writer of the response body.
+type compressWriter interface {
+	io.WriteCloser
+	Flush() error
+}
+
+// responseWriter streams the result with the chunked transfer encoding,
+// and remembers if the body is started so the late errors go to an exception trailer.
+type responseWriter struct {
+	http.ResponseWriter
+	flusher http.Flusher
+	written bool
+
+	// The compression is started by the first write of the body.
+	encoding   string
+	level      int
+	compressor compressWriter
+}
+
+func newResponseWriter(w http.ResponseWriter) *responseWriter {
+	flusher, _ := w.(http.Flusher)
+	return &responseWriter{
+		ResponseWriter: w,
+		flusher:        flusher,
+	}
+}
+
+// setCompression compresses the body with the encoding, gzip or deflate.
+func (w *responseWriter) setCompression(encoding string, level int) {
+	w.encoding = encoding
+	w.level = level
+}
+
+func (w *responseWriter) Write(p []byte) (int, error) {
+	if !w.written && w.encoding != "" {
+		if err := w.startCompression(); err != nil {
+			return 0, err
+		}
+	}
+	w.written = true
+	if w.compressor != nil {
+		return w.compressor.Write(p)
+	}
+	return w.ResponseWriter.Write(p)
+}
+
+func (w *responseWriter) startCompression() error {
+	var err error
+	switch w.encoding {
+	case "gzip":
+		w.compressor, err = gzip.NewWriterLevel(w.ResponseWriter, w.level)
+	case "deflate":
+		w.compressor, err = flate.NewWriter(w.ResponseWriter, w.level)
+	}
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	header := w.Header()
+	header.Set("Content-Encoding", w.encoding)
+	header.Add("Vary", "Accept-Encoding")
+	return nil
+}
+
+func (w *responseWriter) Flush() {
+	if w.compressor != nil {
+		_ = w.compressor.Flush()
+	}
+	if w.flusher != nil {
+		w.flusher.Flush()
+	}
+}
+
+// Close finishes the compressed stream.
+func (w *responseWriter) Close() error {
+	if w.compressor == nil {
+		return nil
+	}
+	err := w.compressor.Close()
+	w.compressor = nil
+	w.Flush()
+	return err
+}
+
+// writeException writes the ClickHouse style exception.
+// Before the body starts it's the whole response with the error status, uncompressed,
+// after that it's appended to the body so the clients can detect the truncation.
+func (w *responseWriter) writeException(err error) {
+	code := errors.Code(err)
+
+	if !w.written {
+		w.encoding = ""
+		header := w.Header()
+		header.Set("Content-Type", "text/plain; charset=UTF-8")
+		header.Set("X-ClickHouse-Exception-Code", fmt.Sprintf("%d", code))
+		w.WriteHeader(exceptionStatus(code))
+	} else {
+		fmt.Fprint(w, "\n")
+	}
+	fmt.Fprintf(w, "Code: %d. DB::Exception: %v\n", code, err)
+	w.Flush()
+}
+
+func exceptionStatus(code int) int {
+	switch code {
+	case errors.CANNOT_PARSE_TEXT, errors.SYNTAX_ERROR, errors.CANNOT_COMPILE_REGEXP:
+		return http.StatusBadRequest
+	case errors.AUTHENTICATION_FAILED:
+		return http.StatusUnauthorized
+	case errors.READONLY, errors.ACCESS_DENIED:
+		return http.StatusForbidden
+	case errors.SESSION_NOT_FOUND, errors.UNKNOWN_TABLE, errors.UNKNOWN_DATABASE:
+		return http.StatusNotFound
+	case errors.SESSION_IS_LOCKED:
+		return http.StatusConflict
+	case errors.LIMIT_EXCEEDED, errors.TOO_MANY_SIMULTANEOUS_QUERIES:
+		return http.StatusTooManyRequests
+	case errors.ABORTED:
+		return http.StatusServiceUnavailable
+	}
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,56 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package http
+
+import (
+	"net/url"
+	"strconv"
+	"time"
+
+	"sessions"
+	"users"
+
+	"base/errors"
+)
+
+// acquireSession returns the session of the session_id parameter, the state of the previous queries is kept,
+// without the session_id every request is a new session, closed by the release.
+func (s *HTTPHandler) acquireSession(user *users.User, params url.Values) (*sessions.Session, func(), error) {
+	conf := s.conf.Server
+
+	name := params.Get("session_id")
+	if name == "" {
+		session := sessions.NewSession()
+		session.SetUser(user)
+		return session, session.Close, nil
+	}
+
+	timeout := conf.DefaultSessionTimeout
+	if v := params.Get("session_timeout"); v != "" {
+		seconds, err := strconv.Atoi(v)
+		if err != nil || seconds < 0 {
+			return nil, nil, errors.Errorf("Invalid setting session_timeout:%s", v)
+		}
+		if conf.MaxSessionTimeout > 0 && seconds > conf.MaxSessionTimeout {
+			return nil, nil, errors.Errorf("Session timeout %d is larger than max_session_timeout:%d", seconds, conf.MaxSessionTimeout)
+		}
+		timeout = seconds
+	}
+
+	session, err := sessions.AcquireNamedSession(name, user, time.Duration(timeout)*time.Second, conf.MaxSessions)
+	if err != nil {
+		return nil, nil, err
+	}
+	return session, session.Release, nil
+}
+
+// applySession sets the settings of the SET statements which are not given as the parameters.
+func applySession(session *sessions.Session, params url.Values) {
+	for k, v := range session.Settings() {
+		if params.Get(k) == "" {
+			params.Set(k, v)
+		}
+	}
+}
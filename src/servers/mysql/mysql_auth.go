@@ -0,0 +1,169 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package mysql
+
+import (
+	"crypto/rand"
+
+	"servers/protocol"
+	"users"
+
+	"base/errors"
+)
+
+type handshakeResponse struct {
+	capabilities uint32
+	user         string
+	authResponse []byte
+	database     string
+	plugin       string
+}
+
+// handshake sends the greeting with the mysql_native_password scramble and authenticates the client,
+// the clients of another default plugin are asked to switch.
+func (s *MySQLHandler) handshake(session *mysqlSession) error {
+	log := s.log
+	conf := s.conf
+	conn := session.conn
+
+	scramble, err := newScramble()
+	if err != nil {
+		return err
+	}
+	if err := conn.writePacket(s.greeting(session, scramble)); err != nil {
+		return err
+	}
+	if err := conn.flush(); err != nil {
+		return err
+	}
+
+	data, err := conn.readPacket()
+	if err != nil {
+		return err
+	}
+	response, err := parseHandshakeResponse(data)
+	if err != nil {
+		return err
+	}
+	if response.capabilities&clientProtocol41 == 0 {
+		err := errors.New("The client doesn't support the protocol 4.1")
+		if xerr := s.writeError(session, err); xerr != nil {
+			return xerr
+		}
+		return err
+	}
+
+	if response.capabilities&clientPluginAuth != 0 && response.plugin != "" && response.plugin != nativePasswordPlugin {
+		switchRequest := []byte{authSwitchPacket}
+		switchRequest = appendNulString(switchRequest, nativePasswordPlugin)
+		switchRequest = append(switchRequest, scramble...)
+		switchRequest = append(switchRequest, 0)
+		if err := conn.writePacket(switchRequest); err != nil {
+			return err
+		}
+		if err := conn.flush(); err != nil {
+			return err
+		}
+		if response.authResponse, err = conn.readPacket(); err != nil {
+			return err
+		}
+	}
+
+	user, err := users.AuthenticateNative(conf, response.user, scramble, response.authResponse)
+	if err != nil {
+		log.Warning("MySQL authentication from %s: %v", conn.conn.RemoteAddr(), err)
+		if xerr := s.writeError(session, err); xerr != nil {
+			return xerr
+		}
+		return err
+	}
+	session.session.SetUser(user)
+
+	if response.database != "" {
+		return s.initDB(session, response.database)
+	}
+	return s.writeOK(session, 0)
+}
+
+func (s *MySQLHandler) greeting(session *mysqlSession, scramble []byte) []byte {
+	b := []byte{protocolVersion}
+	b = appendNulString(b, serverVersion())
+	b = appendUint32(b, session.id)
+	b = append(b, scramble[:8]...)
+	b = append(b, 0)
+	b = appendUint16(b, uint16(serverCapabilities&0xffff))
+	b = append(b, byte(charsetUTF8))
+	b = appendUint16(b, serverStatusAutocommit)
+	b = appendUint16(b, uint16(serverCapabilities>>16))
+	b = append(b, scrambleLength+1)
+	b = append(b, make([]byte, 10)...)
+	b = append(b, scramble[8:]...)
+	b = append(b, 0)
+	return appendNulString(b, nativePasswordPlugin)
+}
+
+// serverVersion looks like a MySQL version, some clients parse it.
+func serverVersion() string {
+	return "5.7.0-" + protocol.VersionName
+}
+
+func parseHandshakeResponse(data []byte) (*handshakeResponse, error) {
+	var err error
+	r := &payloadReader{data: data}
+	response := &handshakeResponse{}
+
+	if response.capabilities, err = r.uint32(); err != nil {
+		return nil, err
+	}
+	// Max packet size, charset and the filler.
+	if _, err = r.bytes(4 + 1 + 23); err != nil {
+		return nil, err
+	}
+	if response.user, err = r.nulString(); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case response.capabilities&clientPluginAuthLenencClientData != 0:
+		response.authResponse, err = r.lenEncBytes()
+	case response.capabilities&clientSecureConnection != 0:
+		var n byte
+		if n, err = r.byte(); err == nil {
+			response.authResponse, err = r.bytes(int(n))
+		}
+	default:
+		var s string
+		s, err = r.nulString()
+		response.authResponse = []byte(s)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if response.capabilities&clientConnectWithDB != 0 && !r.eof() {
+		if response.database, err = r.nulString(); err != nil {
+			return nil, err
+		}
+	}
+	if response.capabilities&clientPluginAuth != 0 && !r.eof() {
+		if response.plugin, err = r.nulString(); err != nil {
+			return nil, err
+		}
+	}
+	// The connection attributes are ignored.
+	return response, nil
+}
+
+// newScramble returns the random printable bytes, some clients stop at a zero.
+func newScramble() ([]byte, error) {
+	scramble := make([]byte, scrambleLength)
+	if _, err := rand.Read(scramble); err != nil {
+		return nil, errors.Wrap(err)
+	}
+	for i := range scramble {
+		scramble[i] = scramble[i]%94 + 33
+	}
+	return scramble, nil
+}
@@ -0,0 +1,155 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package mysql
+
+import (
+	"fmt"
+	"io"
+	"net"
+
+	"config"
+	"sessions"
+
+	"base/errors"
+	"base/sync2"
+	"base/xlog"
+)
+
+// MySQLHandler serves the MySQL wire protocol on the mysql_port,
+// enough for the MySQL clients and the BI tools to run the queries.
+type MySQLHandler struct {
+	log      *xlog.Log
+	conf     *config.Config
+	listener net.Listener
+	connID   sync2.AtomicInt32
+	closing  sync2.AtomicBool
+}
+
+func NewMySQLHandler(log *xlog.Log, conf *config.Config) *MySQLHandler {
+	return &MySQLHandler{
+		log:  log,
+		conf: conf,
+	}
+}
+
+func (s *MySQLHandler) Start() {
+	log := s.log
+
+	if s.conf.Server.MySQLPort <= 0 {
+		return
+	}
+	listener, err := net.Listen("tcp", fmt.Sprintf("%v:%v", s.conf.Server.ListenHost, s.conf.Server.MySQLPort))
+	if err != nil {
+		log.Panic("Couldn't listen: %+v", err)
+	}
+	s.listener = listener
+	go s.serve(listener)
+}
+
+func (s *MySQLHandler) serve(listener net.Listener) {
+	log := s.log
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if s.closing.Get() {
+				return
+			}
+			log.Panic("Couldn't accept: %+v", err)
+		}
+		go s.handle(conn)
+	}
+}
+
+// Stop closes the listener, the connections open go on until the process exits.
+func (s *MySQLHandler) Stop() {
+	s.closing.Set(true)
+	if s.listener != nil {
+		s.listener.Close()
+	}
+}
+
+// Address returns the MySQL protocol address, empty if not enabled.
+func (s *MySQLHandler) Address() string {
+	if s.listener == nil {
+		return ""
+	}
+	return fmt.Sprintf(":%v", s.conf.Server.MySQLPort)
+}
+
+type mysqlSession struct {
+	id      uint32
+	conn    *packetConn
+	session *sessions.Session
+}
+
+func (s *MySQLHandler) handle(conn net.Conn) {
+	log := s.log
+
+	// Catch panics, and close the connection in any case.
+	defer func() {
+		conn.Close()
+		if x := recover(); x != nil {
+			log.Error("%+v", errors.Errorf("%+v", x))
+		}
+	}()
+
+	session := &mysqlSession{
+		id:      uint32(s.connID.Add(1)),
+		conn:    newPacketConn(conn),
+		session: sessions.NewSession(),
+	}
+	defer session.session.Close()
+
+	log.Debug("MySQL connection coming:%s", conn.RemoteAddr().String())
+	if err := s.handleCommands(session); err != nil {
+		if err == io.EOF {
+			log.Info("MySQL connection closed:%v", conn.RemoteAddr().String())
+		} else {
+			log.Error("%+v, %T", err, err)
+		}
+	}
+}
+
+func (s *MySQLHandler) handleCommands(session *mysqlSession) error {
+	log := s.log
+	conn := session.conn
+
+	if err := s.handshake(session); err != nil {
+		return err
+	}
+
+	for {
+		conn.resetSequence()
+		data, err := conn.readPacket()
+		if err != nil {
+			return err
+		}
+		if len(data) == 0 {
+			return errMalformedPacket
+		}
+
+		command, args := data[0], data[1:]
+		log.Debug("MySQL receive command:0x%02x", command)
+		switch command {
+		case comQuit:
+			return io.EOF
+		case comPing:
+			err = s.writeOK(session, 0)
+		case comInitDB:
+			err = s.initDB(session, string(args))
+		case comQuery:
+			err = s.query(session, string(args))
+		case comFieldList:
+			// The column completion of the old clients, nothing to complete.
+			err = s.writeEOF(session)
+		default:
+			err = s.writeError(session, errors.Errorf("Unsupported command:0x%02x", command))
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
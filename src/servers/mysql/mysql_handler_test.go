@@ -0,0 +1,309 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package mysql
+
+import (
+	"crypto/sha1"
+	"net"
+	"testing"
+
+	"config"
+	"mocks"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testClient speaks the client side of the protocol.
+type testClient struct {
+	t    *testing.T
+	conn *packetConn
+}
+
+func newTestClient(t *testing.T, handler *MySQLHandler) (*testClient, func()) {
+	server, client := net.Pipe()
+	go handler.handle(server)
+	return &testClient{t: t, conn: newPacketConn(client)}, func() { client.Close() }
+}
+
+func (c *testClient) read() []byte {
+	data, err := c.conn.readPacket()
+	assert.Nil(c.t, err)
+	return data
+}
+
+func (c *testClient) write(data []byte) {
+	assert.Nil(c.t, c.conn.writePacket(data))
+	assert.Nil(c.t, c.conn.flush())
+}
+
+// login answers the greeting, returns the OK or ERR packet.
+func (c *testClient) login(user string, password string, database string, plugin string) []byte {
+	greeting := c.read()
+	r := &payloadReader{data: greeting}
+	version, _ := r.byte()
+	assert.Equal(c.t, protocolVersion, version)
+	_, err := r.nulString()
+	assert.Nil(c.t, err)
+	_, _ = r.uint32()
+	part1, _ := r.bytes(8)
+	// Filler, capabilities, charset, status, capabilities, auth data length and reserved.
+	_, _ = r.bytes(1 + 2 + 1 + 2 + 2 + 1 + 10)
+	part2, _ := r.bytes(12)
+	scramble := append(append([]byte{}, part1...), part2...)
+
+	capabilities := clientProtocol41 | clientSecureConnection | clientPluginAuth
+	if database != "" {
+		capabilities |= clientConnectWithDB
+	}
+	b := appendUint32(nil, capabilities)
+	b = appendUint32(b, maxPacketSize)
+	b = append(b, byte(charsetUTF8))
+	b = append(b, make([]byte, 23)...)
+	b = appendNulString(b, user)
+	response := nativeResponse(scramble, password)
+	if plugin != nativePasswordPlugin {
+		response = []byte("whatever")
+	}
+	b = append(b, byte(len(response)))
+	b = append(b, response...)
+	if database != "" {
+		b = appendNulString(b, database)
+	}
+	b = appendNulString(b, plugin)
+	c.write(b)
+
+	reply := c.read()
+	if reply[0] == authSwitchPacket {
+		r := &payloadReader{data: reply[1:]}
+		name, _ := r.nulString()
+		assert.Equal(c.t, nativePasswordPlugin, name)
+		c.write(nativeResponse(r.rest()[:scrambleLength], password))
+		reply = c.read()
+	}
+	return reply
+}
+
+func (c *testClient) command(command byte, arg string) {
+	c.conn.resetSequence()
+	c.write(append([]byte{command}, arg...))
+}
+
+// query returns the column names, the rows, or the error message.
+func (c *testClient) query(query string) ([]string, [][]string, string) {
+	c.command(comQuery, query)
+
+	first := c.read()
+	switch first[0] {
+	case okPacket:
+		return nil, nil, ""
+	case errPacket:
+		return nil, nil, string(first[9:])
+	}
+
+	r := &payloadReader{data: first}
+	count, _ := r.lenEncInt()
+	var names []string
+	for i := 0; i < int(count); i++ {
+		r := &payloadReader{data: c.read()}
+		for j := 0; j < 4; j++ {
+			_, _ = r.lenEncBytes()
+		}
+		name, _ := r.lenEncBytes()
+		names = append(names, string(name))
+	}
+	assert.Equal(c.t, eofPacket, c.read()[0])
+
+	var rows [][]string
+	for {
+		data := c.read()
+		if data[0] == eofPacket && len(data) < 9 {
+			return names, rows, ""
+		}
+		if data[0] == errPacket {
+			return names, rows, string(data[9:])
+		}
+		r := &payloadReader{data: data}
+		var row []string
+		for i := 0; i < int(count); i++ {
+			if r.data[r.pos] == nullValue {
+				r.pos++
+				row = append(row, "NULL")
+				continue
+			}
+			v, _ := r.lenEncBytes()
+			row = append(row, string(v))
+		}
+		rows = append(rows, row)
+	}
+}
+
+func nativeResponse(scramble []byte, password string) []byte {
+	if password == "" {
+		return nil
+	}
+	stage1 := sha1.Sum([]byte(password))
+	stage2 := sha1.Sum(stage1[:])
+	h := sha1.New()
+	h.Write(scramble)
+	h.Write(stage2[:])
+	response := h.Sum(nil)
+	for i := range response {
+		response[i] ^= stage1[i]
+	}
+	return response
+}
+
+func TestMySQLHandlerQuery(t *testing.T) {
+	mock, cleanup := mocks.NewMock()
+	defer cleanup()
+	handler := NewMySQLHandler(mock.Log, mock.Conf)
+
+	client, closer := newTestClient(t, handler)
+	defer closer()
+	assert.Equal(t, okPacket, client.login("", "", "", nativePasswordPlugin)[0])
+
+	tests := []struct {
+		name  string
+		query string
+		cols  []string
+		rows  [][]string
+		// The parallel numbers come in any order, only the count is checked.
+		count int
+		err   string
+	}{
+		{
+			name:  "version-comment",
+			query: "select @@version_comment limit 1",
+			cols:  []string{"@@version_comment"},
+			rows:  [][]string{{"VectorSQL"}},
+		},
+		{
+			name:  "set-names",
+			query: "SET NAMES utf8mb4",
+		},
+		{
+			name:  "create-db",
+			query: "create database db1",
+		},
+		{
+			name:  "create-table",
+			query: "create table db1.t1(a UInt32, b String, c Float64) Engine=Memory",
+		},
+		{
+			name:  "insert-values",
+			query: "insert into db1.t1 values(1,'a',1.5)",
+			err:   "INSERT with the data is not supported by the MySQL protocol, use INSERT SELECT or the HTTP/native protocols",
+		},
+		{
+			name:  "select",
+			query: "SELECT number, (number+1) FROM system.numbers limit 3",
+			cols:  []string{"number", "(number+1)"},
+			count: 3,
+		},
+		{
+			name:  "select-empty",
+			query: "select a, b from db1.t1",
+		},
+		{
+			name:  "select-error",
+			query: "select a from db1.t2",
+			err:   "couldn't find table:t2 storage (errno 60)",
+		},
+		{
+			name:  "parse-error",
+			query: "selec 1",
+			err:   "syntax error at position 6 near 'selec' (errno 62)",
+		},
+		{
+			name:  "drop-db",
+			query: "drop database db1",
+		},
+	}
+
+	for _, test := range tests {
+		cols, rows, err := client.query(test.query)
+		assert.Equal(t, test.err, err, test.name)
+		assert.Equal(t, test.cols, cols, test.name)
+		if test.count > 0 {
+			assert.Equal(t, test.count, len(rows), test.name)
+		} else {
+			assert.Equal(t, test.rows, rows, test.name)
+		}
+	}
+
+	// Ping and the database.
+	client.command(comPing, "")
+	assert.Equal(t, okPacket, client.read()[0])
+	client.command(comInitDB, "system")
+	assert.Equal(t, okPacket, client.read()[0])
+	client.command(comInitDB, "nodb")
+	data := client.read()
+	assert.Equal(t, errPacket, data[0])
+	assert.Equal(t, "database:nodb doesn't exists (errno 81)", string(data[9:]))
+	client.command(comQuit, "")
+}
+
+func TestMySQLHandlerAuth(t *testing.T) {
+	mock, cleanup := mocks.NewMock()
+	defer cleanup()
+	mock.Conf.Users = []config.User{
+		{Name: "default", Password: "pass"},
+		{Name: "reader", Password: "secret", Databases: []string{"db1"}},
+	}
+	handler := NewMySQLHandler(mock.Log, mock.Conf)
+
+	tests := []struct {
+		name     string
+		user     string
+		password string
+		database string
+		plugin   string
+		err      string
+	}{
+		{
+			name:     "ok",
+			password: "pass",
+			plugin:   nativePasswordPlugin,
+		},
+		{
+			name:     "switch-plugin",
+			password: "pass",
+			plugin:   "caching_sha2_password",
+		},
+		{
+			name:     "database",
+			user:     "reader",
+			password: "secret",
+			database: "system",
+			plugin:   nativePasswordPlugin,
+		},
+		{
+			name:     "bad-password",
+			password: "x",
+			plugin:   nativePasswordPlugin,
+			err:      "\x15\x04#28000default: Authentication failed: password is incorrect or there is no user with such name (errno 516)",
+		},
+		{
+			name:     "access-denied",
+			user:     "reader",
+			password: "secret",
+			database: "default",
+			plugin:   nativePasswordPlugin,
+			err:      "\x14\x04#42000reader: Not enough privileges to access database default (errno 497)",
+		},
+	}
+
+	for _, test := range tests {
+		client, closer := newTestClient(t, handler)
+		reply := client.login(test.user, test.password, test.database, test.plugin)
+		if test.err != "" {
+			assert.Equal(t, errPacket, reply[0], test.name)
+			assert.Equal(t, test.err, string(reply[1:]), test.name)
+		} else {
+			assert.Equal(t, okPacket, reply[0], test.name)
+		}
+		closer()
+	}
+}
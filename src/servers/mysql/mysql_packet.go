@@ -0,0 +1,210 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package mysql
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"net"
+
+	"base/errors"
+)
+
+// packetConn reads and writes the packets: length(3) + sequence(1) + payload,
+// the payloads of 16MB and more are split, the sequence restarts on every command.
+type packetConn struct {
+	conn     net.Conn
+	reader   *bufio.Reader
+	writer   *bufio.Writer
+	sequence uint8
+}
+
+func newPacketConn(conn net.Conn) *packetConn {
+	return &packetConn{
+		conn:   conn,
+		reader: bufio.NewReader(conn),
+		writer: bufio.NewWriter(conn),
+	}
+}
+
+func (c *packetConn) readPacket() ([]byte, error) {
+	var payload []byte
+	var head [4]byte
+
+	for {
+		if _, err := io.ReadFull(c.reader, head[:]); err != nil {
+			return nil, err
+		}
+		length := int(uint32(head[0]) | uint32(head[1])<<8 | uint32(head[2])<<16)
+		if head[3] != c.sequence {
+			return nil, errors.Errorf("Packet sequence mismatch, got:%d, expected:%d", head[3], c.sequence)
+		}
+		c.sequence++
+
+		data := make([]byte, length)
+		if _, err := io.ReadFull(c.reader, data); err != nil {
+			return nil, err
+		}
+		payload = append(payload, data...)
+		if length < maxPacketSize {
+			return payload, nil
+		}
+	}
+}
+
+// writePacket buffers the packet until the flush.
+func (c *packetConn) writePacket(payload []byte) error {
+	for {
+		length := len(payload)
+		if length > maxPacketSize {
+			length = maxPacketSize
+		}
+		head := [4]byte{byte(length), byte(length >> 8), byte(length >> 16), c.sequence}
+		c.sequence++
+		if _, err := c.writer.Write(head[:]); err != nil {
+			return err
+		}
+		if _, err := c.writer.Write(payload[:length]); err != nil {
+			return err
+		}
+		payload = payload[length:]
+		// The payload of exactly 16MB ends with an empty packet.
+		if length < maxPacketSize {
+			return nil
+		}
+	}
+}
+
+func (c *packetConn) flush() error {
+	return c.writer.Flush()
+}
+
+func (c *packetConn) resetSequence() {
+	c.sequence = 0
+}
+
+func appendLenEncInt(b []byte, v uint64) []byte {
+	switch {
+	case v < 251:
+		return append(b, byte(v))
+	case v < 1<<16:
+		return append(b, 0xfc, byte(v), byte(v>>8))
+	case v < 1<<24:
+		return append(b, 0xfd, byte(v), byte(v>>8), byte(v>>16))
+	}
+	b = append(b, 0xfe)
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], v)
+	return append(b, buf[:]...)
+}
+
+func appendLenEncString(b []byte, s string) []byte {
+	b = appendLenEncInt(b, uint64(len(s)))
+	return append(b, s...)
+}
+
+func appendUint16(b []byte, v uint16) []byte {
+	return append(b, byte(v), byte(v>>8))
+}
+
+func appendUint32(b []byte, v uint32) []byte {
+	return append(b, byte(v), byte(v>>8), byte(v>>16), byte(v>>24))
+}
+
+func appendNulString(b []byte, s string) []byte {
+	b = append(b, s...)
+	return append(b, 0)
+}
+
+// payloadReader decodes the fields of a packet payload.
+type payloadReader struct {
+	data []byte
+	pos  int
+}
+
+var errMalformedPacket = errors.New("Malformed packet")
+
+func (r *payloadReader) byte() (byte, error) {
+	if r.pos >= len(r.data) {
+		return 0, errMalformedPacket
+	}
+	b := r.data[r.pos]
+	r.pos++
+	return b, nil
+}
+
+func (r *payloadReader) bytes(n int) ([]byte, error) {
+	if n < 0 || r.pos+n > len(r.data) {
+		return nil, errMalformedPacket
+	}
+	b := r.data[r.pos : r.pos+n]
+	r.pos += n
+	return b, nil
+}
+
+func (r *payloadReader) uint32() (uint32, error) {
+	b, err := r.bytes(4)
+	if err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint32(b), nil
+}
+
+func (r *payloadReader) nulString() (string, error) {
+	for i := r.pos; i < len(r.data); i++ {
+		if r.data[i] == 0 {
+			s := string(r.data[r.pos:i])
+			r.pos = i + 1
+			return s, nil
+		}
+	}
+	return "", errMalformedPacket
+}
+
+func (r *payloadReader) lenEncInt() (uint64, error) {
+	b, err := r.byte()
+	if err != nil {
+		return 0, err
+	}
+	var n int
+	switch b {
+	case 0xfc:
+		n = 2
+	case 0xfd:
+		n = 3
+	case 0xfe:
+		n = 8
+	default:
+		return uint64(b), nil
+	}
+	bs, err := r.bytes(n)
+	if err != nil {
+		return 0, err
+	}
+	var v uint64
+	for i := n - 1; i >= 0; i-- {
+		v = v<<8 | uint64(bs[i])
+	}
+	return v, nil
+}
+
+func (r *payloadReader) lenEncBytes() ([]byte, error) {
+	n, err := r.lenEncInt()
+	if err != nil {
+		return nil, err
+	}
+	return r.bytes(int(n))
+}
+
+func (r *payloadReader) rest() []byte {
+	b := r.data[r.pos:]
+	r.pos = len(r.data)
+	return b
+}
+
+func (r *payloadReader) eof() bool {
+	return r.pos >= len(r.data)
+}
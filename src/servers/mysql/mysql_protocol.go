@@ -0,0 +1,70 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package mysql
+
+// The capability flags.
+const (
+	clientLongPassword               uint32 = 0x00000001
+	clientFoundRows                  uint32 = 0x00000002
+	clientLongFlag                   uint32 = 0x00000004
+	clientConnectWithDB              uint32 = 0x00000008
+	clientProtocol41                 uint32 = 0x00000200
+	clientTransactions               uint32 = 0x00002000
+	clientSecureConnection           uint32 = 0x00008000
+	clientPluginAuth                 uint32 = 0x00080000
+	clientConnectAttrs               uint32 = 0x00100000
+	clientPluginAuthLenencClientData uint32 = 0x00200000
+
+	// The EOF packets are kept, CLIENT_DEPRECATE_EOF is not announced.
+	serverCapabilities = clientLongPassword | clientFoundRows | clientLongFlag | clientConnectWithDB |
+		clientProtocol41 | clientTransactions | clientSecureConnection | clientPluginAuth |
+		clientConnectAttrs | clientPluginAuthLenencClientData
+)
+
+// The commands.
+const (
+	comQuit      byte = 0x01
+	comInitDB    byte = 0x02
+	comQuery     byte = 0x03
+	comFieldList byte = 0x04
+	comPing      byte = 0x0e
+)
+
+// The packet headers.
+const (
+	okPacket         byte = 0x00
+	authSwitchPacket byte = 0xfe
+	eofPacket        byte = 0xfe
+	errPacket        byte = 0xff
+	nullValue        byte = 0xfb
+)
+
+const (
+	protocolVersion        byte   = 10
+	serverStatusAutocommit uint16 = 0x0002
+	// utf8_general_ci and binary.
+	charsetUTF8   uint16 = 33
+	charsetBinary uint16 = 63
+
+	nativePasswordPlugin = "mysql_native_password"
+	scrambleLength       = 20
+	maxPacketSize        = 1<<24 - 1
+)
+
+// The column types.
+const (
+	typeLong      byte = 0x03
+	typeDouble    byte = 0x05
+	typeLongLong  byte = 0x08
+	typeDateTime  byte = 0x0c
+	typeVarString byte = 0xfd
+)
+
+// The column flags.
+const (
+	flagNotNull  uint16 = 0x0001
+	flagUnsigned uint16 = 0x0020
+	flagBinary   uint16 = 0x0080
+)
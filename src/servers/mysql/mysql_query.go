@@ -0,0 +1,151 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package mysql
+
+import (
+	"context"
+	"regexp"
+
+	"columns"
+	"databases"
+	"datablocks"
+	"datatypes"
+	"datavalues"
+	"executors"
+	"optimizers"
+	"planners"
+	"sessions"
+
+	"base/errors"
+)
+
+var (
+	// The statements of the MySQL drivers setting up the connection, answered OK without running them.
+	clientSetupQuery    = regexp.MustCompile(`(?is)^\s*SET\s+(NAMES|CHARACTER\s+SET|CHARACTER_SET_\w+|COLLATION_\w+|AUTOCOMMIT|SQL_MODE|SQL_SELECT_LIMIT|NET_WRITE_TIMEOUT|SESSION|@@)`)
+	versionCommentQuery = regexp.MustCompile(`(?is)^\s*SELECT\s+@@version_comment(\s+LIMIT\s+1)?\s*;?\s*$`)
+)
+
+func (s *MySQLHandler) initDB(session *mysqlSession, database string) error {
+	if user := session.session.GetUser(); user != nil {
+		if err := user.CheckDatabase(database); err != nil {
+			return s.writeError(session, err)
+		}
+	}
+	if _, err := databases.GetDatabase(database); err != nil {
+		return s.writeError(session, err)
+	}
+	session.session.SetDatabase(database)
+	return s.writeOK(session, 0)
+}
+
+// query runs the COM_QUERY, the errors of the query are sent to the client as the ERR packets,
+// only the connection errors are returned.
+func (s *MySQLHandler) query(session *mysqlSession, query string) error {
+	log := s.log
+	xsession := session.session
+
+	log.Debug("MySQLHandler-Query->Enter:%+v, user:%s", query, xsession.GetUser().Name)
+	switch {
+	case clientSetupQuery.MatchString(query):
+		return s.writeOK(session, 0)
+	case versionCommentQuery.MatchString(query):
+		return s.writeVersionComment(session)
+	}
+
+	// Logical plans.
+	plan, err := planners.PlanFactory(query)
+	if err != nil {
+		log.Error("%+v", err)
+		return s.writeError(session, err)
+	}
+
+	// The settings of the SET statements.
+	conf, _, err := s.conf.WithSettings(xsession.Settings())
+	if err != nil {
+		return s.writeError(session, err)
+	}
+	plan = optimizers.Optimize(conf, plan, optimizers.DefaultOptimizers)
+	if insertPlan, ok := plan.(*planners.InsertPlan); ok && insertPlan.SubPlan == nil {
+		return s.writeError(session, errors.New("INSERT with the data is not supported by the MySQL protocol, use INSERT SELECT or the HTTP/native protocols"))
+	}
+	ctx, limits := executors.NewExecutionLimits(context.Background(), conf)
+	defer limits.Cancel()
+	if err := executors.ShutdownError(); err != nil {
+		return s.writeError(session, err)
+	}
+	id, err := limits.StartQuery("", query, xsession)
+	if err != nil {
+		return s.writeError(session, err)
+	}
+	log = log.With("query_id", id)
+
+	// Executors.
+	ectx := executors.NewExecutorContext(ctx, log, conf, xsession)
+	ectx.SetProgressCallback(func(pv *sessions.ProgressValues) {
+		xsession.UpdateProgress(pv)
+		limits.CheckSpeed(pv)
+	})
+	executor, err := executors.ExecutorFactory(ectx, plan)
+	if err != nil {
+		log.Error("%+v", err)
+		return s.writeError(session, err)
+	}
+	result, err := executor.Execute()
+	if err != nil {
+		log.Error("%+v", err)
+		return s.writeError(session, err)
+	}
+	if result.In == nil {
+		return s.writeOK(session, 0)
+	}
+
+	// The rows are streamed block by block, the failed query is drained after the ERR packet.
+	w := &resultWriter{session: session}
+	var failed bool
+	for x := range result.In.In().Recv() {
+		if failed {
+			continue
+		}
+		switch x := x.(type) {
+		case error:
+			x = limits.Error(x, xsession.GetProgress())
+			log.Error("%+v", x)
+			failed = true
+			limits.Cancel()
+			if err := s.writeError(session, x); err != nil {
+				return err
+			}
+		case *datablocks.DataBlock:
+			if err := w.writeBlock(x); err != nil {
+				return err
+			}
+			x.Release()
+		}
+	}
+	if failed {
+		return nil
+	}
+	log.Debug("%s", executor.String())
+	log.Debug("Query memory peak:%d bytes", limits.MemoryTracker().Peak())
+	// No block, no columns to describe.
+	if !w.header {
+		return s.writeOK(session, 0)
+	}
+	return w.finish()
+}
+
+func (s *MySQLHandler) writeVersionComment(session *mysqlSession) error {
+	cols := []*columns.Column{columns.NewColumn("@@version_comment", datatypes.NewStringDataType())}
+	block := datablocks.NewDataBlock(cols)
+	if err := block.WriteRow([]datavalues.IDataValue{datavalues.MakeString(s.conf.Server.DisplayName)}); err != nil {
+		return err
+	}
+
+	w := &resultWriter{session: session}
+	if err := w.writeBlock(block); err != nil {
+		return err
+	}
+	return w.finish()
+}
@@ -0,0 +1,210 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package mysql
+
+import (
+	"bytes"
+
+	"columns"
+	"datablocks"
+	"datatypes"
+	"datavalues"
+
+	"base/errors"
+)
+
+func (s *MySQLHandler) writeOK(session *mysqlSession, affectedRows uint64) error {
+	b := []byte{okPacket}
+	b = appendLenEncInt(b, affectedRows)
+	// Last insert id.
+	b = appendLenEncInt(b, 0)
+	b = appendUint16(b, serverStatusAutocommit)
+	// Warnings.
+	b = appendUint16(b, 0)
+	if err := session.conn.writePacket(b); err != nil {
+		return err
+	}
+	return session.conn.flush()
+}
+
+func (s *MySQLHandler) writeEOF(session *mysqlSession) error {
+	if err := session.conn.writePacket(eof()); err != nil {
+		return err
+	}
+	return session.conn.flush()
+}
+
+func eof() []byte {
+	b := []byte{eofPacket}
+	// Warnings.
+	b = appendUint16(b, 0)
+	return appendUint16(b, serverStatusAutocommit)
+}
+
+// writeError sends the error as the ERR packet with the MySQL code and SQL state.
+func (s *MySQLHandler) writeError(session *mysqlSession, err error) error {
+	code, state := errorCode(err)
+	b := []byte{errPacket}
+	b = appendUint16(b, code)
+	b = append(b, '#')
+	b = append(b, state...)
+	b = append(b, err.Error()...)
+	if err := session.conn.writePacket(b); err != nil {
+		return err
+	}
+	return session.conn.flush()
+}
+
+// errorCode translates the error to the MySQL error code and SQL state.
+func errorCode(err error) (uint16, string) {
+	switch errors.Code(err) {
+	case errors.AUTHENTICATION_FAILED:
+		// ER_ACCESS_DENIED_ERROR.
+		return 1045, "28000"
+	case errors.ACCESS_DENIED:
+		// ER_DBACCESS_DENIED_ERROR.
+		return 1044, "42000"
+	case errors.READONLY:
+		// ER_OPTION_PREVENTS_STATEMENT.
+		return 1290, "HY000"
+	case errors.TIMEOUT_EXCEEDED, errors.TOO_SLOW:
+		// ER_QUERY_TIMEOUT.
+		return 3024, "HY000"
+	case errors.ABORTED:
+		// ER_SERVER_SHUTDOWN.
+		return 1053, "08S01"
+	case errors.SYNTAX_ERROR:
+		// ER_PARSE_ERROR.
+		return 1064, "42000"
+	case errors.UNKNOWN_DATABASE:
+		// ER_BAD_DB_ERROR.
+		return 1049, "42000"
+	case errors.UNKNOWN_TABLE:
+		// ER_NO_SUCH_TABLE.
+		return 1146, "42S02"
+	}
+	// ER_UNKNOWN_ERROR.
+	return 1105, "HY000"
+}
+
+// resultWriter writes the result set: the column count, the column definitions, EOF,
+// the text rows and EOF, the header is written on the first block.
+type resultWriter struct {
+	session *mysqlSession
+	header  bool
+	rows    uint64
+	buf     bytes.Buffer
+}
+
+func (w *resultWriter) writeHeader(cols []*columns.Column) error {
+	conn := w.session.conn
+
+	if err := conn.writePacket(appendLenEncInt(nil, uint64(len(cols)))); err != nil {
+		return err
+	}
+	for _, col := range cols {
+		if err := conn.writePacket(columnDefinition(col)); err != nil {
+			return err
+		}
+	}
+	if err := conn.writePacket(eof()); err != nil {
+		return err
+	}
+	w.header = true
+	return nil
+}
+
+// writeBlock writes the rows of the block and flushes, the client gets the rows as they are produced.
+func (w *resultWriter) writeBlock(block *datablocks.DataBlock) error {
+	conn := w.session.conn
+
+	if !w.header {
+		if err := w.writeHeader(block.Columns()); err != nil {
+			return err
+		}
+	}
+
+	var row []byte
+	iters := block.ColumnIterators()
+	for i := 0; i < block.NumRows(); i++ {
+		row = row[:0]
+		for _, it := range iters {
+			if !it.Next() {
+				return errors.Errorf("Column %s has no row %d", it.Column().Name, i)
+			}
+			v := it.Value()
+			if datavalues.IsNull(v) {
+				row = append(row, nullValue)
+				continue
+			}
+			w.buf.Reset()
+			if err := it.Column().DataType.SerializeText(&w.buf, v); err != nil {
+				return err
+			}
+			row = appendLenEncInt(row, uint64(w.buf.Len()))
+			row = append(row, w.buf.Bytes()...)
+		}
+		if err := conn.writePacket(row); err != nil {
+			return err
+		}
+		w.rows++
+	}
+	return conn.flush()
+}
+
+func (w *resultWriter) finish() error {
+	if err := w.session.conn.writePacket(eof()); err != nil {
+		return err
+	}
+	return w.session.conn.flush()
+}
+
+func columnDefinition(col *columns.Column) []byte {
+	typ, flags, length := columnType(col.DataType)
+	charset := charsetBinary
+	if typ == typeVarString {
+		charset = charsetUTF8
+	}
+
+	b := appendLenEncString(nil, "def")
+	// Schema, table and original table.
+	b = appendLenEncString(b, "")
+	b = appendLenEncString(b, "")
+	b = appendLenEncString(b, "")
+	b = appendLenEncString(b, col.Name)
+	b = appendLenEncString(b, col.Name)
+	// The length of the fixed fields.
+	b = append(b, 0x0c)
+	b = appendUint16(b, charset)
+	b = appendUint32(b, length)
+	b = append(b, typ)
+	b = appendUint16(b, flags)
+	// Decimals.
+	if typ == typeDouble {
+		b = append(b, 31)
+	} else {
+		b = append(b, 0)
+	}
+	return append(b, 0, 0)
+}
+
+// columnType maps the data type to the MySQL column type, the unknown types are sent as strings.
+func columnType(dataType datatypes.IDataType) (byte, uint16, uint32) {
+	switch dataType.Name() {
+	case datatypes.DataTypeInt32Name:
+		return typeLong, flagBinary, 11
+	case datatypes.DataTypeUInt32Name:
+		return typeLong, flagBinary | flagUnsigned, 10
+	case datatypes.DataTypeInt64Name:
+		return typeLongLong, flagBinary, 20
+	case datatypes.DataTypeUInt64Name:
+		return typeLongLong, flagBinary | flagUnsigned, 20
+	case datatypes.DataTypeFloat64Name:
+		return typeDouble, flagBinary, 22
+	case datatypes.DataTypeDateTimeName:
+		return typeDateTime, flagBinary, 19
+	}
+	return typeVarString, 0, 1 << 24
+}
@@ -0,0 +1,158 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package postgresql
+
+import (
+	"crypto/rand"
+
+	"databases"
+	"servers/protocol"
+	"users"
+
+	"base/errors"
+)
+
+// startup reads the StartupMessage, the SSL and GSSAPI encryption requests are refused with 'N'.
+// The password is asked in cleartext only if the users are configured.
+func (s *PostgreSQLHandler) startup(session *postgresqlSession) error {
+	log := s.log
+	conn := session.conn
+
+	var params map[string]string
+	for params == nil {
+		data, err := conn.readStartup()
+		if err != nil {
+			return err
+		}
+		r := &messageReader{data: data}
+		code, err := r.int32()
+		if err != nil {
+			return err
+		}
+
+		switch code {
+		case sslRequestCode, gssEncRequestCode:
+			if err := conn.writeByte('N'); err != nil {
+				return err
+			}
+			if err := conn.flush(); err != nil {
+				return err
+			}
+		case cancelRequestCode:
+			// The queries can't be cancelled by another connection.
+			return errors.New("PostgreSQL CancelRequest is not supported")
+		case protocolVersion3:
+			if params, err = parseStartupParams(r); err != nil {
+				return err
+			}
+		default:
+			err := errors.Errorf("Unsupported frontend protocol %d.%d", code>>16, code&0xffff)
+			if xerr := s.writeFatal(session, err, sqlStateProtocolViolation); xerr != nil {
+				return xerr
+			}
+			return err
+		}
+	}
+
+	password := ""
+	if len(s.conf.Users) > 0 {
+		if err := conn.writeMessage(msgAuthentication, appendInt32(nil, authCleartextPassword)); err != nil {
+			return err
+		}
+		if err := conn.flush(); err != nil {
+			return err
+		}
+		typ, data, err := conn.readMessage()
+		if err != nil {
+			return err
+		}
+		if typ != msgPassword {
+			return errors.Errorf("Unexpected message %c, expected the password", typ)
+		}
+		r := &messageReader{data: data}
+		if password, err = r.cString(); err != nil {
+			return err
+		}
+	}
+
+	user, err := users.Authenticate(s.conf, params["user"], password)
+	if err != nil {
+		log.Warning("PostgreSQL authentication from %s: %v", conn.conn.RemoteAddr(), err)
+		if xerr := s.writeFatal(session, err, sqlState(err)); xerr != nil {
+			return xerr
+		}
+		return err
+	}
+	session.session.SetUser(user)
+
+	if database := params["database"]; database != "" {
+		if err := user.CheckDatabase(database); err != nil {
+			if xerr := s.writeFatal(session, err, sqlState(err)); xerr != nil {
+				return xerr
+			}
+			return err
+		}
+		if _, err := databases.GetDatabase(database); err != nil {
+			if xerr := s.writeFatal(session, err, sqlStateInvalidCatalogName); xerr != nil {
+				return xerr
+			}
+			return err
+		}
+		session.session.SetDatabase(database)
+	}
+
+	if err := conn.writeMessage(msgAuthentication, appendInt32(nil, authOK)); err != nil {
+		return err
+	}
+	for _, kv := range [][2]string{
+		{"server_version", serverVersion},
+		{"server_encoding", "UTF8"},
+		{"client_encoding", "UTF8"},
+		{"DateStyle", "ISO, YMD"},
+		{"TimeZone", "UTC"},
+		{"integer_datetimes", "on"},
+		{"standard_conforming_strings", "on"},
+		{"application_name", params["application_name"]},
+	} {
+		b := appendCString(nil, kv[0])
+		b = appendCString(b, kv[1])
+		if err := conn.writeMessage(msgParameterStatus, b); err != nil {
+			return err
+		}
+	}
+	var secret [4]byte
+	if _, err := rand.Read(secret[:]); err != nil {
+		return err
+	}
+	b := appendInt32(nil, session.id)
+	b = append(b, secret[:]...)
+	if err := conn.writeMessage(msgBackendKeyData, b); err != nil {
+		return err
+	}
+	return s.writeReadyForQuery(session)
+}
+
+// serverVersion looks like a PostgreSQL version, the clients parse the leading number.
+var serverVersion = "9.6.0 (" + protocol.VersionName + ")"
+
+func parseStartupParams(r *messageReader) (map[string]string, error) {
+	params := make(map[string]string)
+	for !r.eof() {
+		key, err := r.cString()
+		if err != nil {
+			return nil, err
+		}
+		// The list ends with an empty name.
+		if key == "" {
+			break
+		}
+		value, err := r.cString()
+		if err != nil {
+			return nil, err
+		}
+		params[key] = value
+	}
+	return params, nil
+}
@@ -0,0 +1,163 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package postgresql
+
+import (
+	"fmt"
+	"io"
+	"net"
+
+	"config"
+	"sessions"
+
+	"base/errors"
+	"base/sync2"
+	"base/xlog"
+)
+
+// PostgreSQLHandler serves the simple query protocol of PostgreSQL on the postgresql_port,
+// enough for psql and the lib/pq clients to run the queries.
+type PostgreSQLHandler struct {
+	log      *xlog.Log
+	conf     *config.Config
+	listener net.Listener
+	connID   sync2.AtomicInt32
+	closing  sync2.AtomicBool
+}
+
+func NewPostgreSQLHandler(log *xlog.Log, conf *config.Config) *PostgreSQLHandler {
+	return &PostgreSQLHandler{
+		log:  log,
+		conf: conf,
+	}
+}
+
+func (s *PostgreSQLHandler) Start() {
+	log := s.log
+
+	if s.conf.Server.PostgreSQLPort <= 0 {
+		return
+	}
+	listener, err := net.Listen("tcp", fmt.Sprintf("%v:%v", s.conf.Server.ListenHost, s.conf.Server.PostgreSQLPort))
+	if err != nil {
+		log.Panic("Couldn't listen: %+v", err)
+	}
+	s.listener = listener
+	go s.serve(listener)
+}
+
+func (s *PostgreSQLHandler) serve(listener net.Listener) {
+	log := s.log
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if s.closing.Get() {
+				return
+			}
+			log.Panic("Couldn't accept: %+v", err)
+		}
+		go s.handle(conn)
+	}
+}
+
+// Stop closes the listener, the connections open go on until the process exits.
+func (s *PostgreSQLHandler) Stop() {
+	s.closing.Set(true)
+	if s.listener != nil {
+		s.listener.Close()
+	}
+}
+
+// Address returns the PostgreSQL protocol address, empty if not enabled.
+func (s *PostgreSQLHandler) Address() string {
+	if s.listener == nil {
+		return ""
+	}
+	return fmt.Sprintf(":%v", s.conf.Server.PostgreSQLPort)
+}
+
+type postgresqlSession struct {
+	id      int32
+	conn    *messageConn
+	session *sessions.Session
+	// The extended query protocol failed, the messages are discarded until Sync.
+	skipTillSync bool
+}
+
+func (s *PostgreSQLHandler) handle(conn net.Conn) {
+	log := s.log
+
+	// Catch panics, and close the connection in any case.
+	defer func() {
+		conn.Close()
+		if x := recover(); x != nil {
+			log.Error("%+v", errors.Errorf("%+v", x))
+		}
+	}()
+
+	session := &postgresqlSession{
+		id:      s.connID.Add(1),
+		conn:    newMessageConn(conn),
+		session: sessions.NewSession(),
+	}
+	defer session.session.Close()
+
+	log.Debug("PostgreSQL connection coming:%s", conn.RemoteAddr().String())
+	if err := s.handleMessages(session); err != nil {
+		if err == io.EOF {
+			log.Info("PostgreSQL connection closed:%v", conn.RemoteAddr().String())
+		} else {
+			log.Error("%+v, %T", err, err)
+		}
+	}
+}
+
+func (s *PostgreSQLHandler) handleMessages(session *postgresqlSession) error {
+	log := s.log
+	conn := session.conn
+
+	if err := s.startup(session); err != nil {
+		return err
+	}
+
+	for {
+		typ, data, err := conn.readMessage()
+		if err != nil {
+			return err
+		}
+
+		log.Debug("PostgreSQL receive message:%c", typ)
+		switch typ {
+		case msgTerminate:
+			return io.EOF
+		case msgQuery:
+			r := &messageReader{data: data}
+			query, err := r.cString()
+			if err != nil {
+				return err
+			}
+			err = s.query(session, query)
+		case msgSync:
+			session.skipTillSync = false
+			err = s.writeReadyForQuery(session)
+		case msgFlush:
+			err = conn.flush()
+		case msgParse, msgBind, msgDescribe, msgExecute, msgClose:
+			// Answered once, the error is sent with the ReadyForQuery of the Sync.
+			if !session.skipTillSync {
+				session.skipTillSync = true
+				err = s.writeError(session, errors.New("The extended query protocol is not supported, use the simple query protocol"), sqlStateFeatureNotSupported)
+			}
+		default:
+			if err = s.writeError(session, errors.Errorf("Unsupported message:%c", typ), sqlStateFeatureNotSupported); err == nil {
+				err = s.writeReadyForQuery(session)
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
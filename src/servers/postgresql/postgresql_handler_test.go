@@ -0,0 +1,279 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package postgresql
+
+import (
+	"net"
+	"testing"
+
+	"config"
+	"mocks"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testClient speaks the frontend side of the protocol.
+type testClient struct {
+	t    *testing.T
+	conn *messageConn
+}
+
+func newTestClient(t *testing.T, handler *PostgreSQLHandler) (*testClient, func()) {
+	server, client := net.Pipe()
+	go handler.handle(server)
+	return &testClient{t: t, conn: newMessageConn(client)}, func() { client.Close() }
+}
+
+func (c *testClient) read() (byte, []byte) {
+	typ, data, err := c.conn.readMessage()
+	assert.Nil(c.t, err)
+	return typ, data
+}
+
+func (c *testClient) send(typ byte, body []byte) {
+	assert.Nil(c.t, c.conn.writeMessage(typ, body))
+	assert.Nil(c.t, c.conn.flush())
+}
+
+func (c *testClient) startup(code int32, params ...string) {
+	b := appendInt32(nil, code)
+	for _, param := range params {
+		b = appendCString(b, param)
+	}
+	b = append(b, 0)
+	_, err := c.conn.writer.Write(appendInt32(nil, int32(len(b)+4)))
+	assert.Nil(c.t, err)
+	_, err = c.conn.writer.Write(b)
+	assert.Nil(c.t, err)
+	assert.Nil(c.t, c.conn.flush())
+}
+
+// login returns the error message, empty if the ReadyForQuery comes.
+func (c *testClient) login(password string, params ...string) string {
+	// The SSL is refused first.
+	c.startup(sslRequestCode)
+	b, err := c.conn.reader.ReadByte()
+	assert.Nil(c.t, err)
+	assert.Equal(c.t, byte('N'), b)
+
+	c.startup(protocolVersion3, params...)
+	for {
+		typ, data := c.read()
+		switch typ {
+		case msgAuthentication:
+			r := &messageReader{data: data}
+			code, _ := r.int32()
+			if code == authCleartextPassword {
+				c.send(msgPassword, appendCString(nil, password))
+			}
+		case msgErrorResponse:
+			return errorMessage(data)
+		case msgReadyForQuery:
+			return ""
+		}
+	}
+}
+
+// query returns the column names, the rows, the tag, or the error message.
+func (c *testClient) query(query string) ([]string, [][]string, string, string) {
+	c.send(msgQuery, appendCString(nil, query))
+
+	var names []string
+	var rows [][]string
+	var tag, message string
+	for {
+		typ, data := c.read()
+		r := &messageReader{data: data}
+		switch typ {
+		case msgRowDescription:
+			// The count, then the name and 18 bytes of the type per column.
+			r.pos = 2
+			for !r.eof() {
+				name, _ := r.cString()
+				names = append(names, name)
+				r.pos += 18
+			}
+		case msgDataRow:
+			var row []string
+			r.pos = 2
+			for r.pos < len(data) {
+				n, _ := r.int32()
+				if n < 0 {
+					row = append(row, "NULL")
+					continue
+				}
+				row = append(row, string(data[r.pos:r.pos+int(n)]))
+				r.pos += int(n)
+			}
+			rows = append(rows, row)
+		case msgCommandComplete:
+			tag, _ = r.cString()
+		case msgEmptyQueryResponse:
+			tag = "EMPTY"
+		case msgErrorResponse:
+			message = errorMessage(data)
+		case msgReadyForQuery:
+			return names, rows, tag, message
+		}
+	}
+}
+
+// errorMessage returns the SQLSTATE and the message of the ErrorResponse.
+func errorMessage(data []byte) string {
+	var state, message string
+	r := &messageReader{data: data}
+	for !r.eof() && data[r.pos] != 0 {
+		field := data[r.pos]
+		r.pos++
+		value, _ := r.cString()
+		switch field {
+		case 'C':
+			state = value
+		case 'M':
+			message = value
+		}
+	}
+	return state + " " + message
+}
+
+func TestPostgreSQLHandlerQuery(t *testing.T) {
+	mock, cleanup := mocks.NewMock()
+	defer cleanup()
+	handler := NewPostgreSQLHandler(mock.Log, mock.Conf)
+
+	client, closer := newTestClient(t, handler)
+	defer closer()
+	assert.Equal(t, "", client.login("", "user", "default", "database", "system"))
+
+	tests := []struct {
+		name  string
+		query string
+		cols  []string
+		// The parallel numbers come in any order, the rows are only checked if set.
+		rows [][]string
+		tag  string
+		err  string
+	}{
+		{
+			name:  "empty",
+			query: " ;",
+			tag:   "EMPTY",
+		},
+		{
+			name:  "set-client",
+			query: "SET client_encoding TO 'UTF8'",
+			tag:   "SET",
+		},
+		{
+			name:  "create-db",
+			query: "create database db1",
+			tag:   "CREATE DATABASE",
+		},
+		{
+			name:  "create-table",
+			query: "create table db1.t1(a UInt32, b String) Engine=Memory;",
+			tag:   "CREATE TABLE",
+		},
+		{
+			name:  "insert-values",
+			query: "insert into db1.t1 values(1,'a')",
+			err:   "0A000 INSERT with the data is not supported by the PostgreSQL protocol, use INSERT SELECT or the HTTP/native protocols",
+		},
+		{
+			name:  "select",
+			query: "SELECT number, (number+1) FROM system.numbers limit 3",
+			cols:  []string{"number", "(number+1)"},
+			tag:   "SELECT 3",
+		},
+		{
+			name:  "select-empty",
+			query: "select a, b from db1.t1",
+			tag:   "SELECT 0",
+		},
+		{
+			name:  "select-error",
+			query: "select a from db1.t2",
+			err:   "42P01 couldn't find table:t2 storage (errno 60)",
+		},
+		{
+			name:  "parse-error",
+			query: "selec 1",
+			err:   "42601 syntax error at position 6 near 'selec' (errno 62)",
+		},
+		{
+			name:  "drop-db",
+			query: "drop database db1",
+			tag:   "DROP DATABASE",
+		},
+	}
+
+	for _, test := range tests {
+		cols, rows, tag, err := client.query(test.query)
+		assert.Equal(t, test.err, err, test.name)
+		assert.Equal(t, test.cols, cols, test.name)
+		if test.rows != nil {
+			assert.Equal(t, test.rows, rows, test.name)
+		}
+		assert.Equal(t, test.tag, tag, test.name)
+	}
+
+	// The extended protocol fails once until Sync.
+	assert.Nil(t, client.conn.writeMessage(msgParse, appendCString(appendCString(nil, ""), "select 1")))
+	assert.Nil(t, client.conn.writeMessage(msgBind, nil))
+	client.send(msgSync, nil)
+	typ, data := client.read()
+	assert.Equal(t, byte(msgErrorResponse), typ)
+	assert.Equal(t, "0A000 The extended query protocol is not supported, use the simple query protocol", errorMessage(data))
+	typ, _ = client.read()
+	assert.Equal(t, byte(msgReadyForQuery), typ)
+	client.send(msgTerminate, nil)
+}
+
+func TestPostgreSQLHandlerAuth(t *testing.T) {
+	mock, cleanup := mocks.NewMock()
+	defer cleanup()
+	mock.Conf.Users = []config.User{
+		{Name: "default", Password: "pass"},
+		{Name: "reader", Password: "secret", Databases: []string{"db1"}},
+	}
+	handler := NewPostgreSQLHandler(mock.Log, mock.Conf)
+
+	tests := []struct {
+		name     string
+		password string
+		params   []string
+		err      string
+	}{
+		{
+			name:     "ok",
+			password: "pass",
+			params:   []string{"user", "default"},
+		},
+		{
+			name:     "bad-password",
+			password: "x",
+			params:   []string{"user", "default"},
+			err:      "28P01 default: Authentication failed: password is incorrect or there is no user with such name (errno 516)",
+		},
+		{
+			name:     "access-denied",
+			password: "secret",
+			params:   []string{"user", "reader", "database", "default"},
+			err:      "42501 reader: Not enough privileges to access database default (errno 497)",
+		},
+		{
+			name:     "unknown-database",
+			password: "pass",
+			params:   []string{"user", "default", "database", "nodb"},
+			err:      "3D000 database:nodb doesn't exists (errno 81)",
+		},
+	}
+
+	for _, test := range tests {
+		client, closer := newTestClient(t, handler)
+		assert.Equal(t, test.err, client.login(test.password, test.params...), test.name)
+		closer()
+	}
+}
@@ -0,0 +1,181 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package postgresql
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"net"
+
+	"base/errors"
+)
+
+// The codes of the startup packets.
+const (
+	protocolVersion3  = 196608
+	sslRequestCode    = 80877103
+	gssEncRequestCode = 80877104
+	cancelRequestCode = 80877102
+)
+
+// The frontend messages.
+const (
+	msgQuery     = 'Q'
+	msgTerminate = 'X'
+	msgPassword  = 'p'
+	msgParse     = 'P'
+	msgBind      = 'B'
+	msgDescribe  = 'D'
+	msgExecute   = 'E'
+	msgClose     = 'C'
+	msgFlush     = 'H'
+	msgSync      = 'S'
+)
+
+// The backend messages.
+const (
+	msgAuthentication     = 'R'
+	msgParameterStatus    = 'S'
+	msgBackendKeyData     = 'K'
+	msgReadyForQuery      = 'Z'
+	msgRowDescription     = 'T'
+	msgDataRow            = 'D'
+	msgCommandComplete    = 'C'
+	msgEmptyQueryResponse = 'I'
+	msgErrorResponse      = 'E'
+)
+
+const (
+	authOK                = 0
+	authCleartextPassword = 3
+
+	// The transaction status of ReadyForQuery, always idle.
+	transactionIdle = 'I'
+
+	maxStartupSize = 10000
+	maxMessageSize = 1 << 26
+)
+
+// The type OIDs of pg_type.
+const (
+	oidInt8      = 20
+	oidInt4      = 23
+	oidText      = 25
+	oidFloat8    = 701
+	oidTimestamp = 1114
+	oidNumeric   = 1700
+)
+
+var errMalformedMessage = errors.New("Malformed PostgreSQL message")
+
+// messageConn reads and writes the messages: type(1) + length(4, including itself) + body,
+// the startup packets have no type byte.
+type messageConn struct {
+	conn   net.Conn
+	reader *bufio.Reader
+	writer *bufio.Writer
+	head   [5]byte
+}
+
+func newMessageConn(conn net.Conn) *messageConn {
+	return &messageConn{
+		conn:   conn,
+		reader: bufio.NewReader(conn),
+		writer: bufio.NewWriter(conn),
+	}
+}
+
+func (c *messageConn) readStartup() ([]byte, error) {
+	if _, err := io.ReadFull(c.reader, c.head[:4]); err != nil {
+		return nil, err
+	}
+	size := int(binary.BigEndian.Uint32(c.head[:4]))
+	if size < 8 || size > maxStartupSize {
+		return nil, errMalformedMessage
+	}
+	data := make([]byte, size-4)
+	if _, err := io.ReadFull(c.reader, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (c *messageConn) readMessage() (byte, []byte, error) {
+	if _, err := io.ReadFull(c.reader, c.head[:]); err != nil {
+		return 0, nil, err
+	}
+	size := int(binary.BigEndian.Uint32(c.head[1:]))
+	if size < 4 || size > maxMessageSize {
+		return 0, nil, errMalformedMessage
+	}
+	data := make([]byte, size-4)
+	if _, err := io.ReadFull(c.reader, data); err != nil {
+		return 0, nil, err
+	}
+	return c.head[0], data, nil
+}
+
+// writeMessage buffers the message, flush sends them.
+func (c *messageConn) writeMessage(typ byte, body []byte) error {
+	c.head[0] = typ
+	binary.BigEndian.PutUint32(c.head[1:], uint32(len(body)+4))
+	if _, err := c.writer.Write(c.head[:]); err != nil {
+		return err
+	}
+	_, err := c.writer.Write(body)
+	return err
+}
+
+func (c *messageConn) writeByte(b byte) error {
+	return c.writer.WriteByte(b)
+}
+
+func (c *messageConn) flush() error {
+	return c.writer.Flush()
+}
+
+func appendInt16(b []byte, v int16) []byte {
+	return append(b, byte(v>>8), byte(v))
+}
+
+func appendInt32(b []byte, v int32) []byte {
+	return append(b, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+func appendCString(b []byte, s string) []byte {
+	b = append(b, s...)
+	return append(b, 0)
+}
+
+// messageReader reads the fields of a message body.
+type messageReader struct {
+	data []byte
+	pos  int
+}
+
+func (r *messageReader) int32() (int32, error) {
+	if len(r.data)-r.pos < 4 {
+		return 0, errMalformedMessage
+	}
+	v := int32(binary.BigEndian.Uint32(r.data[r.pos:]))
+	r.pos += 4
+	return v, nil
+}
+
+func (r *messageReader) cString() (string, error) {
+	for i := r.pos; i < len(r.data); i++ {
+		if r.data[i] == 0 {
+			s := string(r.data[r.pos:i])
+			r.pos = i + 1
+			return s, nil
+		}
+	}
+	return "", errMalformedMessage
+}
+
+func (r *messageReader) eof() bool {
+	return r.pos >= len(r.data)
+}
@@ -0,0 +1,140 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package postgresql
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"datablocks"
+	"executors"
+	"optimizers"
+	"planners"
+	"sessions"
+
+	"base/errors"
+)
+
+// The statements of psql and the drivers setting up the connection, answered SET without running them.
+var clientSetupQuery = regexp.MustCompile(`(?is)^\s*SET\s+(SESSION\s+)?(CLIENT_ENCODING|DATESTYLE|INTERVALSTYLE|EXTRA_FLOAT_DIGITS|APPLICATION_NAME|SEARCH_PATH|TIME\s+ZONE|TIMEZONE|STATEMENT_TIMEOUT|STANDARD_CONFORMING_STRINGS)\b`)
+
+// query runs the simple Query message, the errors of the query are sent to the client as ErrorResponse,
+// only the connection errors are returned. It always ends with ReadyForQuery.
+func (s *PostgreSQLHandler) query(session *postgresqlSession, query string) error {
+	if err := s.execute(session, query); err != nil {
+		return err
+	}
+	return s.writeReadyForQuery(session)
+}
+
+func (s *PostgreSQLHandler) execute(session *postgresqlSession, query string) error {
+	log := s.log
+	xsession := session.session
+
+	log.Debug("PostgreSQLHandler-Query->Enter:%+v, user:%s", query, xsession.GetUser().Name)
+	query = strings.TrimRight(strings.TrimSpace(query), "; \t\r\n")
+	switch {
+	case query == "":
+		return session.conn.writeMessage(msgEmptyQueryResponse, nil)
+	case clientSetupQuery.MatchString(query):
+		return s.writeCommandComplete(session, "SET")
+	}
+
+	// Logical plans.
+	plan, err := planners.PlanFactory(query)
+	if err != nil {
+		log.Error("%+v", err)
+		return s.writeError(session, err, sqlStateSyntaxError)
+	}
+
+	// The settings of the SET statements.
+	conf, _, err := s.conf.WithSettings(xsession.Settings())
+	if err != nil {
+		return s.writeError(session, err, sqlState(err))
+	}
+	plan = optimizers.Optimize(conf, plan, optimizers.DefaultOptimizers)
+	if insertPlan, ok := plan.(*planners.InsertPlan); ok && insertPlan.SubPlan == nil {
+		return s.writeError(session, errors.New("INSERT with the data is not supported by the PostgreSQL protocol, use INSERT SELECT or the HTTP/native protocols"), sqlStateFeatureNotSupported)
+	}
+	ctx, limits := executors.NewExecutionLimits(context.Background(), conf)
+	defer limits.Cancel()
+	if err := executors.ShutdownError(); err != nil {
+		return s.writeError(session, err, sqlState(err))
+	}
+	id, err := limits.StartQuery("", query, xsession)
+	if err != nil {
+		return s.writeError(session, err, sqlState(err))
+	}
+	log = log.With("query_id", id)
+
+	// Executors.
+	ectx := executors.NewExecutorContext(ctx, log, conf, xsession)
+	ectx.SetProgressCallback(func(pv *sessions.ProgressValues) {
+		xsession.UpdateProgress(pv)
+		limits.CheckSpeed(pv)
+	})
+	executor, err := executors.ExecutorFactory(ectx, plan)
+	if err != nil {
+		log.Error("%+v", err)
+		return s.writeError(session, err, sqlState(err))
+	}
+	result, err := executor.Execute()
+	if err != nil {
+		log.Error("%+v", err)
+		return s.writeError(session, err, sqlState(err))
+	}
+	if result.In == nil {
+		return s.writeCommandComplete(session, commandTag(query))
+	}
+
+	// The rows are streamed block by block, the failed query is drained after the ErrorResponse.
+	w := &resultWriter{session: session}
+	var failed bool
+	for x := range result.In.In().Recv() {
+		if failed {
+			continue
+		}
+		switch x := x.(type) {
+		case error:
+			x = limits.Error(x, xsession.GetProgress())
+			log.Error("%+v", x)
+			failed = true
+			limits.Cancel()
+			if err := s.writeError(session, x, sqlState(x)); err != nil {
+				return err
+			}
+		case *datablocks.DataBlock:
+			if err := w.writeBlock(x); err != nil {
+				return err
+			}
+			x.Release()
+		}
+	}
+	if failed {
+		return nil
+	}
+	log.Debug("%s", executor.String())
+	log.Debug("Query memory peak:%d bytes", limits.MemoryTracker().Peak())
+	return w.finish(commandTag(query))
+}
+
+// commandTag is the tag of CommandComplete without the row count: SELECT, INSERT 0, CREATE TABLE...
+// The rows written by INSERT SELECT are not counted.
+func commandTag(query string) string {
+	fields := strings.Fields(strings.ToUpper(query))
+	switch fields[0] {
+	case "INSERT":
+		return "INSERT 0 0"
+	case "CREATE", "DROP":
+		if len(fields) > 1 {
+			if fields[1] == "TEMPORARY" && len(fields) > 2 {
+				return fields[0] + " " + fields[2]
+			}
+			return fields[0] + " " + fields[1]
+		}
+	}
+	return fields[0]
+}
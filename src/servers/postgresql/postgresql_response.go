@@ -0,0 +1,180 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package postgresql
+
+import (
+	"bytes"
+	"fmt"
+
+	"columns"
+	"datablocks"
+	"datatypes"
+	"datavalues"
+
+	"base/errors"
+)
+
+// The SQLSTATE codes of the ErrorResponse.
+const (
+	sqlStateInternalError         = "XX000"
+	sqlStateSyntaxError           = "42601"
+	sqlStateFeatureNotSupported   = "0A000"
+	sqlStateProtocolViolation     = "08P01"
+	sqlStateInvalidPassword       = "28P01"
+	sqlStateInsufficientPrivilege = "42501"
+	sqlStateInvalidCatalogName    = "3D000"
+	sqlStateUndefinedTable        = "42P01"
+	sqlStateReadOnlyTransaction   = "25006"
+	sqlStateQueryCanceled         = "57014"
+	sqlStateAdminShutdown         = "57P01"
+)
+
+// sqlState translates the error to the SQLSTATE.
+func sqlState(err error) string {
+	switch errors.Code(err) {
+	case errors.AUTHENTICATION_FAILED:
+		return sqlStateInvalidPassword
+	case errors.ACCESS_DENIED:
+		return sqlStateInsufficientPrivilege
+	case errors.READONLY:
+		return sqlStateReadOnlyTransaction
+	case errors.TIMEOUT_EXCEEDED, errors.TOO_SLOW:
+		return sqlStateQueryCanceled
+	case errors.ABORTED:
+		return sqlStateAdminShutdown
+	case errors.SYNTAX_ERROR:
+		return sqlStateSyntaxError
+	case errors.UNKNOWN_DATABASE:
+		return sqlStateInvalidCatalogName
+	case errors.UNKNOWN_TABLE:
+		return sqlStateUndefinedTable
+	}
+	return sqlStateInternalError
+}
+
+func (s *PostgreSQLHandler) writeReadyForQuery(session *postgresqlSession) error {
+	if err := session.conn.writeMessage(msgReadyForQuery, []byte{transactionIdle}); err != nil {
+		return err
+	}
+	return session.conn.flush()
+}
+
+func (s *PostgreSQLHandler) writeCommandComplete(session *postgresqlSession, tag string) error {
+	return session.conn.writeMessage(msgCommandComplete, appendCString(nil, tag))
+}
+
+func (s *PostgreSQLHandler) writeError(session *postgresqlSession, err error, state string) error {
+	return writeErrorResponse(session, "ERROR", err, state)
+}
+
+// writeFatal sends the error which ends the connection.
+func (s *PostgreSQLHandler) writeFatal(session *postgresqlSession, err error, state string) error {
+	if err := writeErrorResponse(session, "FATAL", err, state); err != nil {
+		return err
+	}
+	return session.conn.flush()
+}
+
+func writeErrorResponse(session *postgresqlSession, severity string, err error, state string) error {
+	b := append([]byte{'S'}, appendCString(nil, severity)...)
+	b = append(b, 'V')
+	b = appendCString(b, severity)
+	b = append(b, 'C')
+	b = appendCString(b, state)
+	b = append(b, 'M')
+	b = appendCString(b, err.Error())
+	b = append(b, 0)
+	return session.conn.writeMessage(msgErrorResponse, b)
+}
+
+// resultWriter writes RowDescription on the first block, the text DataRows, and CommandComplete.
+type resultWriter struct {
+	session *postgresqlSession
+	header  bool
+	rows    uint64
+	buf     bytes.Buffer
+}
+
+func (w *resultWriter) writeHeader(cols []*columns.Column) error {
+	b := appendInt16(nil, int16(len(cols)))
+	for _, col := range cols {
+		oid, size := columnType(col.DataType)
+		b = appendCString(b, col.Name)
+		// The table OID and the attribute number.
+		b = appendInt32(b, 0)
+		b = appendInt16(b, 0)
+		b = appendInt32(b, oid)
+		b = appendInt16(b, size)
+		// The type modifier and the text format.
+		b = appendInt32(b, -1)
+		b = appendInt16(b, 0)
+	}
+	w.header = true
+	return w.session.conn.writeMessage(msgRowDescription, b)
+}
+
+// writeBlock writes the rows of the block and flushes, the client gets the rows as they are produced.
+func (w *resultWriter) writeBlock(block *datablocks.DataBlock) error {
+	conn := w.session.conn
+
+	if !w.header {
+		if err := w.writeHeader(block.Columns()); err != nil {
+			return err
+		}
+	}
+
+	var row []byte
+	iters := block.ColumnIterators()
+	for i := 0; i < block.NumRows(); i++ {
+		row = appendInt16(row[:0], int16(len(iters)))
+		for _, it := range iters {
+			if !it.Next() {
+				return errors.Errorf("Column %s has no row %d", it.Column().Name, i)
+			}
+			v := it.Value()
+			if datavalues.IsNull(v) {
+				row = appendInt32(row, -1)
+				continue
+			}
+			w.buf.Reset()
+			if err := it.Column().DataType.SerializeText(&w.buf, v); err != nil {
+				return err
+			}
+			row = appendInt32(row, int32(w.buf.Len()))
+			row = append(row, w.buf.Bytes()...)
+		}
+		if err := conn.writeMessage(msgDataRow, row); err != nil {
+			return err
+		}
+		w.rows++
+	}
+	return conn.flush()
+}
+
+// finish writes the CommandComplete, a SELECT without any block has no columns to describe.
+func (w *resultWriter) finish(tag string) error {
+	if tag == "SELECT" {
+		tag = fmt.Sprintf("SELECT %d", w.rows)
+	}
+	return w.session.conn.writeMessage(msgCommandComplete, appendCString(nil, tag))
+}
+
+// columnType maps the data type to the type OID and the size, the unknown types are sent as text.
+func columnType(dataType datatypes.IDataType) (int32, int16) {
+	switch dataType.Name() {
+	case datatypes.DataTypeInt32Name:
+		return oidInt4, 4
+	case datatypes.DataTypeUInt32Name, datatypes.DataTypeInt64Name:
+		return oidInt8, 8
+	case datatypes.DataTypeUInt64Name:
+		// Out of the range of int8.
+		return oidNumeric, -1
+	case datatypes.DataTypeFloat64Name:
+		return oidFloat8, 8
+	case datatypes.DataTypeDateTimeName:
+		return oidTimestamp, 8
+	}
+	return oidText, -1
+}
@@ -71,13 +71,15 @@ const (
 )
 
 const (
-	VERSION_REVISION                                = 54428
+	VERSION_REVISION                                = 54429
 	DBMS_MIN_REVISION_WITH_CLIENT_INFO              = 54032
 	DBMS_MIN_REVISION_WITH_SERVER_TIMEZONE          = 54058
 	DBMS_MIN_REVISION_WITH_QUOTA_KEY_IN_CLIENT_INFO = 54060
 	DBMS_MIN_REVISION_WITH_SERVER_DISPLAY_NAME      = 54372
 	DBMS_MIN_REVISION_WITH_VERSION_PATCH            = 54401
 	DBMS_MIN_REVISION_WITH_CLIENT_WRITE_INFO        = 54420
+	// The query settings are the name, flags and string value, the older are binary typed.
+	DBMS_MIN_REVISION_WITH_SETTINGS_SERIALIZED_AS_STRINGS = 54429
 )
 
 func ClientPacketType(typ uint64) string {
@@ -11,6 +11,9 @@ import (
 	"base/errors"
 )
 
+// ExceptionName is the exception class name the ClickHouse clients expect.
+const ExceptionName = "DB::Exception"
+
 type ExceptionProtocol struct {
 	Code       int32
 	Name       string
@@ -25,18 +28,13 @@ func WriteExceptionResponse(writer *binary.Writer, err error, withStackTrace boo
 		return errors.Wrapf(err, "couldn't write protocol.ServerException")
 	}
 
-	var code uint32
-	if xerr, ok := err.(*errors.Error); ok {
-		code = uint32(xerr.Code())
-	}
-
 	// Code.
-	if err := writer.UInt32(code); err != nil {
+	if err := writer.UInt32(uint32(errors.Code(err))); err != nil {
 		return errors.Wrapf(err, "couldn't write code")
 	}
 
 	// Name.
-	if err := writer.String(""); err != nil {
+	if err := writer.String(ExceptionName); err != nil {
 		return errors.Wrapf(err, "couldn't write name")
 	}
 
@@ -0,0 +1,67 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package protocol
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"base/binary"
+	"base/errors"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteExceptionResponse(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		withStack bool
+		code      uint32
+		message   string
+	}{
+		{
+			name:    "with-code",
+			err:     errors.ErrorWithCode(errors.UNKNOWN_TABLE, "Couldn't find table:t1 storage"),
+			code:    60,
+			message: "Couldn't find table:t1 storage (errno 60)",
+		},
+		{
+			name:    "without-code",
+			err:     errors.New("broken"),
+			code:    1002,
+			message: "broken",
+		},
+		{
+			name:      "with-stack",
+			err:       errors.ErrorWithCode(errors.SYNTAX_ERROR, "syntax error"),
+			withStack: true,
+			code:      62,
+			message:   "syntax error (errno 62)",
+		},
+	}
+
+	for _, test := range tests {
+		buf := new(bytes.Buffer)
+		err := WriteExceptionResponse(binary.NewWriter(buf), test.err, test.withStack)
+		assert.Nil(t, err, test.name)
+
+		reader := binary.NewReader(buf)
+		packetType, _ := reader.Uvarint()
+		assert.Equal(t, uint64(ServerException), packetType, test.name)
+		code, _ := reader.UInt32()
+		assert.Equal(t, test.code, code, test.name)
+		name, _ := reader.String()
+		assert.Equal(t, "DB::Exception", name, test.name)
+		message, _ := reader.String()
+		assert.Equal(t, test.message, message, test.name)
+		stackTrace, _ := reader.String()
+		assert.True(t, strings.HasPrefix(stackTrace, test.message), test.name)
+		assert.Equal(t, test.withStack, len(stackTrace) > len(test.message), test.name)
+		nested, _ := reader.Bool()
+		assert.False(t, nested, test.name)
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package protocol
+
+import (
+	"base/binary"
+	"base/errors"
+	"sessions"
+)
+
+// WriteProfileInfoResponse writes the result statistics after the last data block,
+// the rows before limit is 'at least' since the limit may stop the reading early.
+func WriteProfileInfoResponse(writer *binary.Writer, pv *sessions.ProfileValues) error {
+	// Header.
+	if err := writer.Uvarint(uint64(ServerProfileInfo)); err != nil {
+		return errors.Wrapf(err, "couldn't write protocol.ServerProfileInfo")
+	}
+
+	if err := writer.Uvarint(uint64(pv.Rows.Get())); err != nil {
+		return errors.Wrapf(err, "couldn't write Rows")
+	}
+	if err := writer.Uvarint(uint64(pv.Blocks.Get())); err != nil {
+		return errors.Wrapf(err, "couldn't write Blocks")
+	}
+	if err := writer.Uvarint(uint64(pv.Bytes.Get())); err != nil {
+		return errors.Wrapf(err, "couldn't write Bytes")
+	}
+
+	appliedLimit := pv.AppliedLimit.Get()
+	if err := writer.Bool(appliedLimit); err != nil {
+		return errors.Wrapf(err, "couldn't write AppliedLimit")
+	}
+	if err := writer.Uvarint(uint64(pv.RowsBeforeLimit.Get())); err != nil {
+		return errors.Wrapf(err, "couldn't write RowsBeforeLimit")
+	}
+	// Calculated rows before limit.
+	if err := writer.Bool(appliedLimit); err != nil {
+		return errors.Wrapf(err, "couldn't write CalculatedRowsBeforeLimit")
+	}
+	return nil
+}
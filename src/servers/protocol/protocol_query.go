@@ -40,12 +40,19 @@ type QueryClientInfo struct {
 	HTTPClientInfo
 }
 
+// The compression of the query data blocks.
+const (
+	CompressionDisable uint64 = 0
+	CompressionEnable  uint64 = 1
+)
+
 type QueryProtocol struct {
 	QueryID     string
 	ClientInfo  *QueryClientInfo
 	Stage       uint64
 	Compression uint64
 	Query       string
+	Settings    []QuerySetting
 }
 
 func ReadQueryRequest(reader *binary.Reader, clientRevision uint64) (*QueryProtocol, error) {
@@ -60,15 +67,8 @@ func ReadQueryRequest(reader *binary.Reader, clientRevision uint64) (*QueryProto
 		return nil, err
 	}
 
-	for {
-		if data, err := reader.String(); err != nil {
-			return nil, errors.Wrapf(err, "Couldn't read setting data")
-		} else {
-			// empty string is a marker of the end of settings.
-			if data == "" {
-				break
-			}
-		}
+	if query.Settings, err = readSettings(reader, clientRevision); err != nil {
+		return nil, err
 	}
 
 	if query.Stage, err = reader.Uvarint(); err != nil {
@@ -0,0 +1,89 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package protocol
+
+import (
+	"bytes"
+	"testing"
+
+	"base/binary"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadQueryRequestSettings(t *testing.T) {
+	tests := []struct {
+		name     string
+		revision uint64
+		write    func(w *binary.Writer)
+		expect   []QuerySetting
+		err      string
+	}{
+		{
+			name:     "strings-with-flags",
+			revision: DBMS_MIN_REVISION_WITH_SETTINGS_SERIALIZED_AS_STRINGS,
+			write: func(w *binary.Writer) {
+				w.String("max_threads")
+				w.Uvarint(settingFlagImportant)
+				w.String("2")
+				w.String("xx_unknown")
+				w.Uvarint(0)
+				w.String("yy")
+			},
+			expect: []QuerySetting{
+				{Name: "max_threads", Value: "2", Important: true},
+				{Name: "xx_unknown", Value: "yy"},
+			},
+		},
+		{
+			name:     "binary",
+			revision: DBMS_MIN_REVISION_WITH_CLIENT_WRITE_INFO,
+			write: func(w *binary.Writer) {
+				w.String("max_block_size")
+				w.Uvarint(1024)
+				w.String("send_logs_level")
+				w.String("trace")
+			},
+			expect: []QuerySetting{
+				{Name: "max_block_size", Value: "1024"},
+				{Name: "send_logs_level", Value: "trace"},
+			},
+		},
+		{
+			name:     "binary-unknown",
+			revision: DBMS_MIN_REVISION_WITH_CLIENT_WRITE_INFO,
+			write: func(w *binary.Writer) {
+				w.String("xx_unknown")
+				w.Uvarint(1)
+			},
+			err: "Unknown setting xx_unknown in the binary settings of the revision 54420 (errno 115)",
+		},
+	}
+
+	for _, test := range tests {
+		buf := new(bytes.Buffer)
+		w := binary.NewWriter(buf)
+		w.String("query-id")
+		// The initial query without the client info.
+		w.Uvarint(0)
+		test.write(w)
+		w.String("")
+		w.Uvarint(2)
+		w.Uvarint(CompressionEnable)
+		w.String("select 1")
+		assert.Nil(t, w.Flush())
+
+		query, err := ReadQueryRequest(binary.NewReader(buf), test.revision)
+		if test.err != "" {
+			assert.Equal(t, test.err, err.Error(), test.name)
+			continue
+		}
+		assert.Nil(t, err, test.name)
+		assert.Equal(t, test.expect, query.Settings, test.name)
+		assert.Equal(t, "query-id", query.QueryID, test.name)
+		assert.Equal(t, CompressionEnable, query.Compression, test.name)
+		assert.Equal(t, "select 1", query.Query, test.name)
+	}
+}
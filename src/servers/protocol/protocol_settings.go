@@ -0,0 +1,155 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package protocol
+
+import (
+	"strconv"
+
+	"base/binary"
+	"base/errors"
+)
+
+const (
+	// The client requires the setting to be applied, the unknown fails the query.
+	settingFlagImportant uint64 = 0x01
+)
+
+type QuerySetting struct {
+	Name      string
+	Value     string
+	Important bool
+}
+
+type settingKind int
+
+const (
+	settingUInt settingKind = iota
+	settingString
+)
+
+// binarySettings are the value types of the settings before DBMS_MIN_REVISION_WITH_SETTINGS_SERIALIZED_AS_STRINGS,
+// the bools, seconds and milliseconds are varints, the floats, enums and chars are strings.
+var binarySettings = map[string]settingKind{
+	"max_block_size":                          settingUInt,
+	"max_insert_block_size":                   settingUInt,
+	"min_insert_block_size_rows":              settingUInt,
+	"min_insert_block_size_bytes":             settingUInt,
+	"max_threads":                             settingUInt,
+	"max_read_buffer_size":                    settingUInt,
+	"max_distributed_connections":             settingUInt,
+	"max_query_size":                          settingUInt,
+	"interactive_delay":                       settingUInt,
+	"connect_timeout":                         settingUInt,
+	"connect_timeout_with_failover_ms":        settingUInt,
+	"receive_timeout":                         settingUInt,
+	"send_timeout":                            settingUInt,
+	"queue_max_wait_ms":                       settingUInt,
+	"poll_interval":                           settingUInt,
+	"use_uncompressed_cache":                  settingUInt,
+	"replace_running_query":                   settingUInt,
+	"max_execution_time":                      settingUInt,
+	"timeout_before_checking_execution_speed": settingUInt,
+	"min_execution_speed":                     settingUInt,
+	"max_rows_to_read":                        settingUInt,
+	"max_bytes_to_read":                       settingUInt,
+	"max_result_rows":                         settingUInt,
+	"max_result_bytes":                        settingUInt,
+	"max_rows_to_group_by":                    settingUInt,
+	"max_rows_to_sort":                        settingUInt,
+	"max_bytes_to_sort":                       settingUInt,
+	"max_memory_usage":                        settingUInt,
+	"max_memory_usage_for_user":               settingUInt,
+	"max_network_bandwidth":                   settingUInt,
+	"max_network_bytes":                       settingUInt,
+	"max_concurrent_queries_for_user":         settingUInt,
+	"max_ast_depth":                           settingUInt,
+	"max_ast_elements":                        settingUInt,
+	"max_expanded_ast_elements":               settingUInt,
+	"max_parallel_replicas":                   settingUInt,
+	"max_partitions_per_insert_block":         settingUInt,
+	"priority":                                settingUInt,
+	"readonly":                                settingUInt,
+	"extremes":                                settingUInt,
+	"log_queries":                             settingUInt,
+	"insert_quorum":                           settingUInt,
+	"insert_quorum_timeout":                   settingUInt,
+	"insert_deduplicate":                      settingUInt,
+	"select_sequential_consistency":           settingUInt,
+	"input_format_skip_unknown_fields":        settingUInt,
+	"input_format_allow_errors_num":           settingUInt,
+	"output_format_json_quote_64bit_integers": settingUInt,
+	"send_progress_in_http_headers":           settingUInt,
+	"http_headers_progress_interval_ms":       settingUInt,
+	"enable_http_compression":                 settingUInt,
+	"http_zlib_compression_level":             settingUInt,
+	"join_use_nulls":                          settingUInt,
+	"prefer_localhost_replica":                settingUInt,
+	"distributed_group_by_no_merge":           settingUInt,
+	"optimize_skip_unused_shards":             settingUInt,
+	"skip_unavailable_shards":                 settingUInt,
+	"low_cardinality_allow_in_native_format":  settingUInt,
+	"totals_auto_threshold":                   settingString,
+	"input_format_allow_errors_ratio":         settingString,
+	"network_compression_method":              settingString,
+	"send_logs_level":                         settingString,
+	"read_overflow_mode":                      settingString,
+	"result_overflow_mode":                    settingString,
+	"timeout_overflow_mode":                   settingString,
+	"group_by_overflow_mode":                  settingString,
+	"sort_overflow_mode":                      settingString,
+	"distributed_product_mode":                settingString,
+	"totals_mode":                             settingString,
+	"load_balancing":                          settingString,
+	"format_csv_delimiter":                    settingString,
+	"date_time_input_format":                  settingString,
+	"count_distinct_implementation":           settingString,
+}
+
+// readSettings reads the settings until the empty name.
+// The binary values can't be skipped without knowing the type, so the unknown setting of the old revisions fails.
+func readSettings(reader *binary.Reader, revision uint64) ([]QuerySetting, error) {
+	var settings []QuerySetting
+
+	for {
+		name, err := reader.String()
+		if err != nil {
+			return nil, errors.Wrapf(err, "Couldn't read setting name")
+		}
+		// empty string is a marker of the end of settings.
+		if name == "" {
+			return settings, nil
+		}
+
+		setting := QuerySetting{Name: name}
+		if revision >= DBMS_MIN_REVISION_WITH_SETTINGS_SERIALIZED_AS_STRINGS {
+			flags, err := reader.Uvarint()
+			if err != nil {
+				return nil, errors.Wrapf(err, "Couldn't read setting flags:%s", name)
+			}
+			setting.Important = (flags & settingFlagImportant) != 0
+			if setting.Value, err = reader.String(); err != nil {
+				return nil, errors.Wrapf(err, "Couldn't read setting value:%s", name)
+			}
+		} else {
+			kind, ok := binarySettings[name]
+			if !ok {
+				return nil, errors.ErrorWithCode(errors.UNKNOWN_SETTING, "Unknown setting %s in the binary settings of the revision %d", name, revision)
+			}
+			switch kind {
+			case settingUInt:
+				v, err := reader.Uvarint()
+				if err != nil {
+					return nil, errors.Wrapf(err, "Couldn't read setting value:%s", name)
+				}
+				setting.Value = strconv.FormatUint(v, 10)
+			case settingString:
+				if setting.Value, err = reader.String(); err != nil {
+					return nil, errors.Wrapf(err, "Couldn't read setting value:%s", name)
+				}
+			}
+		}
+		settings = append(settings, setting)
+	}
+}
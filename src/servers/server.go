@@ -5,30 +5,69 @@
 package servers
 
 import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
 	"config"
+	"executors"
+	"sessions"
 
 	"base/xlog"
 	"servers/debug"
+	"servers/grpc"
 	"servers/http"
+	"servers/mysql"
+	"servers/postgresql"
 	"servers/tcp"
+	"servers/tlsconfig"
 )
 
+// shutdownCancelWait is how long the shutdown waits for the queries cancelled, and for the HTTP responses.
+const shutdownCancelWait = time.Second
+
 type Server struct {
 	log         *xlog.Log
 	conf        *config.Config
 	tcpServer   *tcp.TCPHandler
 	httpServer  *http.HTTPHandler
+	mysqlServer *mysql.MySQLHandler
+	pgServer    *postgresql.PostgreSQLHandler
+	grpcServer  *grpc.GRPCHandler
 	debugServer *debug.DebugServer
+	certs       *tlsconfig.Certificates
+	sighup      chan os.Signal
 }
 
 func NewServer(log *xlog.Log, conf *config.Config) *Server {
-	return &Server{
+	sessions.ServerMemoryTracker().SetLimit(int64(conf.Server.MaxServerMemoryUsage))
+	s := &Server{
 		log:         log,
 		conf:        conf,
 		tcpServer:   tcp.NewTCPHandler(log, conf),
 		httpServer:  http.NewHTTPHandler(log, conf),
+		mysqlServer: mysql.NewMySQLHandler(log, conf),
+		pgServer:    postgresql.NewPostgreSQLHandler(log, conf),
+		grpcServer:  grpc.NewGRPCHandler(log, conf),
 		debugServer: debug.NewDebugServer(log, conf),
 	}
+
+	if tlsconfig.Enabled(&conf.Server) {
+		certs, err := tlsconfig.NewCertificates(conf.Server.TLSCertFile, conf.Server.TLSKeyFile)
+		if err != nil {
+			log.Panic("%+v", err)
+		}
+		tlsConf, err := tlsconfig.New(&conf.Server, certs)
+		if err != nil {
+			log.Panic("%+v", err)
+		}
+		s.certs = certs
+		s.tcpServer.SetTLSConfig(tlsConf)
+		s.httpServer.SetTLSConfig(tlsConf)
+	}
+	return s
 }
 
 func (s *Server) Start() {
@@ -37,8 +76,81 @@ func (s *Server) Start() {
 
 	s.tcpServer.Start()
 	s.httpServer.Start()
+	s.mysqlServer.Start()
+	s.pgServer.Start()
+	s.grpcServer.Start()
 	log.Info("Listening for connections with native protocol (tcp):%v", s.tcpServer.Address())
+	if addr := s.mysqlServer.Address(); addr != "" {
+		log.Info("Listening for connections with MySQL protocol (tcp):%v", addr)
+	}
+	if addr := s.pgServer.Address(); addr != "" {
+		log.Info("Listening for connections with PostgreSQL protocol (tcp):%v", addr)
+	}
+	if addr := s.grpcServer.Address(); addr != "" {
+		log.Info("Listening for connections with gRPC protocol:%v", addr)
+	}
+	if addr := s.tcpServer.SecureAddress(); addr != "" {
+		log.Info("Listening for connections with secure native protocol (tcp_secure):%v", addr)
+	}
+	if addr := s.httpServer.SecureAddress(); addr != "" {
+		log.Info("Listening for https://%v", addr)
+	}
+
+	if s.certs != nil {
+		s.sighup = make(chan os.Signal, 1)
+		signal.Notify(s.sighup, syscall.SIGHUP)
+		go s.reloadCertificates()
+	}
 }
 
+// reloadCertificates reloads the TLS certificate on SIGHUP, the new handshakes get it.
+func (s *Server) reloadCertificates() {
+	log := s.log
+
+	for range s.sighup {
+		if err := s.certs.Reload(); err != nil {
+			log.Error("Reload TLS certificate error:%+v", err)
+			continue
+		}
+		log.Info("TLS certificate reloaded")
+	}
+}
+
+// Stop shuts the server down gracefully:
+// the /ping fails with 503 at once so the load balancers move away, the new connections and queries are refused,
+// the running queries have shutdown_wait_unfinished seconds to finish before they are cancelled,
+// then the logs are flushed and the HTTP listeners closed.
 func (s *Server) Stop() {
+	log := s.log
+
+	if s.sighup != nil {
+		signal.Stop(s.sighup)
+		close(s.sighup)
+	}
+
+	executors.BeginShutdown()
+	s.httpServer.Drain()
+	s.tcpServer.Stop()
+	s.mysqlServer.Stop()
+	s.pgServer.Stop()
+	s.grpcServer.Stop()
+
+	wait := time.Duration(s.conf.Server.ShutdownWaitUnfinished) * time.Second
+	if n := executors.RunningQueries(); n > 0 {
+		log.Info("Shutdown: waiting %v for %d running queries", wait, n)
+	}
+	if !executors.WaitQueries(wait) {
+		n := executors.CancelQueries()
+		log.Warning("Shutdown: %d queries are still running after %v, cancelled", n, wait)
+		// They stop between the blocks, the exceptions are sent meanwhile.
+		executors.WaitQueries(shutdownCancelWait)
+	}
+
+	if err := log.Flush(); err != nil {
+		log.Error("Shutdown: flush logs error:%+v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownCancelWait)
+	defer cancel()
+	s.httpServer.Stop(ctx)
+	log.Info("Shutdown: done")
 }
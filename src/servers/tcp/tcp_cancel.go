@@ -0,0 +1,101 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package tcp
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	"base/errors"
+	"servers/protocol"
+)
+
+// cancelWatcher reads the client packets while the query result is streaming,
+// the Cancel packet and the disconnection cancel the query context.
+type cancelWatcher struct {
+	cancelled bool
+	// The error of the disconnection or of an unexpected packet.
+	err  error
+	exit chan struct{}
+}
+
+// watchCancel polls the connection every interval until done is closed,
+// the packets are only read between the polls so the caller must wait the exit before reading again.
+func (s *TCPHandler) watchCancel(session *TCPSession, mu *sync.Mutex, cancel context.CancelFunc, done <-chan struct{}, interval time.Duration) *cancelWatcher {
+	log := s.log
+	w := &cancelWatcher{exit: make(chan struct{})}
+
+	go func() {
+		conn := session.conn
+		defer close(w.exit)
+		defer conn.SetReadDeadline(time.Time{})
+
+		for {
+			select {
+			case <-done:
+				return
+			default:
+			}
+
+			if err := conn.SetReadDeadline(time.Now().Add(interval)); err != nil {
+				w.err = err
+				cancel()
+				return
+			}
+			// The packet types fit in one byte, a timeout never splits it.
+			packetType, err := session.reader.Uvarint()
+			if err != nil {
+				if x, ok := err.(net.Error); ok && x.Timeout() {
+					continue
+				}
+				log.Debug("TCPHandler->Query client gone:%v", err)
+				w.err = err
+				cancel()
+				return
+			}
+			if err := conn.SetReadDeadline(time.Time{}); err != nil {
+				w.err = err
+				cancel()
+				return
+			}
+
+			log.Debug("Receive packet type while streaming:%v", protocol.ClientPacketType(packetType))
+			switch packetType {
+			case protocol.ClientCancel:
+				w.cancelled = true
+				cancel()
+				return
+			case protocol.ClientData:
+				// The Data packets are not expected while streaming, they are read and dropped.
+				if err := s.processData(session); err != nil {
+					w.err = err
+					cancel()
+					return
+				}
+			case protocol.ClientPing:
+				mu.Lock()
+				err := s.processPing(session)
+				mu.Unlock()
+				if err != nil {
+					w.err = err
+					cancel()
+					return
+				}
+			default:
+				w.err = errors.ErrorWithCode(errors.UNEXPECTED_PACKET_FROM_CLIENT, "Unexpected packet %v from client while the query is running", protocol.ClientPacketType(packetType))
+				cancel()
+				return
+			}
+		}
+	}()
+	return w
+}
+
+// wait blocks until the watcher stops reading the connection.
+func (w *cancelWatcher) wait() {
+	<-w.exit
+}
@@ -0,0 +1,103 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package tcp
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"base/binary"
+	"mocks"
+	"servers/protocol"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTCPWatchCancel(t *testing.T) {
+	mock, cleanup := mocks.NewMock()
+	defer cleanup()
+	handler := &TCPHandler{log: mock.Log, conf: mock.Conf}
+
+	tests := []struct {
+		name      string
+		client    func(conn net.Conn)
+		cancelled bool
+		err       bool
+	}{
+		{
+			name: "cancel",
+			client: func(conn net.Conn) {
+				binary.NewWriter(conn).Uvarint(protocol.ClientCancel)
+			},
+			cancelled: true,
+		},
+		{
+			name: "disconnect",
+			client: func(conn net.Conn) {
+				conn.Close()
+			},
+			err: true,
+		},
+		{
+			name: "ping-then-cancel",
+			client: func(conn net.Conn) {
+				binary.NewWriter(conn).Uvarint(protocol.ClientPing)
+				typ, _ := binary.NewReader(conn).Uvarint()
+				assert.Equal(t, uint64(protocol.ServerPong), typ)
+				binary.NewWriter(conn).Uvarint(protocol.ClientCancel)
+			},
+			cancelled: true,
+		},
+		{
+			name: "unexpected",
+			client: func(conn net.Conn) {
+				binary.NewWriter(conn).Uvarint(protocol.ClientQuery)
+			},
+			err: true,
+		},
+	}
+
+	for _, test := range tests {
+		server, client := net.Pipe()
+		session := NewTCPSession(server)
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan struct{})
+
+		var mu sync.Mutex
+		watcher := handler.watchCancel(session, &mu, cancel, done, 10*time.Millisecond)
+		test.client(client)
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(5 * time.Second):
+			assert.Fail(t, "not cancelled", test.name)
+		}
+		close(done)
+		watcher.wait()
+		assert.Equal(t, test.cancelled, watcher.cancelled, test.name)
+		assert.Equal(t, test.err, watcher.err != nil, test.name)
+		client.Close()
+		server.Close()
+	}
+
+	// Stops polling when the query is done.
+	server, client := net.Pipe()
+	defer client.Close()
+	defer server.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := make(chan struct{})
+	var mu sync.Mutex
+	watcher := handler.watchCancel(NewTCPSession(server), &mu, cancel, done, 10*time.Millisecond)
+	time.Sleep(30 * time.Millisecond)
+	close(done)
+	watcher.wait()
+	assert.Nil(t, ctx.Err())
+	assert.False(t, watcher.cancelled)
+	assert.Nil(t, watcher.err)
+}
@@ -5,17 +5,19 @@
 package tcp
 
 import (
+	"base/binary"
+	"base/errors"
 	"base/humanize"
+	"datablocks"
 	"datastreams"
+	"executors"
+	"servers/protocol"
 )
 
 func (s *TCPHandler) processData(session *TCPSession) error {
 	log := s.log
 
-	stream := datastreams.NewNativeBlockInputStream(session.reader)
-	defer stream.Close()
-
-	block, err := stream.Read()
+	_, block, err := s.readData(session)
 	if err != nil {
 		return err
 	}
@@ -29,3 +31,52 @@ func (s *TCPHandler) processData(session *TCPSession) error {
 	}
 	return nil
 }
+
+// readData reads the Data packet body, the temporary table name and the block.
+func (s *TCPHandler) readData(session *TCPSession) (string, *datablocks.DataBlock, error) {
+	// Temporary table, outside of the compressed blocks.
+	name, err := session.reader.String()
+	if err != nil {
+		return "", nil, errors.Wrap(err)
+	}
+
+	reader := session.reader
+	if session.compression {
+		reader = binary.NewReader(binary.NewCompressedReader(session.reader))
+	}
+	stream := datastreams.NewNativeBlockInputStream(reader)
+	defer stream.Close()
+
+	block, err := stream.Read()
+	if err != nil {
+		return "", nil, err
+	}
+	return name, block, nil
+}
+
+// receiveExternalTables reads the Data packets following the query until the empty block,
+// the named blocks are written into the external tables.
+func (s *TCPHandler) receiveExternalTables(session *TCPSession, external *executors.ExternalTables) error {
+	log := s.log
+
+	for {
+		packetType, err := session.reader.Uvarint()
+		if err != nil {
+			return err
+		}
+		if packetType != protocol.ClientData {
+			return errors.ErrorWithCode(errors.UNEXPECTED_PACKET_FROM_CLIENT, "Unexpected packet %v from client, expected Data of the external tables", protocol.ClientPacketType(packetType))
+		}
+		name, block, err := s.readData(session)
+		if err != nil {
+			return err
+		}
+		if block == nil || (name == "" && block.NumRows() == 0) {
+			return nil
+		}
+		log.Debug("Receive external table:%v, rows:%v", name, block.NumRows())
+		if err := external.Write(name, block.Columns(), datastreams.NewOneBlockInputStream(block)); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package tcp
+
+import (
+	"net"
+	"testing"
+
+	"base/binary"
+	"columns"
+	"datablocks"
+	"datastreams"
+	"datatypes"
+	"datavalues"
+	"executors"
+	"mocks"
+	"servers/protocol"
+	"storages"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTCPReceiveExternalTables(t *testing.T) {
+	mock, cleanup := mocks.NewMock()
+	defer cleanup()
+	handler := &TCPHandler{log: mock.Log, conf: mock.Conf}
+
+	writeData := func(conn net.Conn, name string, block *datablocks.DataBlock) {
+		writer := binary.NewWriter(conn)
+		assert.Nil(t, writer.Uvarint(protocol.ClientData))
+		assert.Nil(t, writer.String(name))
+		assert.Nil(t, datastreams.NewNativeBlockOutputStream(block, conn).Write(block))
+	}
+	cols := []*columns.Column{columns.NewColumn("id", datatypes.NewUInt32DataType())}
+	block := datablocks.NewDataBlock(cols)
+	assert.Nil(t, block.WriteRow([]datavalues.IDataValue{datavalues.MakeInt(1)}))
+	assert.Nil(t, block.WriteRow([]datavalues.IDataValue{datavalues.MakeInt(2)}))
+
+	tests := []struct {
+		name   string
+		client func(conn net.Conn)
+		rows   int
+		err    string
+	}{
+		{
+			name:   "none",
+			client: func(conn net.Conn) { writeData(conn, "", datablocks.NewDataBlock(nil)) },
+		},
+		{
+			name: "two-blocks",
+			client: func(conn net.Conn) {
+				writeData(conn, "ids", block)
+				writeData(conn, "ids", block)
+				writeData(conn, "", datablocks.NewDataBlock(nil))
+			},
+			rows: 4,
+		},
+		{
+			name:   "unexpected",
+			client: func(conn net.Conn) { binary.NewWriter(conn).Uvarint(protocol.ClientPing) },
+			err:    "Unexpected packet ClientPing from client, expected Data of the external tables (errno 101)",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server, client := net.Pipe()
+			defer client.Close()
+			session := NewTCPSession(server)
+			defer session.Close()
+			go test.client(client)
+
+			external := executors.NewExternalTables(mock.Log, mock.Conf, session.session)
+			err := handler.receiveExternalTables(session, external)
+			if test.err != "" {
+				assert.Equal(t, test.err, err.Error())
+				return
+			}
+			assert.Nil(t, err)
+
+			table, ok := session.session.GetTemporaryTable("ids")
+			assert.Equal(t, test.rows > 0, ok)
+			if ok {
+				assert.Equal(t, int64(test.rows), table.(storages.IRowsCountStorage).TotalRows())
+			}
+			external.Close()
+			_, ok = session.session.GetTemporaryTable("ids")
+			assert.False(t, ok)
+		})
+	}
+}
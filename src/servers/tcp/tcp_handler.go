@@ -5,21 +5,27 @@
 package tcp
 
 import (
+	"crypto/tls"
 	"fmt"
 	"io"
 	"net"
 
 	"base/errors"
+	"base/sync2"
 	"base/xlog"
 	"config"
 	"servers/protocol"
 )
 
 type TCPHandler struct {
-	log      *xlog.Log
-	conf     *config.Config
-	state    QueryState
-	listener net.Listener
+	log       *xlog.Log
+	conf      *config.Config
+	state     QueryState
+	listener  net.Listener
+	tlsConfig *tls.Config
+	// The listener of the secure native protocol, and Stop has closed them.
+	secureListener net.Listener
+	closing        sync2.AtomicBool
 }
 
 func NewTCPHandler(log *xlog.Log, conf *config.Config) *TCPHandler {
@@ -34,27 +40,63 @@ func NewTCPHandler(log *xlog.Log, conf *config.Config) *TCPHandler {
 	}
 }
 
+// SetTLSConfig enables the secure native protocol on the tcp_port_secure, before Start.
+func (s *TCPHandler) SetTLSConfig(tlsConf *tls.Config) {
+	if s.conf.Server.TCPPortSecure > 0 {
+		s.tlsConfig = tlsConf
+	}
+}
+
 func (s *TCPHandler) Start() {
 	log := s.log
 
-	go func(svr *TCPHandler) {
-		for {
-			conn, err := svr.listener.Accept()
-			if err != nil {
-				log.Panic("Couldn't accept: %+v", err)
+	go s.serve(s.listener)
+	if s.tlsConfig != nil {
+		listener, err := tls.Listen("tcp", fmt.Sprintf("%v:%v", s.conf.Server.ListenHost, s.conf.Server.TCPPortSecure), s.tlsConfig)
+		if err != nil {
+			log.Panic("Couldn't listen: %+v", err)
+		}
+		s.secureListener = listener
+		go s.serve(listener)
+	}
+}
+
+func (s *TCPHandler) serve(listener net.Listener) {
+	log := s.log
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if s.closing.Get() {
+				return
 			}
-			go svr.handle(conn)
+			log.Panic("Couldn't accept: %+v", err)
 		}
-	}(s)
+		go s.handle(conn)
+	}
 }
 
+// Stop closes the listeners, the connections open go on until the process exits.
 func (s *TCPHandler) Stop() {
+	s.closing.Set(true)
+	s.listener.Close()
+	if s.secureListener != nil {
+		s.secureListener.Close()
+	}
 }
 
 func (s *TCPHandler) Address() string {
 	return fmt.Sprintf(":%v", s.conf.Server.TCPPort)
 }
 
+// SecureAddress returns the secure native protocol address, empty if not enabled.
+func (s *TCPHandler) SecureAddress() string {
+	if s.tlsConfig == nil {
+		return ""
+	}
+	return fmt.Sprintf(":%v", s.conf.Server.TCPPortSecure)
+}
+
 func (s *TCPHandler) handle(conn net.Conn) {
 	log := s.log
 
@@ -109,6 +151,10 @@ func (s *TCPHandler) handlePacket(session *TCPSession) error {
 			if err := s.processData(session); err != nil {
 				return err
 			}
+		case protocol.ClientCancel:
+			// Nothing is streaming here, only the pending insert is dropped.
+			log.Debug("Receive client cancel")
+			s.state.Reset()
 		case protocol.ClientHello:
 			if err := s.processUnexceptedHello(session); err != nil {
 				return err
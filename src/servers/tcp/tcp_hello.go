@@ -9,6 +9,7 @@ import (
 
 	"base/errors"
 	"servers/protocol"
+	"users"
 )
 
 func (s *TCPHandler) processHello(session *TCPSession) error {
@@ -41,8 +42,26 @@ func (s *TCPHandler) processHello(session *TCPSession) error {
 	if session.hello, err = protocol.ReadHelloRequest(reader); err != nil {
 		return err
 	}
+
+	// Authentication.
+	user, err := users.Authenticate(conf, session.hello.User, session.hello.Password)
+	if err != nil {
+		log.Warning("Authentication from %s: %v", session.conn.RemoteAddr(), err)
+		if xerr := session.sendException(err, conf.Server.CalculateTextStackTrace); xerr != nil {
+			return xerr
+		}
+		return err
+	}
+	session.session.SetUser(user)
+
 	// Set the session database.
 	if session.hello.Database != "" {
+		if err := user.CheckDatabase(session.hello.Database); err != nil {
+			if xerr := session.sendException(err, conf.Server.CalculateTextStackTrace); xerr != nil {
+				return xerr
+			}
+			return err
+		}
 		session.session.SetDatabase(session.hello.Database)
 	}
 
@@ -9,6 +9,8 @@ import (
 	"sync"
 	"time"
 
+	"config"
+
 	"datablocks"
 	"datastreams"
 	"executors"
@@ -17,6 +19,8 @@ import (
 	"processors"
 	"servers/protocol"
 	"sessions"
+
+	"base/xlog"
 )
 
 func (s *TCPHandler) processQuery(session *TCPSession) error {
@@ -27,15 +31,45 @@ func (s *TCPHandler) processQuery(session *TCPSession) error {
 	reader := session.reader
 	xsession := session.session
 
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-
 	// Request.
 	query, err := protocol.ReadQueryRequest(reader, session.hello.ClientRevision)
 	if err != nil {
 		return err
 	}
-	log.Debug("TCPHandler-Query->Enter:%+v", query.Query)
+	log.Debug("TCPHandler-Query->Enter:%+v, user:%s", query.Query, xsession.GetUser().Name)
+	session.compression = (query.Compression == protocol.CompressionEnable)
+
+	// The settings of the query packet win over the SET statements of the session.
+	settings := xsession.Settings()
+	for _, setting := range query.Settings {
+		settings[setting.Name] = setting.Value
+	}
+	conf, unknown, err := conf.WithSettings(settings)
+	if err != nil {
+		return session.sendException(err, s.conf.Server.CalculateTextStackTrace)
+	}
+	for _, name := range unknown {
+		log.Debug("TCPHandler-Query->Ignore the setting:%s", name)
+	}
+
+	ctx, limits := executors.NewExecutionLimits(context.Background(), conf)
+	defer limits.Cancel()
+	if err := executors.ShutdownError(); err != nil {
+		return session.sendException(err, conf.Server.CalculateTextStackTrace)
+	}
+	id, err := limits.StartQuery(query.QueryID, query.Query, xsession)
+	if err != nil {
+		return session.sendException(err, conf.Server.CalculateTextStackTrace)
+	}
+	log = log.With("query_id", id)
+
+	// External tables, visible to the query only.
+	external := executors.NewExternalTables(log, conf, xsession)
+	defer external.Close()
+	if err := s.receiveExternalTables(session, external); err != nil {
+		log.Error("%+v", err)
+		return session.sendException(err, conf.Server.CalculateTextStackTrace)
+	}
 
 	// Logical plans.
 	plan, err := planners.PlanFactory(query.Query)
@@ -43,12 +77,14 @@ func (s *TCPHandler) processQuery(session *TCPSession) error {
 		log.Error("%+v", err)
 		return session.sendException(err, conf.Server.CalculateTextStackTrace)
 	}
-	plan = optimizers.Optimize(plan, optimizers.DefaultOptimizers)
+	plan = optimizers.Optimize(conf, plan, optimizers.DefaultOptimizers)
 
 	// Executors.
+	session.resetProgress()
 	ectx := executors.NewExecutorContext(ctx, log, conf, xsession)
 	ectx.SetProgressCallback(func(pv *sessions.ProgressValues) {
 		xsession.UpdateProgress(pv)
+		limits.CheckSpeed(pv)
 	})
 	executor, err := executors.ExecutorFactory(ectx, plan)
 	if err != nil {
@@ -63,7 +99,7 @@ func (s *TCPHandler) processQuery(session *TCPSession) error {
 	}
 
 	if result.In != nil {
-		if err := s.processOrdinaryQuery(session, result.In); err != nil {
+		if err := s.processOrdinaryQuery(session, log, conf, result.In, ectx.ProfileValues(), limits); err != nil {
 			return err
 		}
 	} else if result.Out != nil {
@@ -73,40 +109,73 @@ func (s *TCPHandler) processQuery(session *TCPSession) error {
 		s.state.SetExecutorResult(result)
 	}
 	log.Debug("%s", executor.String())
+	log.Debug("Query memory peak:%d bytes", limits.MemoryTracker().Peak())
 	return session.sendEndOfStream()
 }
 
-func (s *TCPHandler) processOrdinaryQuery(session *TCPSession, sink processors.IProcessor) error {
+// processOrdinaryQuery streams the result, the log has the query_id of the query.
+func (s *TCPHandler) processOrdinaryQuery(session *TCPSession, log *xlog.Log, conf *config.Config, sink processors.IProcessor, profile *sessions.ProfileValues, limits *executors.ExecutionLimits) error {
 	var mu sync.Mutex
-	conf := s.conf
-	log := s.log
 	done := make(chan struct{})
-	defer close(done)
+	delay := s.interactiveDelay()
 
 	log.Debug("TCPHandler->OrdinaryQuery->Enter")
 	if sink != nil {
+		// The Cancel packet and the disconnection while streaming.
+		var once sync.Once
+		watcher := s.watchCancel(session, &mu, limits.Cancel, done, delay)
+		stop := func() {
+			once.Do(func() { close(done) })
+			watcher.wait()
+		}
+		defer stop()
+
+		// Progress packets every interactive_delay while the pipeline runs.
 		go func() {
+			t := time.NewTicker(delay)
+			defer t.Stop()
 			for {
 				select {
 				case <-done:
 					return
-				default:
+				case <-t.C:
 					mu.Lock()
 					if err := session.sendProgress(); err != nil {
 						mu.Unlock()
 						return
 					}
 					mu.Unlock()
-					time.Sleep(time.Millisecond * 100)
 				}
 			}
 		}()
 
+		// After the cancellation, the rest is drained until the pipeline stops.
+		var stopped bool
 		for x := range sink.In().Recv() {
+			if stopped {
+				continue
+			}
+			select {
+			case <-watcher.exit:
+				stopped = watcher.cancelled || watcher.err != nil
+			default:
+			}
+			if stopped {
+				continue
+			}
+
 			switch x := x.(type) {
 			case error:
+				x = limits.Error(x, session.session.GetProgress())
+				if x == context.Canceled {
+					stopped = true
+					continue
+				}
 				log.Error("%+v", x)
-				return session.sendException(x, conf.Server.CalculateTextStackTrace)
+				mu.Lock()
+				err := session.sendException(x, conf.Server.CalculateTextStackTrace)
+				mu.Unlock()
+				return err
 			case *datablocks.DataBlock:
 				chunks, err := x.Split(conf.Server.DefaultBlockSize)
 				if err != nil {
@@ -120,14 +189,47 @@ func (s *TCPHandler) processOrdinaryQuery(session *TCPSession, sink processors.I
 						return err
 					}
 					mu.Unlock()
+					profile.AddBlock(block.NumRows(), block.TotalBytes())
 				}
+				// The chunks are sent, their buffers and the block's go back to the pool.
+				for _, block := range chunks {
+					block.Release()
+				}
+				x.Release()
 			}
 		}
+
+		stop()
+		// The client is gone or misbehaved, nothing more to send.
+		if watcher.err != nil {
+			return watcher.err
+		}
+		if watcher.cancelled {
+			log.Info("TCPHandler->OrdinaryQuery->Cancelled by the client")
+		}
+
+		// The profile info and the rest of the progress.
+		mu.Lock()
+		defer mu.Unlock()
+		if err := session.sendProfileInfo(profile); err != nil {
+			return err
+		}
+		if err := session.sendProgress(); err != nil {
+			return err
+		}
 	}
 	log.Debug("TCPHandler->OrdinaryQuery->Return")
 	return nil
 }
 
+func (s *TCPHandler) interactiveDelay() time.Duration {
+	delay := s.conf.Server.InteractiveDelay
+	if delay <= 0 {
+		delay = config.DefaultServerConfig().InteractiveDelay
+	}
+	return time.Duration(delay) * time.Microsecond
+}
+
 func (s *TCPHandler) processInsertQuery(session *TCPSession, output datastreams.IDataBlockOutputStream) error {
 	return session.sendData(output.SampleBlock())
 }
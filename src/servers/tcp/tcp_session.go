@@ -25,14 +25,19 @@ type TCPSession struct {
 	reader  *binary.Reader
 	writer  *binary.Writer
 	session *sessions.Session
+	// The progress sent of the current query.
+	progressSent *sessions.ProgressValues
+	// The data blocks of the current query are compressed.
+	compression bool
 }
 
 func NewTCPSession(conn net.Conn) *TCPSession {
 	return &TCPSession{
-		conn:    conn,
-		reader:  binary.NewReader(conn),
-		writer:  binary.NewWriter(conn),
-		session: sessions.NewSession(),
+		conn:         conn,
+		reader:       binary.NewReader(conn),
+		writer:       binary.NewWriter(conn),
+		session:      sessions.NewSession(),
+		progressSent: &sessions.ProgressValues{},
 	}
 }
 
@@ -53,28 +58,49 @@ func (session *TCPSession) sendException(x error, withStack bool) error {
 func (session *TCPSession) sendProgress() error {
 	writer := session.writer
 
-	if err := protocol.WriteProgressResponse(writer, session.session.GetProgress(), session.hello.ClientRevision); err != nil {
+	progress := session.session.GetProgress()
+	delta := progress.Delta(session.progressSent)
+	if delta.IsEmpty() {
+		return nil
+	}
+	if err := protocol.WriteProgressResponse(writer, delta, session.hello.ClientRevision); err != nil {
 		return err
 	}
-	return nil
+	session.progressSent = progress
+	return session.flush()
+}
+
+// resetProgress starts the progress of a new query.
+func (session *TCPSession) resetProgress() {
+	session.session.UpdateProgress(&sessions.ProgressValues{})
+	session.progressSent = &sessions.ProgressValues{}
+}
+
+func (session *TCPSession) sendProfileInfo(pv *sessions.ProfileValues) error {
+	return protocol.WriteProfileInfoResponse(session.writer, pv)
 }
 
 func (session *TCPSession) sendData(block *datablocks.DataBlock) error {
 	defer expvar.Get(metric_tcp_datablock_send_sec).(metric.Metric).Record(time.Now())
 
 	writer := session.writer
-	output := datastreams.NewNativeBlockOutputStream(block.Clone(), writer)
-
 	if err := writer.Uvarint(uint64(protocol.ServerData)); err != nil {
 		return errors.Wrapf(err, "Couldn't write query header")
 	}
 	if err := writer.String(""); err != nil {
 		return err
 	}
+
+	if !session.compression {
+		output := datastreams.NewNativeBlockOutputStream(block.Clone(), writer)
+		return output.Write(block)
+	}
+	compressed := binary.NewCompressedWriter(writer)
+	output := datastreams.NewNativeBlockOutputStream(block.Clone(), compressed)
 	if err := output.Write(block); err != nil {
 		return err
 	}
-	return nil
+	return compressed.Flush()
 }
 
 func (session *TCPSession) sendEndOfStream() error {
@@ -0,0 +1,105 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package tlsconfig
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"sync"
+
+	"base/errors"
+	"config"
+)
+
+// Enabled checks if the certificate is configured.
+func Enabled(conf *config.Server) bool {
+	return conf.TLSCertFile != "" && conf.TLSKeyFile != ""
+}
+
+// Certificates holds the server key pair, the handshakes get the latest loaded one.
+type Certificates struct {
+	mu       sync.RWMutex
+	certFile string
+	keyFile  string
+	cert     *tls.Certificate
+}
+
+func NewCertificates(certFile string, keyFile string) (*Certificates, error) {
+	c := &Certificates{
+		certFile: certFile,
+		keyFile:  keyFile,
+	}
+	if err := c.Reload(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Reload reads the files again, the current pair is kept if they are invalid.
+func (c *Certificates) Reload() error {
+	cert, err := tls.LoadX509KeyPair(c.certFile, c.keyFile)
+	if err != nil {
+		return errors.Wrapf(err, "Couldn't load the TLS certificate:%s, key:%s", c.certFile, c.keyFile)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cert = &cert
+	return nil
+}
+
+func (c *Certificates) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.cert, nil
+}
+
+// New creates the server TLS config with the min version and the client verification.
+func New(conf *config.Server, certs *Certificates) (*tls.Config, error) {
+	version, err := ParseVersion(conf.TLSMinVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConf := &tls.Config{
+		MinVersion:     version,
+		GetCertificate: certs.GetCertificate,
+	}
+	if conf.TLSCAFile != "" {
+		pem, err := ioutil.ReadFile(conf.TLSCAFile)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Couldn't read the TLS CA:%s", conf.TLSCAFile)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, errors.Errorf("No certificate found in the TLS CA:%s", conf.TLSCAFile)
+		}
+		tlsConf.ClientCAs = pool
+		tlsConf.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+	if conf.TLSRequireClientCert {
+		if tlsConf.ClientCAs == nil {
+			return nil, errors.New("tls_require_client_cert needs the tls_ca_file")
+		}
+		tlsConf.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return tlsConf, nil
+}
+
+// ParseVersion parses the TLS version, the empty is 1.2.
+func ParseVersion(version string) (uint16, error) {
+	switch version {
+	case "1.0":
+		return tls.VersionTLS10, nil
+	case "1.1":
+		return tls.VersionTLS11, nil
+	case "", "1.2":
+		return tls.VersionTLS12, nil
+	case "1.3":
+		return tls.VersionTLS13, nil
+	}
+	return 0, errors.Errorf("Unknown TLS version:%s", version)
+}
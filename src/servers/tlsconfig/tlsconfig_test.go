@@ -0,0 +1,173 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package tlsconfig
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"config"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// writeCert writes a self-signed certificate of the common name, returns the cert and key files.
+func writeCert(t *testing.T, dir string, name string) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: name},
+		DNSNames:              []string{"localhost"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.Nil(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	assert.Nil(t, err)
+
+	certFile := filepath.Join(dir, name+".crt")
+	keyFile := filepath.Join(dir, name+".key")
+	assert.Nil(t, ioutil.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	assert.Nil(t, ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600))
+	return certFile, keyFile
+}
+
+// handshake echoes one byte over the TLS connection.
+func handshake(t *testing.T, serverConf *tls.Config, clientConf *tls.Config) (*tls.ConnectionState, error) {
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", serverConf)
+	assert.Nil(t, err)
+	defer listener.Close()
+
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		b := make([]byte, 1)
+		if _, err := conn.Read(b); err == nil {
+			_, _ = conn.Write(b)
+		}
+	}()
+
+	conn, err := tls.Dial("tcp", listener.Addr().String(), clientConf)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(5 * time.Second))
+	// The client certificate is only checked by the server after the client handshake ends.
+	if _, err := conn.Write([]byte("x")); err != nil {
+		return nil, err
+	}
+	if _, err := conn.Read(make([]byte, 1)); err != nil {
+		return nil, err
+	}
+	state := conn.ConnectionState()
+	return &state, nil
+}
+
+func TestTLSConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "vectorsql-tls")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	certFile, keyFile := writeCert(t, dir, "server")
+	clientCert, clientKey := writeCert(t, dir, "client")
+	conf := &config.Server{TLSCertFile: certFile, TLSKeyFile: keyFile}
+	assert.True(t, Enabled(conf))
+
+	certs, err := NewCertificates(certFile, keyFile)
+	assert.Nil(t, err)
+	serverConf, err := New(conf, certs)
+	assert.Nil(t, err)
+	assert.Equal(t, uint16(tls.VersionTLS12), serverConf.MinVersion)
+
+	roots := x509.NewCertPool()
+	pem, _ := ioutil.ReadFile(certFile)
+	roots.AppendCertsFromPEM(pem)
+	state, err := handshake(t, serverConf, &tls.Config{RootCAs: roots, ServerName: "localhost"})
+	assert.Nil(t, err)
+	assert.Equal(t, "server", state.PeerCertificates[0].Subject.CommonName)
+
+	// Reload with a new pair in the same files.
+	newCert, newKey := writeCert(t, dir, "reloaded")
+	assert.Nil(t, os.Rename(newCert, certFile))
+	assert.Nil(t, os.Rename(newKey, keyFile))
+	assert.Nil(t, certs.Reload())
+	state, err = handshake(t, serverConf, &tls.Config{InsecureSkipVerify: true})
+	assert.Nil(t, err)
+	assert.Equal(t, "reloaded", state.PeerCertificates[0].Subject.CommonName)
+
+	// Broken files keep the current pair.
+	assert.Nil(t, ioutil.WriteFile(certFile, []byte("broken"), 0600))
+	assert.NotNil(t, certs.Reload())
+	state, err = handshake(t, serverConf, &tls.Config{InsecureSkipVerify: true})
+	assert.Nil(t, err)
+	assert.Equal(t, "reloaded", state.PeerCertificates[0].Subject.CommonName)
+
+	// Client certificates.
+	conf.TLSCAFile = clientCert
+	conf.TLSRequireClientCert = true
+	serverConf, err = New(conf, certs)
+	assert.Nil(t, err)
+	_, err = handshake(t, serverConf, &tls.Config{InsecureSkipVerify: true})
+	assert.NotNil(t, err)
+	pair, err := tls.LoadX509KeyPair(clientCert, clientKey)
+	assert.Nil(t, err)
+	_, err = handshake(t, serverConf, &tls.Config{InsecureSkipVerify: true, Certificates: []tls.Certificate{pair}})
+	assert.Nil(t, err)
+}
+
+func TestTLSConfigError(t *testing.T) {
+	certs := &Certificates{}
+	tests := []struct {
+		name string
+		conf *config.Server
+		err  string
+	}{
+		{
+			name: "version",
+			conf: &config.Server{TLSMinVersion: "2.0"},
+			err:  "Unknown TLS version:2.0",
+		},
+		{
+			name: "require-without-ca",
+			conf: &config.Server{TLSRequireClientCert: true},
+			err:  "tls_require_client_cert needs the tls_ca_file",
+		},
+		{
+			name: "ca-not-found",
+			conf: &config.Server{TLSCAFile: "/not/found/ca.crt"},
+			err:  "Couldn't read the TLS CA:/not/found/ca.crt",
+		},
+	}
+
+	for _, test := range tests {
+		_, err := New(test.conf, certs)
+		assert.NotNil(t, err, test.name)
+		assert.Contains(t, err.Error(), test.err, test.name)
+	}
+
+	_, err := NewCertificates("/not/found/server.crt", "/not/found/server.key")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,53 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package sessions
+
+import (
+	"base/sync2"
+)
+
+// Event is a counter of the server since it started, shown by system.events.
+type Event struct {
+	name        string
+	description string
+	value       sync2.AtomicInt64
+}
+
+var events []*Event
+
+var (
+	QueryCacheHits   = newEvent("QueryCacheHits", "Number of times the result of a query was found in the query cache.")
+	QueryCacheMisses = newEvent("QueryCacheMisses", "Number of times the result of a query with use_query_cache was not found in the query cache.")
+
+	ExternalSortWritePart = newEvent("ExternalSortWritePart", "Number of times a sorted run was written to a temporary file for the external sort.")
+	ExternalSortMerge     = newEvent("ExternalSortMerge", "Number of times the sorted runs of the external sort were merged.")
+)
+
+func newEvent(name string, description string) *Event {
+	event := &Event{name: name, description: description}
+	events = append(events, event)
+	return event
+}
+
+// Events returns all the events in the order they are declared.
+func Events() []*Event {
+	return events
+}
+
+func (e *Event) Add(n int64) {
+	e.value.Add(n)
+}
+
+func (e *Event) Name() string {
+	return e.name
+}
+
+func (e *Event) Description() string {
+	return e.description
+}
+
+func (e *Event) Value() int64 {
+	return e.value.Get()
+}
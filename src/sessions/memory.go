@@ -0,0 +1,141 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package sessions
+
+import (
+	"context"
+	"sync"
+
+	"base/errors"
+	"base/humanize"
+)
+
+// MemoryTracker counts the bytes a query holds, such as the blocks of ORDER BY and the groups of GROUP BY.
+// The charges go to the parent too, the query tracker is a child of the server one,
+// and the charge beyond the limit of either fails with MEMORY_LIMIT_EXCEEDED.
+// The nil tracker accepts all the charges.
+type MemoryTracker struct {
+	mu     sync.Mutex
+	name   string
+	limit  int64
+	used   int64
+	peak   int64
+	parent *MemoryTracker
+	// The charges are not passed to the parent any more, they were given back by Detach.
+	detached bool
+}
+
+var serverMemoryTracker = NewMemoryTracker("server", 0, nil)
+
+// ServerMemoryTracker returns the tracker of all the queries, limited by max_server_memory_usage.
+func ServerMemoryTracker() *MemoryTracker {
+	return serverMemoryTracker
+}
+
+// NewMemoryTracker creates the tracker, the limit 0 is unlimited.
+func NewMemoryTracker(name string, limit int64, parent *MemoryTracker) *MemoryTracker {
+	return &MemoryTracker{
+		name:   name,
+		limit:  limit,
+		parent: parent,
+	}
+}
+
+func (t *MemoryTracker) SetLimit(limit int64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.limit = limit
+}
+
+// Alloc charges the bytes, nothing is charged if it fails.
+func (t *MemoryTracker) Alloc(bytes int64) error {
+	if t == nil || bytes <= 0 {
+		return nil
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	used := t.used + bytes
+	if t.limit > 0 && used > t.limit {
+		return errors.ErrorWithCode(errors.MEMORY_LIMIT_EXCEEDED, "Memory limit (for %s) exceeded: would use %s (attempt to allocate chunk of %d bytes), peak: %s, maximum: %s",
+			t.name, humanize.IBytes(uint64(used)), bytes, humanize.IBytes(uint64(t.peak)), humanize.IBytes(uint64(t.limit)))
+	}
+	if t.parent != nil && !t.detached {
+		if err := t.parent.Alloc(bytes); err != nil {
+			return err
+		}
+	}
+	t.used = used
+	if used > t.peak {
+		t.peak = used
+	}
+	return nil
+}
+
+// Free credits the bytes charged by Alloc.
+func (t *MemoryTracker) Free(bytes int64) {
+	if t == nil || bytes <= 0 {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.used -= bytes
+	if t.parent != nil && !t.detached {
+		t.parent.Free(bytes)
+	}
+}
+
+// Detach gives the bytes still charged back to the parent when the query ends,
+// the transforms still running after the cancellation don't count for the server any more.
+func (t *MemoryTracker) Detach() {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if !t.detached && t.parent != nil {
+		t.parent.Free(t.used)
+	}
+	t.detached = true
+}
+
+// Used returns the bytes charged now.
+func (t *MemoryTracker) Used() int64 {
+	if t == nil {
+		return 0
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.used
+}
+
+// Peak returns the most bytes charged at once.
+func (t *MemoryTracker) Peak() int64 {
+	if t == nil {
+		return 0
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.peak
+}
+
+type memoryTrackerKey struct{}
+
+// WithMemoryTracker returns the query context carrying the tracker the transforms charge.
+func WithMemoryTracker(ctx context.Context, t *MemoryTracker) context.Context {
+	return context.WithValue(ctx, memoryTrackerKey{}, t)
+}
+
+// MemoryTrackerFromContext returns the tracker of the query context, nil if there is none.
+func MemoryTrackerFromContext(ctx context.Context) *MemoryTracker {
+	if ctx != nil {
+		if t, ok := ctx.Value(memoryTrackerKey{}).(*MemoryTracker); ok {
+			return t
+		}
+	}
+	return nil
+}
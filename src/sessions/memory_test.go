@@ -0,0 +1,66 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package sessions
+
+import (
+	"context"
+	"testing"
+
+	"base/errors"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryTracker(t *testing.T) {
+	server := NewMemoryTracker("server", 1000, nil)
+	query := NewMemoryTracker("query", 600, server)
+	other := NewMemoryTracker("query", 0, server)
+
+	assert.Nil(t, query.Alloc(500))
+	assert.Equal(t, int64(500), server.Used())
+
+	// Beyond the query limit, nothing is charged.
+	err := query.Alloc(200)
+	assert.Equal(t, errors.MEMORY_LIMIT_EXCEEDED, errors.Code(err))
+	assert.Equal(t, "Memory limit (for query) exceeded: would use 700 B (attempt to allocate chunk of 200 bytes), peak: 500 B, maximum: 600 B (errno 241)", err.Error())
+	assert.Equal(t, int64(500), query.Used())
+	assert.Equal(t, int64(500), server.Used())
+
+	// Beyond the server limit, by the queries together.
+	err = other.Alloc(600)
+	assert.Equal(t, "Memory limit (for server) exceeded: would use 1.1 KiB (attempt to allocate chunk of 600 bytes), peak: 500 B, maximum: 1000 B (errno 241)", err.Error())
+	assert.Equal(t, int64(0), other.Used())
+	assert.Nil(t, other.Alloc(100))
+
+	query.Free(400)
+	assert.Equal(t, int64(100), query.Used())
+	assert.Equal(t, int64(500), query.Peak())
+	assert.Equal(t, int64(200), server.Used())
+	assert.Equal(t, int64(600), server.Peak())
+
+	// The query ends with its memory still charged, the server gets it back once.
+	query.Detach()
+	query.Detach()
+	assert.Equal(t, int64(100), server.Used())
+	query.Free(100)
+	assert.Nil(t, query.Alloc(50))
+	assert.Equal(t, int64(100), server.Used())
+
+	// No tracker, no limit.
+	var none *MemoryTracker
+	assert.Nil(t, none.Alloc(1<<40))
+	none.Free(1)
+	none.Detach()
+	assert.Equal(t, int64(0), none.Peak())
+}
+
+func TestMemoryTrackerContext(t *testing.T) {
+	assert.Nil(t, MemoryTrackerFromContext(context.Background()))
+
+	tracker := NewMemoryTracker("query", 0, nil)
+	ctx, cancel := context.WithCancel(WithMemoryTracker(context.Background(), tracker))
+	defer cancel()
+	assert.True(t, tracker == MemoryTrackerFromContext(ctx))
+}
@@ -0,0 +1,50 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package sessions
+
+import (
+	"base/sync2"
+)
+
+// Metric is a gauge of the server at the moment, shown by system.metrics.
+type Metric struct {
+	name        string
+	description string
+	value       sync2.AtomicInt64
+}
+
+var metrics []*Metric
+
+var (
+	QueryMetric       = newMetric("Query", "Number of executing queries.")
+	QueryQueuedMetric = newMetric("QueryQueued", "Number of queries waiting for max_concurrent_queries or max_concurrent_queries_for_user.")
+)
+
+func newMetric(name string, description string) *Metric {
+	metric := &Metric{name: name, description: description}
+	metrics = append(metrics, metric)
+	return metric
+}
+
+// Metrics returns all the metrics in the order they are declared.
+func Metrics() []*Metric {
+	return metrics
+}
+
+func (m *Metric) Add(n int64) {
+	m.value.Add(n)
+}
+
+func (m *Metric) Name() string {
+	return m.name
+}
+
+func (m *Metric) Description() string {
+	return m.description
+}
+
+func (m *Metric) Value() int64 {
+	return m.value.Get()
+}
@@ -0,0 +1,117 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package sessions
+
+import (
+	"crypto/rand"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"base/errors"
+)
+
+// Process is a running query, shown by system.processes until it's unregistered.
+type Process struct {
+	id      string
+	query   string
+	start   time.Time
+	session *Session
+	memory  *MemoryTracker
+}
+
+var (
+	processMu sync.Mutex
+	processes = make(map[string]*Process)
+)
+
+// NewQueryID returns a random UUID, the query_id of the query the client doesn't give one.
+func NewQueryID() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		panic(err)
+	}
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+// RegisterProcess registers the query of the id, the empty id is a NewQueryID.
+// The id of a query still running fails with QUERY_WITH_SAME_ID_IS_ALREADY_RUNNING, as ClickHouse does.
+func RegisterProcess(id string, query string, session *Session, memory *MemoryTracker) (*Process, error) {
+	if id == "" {
+		id = NewQueryID()
+	}
+
+	processMu.Lock()
+	defer processMu.Unlock()
+	if _, ok := processes[id]; ok {
+		return nil, errors.ErrorWithCode(errors.QUERY_WITH_SAME_ID_IS_ALREADY_RUNNING, "Query with id = %s is already running", id)
+	}
+	p := &Process{
+		id:      id,
+		query:   query,
+		start:   time.Now(),
+		session: session,
+		memory:  memory,
+	}
+	processes[id] = p
+	return p, nil
+}
+
+// Unregister removes the query from the running ones, its id can be used again.
+func (p *Process) Unregister() {
+	processMu.Lock()
+	defer processMu.Unlock()
+	if processes[p.id] == p {
+		delete(processes, p.id)
+	}
+}
+
+// Processes returns the running queries, the oldest first.
+func Processes() []*Process {
+	processMu.Lock()
+	res := make([]*Process, 0, len(processes))
+	for _, p := range processes {
+		res = append(res, p)
+	}
+	processMu.Unlock()
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].start.Before(res[j].start)
+	})
+	return res
+}
+
+func (p *Process) QueryID() string {
+	return p.id
+}
+
+func (p *Process) Query() string {
+	return p.query
+}
+
+// User returns the name of the user of the query.
+func (p *Process) User() string {
+	if user := p.session.GetUser(); user != nil {
+		return user.Name
+	}
+	return ""
+}
+
+func (p *Process) Elapsed() time.Duration {
+	return time.Since(p.start)
+}
+
+// Progress returns the snapshot of the progress of the query so far, read by the other sessions while it runs.
+func (p *Process) Progress() *ProgressValues {
+	return p.session.GetProgress()
+}
+
+// MemoryUsage returns the bytes the query holds.
+func (p *Process) MemoryUsage() int64 {
+	return p.memory.Used()
+}
@@ -0,0 +1,70 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package sessions
+
+import (
+	"regexp"
+	"sync"
+	"testing"
+
+	"base/errors"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProcesses(t *testing.T) {
+	session := NewSession()
+	defer session.Close()
+
+	assert.Regexp(t, regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`), NewQueryID())
+	assert.NotEqual(t, NewQueryID(), NewQueryID())
+
+	p1, err := RegisterProcess("", "select 1", session, nil)
+	assert.Nil(t, err)
+	assert.Len(t, p1.QueryID(), 36)
+
+	p2, err := RegisterProcess("q", "select 2", session, nil)
+	assert.Nil(t, err)
+	_, err = RegisterProcess("q", "select 3", session, nil)
+	assert.Equal(t, errors.QUERY_WITH_SAME_ID_IS_ALREADY_RUNNING, errors.Code(err))
+	assert.Len(t, Processes(), 2)
+	assert.Equal(t, "select 2", p2.Query())
+	assert.Equal(t, int64(0), p2.MemoryUsage())
+
+	p2.Unregister()
+	p2.Unregister()
+	p3, err := RegisterProcess("q", "select 3", session, nil)
+	assert.Nil(t, err)
+	p1.Unregister()
+	p3.Unregister()
+	assert.Empty(t, Processes())
+}
+
+func TestProcessProgressWhileRunning(t *testing.T) {
+	session := NewSession()
+	defer session.Close()
+
+	pv := &ProgressValues{}
+	session.UpdateProgress(pv)
+	p, err := RegisterProcess("", "select 1", session, nil)
+	assert.Nil(t, err)
+	defer p.Unregister()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 1000; i++ {
+			pv.ReadRows.Add(1)
+		}
+	}()
+	for i := 0; i < 100; i++ {
+		for _, process := range Processes() {
+			_ = process.Progress().ReadRows.Get()
+		}
+	}
+	wg.Wait()
+	assert.Equal(t, int64(1000), p.Progress().ReadRows.Get())
+}
@@ -16,3 +16,59 @@ type ProgressValues struct {
 	WrittenRows     sync2.AtomicInt64
 	WrittenBytes    sync2.AtomicInt64
 }
+
+// Snapshot copies the progress by the atomic loads, the lanes may still be adding to it.
+func (pv *ProgressValues) Snapshot() *ProgressValues {
+	snapshot := &ProgressValues{}
+	snapshot.Cost.Set(pv.Cost.Get())
+	snapshot.ReadRows.Set(pv.ReadRows.Get())
+	snapshot.ReadBytes.Set(pv.ReadBytes.Get())
+	snapshot.TotalRowsToRead.Set(pv.TotalRowsToRead.Get())
+	snapshot.WrittenRows.Set(pv.WrittenRows.Get())
+	snapshot.WrittenBytes.Set(pv.WrittenBytes.Get())
+	return snapshot
+}
+
+// Delta returns the progress made since the prev, the native protocol Progress packets are increments.
+func (pv *ProgressValues) Delta(prev *ProgressValues) *ProgressValues {
+	delta := &ProgressValues{}
+	delta.Cost.Set(pv.Cost.Get() - prev.Cost.Get())
+	delta.ReadRows.Set(pv.ReadRows.Get() - prev.ReadRows.Get())
+	delta.ReadBytes.Set(pv.ReadBytes.Get() - prev.ReadBytes.Get())
+	delta.TotalRowsToRead.Set(pv.TotalRowsToRead.Get() - prev.TotalRowsToRead.Get())
+	delta.WrittenRows.Set(pv.WrittenRows.Get() - prev.WrittenRows.Get())
+	delta.WrittenBytes.Set(pv.WrittenBytes.Get() - prev.WrittenBytes.Get())
+	return delta
+}
+
+// Merge adds the progress of the other, such as the one of another lane.
+func (pv *ProgressValues) Merge(other *ProgressValues) {
+	pv.Cost.Add(other.Cost.Get())
+	pv.ReadRows.Add(other.ReadRows.Get())
+	pv.ReadBytes.Add(other.ReadBytes.Get())
+	pv.TotalRowsToRead.Add(other.TotalRowsToRead.Get())
+	pv.WrittenRows.Add(other.WrittenRows.Get())
+	pv.WrittenBytes.Add(other.WrittenBytes.Get())
+}
+
+// IsEmpty checks if there is no rows or bytes progress.
+func (pv *ProgressValues) IsEmpty() bool {
+	return pv.ReadRows.Get() == 0 && pv.ReadBytes.Get() == 0 && pv.TotalRowsToRead.Get() == 0 &&
+		pv.WrittenRows.Get() == 0 && pv.WrittenBytes.Get() == 0
+}
+
+// ProfileValues are the result statistics of a query.
+type ProfileValues struct {
+	Rows            sync2.AtomicInt64
+	Blocks          sync2.AtomicInt64
+	Bytes           sync2.AtomicInt64
+	AppliedLimit    sync2.AtomicBool
+	RowsBeforeLimit sync2.AtomicInt64
+}
+
+// AddBlock counts a result block.
+func (pv *ProfileValues) AddBlock(rows int, bytes uint64) {
+	pv.Rows.Add(int64(rows))
+	pv.Blocks.Add(1)
+	pv.Bytes.Add(int64(bytes))
+}
@@ -0,0 +1,91 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package sessions
+
+import (
+	"context"
+
+	"base/errors"
+	"base/humanize"
+	"base/sync2"
+)
+
+// ReadQuota counts the rows and the bytes the data sources of a query read, for max_rows_to_read and max_bytes_to_read.
+// The sources of the query share it, the limit is on their sum.
+// The nil quota is unlimited.
+type ReadQuota struct {
+	maxRows  int64
+	maxBytes int64
+	// The read_overflow_mode is break: the sources end rather than failing the query.
+	overflowBreak bool
+	rows          sync2.AtomicInt64
+	bytes         sync2.AtomicInt64
+}
+
+// NewReadQuota creates the quota, the limit 0 is unlimited.
+func NewReadQuota(maxRows, maxBytes int64, overflowBreak bool) *ReadQuota {
+	return &ReadQuota{
+		maxRows:       maxRows,
+		maxBytes:      maxBytes,
+		overflowBreak: overflowBreak,
+	}
+}
+
+// Read counts a block read by the source, false if the source has to stop before sending it.
+// Beyond the limits the error is TOO_MANY_ROWS or TOO_MANY_BYTES, nil if the overflow mode is break.
+func (q *ReadQuota) Read(rows, bytes int64) (bool, error) {
+	if q == nil {
+		return true, nil
+	}
+
+	var err error
+	readRows, readBytes := q.rows.Add(rows), q.bytes.Add(bytes)
+	switch {
+	case q.maxRows > 0 && readRows > q.maxRows:
+		err = errors.ErrorWithCode(errors.TOO_MANY_ROWS, "Limit for rows to read exceeded: read %d rows, maximum: %d", readRows, q.maxRows)
+	case q.maxBytes > 0 && readBytes > q.maxBytes:
+		err = errors.ErrorWithCode(errors.TOO_MANY_BYTES, "Limit for bytes to read exceeded: read %s (%d bytes), maximum: %s (%d bytes)",
+			humanize.IBytes(uint64(readBytes)), readBytes, humanize.IBytes(uint64(q.maxBytes)), q.maxBytes)
+	default:
+		return true, nil
+	}
+	if q.overflowBreak {
+		return false, nil
+	}
+	return false, err
+}
+
+// ReadRows returns the rows counted so far.
+func (q *ReadQuota) ReadRows() int64 {
+	if q == nil {
+		return 0
+	}
+	return q.rows.Get()
+}
+
+// ReadBytes returns the bytes counted so far.
+func (q *ReadQuota) ReadBytes() int64 {
+	if q == nil {
+		return 0
+	}
+	return q.bytes.Get()
+}
+
+type readQuotaKey struct{}
+
+// WithReadQuota returns the query context carrying the quota the data sources count.
+func WithReadQuota(ctx context.Context, q *ReadQuota) context.Context {
+	return context.WithValue(ctx, readQuotaKey{}, q)
+}
+
+// ReadQuotaFromContext returns the quota of the query context, nil if there is none.
+func ReadQuotaFromContext(ctx context.Context) *ReadQuota {
+	if ctx != nil {
+		if q, ok := ctx.Value(readQuotaKey{}).(*ReadQuota); ok {
+			return q
+		}
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package sessions
+
+import (
+	"context"
+	"testing"
+
+	"base/errors"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadQuota(t *testing.T) {
+	tests := []struct {
+		name          string
+		maxRows       int64
+		maxBytes      int64
+		overflowBreak bool
+		reads         [][2]int64
+		expect        []bool
+		err           string
+		code          int
+	}{
+		{
+			name:    "rows",
+			maxRows: 10,
+			reads:   [][2]int64{{5, 100}, {5, 100}, {1, 100}},
+			expect:  []bool{true, true, false},
+			err:     "Limit for rows to read exceeded: read 11 rows, maximum: 10 (errno 158)",
+			code:    errors.TOO_MANY_ROWS,
+		},
+		{
+			name:     "bytes",
+			maxBytes: 2048,
+			reads:    [][2]int64{{1, 1024}, {1, 2048}},
+			expect:   []bool{true, false},
+			err:      "Limit for bytes to read exceeded: read 3.0 KiB (3072 bytes), maximum: 2.0 KiB (2048 bytes) (errno 307)",
+			code:     errors.TOO_MANY_BYTES,
+		},
+		{
+			name:          "break",
+			maxRows:       10,
+			overflowBreak: true,
+			reads:         [][2]int64{{8, 1}, {8, 1}},
+			expect:        []bool{true, false},
+		},
+		{
+			name:   "unlimited",
+			reads:  [][2]int64{{1 << 40, 1 << 40}},
+			expect: []bool{true},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			quota := NewReadQuota(test.maxRows, test.maxBytes, test.overflowBreak)
+
+			var err error
+			for i, read := range test.reads {
+				var ok bool
+				ok, err = quota.Read(read[0], read[1])
+				assert.Equal(t, test.expect[i], ok)
+			}
+			if test.err != "" {
+				assert.NotNil(t, err)
+				assert.Equal(t, test.code, errors.Code(err))
+				assert.Equal(t, test.err, err.Error())
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+
+	// No quota, no limit.
+	var none *ReadQuota
+	ok, err := none.Read(1, 1)
+	assert.True(t, ok)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), none.ReadRows())
+}
+
+func TestReadQuotaContext(t *testing.T) {
+	assert.Nil(t, ReadQuotaFromContext(context.Background()))
+
+	quota := NewReadQuota(1, 1, false)
+	ctx := WithReadQuota(context.Background(), quota)
+	assert.True(t, quota == ReadQuotaFromContext(ctx))
+}
@@ -4,22 +4,49 @@
 
 package sessions
 
-import "sync"
+import (
+	"sync"
+	"time"
+
+	"users"
+
+	"base/errors"
+)
+
+// ITemporaryTable is the storage of the CREATE TEMPORARY TABLE, closed with the session.
+type ITemporaryTable interface {
+	Close()
+}
 
 type Session struct {
 	mu       sync.Mutex
 	id       uint64
 	database string
+	user     *users.User
 	progress *ProgressValues
+	settings map[string]string
+	tables   map[string]ITemporaryTable
+
+	// The named session of the session_id, kept between the queries until it times out.
+	name     string
+	timeout  time.Duration
+	lastUsed time.Time
+	inUse    bool
 }
 
 func NewSession() *Session {
 	mgrMu.Lock()
 	defer mgrMu.Unlock()
+	return newSession()
+}
+
+func newSession() *Session {
 	session := &Session{
 		id:       sessionID,
 		database: "system",
 		progress: &ProgressValues{},
+		settings: make(map[string]string),
+		tables:   make(map[string]ITemporaryTable),
 	}
 	sessionMgr[sessionID] = session
 	sessionID++
@@ -33,18 +60,12 @@ func (s *Session) UpdateProgress(pv *ProgressValues) {
 	s.progress = pv
 }
 
+// GetProgress returns the snapshot of the progress, safe to take while the query runs.
 func (s *Session) GetProgress() *ProgressValues {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	return &ProgressValues{
-		Cost:            s.progress.Cost,
-		ReadRows:        s.progress.ReadRows,
-		ReadBytes:       s.progress.ReadBytes,
-		TotalRowsToRead: s.progress.TotalRowsToRead,
-		WrittenRows:     s.progress.WrittenRows,
-		WrittenBytes:    s.progress.WrittenBytes,
-	}
+	return s.progress.Snapshot()
 }
 
 func (s *Session) SetDatabase(db string) {
@@ -55,8 +76,87 @@ func (s *Session) GetDatabase() string {
 	return s.database
 }
 
+// SetUser sets the authenticated user, the queries are checked by its privileges.
+func (s *Session) SetUser(user *users.User) {
+	s.user = user
+}
+
+// GetUser returns the authenticated user, nil for the internal sessions without the access control.
+func (s *Session) GetUser() *users.User {
+	return s.user
+}
+
+// SetSetting keeps the setting of the SET statement for the following queries.
+func (s *Session) SetSetting(name string, value string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.settings[name] = value
+}
+
+// GetSetting returns the setting of the SET statement.
+func (s *Session) GetSetting(name string) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	v, ok := s.settings[name]
+	return v, ok
+}
+
+// Settings returns a copy of the settings of the SET statements.
+func (s *Session) Settings() map[string]string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	settings := make(map[string]string, len(s.settings))
+	for k, v := range s.settings {
+		settings[k] = v
+	}
+	return settings
+}
+
+// AddTemporaryTable adds the table visible only within the session.
+func (s *Session) AddTemporaryTable(name string, table ITemporaryTable) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.tables[name]; ok {
+		return errors.Errorf("Temporary table %s already exists", name)
+	}
+	s.tables[name] = table
+	return nil
+}
+
+func (s *Session) GetTemporaryTable(name string) (ITemporaryTable, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	table, ok := s.tables[name]
+	return table, ok
+}
+
+// DropTemporaryTable closes and removes the table, false if not exists.
+func (s *Session) DropTemporaryTable(name string) bool {
+	s.mu.Lock()
+	table, ok := s.tables[name]
+	delete(s.tables, name)
+	s.mu.Unlock()
+
+	if ok {
+		table.Close()
+	}
+	return ok
+}
+
+// Close removes the session and drops its temporary tables.
 func (s *Session) Close() {
 	mgrMu.Lock()
-	defer mgrMu.Unlock()
 	delete(sessionMgr, s.id)
+	if s.name != "" && namedSessions[s.name] == s {
+		delete(namedSessions, s.name)
+	}
+	mgrMu.Unlock()
+
+	s.mu.Lock()
+	tables := s.tables
+	s.tables = make(map[string]ITemporaryTable)
+	s.mu.Unlock()
+	for _, table := range tables {
+		table.Close()
+	}
 }
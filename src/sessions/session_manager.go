@@ -6,10 +6,90 @@ package sessions
 
 import (
 	"sync"
+	"time"
+
+	"users"
+
+	"base/errors"
+)
+
+const (
+	expireInterval = time.Second
 )
 
 var (
-	mgrMu      sync.RWMutex
-	sessionID  uint64
-	sessionMgr = make(map[uint64]*Session)
+	mgrMu         sync.RWMutex
+	sessionID     uint64
+	sessionMgr    = make(map[uint64]*Session)
+	namedSessions = make(map[string]*Session)
+	expireOnce    sync.Once
 )
+
+// AcquireNamedSession returns the session of the session_id, created if not exists,
+// and locks it for the query until Release, a session serves one query at a time.
+// The session closes after the timeout without queries, maxSessions limits the named sessions, 0 is unlimited.
+func AcquireNamedSession(name string, user *users.User, timeout time.Duration, maxSessions int) (*Session, error) {
+	expireOnce.Do(func() {
+		go expireLoop()
+	})
+
+	mgrMu.Lock()
+	defer mgrMu.Unlock()
+
+	session, ok := namedSessions[name]
+	if !ok {
+		if maxSessions > 0 && len(namedSessions) >= maxSessions {
+			return nil, errors.ErrorWithCode(errors.LIMIT_EXCEEDED, "Session limit exceeded: %d sessions", maxSessions)
+		}
+		session = newSession()
+		session.name = name
+		session.user = user
+		namedSessions[name] = session
+	}
+
+	if session.user != nil && user != nil && session.user.Name != user.Name {
+		return nil, errors.ErrorWithCode(errors.SESSION_NOT_FOUND, "Session %s not found for the user %s", name, user.Name)
+	}
+	if session.inUse {
+		return nil, errors.ErrorWithCode(errors.SESSION_IS_LOCKED, "Session %s is locked by a concurrent client", name)
+	}
+	session.inUse = true
+	session.timeout = timeout
+	session.lastUsed = time.Now()
+	return session, nil
+}
+
+// Release unlocks the named session, the timeout starts from now.
+func (s *Session) Release() {
+	mgrMu.Lock()
+	defer mgrMu.Unlock()
+	s.inUse = false
+	s.lastUsed = time.Now()
+}
+
+// ExpireSessions closes the named sessions idle longer than their timeout, returns the number closed.
+func ExpireSessions(now time.Time) int {
+	var expired []*Session
+
+	mgrMu.Lock()
+	for name, session := range namedSessions {
+		if !session.inUse && now.Sub(session.lastUsed) > session.timeout {
+			delete(namedSessions, name)
+			expired = append(expired, session)
+		}
+	}
+	mgrMu.Unlock()
+
+	for _, session := range expired {
+		session.Close()
+	}
+	return len(expired)
+}
+
+func expireLoop() {
+	t := time.NewTicker(expireInterval)
+	defer t.Stop()
+	for now := range t.C {
+		ExpireSessions(now)
+	}
+}
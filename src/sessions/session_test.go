@@ -5,11 +5,25 @@
 package sessions
 
 import (
+	"sync"
 	"testing"
+	"time"
+
+	"users"
+
+	"base/errors"
 
 	"github.com/stretchr/testify/assert"
 )
 
+type mockTable struct {
+	closed bool
+}
+
+func (t *mockTable) Close() {
+	t.closed = true
+}
+
 func TestSession(t *testing.T) {
 	session := NewSession()
 	defer session.Close()
@@ -26,3 +40,127 @@ func TestSession(t *testing.T) {
 	got := session.GetProgress()
 	assert.Equal(t, pv, got)
 }
+
+func TestSessionGetProgressWhileRunning(t *testing.T) {
+	session := NewSession()
+	defer session.Close()
+
+	pv := &ProgressValues{}
+	session.UpdateProgress(pv)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 1000; i++ {
+			pv.ReadRows.Add(1)
+			pv.ReadBytes.Add(8)
+		}
+	}()
+	for i := 0; i < 100; i++ {
+		got := session.GetProgress()
+		assert.True(t, got.ReadRows.Get() <= 1000)
+	}
+	wg.Wait()
+	assert.Equal(t, int64(1000), session.GetProgress().ReadRows.Get())
+}
+
+func TestProgressDelta(t *testing.T) {
+	prev := &ProgressValues{}
+	prev.ReadRows.Set(10)
+	prev.ReadBytes.Set(100)
+	prev.TotalRowsToRead.Set(1000)
+
+	pv := &ProgressValues{}
+	pv.ReadRows.Set(15)
+	pv.ReadBytes.Set(150)
+	pv.TotalRowsToRead.Set(1000)
+
+	delta := pv.Delta(prev)
+	assert.Equal(t, int64(5), delta.ReadRows.Get())
+	assert.Equal(t, int64(50), delta.ReadBytes.Get())
+	assert.Equal(t, int64(0), delta.TotalRowsToRead.Get())
+	assert.False(t, delta.IsEmpty())
+	assert.True(t, pv.Delta(pv).IsEmpty())
+}
+
+func TestProfileValues(t *testing.T) {
+	pv := &ProfileValues{}
+	pv.AddBlock(10, 80)
+	pv.AddBlock(5, 40)
+	assert.Equal(t, int64(15), pv.Rows.Get())
+	assert.Equal(t, int64(2), pv.Blocks.Get())
+	assert.Equal(t, int64(120), pv.Bytes.Get())
+}
+
+func TestSessionSettingsAndTables(t *testing.T) {
+	session := NewSession()
+
+	session.SetSetting("max_threads", "4")
+	v, ok := session.GetSetting("max_threads")
+	assert.True(t, ok)
+	assert.Equal(t, "4", v)
+	settings := session.Settings()
+	settings["max_threads"] = "8"
+	v, _ = session.GetSetting("max_threads")
+	assert.Equal(t, "4", v)
+
+	t1, t2 := &mockTable{}, &mockTable{}
+	assert.Nil(t, session.AddTemporaryTable("t1", t1))
+	assert.NotNil(t, session.AddTemporaryTable("t1", t2))
+	assert.Nil(t, session.AddTemporaryTable("t2", t2))
+	_, ok = session.GetTemporaryTable("t1")
+	assert.True(t, ok)
+
+	assert.True(t, session.DropTemporaryTable("t1"))
+	assert.True(t, t1.closed)
+	assert.False(t, session.DropTemporaryTable("t1"))
+
+	session.Close()
+	assert.True(t, t2.closed)
+}
+
+func TestNamedSession(t *testing.T) {
+	user := &users.User{Name: "default"}
+
+	s1, err := AcquireNamedSession("named-1", user, time.Minute, 2)
+	assert.Nil(t, err)
+	s1.SetDatabase("db1")
+
+	// Locked by the running query.
+	_, err = AcquireNamedSession("named-1", user, time.Minute, 2)
+	assert.Equal(t, errors.SESSION_IS_LOCKED, err.(*errors.Error).Code())
+	s1.Release()
+
+	// The state persists.
+	got, err := AcquireNamedSession("named-1", user, time.Minute, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, s1, got)
+	assert.Equal(t, "db1", got.GetDatabase())
+	got.Release()
+
+	// Another user.
+	_, err = AcquireNamedSession("named-1", &users.User{Name: "u2"}, time.Minute, 2)
+	assert.Equal(t, errors.SESSION_NOT_FOUND, err.(*errors.Error).Code())
+
+	// Limit.
+	s2, err := AcquireNamedSession("named-2", user, time.Millisecond, 2)
+	assert.Nil(t, err)
+	_, err = AcquireNamedSession("named-3", user, time.Minute, 2)
+	assert.Equal(t, errors.LIMIT_EXCEEDED, err.(*errors.Error).Code())
+	assert.Contains(t, err.Error(), "Session limit exceeded")
+
+	// Expiry, the session in use is kept.
+	table := &mockTable{}
+	assert.Nil(t, s2.AddTemporaryTable("t1", table))
+	assert.Equal(t, 1, ExpireSessions(time.Now().Add(time.Hour)))
+	assert.False(t, table.closed)
+	s2.Release()
+	assert.Equal(t, 1, ExpireSessions(time.Now().Add(time.Hour)))
+	assert.True(t, table.closed)
+
+	s3, err := AcquireNamedSession("named-2", user, time.Minute, 2)
+	assert.Nil(t, err)
+	assert.NotEqual(t, s2, s3)
+	assert.Equal(t, "system", s3.GetDatabase())
+	s3.Close()
+}
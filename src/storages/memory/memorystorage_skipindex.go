@@ -0,0 +1,143 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package storages
+
+import (
+	"datablocks"
+	"datavalues"
+	"planners"
+)
+
+// blockRange is the minmax skip index of a block: the least and the greatest values of each column,
+// nil for the columns of the other families than the ints and the strings, or with the NULLs only.
+type blockRange struct {
+	names []string
+	mins  []datavalues.IDataValue
+	maxs  []datavalues.IDataValue
+}
+
+func newBlockRange(block *datablocks.DataBlock) *blockRange {
+	cols := block.Columns()
+	r := &blockRange{
+		names: make([]string, len(cols)),
+		mins:  make([]datavalues.IDataValue, len(cols)),
+		maxs:  make([]datavalues.IDataValue, len(cols)),
+	}
+	for i, it := range block.ColumnIterators() {
+		r.names[i] = cols[i].Name
+		ok := true
+		for ok && it.Next() {
+			v := it.Value()
+			switch v.Family() {
+			case datavalues.FamilyNull:
+				continue
+			case datavalues.FamilyInt, datavalues.FamilyString:
+			default:
+				ok = false
+				continue
+			}
+			if r.mins[i] == nil {
+				r.mins[i], r.maxs[i] = v, v
+				continue
+			}
+			if !sameFamily(r.mins[i], v) {
+				ok = false
+				continue
+			}
+			if cmp, err := datavalues.CompareTyped(v, r.mins[i]); err != nil {
+				ok = false
+			} else if cmp == datavalues.LessThan {
+				r.mins[i] = v
+			}
+			if cmp, err := datavalues.CompareTyped(v, r.maxs[i]); err != nil {
+				ok = false
+			} else if cmp == datavalues.GreaterThan {
+				r.maxs[i] = v
+			}
+		}
+		if !ok {
+			r.mins[i], r.maxs[i] = nil, nil
+		}
+	}
+	return r
+}
+
+// mayMatch is false if a conjunct of the filter is false for all the rows of the block,
+// checked for the comparisons of a column with a constant of its family.
+func (r *blockRange) mayMatch(filter *planners.FilterPlan) bool {
+	for _, conjunct := range planners.Conjuncts(filter.SubPlan) {
+		if !r.conjunctMayMatch(conjunct) {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *blockRange) conjunctMayMatch(plan planners.IPlan) bool {
+	cmp, ok := plan.(*planners.BinaryExpressionPlan)
+	if !ok {
+		return true
+	}
+	op := cmp.FuncName
+	variable, left := cmp.Left.(*planners.VariablePlan)
+	constant, right := cmp.Right.(*planners.ConstantPlan)
+	if !left || !right {
+		variable, left = cmp.Right.(*planners.VariablePlan)
+		constant, right = cmp.Left.(*planners.ConstantPlan)
+		if !left || !right {
+			return true
+		}
+		op = reversed[op]
+	}
+
+	i := -1
+	for j, name := range r.names {
+		if name == variable.Value {
+			i = j
+		}
+	}
+	if i < 0 || r.mins[i] == nil {
+		return true
+	}
+	v, err := datavalues.TryToValue(constant.Value)
+	if err != nil || !sameFamily(r.mins[i], v) {
+		return true
+	}
+	min, err := datavalues.CompareTyped(r.mins[i], v)
+	if err != nil {
+		return true
+	}
+	max, err := datavalues.CompareTyped(r.maxs[i], v)
+	if err != nil {
+		return true
+	}
+
+	switch op {
+	case "=":
+		return min != datavalues.GreaterThan && max != datavalues.LessThan
+	case "<":
+		return min == datavalues.LessThan
+	case "<=":
+		return min != datavalues.GreaterThan
+	case ">":
+		return max == datavalues.GreaterThan
+	case ">=":
+		return max != datavalues.LessThan
+	}
+	return true
+}
+
+// reversed is the comparison of the operands swapped.
+var reversed = map[string]string{
+	"=":  "=",
+	"<":  ">",
+	"<=": ">=",
+	">":  "<",
+	">=": "<=",
+}
+
+func sameFamily(a, b datavalues.IDataValue) bool {
+	return a.Family() == b.Family()
+}
@@ -10,6 +10,7 @@ import (
 	"columns"
 	"datablocks"
 	"datastreams"
+	"planners"
 	"sessions"
 )
 
@@ -56,6 +57,43 @@ func (storage *MemoryStorage) GetInputStream(session *sessions.Session) (datastr
 	return stream, nil
 }
 
+// GetScanInputStream skips the blocks by their skip index, the ones none of whose rows match the filter,
+// and copies only the columns of the scan.
+func (storage *MemoryStorage) GetScanInputStream(session *sessions.Session, scan *planners.ScanPlan) (datastreams.IDataBlockInputStream, error) {
+	log := storage.ctx.log
+
+	i := 0
+	iteratorFn := func() (*datablocks.DataBlock, error) {
+		for ; i < len(storage.output.blocks); i++ {
+			if scan.Filter != nil && !storage.output.ranges[i].mayMatch(scan.Filter) {
+				log.Debug("Storage->Memory->InputStream->Skip: index:%v", i)
+				continue
+			}
+			var res *datablocks.DataBlock
+			if scan.Columns != nil {
+				res = storage.output.blocks[i].DeepCloneColumns(scan.Columns)
+			} else {
+				res = storage.output.blocks[i].DeepClone()
+			}
+			log.Debug("Storage->Memory->InputStream->Block: index:%v, rows:%v", i, res.NumRows())
+			i++
+			return res, nil
+		}
+		return nil, nil
+	}
+	stream := datastreams.NewIteratorBlockInputStream(iteratorFn)
+	return stream, nil
+}
+
+func (storage *MemoryStorage) TotalRows() int64 {
+	return storage.output.totalRows()
+}
+
+// ExactRows is the rows of the blocks written, the ones being written are not in until they are.
+func (storage *MemoryStorage) ExactRows(session *sessions.Session) (int64, bool) {
+	return storage.output.totalRows(), true
+}
+
 func (storage *MemoryStorage) Close() {
 	storage.cols = nil
 	storage.output.Close()
@@ -65,6 +103,8 @@ type NativeBlockOutputStream struct {
 	mu     sync.RWMutex
 	header *datablocks.DataBlock
 	blocks []*datablocks.DataBlock
+	// The skip index of each block.
+	ranges []*blockRange
 }
 
 func NewNativeBlockOutputStream(header *datablocks.DataBlock) *NativeBlockOutputStream {
@@ -79,18 +119,31 @@ func (stream *NativeBlockOutputStream) Write(block *datablocks.DataBlock) error
 	stream.mu.Lock()
 	defer stream.mu.Unlock()
 	stream.blocks = append(stream.blocks, block)
+	stream.ranges = append(stream.ranges, newBlockRange(block))
 	return nil
 }
 
+func (stream *NativeBlockOutputStream) totalRows() int64 {
+	stream.mu.RLock()
+	defer stream.mu.RUnlock()
+
+	var rows int64
+	for _, block := range stream.blocks {
+		rows += int64(block.NumRows())
+	}
+	return rows
+}
+
 func (stream *NativeBlockOutputStream) Finalize() error {
 	return nil
 }
 
 func (stream *NativeBlockOutputStream) Close() {
 	for _, block := range stream.blocks {
-		block.Close()
+		block.Release()
 	}
 	stream.blocks = nil
+	stream.ranges = nil
 	stream.header = nil
 }
 
@@ -7,6 +7,7 @@ package storages
 import (
 	"columns"
 	"datastreams"
+	"planners"
 	"sessions"
 )
 
@@ -17,3 +18,20 @@ type IStorage interface {
 	GetOutputStream(*sessions.Session) (datastreams.IDataBlockOutputStream, error)
 	Close()
 }
+
+// IRowsCountStorage is the storage knowing its total rows, filled into the client progress.
+type IRowsCountStorage interface {
+	TotalRows() int64
+}
+
+// IStatisticsStorage is the storage knowing the statistics of its rows, the COUNT of all the rows is answered by them.
+type IStatisticsStorage interface {
+	// ExactRows returns the rows of the storage, false if it can't tell them exactly.
+	ExactRows(*sessions.Session) (int64, bool)
+}
+
+// IScanStorage is the storage reading by the scan plan: the stream may skip the blocks none of whose rows
+// match the Filter, the scan filters the rows of the others. The blocks have the Columns only if they are set.
+type IScanStorage interface {
+	GetScanInputStream(*sessions.Session, *planners.ScanPlan) (datastreams.IDataBlockInputStream, error)
+}
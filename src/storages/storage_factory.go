@@ -16,10 +16,14 @@ type storageCreator func(*StorageContext, []*columns.Column) IStorage
 
 var (
 	table = map[string]storageCreator{
-		MemoryStorageEngineName:          NewMemoryStorage,
-		SystemDatabasesStorageEngineName: NewSystemDatabasesStorage,
-		SystemTablesStorageEngineName:    NewSystemTablesStorage,
-		SystemNumbersStorageEngineName:   NewSystemNumbersStorage,
+		MemoryStorageEngineName:             NewMemoryStorage,
+		SystemDatabasesStorageEngineName:    NewSystemDatabasesStorage,
+		SystemTablesStorageEngineName:       NewSystemTablesStorage,
+		SystemNumbersStorageEngineName:      NewSystemNumbersStorage,
+		SystemDictionariesStorageEngineName: NewSystemDictionariesStorage,
+		SystemEventsStorageEngineName:       NewSystemEventsStorage,
+		SystemProcessesStorageEngineName:    NewSystemProcessesStorage,
+		SystemMetricsStorageEngineName:      NewSystemMetricsStorage,
 	}
 )
 
@@ -27,7 +31,7 @@ func StorageFactory(ctx *StorageContext, engine string, columns []*columns.Colum
 	name := strings.ToUpper(engine)
 	creator, ok := table[name]
 	if !ok {
-		return nil, errors.Errorf("Couldn't get the storage:%s", name)
+		return nil, errors.ErrorWithCode(errors.UNKNOWN_STORAGE, "Couldn't get the storage:%s", name)
 	}
 	return creator(ctx, columns), nil
 }
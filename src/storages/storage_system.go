@@ -11,9 +11,13 @@ import (
 )
 
 const (
-	SystemDatabasesStorageEngineName = "SYSTEM_DATABASES"
-	SystemTablesStorageEngineName    = "SYSTEM_TABLES"
-	SystemNumbersStorageEngineName   = "SYSTEM_NUMBERS"
+	SystemDatabasesStorageEngineName    = "SYSTEM_DATABASES"
+	SystemTablesStorageEngineName       = "SYSTEM_TABLES"
+	SystemNumbersStorageEngineName      = "SYSTEM_NUMBERS"
+	SystemDictionariesStorageEngineName = "SYSTEM_DICTIONARIES"
+	SystemEventsStorageEngineName       = "SYSTEM_EVENTS"
+	SystemProcessesStorageEngineName    = "SYSTEM_PROCESSES"
+	SystemMetricsStorageEngineName      = "SYSTEM_METRICS"
 )
 
 func NewSystemDatabasesStorage(ctx *StorageContext, cols []*columns.Column) IStorage {
@@ -30,3 +34,23 @@ func NewSystemNumbersStorage(ctx *StorageContext, cols []*columns.Column) IStora
 	systemCtx := system.NewSystemStorageContext(ctx.log, ctx.conf, ctx.tablesFillFunc, ctx.databasesFillFunc)
 	return system.NewSystemNumbersStorage(systemCtx)
 }
+
+func NewSystemDictionariesStorage(ctx *StorageContext, cols []*columns.Column) IStorage {
+	systemCtx := system.NewSystemStorageContext(ctx.log, ctx.conf, ctx.tablesFillFunc, ctx.databasesFillFunc)
+	return system.NewSystemDictionariesStorage(systemCtx)
+}
+
+func NewSystemEventsStorage(ctx *StorageContext, cols []*columns.Column) IStorage {
+	systemCtx := system.NewSystemStorageContext(ctx.log, ctx.conf, ctx.tablesFillFunc, ctx.databasesFillFunc)
+	return system.NewSystemEventsStorage(systemCtx)
+}
+
+func NewSystemProcessesStorage(ctx *StorageContext, cols []*columns.Column) IStorage {
+	systemCtx := system.NewSystemStorageContext(ctx.log, ctx.conf, ctx.tablesFillFunc, ctx.databasesFillFunc)
+	return system.NewSystemProcessesStorage(systemCtx)
+}
+
+func NewSystemMetricsStorage(ctx *StorageContext, cols []*columns.Column) IStorage {
+	systemCtx := system.NewSystemStorageContext(ctx.log, ctx.conf, ctx.tablesFillFunc, ctx.databasesFillFunc)
+	return system.NewSystemMetricsStorage(systemCtx)
+}
@@ -0,0 +1,84 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package system
+
+import (
+	"strings"
+
+	"base/errors"
+	"columns"
+	"datablocks"
+	"datastreams"
+	"datatypes"
+	"datavalues"
+	"dictionaries"
+	"sessions"
+)
+
+type SystemDictionariesStorage struct {
+	ctx *SystemStorageContext
+}
+
+func NewSystemDictionariesStorage(ctx *SystemStorageContext) *SystemDictionariesStorage {
+	return &SystemDictionariesStorage{
+		ctx: ctx,
+	}
+}
+
+func (storage *SystemDictionariesStorage) Name() string {
+	return ""
+}
+
+func (storage *SystemDictionariesStorage) Columns() []*columns.Column {
+	return []*columns.Column{
+		{Name: "name", DataType: datatypes.NewStringDataType()},
+		{Name: "status", DataType: datatypes.NewStringDataType()},
+		{Name: "source", DataType: datatypes.NewStringDataType()},
+		{Name: "key_type", DataType: datatypes.NewStringDataType()},
+		{Name: "attribute.names", DataType: datatypes.NewStringDataType()},
+		{Name: "element_count", DataType: datatypes.NewUInt64DataType()},
+		{Name: "bytes_allocated", DataType: datatypes.NewUInt64DataType()},
+		{Name: "loading_start_time", DataType: datatypes.NewStringDataType()},
+		{Name: "loading_duration", DataType: datatypes.NewFloat64DataType()},
+		{Name: "last_exception", DataType: datatypes.NewStringDataType()},
+	}
+}
+
+func (storage *SystemDictionariesStorage) GetOutputStream(session *sessions.Session) (datastreams.IDataBlockOutputStream, error) {
+	return nil, errors.New("Couldn't find outputstream")
+}
+
+func (storage *SystemDictionariesStorage) GetInputStream(session *sessions.Session) (datastreams.IDataBlockInputStream, error) {
+	// Block.
+	block := datablocks.NewDataBlock(storage.Columns())
+	for _, dict := range dictionaries.GetDictionaries() {
+		status := dict.Status()
+
+		var loadingStartTime string
+		if !status.LoadedAt.IsZero() {
+			loadingStartTime = status.LoadedAt.Format("2006-01-02 15:04:05")
+		}
+		if err := block.WriteRow([]datavalues.IDataValue{
+			datavalues.MakeString(status.Name),
+			datavalues.MakeString(string(status.Status)),
+			datavalues.MakeString(status.Source),
+			datavalues.MakeString(status.KeyType),
+			datavalues.MakeString(strings.Join(status.Attributes, ",")),
+			datavalues.ToValue(status.ElementCount),
+			datavalues.ToValue(status.BytesAllocated),
+			datavalues.MakeString(loadingStartTime),
+			datavalues.MakeFloat(status.LoadDuration.Seconds()),
+			datavalues.MakeString(status.LastException),
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	// Stream.
+	return datastreams.NewOneBlockInputStream(block), nil
+}
+
+func (storage *SystemDictionariesStorage) Close() {
+}
@@ -0,0 +1,61 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package system
+
+import (
+	"base/errors"
+	"columns"
+	"datablocks"
+	"datastreams"
+	"datatypes"
+	"datavalues"
+	"sessions"
+)
+
+type SystemEventsStorage struct {
+	ctx *SystemStorageContext
+}
+
+func NewSystemEventsStorage(ctx *SystemStorageContext) *SystemEventsStorage {
+	return &SystemEventsStorage{
+		ctx: ctx,
+	}
+}
+
+func (storage *SystemEventsStorage) Name() string {
+	return ""
+}
+
+func (storage *SystemEventsStorage) Columns() []*columns.Column {
+	return []*columns.Column{
+		{Name: "event", DataType: datatypes.NewStringDataType()},
+		{Name: "value", DataType: datatypes.NewUInt64DataType()},
+		{Name: "description", DataType: datatypes.NewStringDataType()},
+	}
+}
+
+func (storage *SystemEventsStorage) GetOutputStream(session *sessions.Session) (datastreams.IDataBlockOutputStream, error) {
+	return nil, errors.New("Couldn't find outputstream")
+}
+
+func (storage *SystemEventsStorage) GetInputStream(session *sessions.Session) (datastreams.IDataBlockInputStream, error) {
+	// Block.
+	block := datablocks.NewDataBlock(storage.Columns())
+	for _, event := range sessions.Events() {
+		if err := block.WriteRow([]datavalues.IDataValue{
+			datavalues.MakeString(event.Name()),
+			datavalues.MakeInt(event.Value()),
+			datavalues.MakeString(event.Description()),
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	// Stream.
+	return datastreams.NewOneBlockInputStream(block), nil
+}
+
+func (storage *SystemEventsStorage) Close() {
+}
@@ -0,0 +1,61 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package system
+
+import (
+	"base/errors"
+	"columns"
+	"datablocks"
+	"datastreams"
+	"datatypes"
+	"datavalues"
+	"sessions"
+)
+
+type SystemMetricsStorage struct {
+	ctx *SystemStorageContext
+}
+
+func NewSystemMetricsStorage(ctx *SystemStorageContext) *SystemMetricsStorage {
+	return &SystemMetricsStorage{
+		ctx: ctx,
+	}
+}
+
+func (storage *SystemMetricsStorage) Name() string {
+	return ""
+}
+
+func (storage *SystemMetricsStorage) Columns() []*columns.Column {
+	return []*columns.Column{
+		{Name: "metric", DataType: datatypes.NewStringDataType()},
+		{Name: "value", DataType: datatypes.NewInt64DataType()},
+		{Name: "description", DataType: datatypes.NewStringDataType()},
+	}
+}
+
+func (storage *SystemMetricsStorage) GetOutputStream(session *sessions.Session) (datastreams.IDataBlockOutputStream, error) {
+	return nil, errors.New("Couldn't find outputstream")
+}
+
+func (storage *SystemMetricsStorage) GetInputStream(session *sessions.Session) (datastreams.IDataBlockInputStream, error) {
+	// Block.
+	block := datablocks.NewDataBlock(storage.Columns())
+	for _, metric := range sessions.Metrics() {
+		if err := block.WriteRow([]datavalues.IDataValue{
+			datavalues.MakeString(metric.Name()),
+			datavalues.MakeInt(metric.Value()),
+			datavalues.MakeString(metric.Description()),
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	// Stream.
+	return datastreams.NewOneBlockInputStream(block), nil
+}
+
+func (storage *SystemMetricsStorage) Close() {
+}
@@ -64,6 +64,12 @@ func (stream *SystemNumbersBlockIntputStream) Name() string {
 	return "SystemNumbersBlockIntputStream"
 }
 
+func (stream *SystemNumbersBlockIntputStream) SetMaxBlockSize(rows int) {
+	if rows > 0 {
+		stream.maxBlockSize = rows
+	}
+}
+
 func (stream *SystemNumbersBlockIntputStream) Read() (*datablocks.DataBlock, error) {
 	rows := 0
 	block := stream.block.Clone()
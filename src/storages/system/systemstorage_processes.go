@@ -0,0 +1,71 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package system
+
+import (
+	"base/errors"
+	"columns"
+	"datablocks"
+	"datastreams"
+	"datatypes"
+	"datavalues"
+	"sessions"
+)
+
+// SystemProcessesStorage is system.processes, the queries running now.
+type SystemProcessesStorage struct {
+	ctx *SystemStorageContext
+}
+
+func NewSystemProcessesStorage(ctx *SystemStorageContext) *SystemProcessesStorage {
+	return &SystemProcessesStorage{
+		ctx: ctx,
+	}
+}
+
+func (storage *SystemProcessesStorage) Name() string {
+	return ""
+}
+
+func (storage *SystemProcessesStorage) Columns() []*columns.Column {
+	return []*columns.Column{
+		{Name: "query_id", DataType: datatypes.NewStringDataType()},
+		{Name: "user", DataType: datatypes.NewStringDataType()},
+		{Name: "elapsed", DataType: datatypes.NewFloat64DataType()},
+		{Name: "read_rows", DataType: datatypes.NewUInt64DataType()},
+		{Name: "read_bytes", DataType: datatypes.NewUInt64DataType()},
+		{Name: "memory_usage", DataType: datatypes.NewInt64DataType()},
+		{Name: "query", DataType: datatypes.NewStringDataType()},
+	}
+}
+
+func (storage *SystemProcessesStorage) GetOutputStream(session *sessions.Session) (datastreams.IDataBlockOutputStream, error) {
+	return nil, errors.New("Couldn't find outputstream")
+}
+
+func (storage *SystemProcessesStorage) GetInputStream(session *sessions.Session) (datastreams.IDataBlockInputStream, error) {
+	// Block.
+	block := datablocks.NewDataBlock(storage.Columns())
+	for _, process := range sessions.Processes() {
+		progress := process.Progress()
+		if err := block.WriteRow([]datavalues.IDataValue{
+			datavalues.MakeString(process.QueryID()),
+			datavalues.MakeString(process.User()),
+			datavalues.MakeFloat(process.Elapsed().Seconds()),
+			datavalues.MakeInt(progress.ReadRows.Get()),
+			datavalues.MakeInt(progress.ReadBytes.Get()),
+			datavalues.MakeInt(process.MemoryUsage()),
+			datavalues.MakeString(process.Query()),
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	// Stream.
+	return datastreams.NewOneBlockInputStream(block), nil
+}
+
+func (storage *SystemProcessesStorage) Close() {
+}
@@ -17,6 +17,7 @@ type TransformContext struct {
 	log              *xlog.Log
 	conf             *config.Config
 	progressCallback func(values *sessions.ProgressValues)
+	profileValues    *sessions.ProfileValues
 }
 
 func NewTransformContext(ctx context.Context, log *xlog.Log, conf *config.Config) *TransformContext {
@@ -30,3 +31,7 @@ func NewTransformContext(ctx context.Context, log *xlog.Log, conf *config.Config
 func (ctx *TransformContext) SetProgressCallback(fn func(pv *sessions.ProgressValues)) {
 	ctx.progressCallback = fn
 }
+
+func (ctx *TransformContext) SetProfileValues(pv *sessions.ProfileValues) {
+	ctx.profileValues = pv
+}
@@ -5,9 +5,12 @@
 package transforms
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
 	"datastreams"
+	"planners"
 	"processors"
 	"sessions"
 )
@@ -16,6 +19,12 @@ type DataSourceTransform struct {
 	ctx            *TransformContext
 	input          datastreams.IDataBlockInputStream
 	progressValues sessions.ProgressValues
+	// The total rows are known from the storage, otherwise they grow with the rows read.
+	totalRowsKnown bool
+	// The predicates pushed down to the scan, the rows not matching are dropped after they are read.
+	filter *planners.FilterPlan
+	// The rows sent at most, the source stops as it has sent them. 0 is no limit.
+	limit int
 	processors.BaseProcessor
 }
 
@@ -27,16 +36,76 @@ func NewDataSourceTransform(ctx *TransformContext, input datastreams.IDataBlockI
 	}
 }
 
+// NewNamedDataSourceTransform creates the source of the rows answered otherwise than by reading the table,
+// such as by the statistics of the storage. The EXPLAIN shows how by the name of the transform.
+func NewNamedDataSourceTransform(ctx *TransformContext, input datastreams.IDataBlockInputStream, how string) processors.IProcessor {
+	return &DataSourceTransform{
+		ctx:           ctx,
+		input:         input,
+		BaseProcessor: processors.NewBaseProcessor(fmt.Sprintf("transform_datasource (%s)", how)),
+	}
+}
+
+// NewPushedDataSourceTransform creates the source of the scan with the predicates and the limit pushed down to it,
+// the filter is nil and the limit 0 if they are not. The EXPLAIN shows them by the name of the transform.
+func NewPushedDataSourceTransform(ctx *TransformContext, input datastreams.IDataBlockInputStream, filter *planners.FilterPlan, limit int) (processors.IProcessor, error) {
+	var pushed []string
+	if filter != nil {
+		conjuncts := planners.Conjuncts(filter.SubPlan)
+		predicates := make([]string, len(conjuncts))
+		for i, conjunct := range conjuncts {
+			expr, err := planners.BuildExpression(conjunct)
+			if err != nil {
+				return nil, err
+			}
+			predicates[i] = expr.String()
+		}
+		pushed = append(pushed, "pushed: "+strings.Join(predicates, " AND "))
+	}
+	if limit > 0 {
+		pushed = append(pushed, fmt.Sprintf("limit %d", limit))
+	}
+	return &DataSourceTransform{
+		ctx:           ctx,
+		input:         input,
+		filter:        filter,
+		limit:         limit,
+		BaseProcessor: processors.NewBaseProcessor(fmt.Sprintf("transform_datasource (%s)", strings.Join(pushed, ", "))),
+	}, nil
+}
+
+// SetTotalRowsToRead sets the total rows of the source, for the progress bar of the clients.
+func (t *DataSourceTransform) SetTotalRowsToRead(rows int64) {
+	t.progressValues.TotalRowsToRead.Set(rows)
+	t.totalRowsKnown = true
+}
+
 func (t *DataSourceTransform) Execute() {
 	ctx := t.ctx
 	log := ctx.log
 	input := t.input
 	out := t.Out()
+	quota := sessions.ReadQuotaFromContext(ctx.ctx)
 
 	defer out.Close()
+
+	var sent int
+	var fields []string
+	if t.filter != nil {
+		var err error
+		if fields, err = planners.BuildVariableValues(t.filter.SubPlan); err != nil {
+			out.Send(err)
+			return
+		}
+	}
+	if t.totalRowsKnown && ctx.progressCallback != nil {
+		ctx.progressCallback(&t.progressValues)
+	}
 	for {
 		select {
 		case <-ctx.ctx.Done():
+			// The downstream gets the timeout or the cancellation, not a finished stream.
+			out.Send(ctx.ctx.Err())
 			return
 		default:
 			if out.IsClose() {
@@ -49,17 +118,46 @@ func (t *DataSourceTransform) Execute() {
 				out.Send(err)
 				return
 			} else if data == nil {
+				// The source stopped by the context is not finished either.
+				if err := ctx.ctx.Err(); err != nil {
+					out.Send(err)
+				}
 				return
 			}
 			cost := time.Since(start)
 			t.progressValues.Cost.Add(cost)
 			t.progressValues.ReadBytes.Add(int64(data.TotalBytes()))
 			t.progressValues.ReadRows.Add(int64(data.NumRows()))
-			t.progressValues.TotalRowsToRead.Add(int64(data.NumRows()))
+			if !t.totalRowsKnown {
+				t.progressValues.TotalRowsToRead.Add(int64(data.NumRows()))
+			}
 			if ctx.progressCallback != nil {
 				ctx.progressCallback(&t.progressValues)
 			}
 
+			// Checked per block, the block beyond max_rows_to_read or max_bytes_to_read is not sent.
+			// The overflow mode break ends the stream as if the source was finished.
+			if ok, err := quota.Read(int64(data.NumRows()), int64(data.TotalBytes())); !ok {
+				if err != nil {
+					out.Send(err)
+				}
+				return
+			}
+			if t.filter != nil {
+				if err := data.FilterByPlan(fields, t.filter); err != nil {
+					out.Send(err)
+					return
+				}
+				if data.NumRows() == 0 {
+					continue
+				}
+			}
+			if t.limit > 0 && sent+data.NumRows() >= t.limit {
+				data.Limit(0, t.limit-sent)
+				out.Send(data)
+				return
+			}
+			sent += data.NumRows()
 			out.Send(data)
 		}
 
@@ -13,6 +13,7 @@ import (
 	"datatypes"
 	"mocks"
 	"processors"
+	"sessions"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -69,3 +70,100 @@ func TestDataSourceTransfrom(t *testing.T) {
 		})
 	}
 }
+
+func TestDataSourceTransfromProgress(t *testing.T) {
+	tests := []struct {
+		name      string
+		totalRows int64
+		expect    int64
+	}{
+		{
+			name:   "unknown-total",
+			expect: 3,
+		},
+		{
+			name:      "known-total",
+			totalRows: 100,
+			expect:    100,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mock, cleanup := mocks.NewMock()
+			defer cleanup()
+			ctx := NewTransformContext(mock.Ctx, mock.Log, mock.Conf)
+			var callbacks int
+			ctx.SetProgressCallback(func(pv *sessions.ProgressValues) {
+				callbacks++
+			})
+
+			stream := mocks.NewMockBlockInputStream(mocks.NewSourceFromSlice(mocks.NewBlockFromSlice(
+				[]*columns.Column{
+					{Name: "name", DataType: datatypes.NewStringDataType()},
+				},
+				[]interface{}{"x"},
+				[]interface{}{"y"},
+				[]interface{}{"z"},
+			)))
+			datasource := NewDataSourceTransform(ctx, stream)
+			if test.totalRows > 0 {
+				datasource.(*DataSourceTransform).SetTotalRowsToRead(test.totalRows)
+			}
+
+			sink := processors.NewSink("sink")
+			pipeline := processors.NewPipeline(context.Background())
+			pipeline.Add(datasource)
+			pipeline.Add(sink)
+			pipeline.Run()
+			err := pipeline.Wait(func(x interface{}) error {
+				return nil
+			})
+			assert.Nil(t, err)
+
+			stats := datasource.(*DataSourceTransform).Stats()
+			assert.Equal(t, int64(3), stats.ReadRows.Get())
+			assert.Equal(t, test.expect, stats.TotalRowsToRead.Get())
+			assert.True(t, callbacks > 0)
+		})
+	}
+}
+
+func TestDataSourceTransfromLimit(t *testing.T) {
+	mock, cleanup := mocks.NewMock()
+	defer cleanup()
+	ctx := NewTransformContext(mock.Ctx, mock.Log, mock.Conf)
+
+	var source []interface{}
+	for i := 0; i < 3; i++ {
+		source = append(source, mocks.NewBlockFromSlice(
+			[]*columns.Column{
+				{Name: "name", DataType: datatypes.NewStringDataType()},
+			},
+			[]interface{}{"x"},
+			[]interface{}{"y"},
+		))
+	}
+	stream := mocks.NewMockBlockInputStream(mocks.NewSourceFromSlice(source...))
+	datasource, err := NewPushedDataSourceTransform(ctx, stream, nil, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, "transform_datasource (limit 3)", datasource.Name())
+
+	sink := processors.NewSink("sink")
+	pipeline := processors.NewPipeline(context.Background())
+	pipeline.Add(datasource)
+	pipeline.Add(sink)
+	pipeline.Run()
+
+	var rows []int
+	err = pipeline.Wait(func(x interface{}) error {
+		rows = append(rows, x.(*datablocks.DataBlock).NumRows())
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []int{2, 1}, rows)
+
+	// The third block is not read.
+	stats := datasource.(*DataSourceTransform).Stats()
+	assert.Equal(t, int64(4), stats.ReadRows.Get())
+}
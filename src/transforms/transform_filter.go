@@ -10,8 +10,6 @@ import (
 	"processors"
 	"sessions"
 	"time"
-
-	"github.com/gammazero/workerpool"
 )
 
 type FilterTransform struct {
@@ -30,7 +28,6 @@ func NewFilterTransform(ctx *TransformContext, filter *planners.FilterPlan) proc
 }
 
 func (t *FilterTransform) Execute() {
-	ctx := t.ctx
 	plan := t.filter
 	out := t.Out()
 	defer out.Close()
@@ -42,33 +39,28 @@ func (t *FilterTransform) Execute() {
 		return
 	}
 
-	workerPool := workerpool.New(ctx.conf.Runtime.ParallelWorkerNumber)
+	// The blocks are filtered in order, the pipeline runs one transform per lane.
 	onNext := func(x interface{}) {
 		switch y := x.(type) {
 		case *datablocks.DataBlock:
-			workerPool.Submit(func() {
-				start := time.Now()
-				if err := y.FilterByPlan(fields, plan); err != nil {
-					out.Send(err)
-				} else {
-					if y.NumRows() > 0 {
-						cost := time.Since(start)
-						t.progressValues.Cost.Add(cost)
-						t.progressValues.ReadBytes.Add(int64(y.TotalBytes()))
-						t.progressValues.ReadRows.Add(int64(y.NumRows()))
-						t.progressValues.TotalRowsToRead.Add(int64(y.NumRows()))
-						out.Send(y)
-					}
+			start := time.Now()
+			if err := y.FilterByPlan(fields, plan); err != nil {
+				out.Send(err)
+			} else {
+				if y.NumRows() > 0 {
+					cost := time.Since(start)
+					t.progressValues.Cost.Add(cost)
+					t.progressValues.ReadBytes.Add(int64(y.TotalBytes()))
+					t.progressValues.ReadRows.Add(int64(y.NumRows()))
+					t.progressValues.TotalRowsToRead.Add(int64(y.NumRows()))
+					out.Send(y)
 				}
-			})
+			}
 		default:
 			out.Send(x)
 		}
 	}
-	onDone := func() {
-		workerPool.StopWait()
-	}
-	t.Subscribe(onNext, onDone)
+	t.Subscribe(onNext)
 }
 
 func (t *FilterTransform) Stats() sessions.ProgressValues {
@@ -41,11 +41,19 @@ func (t *Limitransform) Execute() {
 	out := t.Out()
 	defer out.Close()
 
+	profile := t.ctx.profileValues
+	if profile != nil {
+		profile.AppliedLimit.Set(true)
+	}
+
 	onNext := func(x interface{}) {
 		switch y := x.(type) {
 		case *datablocks.DataBlock:
 			if x != nil {
 				start := time.Now()
+				if profile != nil {
+					profile.RowsBeforeLimit.Add(int64(y.NumRows()))
+				}
 				cutOffset, cutLimit := y.Limit(offset, limit)
 				offset -= cutOffset
 				limit -= cutLimit
@@ -5,6 +5,7 @@
 package transforms
 
 import (
+	"fmt"
 	"time"
 
 	"datablocks"
@@ -21,30 +22,71 @@ type OrderByTransform struct {
 }
 
 func NewOrderByTransform(ctx *TransformContext, plan *planners.OrderByPlan) processors.IProcessor {
+	name := "transform_orderby"
+	if plan.Limit > 0 {
+		name = fmt.Sprintf("%s (partial sort, limit %d)", name, plan.Limit)
+	}
 	return &OrderByTransform{
 		ctx:           ctx,
 		plan:          plan,
-		BaseProcessor: processors.NewBaseProcessor("transform_orderby"),
+		BaseProcessor: processors.NewBaseProcessor(name),
 	}
 }
 
 func (t *OrderByTransform) Execute() {
-	var block *datablocks.DataBlock
+	var block, empty *datablocks.DataBlock
+	var charged int64
+	var failed bool
 
 	plan := t.plan
 	out := t.Out()
 	defer out.Close()
 
+	// The blocks are kept until all of them are sorted.
+	memory := sessions.MemoryTrackerFromContext(t.ctx.ctx)
+	defer func() { memory.Free(charged) }()
+
+	// Beyond max_bytes_before_external_sort the blocks kept are sorted and spilled as a run,
+	// the runs are merged at the end.
+	conf := t.ctx.conf
+	limit := int64(conf.Runtime.MaxBytesBeforeExternalSort)
+	spill := newSortSpill(conf.Server.TmpPath, conf.Server.DefaultBlockSize, &t.progressValues)
+	defer spill.close()
+
 	// Get all base fields by the expression.
 	fields, err := planners.BuildVariableValues(plan)
 	if err != nil {
 		out.Send(err)
 		return
 	}
+	if plan.Limit > 0 {
+		t.partialSort(fields)
+		return
+	}
 
 	onNext := func(x interface{}) {
 		switch y := x.(type) {
 		case *datablocks.DataBlock:
+			if failed {
+				return
+			}
+			// The rows dropped by the filters before are not kept.
+			y.Materialize()
+			// The empty blocks may have the header of the selection only and come first from the lanes,
+			// they are sent only if no rows come.
+			if y.NumRows() == 0 {
+				if empty == nil {
+					empty = y
+				}
+				return
+			}
+			bytes := int64(y.TotalBytes())
+			if err := memory.Alloc(bytes); err != nil {
+				failed = true
+				out.Send(err)
+				return
+			}
+			charged += bytes
 			if block == nil {
 				block = y
 			} else {
@@ -52,11 +94,31 @@ func (t *OrderByTransform) Execute() {
 					out.Send(err)
 				}
 			}
+			if limit > 0 && charged > limit {
+				if err := spill.write(block, fields, plan); err != nil {
+					failed = true
+					out.Send(err)
+					return
+				}
+				memory.Free(charged)
+				charged = 0
+				block = nil
+			}
 		case error:
 			out.Send(y)
 		}
 	}
 	onDone := func() {
+		if failed {
+			return
+		}
+		if spill.runs() > 0 {
+			t.merge(block, fields, spill)
+			return
+		}
+		if block == nil && empty != nil {
+			out.Send(empty)
+		}
 		if block != nil {
 			start := time.Now()
 			if err := block.OrderByPlan(fields, t.plan); err != nil {
@@ -74,6 +136,93 @@ func (t *OrderByTransform) Execute() {
 	t.Subscribe(onNext, onDone)
 }
 
+// partialSort keeps only the first rows of the limit in a heap, the memory is of the limit rows whatever the input.
+// The lanes each send their first rows, the merge of them is the partial sort of one more transform.
+func (t *OrderByTransform) partialSort(fields []string) {
+	var failed bool
+	out := t.Out()
+
+	topN, err := datablocks.NewOrderByTopN(fields, t.plan, t.plan.Limit)
+	if err != nil {
+		out.Send(err)
+		return
+	}
+
+	onNext := func(x interface{}) {
+		switch y := x.(type) {
+		case *datablocks.DataBlock:
+			if failed {
+				return
+			}
+			start := time.Now()
+			if err := topN.Push(y); err != nil {
+				failed = true
+				out.Send(err)
+				return
+			}
+			t.progressValues.Cost.Add(time.Since(start))
+			t.progressValues.ReadBytes.Add(int64(y.TotalBytes()))
+			t.progressValues.ReadRows.Add(int64(y.NumRows()))
+			t.progressValues.TotalRowsToRead.Add(int64(y.NumRows()))
+		case error:
+			out.Send(y)
+		}
+	}
+	onDone := func() {
+		if failed {
+			return
+		}
+		block, err := topN.Block()
+		if err != nil {
+			out.Send(err)
+			return
+		}
+		if block != nil {
+			out.Send(block)
+		}
+	}
+	t.Subscribe(onNext, onDone)
+}
+
+// merge spills the blocks kept as the last run and sends the rows of all the runs in order,
+// a block of max_block_size rows at a time. It stops as the query is cancelled or nothing reads the output.
+func (t *OrderByTransform) merge(block *datablocks.DataBlock, fields []string, spill *sortSpill) {
+	out := t.Out()
+	if block != nil {
+		if err := spill.write(block, fields, t.plan); err != nil {
+			out.Send(err)
+			return
+		}
+	}
+
+	start := time.Now()
+	merger, err := spill.merger(fields, t.plan)
+	if err != nil {
+		out.Send(err)
+		return
+	}
+	for !out.IsClose() {
+		if err := t.ctx.ctx.Err(); err != nil {
+			out.Send(err)
+			return
+		}
+		merged, err := merger.Next()
+		if err != nil {
+			out.Send(err)
+			return
+		}
+		if merged == nil {
+			return
+		}
+		t.progressValues.Cost.Add(time.Since(start))
+		t.progressValues.ReadBytes.Add(int64(merged.TotalBytes()))
+		t.progressValues.ReadRows.Add(int64(merged.NumRows()))
+		t.progressValues.TotalRowsToRead.Add(int64(merged.NumRows()))
+		out.Send(merged)
+		start = time.Now()
+	}
+}
+
 func (t *OrderByTransform) Stats() sessions.ProgressValues {
 	return t.progressValues
 }
@@ -0,0 +1,115 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package transforms
+
+import (
+	"bufio"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"base/binary"
+	"base/errors"
+	"datablocks"
+	"planners"
+	"sessions"
+)
+
+// sortSpill is the sorted runs of the external sort, each in a temporary file under the tmp_path.
+// The files are removed by close, whether the query finishes, fails or is cancelled.
+type sortSpill struct {
+	dir       string
+	blockSize int
+	files     []*os.File
+	pv        *sessions.ProgressValues
+}
+
+func newSortSpill(dir string, blockSize int, pv *sessions.ProgressValues) *sortSpill {
+	if dir == "" {
+		dir = os.TempDir()
+	}
+	return &sortSpill{
+		dir:       dir,
+		blockSize: blockSize,
+		pv:        pv,
+	}
+}
+
+func (s *sortSpill) runs() int {
+	return len(s.files)
+}
+
+// write sorts the block and writes it as a run, in the blocks of the block size for the merge to read one at a time.
+// The rows and the bytes written are in the written progress of the transform.
+func (s *sortSpill) write(block *datablocks.DataBlock, fields []string, plan *planners.OrderByPlan) error {
+	if err := block.OrderByPlan(fields, plan); err != nil {
+		return err
+	}
+	f, err := ioutil.TempFile(s.dir, "vectorsql-sort-")
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	s.files = append(s.files, f)
+
+	counter := &countingWriter{w: bufio.NewWriter(f)}
+	writer := binary.NewWriter(counter)
+	rows := block.NumRows()
+	for offset := 0; offset < rows; offset += s.blockSize {
+		if err := block.WriteSpill(writer, offset, s.blockSize); err != nil {
+			return err
+		}
+	}
+	if err := counter.w.Flush(); err != nil {
+		return errors.Wrap(err)
+	}
+	s.pv.WrittenRows.Add(int64(rows))
+	s.pv.WrittenBytes.Add(counter.n)
+	sessions.ExternalSortWritePart.Add(1)
+	return nil
+}
+
+// merger merges the runs written, the files are read from the start.
+func (s *sortSpill) merger(fields []string, plan *planners.OrderByPlan) (*datablocks.OrderByMerger, error) {
+	runs := make([]func() (*datablocks.DataBlock, error), len(s.files))
+	for i, f := range s.files {
+		if _, err := f.Seek(0, io.SeekStart); err != nil {
+			return nil, errors.Wrap(err)
+		}
+		reader := binary.NewReader(fullReader{r: bufio.NewReader(f)})
+		runs[i] = func() (*datablocks.DataBlock, error) {
+			return datablocks.ReadSpill(reader)
+		}
+	}
+	sessions.ExternalSortMerge.Add(1)
+	return datablocks.NewOrderByMerger(fields, plan, runs, s.blockSize)
+}
+
+func (s *sortSpill) close() {
+	for _, f := range s.files {
+		f.Close()
+		os.Remove(f.Name())
+	}
+	s.files = nil
+}
+
+type countingWriter struct {
+	w *bufio.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// fullReader reads all the bytes asked for, the binary.Reader takes a short read as the value.
+type fullReader struct {
+	r io.Reader
+}
+
+func (f fullReader) Read(p []byte) (int, error) {
+	return io.ReadFull(f.r, p)
+}
@@ -7,12 +7,16 @@ package transforms
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
+	"os"
 	"testing"
 
 	"base/errors"
 	"columns"
+	"config"
 	"datablocks"
 	"datatypes"
+	"datavalues"
 	"mocks"
 	"planners"
 	"processors"
@@ -73,6 +77,46 @@ func TestOrderByTransfrom(t *testing.T) {
 				[]interface{}{"z", 13},
 			),
 		},
+		{
+			name: "collate-nocase",
+			plan: planners.NewOrderByPlan(
+				planners.Order{
+					Expression: planners.NewVariablePlan("name"),
+					Direction:  "asc",
+					Collation:  datavalues.CollationNoCase,
+				},
+				planners.Order{
+					Expression: planners.NewVariablePlan("age"),
+					Direction:  "asc",
+				},
+			),
+			source: mocks.NewSourceFromSlice(
+				mocks.NewBlockFromSlice(
+					[]*columns.Column{
+						{Name: "name", DataType: datatypes.NewStringDataType()},
+						{Name: "age", DataType: datatypes.NewInt32DataType()},
+					},
+					[]interface{}{"b", 1},
+					[]interface{}{"B", 2},
+					[]interface{}{"a", 3},
+					[]interface{}{"C", 4},
+					[]interface{}{"A", 5},
+					[]interface{}{"c", 6},
+				),
+			),
+			expect: mocks.NewBlockFromSlice(
+				[]*columns.Column{
+					{Name: "name", DataType: datatypes.NewStringDataType()},
+					{Name: "age", DataType: datatypes.NewInt32DataType()},
+				},
+				[]interface{}{"a", 3},
+				[]interface{}{"A", 5},
+				[]interface{}{"b", 1},
+				[]interface{}{"B", 2},
+				[]interface{}{"C", 4},
+				[]interface{}{"c", 6},
+			),
+		},
 		{
 			name: "simple-error-pass",
 			plan: planners.NewOrderByPlan(
@@ -142,3 +186,243 @@ func TestOrderByTransfrom(t *testing.T) {
 		})
 	}
 }
+
+func TestOrderByTransformExternal(t *testing.T) {
+	cols := []*columns.Column{
+		{Name: "name", DataType: datatypes.NewStringDataType()},
+		{Name: "age", DataType: datatypes.NewInt32DataType()},
+	}
+	plan := planners.NewOrderByPlan(
+		planners.Order{
+			Expression: planners.NewVariablePlan("name"),
+			Direction:  "asc",
+		},
+		planners.Order{
+			Expression: planners.NewVariablePlan("age"),
+			Direction:  "desc",
+		},
+	)
+	var null *string
+	names := []interface{}{"x", "z", null, "y", "x"}
+	var source []interface{}
+	for i := 0; i < 5; i++ {
+		var rows [][]interface{}
+		for j := 0; j < 20; j++ {
+			rows = append(rows, []interface{}{names[(i+j)%len(names)], i*20 + j})
+		}
+		source = append(source, mocks.NewBlockFromSlice(cols, rows...))
+	}
+
+	run := func(conf *config.Config) (*datablocks.DataBlock, int, *OrderByTransform, error) {
+		mock, cleanup := mocks.NewMock()
+		defer cleanup()
+		ctx := NewTransformContext(mock.Ctx, mock.Log, conf)
+
+		// The mock stream is read to the end, the blocks are copied as the transform appends to them.
+		var blocks []interface{}
+		for _, x := range source {
+			blocks = append(blocks, x.(*datablocks.DataBlock).DeepClone())
+		}
+		stream := mocks.NewMockBlockInputStream(blocks)
+		datasource := NewDataSourceTransform(ctx, stream)
+		orderby := NewOrderByTransform(ctx, plan)
+		sink := processors.NewSink("sink")
+
+		pipeline := processors.NewPipeline(context.Background())
+		pipeline.Add(datasource)
+		pipeline.Add(orderby)
+		pipeline.Add(sink)
+		pipeline.Run()
+
+		var actual *datablocks.DataBlock
+		var n int
+		err := pipeline.Wait(func(x interface{}) error {
+			switch x := x.(type) {
+			case *datablocks.DataBlock:
+				n++
+				if actual == nil {
+					actual = x
+				} else {
+					assert.Nil(t, actual.Append(x))
+				}
+			}
+			return nil
+		})
+		return actual, n, orderby.(*OrderByTransform), err
+	}
+
+	dir, err := ioutil.TempDir("", "vectorsql-sort")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	expect, n, _, err := run(config.DefaultConfig())
+	assert.Nil(t, err)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, 100, expect.NumRows())
+
+	// Each block is a run, the merge sends the blocks of 16 rows.
+	conf := config.DefaultConfig()
+	conf.Server.TmpPath = dir
+	conf.Server.DefaultBlockSize = 16
+	conf.Runtime.MaxBytesBeforeExternalSort = 1
+	actual, n, orderby, err := run(conf)
+	assert.Nil(t, err)
+	assert.Equal(t, 7, n)
+	assert.True(t, mocks.DataBlockEqual(expect, actual))
+	rows := actual.RowIterator()
+	assert.True(t, rows.Next())
+	assert.True(t, datavalues.IsNull(rows.Value()[0]))
+	stats := orderby.Stats()
+	assert.Equal(t, int64(100), stats.WrittenRows.Get())
+	assert.True(t, stats.WrittenBytes.Get() > 0)
+	assert.Equal(t, int64(100), stats.TotalRowsToRead.Get())
+
+	// The temporary files are removed.
+	files, err := ioutil.ReadDir(dir)
+	assert.Nil(t, err)
+	assert.Empty(t, files)
+
+	// A run and the blocks kept at the end.
+	conf.Runtime.MaxBytesBeforeExternalSort = int(source[0].(*datablocks.DataBlock).TotalBytes()) * 3
+	actual, _, _, err = run(conf)
+	assert.Nil(t, err)
+	assert.True(t, mocks.DataBlockEqual(expect, actual))
+	files, err = ioutil.ReadDir(dir)
+	assert.Nil(t, err)
+	assert.Empty(t, files)
+}
+
+func TestOrderByTransformPartial(t *testing.T) {
+	cols := []*columns.Column{
+		{Name: "name", DataType: datatypes.NewStringDataType()},
+		{Name: "age", DataType: datatypes.NewInt32DataType()},
+	}
+	var null *string
+	names := []interface{}{"x", "z", null, "y", "x"}
+	var source []interface{}
+	for i := 0; i < 5; i++ {
+		var rows [][]interface{}
+		for j := 0; j < 20; j++ {
+			rows = append(rows, []interface{}{names[(i+j)%len(names)], (i*37 + j*11) % 100})
+		}
+		source = append(source, mocks.NewBlockFromSlice(cols, rows...))
+	}
+
+	run := func(limit int, lanes int) (*datablocks.DataBlock, *OrderByTransform, error) {
+		mock, cleanup := mocks.NewMock()
+		defer cleanup()
+		ctx := NewTransformContext(mock.Ctx, mock.Log, mock.Conf)
+
+		plan := planners.NewOrderByPlan(
+			planners.Order{
+				Expression: planners.NewVariablePlan("name"),
+				Direction:  "asc",
+			},
+			planners.Order{
+				Expression: planners.NewVariablePlan("age"),
+				Direction:  "desc",
+			},
+		)
+		plan.Limit = limit
+
+		var blocks []interface{}
+		for _, x := range source {
+			blocks = append(blocks, x.(*datablocks.DataBlock).DeepClone())
+		}
+		stream := mocks.NewMockBlockInputStream(blocks)
+		pipeline := processors.NewPipeline(context.Background())
+		pipeline.Add(NewDataSourceTransform(ctx, stream))
+		partials := make([]processors.IProcessor, lanes)
+		for i := range partials {
+			partials[i] = NewOrderByTransform(ctx, plan)
+		}
+		pipeline.AddLanes(partials...)
+		merge := NewOrderByTransform(ctx, plan)
+		pipeline.Add(merge)
+		pipeline.Add(processors.NewSink("sink"))
+		pipeline.Run()
+
+		var actual *datablocks.DataBlock
+		err := pipeline.Wait(func(x interface{}) error {
+			switch x := x.(type) {
+			case *datablocks.DataBlock:
+				if actual == nil {
+					actual = x
+				} else {
+					assert.Nil(t, actual.Append(x))
+				}
+			}
+			return nil
+		})
+		return actual, merge.(*OrderByTransform), err
+	}
+
+	sorted, _, err := run(0, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, 100, sorted.NumRows())
+
+	for _, limit := range []int{1, 7, 100, 150} {
+		for _, lanes := range []int{1, 3} {
+			var rows [][]datavalues.IDataValue
+			it := sorted.RowIterator()
+			for it.Next() && len(rows) < limit {
+				rows = append(rows, it.Value())
+			}
+			expect := datablocks.NewDataBlock(cols)
+			for _, row := range rows {
+				assert.Nil(t, expect.WriteRow(row))
+			}
+
+			actual, merge, err := run(limit, lanes)
+			assert.Nil(t, err)
+			assert.True(t, mocks.DataBlockEqual(expect, actual), "limit %d, lanes %d", limit, lanes)
+			assert.Equal(t, fmt.Sprintf("transform_orderby (partial sort, limit %d)", limit), merge.Name())
+		}
+	}
+}
+
+func TestOrderByTransformEmptyBlockFirst(t *testing.T) {
+	mock, cleanup := mocks.NewMock()
+	defer cleanup()
+	ctx := NewTransformContext(mock.Ctx, mock.Log, mock.Conf)
+
+	cols := []*columns.Column{
+		{Name: "name", DataType: datatypes.NewStringDataType()},
+		{Name: "age", DataType: datatypes.NewInt32DataType()},
+	}
+	plan := planners.NewOrderByPlan(planners.Order{
+		Expression: planners.NewVariablePlan("age"),
+		Direction:  "desc",
+	})
+
+	// The empty block of a lane has the header of the selection only, it comes before the rows.
+	run := func(blocks ...interface{}) (*datablocks.DataBlock, error) {
+		pipeline := processors.NewPipeline(context.Background())
+		pipeline.Add(NewDataSourceTransform(ctx, mocks.NewMockBlockInputStream(blocks)))
+		pipeline.Add(NewOrderByTransform(ctx, plan))
+		pipeline.Add(processors.NewSink("sink"))
+		pipeline.Run()
+
+		var actual *datablocks.DataBlock
+		err := pipeline.Wait(func(x interface{}) error {
+			if x, ok := x.(*datablocks.DataBlock); ok {
+				assert.Nil(t, actual)
+				actual = x
+			}
+			return nil
+		})
+		return actual, err
+	}
+
+	empty := datablocks.NewDataBlock(cols[:1])
+	actual, err := run(empty, mocks.NewBlockFromSlice(cols, []interface{}{"x", 1}, []interface{}{"y", 2}))
+	assert.Nil(t, err)
+	expect := mocks.NewBlockFromSlice(cols, []interface{}{"y", 2}, []interface{}{"x", 1})
+	assert.True(t, mocks.DataBlockEqual(expect, actual))
+
+	// Nothing but the empty blocks is the empty result with the header.
+	actual, err = run(datablocks.NewDataBlock(cols[:1]))
+	assert.Nil(t, err)
+	assert.Equal(t, 0, actual.NumRows())
+	assert.Equal(t, 1, actual.NumColumns())
+}
@@ -0,0 +1,77 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package transforms
+
+import (
+	"bytes"
+	"time"
+
+	"base/binary"
+	"datablocks"
+	"processors"
+	"sessions"
+)
+
+// QueryCacheTransform passes the result blocks through and serializes them for the query cache,
+// the store gets them once the result is complete.
+// The result failing, stopped by the downstream or beyond maxBytes is not stored.
+type QueryCacheTransform struct {
+	ctx            *TransformContext
+	maxBytes       int
+	store          func(blocks [][]byte)
+	progressValues sessions.ProgressValues
+	processors.BaseProcessor
+}
+
+func NewQueryCacheTransform(ctx *TransformContext, maxBytes int, store func(blocks [][]byte)) processors.IProcessor {
+	return &QueryCacheTransform{
+		ctx:           ctx,
+		maxBytes:      maxBytes,
+		store:         store,
+		BaseProcessor: processors.NewBaseProcessor("transform_query_cache"),
+	}
+}
+
+func (t *QueryCacheTransform) Execute() {
+	var blocks [][]byte
+	var size int
+	var skipped bool
+
+	out := t.Out()
+	defer out.Close()
+
+	onNext := func(x interface{}) {
+		switch y := x.(type) {
+		case *datablocks.DataBlock:
+			if !skipped {
+				start := time.Now()
+				buf := new(bytes.Buffer)
+				if err := y.WriteNative(binary.NewWriter(buf)); err != nil || size+buf.Len() > t.maxBytes {
+					skipped, blocks = true, nil
+				} else {
+					size += buf.Len()
+					blocks = append(blocks, buf.Bytes())
+				}
+				t.progressValues.Cost.Add(time.Since(start))
+				t.progressValues.ReadBytes.Add(int64(buf.Len()))
+			}
+			t.progressValues.ReadRows.Add(int64(y.NumRows()))
+			out.Send(y)
+		case error:
+			skipped, blocks = true, nil
+			out.Send(y)
+		}
+	}
+	onDone := func() {
+		if !skipped {
+			t.store(blocks)
+		}
+	}
+	t.Subscribe(onNext, onDone)
+}
+
+func (t *QueryCacheTransform) Stats() sessions.ProgressValues {
+	return t.progressValues
+}
@@ -0,0 +1,86 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package transforms
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"base/binary"
+	"columns"
+	"datablocks"
+	"datatypes"
+	"mocks"
+	"processors"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueryCacheTransform(t *testing.T) {
+	cols := []*columns.Column{
+		{Name: "name", DataType: datatypes.NewStringDataType()},
+		{Name: "age", DataType: datatypes.NewInt32DataType()},
+	}
+	block := mocks.NewBlockFromSlice(cols,
+		[]interface{}{"x", 11},
+		[]interface{}{"z", 13},
+	)
+
+	tests := []struct {
+		name     string
+		maxBytes int
+		stored   bool
+	}{
+		{name: "stored", maxBytes: 1 << 20, stored: true},
+		{name: "too-big", maxBytes: 8},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mock, cleanup := mocks.NewMock()
+			defer cleanup()
+			ctx := NewTransformContext(mock.Ctx, mock.Log, mock.Conf)
+
+			stream := mocks.NewMockBlockInputStream(mocks.NewSourceFromSlice(block, block))
+			datasource := NewDataSourceTransform(ctx, stream)
+
+			var stored [][]byte
+			var called bool
+			cache := NewQueryCacheTransform(ctx, test.maxBytes, func(blocks [][]byte) {
+				stored, called = blocks, true
+			})
+
+			sink := processors.NewSink("sink")
+			pipeline := processors.NewPipeline(context.Background())
+			pipeline.Add(datasource)
+			pipeline.Add(cache)
+			pipeline.Add(sink)
+			pipeline.Run()
+
+			// The blocks pass through whether they are stored or not.
+			var n int
+			err := pipeline.Wait(func(x interface{}) error {
+				assert.True(t, mocks.DataBlockEqual(block, x.(*datablocks.DataBlock)))
+				n++
+				return nil
+			})
+			assert.Nil(t, err)
+			assert.Equal(t, 2, n)
+
+			assert.Equal(t, test.stored, called)
+			if test.stored {
+				assert.Equal(t, 2, len(stored))
+				for _, data := range stored {
+					actual, err := datablocks.ReadNative(binary.NewReader(bytes.NewReader(data)))
+					assert.Nil(t, err)
+					assert.True(t, mocks.DataBlockEqual(block, actual))
+				}
+			}
+			stats := cache.(*QueryCacheTransform).Stats()
+			assert.Equal(t, int64(4), stats.ReadRows.Get())
+		})
+	}
+}
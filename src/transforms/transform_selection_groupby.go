@@ -18,6 +18,9 @@ import (
 	"github.com/gammazero/workerpool"
 )
 
+// groupStateBytes is the estimate of one expression with its state of a group.
+const groupStateBytes = 128
+
 type GroupBySelectionTransform struct {
 	ctx            *TransformContext
 	plan           *planners.SelectionPlan
@@ -40,9 +43,15 @@ func (t *GroupBySelectionTransform) Execute() {
 	defer out.Close()
 
 	var mu sync.Mutex
+	var charged int64
+	var failed bool
 	groupers := make([]*collections.HashMap, 0, 32)
 	workerPool := workerpool.New(ctx.conf.Runtime.ParallelWorkerNumber)
 
+	// The groups are kept until all the blocks are merged.
+	memory := sessions.MemoryTrackerFromContext(ctx.ctx)
+	defer func() { memory.Free(charged) }()
+
 	onNext := func(x interface{}) {
 		switch y := x.(type) {
 		case *datablocks.DataBlock:
@@ -53,7 +62,19 @@ func (t *GroupBySelectionTransform) Execute() {
 					out.Send(err)
 					return
 				}
+				bytes := int64(grouper.Bytes() + grouper.Count()*plan.Projects.Length()*groupStateBytes)
 				mu.Lock()
+				if failed {
+					mu.Unlock()
+					return
+				}
+				if err := memory.Alloc(bytes); err != nil {
+					failed = true
+					mu.Unlock()
+					out.Send(err)
+					return
+				}
+				charged += bytes
 				groupers = append(groupers, grouper)
 				mu.Unlock()
 
@@ -70,6 +91,9 @@ func (t *GroupBySelectionTransform) Execute() {
 
 	onDone := func() {
 		workerPool.StopWait()
+		if failed {
+			return
+		}
 		final := collections.NewHashMap()
 		for _, grouper := range groupers {
 			iter := grouper.GetIterator()
@@ -10,8 +10,6 @@ import (
 	"processors"
 	"sessions"
 	"time"
-
-	"github.com/gammazero/workerpool"
 )
 
 type NormalSelectionTransform struct {
@@ -30,7 +28,6 @@ func NewNormalSelectionTransform(ctx *TransformContext, plan *planners.Selection
 }
 
 func (t *NormalSelectionTransform) Execute() {
-	ctx := t.ctx
 	out := t.Out()
 	defer out.Close()
 	plan := t.plan
@@ -42,31 +39,26 @@ func (t *NormalSelectionTransform) Execute() {
 		return
 	}
 
-	workerPool := workerpool.New(ctx.conf.Runtime.ParallelWorkerNumber)
+	// The blocks are selected in order, the pipeline runs one transform per lane.
 	onNext := func(x interface{}) {
 		switch y := x.(type) {
 		case *datablocks.DataBlock:
-			workerPool.Submit(func() {
-				start := time.Now()
-				if block, err := y.NormalSelectionByPlan(fields, plan); err != nil {
-					out.Send(err)
-				} else {
-					cost := time.Since(start)
-					t.progressValues.Cost.Add(cost)
-					t.progressValues.ReadBytes.Add(int64(y.TotalBytes()))
-					t.progressValues.ReadRows.Add(int64(y.NumRows()))
-					t.progressValues.TotalRowsToRead.Add(int64(y.NumRows()))
-					out.Send(block)
-				}
-			})
+			start := time.Now()
+			if block, err := y.NormalSelectionByPlan(fields, plan); err != nil {
+				out.Send(err)
+			} else {
+				cost := time.Since(start)
+				t.progressValues.Cost.Add(cost)
+				t.progressValues.ReadBytes.Add(int64(y.TotalBytes()))
+				t.progressValues.ReadRows.Add(int64(y.NumRows()))
+				t.progressValues.TotalRowsToRead.Add(int64(y.NumRows()))
+				out.Send(block)
+			}
 		case error:
 			out.Send(y)
 		}
 	}
-	onDone := func() {
-		workerPool.StopWait()
-	}
-	t.Subscribe(onNext, onDone)
+	t.Subscribe(onNext)
 }
 
 func (t *NormalSelectionTransform) Stats() sessions.ProgressValues {
@@ -0,0 +1,188 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package users
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"strings"
+
+	"config"
+
+	"base/errors"
+)
+
+const (
+	DefaultUserName = "default"
+
+	// The system database is always accessible, it's the default database of the sessions.
+	systemDatabase = "system"
+)
+
+type User struct {
+	Name      string
+	Readonly  bool
+	Settings  map[string]string
+	databases map[string]bool
+}
+
+// Authenticate checks the password of the user against the configuration.
+// The unknown user and the bad password fail the same way, not telling if the user exists.
+// Without any users configured, the 'default' user with an empty password is allowed everything.
+func Authenticate(conf *config.Config, name string, password string) (*User, error) {
+	if name == "" {
+		name = DefaultUserName
+	}
+
+	if len(conf.Users) == 0 && name == DefaultUserName {
+		if password == "" {
+			return &User{Name: name}, nil
+		}
+		return nil, authenticationError(name)
+	}
+
+	found, dummy := findUser(conf, name)
+	if !checkPassword(found, password) || dummy {
+		return nil, authenticationError(name)
+	}
+	return newUser(conf, found)
+}
+
+// AuthenticateNative checks the mysql_native_password response to the scramble,
+// the response is SHA1(password) XOR SHA1(scramble + SHA1(SHA1(password))).
+// It needs the plain password or the PasswordDoubleSHA1Hex, the users with only the SHA256 can't use it.
+func AuthenticateNative(conf *config.Config, name string, scramble []byte, response []byte) (*User, error) {
+	if name == "" {
+		name = DefaultUserName
+	}
+
+	if len(conf.Users) == 0 && name == DefaultUserName {
+		if len(response) == 0 {
+			return &User{Name: name}, nil
+		}
+		return nil, authenticationError(name)
+	}
+
+	found, dummy := findUser(conf, name)
+	if !checkNativePassword(found, scramble, response) || dummy {
+		return nil, authenticationError(name)
+	}
+	return newUser(conf, found)
+}
+
+// findUser returns the configured user, or a dummy one to be checked anyway to keep the same timing.
+func findUser(conf *config.Config, name string) (*config.User, bool) {
+	for i := range conf.Users {
+		if conf.Users[i].Name == name {
+			return &conf.Users[i], false
+		}
+	}
+	return &config.User{}, true
+}
+
+func newUser(conf *config.Config, found *config.User) (*User, error) {
+	user := &User{
+		Name:     found.Name,
+		Readonly: found.Readonly,
+		Settings: make(map[string]string),
+	}
+	if len(found.Databases) > 0 {
+		user.databases = make(map[string]bool)
+		for _, db := range found.Databases {
+			user.databases[db] = true
+		}
+	}
+	if found.Profile != "" {
+		profile := getProfile(conf, found.Profile)
+		if profile == nil {
+			return nil, errors.Errorf("Settings profile %s not found for user %s", found.Profile, found.Name)
+		}
+		for k, v := range profile.Settings {
+			user.Settings[k] = v
+		}
+	}
+	return user, nil
+}
+
+func checkPassword(user *config.User, password string) bool {
+	if user.PasswordSHA256Hex != "" {
+		sum := sha256.Sum256([]byte(password))
+		expect := strings.ToLower(user.PasswordSHA256Hex)
+		return subtle.ConstantTimeCompare([]byte(hex.EncodeToString(sum[:])), []byte(expect)) == 1
+	}
+	if user.PasswordDoubleSHA1Hex != "" {
+		stage1 := sha1.Sum([]byte(password))
+		stage2 := sha1.Sum(stage1[:])
+		expect := strings.ToLower(user.PasswordDoubleSHA1Hex)
+		return subtle.ConstantTimeCompare([]byte(hex.EncodeToString(stage2[:])), []byte(expect)) == 1
+	}
+	return subtle.ConstantTimeCompare([]byte(password), []byte(user.Password)) == 1
+}
+
+func checkNativePassword(user *config.User, scramble []byte, response []byte) bool {
+	var stage2 []byte
+	switch {
+	case user.PasswordDoubleSHA1Hex != "":
+		v, err := hex.DecodeString(user.PasswordDoubleSHA1Hex)
+		if err != nil {
+			return false
+		}
+		stage2 = v
+	case user.PasswordSHA256Hex != "":
+		return false
+	default:
+		// The empty password has the empty response.
+		if user.Password == "" {
+			return len(response) == 0
+		}
+		stage1 := sha1.Sum([]byte(user.Password))
+		sum := sha1.Sum(stage1[:])
+		stage2 = sum[:]
+	}
+	if len(response) != sha1.Size || len(stage2) != sha1.Size {
+		return false
+	}
+
+	h := sha1.New()
+	h.Write(scramble)
+	h.Write(stage2)
+	stage1 := h.Sum(nil)
+	for i := range stage1 {
+		stage1[i] ^= response[i]
+	}
+	got := sha1.Sum(stage1)
+	return subtle.ConstantTimeCompare(got[:], stage2) == 1
+}
+
+func getProfile(conf *config.Config, name string) *config.Profile {
+	for i := range conf.Profiles {
+		if conf.Profiles[i].Name == name {
+			return &conf.Profiles[i]
+		}
+	}
+	return nil
+}
+
+func authenticationError(name string) error {
+	return errors.ErrorWithCode(errors.AUTHENTICATION_FAILED, "%s: Authentication failed: password is incorrect or there is no user with such name", name)
+}
+
+// CheckDatabase checks if the user can access the database.
+func (user *User) CheckDatabase(database string) error {
+	if user.databases == nil || database == systemDatabase || user.databases[database] {
+		return nil
+	}
+	return errors.ErrorWithCode(errors.ACCESS_DENIED, "%s: Not enough privileges to access database %s", user.Name, database)
+}
+
+// CheckWrite checks if the user can run the INSERT or DDL queries.
+func (user *User) CheckWrite() error {
+	if user.Readonly {
+		return errors.ErrorWithCode(errors.READONLY, "%s: Cannot execute query in readonly mode", user.Name)
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+// Copyright 2020 The VectorSQL Authors.
+//
+// Code is licensed under Apache License, Version 2.0.
+
+package users
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"config"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuthenticate(t *testing.T) {
+	sum := sha256.Sum256([]byte("secret"))
+	conf := config.DefaultConfig()
+	conf.Users = []config.User{
+		{Name: "default", Password: "pass"},
+		{Name: "reader", PasswordSHA256Hex: hex.EncodeToString(sum[:]), Profile: "readonly", Databases: []string{"db1"}, Readonly: true},
+		{Name: "broken", Profile: "none"},
+	}
+	conf.Profiles = []config.Profile{
+		{Name: "readonly", Settings: map[string]string{"max_insert_block_size": "10"}},
+	}
+
+	tests := []struct {
+		name     string
+		user     string
+		password string
+		expect   string
+		err      string
+	}{
+		{
+			name:     "default",
+			password: "pass",
+			expect:   "default",
+		},
+		{
+			name:     "sha256",
+			user:     "reader",
+			password: "secret",
+			expect:   "reader",
+		},
+		{
+			name:     "bad-password",
+			user:     "reader",
+			password: "pass",
+			err:      "reader: Authentication failed: password is incorrect or there is no user with such name (errno 516)",
+		},
+		{
+			name: "unknown-user",
+			user: "nobody",
+			err:  "nobody: Authentication failed: password is incorrect or there is no user with such name (errno 516)",
+		},
+		{
+			name: "unknown-profile",
+			user: "broken",
+			err:  "Settings profile none not found for user broken",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			user, err := Authenticate(conf, test.user, test.password)
+			if test.err != "" {
+				assert.Equal(t, test.err, err.Error())
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, test.expect, user.Name)
+		})
+	}
+
+	user, err := Authenticate(conf, "reader", "secret")
+	assert.Nil(t, err)
+	assert.Equal(t, "10", user.Settings["max_insert_block_size"])
+	assert.Nil(t, user.CheckDatabase("db1"))
+	assert.Nil(t, user.CheckDatabase("system"))
+	assert.Equal(t, "reader: Not enough privileges to access database db2 (errno 497)", user.CheckDatabase("db2").Error())
+	assert.Equal(t, "reader: Cannot execute query in readonly mode (errno 164)", user.CheckWrite().Error())
+}
+
+func TestAuthenticateWithoutUsers(t *testing.T) {
+	conf := config.DefaultConfig()
+
+	user, err := Authenticate(conf, "", "")
+	assert.Nil(t, err)
+	assert.Equal(t, "default", user.Name)
+	assert.Nil(t, user.CheckDatabase("db2"))
+	assert.Nil(t, user.CheckWrite())
+
+	_, err = Authenticate(conf, "default", "x")
+	assert.NotNil(t, err)
+	_, err = Authenticate(conf, "admin", "")
+	assert.NotNil(t, err)
+}
+
+// nativeResponse is the mysql_native_password response of the client.
+func nativeResponse(scramble []byte, password string) []byte {
+	if password == "" {
+		return nil
+	}
+	stage1 := sha1.Sum([]byte(password))
+	stage2 := sha1.Sum(stage1[:])
+	h := sha1.New()
+	h.Write(scramble)
+	h.Write(stage2[:])
+	response := h.Sum(nil)
+	for i := range response {
+		response[i] ^= stage1[i]
+	}
+	return response
+}
+
+func TestAuthenticateNative(t *testing.T) {
+	sha256Sum := sha256.Sum256([]byte("secret"))
+	stage1 := sha1.Sum([]byte("secret"))
+	stage2 := sha1.Sum(stage1[:])
+	conf := config.DefaultConfig()
+	conf.Users = []config.User{
+		{Name: "default", Password: "pass"},
+		{Name: "empty"},
+		{Name: "bi", PasswordDoubleSHA1Hex: hex.EncodeToString(stage2[:])},
+		{Name: "reader", PasswordSHA256Hex: hex.EncodeToString(sha256Sum[:])},
+	}
+	scramble := []byte("0123456789abcdefghij")
+
+	tests := []struct {
+		name     string
+		user     string
+		password string
+		err      bool
+	}{
+		{name: "plain", user: "default", password: "pass"},
+		{name: "plain-bad", user: "default", password: "x", err: true},
+		{name: "empty", user: "empty"},
+		{name: "empty-bad", user: "empty", password: "x", err: true},
+		{name: "double-sha1", user: "bi", password: "secret"},
+		{name: "double-sha1-bad", user: "bi", password: "pass", err: true},
+		{name: "sha256-unsupported", user: "reader", password: "secret", err: true},
+		{name: "unknown-user", user: "nobody", err: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			user, err := AuthenticateNative(conf, test.user, scramble, nativeResponse(scramble, test.password))
+			if test.err {
+				assert.Equal(t, test.user+": Authentication failed: password is incorrect or there is no user with such name (errno 516)", err.Error())
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, test.user, user.Name)
+		})
+	}
+
+	// The double SHA1 is also checked on the plain password of the other protocols.
+	user, err := Authenticate(conf, "bi", "secret")
+	assert.Nil(t, err)
+	assert.Equal(t, "bi", user.Name)
+
+	// Without users.
+	user, err = AuthenticateNative(config.DefaultConfig(), "", scramble, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "default", user.Name)
+}